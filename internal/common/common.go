@@ -16,7 +16,10 @@
 
 package common
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"time"
+)
 
 // system filed id:
 // 0: unique row id
@@ -44,11 +47,66 @@ const (
 	// DefaultShardsNum defines the default number of shards when creating a collection
 	DefaultShardsNum = int32(2)
 
+	// DefaultPartitionsWithPartitionKey defines the default number of partitions when the collection has a partition key
+	DefaultPartitionsWithPartitionKey = int64(16)
+
 	// InvalidPartitionID indicates that the partition is not specified. It will be set when the partitionName is empty
 	InvalidPartitionID = int64(-1)
 
 	// InvalidFieldID indicates that the field does not exist . It will be set when the field is not found.
 	InvalidFieldID = int64(-1)
+
+	// DefaultRootUser defines the name of the user created when RootCoord starts for the first time
+	DefaultRootUser = "root"
+
+	// DefaultRootPassword defines the initial password of the default root user
+	DefaultRootPassword = "Milvus"
+
+	// RoleAdmin defines the built-in role which owns all the privileges
+	RoleAdmin = "admin"
+
+	// RolePublic defines the built-in role which every user implicitly belongs to
+	RolePublic = "public"
+
+	// HeaderInternalToken is the gRPC metadata key which carries the token shared by the components,
+	// the proxy authenticates the calls to its internal service by it
+	HeaderInternalToken = "internal-token"
+
+	// AnyWord matches all the objects of an object type in a grant
+	AnyWord = "*"
+
+	// DefaultDBName defines the name of the database used when the request doesn't specify one
+	DefaultDBName = "default"
+
+	// DefaultDBID defines the ID of the default database, the collections created before databases are supported belong to it
+	DefaultDBID = int64(0)
+
+	// QueryIteratorPrefix is the etcd key prefix of the query iterators under the meta root path
+	QueryIteratorPrefix = "query_iterator"
+
+	// TimetravelRange is how long the compaction keeps the history of the data for the time travel
+	TimetravelRange = 5 * 24 * time.Hour
+
+	// MaxLengthKey is the type param key of the maximum length in bytes of a VarChar field
+	MaxLengthKey = "max_length"
+
+	// MaxVarCharLength is the upper limit of the max_length type param of VarChar fields
+	MaxVarCharLength = 65535
+
+	// MaxCapacityKey is the type param key of the maximum number of elements of an Array field
+	MaxCapacityKey = "max_capacity"
+
+	// MaxArrayCapacity is the upper limit of the max_capacity type param of Array fields
+	MaxArrayCapacity = 4096
+
+	// MaxNnzKey is the type param key of the maximum number of non-zero elements of a row of a sparse float vector field
+	MaxNnzKey = "max_nnz"
+
+	// MaxSparseFloatNnz is the upper limit of the max_nnz type param of sparse float vector fields
+	MaxSparseFloatNnz = 4096
+
+	// MaxJSONSize is the upper limit of the size in bytes of a serialized JSON document in a JSON field
+	MaxJSONSize = 65536
 )
 
 // Endian is type alias of binary.LittleEndian.
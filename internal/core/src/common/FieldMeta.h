@@ -44,6 +44,9 @@ datatype_sizeof(DataType data_type, int dim = 1) {
             Assert(dim % 8 == 0);
             return dim / 8;
         }
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16:
+            return sizeof(uint16_t) * dim;
         default: {
             throw std::invalid_argument("unsupported data type");
         }
@@ -68,11 +71,23 @@ datatype_name(DataType data_type) {
             return "float";
         case DataType::DOUBLE:
             return "double";
+        case DataType::STRING:
+            return "string";
+        case DataType::ARRAY:
+            return "array";
+        case DataType::JSON:
+            return "json";
         case DataType::VECTOR_FLOAT:
             return "vector_float";
         case DataType::VECTOR_BINARY: {
             return "vector_binary";
         }
+        case DataType::VECTOR_FLOAT16:
+            return "vector_float16";
+        case DataType::VECTOR_BFLOAT16:
+            return "vector_bfloat16";
+        case DataType::VECTOR_SPARSE_FLOAT:
+            return "vector_sparse_float";
         default: {
             auto err_msg = "Unsupported DataType(" + std::to_string((int)data_type) + ")";
             PanicInfo(err_msg);
@@ -82,7 +97,32 @@ datatype_name(DataType data_type) {
 
 inline bool
 datatype_is_vector(DataType datatype) {
-    return datatype == DataType::VECTOR_BINARY || datatype == DataType::VECTOR_FLOAT;
+    return datatype == DataType::VECTOR_BINARY || datatype == DataType::VECTOR_FLOAT ||
+           datatype == DataType::VECTOR_FLOAT16 || datatype == DataType::VECTOR_BFLOAT16 ||
+           datatype == DataType::VECTOR_SPARSE_FLOAT;
+}
+
+inline bool
+datatype_is_string(DataType datatype) {
+    return datatype == DataType::STRING;
+}
+
+inline bool
+datatype_is_array(DataType datatype) {
+    return datatype == DataType::ARRAY;
+}
+
+inline bool
+datatype_is_json(DataType datatype) {
+    return datatype == DataType::JSON;
+}
+
+// the values of a variable-length type take their own lengths, a value is the uint32 length followed by the bytes in
+// a row
+inline bool
+datatype_is_variable_length(DataType datatype) {
+    return datatype == DataType::STRING || datatype == DataType::ARRAY || datatype == DataType::JSON ||
+           datatype == DataType::VECTOR_SPARSE_FLOAT;
 }
 
 inline bool
@@ -121,6 +161,24 @@ class FieldMeta {
 
     FieldMeta(const FieldName& name, FieldId id, DataType type) : name_(name), id_(id), type_(type) {
         Assert(!is_vector());
+        Assert(!is_string());
+        Assert(!is_array());
+    }
+
+    FieldMeta(const FieldName& name, FieldId id, DataType type, int64_t max_length)
+        : name_(name), id_(id), type_(type), string_info_(StringInfo{max_length}) {
+        Assert(is_string());
+    }
+
+    FieldMeta(const FieldName& name,
+              FieldId id,
+              DataType type,
+              DataType element_type,
+              int64_t max_capacity,
+              int64_t max_length)
+        : name_(name), id_(id), type_(type), array_info_(ArrayInfo{element_type, max_capacity, max_length}) {
+        Assert(is_array());
+        Assert(!datatype_is_vector(element_type) && !datatype_is_array(element_type));
     }
 
     FieldMeta(const FieldName& name, FieldId id, DataType type, int64_t dim, std::optional<MetricType> metric_type)
@@ -131,7 +189,57 @@ class FieldMeta {
     bool
     is_vector() const {
         Assert(type_ != DataType::NONE);
-        return type_ == DataType::VECTOR_BINARY || type_ == DataType::VECTOR_FLOAT;
+        return type_ == DataType::VECTOR_BINARY || type_ == DataType::VECTOR_FLOAT ||
+               type_ == DataType::VECTOR_FLOAT16 || type_ == DataType::VECTOR_BFLOAT16 ||
+               type_ == DataType::VECTOR_SPARSE_FLOAT;
+    }
+
+    bool
+    is_string() const {
+        Assert(type_ != DataType::NONE);
+        return type_ == DataType::STRING;
+    }
+
+    bool
+    is_variable_length() const {
+        Assert(type_ != DataType::NONE);
+        return datatype_is_variable_length(type_);
+    }
+
+    int64_t
+    get_max_length() const {
+        Assert(is_string());
+        Assert(string_info_.has_value());
+        return string_info_->max_length_;
+    }
+
+    bool
+    is_array() const {
+        Assert(type_ != DataType::NONE);
+        return type_ == DataType::ARRAY;
+    }
+
+    DataType
+    get_element_type() const {
+        Assert(is_array());
+        Assert(array_info_.has_value());
+        return array_info_->element_type_;
+    }
+
+    int64_t
+    get_max_capacity() const {
+        Assert(is_array());
+        Assert(array_info_.has_value());
+        return array_info_->max_capacity_;
+    }
+
+    // the size of an element of the array, a string element takes its own length and has no fixed size
+    int
+    get_element_sizeof() const {
+        Assert(is_array());
+        Assert(array_info_.has_value());
+        AssertInfo(!datatype_is_string(array_info_->element_type_), "the string elements of an array have no fixed size");
+        return datatype_sizeof(array_info_->element_type_);
     }
 
     int64_t
@@ -163,8 +271,21 @@ class FieldMeta {
         return type_;
     }
 
+    // the value of a nullable field is led by a validity byte in a row
+    bool
+    is_nullable() const {
+        return nullable_;
+    }
+
+    void
+    set_nullable(bool nullable) {
+        AssertInfo(!nullable || !is_vector(), "vector field can not be nullable");
+        nullable_ = nullable;
+    }
+
     int
     get_sizeof() const {
+        AssertInfo(!is_variable_length(), "the values of a variable-length field have no fixed size");
         if (is_vector()) {
             return datatype_sizeof(type_, get_dim());
         } else {
@@ -174,13 +295,25 @@ class FieldMeta {
 
  private:
     struct VectorInfo {
+        // for sparse float vector, dim_ holds max_nnz
         int64_t dim_;
         std::optional<MetricType> metric_type_;
     };
+    struct StringInfo {
+        int64_t max_length_;
+    };
+    struct ArrayInfo {
+        DataType element_type_;
+        int64_t max_capacity_;
+        int64_t max_length_;
+    };
     FieldName name_;
     FieldId id_;
     DataType type_ = DataType::NONE;
     std::optional<VectorInfo> vector_info_;
+    std::optional<StringInfo> string_info_;
+    std::optional<ArrayInfo> array_info_;
+    bool nullable_ = false;
 };
 
 }  // namespace milvus
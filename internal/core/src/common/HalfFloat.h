@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+#include <cstdint>
+#include <cstring>
+#include <vector>
+
+#include "Types.h"
+#include "exceptions/EasyAssert.h"
+
+namespace milvus {
+
+inline float
+float16_to_float(uint16_t h) {
+    uint32_t sign = static_cast<uint32_t>(h & 0x8000) << 16;
+    uint32_t exp = (h >> 10) & 0x1f;
+    uint32_t mant = h & 0x3ff;
+    uint32_t bits;
+    if (exp == 0x1f) {
+        // inf or nan
+        bits = sign | 0x7f800000 | (mant << 13);
+    } else if (exp != 0) {
+        bits = sign | ((exp + 112) << 23) | (mant << 13);
+    } else if (mant == 0) {
+        bits = sign;
+    } else {
+        // subnormal, normalized in float
+        exp = 113;
+        while ((mant & 0x400) == 0) {
+            mant <<= 1;
+            --exp;
+        }
+        bits = sign | (exp << 23) | ((mant & 0x3ff) << 13);
+    }
+    float f;
+    std::memcpy(&f, &bits, sizeof(f));
+    return f;
+}
+
+inline float
+bfloat16_to_float(uint16_t h) {
+    uint32_t bits = static_cast<uint32_t>(h) << 16;
+    float f;
+    std::memcpy(&f, &bits, sizeof(f));
+    return f;
+}
+
+// converts count float16 or bfloat16 elements into floats, which the distances are calculated on
+inline std::vector<float>
+half_float_to_float(DataType data_type, const void* data, int64_t count) {
+    auto src = reinterpret_cast<const uint16_t*>(data);
+    std::vector<float> result(count);
+    if (data_type == DataType::VECTOR_FLOAT16) {
+        for (int64_t i = 0; i < count; ++i) {
+            result[i] = float16_to_float(src[i]);
+        }
+    } else {
+        AssertInfo(data_type == DataType::VECTOR_BFLOAT16, "[half_float_to_float]Data type isn't half float");
+        for (int64_t i = 0; i < count; ++i) {
+            result[i] = bfloat16_to_float(src[i]);
+        }
+    }
+    return result;
+}
+
+}  // namespace milvus
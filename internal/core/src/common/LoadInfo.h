@@ -28,10 +28,15 @@ struct LoadFieldDataInfo {
     int64_t field_id;
     const void* blob = nullptr;
     int64_t row_count = -1;
+    const bool* valid_data = nullptr;  // validity of the rows of a nullable field, nullptr if all the rows are valid
+    // the row_count + 1 offsets of the values of a variable-length field in blob, the i-th value takes the bytes
+    // [offsets[i], offsets[i + 1]), nullptr for a fixed-width field
+    const int64_t* offsets = nullptr;
 };
 
 struct LoadDeletedRecordInfo {
     const void* timestamps = nullptr;
-    const void* primary_keys = nullptr;
+    const void* primary_keys = nullptr;  // serialized proto::schema::IDs
+    int64_t primary_keys_size = 0;
     int64_t row_count = -1;
 };
@@ -51,21 +51,41 @@ Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
 
         auto data_type = DataType(child.data_type());
 
-        if (datatype_is_vector(data_type)) {
-            auto type_map = RepeatedKeyValToMap(child.type_params());
-            auto index_map = RepeatedKeyValToMap(child.index_params());
+        auto field_meta = [&]() {
+            if (datatype_is_vector(data_type)) {
+                auto type_map = RepeatedKeyValToMap(child.type_params());
+                auto index_map = RepeatedKeyValToMap(child.index_params());
 
-            AssertInfo(type_map.count("dim"), "dim not found");
-            auto dim = boost::lexical_cast<int64_t>(type_map.at("dim"));
-            if (!index_map.count("metric_type")) {
-                schema->AddField(name, field_id, data_type, dim, std::nullopt);
-            } else {
+                // a sparse float vector has no fixed dim, its number of non-zeros is bounded by max_nnz
+                auto dim_key = data_type == DataType::VECTOR_SPARSE_FLOAT ? "max_nnz" : "dim";
+                AssertInfo(type_map.count(dim_key), std::string(dim_key) + " not found");
+                auto dim = boost::lexical_cast<int64_t>(type_map.at(dim_key));
+                if (!index_map.count("metric_type")) {
+                    return FieldMeta(name, field_id, data_type, dim, std::nullopt);
+                }
                 auto metric_type = GetMetricType(index_map.at("metric_type"));
-                schema->AddField(name, field_id, data_type, dim, metric_type);
+                return FieldMeta(name, field_id, data_type, dim, metric_type);
+            } else if (datatype_is_string(data_type)) {
+                auto type_map = RepeatedKeyValToMap(child.type_params());
+                AssertInfo(type_map.count("max_length"), "max_length not found");
+                auto max_length = boost::lexical_cast<int64_t>(type_map.at("max_length"));
+                return FieldMeta(name, field_id, data_type, max_length);
+            } else if (datatype_is_array(data_type)) {
+                auto type_map = RepeatedKeyValToMap(child.type_params());
+                auto element_type = DataType(child.element_type());
+                AssertInfo(type_map.count("max_capacity"), "max_capacity not found");
+                auto max_capacity = boost::lexical_cast<int64_t>(type_map.at("max_capacity"));
+                int64_t max_length = 0;
+                if (datatype_is_string(element_type)) {
+                    AssertInfo(type_map.count("max_length"), "max_length not found");
+                    max_length = boost::lexical_cast<int64_t>(type_map.at("max_length"));
+                }
+                return FieldMeta(name, field_id, data_type, element_type, max_capacity, max_length);
             }
-        } else {
-            schema->AddField(name, field_id, data_type);
-        }
+            return FieldMeta(name, field_id, data_type);
+        }();
+        field_meta.set_nullable(child.nullable());
+        schema->AddField(std::move(field_meta));
 
         if (child.is_primary_key()) {
             AssertInfo(!schema->get_primary_key_offset().has_value(), "repetitive primary key");
@@ -73,6 +93,7 @@ Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
             schema->set_primary_key(field_offset);
         }
     }
+    schema->set_ttl_seconds(schema_proto.ttl_seconds());
     if (schema->get_is_auto_id()) {
         AssertInfo(!schema->get_primary_key_offset().has_value(), "auto id mode: shouldn't have primary key");
     } else {
@@ -52,6 +52,13 @@ class Schema {
         this->AddField(std::move(field_meta));
     }
 
+    // string type
+    void
+    AddField(const FieldName& name, const FieldId id, DataType data_type, int64_t max_length) {
+        auto field_meta = FieldMeta(name, id, data_type, max_length);
+        this->AddField(std::move(field_meta));
+    }
+
     // vector type
     void
     AddField(const FieldName& name,
@@ -79,6 +86,16 @@ class Schema {
         return is_auto_id_;
     }
 
+    void
+    set_ttl_seconds(int64_t ttl_seconds) {
+        ttl_seconds_ = ttl_seconds;
+    }
+
+    int64_t
+    get_ttl_seconds() const {
+        return ttl_seconds_;
+    }
+
     auto
     begin() const {
         return fields_.begin();
@@ -153,8 +170,11 @@ class Schema {
         AssertInfo(!id_offsets_.count(field_meta.get_id()), "duplicated field id");
         id_offsets_.emplace(field_meta.get_id(), offset);
 
-        auto field_sizeof = field_meta.get_sizeof();
-        sizeof_infos_.push_back(std::move(field_sizeof));
+        // the size of the field in a row, including the validity byte of a nullable field, a variable-length field
+        // only counts the uint32 length of its value
+        int64_t field_sizeof = field_meta.is_variable_length() ? sizeof(uint32_t) : field_meta.get_sizeof();
+        field_sizeof += field_meta.is_nullable() ? 1 : 0;
+        sizeof_infos_.push_back(field_sizeof);
         fields_.emplace_back(std::move(field_meta));
         total_sizeof_ += field_sizeof;
     }
@@ -170,6 +190,7 @@ class Schema {
     int total_sizeof_ = 0;
     bool is_auto_id_ = true;
     std::optional<FieldOffset> primary_key_offset_opt_;
+    int64_t ttl_seconds_ = 0;  // the entities expire ttl_seconds after they are inserted, 0 means never
 };
 
 using SchemaPtr = std::shared_ptr<Schema>;
@@ -15,6 +15,7 @@
 #include <limits>
 #include <string>
 #include <utility>
+#include <variant>
 #include <vector>
 #include <boost/align/aligned_allocator.hpp>
 #include <NamedType/named_type.hpp>
@@ -28,6 +29,18 @@ namespace milvus {
 
 using Timestamp = uint64_t;  // TODO: use TiKV-like timestamp
 constexpr auto MAX_TIMESTAMP = std::numeric_limits<Timestamp>::max();
+// the lower bits of the hybrid timestamp hold the logical counter, the higher bits hold the physical time in ms
+constexpr int LOGICAL_BITS = 18;
+
+// returns the timestamp before which the entities are expired at the timestamp, 0 if they never expire
+inline Timestamp
+get_expire_timestamp(int64_t ttl_seconds, Timestamp timestamp) {
+    if (ttl_seconds <= 0) {
+        return 0;
+    }
+    auto ttl = (static_cast<Timestamp>(ttl_seconds) * 1000) << LOGICAL_BITS;
+    return timestamp > ttl ? timestamp - ttl : 0;
+}
 
 using engine::DataType;
 using engine::idx_t;
@@ -38,6 +51,9 @@ using VectorArray = proto::schema::VectorField;
 using IdArray = proto::schema::IDs;
 using MetricType = faiss::MetricType;
 
+// primary key of int64 or varchar, std::monostate stands for an invalid one
+using PkType = std::variant<std::monostate, int64_t, std::string>;
+
 MetricType
 GetMetricType(const std::string& type);
 
@@ -79,7 +95,7 @@ struct SearchResult {
     // TODO(gexi): utilize these field
     void* segment_;
     std::vector<int64_t> result_offsets_;
-    std::vector<int64_t> primary_keys_;
+    std::vector<PkType> primary_keys_;
     std::vector<std::vector<char>> row_data_;
 };
 
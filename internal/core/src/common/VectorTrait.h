@@ -28,6 +28,32 @@ class BinaryVector : public VectorTrait {
     static constexpr auto metric_type = DataType::VECTOR_BINARY;
 };
 
+// float16 and bfloat16 vectors are stored as 2 bytes per element, and searched on their float values
+class Float16Vector : public VectorTrait {
+ public:
+    using embedded_type = uint16_t;
+    static constexpr auto metric_type = DataType::VECTOR_FLOAT16;
+};
+
+class BFloat16Vector : public VectorTrait {
+ public:
+    using embedded_type = uint16_t;
+    static constexpr auto metric_type = DataType::VECTOR_BFLOAT16;
+};
+
+// each sparse float vector is stored as a variable-length value of its (uint32 index, float value) pairs
+class SparseFloatVector : public VectorTrait {
+ public:
+    using embedded_type = uint8_t;
+    static constexpr auto metric_type = DataType::VECTOR_SPARSE_FLOAT;
+};
+
+// tag of the array columns, each array is stored as a variable-length value
+class Array {};
+
+// tag of the JSON columns, each serialized JSON document is stored as a variable-length value
+class JSON {};
+
 template <typename VectorType>
 inline constexpr int64_t
 element_sizeof(int64_t dim) {
@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #pragma once
+#include <stdbool.h>
 #include <stdint.h>
 
 #ifdef __cplusplus
@@ -45,11 +46,14 @@ typedef struct CLoadFieldDataInfo {
     int64_t field_id;
     void* blob;
     int64_t row_count;
+    const bool* valid_data;  // validity of the rows of a nullable field, nullptr if all the rows are valid
+    const int64_t* offsets;  // the row_count + 1 offsets of the values of a variable-length field in blob, or nullptr
 } CLoadFieldDataInfo;
 
 typedef struct CLoadDeletedRecordInfo {
     void* timestamps;
-    void* primary_keys;
+    void* primary_keys;  // serialized proto::schema::IDs
+    int64_t primary_keys_size;
     int64_t row_count;
 } CLoadDeletedRecordInfo;
 
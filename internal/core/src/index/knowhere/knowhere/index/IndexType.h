@@ -69,6 +69,7 @@ extern const char* INDEX_RHNSWSQ;
 extern const char* INDEX_ANNOY;
 extern const char* INDEX_NGTPANNG;
 extern const char* INDEX_NGTONNG;
+extern const char* INDEX_SPARSE_INVERTED;
 }  // namespace IndexEnum
 
 enum class IndexMode { MODE_CPU = 0, MODE_GPU = 1 };
@@ -41,7 +41,10 @@ static const int64_t HNSW_MAX_EFCONSTRUCTION = 512;
 static const int64_t HNSW_MIN_M = 4;
 static const int64_t HNSW_MAX_M = 64;
 static const int64_t HNSW_MAX_EF = 32768;
+static const int64_t SPARSE_MIN_NNZ = 1;
+static const int64_t SPARSE_MAX_NNZ = 4096;
 static const std::vector<std::string> METRICS{knowhere::Metric::L2, knowhere::Metric::IP};
+static const std::vector<std::string> SPARSE_METRICS{knowhere::Metric::IP};
 
 #define CheckIntByRange(key, min, max)                                                                   \
     if (!oricfg.contains(key) || !oricfg[key].is_number_integer() || oricfg[key].get<int64_t>() > max || \
@@ -423,5 +426,19 @@ NGTONNGConfAdapter::CheckSearch(Config& oricfg, const IndexType type, const Inde
     return ConfAdapter::CheckSearch(oricfg, type, mode);
 }
 
+bool
+SparseInvertedConfAdapter::CheckTrain(Config& oricfg, const IndexMode mode) {
+    // sparse rows have no fixed dimension, their number of non-zeros is bounded by max_nnz instead
+    CheckIntByRange(knowhere::meta::MAX_NNZ, SPARSE_MIN_NNZ, SPARSE_MAX_NNZ);
+    CheckStrByValues(knowhere::Metric::TYPE, SPARSE_METRICS);
+    if (oricfg.contains(knowhere::IndexParams::drop_ratio_build)) {
+        auto& ratio = oricfg[knowhere::IndexParams::drop_ratio_build];
+        if (!ratio.is_number() || ratio.get<float>() < 0.0 || ratio.get<float>() >= 1.0) {
+            return false;
+        }
+    }
+    return true;
+}
+
 }  // namespace knowhere
 }  // namespace milvus
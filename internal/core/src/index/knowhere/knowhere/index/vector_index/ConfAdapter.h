@@ -154,5 +154,11 @@ class NGTONNGConfAdapter : public ConfAdapter {
     CheckSearch(Config& oricfg, const IndexType type, const IndexMode mode) override;
 };
 
+class SparseInvertedConfAdapter : public ConfAdapter {
+ public:
+    bool
+    CheckTrain(Config& oricfg, const IndexMode mode) override;
+};
+
 }  // namespace knowhere
 }  // namespace milvus
@@ -56,6 +56,7 @@ AdapterMgr::RegisterAdapter() {
     REGISTER_CONF_ADAPTER(RHNSWSQConfAdapter, IndexEnum::INDEX_RHNSWSQ, rhnswsq_adapter);
     REGISTER_CONF_ADAPTER(NGTPANNGConfAdapter, IndexEnum::INDEX_NGTPANNG, ngtpanng_adapter);
     REGISTER_CONF_ADAPTER(NGTONNGConfAdapter, IndexEnum::INDEX_NGTONNG, ngtonng_adapter);
+    REGISTER_CONF_ADAPTER(SparseInvertedConfAdapter, IndexEnum::INDEX_SPARSE_INVERTED, sparse_inverted_adapter);
 }
 
 }  // namespace knowhere
@@ -0,0 +1,263 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+#include "knowhere/index/vector_index/IndexSparseInverted.h"
+
+#include <algorithm>
+#include <cmath>
+#include <cstring>
+#include <limits>
+#include <utility>
+#include <vector>
+
+#include "knowhere/common/Exception.h"
+#include "knowhere/common/Log.h"
+#include "knowhere/index/vector_index/adapter/VectorAdapter.h"
+
+namespace milvus {
+namespace knowhere {
+
+namespace {
+constexpr int64_t kPairSize = sizeof(uint32_t) + sizeof(float);
+
+// RowStarts returns the starts of the rows, each row is a uint32 length followed by its (index, value) pairs
+std::vector<const uint8_t*>
+RowStarts(const void* data, int64_t rows) {
+    std::vector<const uint8_t*> starts(rows);
+    auto p = static_cast<const uint8_t*>(data);
+    for (int64_t i = 0; i < rows; ++i) {
+        starts[i] = p;
+        uint32_t length;
+        memcpy(&length, p, sizeof(uint32_t));
+        p += sizeof(uint32_t) + length;
+    }
+    return starts;
+}
+
+// ReadRow returns the nnz of the row and points pairs at its (index, value) entries
+uint32_t
+ReadRow(const uint8_t* row, int64_t max_nnz, const uint8_t** pairs) {
+    uint32_t length;
+    memcpy(&length, row, sizeof(uint32_t));
+    if (length % kPairSize != 0) {
+        KNOWHERE_THROW_MSG("invalid size of sparse row");
+    }
+    uint32_t nnz = length / kPairSize;
+    if (nnz > max_nnz) {
+        KNOWHERE_THROW_MSG("sparse row has more non-zeros than max_nnz");
+    }
+    *pairs = row + sizeof(uint32_t);
+    return nnz;
+}
+
+void
+ReadPair(const uint8_t* pairs, uint32_t i, uint32_t* index, float* value) {
+    auto p = pairs + i * kPairSize;
+    memcpy(index, p, sizeof(uint32_t));
+    memcpy(value, p + sizeof(uint32_t), sizeof(float));
+}
+}  // namespace
+
+BinarySet
+IndexSparseInverted::Serialize(const Config& config) {
+    if (count_ == 0 && postings_.empty()) {
+        KNOWHERE_THROW_MSG("index not initialize or trained");
+    }
+
+    std::shared_ptr<uint8_t[]> meta_data(new uint8_t[2 * sizeof(int64_t)]);
+    memcpy(meta_data.get(), &max_nnz_, sizeof(int64_t));
+    memcpy(meta_data.get() + sizeof(int64_t), &count_, sizeof(int64_t));
+
+    // each posting list is laid out as: uint32 index, uint64 length, length * (int64 offset, float value)
+    size_t postings_length = 0;
+    for (auto& [index, list] : postings_) {
+        postings_length += sizeof(uint32_t) + sizeof(uint64_t) + list.size() * (sizeof(int64_t) + sizeof(float));
+    }
+    std::shared_ptr<uint8_t[]> postings_data(new uint8_t[postings_length]);
+    auto p = postings_data.get();
+    for (auto& [index, list] : postings_) {
+        uint64_t length = list.size();
+        memcpy(p, &index, sizeof(uint32_t));
+        p += sizeof(uint32_t);
+        memcpy(p, &length, sizeof(uint64_t));
+        p += sizeof(uint64_t);
+        for (auto& posting : list) {
+            memcpy(p, &posting.offset, sizeof(int64_t));
+            p += sizeof(int64_t);
+            memcpy(p, &posting.value, sizeof(float));
+            p += sizeof(float);
+        }
+    }
+
+    BinarySet res_set;
+    res_set.Append("sparse_inverted_meta", meta_data, 2 * sizeof(int64_t));
+    res_set.Append("sparse_inverted_postings", postings_data, postings_length);
+    if (config.contains(INDEX_FILE_SLICE_SIZE_IN_MEGABYTE)) {
+        Disassemble(config[INDEX_FILE_SLICE_SIZE_IN_MEGABYTE].get<int64_t>() * 1024 * 1024, res_set);
+    }
+    return res_set;
+}
+
+void
+IndexSparseInverted::Load(const BinarySet& index_binary) {
+    Assemble(const_cast<BinarySet&>(index_binary));
+    auto meta_data = index_binary.GetByName("sparse_inverted_meta");
+    memcpy(&max_nnz_, meta_data->data.get(), sizeof(int64_t));
+    memcpy(&count_, meta_data->data.get() + sizeof(int64_t), sizeof(int64_t));
+
+    postings_.clear();
+    auto postings_data = index_binary.GetByName("sparse_inverted_postings");
+    auto p = postings_data->data.get();
+    auto end = p + postings_data->size;
+    while (p < end) {
+        uint32_t index;
+        uint64_t length;
+        memcpy(&index, p, sizeof(uint32_t));
+        p += sizeof(uint32_t);
+        memcpy(&length, p, sizeof(uint64_t));
+        p += sizeof(uint64_t);
+        auto& list = postings_[index];
+        list.resize(length);
+        for (auto& posting : list) {
+            memcpy(&posting.offset, p, sizeof(int64_t));
+            p += sizeof(int64_t);
+            memcpy(&posting.value, p, sizeof(float));
+            p += sizeof(float);
+        }
+    }
+}
+
+void
+IndexSparseInverted::BuildAll(const DatasetPtr& dataset_ptr, const Config& config) {
+    GET_TENSOR_DATA_DIM(dataset_ptr)
+
+    auto drop_ratio = 0.0f;
+    if (config.contains(IndexParams::drop_ratio_build)) {
+        drop_ratio = config[IndexParams::drop_ratio_build].get<float>();
+    }
+
+    max_nnz_ = dim;
+    count_ = rows;
+    postings_.clear();
+    auto starts = RowStarts(p_data, rows);
+    std::vector<float> magnitudes;
+    for (int64_t i = 0; i < rows; ++i) {
+        const uint8_t* pairs;
+        auto nnz = ReadRow(starts[i], max_nnz_, &pairs);
+
+        // drop the drop_ratio smallest values (by magnitude) of the row before indexing
+        auto threshold = 0.0f;
+        auto dropped = static_cast<int64_t>(nnz * drop_ratio);
+        if (dropped > 0) {
+            magnitudes.resize(nnz);
+            for (uint32_t j = 0; j < nnz; ++j) {
+                uint32_t index;
+                ReadPair(pairs, j, &index, &magnitudes[j]);
+                magnitudes[j] = std::abs(magnitudes[j]);
+            }
+            std::nth_element(magnitudes.begin(), magnitudes.begin() + dropped - 1, magnitudes.end());
+            threshold = magnitudes[dropped - 1];
+        }
+
+        for (uint32_t j = 0; j < nnz; ++j) {
+            uint32_t index;
+            float value;
+            ReadPair(pairs, j, &index, &value);
+            if (dropped > 0 && std::abs(value) <= threshold) {
+                continue;
+            }
+            postings_[index].push_back({i, value});
+        }
+    }
+}
+
+DatasetPtr
+IndexSparseInverted::Query(const DatasetPtr& dataset_ptr, const Config& config, const faiss::BitsetView bitset) {
+    GET_TENSOR_DATA_DIM(dataset_ptr)
+    auto k = config[meta::TOPK].get<int64_t>();
+    auto all_num = rows * k;
+    auto p_id = static_cast<int64_t*>(malloc(all_num * sizeof(int64_t)));
+    auto p_dist = static_cast<float*>(malloc(all_num * sizeof(float)));
+    auto starts = RowStarts(p_data, rows);
+
+#pragma omp parallel for
+    for (int64_t i = 0; i < rows; ++i) {
+        const uint8_t* pairs;
+        auto nnz = ReadRow(starts[i], dim, &pairs);
+
+        std::vector<float> scores(count_, 0.0f);
+        std::vector<bool> touched(count_, false);
+        for (uint32_t j = 0; j < nnz; ++j) {
+            uint32_t index;
+            float value;
+            ReadPair(pairs, j, &index, &value);
+            auto iter = postings_.find(index);
+            if (iter == postings_.end()) {
+                continue;
+            }
+            for (auto& posting : iter->second) {
+                scores[posting.offset] += value * posting.value;
+                touched[posting.offset] = true;
+            }
+        }
+
+        std::vector<std::pair<float, int64_t>> candidates;
+        for (int64_t offset = 0; offset < count_; ++offset) {
+            if (!touched[offset] || (!bitset.empty() && bitset.test(offset))) {
+                continue;
+            }
+            candidates.emplace_back(scores[offset], offset);
+        }
+        auto result_num = std::min<int64_t>(k, candidates.size());
+        std::partial_sort(candidates.begin(), candidates.begin() + result_num, candidates.end(),
+                          [](const auto& a, const auto& b) { return a.first > b.first; });
+
+        auto local_p_id = p_id + k * i;
+        auto local_p_dist = p_dist + k * i;
+        for (int64_t j = 0; j < result_num; ++j) {
+            local_p_id[j] = candidates[j].second;
+            local_p_dist[j] = candidates[j].first;
+        }
+        MapOffsetToUid(local_p_id, result_num);
+
+        for (int64_t j = result_num; j < k; ++j) {
+            local_p_id[j] = -1;
+            local_p_dist[j] = -std::numeric_limits<float>::max();
+        }
+    }
+
+    auto ret_ds = std::make_shared<Dataset>();
+    ret_ds->Set(meta::IDS, p_id);
+    ret_ds->Set(meta::DISTANCE, p_dist);
+    return ret_ds;
+}
+
+int64_t
+IndexSparseInverted::Count() {
+    return count_;
+}
+
+int64_t
+IndexSparseInverted::Dim() {
+    return max_nnz_;
+}
+
+void
+IndexSparseInverted::UpdateIndexSize() {
+    int64_t size = 0;
+    for (auto& [index, list] : postings_) {
+        size += sizeof(uint32_t) + list.size() * sizeof(Posting);
+    }
+    index_size_ = size;
+}
+
+}  // namespace knowhere
+}  // namespace milvus
@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+#pragma once
+
+#include <memory>
+#include <unordered_map>
+#include <vector>
+
+#include "knowhere/common/Exception.h"
+#include "knowhere/index/vector_index/VecIndex.h"
+
+namespace milvus {
+namespace knowhere {
+
+// IndexSparseInverted keeps one posting list per non-zero dimension of sparse float vectors.
+// Rows are passed one after another, each a uint32 length followed by its (uint32 index, float value) pairs,
+// the dataset DIM carries max_nnz. Only inner product is supported.
+class IndexSparseInverted : public VecIndex {
+ public:
+    IndexSparseInverted() {
+        index_type_ = IndexEnum::INDEX_SPARSE_INVERTED;
+    }
+
+    BinarySet
+    Serialize(const Config& config) override;
+
+    void
+    Load(const BinarySet& index_binary) override;
+
+    void
+    BuildAll(const DatasetPtr& dataset_ptr, const Config& config) override;
+
+    void
+    Train(const DatasetPtr& dataset_ptr, const Config& config) override {
+        KNOWHERE_THROW_MSG("SparseInverted not support build item dynamically, please invoke BuildAll interface.");
+    }
+
+    void
+    AddWithoutIds(const DatasetPtr&, const Config&) override {
+        KNOWHERE_THROW_MSG("Incremental index is not supported");
+    }
+
+    DatasetPtr
+    Query(const DatasetPtr& dataset_ptr, const Config& config, const faiss::BitsetView bitset) override;
+
+    int64_t
+    Count() override;
+
+    int64_t
+    Dim() override;
+
+    void
+    UpdateIndexSize() override;
+
+ private:
+    struct Posting {
+        int64_t offset;
+        float value;
+    };
+
+    int64_t max_nnz_ = 0;
+    int64_t count_ = 0;
+    std::unordered_map<uint32_t, std::vector<Posting>> postings_;
+};
+
+}  // namespace knowhere
+}  // namespace milvus
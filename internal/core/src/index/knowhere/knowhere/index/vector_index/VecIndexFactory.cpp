@@ -26,6 +26,7 @@
 #include "knowhere/index/vector_index/IndexRHNSWFlat.h"
 #include "knowhere/index/vector_index/IndexRHNSWPQ.h"
 #include "knowhere/index/vector_index/IndexRHNSWSQ.h"
+#include "knowhere/index/vector_index/IndexSparseInverted.h"
 #include "knowhere/index/vector_offset_index/IndexIVF_NM.h"
 #include "knowhere/index/vector_offset_index/IndexNSG_NM.h"
 
@@ -105,6 +106,8 @@ VecIndexFactory::CreateVecIndex(const IndexType& type, const IndexMode mode) {
         return std::make_shared<knowhere::IndexNGTPANNG>();
     } else if (type == IndexEnum::INDEX_NGTONNG) {
         return std::make_shared<knowhere::IndexNGTONNG>();
+    } else if (type == IndexEnum::INDEX_SPARSE_INVERTED) {
+        return std::make_shared<knowhere::IndexSparseInverted>();
     } else {
         return nullptr;
     }
@@ -25,6 +25,7 @@ constexpr const char* IDS = "ids";
 constexpr const char* DISTANCE = "distance";
 constexpr const char* TOPK = "k";
 constexpr const char* DEVICEID = "gpu_id";
+constexpr const char* MAX_NNZ = "max_nnz";
 };  // namespace meta
 
 namespace IndexParams {
@@ -67,6 +68,8 @@ constexpr const char* selectively_pruned_edge_size = "selectively_pruned_edge_si
 // NGT_ONNG Params
 constexpr const char* outgoing_edge_size = "outgoing_edge_size";
 constexpr const char* incoming_edge_size = "incoming_edge_size";
+// Sparse Inverted Params
+constexpr const char* drop_ratio_build = "drop_ratio_build";
 }  // namespace IndexParams
 
 namespace Metric {
@@ -67,6 +67,7 @@ IndexWrapper::parse_impl(const std::string& serialized_params_str, knowhere::Con
     /***************************** meta *******************************/
     check_parameter<int>(conf, milvus::knowhere::meta::DIM, stoi_closure, std::nullopt);
     check_parameter<int>(conf, milvus::knowhere::meta::TOPK, stoi_closure, std::nullopt);
+    check_parameter<int>(conf, milvus::knowhere::meta::MAX_NNZ, stoi_closure, std::nullopt);
 
     /***************************** IVF Params *******************************/
     check_parameter<int>(conf, milvus::knowhere::IndexParams::nprobe, stoi_closure, std::nullopt);
@@ -107,6 +108,9 @@ IndexWrapper::parse_impl(const std::string& serialized_params_str, knowhere::Con
     check_parameter<int>(conf, milvus::knowhere::IndexParams::outgoing_edge_size, stoi_closure, std::nullopt);
     check_parameter<int>(conf, milvus::knowhere::IndexParams::incoming_edge_size, stoi_closure, std::nullopt);
 
+    /************************** Sparse Inverted Params *****************************/
+    check_parameter<float>(conf, milvus::knowhere::IndexParams::drop_ratio_build, stof_closure, std::nullopt);
+
     /************************** Serialize Params *******************************/
     check_parameter<int>(conf, milvus::knowhere::INDEX_FILE_SLICE_SIZE_IN_MEGABYTE, stoi_closure, std::optional{4});
 }
@@ -154,6 +158,13 @@ IndexWrapper::dim() {
     return (dimension.value());
 }
 
+int64_t
+IndexWrapper::max_nnz() {
+    auto max_nnz = get_config_by_name<int64_t>(milvus::knowhere::meta::MAX_NNZ);
+    AssertInfo(max_nnz.has_value(), "[IndexWrapper]max_nnz doesn't have value");
+    return (max_nnz.value());
+}
+
 void
 IndexWrapper::BuildWithoutIds(const knowhere::DatasetPtr& dataset) {
     auto index_type = get_index_type();
@@ -26,6 +26,9 @@ class IndexWrapper {
     int64_t
     dim();
 
+    int64_t
+    max_nnz();
+
     void
     BuildWithoutIds(const knowhere::DatasetPtr& dataset);
 
@@ -9,6 +9,8 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <cstring>
+#include <stdexcept>
 #include <string>
 #include "index/knowhere/knowhere/index/vector_index/adapter/VectorAdapter.h"
 #include "indexbuilder/IndexWrapper.h"
@@ -84,6 +86,35 @@ BuildBinaryVecIndexWithoutIds(CIndex index, int64_t data_size, const uint8_t* ve
     return status;
 }
 
+CStatus
+BuildSparseFloatVecIndexWithoutIds(CIndex index, int64_t data_size, const uint8_t* vectors) {
+    auto status = CStatus();
+    try {
+        auto cIndex = (milvus::indexbuilder::IndexWrapper*)index;
+        auto max_nnz = cIndex->max_nnz();
+        int64_t row_nums = 0;
+        for (int64_t offset = 0; offset < data_size; ++row_nums) {
+            if (offset + int64_t(sizeof(uint32_t)) > data_size) {
+                throw std::invalid_argument("sparse row is truncated");
+            }
+            uint32_t length;
+            memcpy(&length, vectors + offset, sizeof(uint32_t));
+            offset += sizeof(uint32_t) + length;
+            if (offset > data_size) {
+                throw std::invalid_argument("sparse row is truncated");
+            }
+        }
+        auto ds = milvus::knowhere::GenDataset(row_nums, max_nnz, vectors);
+        cIndex->BuildWithoutIds(ds);
+        status.error_code = Success;
+        status.error_msg = "";
+    } catch (std::exception& e) {
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+    }
+    return status;
+}
+
 CStatus
 SerializeToSlicedBuffer(CIndex index, CBinary* c_binary) {
     auto status = CStatus();
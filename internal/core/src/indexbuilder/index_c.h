@@ -49,6 +49,10 @@ BuildFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float*
 CStatus
 BuildBinaryVecIndexWithoutIds(CIndex index, int64_t data_size, const uint8_t* vectors);
 
+// vectors holds the sparse rows one after another, each a uint32 length followed by its (index, value) pairs
+CStatus
+BuildSparseFloatVecIndexWithoutIds(CIndex index, int64_t data_size, const uint8_t* vectors);
+
 CStatus
 SerializeToSlicedBuffer(CIndex index, CBinary* c_binary);
 
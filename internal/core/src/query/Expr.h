@@ -62,6 +62,8 @@ struct LogicalBinaryExpr : BinaryExprBase {
 struct TermExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
+    // the keys walked into the documents if the field is a JSON
+    std::vector<std::string> nested_path_;
 
  protected:
     // prevent accidential instantiation
@@ -92,6 +94,8 @@ static const std::map<std::string, OpType> mapping_ = {
 struct UnaryRangeExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
+    // the keys walked into the documents if the field is a JSON
+    std::vector<std::string> nested_path_;
     OpType op_type_;
 
  protected:
@@ -106,6 +110,8 @@ struct UnaryRangeExpr : Expr {
 struct BinaryRangeExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
+    // the keys walked into the documents if the field is a JSON
+    std::vector<std::string> nested_path_;
     bool lower_inclusive_;
     bool upper_inclusive_;
 
@@ -129,4 +135,51 @@ struct CompareExpr : Expr {
     void
     accept(ExprVisitor&) override;
 };
+
+struct NullExpr : Expr {
+    enum class OpType {
+        Invalid = 0,
+        IsNull = 1,
+        IsNotNull = 2,
+    };
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+    OpType op_type_;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+struct ArrayContainsExpr : Expr {
+    enum class OpType {
+        Invalid = 0,
+        Contains = 1,
+        ContainsAny = 2,
+        ContainsAll = 3,
+    };
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+    DataType element_type_ = DataType::NONE;
+    OpType op_type_;
+
+ protected:
+    // prevent accidential instantiation
+    ArrayContainsExpr() = default;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+struct ArrayLengthExpr : Expr {
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+    OpType op_type_;
+    int64_t length_;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
 }  // namespace milvus::query
@@ -12,10 +12,14 @@
 #pragma once
 #include "Expr.h"
 #include <tuple>
+#include <variant>
 #include <vector>
 #include <boost/container/vector.hpp>
 
 namespace milvus::query {
+// a literal compared with the values under the keys of a JSON field, which may be of any of these types
+using JSONValue = std::variant<bool, int64_t, double, std::string>;
+
 template <typename T>
 struct TermExprImpl : TermExpr {
     boost::container::vector<T> terms_;
@@ -31,4 +35,10 @@ struct BinaryRangeExprImpl : BinaryRangeExpr {
     T lower_value_;
     T upper_value_;
 };
+
+// the integer elements are compared as int64_t, the floating elements as double
+template <typename T>
+struct ArrayContainsExprImpl : ArrayContainsExpr {
+    std::vector<T> elements_;
+};
 }  // namespace milvus::query
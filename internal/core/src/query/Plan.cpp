@@ -229,7 +229,8 @@ Parser::ParseVecNode(const Json& out_body) {
     auto vec_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         auto& field_meta = schema.operator[](field_name);
         auto data_type = field_meta.get_data_type();
-        if (data_type == DataType::VECTOR_FLOAT) {
+        if (data_type == DataType::VECTOR_FLOAT || data_type == DataType::VECTOR_FLOAT16 ||
+            data_type == DataType::VECTOR_BFLOAT16) {
             return std::make_unique<FloatVectorANNS>();
         } else {
             return std::make_unique<BinaryVectorANNS>();
@@ -368,9 +369,22 @@ ParsePlaceholderGroup(const Plan* plan, const std::string& blob) {
         element.num_of_queries_ = info.values_size();
         AssertInfo(element.num_of_queries_, "must have queries");
         Assert(element.num_of_queries_ > 0);
+        auto& target = element.blob_;
+        if (field_meta.is_variable_length()) {
+            // the queries of a variable-length field have no fixed size, each of them is laid out as the uint32
+            // length followed by the bytes
+            element.line_sizeof_ = 0;
+            for (auto& line : info.values()) {
+                uint32_t length = line.size();
+                auto length_data = reinterpret_cast<const char*>(&length);
+                target.insert(target.end(), length_data, length_data + sizeof(length));
+                target.insert(target.end(), line.begin(), line.end());
+            }
+            result->emplace_back(std::move(element));
+            continue;
+        }
         element.line_sizeof_ = info.values().Get(0).size();
         Assert(field_meta.get_sizeof() == element.line_sizeof_);
-        auto& target = element.blob_;
         target.reserve(element.line_sizeof_ * element.num_of_queries_);
         for (auto& line : info.values()) {
             Assert(element.line_sizeof_ == line.size());
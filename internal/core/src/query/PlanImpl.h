@@ -64,6 +64,7 @@ struct Placeholder {
     // milvus::proto::service::PlaceholderGroup group_;
     std::string tag_;
     int64_t num_of_queries_;
+    // 0 for the queries of a variable-length field, which are laid out as their uint32 lengths followed by the bytes
     int64_t line_sizeof_;
     aligned_vector<char> blob_;
 
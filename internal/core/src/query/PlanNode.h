@@ -62,6 +62,10 @@ struct RetrievePlanNode : PlanNode {
     accept(PlanNodeVisitor&) override;
 
     ExprPtr predicate_;
+    // the number of rows kept in the segment, ordered by order_by_ or by the primary key, 0 means no limit
+    int64_t limit_ = 0;
+    std::optional<FieldOffset> order_by_;
+    bool descending_ = false;
 };
 
 }  // namespace milvus::query
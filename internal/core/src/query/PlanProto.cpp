@@ -18,17 +18,43 @@
 namespace milvus::query {
 namespace planpb = milvus::proto::plan;
 
+// the literal compared with the values of a JSON field keeps the type it is written in
+static JSONValue
+ExtractJSONValue(const planpb::GenericValue& value_proto) {
+    switch (value_proto.val_case()) {
+        case planpb::GenericValue::kBoolVal: {
+            return value_proto.bool_val();
+        }
+        case planpb::GenericValue::kInt64Val: {
+            return value_proto.int64_val();
+        }
+        case planpb::GenericValue::kFloatVal: {
+            return value_proto.float_val();
+        }
+        case planpb::GenericValue::kStringVal: {
+            return value_proto.string_val();
+        }
+        default: {
+            PanicInfo("unsupported value of JSON field");
+        }
+    }
+}
+
 template <typename T>
 std::unique_ptr<TermExprImpl<T>>
 ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::TermExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string> || std::is_same_v<T, JSONValue>);
     auto result = std::make_unique<TermExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
+    auto& nested_path = expr_proto.column_info().nested_path();
+    result->nested_path_.assign(nested_path.begin(), nested_path.end());
     auto size = expr_proto.values_size();
     for (int i = 0; i < size; ++i) {
         auto& value_proto = expr_proto.values(i);
-        if constexpr (std::is_same_v<T, bool>) {
+        if constexpr (std::is_same_v<T, JSONValue>) {
+            result->terms_.emplace_back(ExtractJSONValue(value_proto));
+        } else if constexpr (std::is_same_v<T, bool>) {
             Assert(value_proto.val_case() == planpb::GenericValue::kBoolVal);
             result->terms_.emplace_back(static_cast<T>(value_proto.bool_val()));
         } else if constexpr (std::is_integral_v<T>) {
@@ -37,6 +63,9 @@ ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::
         } else if constexpr (std::is_floating_point_v<T>) {
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             result->terms_.emplace_back(static_cast<T>(value_proto.float_val()));
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            result->terms_.emplace_back(value_proto.string_val());
         } else {
             static_assert(always_false<T>);
         }
@@ -47,14 +76,18 @@ ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::
 template <typename T>
 std::unique_ptr<UnaryRangeExprImpl<T>>
 ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const planpb::UnaryRangeExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string> || std::is_same_v<T, JSONValue>);
     auto result = std::make_unique<UnaryRangeExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
+    auto& nested_path = expr_proto.column_info().nested_path();
+    result->nested_path_.assign(nested_path.begin(), nested_path.end());
     result->op_type_ = static_cast<OpType>(expr_proto.op());
 
     auto setValue = [&](T& v, const auto& value_proto) {
-        if constexpr (std::is_same_v<T, bool>) {
+        if constexpr (std::is_same_v<T, JSONValue>) {
+            v = ExtractJSONValue(value_proto);
+        } else if constexpr (std::is_same_v<T, bool>) {
             Assert(value_proto.val_case() == planpb::GenericValue::kBoolVal);
             v = static_cast<T>(value_proto.bool_val());
         } else if constexpr (std::is_integral_v<T>) {
@@ -63,6 +96,9 @@ ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const pl
         } else if constexpr (std::is_floating_point_v<T>) {
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             v = static_cast<T>(value_proto.float_val());
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            v = value_proto.string_val();
         } else {
             static_assert(always_false<T>);
         }
@@ -74,13 +110,17 @@ ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const pl
 template <typename T>
 std::unique_ptr<BinaryRangeExprImpl<T>>
 ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const planpb::BinaryRangeExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string> || std::is_same_v<T, JSONValue>);
     auto result = std::make_unique<BinaryRangeExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
+    auto& nested_path = expr_proto.column_info().nested_path();
+    result->nested_path_.assign(nested_path.begin(), nested_path.end());
 
     auto setValue = [&](T& v, const auto& value_proto) {
-        if constexpr (std::is_same_v<T, bool>) {
+        if constexpr (std::is_same_v<T, JSONValue>) {
+            v = ExtractJSONValue(value_proto);
+        } else if constexpr (std::is_same_v<T, bool>) {
             Assert(value_proto.val_case() == planpb::GenericValue::kBoolVal);
             v = static_cast<T>(value_proto.bool_val());
         } else if constexpr (std::is_integral_v<T>) {
@@ -89,6 +129,9 @@ ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const p
         } else if constexpr (std::is_floating_point_v<T>) {
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             v = static_cast<T>(value_proto.float_val());
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            v = value_proto.string_val();
         } else {
             static_assert(always_false<T>);
         }
@@ -146,6 +189,11 @@ ProtoParser::RetrievePlanNodeFromProto(const planpb::PlanNode& plan_node_proto)
 
     auto plan_node = [&]() -> std::unique_ptr<RetrievePlanNode> { return std::make_unique<RetrievePlanNode>(); }();
     plan_node->predicate_ = std::move(expr_opt);
+    plan_node->limit_ = plan_node_proto.limit();
+    if (plan_node_proto.order_by_field_id() != 0) {
+        plan_node->order_by_ = schema.get_offset(FieldId(plan_node_proto.order_by_field_id()));
+    }
+    plan_node->descending_ = plan_node_proto.descending();
     return plan_node;
 }
 
@@ -220,6 +268,12 @@ ProtoParser::ParseUnaryRangeExpr(const proto::plan::UnaryRangeExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractUnaryRangeExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::STRING: {
+                return ExtractUnaryRangeExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
+            case DataType::JSON: {
+                return ExtractUnaryRangeExprImpl<JSONValue>(field_offset, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -259,6 +313,12 @@ ProtoParser::ParseBinaryRangeExpr(const proto::plan::BinaryRangeExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractBinaryRangeExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::STRING: {
+                return ExtractBinaryRangeExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
+            case DataType::JSON: {
+                return ExtractBinaryRangeExprImpl<JSONValue>(field_offset, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -292,6 +352,95 @@ ProtoParser::ParseCompareExpr(const proto::plan::CompareExpr& expr_pb) {
     }();
 }
 
+ExprPtr
+ProtoParser::ParseNullExpr(const proto::plan::NullExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto field_offset = schema.get_offset(field_id);
+    auto data_type = schema[field_offset].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+
+    return [&]() -> ExprPtr {
+        auto result = std::make_unique<NullExpr>();
+        result->field_offset_ = field_offset;
+        result->data_type_ = data_type;
+        result->op_type_ = static_cast<NullExpr::OpType>(expr_pb.op());
+        return result;
+    }();
+}
+
+template <typename T>
+std::unique_ptr<ArrayContainsExprImpl<T>>
+ExtractArrayContainsExprImpl(FieldOffset field_offset,
+                             DataType data_type,
+                             DataType element_type,
+                             const planpb::ArrayContainsExpr& expr_proto) {
+    auto result = std::make_unique<ArrayContainsExprImpl<T>>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = data_type;
+    result->element_type_ = element_type;
+    result->op_type_ = static_cast<ArrayContainsExpr::OpType>(expr_proto.op());
+    for (auto& value_proto : expr_proto.elements()) {
+        if constexpr (std::is_same_v<T, bool>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kBoolVal);
+            result->elements_.emplace_back(value_proto.bool_val());
+        } else if constexpr (std::is_same_v<T, int64_t>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
+            result->elements_.emplace_back(value_proto.int64_val());
+        } else if constexpr (std::is_same_v<T, double>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
+            result->elements_.emplace_back(value_proto.float_val());
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            result->elements_.emplace_back(value_proto.string_val());
+        } else {
+            static_assert(always_false<T>);
+        }
+    }
+    return result;
+}
+
+ExprPtr
+ProtoParser::ParseArrayContainsExpr(const proto::plan::ArrayContainsExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto field_offset = schema.get_offset(field_id);
+    auto& field_meta = schema[field_offset];
+    auto data_type = field_meta.get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    auto element_type = field_meta.get_element_type();
+    Assert(element_type == static_cast<DataType>(column_info.element_type()));
+
+    return [&]() -> ExprPtr {
+        if (element_type == DataType::BOOL) {
+            return ExtractArrayContainsExprImpl<bool>(field_offset, data_type, element_type, expr_pb);
+        } else if (datatype_is_integer(element_type)) {
+            return ExtractArrayContainsExprImpl<int64_t>(field_offset, data_type, element_type, expr_pb);
+        } else if (datatype_is_floating(element_type)) {
+            return ExtractArrayContainsExprImpl<double>(field_offset, data_type, element_type, expr_pb);
+        } else if (datatype_is_string(element_type)) {
+            return ExtractArrayContainsExprImpl<std::string>(field_offset, data_type, element_type, expr_pb);
+        }
+        PanicInfo("unsupported element type");
+    }();
+}
+
+ExprPtr
+ProtoParser::ParseArrayLengthExpr(const proto::plan::ArrayLengthExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto field_offset = schema.get_offset(field_id);
+    auto data_type = schema[field_offset].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+
+    auto result = std::make_unique<ArrayLengthExpr>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = data_type;
+    result->op_type_ = static_cast<OpType>(expr_pb.op());
+    result->length_ = expr_pb.length();
+    return result;
+}
+
 ExprPtr
 ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
     auto& columnInfo = expr_pb.column_info();
@@ -324,6 +473,12 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractTermExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::STRING: {
+                return ExtractTermExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
+            case DataType::JSON: {
+                return ExtractTermExprImpl<JSONValue>(field_offset, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -377,6 +532,15 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kCompareExpr: {
             return ParseCompareExpr(expr_pb.compare_expr());
         }
+        case ppe::kNullExpr: {
+            return ParseNullExpr(expr_pb.null_expr());
+        }
+        case ppe::kArrayContainsExpr: {
+            return ParseArrayContainsExpr(expr_pb.array_contains_expr());
+        }
+        case ppe::kArrayLengthExpr: {
+            return ParseArrayLengthExpr(expr_pb.array_length_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
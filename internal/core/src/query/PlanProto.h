@@ -35,6 +35,15 @@ class ProtoParser {
     ExprPtr
     ParseCompareExpr(const proto::plan::CompareExpr& expr_pb);
 
+    ExprPtr
+    ParseNullExpr(const proto::plan::NullExpr& expr_pb);
+
+    ExprPtr
+    ParseArrayContainsExpr(const proto::plan::ArrayContainsExpr& expr_pb);
+
+    ExprPtr
+    ParseArrayLengthExpr(const proto::plan::ArrayLengthExpr& expr_pb);
+
     ExprPtr
     ParseTermExpr(const proto::plan::TermExpr& expr_pb);
 
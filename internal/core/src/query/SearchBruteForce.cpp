@@ -15,6 +15,7 @@
 #include <boost/dynamic_bitset.hpp>
 #include <queue>
 #include "SubSearchResult.h"
+#include "common/HalfFloat.h"
 
 #include <faiss/utils/distances.h>
 #include <faiss/utils/BinaryDistance.h>
@@ -111,6 +112,16 @@ FloatSearchBruteForce(const dataset::SearchDataset& dataset,
     }
 }
 
+SubSearchResult
+HalfFloatSearchBruteForce(const dataset::SearchDataset& dataset,
+                          const void* chunk_data_raw,
+                          int64_t size_per_chunk,
+                          const faiss::BitsetView& bitset,
+                          DataType data_type) {
+    auto chunk_data = half_float_to_float(data_type, chunk_data_raw, size_per_chunk * dataset.dim);
+    return FloatSearchBruteForce(dataset, chunk_data.data(), size_per_chunk, bitset);
+}
+
 SubSearchResult
 BinarySearchBruteForce(const dataset::SearchDataset& dataset,
                        const void* chunk_data_raw,
@@ -122,4 +133,81 @@ BinarySearchBruteForce(const dataset::SearchDataset& dataset,
     return BinarySearchBruteForceFast(dataset.metric_type, dataset.dim, chunk_data, size_per_chunk, dataset.topk,
                                       dataset.num_queries, dataset.round_decimal, query_data, bitset);
 }
+
+// inner product of two sparse rows of (index, value) pairs, the indices of both rows are strictly increasing
+static float
+sparse_inner_product(std::string_view x, std::string_view y) {
+    constexpr auto pair_sizeof = sizeof(uint32_t) + sizeof(float);
+    auto x_nnz = x.size() / pair_sizeof;
+    auto y_nnz = y.size() / pair_sizeof;
+
+    float sum = 0;
+    size_t i = 0, j = 0;
+    while (i < x_nnz && j < y_nnz) {
+        uint32_t x_index, y_index;
+        memcpy(&x_index, x.data() + i * pair_sizeof, sizeof(uint32_t));
+        memcpy(&y_index, y.data() + j * pair_sizeof, sizeof(uint32_t));
+        if (x_index < y_index) {
+            ++i;
+        } else if (x_index > y_index) {
+            ++j;
+        } else {
+            float x_value, y_value;
+            memcpy(&x_value, x.data() + i * pair_sizeof + sizeof(uint32_t), sizeof(float));
+            memcpy(&y_value, y.data() + j * pair_sizeof + sizeof(uint32_t), sizeof(float));
+            sum += x_value * y_value;
+            ++i;
+            ++j;
+        }
+    }
+    return sum;
+}
+
+SubSearchResult
+SparseFloatSearchBruteForce(const dataset::SearchDataset& dataset,
+                            const std::string_view* rows,
+                            int64_t row_count,
+                            const faiss::BitsetView& bitset) {
+    auto metric_type = dataset.metric_type;
+    AssertInfo(metric_type == MetricType::METRIC_INNER_PRODUCT,
+               "[SparseFloatSearchBruteForce]Sparse float vector only supports IP");
+    auto num_queries = dataset.num_queries;
+    auto topk = dataset.topk;
+    SubSearchResult sub_qr(num_queries, topk, metric_type, dataset.round_decimal);
+    auto query_data = reinterpret_cast<const char*>(dataset.query_data);
+
+    // keep the topk largest scores of each query in a min heap
+    using Candidate = std::pair<float, idx_t>;
+    auto greater = [](const Candidate& a, const Candidate& b) { return a.first > b.first; };
+    for (int64_t q = 0; q < num_queries; ++q) {
+        uint32_t query_length;
+        memcpy(&query_length, query_data, sizeof(uint32_t));
+        std::string_view query(query_data + sizeof(uint32_t), query_length);
+        query_data += sizeof(uint32_t) + query_length;
+
+        std::priority_queue<Candidate, std::vector<Candidate>, decltype(greater)> heap(greater);
+        for (int64_t i = 0; i < row_count; ++i) {
+            if (!bitset.empty() && bitset.test(i)) {
+                continue;
+            }
+            auto score = sparse_inner_product(query, rows[i]);
+            if (heap.size() < topk) {
+                heap.emplace(score, i);
+            } else if (score > heap.top().first) {
+                heap.pop();
+                heap.emplace(score, i);
+            }
+        }
+
+        auto values = sub_qr.get_values() + q * topk;
+        auto labels = sub_qr.get_labels() + q * topk;
+        for (auto j = static_cast<int64_t>(heap.size()) - 1; j >= 0; --j) {
+            values[j] = heap.top().first;
+            labels[j] = heap.top().second;
+            heap.pop();
+        }
+    }
+    sub_qr.round_values();
+    return sub_qr;
+}
 }  // namespace milvus::query
@@ -10,6 +10,8 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #pragma once
+
+#include <string_view>
 #include <faiss/utils/BinaryDistance.h>
 #include "segcore/ConcurrentVector.h"
 #include "common/Schema.h"
@@ -30,4 +32,20 @@ FloatSearchBruteForce(const dataset::SearchDataset& dataset,
                       int64_t size_per_chunk,
                       const faiss::BitsetView& bitset);
 
+// query data is float, chunk rows are float16 or bfloat16 of data_type, which are converted to float
+SubSearchResult
+HalfFloatSearchBruteForce(const dataset::SearchDataset& dataset,
+                          const void* chunk_data_raw,
+                          int64_t size_per_chunk,
+                          const faiss::BitsetView& bitset,
+                          DataType data_type);
+
+// queries are laid out one after another, each a uint32 length followed by its (index, value) pairs, rows are the
+// (index, value) pairs of the sparse rows, only inner product is supported
+SubSearchResult
+SparseFloatSearchBruteForce(const dataset::SearchDataset& dataset,
+                            const std::string_view* rows,
+                            int64_t row_count,
+                            const faiss::BitsetView& bitset);
+
 }  // namespace milvus::query
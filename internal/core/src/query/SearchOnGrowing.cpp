@@ -18,6 +18,7 @@
 #include "utils/Utils.h"
 #include "query/SearchBruteForce.h"
 #include "query/SearchOnIndex.h"
+#include "common/HalfFloat.h"
 
 namespace milvus::query {
 Status
@@ -172,6 +173,117 @@ BinarySearch(const segcore::SegmentGrowingImpl& segment,
     return Status::OK();
 }
 
+// float16 and bfloat16 vectors are searched by brute force on their float values
+template <typename VectorType>
+Status
+HalfFloatSearch(const segcore::SegmentGrowingImpl& segment,
+                const query::SearchInfo& info,
+                const void* query_data,
+                int64_t num_queries,
+                int64_t ins_barrier,
+                const faiss::BitsetView& bitset,
+                SearchResult& results) {
+    auto& schema = segment.get_schema();
+    auto& record = segment.get_insert_record();
+    auto metric_type = info.metric_type_;
+
+    auto vecfield_offset = info.field_offset_;
+    auto& field = schema[vecfield_offset];
+    auto data_type = field.get_data_type();
+
+    AssertInfo(data_type == VectorType::metric_type, "[HalfFloatSearch]Field data type mismatch");
+    auto dim = field.get_dim();
+    auto topk = info.topk_;
+    auto round_decimal = info.round_decimal_;
+    auto float_query = half_float_to_float(data_type, query_data, num_queries * dim);
+    query::dataset::SearchDataset search_dataset{metric_type,   num_queries, topk,
+                                                 round_decimal, dim,         float_query.data()};
+
+    auto vec_ptr = record.get_field_data<VectorType>(vecfield_offset);
+
+    auto vec_size_per_chunk = vec_ptr->get_size_per_chunk();
+    auto max_chunk = upper_div(ins_barrier, vec_size_per_chunk);
+    SubSearchResult final_result(num_queries, topk, metric_type, round_decimal);
+    for (int chunk_id = 0; chunk_id < max_chunk; ++chunk_id) {
+        auto& chunk = vec_ptr->get_chunk(chunk_id);
+        auto element_begin = chunk_id * vec_size_per_chunk;
+        auto element_end = std::min(ins_barrier, (chunk_id + 1) * vec_size_per_chunk);
+        auto nsize = element_end - element_begin;
+
+        auto sub_view = BitsetSubView(bitset, element_begin, nsize);
+        auto sub_result = HalfFloatSearchBruteForce(search_dataset, chunk.data(), nsize, sub_view, data_type);
+
+        // convert chunk uid to segment uid
+        for (auto& x : sub_result.mutable_labels()) {
+            if (x != -1) {
+                x += chunk_id * vec_size_per_chunk;
+            }
+        }
+        final_result.merge(sub_result);
+    }
+
+    results.result_distances_ = std::move(final_result.mutable_values());
+    results.internal_seg_offsets_ = std::move(final_result.mutable_labels());
+    results.topk_ = topk;
+    results.num_queries_ = num_queries;
+
+    return Status::OK();
+}
+
+Status
+SparseFloatSearch(const segcore::SegmentGrowingImpl& segment,
+                  const query::SearchInfo& info,
+                  const uint8_t* query_data,
+                  int64_t num_queries,
+                  int64_t ins_barrier,
+                  const faiss::BitsetView& bitset,
+                  SearchResult& results) {
+    auto& schema = segment.get_schema();
+    auto& record = segment.get_insert_record();
+    auto metric_type = info.metric_type_;
+
+    auto vecfield_offset = info.field_offset_;
+    auto& field = schema[vecfield_offset];
+
+    AssertInfo(field.get_data_type() == DataType::VECTOR_SPARSE_FLOAT,
+               "[SparseFloatSearch]Field data type isn't VECTOR_SPARSE_FLOAT");
+    auto max_nnz = field.get_dim();
+    auto topk = info.topk_;
+    auto round_decimal = info.round_decimal_;
+    query::dataset::SearchDataset search_dataset{metric_type, num_queries, topk, round_decimal, max_nnz, query_data};
+
+    auto vec_ptr = record.get_field_data<SparseFloatVector>(vecfield_offset);
+
+    // no small index for sparse float vector, brute force on all the chunks
+    auto vec_size_per_chunk = vec_ptr->get_size_per_chunk();
+    auto max_chunk = upper_div(ins_barrier, vec_size_per_chunk);
+    SubSearchResult final_result(num_queries, topk, metric_type, round_decimal);
+    for (int chunk_id = 0; chunk_id < max_chunk; ++chunk_id) {
+        auto rows = vec_ptr->get_views(chunk_id);
+        auto element_begin = chunk_id * vec_size_per_chunk;
+        auto element_end = std::min(ins_barrier, (chunk_id + 1) * vec_size_per_chunk);
+        auto nsize = element_end - element_begin;
+
+        auto sub_view = BitsetSubView(bitset, element_begin, nsize);
+        auto sub_result = SparseFloatSearchBruteForce(search_dataset, rows.data(), nsize, sub_view);
+
+        // convert chunk uid to segment uid
+        for (auto& x : sub_result.mutable_labels()) {
+            if (x != -1) {
+                x += chunk_id * vec_size_per_chunk;
+            }
+        }
+        final_result.merge(sub_result);
+    }
+
+    results.result_distances_ = std::move(final_result.mutable_values());
+    results.internal_seg_offsets_ = std::move(final_result.mutable_labels());
+    results.topk_ = topk;
+    results.num_queries_ = num_queries;
+
+    return Status::OK();
+}
+
 // TODO: refactor and merge this into one
 void
 SearchOnGrowing(const segcore::SegmentGrowingImpl& segment,
@@ -187,6 +299,13 @@ SearchOnGrowing(const segcore::SegmentGrowingImpl& segment,
     if (data_type == DataType::VECTOR_FLOAT) {
         auto typed_data = reinterpret_cast<const float*>(query_data);
         FloatSearch(segment, info, typed_data, num_queries, ins_barrier, bitset, results);
+    } else if (data_type == DataType::VECTOR_FLOAT16) {
+        HalfFloatSearch<Float16Vector>(segment, info, query_data, num_queries, ins_barrier, bitset, results);
+    } else if (data_type == DataType::VECTOR_BFLOAT16) {
+        HalfFloatSearch<BFloat16Vector>(segment, info, query_data, num_queries, ins_barrier, bitset, results);
+    } else if (data_type == DataType::VECTOR_SPARSE_FLOAT) {
+        auto typed_data = reinterpret_cast<const uint8_t*>(query_data);
+        SparseFloatSearch(segment, info, typed_data, num_queries, ins_barrier, bitset, results);
     } else {
         auto typed_data = reinterpret_cast<const uint8_t*>(query_data);
         BinarySearch(segment, info, typed_data, num_queries, ins_barrier, bitset, results);
@@ -21,6 +21,7 @@
 #include "knowhere/index/vector_index/adapter/VectorAdapter.h"
 #include <boost_ext/dynamic_bitset_ext.hpp>
 #include <cmath>
+#include "common/HalfFloat.h"
 
 namespace milvus::query {
 
@@ -80,6 +81,13 @@ SearchOnSealed(const Schema& schema,
     AssertInfo(field_indexing->metric_type_ == search_info.metric_type_,
                "Metric type of field index isn't the same with search info");
 
+    // the indexes of float16 and bfloat16 vectors are built on their float values
+    std::vector<float> float_query;
+    if (field.get_data_type() == DataType::VECTOR_FLOAT16 || field.get_data_type() == DataType::VECTOR_BFLOAT16) {
+        float_query = half_float_to_float(field.get_data_type(), query_data, num_queries * dim);
+        query_data = float_query.data();
+    }
+
     auto final = [&] {
         auto ds = knowhere::GenDataset(num_queries, dim, query_data);
 
@@ -42,6 +42,15 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(ArrayContainsExpr& expr) override;
+
+    void
+    visit(ArrayLengthExpr& expr) override;
+
  public:
     using RetType = boost::dynamic_bitset<>;
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment, int64_t row_count, Timestamp timestamp)
@@ -74,10 +83,48 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType;
 
+    template <typename ElementFunc>
+    auto
+    ExecStringRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
+    auto
+    ExecStringUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecStringBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecStringTermVisitorImpl(TermExpr& expr_raw) -> RetType;
+
     template <typename CmpFunc>
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> RetType;
 
+    auto
+    ExecValidVisitorImpl(FieldOffset field_offset) -> RetType;
+
+    template <typename ValueFunc>
+    auto
+    ExecArrayVisitorImpl(FieldOffset field_offset, ValueFunc value_func) -> RetType;
+
+    template <typename T>
+    auto
+    ExecArrayContainsVisitorImpl(ArrayContainsExpr& expr_raw) -> RetType;
+
+    template <typename ValueFunc>
+    auto
+    ExecJSONVisitorImpl(FieldOffset field_offset, const std::vector<std::string>& nested_path, ValueFunc value_func)
+        -> RetType;
+
+    auto
+    ExecJSONUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecJSONBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecJSONTermVisitorImpl(TermExpr& expr_raw) -> RetType;
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     int64_t row_count_;
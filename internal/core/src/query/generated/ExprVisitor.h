@@ -36,5 +36,14 @@ class ExprVisitor {
 
     virtual void
     visit(CompareExpr&) = 0;
+
+    virtual void
+    visit(NullExpr&) = 0;
+
+    virtual void
+    visit(ArrayContainsExpr&) = 0;
+
+    virtual void
+    visit(ArrayLengthExpr&) = 0;
 };
 }  // namespace milvus::query
@@ -37,6 +37,15 @@ class ShowExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(ArrayContainsExpr& expr) override;
+
+    void
+    visit(ArrayLengthExpr& expr) override;
+
  public:
     using RetType = Json;
 
@@ -42,6 +42,15 @@ class VerifyExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(ArrayContainsExpr& expr) override;
+
+    void
+    visit(ArrayLengthExpr& expr) override;
+
  public:
 };
 }  // namespace milvus::query
@@ -9,11 +9,16 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
+#include <cstring>
+#include <limits>
 #include <optional>
+#include <string_view>
 #include <boost/dynamic_bitset.hpp>
 #include <boost/variant.hpp>
 #include <utility>
 #include <deque>
+#include "utils/Json.h"
 #include "segcore/SegmentGrowingImpl.h"
 #include "query/ExprImpl.h"
 #include "query/generated/ExecExprVisitor.h"
@@ -56,10 +61,48 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType;
 
+    template <typename ElementFunc>
+    auto
+    ExecStringRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
+    auto
+    ExecStringUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecStringBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecStringTermVisitorImpl(TermExpr& expr_raw) -> RetType;
+
     template <typename CmpFunc>
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> RetType;
 
+    auto
+    ExecValidVisitorImpl(FieldOffset field_offset) -> RetType;
+
+    template <typename ValueFunc>
+    auto
+    ExecArrayVisitorImpl(FieldOffset field_offset, ValueFunc value_func) -> RetType;
+
+    template <typename T>
+    auto
+    ExecArrayContainsVisitorImpl(ArrayContainsExpr& expr_raw) -> RetType;
+
+    template <typename ValueFunc>
+    auto
+    ExecJSONVisitorImpl(FieldOffset field_offset, const std::vector<std::string>& nested_path, ValueFunc value_func)
+        -> RetType;
+
+    auto
+    ExecJSONUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecJSONBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecJSONTermVisitorImpl(TermExpr& expr_raw) -> RetType;
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     int64_t row_count_;
@@ -175,6 +218,73 @@ ExecExprVisitor::ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc index_
     return final_result;
 }
 
+// no scalar index is built for varchar, the raw values are always scanned
+template <typename ElementFunc>
+auto
+ExecExprVisitor::ExecStringRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<boost::dynamic_bitset<>> results;
+    for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        boost::dynamic_bitset<> result(this_size);
+        auto views = segment_.chunk_views(field_offset, chunk_id);
+        for (int index = 0; index < this_size; ++index) {
+            result[index] = element_func(views[index]);
+        }
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Final result size not equal to row count");
+    return final_result;
+}
+
+auto
+ExecExprVisitor::ExecStringUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<UnaryRangeExprImpl<std::string>&>(expr_raw);
+    auto val = std::string_view(expr.value_);
+    switch (expr.op_type_) {
+        case OpType::Equal: {
+            return ExecStringRangeVisitorImpl(expr.field_offset_, [val](std::string_view x) { return x == val; });
+        }
+        case OpType::NotEqual: {
+            return ExecStringRangeVisitorImpl(expr.field_offset_, [val](std::string_view x) { return x != val; });
+        }
+        case OpType::GreaterEqual: {
+            return ExecStringRangeVisitorImpl(expr.field_offset_, [val](std::string_view x) { return x >= val; });
+        }
+        case OpType::GreaterThan: {
+            return ExecStringRangeVisitorImpl(expr.field_offset_, [val](std::string_view x) { return x > val; });
+        }
+        case OpType::LessEqual: {
+            return ExecStringRangeVisitorImpl(expr.field_offset_, [val](std::string_view x) { return x <= val; });
+        }
+        case OpType::LessThan: {
+            return ExecStringRangeVisitorImpl(expr.field_offset_, [val](std::string_view x) { return x < val; });
+        }
+        default: {
+            PanicInfo("unsupported range node");
+        }
+    }
+}
+
+auto
+ExecExprVisitor::ExecStringBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<BinaryRangeExprImpl<std::string>&>(expr_raw);
+    bool lower_inclusive = expr.lower_inclusive_;
+    bool upper_inclusive = expr.upper_inclusive_;
+    auto val1 = std::string_view(expr.lower_value_);
+    auto val2 = std::string_view(expr.upper_value_);
+    if (val1 > val2 || (val1 == val2 && !(lower_inclusive && upper_inclusive))) {
+        RetType res(row_count_, false);
+        return res;
+    }
+    auto elem_func = [=](std::string_view x) {
+        return (lower_inclusive ? val1 <= x : val1 < x) && (upper_inclusive ? x <= val2 : x < val2);
+    };
+    return ExecStringRangeVisitorImpl(expr.field_offset_, elem_func);
+}
+
 #pragma clang diagnostic push
 #pragma ide diagnostic ignored "Simplify"
 template <typename T>
@@ -292,10 +402,22 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
             res = ExecUnaryRangeVisitorDispatcher<double>(expr);
             break;
         }
+        case DataType::STRING: {
+            res = ExecStringUnaryRangeVisitorDispatcher(expr);
+            break;
+        }
+        case DataType::JSON: {
+            res = ExecJSONUnaryRangeVisitorDispatcher(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    if (field_meta.is_nullable()) {
+        // a null value never satisfies the predicate
+        res &= ExecValidVisitorImpl(expr.field_offset_);
+    }
     ret_ = std::move(res);
 }
 
@@ -334,10 +456,22 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
             res = ExecBinaryRangeVisitorDispatcher<double>(expr);
             break;
         }
+        case DataType::STRING: {
+            res = ExecStringBinaryRangeVisitorDispatcher(expr);
+            break;
+        }
+        case DataType::JSON: {
+            res = ExecJSONBinaryRangeVisitorDispatcher(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    if (field_meta.is_nullable()) {
+        // a null value never satisfies the predicate
+        res &= ExecValidVisitorImpl(expr.field_offset_);
+    }
     ret_ = std::move(res);
 }
 
@@ -454,6 +588,58 @@ ExecExprVisitor::visit(CompareExpr& expr) {
         }
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    if (left_field_meta.is_nullable()) {
+        res &= ExecValidVisitorImpl(expr.left_field_offset_);
+    }
+    if (right_field_meta.is_nullable()) {
+        res &= ExecValidVisitorImpl(expr.right_field_offset_);
+    }
+    ret_ = std::move(res);
+}
+
+auto
+ExecExprVisitor::ExecValidVisitorImpl(FieldOffset field_offset) -> RetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<RetType> bitsets;
+    for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto chunk = segment_.chunk_valid_data(field_offset, chunk_id);
+        auto size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        boost::dynamic_bitset<> bitset(size);
+        for (int i = 0; i < size; ++i) {
+            bitset[i] = chunk[i];
+        }
+        bitsets.emplace_back(std::move(bitset));
+    }
+    auto final_result = Assemble(bitsets);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    return final_result;
+}
+
+void
+ExecExprVisitor::visit(NullExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    RetType res;
+    if (field_meta.is_nullable()) {
+        res = ExecValidVisitorImpl(expr.field_offset_);
+    } else {
+        res = RetType(row_count_);
+        res.set();
+    }
+    switch (expr.op_type_) {
+        case NullExpr::OpType::IsNotNull: {
+            break;
+        }
+        case NullExpr::OpType::IsNull: {
+            res.flip();
+            break;
+        }
+        default: {
+            PanicInfo("unsupported optype");
+        }
+    }
     ret_ = std::move(res);
 }
 
@@ -485,6 +671,15 @@ ExecExprVisitor::ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType {
     return final_result;
 }
 
+auto
+ExecExprVisitor::ExecStringTermVisitorImpl(TermExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<TermExprImpl<std::string>&>(expr_raw);
+    std::sort(expr.terms_.begin(), expr.terms_.end());
+    std::vector<std::string_view> terms(expr.terms_.begin(), expr.terms_.end());
+    auto elem_func = [&terms](std::string_view x) { return std::binary_search(terms.begin(), terms.end(), x); };
+    return ExecStringRangeVisitorImpl(expr.field_offset_, elem_func);
+}
+
 void
 ExecExprVisitor::visit(TermExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_offset_];
@@ -520,10 +715,376 @@ ExecExprVisitor::visit(TermExpr& expr) {
             res = ExecTermVisitorImpl<double>(expr);
             break;
         }
+        case DataType::STRING: {
+            res = ExecStringTermVisitorImpl(expr);
+            break;
+        }
+        case DataType::JSON: {
+            res = ExecJSONTermVisitorImpl(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    if (field_meta.is_nullable()) {
+        // a null value never satisfies the predicate
+        res &= ExecValidVisitorImpl(expr.field_offset_);
+    }
+    ret_ = std::move(res);
+}
+
+// an array is a variable-length value, the number of elements in uint32 followed by the elements, no scalar index is
+// built for it, the raw values are always scanned
+template <typename ValueFunc>
+auto
+ExecExprVisitor::ExecArrayVisitorImpl(FieldOffset field_offset, ValueFunc value_func) -> RetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<boost::dynamic_bitset<>> results;
+    for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        boost::dynamic_bitset<> result(this_size);
+        auto views = segment_.chunk_views(field_offset, chunk_id);
+        for (int index = 0; index < this_size; ++index) {
+            result[index] = value_func(views[index]);
+        }
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Final result size not equal to row count");
+    return final_result;
+}
+
+static uint32_t
+ArrayLength(std::string_view value) {
+    uint32_t length;
+    memcpy(&length, value.data(), sizeof(length));
+    return length;
+}
+
+// a varchar element of an array is the length in uint32 followed by the bytes
+static std::string_view
+VarCharElementToView(const char* element) {
+    uint32_t length;
+    memcpy(&length, element, sizeof(length));
+    return std::string_view(element + sizeof(length), length);
+}
+
+// read a fixed-width element of the array, the integer elements are widened to int64_t and the floating elements to
+// double
+template <typename T>
+static auto
+ArrayElementAt(const char* element, DataType element_type) {
+    if constexpr (std::is_same_v<T, bool>) {
+        bool v;
+        memcpy(&v, element, sizeof(v));
+        return v;
+    } else if constexpr (std::is_same_v<T, int64_t>) {
+        switch (element_type) {
+            case DataType::INT8: {
+                int8_t v;
+                memcpy(&v, element, sizeof(v));
+                return static_cast<int64_t>(v);
+            }
+            case DataType::INT16: {
+                int16_t v;
+                memcpy(&v, element, sizeof(v));
+                return static_cast<int64_t>(v);
+            }
+            case DataType::INT32: {
+                int32_t v;
+                memcpy(&v, element, sizeof(v));
+                return static_cast<int64_t>(v);
+            }
+            case DataType::INT64: {
+                int64_t v;
+                memcpy(&v, element, sizeof(v));
+                return v;
+            }
+            default: {
+                PanicInfo("unsupported element type");
+            }
+        }
+    } else {
+        static_assert(std::is_same_v<T, double>);
+        if (element_type == DataType::FLOAT) {
+            float v;
+            memcpy(&v, element, sizeof(v));
+            return static_cast<double>(v);
+        }
+        double v;
+        memcpy(&v, element, sizeof(v));
+        return v;
+    }
+}
+
+template <typename T>
+auto
+ExecExprVisitor::ExecArrayContainsVisitorImpl(ArrayContainsExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<ArrayContainsExprImpl<T>&>(expr_raw);
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    auto element_type = field_meta.get_element_type();
+    // the string elements take their own lengths
+    auto element_sizeof = datatype_is_string(element_type) ? 0 : field_meta.get_element_sizeof();
+    auto& elements = expr.elements_;
+    auto contains_all = expr.op_type_ == ArrayContainsExpr::OpType::ContainsAll;
+    auto value_func = [&](std::string_view array) {
+        auto length = ArrayLength(array);
+        auto in_array = [&](const T& value) {
+            auto element = array.data() + sizeof(uint32_t);
+            for (uint32_t i = 0; i < length; ++i) {
+                if constexpr (std::is_same_v<T, std::string>) {
+                    auto view = VarCharElementToView(element);
+                    if (view == value) {
+                        return true;
+                    }
+                    element += sizeof(uint32_t) + view.size();
+                } else {
+                    if (ArrayElementAt<T>(element, element_type) == value) {
+                        return true;
+                    }
+                    element += element_sizeof;
+                }
+            }
+            return false;
+        };
+        if (contains_all) {
+            return std::all_of(elements.begin(), elements.end(), in_array);
+        }
+        return std::any_of(elements.begin(), elements.end(), in_array);
+    };
+    return ExecArrayVisitorImpl(expr.field_offset_, value_func);
+}
+
+void
+ExecExprVisitor::visit(ArrayContainsExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    AssertInfo(expr.element_type_ == field_meta.get_element_type(),
+               "[ExecExprVisitor]ElementType of expr isn't field_meta element type");
+    RetType res;
+    if (expr.element_type_ == DataType::BOOL) {
+        res = ExecArrayContainsVisitorImpl<bool>(expr);
+    } else if (datatype_is_integer(expr.element_type_)) {
+        res = ExecArrayContainsVisitorImpl<int64_t>(expr);
+    } else if (datatype_is_floating(expr.element_type_)) {
+        res = ExecArrayContainsVisitorImpl<double>(expr);
+    } else if (datatype_is_string(expr.element_type_)) {
+        res = ExecArrayContainsVisitorImpl<std::string>(expr);
+    } else {
+        PanicInfo("unsupported");
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    if (field_meta.is_nullable()) {
+        // a null value never satisfies the predicate
+        res &= ExecValidVisitorImpl(expr.field_offset_);
+    }
+    ret_ = std::move(res);
+}
+
+// the value under the keys of a JSON document, an object is indexed by the key and an array by the key in decimal,
+// nullptr if there is no such value
+static const json*
+JSONValueAt(const json& doc, const std::vector<std::string>& nested_path) {
+    auto value = &doc;
+    for (auto& key : nested_path) {
+        if (value->is_object()) {
+            auto iter = value->find(key);
+            if (iter == value->end()) {
+                return nullptr;
+            }
+            value = &*iter;
+        } else if (value->is_array()) {
+            auto is_digit = [](char c) { return c >= '0' && c <= '9'; };
+            // an index with more digits is out of the range of any array
+            if (key.empty() || key.size() > 18 || !std::all_of(key.begin(), key.end(), is_digit)) {
+                return nullptr;
+            }
+            auto index = std::stoull(key);
+            if (index >= value->size()) {
+                return nullptr;
+            }
+            value = &(*value)[index];
+        } else {
+            return nullptr;
+        }
+    }
+    return value;
+}
+
+template <typename T>
+static int
+ThreeWayCompare(const T& a, const T& b) {
+    return a < b ? -1 : (b < a ? 1 : 0);
+}
+
+// compare a value of a JSON document with the literal, nullopt if they are not comparable. Integers and floats are
+// compared by their numeric values, strings can only be compared with strings and booleans with booleans, nulls,
+// objects and arrays are not comparable
+static std::optional<int>
+CompareJSONValue(const json& value, const JSONValue& literal) {
+    if (std::holds_alternative<bool>(literal)) {
+        if (!value.is_boolean()) {
+            return std::nullopt;
+        }
+        return ThreeWayCompare(value.get<bool>(), std::get<bool>(literal));
+    }
+    if (std::holds_alternative<std::string>(literal)) {
+        if (!value.is_string()) {
+            return std::nullopt;
+        }
+        return ThreeWayCompare(value.get_ref<const std::string&>(), std::get<std::string>(literal));
+    }
+    if (!value.is_number()) {
+        return std::nullopt;
+    }
+    if (value.is_number_float() || std::holds_alternative<double>(literal)) {
+        auto val = std::holds_alternative<double>(literal) ? std::get<double>(literal)
+                                                           : static_cast<double>(std::get<int64_t>(literal));
+        return ThreeWayCompare(value.get<double>(), val);
+    }
+    auto val = std::get<int64_t>(literal);
+    if (value.is_number_unsigned()) {
+        auto x = value.get<uint64_t>();
+        if (x > static_cast<uint64_t>(std::numeric_limits<int64_t>::max())) {
+            return 1;
+        }
+        return ThreeWayCompare(static_cast<int64_t>(x), val);
+    }
+    return ThreeWayCompare(value.get<int64_t>(), val);
+}
+
+// whether the result of a three-way comparison satisfies the operator
+static bool
+MatchOrdering(OpType op, int cmp) {
+    switch (op) {
+        case OpType::Equal: {
+            return cmp == 0;
+        }
+        case OpType::NotEqual: {
+            return cmp != 0;
+        }
+        case OpType::GreaterEqual: {
+            return cmp >= 0;
+        }
+        case OpType::GreaterThan: {
+            return cmp > 0;
+        }
+        case OpType::LessEqual: {
+            return cmp <= 0;
+        }
+        case OpType::LessThan: {
+            return cmp < 0;
+        }
+        default: {
+            PanicInfo("unsupported range node");
+        }
+    }
+}
+
+// a JSON document is stored as its serialized bytes, a null document is empty, no scalar index is built for it, the
+// raw values are always scanned. The documents which fail to parse or have no value under the keys never match
+template <typename ValueFunc>
+auto
+ExecExprVisitor::ExecJSONVisitorImpl(FieldOffset field_offset,
+                                     const std::vector<std::string>& nested_path,
+                                     ValueFunc value_func) -> RetType {
+    auto doc_func = [&](std::string_view doc) {
+        auto parsed = json::parse(doc.begin(), doc.end(), nullptr, false);
+        if (parsed.is_discarded()) {
+            return false;
+        }
+        auto value = JSONValueAt(parsed, nested_path);
+        return value != nullptr && value_func(*value);
+    };
+    return ExecArrayVisitorImpl(field_offset, doc_func);
+}
+
+auto
+ExecExprVisitor::ExecJSONUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<UnaryRangeExprImpl<JSONValue>&>(expr_raw);
+    auto op = expr.op_type_;
+    auto& val = expr.value_;
+    auto value_func = [op, &val](const json& x) {
+        auto cmp = CompareJSONValue(x, val);
+        return cmp.has_value() && MatchOrdering(op, cmp.value());
+    };
+    return ExecJSONVisitorImpl(expr.field_offset_, expr.nested_path_, value_func);
+}
+
+auto
+ExecExprVisitor::ExecJSONBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<BinaryRangeExprImpl<JSONValue>&>(expr_raw);
+    auto lower_op = expr.lower_inclusive_ ? OpType::GreaterEqual : OpType::GreaterThan;
+    auto upper_op = expr.upper_inclusive_ ? OpType::LessEqual : OpType::LessThan;
+    auto& val1 = expr.lower_value_;
+    auto& val2 = expr.upper_value_;
+    auto value_func = [=, &val1, &val2](const json& x) {
+        auto lower_cmp = CompareJSONValue(x, val1);
+        auto upper_cmp = CompareJSONValue(x, val2);
+        return lower_cmp.has_value() && upper_cmp.has_value() && MatchOrdering(lower_op, lower_cmp.value()) &&
+               MatchOrdering(upper_op, upper_cmp.value());
+    };
+    return ExecJSONVisitorImpl(expr.field_offset_, expr.nested_path_, value_func);
+}
+
+auto
+ExecExprVisitor::ExecJSONTermVisitorImpl(TermExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<TermExprImpl<JSONValue>&>(expr_raw);
+    auto& terms = expr.terms_;
+    auto value_func = [&terms](const json& x) {
+        return std::any_of(terms.begin(), terms.end(), [&x](const JSONValue& term) {
+            auto cmp = CompareJSONValue(x, term);
+            return cmp.has_value() && cmp.value() == 0;
+        });
+    };
+    return ExecJSONVisitorImpl(expr.field_offset_, expr.nested_path_, value_func);
+}
+
+void
+ExecExprVisitor::visit(ArrayLengthExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    auto field_offset = expr.field_offset_;
+    auto val = expr.length_;
+    RetType res;
+    switch (expr.op_type_) {
+        case OpType::Equal: {
+            res = ExecArrayVisitorImpl(field_offset, [val](std::string_view x) { return ArrayLength(x) == val; });
+            break;
+        }
+        case OpType::NotEqual: {
+            res = ExecArrayVisitorImpl(field_offset, [val](std::string_view x) { return ArrayLength(x) != val; });
+            break;
+        }
+        case OpType::GreaterEqual: {
+            res = ExecArrayVisitorImpl(field_offset, [val](std::string_view x) { return ArrayLength(x) >= val; });
+            break;
+        }
+        case OpType::GreaterThan: {
+            res = ExecArrayVisitorImpl(field_offset, [val](std::string_view x) { return ArrayLength(x) > val; });
+            break;
+        }
+        case OpType::LessEqual: {
+            res = ExecArrayVisitorImpl(field_offset, [val](std::string_view x) { return ArrayLength(x) <= val; });
+            break;
+        }
+        case OpType::LessThan: {
+            res = ExecArrayVisitorImpl(field_offset, [val](std::string_view x) { return ArrayLength(x) < val; });
+            break;
+        }
+        default: {
+            PanicInfo("unsupported range node");
+        }
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    if (field_meta.is_nullable()) {
+        // a null value never satisfies the predicate
+        res &= ExecValidVisitorImpl(expr.field_offset_);
+    }
     ret_ = std::move(res);
 }
 }  // namespace milvus::query
@@ -106,6 +106,7 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         bitset_holder.resize(active_count, true);
     }
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    segment->mask_with_expiration(bitset_holder, timestamp_);
 
     if (!bitset_holder.empty()) {
         bitset_holder.flip();
@@ -142,6 +143,13 @@ ExecPlanNodeVisitor::visit(RetrievePlanNode& node) {
 
     segment->mask_with_timestamps(bitset_holder, timestamp_);
 
+    if (segment->get_schema().get_ttl_seconds() > 0) {
+        if (bitset_holder.empty()) {
+            bitset_holder.resize(active_count, true);
+        }
+        segment->mask_with_expiration(bitset_holder, timestamp_);
+    }
+
     BitsetView view;
     if (!bitset_holder.empty()) {
         bitset_holder.flip();
@@ -99,12 +99,31 @@ ShowExprVisitor::visit(LogicalBinaryExpr& expr) {
     ret_ = this->combine(std::move(extra), expr);
 }
 
+// the literal compared with the values of a JSON field is shown as the value it holds
+template <typename T>
+static Json
+ValueToJson(const T& value) {
+    if constexpr (std::is_same_v<T, JSONValue>) {
+        return std::visit([](const auto& v) { return Json(v); }, value);
+    } else {
+        return Json(value);
+    }
+}
+
 template <typename T>
 static Json
 TermExtract(const TermExpr& expr_raw) {
     auto expr = dynamic_cast<const TermExprImpl<T>*>(&expr_raw);
     AssertInfo(expr, "[ShowExprVisitor]TermExpr cast to TermExprImpl failed");
-    return Json{expr->terms_};
+    if constexpr (std::is_same_v<T, JSONValue>) {
+        Json terms = Json::array();
+        for (auto& term : expr->terms_) {
+            terms.push_back(ValueToJson(term));
+        }
+        return Json{terms};
+    } else {
+        return Json{expr->terms_};
+    }
 }
 
 void
@@ -127,6 +146,10 @@ ShowExprVisitor::visit(TermExpr& expr) {
                 return TermExtract<double>(expr);
             case DataType::FLOAT:
                 return TermExtract<float>(expr);
+            case DataType::STRING:
+                return TermExtract<std::string>(expr);
+            case DataType::JSON:
+                return TermExtract<JSONValue>(expr);
             default:
                 PanicInfo("unsupported type");
         }
@@ -136,6 +159,9 @@ ShowExprVisitor::visit(TermExpr& expr) {
              {"field_offset", expr.field_offset_.get()},
              {"data_type", datatype_name(expr.data_type_)},
              {"terms", std::move(terms)}};
+    if (datatype_is_json(expr.data_type_)) {
+        res["nested_path"] = expr.nested_path_;
+    }
 
     ret_ = res;
 }
@@ -151,7 +177,10 @@ UnaryRangeExtract(const UnaryRangeExpr& expr_raw) {
              {"field_offset", expr->field_offset_.get()},
              {"data_type", datatype_name(expr->data_type_)},
              {"op", OpType_Name(static_cast<OpType>(expr->op_type_))},
-             {"value", expr->value_}};
+             {"value", ValueToJson(expr->value_)}};
+    if (datatype_is_json(expr->data_type_)) {
+        res["nested_path"] = expr->nested_path_;
+    }
     return res;
 }
 
@@ -181,6 +210,12 @@ ShowExprVisitor::visit(UnaryRangeExpr& expr) {
         case DataType::FLOAT:
             ret_ = UnaryRangeExtract<float>(expr);
             return;
+        case DataType::STRING:
+            ret_ = UnaryRangeExtract<std::string>(expr);
+            return;
+        case DataType::JSON:
+            ret_ = UnaryRangeExtract<JSONValue>(expr);
+            return;
         default:
             PanicInfo("unsupported type");
     }
@@ -198,8 +233,11 @@ BinaryRangeExtract(const BinaryRangeExpr& expr_raw) {
              {"data_type", datatype_name(expr->data_type_)},
              {"lower_inclusive", expr->lower_inclusive_},
              {"upper_inclusive", expr->upper_inclusive_},
-             {"lower_value", expr->lower_value_},
-             {"upper_value", expr->upper_value_}};
+             {"lower_value", ValueToJson(expr->lower_value_)},
+             {"upper_value", ValueToJson(expr->upper_value_)}};
+    if (datatype_is_json(expr->data_type_)) {
+        res["nested_path"] = expr->nested_path_;
+    }
     return res;
 }
 
@@ -229,6 +267,12 @@ ShowExprVisitor::visit(BinaryRangeExpr& expr) {
         case DataType::FLOAT:
             ret_ = BinaryRangeExtract<float>(expr);
             return;
+        case DataType::STRING:
+            ret_ = BinaryRangeExtract<std::string>(expr);
+            return;
+        case DataType::JSON:
+            ret_ = BinaryRangeExtract<JSONValue>(expr);
+            return;
         default:
             PanicInfo("unsupported type");
     }
@@ -248,4 +292,66 @@ ShowExprVisitor::visit(CompareExpr& expr) {
              {"op", OpType_Name(static_cast<OpType>(expr.op_type_))}};
     ret_ = res;
 }
+
+void
+ShowExprVisitor::visit(NullExpr& expr) {
+    using proto::plan::NullExpr_NullOp;
+    using proto::plan::NullExpr_NullOp_Name;
+    AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "Null"},
+             {"field_offset", expr.field_offset_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", NullExpr_NullOp_Name(static_cast<NullExpr_NullOp>(expr.op_type_))}};
+    ret_ = res;
+}
+
+template <typename T>
+static Json
+ArrayContainsExtract(const ArrayContainsExpr& expr_raw) {
+    auto expr = dynamic_cast<const ArrayContainsExprImpl<T>*>(&expr_raw);
+    AssertInfo(expr, "[ShowExprVisitor]ArrayContainsExpr cast to ArrayContainsExprImpl failed");
+    return Json{expr->elements_};
+}
+
+void
+ShowExprVisitor::visit(ArrayContainsExpr& expr) {
+    using proto::plan::ArrayContainsExpr_ContainsOp;
+    using proto::plan::ArrayContainsExpr_ContainsOp_Name;
+    AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+    auto elements = [&] {
+        if (expr.element_type_ == DataType::BOOL) {
+            return ArrayContainsExtract<bool>(expr);
+        } else if (datatype_is_integer(expr.element_type_)) {
+            return ArrayContainsExtract<int64_t>(expr);
+        } else if (datatype_is_floating(expr.element_type_)) {
+            return ArrayContainsExtract<double>(expr);
+        } else if (datatype_is_string(expr.element_type_)) {
+            return ArrayContainsExtract<std::string>(expr);
+        }
+        PanicInfo("unsupported type");
+    }();
+
+    Json res{{"expr_type", "ArrayContains"},
+             {"field_offset", expr.field_offset_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"element_type", datatype_name(expr.element_type_)},
+             {"op", ArrayContainsExpr_ContainsOp_Name(static_cast<ArrayContainsExpr_ContainsOp>(expr.op_type_))},
+             {"elements", elements}};
+    ret_ = res;
+}
+
+void
+ShowExprVisitor::visit(ArrayLengthExpr& expr) {
+    using proto::plan::OpType;
+    using proto::plan::OpType_Name;
+    AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "ArrayLength"},
+             {"field_offset", expr.field_offset_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", OpType_Name(static_cast<OpType>(expr.op_type_))},
+             {"length", expr.length_}};
+    ret_ = res;
+}
 }  // namespace milvus::query
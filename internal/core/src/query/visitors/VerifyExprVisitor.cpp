@@ -42,4 +42,19 @@ VerifyExprVisitor::visit(CompareExpr& expr) {
     // TODO
 }
 
+void
+VerifyExprVisitor::visit(NullExpr& expr) {
+    // TODO
+}
+
+void
+VerifyExprVisitor::visit(ArrayContainsExpr& expr) {
+    // TODO
+}
+
+void
+VerifyExprVisitor::visit(ArrayLengthExpr& expr) {
+    // TODO
+}
+
 }  // namespace milvus::query
@@ -13,15 +13,19 @@
 
 #include <atomic>
 #include <cassert>
+#include <cstring>
 #include <deque>
 #include <mutex>
 #include <shared_mutex>
+#include <string>
+#include <string_view>
 #include <utility>
 #include <vector>
 
 #include <boost/container/vector.hpp>
 #include <tbb/concurrent_vector.h>
 
+#include "common/FieldMeta.h"
 #include "common/Types.h"
 #include "common/Span.h"
 #include "exceptions/EasyAssert.h"
@@ -231,6 +235,74 @@ class ConcurrentVectorImpl : public VectorBase {
     ThreadSafeVector<Chunk> chunks_;
 };
 
+// the values of a variable-length field, each value keeps its own bytes, no padding
+class VariableLengthConcurrentVector : public VectorBase {
+ public:
+    using Chunk = FixedVector<std::string>;
+    VariableLengthConcurrentVector(VariableLengthConcurrentVector&&) = delete;
+    VariableLengthConcurrentVector(const VariableLengthConcurrentVector&) = delete;
+
+    VariableLengthConcurrentVector&
+    operator=(VariableLengthConcurrentVector&&) = delete;
+    VariableLengthConcurrentVector&
+    operator=(const VariableLengthConcurrentVector&) = delete;
+
+ public:
+    explicit VariableLengthConcurrentVector(int64_t size_per_chunk) : VectorBase(size_per_chunk) {
+    }
+
+    void
+    grow_to_at_least(int64_t element_count) override {
+        auto chunk_count = upper_div(element_count, size_per_chunk_);
+        chunks_.emplace_to_at_least(chunk_count, size_per_chunk_);
+    }
+
+    SpanBase
+    get_span_base(int64_t chunk_id) const override {
+        PanicInfo("the values of a variable-length field can not be viewed as a span");
+    }
+
+    // source holds element_count values back to back, each of them is the uint32 length followed by the bytes
+    void
+    set_data_raw(ssize_t element_offset, const void* source, ssize_t element_count) override {
+        if (element_count == 0) {
+            return;
+        }
+        this->grow_to_at_least(element_offset + element_count);
+        auto src = static_cast<const char*>(source);
+        for (ssize_t i = 0; i < element_count; ++i) {
+            uint32_t length;
+            memcpy(&length, src, sizeof(length));
+            src += sizeof(length);
+            auto element_index = element_offset + i;
+            chunks_[element_index / size_per_chunk_][element_index % size_per_chunk_].assign(src, length);
+            src += length;
+        }
+    }
+
+    // the views of the values in the chunk, they stay valid as long as the vector
+    std::vector<std::string_view>
+    get_views(int64_t chunk_id) const {
+        auto& chunk = chunks_[chunk_id];
+        return std::vector<std::string_view>(chunk.begin(), chunk.end());
+    }
+
+    std::string_view
+    view(ssize_t element_index) const {
+        auto chunk_id = element_index / size_per_chunk_;
+        auto chunk_offset = element_index % size_per_chunk_;
+        return chunks_[chunk_id][chunk_offset];
+    }
+
+    ssize_t
+    num_chunk() const {
+        return chunks_.size();
+    }
+
+ private:
+    ThreadSafeVector<Chunk> chunks_;
+};
+
 template <typename Type>
 class ConcurrentVector : public ConcurrentVectorImpl<Type, true> {
  public:
@@ -260,4 +332,51 @@ class ConcurrentVector<BinaryVector> : public ConcurrentVectorImpl<uint8_t, fals
     int64_t binary_dim_;
 };
 
+template <>
+class ConcurrentVector<Float16Vector> : public ConcurrentVectorImpl<uint16_t, false> {
+ public:
+    explicit ConcurrentVector(int64_t dim, int64_t size_per_chunk)
+        : ConcurrentVectorImpl<uint16_t, false>::ConcurrentVectorImpl(dim, size_per_chunk) {
+    }
+};
+
+template <>
+class ConcurrentVector<BFloat16Vector> : public ConcurrentVectorImpl<uint16_t, false> {
+ public:
+    explicit ConcurrentVector(int64_t dim, int64_t size_per_chunk)
+        : ConcurrentVectorImpl<uint16_t, false>::ConcurrentVectorImpl(dim, size_per_chunk) {
+    }
+};
+
+template <>
+class ConcurrentVector<std::string> : public VariableLengthConcurrentVector {
+ public:
+    explicit ConcurrentVector(int64_t size_per_chunk) : VariableLengthConcurrentVector(size_per_chunk) {
+    }
+};
+
+template <>
+class ConcurrentVector<SparseFloatVector> : public VariableLengthConcurrentVector {
+ public:
+    // the bytes of a sparse float vector are its pairs of uint32 index and float value
+    explicit ConcurrentVector(int64_t size_per_chunk) : VariableLengthConcurrentVector(size_per_chunk) {
+    }
+};
+
+template <>
+class ConcurrentVector<Array> : public VariableLengthConcurrentVector {
+ public:
+    // the bytes of an array are the number of elements in uint32 followed by the elements
+    explicit ConcurrentVector(int64_t size_per_chunk) : VariableLengthConcurrentVector(size_per_chunk) {
+    }
+};
+
+template <>
+class ConcurrentVector<JSON> : public VariableLengthConcurrentVector {
+ public:
+    // the bytes of a JSON are the serialized document, a null document has no bytes
+    explicit ConcurrentVector(int64_t size_per_chunk) : VariableLengthConcurrentVector(size_per_chunk) {
+    }
+};
+
 }  // namespace milvus::segcore
@@ -125,11 +125,21 @@ class IndexingRecord {
                 if (field.get_data_type() == DataType::VECTOR_BINARY) {
                     continue;
                 }
+                // sparse, float16 and bfloat16 vectors are searched by brute force on growing segments
+                if (field.get_data_type() == DataType::VECTOR_SPARSE_FLOAT ||
+                    field.get_data_type() == DataType::VECTOR_FLOAT16 ||
+                    field.get_data_type() == DataType::VECTOR_BFLOAT16) {
+                    continue;
+                }
                 // flat should be skipped
                 if (!field.get_metric_type().has_value()) {
                     continue;
                 }
             }
+            // no small index for varchar and array, the expressions scan the raw values
+            if (field.is_string() || field.is_array()) {
+                continue;
+            }
 
             field_indexings_.try_emplace(offset, CreateIndex(field, segcore_config_));
         }
@@ -16,6 +16,11 @@ namespace milvus::segcore {
 InsertRecord::InsertRecord(const Schema& schema, int64_t size_per_chunk)
     : uids_(size_per_chunk), timestamps_(size_per_chunk) {
     for (auto& field : schema) {
+        if (field.is_nullable()) {
+            valid_data_.emplace_back(std::make_unique<ConcurrentVector<bool>>(size_per_chunk));
+        } else {
+            valid_data_.emplace_back(nullptr);
+        }
         if (field.is_vector()) {
             if (field.get_data_type() == DataType::VECTOR_FLOAT) {
                 this->append_field_data<FloatVector>(field.get_dim(), size_per_chunk);
@@ -23,6 +28,15 @@ InsertRecord::InsertRecord(const Schema& schema, int64_t size_per_chunk)
             } else if (field.get_data_type() == DataType::VECTOR_BINARY) {
                 this->append_field_data<BinaryVector>(field.get_dim(), size_per_chunk);
                 continue;
+            } else if (field.get_data_type() == DataType::VECTOR_FLOAT16) {
+                this->append_field_data<Float16Vector>(field.get_dim(), size_per_chunk);
+                continue;
+            } else if (field.get_data_type() == DataType::VECTOR_BFLOAT16) {
+                this->append_field_data<BFloat16Vector>(field.get_dim(), size_per_chunk);
+                continue;
+            } else if (field.get_data_type() == DataType::VECTOR_SPARSE_FLOAT) {
+                this->append_sparse_float_vector_field_data(size_per_chunk);
+                continue;
             } else {
                 PanicInfo("unsupported");
             }
@@ -56,6 +70,18 @@ InsertRecord::InsertRecord(const Schema& schema, int64_t size_per_chunk)
                 this->append_field_data<double>(size_per_chunk);
                 break;
             }
+            case DataType::STRING: {
+                this->append_string_field_data(size_per_chunk);
+                break;
+            }
+            case DataType::ARRAY: {
+                this->append_array_field_data(size_per_chunk);
+                break;
+            }
+            case DataType::JSON: {
+                this->append_json_field_data(size_per_chunk);
+                break;
+            }
             default: {
                 PanicInfo("unsupported");
             }
@@ -56,6 +56,17 @@ struct InsertRecord {
         return ptr;
     }
 
+    // get the validity of a nullable field, nullptr if the field is not nullable
+    const ConcurrentVector<bool>*
+    get_valid_data(FieldOffset field_offset) const {
+        return valid_data_[field_offset.get()].get();
+    }
+
+    ConcurrentVector<bool>*
+    get_valid_data(FieldOffset field_offset) {
+        return valid_data_[field_offset.get()].get();
+    }
+
     // append a column of scalar type
     template <typename Type>
     void
@@ -72,8 +83,33 @@ struct InsertRecord {
         fields_data_.emplace_back(std::make_unique<ConcurrentVector<VectorType>>(dim, size_per_chunk));
     }
 
+    // append a column of string type
+    void
+    append_string_field_data(int64_t size_per_chunk) {
+        fields_data_.emplace_back(std::make_unique<ConcurrentVector<std::string>>(size_per_chunk));
+    }
+
+    // append a column of sparse float vector type
+    void
+    append_sparse_float_vector_field_data(int64_t size_per_chunk) {
+        fields_data_.emplace_back(std::make_unique<ConcurrentVector<SparseFloatVector>>(size_per_chunk));
+    }
+
+    // append a column of array type
+    void
+    append_array_field_data(int64_t size_per_chunk) {
+        fields_data_.emplace_back(std::make_unique<ConcurrentVector<Array>>(size_per_chunk));
+    }
+
+    // append a column of JSON type
+    void
+    append_json_field_data(int64_t size_per_chunk) {
+        fields_data_.emplace_back(std::make_unique<ConcurrentVector<JSON>>(size_per_chunk));
+    }
+
  private:
     std::vector<std::unique_ptr<VectorBase>> fields_data_;
+    std::vector<std::unique_ptr<ConcurrentVector<bool>>> valid_data_;
 };
 
 }  // namespace milvus::segcore
@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+
+#include <atomic>
+#include <string>
+#include <vector>
+#include <tbb/concurrent_unordered_map.h>
+
+#include "common/Types.h"
+
+namespace milvus::segcore {
+
+// PkInterner maps the varchar primary keys of a segment to int64 ids, so that the uid to offset map, the pk index
+// and the deleted record keep working on int64. The ids are only meaningful inside the segment.
+class PkInterner {
+ public:
+    idx_t
+    intern(const std::string& pk) {
+        auto iter = ids_.find(pk);
+        if (iter != ids_.end()) {
+            return iter->second;
+        }
+        auto [inserted, ok] = ids_.insert(std::make_pair(pk, next_id_.fetch_add(1)));
+        return inserted->second;
+    }
+
+ private:
+    tbb::concurrent_unordered_map<std::string, idx_t> ids_;
+    std::atomic<idx_t> next_id_ = 0;
+};
+
+}  // namespace milvus::segcore
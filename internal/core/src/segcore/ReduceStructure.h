@@ -18,15 +18,16 @@
 using milvus::SearchResult;
 
 struct SearchResultPair {
-    int64_t primary_key_;
+    milvus::PkType primary_key_;
     float distance_;
     milvus::SearchResult* search_result_;
     int64_t index_;
     int64_t offset_;
     int64_t offset_rb_;  // right bound
 
-    SearchResultPair(int64_t primary_key, float distance, SearchResult* result, int64_t index, int64_t lb, int64_t rb)
-        : primary_key_(primary_key),
+    SearchResultPair(
+        milvus::PkType primary_key, float distance, SearchResult* result, int64_t index, int64_t lb, int64_t rb)
+        : primary_key_(std::move(primary_key)),
           distance_(distance),
           search_result_(result),
           index_(index),
@@ -36,10 +37,10 @@ struct SearchResultPair {
 
     bool
     operator>(const SearchResultPair& other) const {
-        if (this->primary_key_ == INVALID_ID) {
+        if (!is_valid()) {
             return false;
         } else {
-            if (other.primary_key_ == INVALID_ID) {
+            if (!other.is_valid()) {
                 return true;
             } else {
                 return (distance_ > other.distance_);
@@ -47,6 +48,11 @@ struct SearchResultPair {
         }
     }
 
+    bool
+    is_valid() const {
+        return !std::holds_alternative<std::monostate>(primary_key_);
+    }
+
     void
     reset() {
         if (offset_ < offset_rb_) {
@@ -55,11 +61,11 @@ struct SearchResultPair {
                 primary_key_ = search_result_->primary_keys_.at(offset_);
                 distance_ = search_result_->result_distances_.at(offset_);
             } else {
-                primary_key_ = INVALID_ID;
+                primary_key_ = std::monostate{};
                 distance_ = MAXFLOAT;
             }
         } else {
-            primary_key_ = INVALID_ID;
+            primary_key_ = std::monostate{};
             distance_ = MAXFLOAT;
         }
     }
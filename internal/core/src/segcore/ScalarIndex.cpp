@@ -75,6 +75,19 @@ ScalarIndexVector::do_search_ids(const std::vector<idx_t>& ids) const {
     return {std::move(dst_ids), std::move(dst_offsets)};
 }
 
+std::vector<SegOffset>
+ScalarIndexVector::do_search_offsets(idx_t id) const {
+    using Pair = std::pair<T, SegOffset>;
+    auto [iter_beg, iter_end] =
+        std::equal_range(mapping_.begin(), mapping_.end(), std::make_pair(id, SegOffset(0)),
+                         [](const Pair& left, const Pair& right) { return left.first < right.first; });
+    std::vector<SegOffset> dst_offsets;
+    for (auto iter = iter_beg; iter != iter_end; ++iter) {
+        dst_offsets.push_back(iter->second);
+    }
+    return dst_offsets;
+}
+
 void
 ScalarIndexVector::append_data(const ScalarIndexVector::T* ids, int64_t count, SegOffset base) {
     for (int64_t i = 0; i < count; ++i) {
@@ -27,6 +27,9 @@ class ScalarIndexBase {
     do_search_ids(const IdArray& ids) const = 0;
     virtual std::pair<std::vector<idx_t>, std::vector<SegOffset>>
     do_search_ids(const std::vector<idx_t>& ids) const = 0;
+    // all the offsets of the rows with the id, the repeated ids are the versions of an upserted entity
+    virtual std::vector<SegOffset>
+    do_search_offsets(idx_t id) const = 0;
     virtual ~ScalarIndexBase() = default;
     virtual std::string
     debug() const = 0;
@@ -49,6 +52,9 @@ class ScalarIndexVector : public ScalarIndexBase {
     std::pair<std::vector<idx_t>, std::vector<SegOffset>>
     do_search_ids(const std::vector<idx_t>& ids) const override;
 
+    std::vector<SegOffset>
+    do_search_offsets(idx_t id) const override;
+
     std::string
     debug() const override {
         std::string dbg_str;
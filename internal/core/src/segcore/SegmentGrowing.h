@@ -25,8 +25,8 @@ namespace milvus {
 namespace segcore {
 using SearchResult = milvus::SearchResult;
 struct RowBasedRawData {
-    void* raw_data;      // schema
-    int sizeof_per_row;  // alignment
+    void* raw_data;         // the rows back to back, a value of a variable-length field takes its own length
+    int64_t raw_data_size;  // the size of all the rows in bytes
     int64_t count;
 };
 
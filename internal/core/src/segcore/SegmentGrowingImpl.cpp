@@ -61,13 +61,15 @@ SegmentGrowingImpl::get_deleted_bitmap(int64_t del_barrier,
         for (auto del_index = del_barrier; del_index < old->del_barrier; ++del_index) {
             // get uid in delete logs
             auto uid = deleted_record_.uids_[del_index];
+            auto del_ts = deleted_record_.timestamps_[del_index];
             // map uid to corresponding offsets, select the max one, which should be the target
-            // the max one should be closest to query_timestamp, so the delete log should refer to it
+            // the max one should be closest to the delete, so the delete log should refer to it,
+            // the row inserted with the same timestamp (e.g. by upsert) is newer than the delete
             int64_t the_offset = -1;
             auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
             for (auto iter = iter_b; iter != iter_e; ++iter) {
                 auto offset = iter->second;
-                if (record_.timestamps_[offset] < query_timestamp) {
+                if (record_.timestamps_[offset] < del_ts) {
                     AssertInfo(offset < insert_barrier, "Timestamp offset is larger than insert barrier");
                     the_offset = std::max(the_offset, offset);
                 }
@@ -84,8 +86,10 @@ SegmentGrowingImpl::get_deleted_bitmap(int64_t del_barrier,
         for (auto del_index = old->del_barrier; del_index < del_barrier; ++del_index) {
             // get uid in delete logs
             auto uid = deleted_record_.uids_[del_index];
+            auto del_ts = deleted_record_.timestamps_[del_index];
             // map uid to corresponding offsets, select the max one, which should be the target
-            // the max one should be closest to query_timestamp, so the delete log should refer to it
+            // the max one should be closest to the delete, so the delete log should refer to it,
+            // the row inserted with the same timestamp (e.g. by upsert) is newer than the delete
             int64_t the_offset = -1;
             auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
             for (auto iter = iter_b; iter != iter_e; ++iter) {
@@ -93,7 +97,7 @@ SegmentGrowingImpl::get_deleted_bitmap(int64_t del_barrier,
                 if (offset >= insert_barrier) {
                     continue;
                 }
-                if (record_.timestamps_[offset] < query_timestamp) {
+                if (record_.timestamps_[offset] < del_ts) {
                     AssertInfo(offset < insert_barrier, "Timestamp offset is larger than insert barrier");
                     the_offset = std::max(the_offset, offset);
                 }
@@ -144,16 +148,46 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
                            const Timestamp* timestamps_raw,
                            const RowBasedRawData& entities_raw) {
     AssertInfo(entities_raw.count == size, "Entities_raw count not equal to insert size");
-    // step 1: check schema if valid
-    if (entities_raw.sizeof_per_row != schema_->get_total_sizeof()) {
-        std::string msg = "entity length = " + std::to_string(entities_raw.sizeof_per_row) +
-                          ", schema length = " + std::to_string(schema_->get_total_sizeof());
+    // step 1: check schema if valid, the rows are walked to find where the values of the fields begin, since the
+    // values of the variable-length fields take their own lengths
+    auto raw_data = reinterpret_cast<const char*>(entities_raw.raw_data);
+    auto num_fields = schema_->size();
+    // value_offsets[i * (num_fields + 1) + fid] is the offset of the value of field fid of row i, including the validity
+    // byte of a nullable field, and value_offsets[i * (num_fields + 1) + num_fields] is the end of row i
+    std::vector<int64_t> value_offsets(size * (num_fields + 1));
+    int64_t offset = 0;
+    for (int64_t i = 0; i < size; ++i) {
+        for (int fid = 0; fid < num_fields; ++fid) {
+            value_offsets[i * (num_fields + 1) + fid] = offset;
+            auto& field_meta = (*schema_)[FieldOffset(fid)];
+            if (field_meta.is_nullable()) {
+                ++offset;
+            }
+            if (field_meta.is_variable_length()) {
+                uint32_t length = 0;
+                if (offset + static_cast<int64_t>(sizeof(length)) <= entities_raw.raw_data_size) {
+                    memcpy(&length, raw_data + offset, sizeof(length));
+                }
+                offset += sizeof(length) + length;
+            } else {
+                offset += field_meta.get_sizeof();
+            }
+            if (offset > entities_raw.raw_data_size) {
+                std::string msg = "entity length = " + std::to_string(entities_raw.raw_data_size) +
+                                  ", row " + std::to_string(i) + " is truncated in field " +
+                                  field_meta.get_name().get();
+                throw std::runtime_error(msg);
+            }
+        }
+        value_offsets[i * (num_fields + 1) + num_fields] = offset;
+    }
+    if (offset != entities_raw.raw_data_size) {
+        std::string msg = "entity length = " + std::to_string(entities_raw.raw_data_size) +
+                          ", schema length = " + std::to_string(offset);
         throw std::runtime_error(msg);
     }
 
     // step 2: sort timestamp
-    auto raw_data = reinterpret_cast<const char*>(entities_raw.raw_data);
-    auto len_per_row = entities_raw.sizeof_per_row;
     std::vector<std::tuple<Timestamp, idx_t, int64_t>> ordering;
     ordering.resize(size);
     // #pragma omp parallel for
@@ -162,15 +196,20 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
     }
     std::sort(ordering.begin(), ordering.end());
 
-    // step 3: and convert row-based data to column-based data accordingly
-    auto sizeof_infos = schema_->get_sizeof_infos();
-    std::vector<int> offset_infos(schema_->size() + 1, 0);
-    std::partial_sum(sizeof_infos.begin(), sizeof_infos.end(), offset_infos.begin() + 1);
-    std::vector<aligned_vector<uint8_t>> entities(schema_->size());
+    // step 3: and convert row-based data to column-based data accordingly, the values of a variable-length field are
+    // kept back to back with their lengths
+    std::vector<aligned_vector<uint8_t>> entities(num_fields);
+    // the value of a nullable field is led by a validity byte in a row, which is split into valid_data
+    std::vector<aligned_vector<uint8_t>> valid_data(num_fields);
 
-    for (int fid = 0; fid < schema_->size(); ++fid) {
-        auto len = sizeof_infos[fid];
-        entities[fid].resize(len * size);
+    for (int fid = 0; fid < num_fields; ++fid) {
+        auto& field_meta = (*schema_)[FieldOffset(fid)];
+        if (!field_meta.is_variable_length()) {
+            entities[fid].reserve(field_meta.get_sizeof() * size);
+        }
+        if (field_meta.is_nullable()) {
+            valid_data[fid].resize(size);
+        }
     }
 
     std::vector<idx_t> uids(size);
@@ -180,16 +219,19 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
         auto [t, uid, order_index] = ordering[index];
         timestamps[index] = t;
         uids[index] = uid;
-        for (int fid = 0; fid < schema_->size(); ++fid) {
-            auto len = sizeof_infos[fid];
-            auto offset = offset_infos[fid];
-            auto src = raw_data + order_index * len_per_row + offset;
-            auto dst = entities[fid].data() + index * len;
-            memcpy(dst, src, len);
+        for (int fid = 0; fid < num_fields; ++fid) {
+            auto begin = value_offsets[order_index * (num_fields + 1) + fid];
+            auto end = value_offsets[order_index * (num_fields + 1) + fid + 1];
+            auto src = raw_data + begin;
+            if (!valid_data[fid].empty()) {
+                valid_data[fid][index] = *src != 0;
+                ++src;
+            }
+            entities[fid].insert(entities[fid].end(), src, raw_data + end);
         }
     }
 
-    do_insert(reserved_begin, size, uids.data(), timestamps.data(), entities);
+    do_insert(reserved_begin, size, uids.data(), timestamps.data(), entities, valid_data);
     return Status::OK();
 }
 
@@ -198,13 +240,25 @@ SegmentGrowingImpl::do_insert(int64_t reserved_begin,
                               int64_t size,
                               const idx_t* row_ids,
                               const Timestamp* timestamps,
-                              const std::vector<aligned_vector<uint8_t>>& columns_data) {
+                              const std::vector<aligned_vector<uint8_t>>& columns_data,
+                              const std::vector<aligned_vector<uint8_t>>& valid_data) {
     // step 4: fill into Segment.ConcurrentVector
     record_.timestamps_.set_data(reserved_begin, timestamps, size);
     record_.uids_.set_data(reserved_begin, row_ids, size);
     for (int fid = 0; fid < schema_->size(); ++fid) {
         auto field_offset = FieldOffset(fid);
         record_.get_field_data_base(field_offset)->set_data_raw(reserved_begin, columns_data[fid].data(), size);
+        auto valid_vec = record_.get_valid_data(field_offset);
+        if (valid_vec == nullptr) {
+            continue;
+        }
+        if (fid < valid_data.size() && !valid_data[fid].empty()) {
+            valid_vec->set_data_raw(reserved_begin, valid_data[fid].data(), size);
+        } else {
+            // all the rows are valid
+            std::vector<uint8_t> all_valid(size, 1);
+            valid_vec->set_data_raw(reserved_begin, all_valid.data(), size);
+        }
     }
 
     if (schema_->get_is_auto_id()) {
@@ -216,10 +270,18 @@ SegmentGrowingImpl::do_insert(int64_t reserved_begin,
     } else {
         auto offset = schema_->get_primary_key_offset().value_or(FieldOffset(-1));
         AssertInfo(offset.get() != -1, "Primary key offset is -1");
-        auto& row = columns_data[offset.get()];
-        auto row_ptr = reinterpret_cast<const int64_t*>(row.data());
-        for (int i = 0; i < size; ++i) {
-            uid2offset_.insert(std::make_pair(row_ptr[i], reserved_begin + i));
+        if (is_varchar_primary_key()) {
+            auto vec = record_.get_field_data<std::string>(offset);
+            for (int i = 0; i < size; ++i) {
+                auto id = pk_interner_.intern(std::string(vec->view(reserved_begin + i)));
+                uid2offset_.insert(std::make_pair(id, reserved_begin + i));
+            }
+        } else {
+            auto& row = columns_data[offset.get()];
+            auto row_ptr = reinterpret_cast<const int64_t*>(row.data());
+            for (int i = 0; i < size; ++i) {
+                uid2offset_.insert(std::make_pair(row_ptr[i], reserved_begin + i));
+            }
         }
     }
 
@@ -273,6 +335,20 @@ SegmentGrowingImpl::chunk_data_impl(FieldOffset field_offset, int64_t chunk_id)
     return vec->get_span_base(chunk_id);
 }
 
+SpanBase
+SegmentGrowingImpl::chunk_valid_data_impl(FieldOffset field_offset, int64_t chunk_id) const {
+    auto vec = get_insert_record().get_valid_data(field_offset);
+    AssertInfo(vec != nullptr, "field " + std::to_string(field_offset.get()) + " is not nullable");
+    return vec->get_span_base(chunk_id);
+}
+
+std::vector<std::string_view>
+SegmentGrowingImpl::chunk_views_impl(FieldOffset field_offset, int64_t chunk_id) const {
+    auto vec = dynamic_cast<const VariableLengthConcurrentVector*>(get_insert_record().get_field_data_base(field_offset));
+    AssertInfo(vec != nullptr, "field " + std::to_string(field_offset.get()) + " is not of a variable-length type");
+    return vec->get_views(chunk_id);
+}
+
 int64_t
 SegmentGrowingImpl::num_chunk() const {
     auto size = get_insert_record().ack_responder_.GetAck();
@@ -309,6 +385,10 @@ SegmentGrowingImpl::bulk_subscript(FieldOffset field_offset,
             bulk_subscript_impl<FloatVector>(field_meta.get_sizeof(), *vec_ptr, seg_offsets, count, output);
         } else if (field_meta.get_data_type() == DataType::VECTOR_BINARY) {
             bulk_subscript_impl<BinaryVector>(field_meta.get_sizeof(), *vec_ptr, seg_offsets, count, output);
+        } else if (field_meta.get_data_type() == DataType::VECTOR_FLOAT16) {
+            bulk_subscript_impl<Float16Vector>(field_meta.get_sizeof(), *vec_ptr, seg_offsets, count, output);
+        } else if (field_meta.get_data_type() == DataType::VECTOR_BFLOAT16) {
+            bulk_subscript_impl<BFloat16Vector>(field_meta.get_sizeof(), *vec_ptr, seg_offsets, count, output);
         } else {
             PanicInfo("logical error");
         }
@@ -351,6 +431,19 @@ SegmentGrowingImpl::bulk_subscript(FieldOffset field_offset,
     }
 }
 
+void
+SegmentGrowingImpl::bulk_subscript_views(FieldOffset field_offset,
+                                         const int64_t* seg_offsets,
+                                         int64_t count,
+                                         std::string_view* output) const {
+    auto vec_ptr = dynamic_cast<const VariableLengthConcurrentVector*>(record_.get_field_data_base(field_offset));
+    AssertInfo(vec_ptr, "field " + std::to_string(field_offset.get()) + " is not of a variable-length type");
+    for (int64_t i = 0; i < count; ++i) {
+        auto offset = seg_offsets[i];
+        output[i] = (offset == INVALID_SEG_OFFSET ? std::string_view() : vec_ptr->view(offset));
+    }
+}
+
 template <typename T>
 void
 SegmentGrowingImpl::bulk_subscript_impl(int64_t element_sizeof,
@@ -440,8 +533,25 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
     for (int field_offset = 0; field_offset < schema_->size(); ++field_offset) {
         auto& field_meta = schema_->operator[](FieldOffset(field_offset));
         aligned_vector<uint8_t> column;
-        auto element_sizeof = field_meta.get_sizeof();
         auto& src_vec = values.columns_[field_offset];
+        if (field_meta.is_variable_length()) {
+            // the values are of variable lengths, they are walked to find where they begin
+            std::vector<int64_t> begins(size + 1, 0);
+            for (int64_t i = 0; i < size; ++i) {
+                uint32_t length;
+                AssertInfo(begins[i] + sizeof(length) <= src_vec.size(), "Vector size is not aligned");
+                memcpy(&length, src_vec.data() + begins[i], sizeof(length));
+                begins[i + 1] = begins[i] + sizeof(length) + length;
+            }
+            AssertInfo(begins[size] == src_vec.size(), "Vector size is not aligned");
+            for (int64_t i = 0; i < size; ++i) {
+                auto offset = indexes[i];
+                column.insert(column.end(), src_vec.data() + begins[offset], src_vec.data() + begins[offset + 1]);
+            }
+            columns_data.emplace_back(std::move(column));
+            continue;
+        }
+        auto element_sizeof = field_meta.get_sizeof();
         AssertInfo(src_vec.size() == element_sizeof * size, "Vector size is not aligned");
         for (int64_t i = 0; i < size; ++i) {
             auto offset = indexes[i];
@@ -450,7 +560,7 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
         }
         columns_data.emplace_back(std::move(column));
     }
-    do_insert(reserved_offset, size, row_ids.data(), timestamps.data(), columns_data);
+    do_insert(reserved_offset, size, row_ids.data(), timestamps.data(), columns_data, {});
 }
 
 std::vector<SegOffset>
@@ -540,4 +650,18 @@ SegmentGrowingImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk,
     // DO NOTHING
 }
 
+void
+SegmentGrowingImpl::mask_with_expiration(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const {
+    auto expire_ts = get_expire_timestamp(schema_->get_ttl_seconds(), timestamp);
+    if (expire_ts == 0) {
+        return;
+    }
+    auto& ts_vec = this->get_insert_record().timestamps_;
+    for (int64_t i = 0; i < bitset_chunk.size(); ++i) {
+        if (ts_vec[i] < expire_ts) {
+            bitset_chunk[i] = false;
+        }
+    }
+}
+
 }  // namespace milvus::segcore
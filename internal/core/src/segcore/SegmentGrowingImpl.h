@@ -152,6 +152,12 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     bulk_subscript(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count, void* output) const override;
 
+    void
+    bulk_subscript_views(FieldOffset field_offset,
+                         const int64_t* seg_offsets,
+                         int64_t count,
+                         std::string_view* output) const override;
+
  public:
     friend std::unique_ptr<SegmentGrowing>
     CreateGrowingSegment(SchemaPtr schema, const SegcoreConfig& segcore_config);
@@ -166,6 +172,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_expiration(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -201,6 +210,12 @@ class SegmentGrowingImpl : public SegmentGrowing {
     SpanBase
     chunk_data_impl(FieldOffset field_offset, int64_t chunk_id) const override;
 
+    SpanBase
+    chunk_valid_data_impl(FieldOffset field_offset, int64_t chunk_id) const override;
+
+    std::vector<std::string_view>
+    chunk_views_impl(FieldOffset field_offset, int64_t chunk_id) const override;
+
     void
     check_search(const query::Plan* plan) const override {
         Assert(plan);
@@ -212,7 +227,8 @@ class SegmentGrowingImpl : public SegmentGrowing {
               int64_t size,
               const idx_t* row_ids,
               const Timestamp* timestamps,
-              const std::vector<aligned_vector<uint8_t>>& columns_data);
+              const std::vector<aligned_vector<uint8_t>>& columns_data,
+              const std::vector<aligned_vector<uint8_t>>& valid_data);
 
  private:
     SegcoreConfig segcore_config_;
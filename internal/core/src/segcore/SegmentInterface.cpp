@@ -9,11 +9,80 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
+#include <numeric>
+
 #include "segcore/SegmentInterface.h"
+#include "common/Consts.h"
 #include "query/generated/ExecPlanNodeVisitor.h"
 namespace milvus::segcore {
 class Naive;
 
+namespace {
+// compare the values of two rows of a scalar column, null values are greater than all the other values
+int
+CompareRows(const DataArray& column, int64_t i, int64_t j) {
+    auto& valid_data = column.valid_data();
+    if (!valid_data.empty() && !(valid_data[i] && valid_data[j])) {
+        return valid_data[i] == valid_data[j] ? 0 : (valid_data[i] ? -1 : 1);
+    }
+    auto compare = [](const auto& a, const auto& b) { return a < b ? -1 : (b < a ? 1 : 0); };
+    auto& scalars = column.scalars();
+    switch (scalars.data_case()) {
+        case proto::schema::ScalarField::kBoolData:
+            return compare(scalars.bool_data().data(i), scalars.bool_data().data(j));
+        case proto::schema::ScalarField::kIntData:
+            return compare(scalars.int_data().data(i), scalars.int_data().data(j));
+        case proto::schema::ScalarField::kLongData:
+            return compare(scalars.long_data().data(i), scalars.long_data().data(j));
+        case proto::schema::ScalarField::kFloatData:
+            return compare(scalars.float_data().data(i), scalars.float_data().data(j));
+        case proto::schema::ScalarField::kDoubleData:
+            return compare(scalars.double_data().data(i), scalars.double_data().data(j));
+        case proto::schema::ScalarField::kStringData:
+            return compare(scalars.string_data().data(i), scalars.string_data().data(j));
+        default:
+            PanicInfo("can not order by the field of type " + proto::schema::DataType_Name(column.type()));
+    }
+}
+}  // namespace
+
+// keep the first limit rows of the retrieved offsets, ordered the same as the query node orders the merged results:
+// by the order by field, and then by the primary key
+void
+SegmentInternalInterface::limit_retrieve_offsets(const query::RetrievePlan* plan, std::vector<int64_t>& offsets) const {
+    auto& plan_node = plan->plan_node_;
+    auto limit = plan_node->limit_;
+    if (limit <= 0 || static_cast<int64_t>(offsets.size()) <= limit) {
+        return;
+    }
+    auto pk_offset = plan->schema_.get_primary_key_offset();
+    AssertInfo(pk_offset.has_value(), "retrieve with limit requires the primary key");
+    auto pks = BulkSubScript(pk_offset.value(), (SegOffset*)offsets.data(), offsets.size());
+    std::unique_ptr<DataArray> order_by;
+    if (plan_node->order_by_.has_value()) {
+        order_by = BulkSubScript(plan_node->order_by_.value(), (SegOffset*)offsets.data(), offsets.size());
+    }
+
+    std::vector<int64_t> rows(offsets.size());
+    std::iota(rows.begin(), rows.end(), 0);
+    std::stable_sort(rows.begin(), rows.end(), [&](int64_t i, int64_t j) {
+        if (order_by != nullptr) {
+            auto c = plan_node->descending_ ? CompareRows(*order_by, j, i) : CompareRows(*order_by, i, j);
+            if (c != 0) {
+                return c < 0;
+            }
+        }
+        return CompareRows(*pks, i, j) < 0;
+    });
+
+    std::vector<int64_t> limited(limit);
+    for (int64_t i = 0; i < limit; ++i) {
+        limited[i] = offsets[rows[i]];
+    }
+    offsets = std::move(limited);
+}
+
 void
 SegmentInternalInterface::FillPrimaryKeys(const query::Plan* plan, SearchResult& results) const {
     std::shared_lock lck(mutex_);
@@ -24,6 +93,18 @@ SegmentInternalInterface::FillPrimaryKeys(const query::Plan* plan, SearchResult&
     Assert(results.primary_keys_.size() == 0);
     results.primary_keys_.resize(size);
 
+    if (is_varchar_primary_key()) {
+        auto key_offset = get_schema().get_primary_key_offset().value();
+        std::vector<std::string_view> views(size);
+        bulk_subscript_views(key_offset, results.internal_seg_offsets_.data(), size, views.data());
+        for (int64_t i = 0; i < size; ++i) {
+            if (results.internal_seg_offsets_[i] != INVALID_SEG_OFFSET) {
+                results.primary_keys_[i] = std::string(views[i]);
+            }
+        }
+        return;
+    }
+
     auto element_sizeof = sizeof(int64_t);
     aligned_vector<char> blob(size * element_sizeof);
     if (plan->schema_.get_is_auto_id()) {
@@ -36,7 +117,12 @@ SegmentInternalInterface::FillPrimaryKeys(const query::Plan* plan, SearchResult&
         bulk_subscript(key_offset, results.internal_seg_offsets_.data(), size, blob.data());
     }
 
-    memcpy(results.primary_keys_.data(), blob.data(), element_sizeof * size);
+    auto pks = reinterpret_cast<const int64_t*>(blob.data());
+    for (int64_t i = 0; i < size; ++i) {
+        if (pks[i] != INVALID_ID) {
+            results.primary_keys_[i] = pks[i];
+        }
+    }
 }
 
 void
@@ -48,13 +134,20 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
                "Size of result distances is not equal to size of segment offsets");
     Assert(results.row_data_.size() == 0);
 
-    std::vector<int64_t> element_sizeofs;
-    std::vector<aligned_vector<char>> blobs;
+    // the rows are assembled column by column, the value of a variable-length field is the uint32 length followed by
+    // the bytes
+    results.row_data_.resize(size);
+    auto append_column = [&](const char* blob, int64_t element_sizeof) {
+        for (int64_t i = 0; i < size; ++i) {
+            auto src = blob + element_sizeof * i;
+            results.row_data_[i].insert(results.row_data_[i].end(), src, src + element_sizeof);
+        }
+    };
 
-    // fill row_ids
+    // fill row_ids, the row ids stand in for the varchar primary keys, which are carried by primary_keys_
     {
         aligned_vector<char> blob(size * sizeof(int64_t));
-        if (plan->schema_.get_is_auto_id()) {
+        if (plan->schema_.get_is_auto_id() || is_varchar_primary_key()) {
             bulk_subscript(SystemFieldType::RowId, results.internal_seg_offsets_.data(), size, blob.data());
         } else {
             auto key_offset_opt = get_schema().get_primary_key_offset();
@@ -64,35 +157,52 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
                        "Primary key field is not INT64 type");
             bulk_subscript(key_offset, results.internal_seg_offsets_.data(), size, blob.data());
         }
-        blobs.emplace_back(std::move(blob));
-        element_sizeofs.push_back(sizeof(int64_t));
+        append_column(blob.data(), sizeof(int64_t));
     }
 
     // fill other entries except primary key
     for (auto field_offset : plan->target_entries_) {
         auto& field_meta = get_schema()[field_offset];
+        if (field_meta.is_nullable()) {
+            // the value of a nullable field is led by a validity byte
+            aligned_vector<char> valid_blob(size * sizeof(bool));
+            bulk_subscript_valid_data(field_offset, results.internal_seg_offsets_.data(), size,
+                                      reinterpret_cast<bool*>(valid_blob.data()));
+            append_column(valid_blob.data(), sizeof(bool));
+        }
+        if (field_meta.is_variable_length()) {
+            std::vector<std::string_view> views(size);
+            bulk_subscript_views(field_offset, results.internal_seg_offsets_.data(), size, views.data());
+            for (int64_t i = 0; i < size; ++i) {
+                uint32_t length = views[i].size();
+                auto& target = results.row_data_[i];
+                auto length_ptr = reinterpret_cast<const char*>(&length);
+                target.insert(target.end(), length_ptr, length_ptr + sizeof(length));
+                target.insert(target.end(), views[i].begin(), views[i].end());
+            }
+            continue;
+        }
         auto element_sizeof = field_meta.get_sizeof();
         aligned_vector<char> blob(size * element_sizeof);
         bulk_subscript(field_offset, results.internal_seg_offsets_.data(), size, blob.data());
-        blobs.emplace_back(std::move(blob));
-        element_sizeofs.push_back(element_sizeof);
+        append_column(blob.data(), element_sizeof);
     }
+}
 
-    auto target_sizeof = std::accumulate(element_sizeofs.begin(), element_sizeofs.end(), 0);
-
-    for (int64_t i = 0; i < size; ++i) {
-        int64_t element_offset = 0;
-        std::vector<char> target(target_sizeof);
-        for (int loc = 0; loc < blobs.size(); ++loc) {
-            auto element_sizeof = element_sizeofs[loc];
-            auto blob_ptr = blobs[loc].data();
-            auto src = blob_ptr + element_sizeof * i;
-            auto dst = target.data() + element_offset;
-            memcpy(dst, src, element_sizeof);
-            element_offset += element_sizeof;
-        }
-        assert(element_offset == target_sizeof);
-        results.row_data_.emplace_back(std::move(target));
+void
+SegmentInternalInterface::bulk_subscript_valid_data(FieldOffset field_offset,
+                                                    const int64_t* seg_offsets,
+                                                    int64_t count,
+                                                    bool* output) const {
+    auto size_per_chunk = this->size_per_chunk();
+    for (int64_t i = 0; i < count; ++i) {
+        auto offset = seg_offsets[i];
+        if (offset == INVALID_SEG_OFFSET) {
+            output[i] = false;
+            continue;
+        }
+        auto chunk = chunk_valid_data(field_offset, offset / size_per_chunk);
+        output[i] = chunk[offset % size_per_chunk];
     }
 }
 
@@ -163,6 +273,47 @@ CreateScalarArrayFrom(const void* data_raw, int64_t count, DataType data_type) {
     return scalar_array;
 }
 
+// decode the bytes of an array, the number of elements in uint32 followed by the elements, a string element is the
+// length in uint32 followed by the bytes
+static std::unique_ptr<ScalarArray>
+CreateArrayFrom(std::string_view value, const FieldMeta& field_meta) {
+    uint32_t length;
+    AssertInfo(value.size() >= sizeof(length), "array is truncated");
+    memcpy(&length, value.data(), sizeof(length));
+    AssertInfo(length <= field_meta.get_max_capacity(), "array length exceeds max_capacity");
+    auto elements = value.data() + sizeof(length);
+    auto elements_end = value.data() + value.size();
+    auto element_type = field_meta.get_element_type();
+    switch (element_type) {
+        case DataType::BOOL: {
+            auto array = std::make_unique<ScalarArray>();
+            auto data = reinterpret_cast<const bool*>(elements);
+            array->mutable_bool_data()->mutable_data()->Add(data, data + length);
+            return array;
+        }
+        case DataType::STRING: {
+            auto array = std::make_unique<ScalarArray>();
+            auto obj = array->mutable_string_data();
+            auto element = elements;
+            for (uint32_t i = 0; i < length; ++i) {
+                uint32_t element_length;
+                AssertInfo(element + sizeof(element_length) <= elements_end, "array is truncated");
+                memcpy(&element_length, element, sizeof(element_length));
+                element += sizeof(element_length);
+                AssertInfo(element + element_length <= elements_end, "array is truncated");
+                obj->add_data(std::string(element, element_length));
+                element += element_length;
+            }
+            return array;
+        }
+        default: {
+            AssertInfo(elements + length * field_meta.get_element_sizeof() == elements_end,
+                       "array size mismatches with the number of elements");
+            return CreateScalarArrayFrom(elements, length, element_type);
+        }
+    }
+}
+
 static std::unique_ptr<DataArray>
 CreateDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_meta) {
     auto data_type = field_meta.get_data_type();
@@ -193,6 +344,20 @@ CreateDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_
                 obj->assign(data, num_bytes);
                 break;
             }
+            case DataType::VECTOR_FLOAT16: {
+                auto num_bytes = count * field_meta.get_sizeof();
+                auto data = reinterpret_cast<const char*>(data_raw);
+                auto obj = vector_array->mutable_float16_vector();
+                obj->assign(data, num_bytes);
+                break;
+            }
+            case DataType::VECTOR_BFLOAT16: {
+                auto num_bytes = count * field_meta.get_sizeof();
+                auto data = reinterpret_cast<const char*>(data_raw);
+                auto obj = vector_array->mutable_bfloat16_vector();
+                obj->assign(data, num_bytes);
+                break;
+            }
             default: {
                 PanicInfo("unsupported datatype");
             }
@@ -201,13 +366,96 @@ CreateDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_
     return data_array;
 }
 
+std::unique_ptr<DataArray>
+SegmentInternalInterface::CreateDataArrayFromViews(FieldOffset field_offset,
+                                                   const int64_t* seg_offsets,
+                                                   int64_t count) const {
+    auto& field_meta = get_schema()[field_offset];
+    auto data_array = std::make_unique<DataArray>();
+    data_array->set_field_id(field_meta.get_id().get());
+    data_array->set_type(milvus::proto::schema::DataType(field_meta.get_data_type()));
+
+    std::vector<std::string_view> views(count);
+    bulk_subscript_views(field_offset, seg_offsets, count, views.data());
+    switch (field_meta.get_data_type()) {
+        case DataType::STRING: {
+            auto obj = data_array->mutable_scalars()->mutable_string_data();
+            for (auto& view : views) {
+                obj->add_data(std::string(view));
+            }
+            break;
+        }
+        case DataType::ARRAY: {
+            auto obj = data_array->mutable_scalars()->mutable_array_data();
+            obj->set_element_type(milvus::proto::schema::DataType(field_meta.get_element_type()));
+            for (int64_t i = 0; i < count; ++i) {
+                // the array at an invalid offset is empty
+                if (seg_offsets[i] == INVALID_SEG_OFFSET) {
+                    obj->add_data();
+                    continue;
+                }
+                auto array = CreateArrayFrom(views[i], field_meta);
+                obj->mutable_data()->AddAllocated(array.release());
+            }
+            break;
+        }
+        case DataType::JSON: {
+            auto obj = data_array->mutable_scalars()->mutable_json_data();
+            for (auto& view : views) {
+                obj->add_data(std::string(view));
+            }
+            break;
+        }
+        case DataType::VECTOR_SPARSE_FLOAT: {
+            // the dim of the sparse float vectors is the largest index plus one
+            constexpr auto pair_sizeof = sizeof(uint32_t) + sizeof(float);
+            auto vector_array = data_array->mutable_vectors();
+            auto obj = vector_array->mutable_sparse_float_vector();
+            int64_t sparse_dim = 0;
+            for (auto& view : views) {
+                AssertInfo(view.size() % pair_sizeof == 0, "invalid size of sparse float vector");
+                auto nnz = view.size() / pair_sizeof;
+                AssertInfo(static_cast<int64_t>(nnz) <= field_meta.get_dim(),
+                           "sparse float vector nnz exceeds max_nnz");
+                if (nnz > 0) {
+                    uint32_t last_index;
+                    memcpy(&last_index, view.data() + (nnz - 1) * pair_sizeof, sizeof(last_index));
+                    sparse_dim = std::max(sparse_dim, static_cast<int64_t>(last_index) + 1);
+                }
+                obj->add_contents(std::string(view));
+            }
+            obj->set_dim(sparse_dim);
+            vector_array->set_dim(sparse_dim);
+            break;
+        }
+        default: {
+            PanicInfo("unsupported variable-length datatype");
+        }
+    }
+    return data_array;
+}
+
 std::unique_ptr<DataArray>
 SegmentInternalInterface::BulkSubScript(FieldOffset field_offset, const SegOffset* seg_offsets, int64_t count) const {
     if (field_offset.get() >= 0) {
         auto& field_meta = get_schema()[field_offset];
-        aligned_vector<char> data(field_meta.get_sizeof() * count);
-        bulk_subscript(field_offset, (const int64_t*)seg_offsets, count, data.data());
-        return CreateDataArrayFrom(data.data(), count, field_meta);
+        std::unique_ptr<DataArray> data_array;
+        if (field_meta.is_variable_length()) {
+            data_array = CreateDataArrayFromViews(field_offset, (const int64_t*)seg_offsets, count);
+        } else {
+            aligned_vector<char> data(field_meta.get_sizeof() * count);
+            bulk_subscript(field_offset, (const int64_t*)seg_offsets, count, data.data());
+            data_array = CreateDataArrayFrom(data.data(), count, field_meta);
+        }
+        if (field_meta.is_nullable()) {
+            std::vector<char> valid_data(count);
+            bulk_subscript_valid_data(field_offset, (const int64_t*)seg_offsets, count,
+                                      reinterpret_cast<bool*>(valid_data.data()));
+            for (auto valid : valid_data) {
+                data_array->add_valid_data(valid != 0);
+            }
+        }
+        return data_array;
     } else {
         Assert(field_offset.get() == -1);
         aligned_vector<char> data(sizeof(int64_t) * count);
@@ -223,6 +471,7 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
     query::ExecPlanNodeVisitor visitor(*this, timestamp);
     auto retrieve_results = visitor.get_retrieve_result(*plan->plan_node_);
     retrieve_results.segment_ = (void*)this;
+    limit_retrieve_offsets(plan, retrieve_results.result_offsets_);
 
     for (auto& seg_offset : retrieve_results.result_offsets_) {
         results->add_offset(seg_offset);
@@ -237,6 +486,10 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
         auto col_data = col.release();
         fields_data->AddAllocated(col_data);
         if (pk_offset.has_value() && pk_offset.value() == field_offset) {
+            if (col_data->scalars().has_string_data()) {
+                ids->mutable_str_id()->mutable_data()->CopyFrom(col_data->scalars().string_data().data());
+                continue;
+            }
             auto int_ids = ids->mutable_int_id();
             for (int j = 0; j < col_data->scalars().long_data().data_size(); ++j) {
                 int_ids->add_data(col_data->scalars().long_data().data(j));
@@ -245,4 +498,18 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
     }
     return results;
 }
+
+std::vector<idx_t>
+SegmentInternalInterface::PrimaryKeysToIds(const IdArray& primary_keys) const {
+    if (primary_keys.has_str_id()) {
+        auto& str_ids = primary_keys.str_id().data();
+        std::vector<idx_t> ids(str_ids.size());
+        for (int i = 0; i < str_ids.size(); ++i) {
+            ids[i] = pk_interner_.intern(str_ids[i]);
+        }
+        return ids;
+    }
+    auto& int_ids = primary_keys.int_id().data();
+    return std::vector<idx_t>(int_ids.begin(), int_ids.end());
+}
 }  // namespace milvus::segcore
@@ -15,6 +15,7 @@
 #include "query/Plan.h"
 #include "common/Span.h"
 #include "FieldIndexing.h"
+#include "segcore/PkInterner.h"
 #include <knowhere/index/vector_index/VecIndex.h>
 #include "common/SystemProperty.h"
 #include "query/PlanNode.h"
@@ -25,6 +26,7 @@
 #include <vector>
 #include <utility>
 #include <string>
+#include <string_view>
 
 namespace milvus::segcore {
 
@@ -58,6 +60,17 @@ class SegmentInterface {
     virtual Status
     Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) = 0;
 
+    // convert primary keys to the int64 ids used inside the segment, the varchar keys are interned
+    virtual std::vector<idx_t>
+    PrimaryKeysToIds(const IdArray& primary_keys) const = 0;
+
+    bool
+    is_varchar_primary_key() const {
+        auto& schema = get_schema();
+        auto pk_offset = schema.get_primary_key_offset();
+        return !schema.get_is_auto_id() && pk_offset.has_value() && schema[pk_offset.value()].is_string();
+    }
+
     virtual ~SegmentInterface() = default;
 };
 
@@ -71,6 +84,18 @@ class SegmentInternalInterface : public SegmentInterface {
         return static_cast<Span<T>>(chunk_data_impl(field_offset, chunk_id));
     }
 
+    // the validity of the rows of a nullable field in a chunk
+    Span<bool>
+    chunk_valid_data(FieldOffset field_offset, int64_t chunk_id) const {
+        return static_cast<Span<bool>>(chunk_valid_data_impl(field_offset, chunk_id));
+    }
+
+    // the values of a variable-length field in a chunk, each view holds the bytes of a value
+    std::vector<std::string_view>
+    chunk_views(FieldOffset field_offset, int64_t chunk_id) const {
+        return chunk_views_impl(field_offset, chunk_id);
+    }
+
     template <typename T>
     const knowhere::scalar::StructuredIndex<T>&
     chunk_scalar_index(FieldOffset field_offset, int64_t chunk_id) const {
@@ -96,9 +121,16 @@ class SegmentInternalInterface : public SegmentInterface {
     std::unique_ptr<proto::segcore::RetrieveResults>
     Retrieve(const query::RetrievePlan* plan, Timestamp timestamp) const override;
 
+    std::vector<idx_t>
+    PrimaryKeysToIds(const IdArray& primary_keys) const override;
+
     virtual std::string
     debug() const = 0;
 
+ protected:
+    void
+    limit_retrieve_offsets(const query::RetrievePlan* plan, std::vector<int64_t>& offsets) const;
+
  public:
     virtual void
     vector_search(int64_t vec_count,
@@ -119,6 +151,10 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const = 0;
 
+    // mask the entities expired at the timestamp by the ttl of the collection
+    virtual void
+    mask_with_expiration(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const = 0;
+
     // count of chunks
     virtual int64_t
     num_chunk() const = 0;
@@ -141,6 +177,14 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual SpanBase
     chunk_data_impl(FieldOffset field_offset, int64_t chunk_id) const = 0;
 
+    // internal API: return the validity of a nullable field in span
+    virtual SpanBase
+    chunk_valid_data_impl(FieldOffset field_offset, int64_t chunk_id) const = 0;
+
+    // internal API: return the views of the values of a variable-length field in a chunk
+    virtual std::vector<std::string_view>
+    chunk_views_impl(FieldOffset field_offset, int64_t chunk_id) const = 0;
+
     // internal API: return chunk_index in span, support scalar index only
     virtual const knowhere::Index*
     chunk_index_impl(FieldOffset field_offset, int64_t chunk_id) const = 0;
@@ -154,11 +198,27 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     bulk_subscript(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count, void* output) const = 0;
 
+    // calculate output[i] = the view of Vec[seg_offsets[i]], where Vec binds to a variable-length field, the view of an
+    // invalid offset is empty
+    virtual void
+    bulk_subscript_views(FieldOffset field_offset,
+                         const int64_t* seg_offsets,
+                         int64_t count,
+                         std::string_view* output) const = 0;
+
+    // calculate output[i] = whether the row seg_offsets[i] of a nullable field is valid
+    void
+    bulk_subscript_valid_data(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count, bool* output) const;
+
     // TODO: special hack: FieldOffset == -1 -> RowId.
     // TODO: remove this hack when transfer is done
     virtual std::unique_ptr<DataArray>
     BulkSubScript(FieldOffset field_offset, const SegOffset* seg_offsets, int64_t count) const;
 
+    // the values of a variable-length field at seg_offsets, in DataArray
+    std::unique_ptr<DataArray>
+    CreateDataArrayFromViews(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count) const;
+
     virtual std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
     search_ids(const IdArray& id_array, Timestamp timestamp) const = 0;
 
@@ -167,6 +227,7 @@ class SegmentInternalInterface : public SegmentInterface {
 
  protected:
     mutable std::shared_mutex mutex_;
+    mutable PkInterner pk_interner_;
 };
 
 }  // namespace milvus::segcore
@@ -9,7 +9,10 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <unordered_set>
+
 #include "common/Consts.h"
+#include "common/HalfFloat.h"
 #include "query/SearchBruteForce.h"
 #include "query/SearchOnSealed.h"
 #include "query/ScalarIndex.h"
@@ -64,7 +67,8 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
     // NOTE: lock only when data is ready to avoid starvation
     AssertInfo(info.row_count > 0, "The row count of field data is 0");
     auto field_id = FieldId(info.field_id);
-    AssertInfo(info.blob, "Field info blob is null");
+    // the data of a variable-length field may be empty if all the values are empty
+    AssertInfo(info.blob || info.offsets, "Field info blob is null");
     auto create_index = [](const int64_t* data, int64_t size) {
         AssertInfo(size, "Vector data size is 0 when create index");
         auto pk_index = std::make_unique<ScalarIndexVector>();
@@ -125,12 +129,24 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
         // prepare data
         auto field_offset = schema_->get_offset(field_id);
         auto& field_meta = schema_->operator[](field_offset);
+        if (field_meta.is_variable_length()) {
+            load_variable_length_field_data(field_offset, info);
+            return;
+        }
         // Assert(!field_meta.is_vector());
         auto element_sizeof = field_meta.get_sizeof();
         auto span = SpanBase(info.blob, info.row_count, element_sizeof);
         auto length_in_bytes = element_sizeof * info.row_count;
         aligned_vector<char> vec_data(length_in_bytes);
         memcpy(vec_data.data(), info.blob, length_in_bytes);
+        FixedVector<bool> valid_data;
+        if (field_meta.is_nullable()) {
+            if (info.valid_data != nullptr) {
+                valid_data.assign(info.valid_data, info.valid_data + info.row_count);
+            } else {
+                valid_data.assign(info.row_count, true);
+            }
+        }
 
         // generate scalar index
         std::unique_ptr<knowhere::Index> index;
@@ -157,6 +173,8 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
             scalar_indexings_[field_offset.get()] = std::move(index);
         }
 
+        valid_data_[field_offset.get()] = std::move(valid_data);
+
         if (schema_->get_primary_key_offset() == field_offset) {
             primary_key_index_ = std::move(pk_index_);
         }
@@ -165,16 +183,72 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
     }
 }
 
+void
+SegmentSealedImpl::load_variable_length_field_data(FieldOffset field_offset, const LoadFieldDataInfo& info) {
+    auto& field_meta = schema_->operator[](field_offset);
+    AssertInfo(info.offsets, "the offsets of the values of variable-length field " + field_meta.get_name().get() +
+                                 " are null");
+    // offsets[i] is where the value of row i begins in blob, offsets[row_count] is the size of blob
+    aligned_vector<int64_t> offsets(info.offsets, info.offsets + info.row_count + 1);
+    AssertInfo(offsets[0] == 0, "the offsets of the values don't begin with 0");
+    for (int64_t i = 0; i < info.row_count; ++i) {
+        AssertInfo(offsets[i] <= offsets[i + 1], "the offsets of the values are not ascending");
+    }
+    aligned_vector<char> vec_data(offsets[info.row_count]);
+    if (!vec_data.empty()) {
+        memcpy(vec_data.data(), info.blob, vec_data.size());
+    }
+    FixedVector<bool> valid_data;
+    if (field_meta.is_nullable()) {
+        if (info.valid_data != nullptr) {
+            valid_data.assign(info.valid_data, info.valid_data + info.row_count);
+        } else {
+            valid_data.assign(info.row_count, true);
+        }
+    }
+
+    std::unique_ptr<ScalarIndexBase> pk_index;
+    if (schema_->get_primary_key_offset() == field_offset) {
+        std::vector<idx_t> ids(info.row_count);
+        for (int64_t i = 0; i < info.row_count; ++i) {
+            ids[i] = pk_interner_.intern(std::string(vec_data.data() + offsets[i], offsets[i + 1] - offsets[i]));
+        }
+        pk_index = std::make_unique<ScalarIndexVector>();
+        pk_index->append_data(ids.data(), ids.size(), SegOffset(0));
+        pk_index->build();
+    }
+
+    // write data under lock
+    std::unique_lock lck(mutex_);
+    update_row_count(info.row_count);
+    AssertInfo(fields_data_[field_offset.get()].empty() && fields_offsets_[field_offset.get()].empty(),
+               "field data already exists");
+    if (field_meta.is_vector()) {
+        AssertInfo(!vecindexs_.is_ready(field_offset), "field data can't be loaded when indexing exists");
+    }
+    fields_data_[field_offset.get()] = std::move(vec_data);
+    fields_offsets_[field_offset.get()] = std::move(offsets);
+    valid_data_[field_offset.get()] = std::move(valid_data);
+    if (pk_index) {
+        primary_key_index_ = std::move(pk_index);
+    }
+    set_bit(field_data_ready_bitset_, field_offset, true);
+}
+
 void
 SegmentSealedImpl::LoadDeletedRecord(const LoadDeletedRecordInfo& info) {
     AssertInfo(info.row_count > 0, "The row count of deleted record is 0");
     AssertInfo(info.primary_keys, "Deleted primary keys is null");
     AssertInfo(info.timestamps, "Deleted timestamps is null");
-    auto primary_keys = reinterpret_cast<const idx_t*>(info.primary_keys);
+    IdArray pks;
+    auto suc = pks.ParseFromArray(info.primary_keys, info.primary_keys_size);
+    AssertInfo(suc, "unmarshal deleted primary keys failed");
+    auto primary_keys = PrimaryKeysToIds(pks);
+    AssertInfo(primary_keys.size() == info.row_count, "The size of deleted primary keys is not equal to row count");
     auto timestamps = reinterpret_cast<const Timestamp*>(info.timestamps);
     int64_t size = info.row_count;
 
-    deleted_record_.uids_.set_data(0, primary_keys, size);
+    deleted_record_.uids_.set_data(0, primary_keys.data(), size);
     deleted_record_.timestamps_.set_data(0, timestamps, size);
     deleted_record_.ack_responder_.AddSegment(0, size);
     deleted_record_.reserved.fetch_add(size);
@@ -207,6 +281,34 @@ SegmentSealedImpl::chunk_data_impl(FieldOffset field_offset, int64_t chunk_id) c
     return base;
 }
 
+std::vector<std::string_view>
+SegmentSealedImpl::chunk_views_impl(FieldOffset field_offset, int64_t chunk_id) const {
+    std::shared_lock lck(mutex_);
+    AssertInfo(get_bit(field_data_ready_bitset_, field_offset),
+               "Can't get bitset element at " + std::to_string(field_offset.get()));
+    AssertInfo(schema_->operator[](field_offset).is_variable_length(),
+               "field " + std::to_string(field_offset.get()) + " is not of a variable-length type");
+    auto& data = fields_data_[field_offset.get()];
+    auto& offsets = fields_offsets_[field_offset.get()];
+    auto row_count = row_count_opt_.value();
+    std::vector<std::string_view> views(row_count);
+    for (int64_t i = 0; i < row_count; ++i) {
+        views[i] = std::string_view(data.data() + offsets[i], offsets[i + 1] - offsets[i]);
+    }
+    return views;
+}
+
+SpanBase
+SegmentSealedImpl::chunk_valid_data_impl(FieldOffset field_offset, int64_t chunk_id) const {
+    std::shared_lock lck(mutex_);
+    AssertInfo(get_bit(field_data_ready_bitset_, field_offset),
+               "Can't get bitset element at " + std::to_string(field_offset.get()));
+    AssertInfo(schema_->operator[](field_offset).is_nullable(),
+               "field " + std::to_string(field_offset.get()) + " is not nullable");
+    SpanBase base(valid_data_[field_offset.get()].data(), row_count_opt_.value(), sizeof(bool));
+    return base;
+}
+
 const knowhere::Index*
 SegmentSealedImpl::chunk_index_impl(FieldOffset field_offset, int64_t chunk_id) const {
     AssertInfo(chunk_id == 0, "Chunk_id is not equal to 0");
@@ -248,32 +350,43 @@ SegmentSealedImpl::get_deleted_bitmap(int64_t del_barrier,
     // Sealed segment only has one chunk with chunk_id 0
     auto span = deleted_record_.uids_.get_span_base(0);
     auto uids_ptr = reinterpret_cast<const idx_t*>(span.data());
-    auto del_size = deleted_record_.reserved.load();
-    std::vector<idx_t> ids(del_size);
-    std::copy_n(uids_ptr, del_size, ids.data());
 
-    auto [uids, seg_offsets] = primary_key_index_->do_search_ids(ids);
-    for (int i = 0; i < uids.size(); ++i) {
-        bitmap->set(seg_offsets[i].get());
-    }
-    if (uids.size() == 0 || seg_offsets.size() == 0) {
-        return current;
-    }
+    // map the uid of the delete log to the offsets of the rows it deletes, which are all the versions of the entity
+    // inserted before the delete, the row inserted with the same timestamp (e.g. by upsert) is newer than the delete
+    auto deleted_offsets = [&](int64_t del_index) -> std::vector<int64_t> {
+        std::vector<int64_t> offsets;
+        auto del_ts = deleted_record_.timestamps_[del_index];
+        for (auto seg_offset : primary_key_index_->do_search_offsets(uids_ptr[del_index])) {
+            int64_t the_offset = seg_offset.get();
+            AssertInfo(the_offset >= 0 && the_offset < timestamps_.size(), "Seg offset is invalid");
+            if (timestamps_[the_offset] < del_ts) {
+                offsets.push_back(the_offset);
+            }
+        }
+        return offsets;
+    };
 
     if (del_barrier < old->del_barrier) {
+        std::unordered_set<idx_t> uids;
         for (auto del_index = del_barrier; del_index < old->del_barrier; ++del_index) {
-            int64_t the_offset = seg_offsets[del_index].get();
-            AssertInfo(the_offset >= 0, "Seg offset is invalid");
-            if (deleted_record_.timestamps_[del_index] < query_timestamp) {
+            for (auto the_offset : deleted_offsets(del_index)) {
                 bitmap->clear(the_offset);
             }
+            uids.insert(uids_ptr[del_index]);
+        }
+        // the older versions of the entities are still deleted by the deletes before the barrier
+        for (int64_t del_index = 0; del_index < del_barrier; ++del_index) {
+            if (uids.count(uids_ptr[del_index]) == 0) {
+                continue;
+            }
+            for (auto the_offset : deleted_offsets(del_index)) {
+                bitmap->set(the_offset);
+            }
         }
         return current;
     } else {
         for (auto del_index = old->del_barrier; del_index < del_barrier; ++del_index) {
-            int64_t the_offset = seg_offsets[del_index].get();
-            AssertInfo(the_offset >= 0, "Seg offset is invalid");
-            if (deleted_record_.timestamps_[del_index] < query_timestamp) {
+            for (auto the_offset : deleted_offsets(del_index)) {
                 bitmap->set(the_offset);
             }
         }
@@ -342,9 +455,19 @@ SegmentSealedImpl::vector_search(int64_t vec_count,
     auto row_count = row_count_opt_.value();
     auto chunk_data = fields_data_[field_offset.get()].data();
 
+    std::vector<float> float_query;
     auto sub_qr = [&] {
         if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
             return query::FloatSearchBruteForce(dataset, chunk_data, row_count, bitset);
+        } else if (field_meta.get_data_type() == DataType::VECTOR_FLOAT16 ||
+                   field_meta.get_data_type() == DataType::VECTOR_BFLOAT16) {
+            float_query = half_float_to_float(field_meta.get_data_type(), query_data, query_count * dataset.dim);
+            dataset.query_data = float_query.data();
+            return query::HalfFloatSearchBruteForce(dataset, chunk_data, row_count, bitset,
+                                                    field_meta.get_data_type());
+        } else if (field_meta.get_data_type() == DataType::VECTOR_SPARSE_FLOAT) {
+            auto rows = chunk_views(field_offset, 0);
+            return query::SparseFloatSearchBruteForce(dataset, rows.data(), row_count, bitset);
         } else {
             return query::BinarySearchBruteForce(dataset, chunk_data, row_count, bitset);
         }
@@ -379,6 +502,8 @@ SegmentSealedImpl::DropFieldData(const FieldId field_id) {
         std::unique_lock lck(mutex_);
         set_bit(field_data_ready_bitset_, field_offset, false);
         auto vec = std::move(fields_data_[field_offset.get()]);
+        auto offsets = std::move(fields_offsets_[field_offset.get()]);
+        auto valid_data = std::move(valid_data_[field_offset.get()]);
         lck.unlock();
 
         vec.clear();
@@ -424,6 +549,8 @@ SegmentSealedImpl::check_search(const query::Plan* plan) const {
 SegmentSealedImpl::SegmentSealedImpl(SchemaPtr schema)
     : schema_(schema),
       fields_data_(schema->size()),
+      fields_offsets_(schema->size()),
+      valid_data_(schema->size()),
       field_data_ready_bitset_(schema->size()),
       vecindex_ready_bitset_(schema->size()),
       scalar_indexings_(schema->size()) {
@@ -506,7 +633,9 @@ SegmentSealedImpl::bulk_subscript(FieldOffset field_offset,
         }
 
         case DataType::VECTOR_FLOAT:
-        case DataType::VECTOR_BINARY: {
+        case DataType::VECTOR_BINARY:
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16: {
             bulk_subscript_impl(field_meta.get_sizeof(), src_vec, seg_offsets, count, output);
             break;
         }
@@ -517,6 +646,26 @@ SegmentSealedImpl::bulk_subscript(FieldOffset field_offset,
     }
 }
 
+void
+SegmentSealedImpl::bulk_subscript_views(FieldOffset field_offset,
+                                        const int64_t* seg_offsets,
+                                        int64_t count,
+                                        std::string_view* output) const {
+    AssertInfo(schema_->operator[](field_offset).is_variable_length(),
+               "field " + std::to_string(field_offset.get()) + " is not of a variable-length type");
+    if (!get_bit(field_data_ready_bitset_, field_offset)) {
+        return;
+    }
+    auto& data = fields_data_[field_offset.get()];
+    auto& offsets = fields_offsets_[field_offset.get()];
+    for (int64_t i = 0; i < count; ++i) {
+        auto offset = seg_offsets[i];
+        output[i] = (offset == INVALID_SEG_OFFSET
+                         ? std::string_view()
+                         : std::string_view(data.data() + offsets[offset], offsets[offset + 1] - offsets[offset]));
+    }
+}
+
 bool
 SegmentSealedImpl::HasIndex(FieldId field_id) const {
     std::shared_lock lck(mutex_);
@@ -639,6 +788,20 @@ SegmentSealedImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, T
     bitset_chunk &= mask;
 }
 
+void
+SegmentSealedImpl::mask_with_expiration(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const {
+    auto expire_ts = get_expire_timestamp(schema_->get_ttl_seconds(), timestamp);
+    if (expire_ts == 0) {
+        return;
+    }
+    AssertInfo(this->timestamps_.size() == get_row_count(), "Timestamp size not equal to row count");
+    for (int64_t i = 0; i < bitset_chunk.size(); ++i) {
+        if (this->timestamps_[i] < expire_ts) {
+            bitset_chunk[i] = false;
+        }
+    }
+}
+
 SegmentSealedPtr
 CreateSealedSegment(SchemaPtr schema) {
     return std::make_unique<SegmentSealedImpl>(schema);
@@ -26,6 +26,7 @@
 #include <memory>
 #include <utility>
 #include <string>
+#include <string_view>
 
 namespace milvus::segcore {
 class SegmentSealedImpl : public SegmentSealed {
@@ -89,6 +90,12 @@ class SegmentSealedImpl : public SegmentSealed {
     SpanBase
     chunk_data_impl(FieldOffset field_offset, int64_t chunk_id) const override;
 
+    SpanBase
+    chunk_valid_data_impl(FieldOffset field_offset, int64_t chunk_id) const override;
+
+    std::vector<std::string_view>
+    chunk_views_impl(FieldOffset field_offset, int64_t chunk_id) const override;
+
     const knowhere::Index*
     chunk_index_impl(FieldOffset field_offset, int64_t chunk_id) const override;
 
@@ -102,6 +109,12 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     bulk_subscript(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count, void* output) const override;
 
+    void
+    bulk_subscript_views(FieldOffset field_offset,
+                         const int64_t* seg_offsets,
+                         int64_t count,
+                         std::string_view* output) const override;
+
     void
     check_search(const query::Plan* plan) const override;
 
@@ -109,6 +122,9 @@ class SegmentSealedImpl : public SegmentSealed {
     get_active_count(Timestamp ts) const override;
 
  private:
+    void
+    load_variable_length_field_data(FieldOffset field_offset, const LoadFieldDataInfo& info);
+
     template <typename T>
     static void
     bulk_subscript_impl(const void* src_raw, const int64_t* seg_offsets, int64_t count, void* dst_raw);
@@ -129,6 +145,9 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_expiration(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -179,6 +198,10 @@ class SegmentSealedImpl : public SegmentSealed {
     std::unique_ptr<ScalarIndexBase> primary_key_index_;
 
     std::vector<aligned_vector<char>> fields_data_;
+    // the offsets of the values of the variable-length fields in fields_data_, row_count + 1 for each field
+    std::vector<aligned_vector<int64_t>> fields_offsets_;
+    // the validity of the rows of the nullable fields
+    std::vector<FixedVector<bool>> valid_data_;
     mutable DeletedRecord deleted_record_;
 
     SealedIndexingRecord vecindexs_;
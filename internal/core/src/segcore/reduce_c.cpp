@@ -79,7 +79,7 @@ ReduceResultData(std::vector<SearchResult*>& search_results, int64_t nq, int64_t
     }
 
     std::vector<std::vector<int64_t>> search_records(num_segments);
-    std::unordered_set<int64_t> pk_set;
+    std::unordered_set<milvus::PkType> pk_set;
     int64_t skip_dup_cnt = 0;
 
     // reduce search results
@@ -110,14 +110,14 @@ ReduceResultData(std::vector<SearchResult*>& search_results, int64_t nq, int64_t
             std::sort(result_pairs.begin(), result_pairs.end(), std::greater<>());
             auto& pilot = result_pairs[0];
             auto index = pilot.index_;
-            int64_t curr_pk = pilot.primary_key_;
+            auto curr_pk = pilot.primary_key_;
             // remove duplicates
-            if (curr_pk == INVALID_ID || pk_set.count(curr_pk) == 0) {
+            if (!pilot.is_valid() || pk_set.count(curr_pk) == 0) {
                 pilot.search_result_->result_offsets_.push_back(curr_offset++);
                 // when inserted data are dirty, it's possible that primary keys are duplicated,
                 // in this case, "offset_" may be greater than "offset_rb_" (#10530)
                 search_records[index].push_back(pilot.offset_ < pilot.offset_rb_ ? pilot.offset_ : INVALID_OFFSET);
-                if (curr_pk != INVALID_ID) {
+                if (pilot.is_valid()) {
                     pk_set.insert(curr_pk);
                 }
             } else {
@@ -137,12 +137,12 @@ ReduceResultData(std::vector<SearchResult*>& search_results, int64_t nq, int64_t
             continue;
         }
 
-        std::vector<int64_t> primary_keys;
+        std::vector<milvus::PkType> primary_keys;
         std::vector<float> result_distances;
         std::vector<int64_t> internal_seg_offsets;
         for (int j = 0; j < search_records[i].size(); j++) {
             auto& offset = search_records[i][j];
-            primary_keys.push_back(offset != INVALID_OFFSET ? search_result->primary_keys_[offset] : INVALID_ID);
+            primary_keys.push_back(offset != INVALID_OFFSET ? search_result->primary_keys_[offset] : milvus::PkType{});
             result_distances.push_back(offset != INVALID_OFFSET ? search_result->result_distances_[offset] : MAXFLOAT);
             internal_seg_offsets.push_back(offset != INVALID_OFFSET ? search_result->internal_seg_offsets_[offset]
                                                                     : INVALID_SEG_OFFSET);
@@ -198,9 +198,11 @@ ReorganizeSearchResults(CMarshaledHits* c_marshaled_hits, CSearchResult* c_searc
         auto sr = (SearchResult*)c_search_results[0];
         auto topk = sr->topk_;
         auto num_queries = sr->num_queries_;
+        auto varchar_pk = ((milvus::segcore::SegmentInterface*)(sr->segment_))->is_varchar_primary_key();
 
         std::vector<float> result_distances(num_queries * topk);
         std::vector<std::vector<char>> row_datas(num_queries * topk);
+        std::vector<milvus::PkType> primary_keys(num_queries * topk);
 
         std::vector<int64_t> counts(num_segments);
         for (int i = 0; i < num_segments; i++) {
@@ -215,6 +217,7 @@ ReorganizeSearchResults(CMarshaledHits* c_marshaled_hits, CSearchResult* c_searc
                 auto loc = search_result->result_offsets_[j];
                 result_distances[loc] = search_result->result_distances_[j];
                 row_datas[loc] = search_result->row_data_[j];
+                primary_keys[loc] = search_result->primary_keys_[j];
             }
             counts[i] = size;
         }
@@ -236,7 +239,13 @@ ReorganizeSearchResults(CMarshaledHits* c_marshaled_hits, CSearchResult* c_searc
                 hits[m].add_scores(result_distances[result_offset]);
                 auto& row_data = row_datas[result_offset];
                 hits[m].add_row_data(row_data.data(), row_data.size());
-                hits[m].add_ids(*(int64_t*)row_data.data());
+                // the varchar primary keys are returned in str_ids, the invalid ones are empty strings
+                if (varchar_pk) {
+                    auto str_pk = std::get_if<std::string>(&primary_keys[result_offset]);
+                    hits[m].add_str_ids(str_pk != nullptr ? *str_pk : std::string());
+                } else {
+                    hits[m].add_ids(*(int64_t*)row_data.data());
+                }
             }
         }
 
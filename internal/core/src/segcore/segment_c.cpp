@@ -117,14 +117,14 @@ Insert(CSegmentInterface c_segment,
        const int64_t* row_ids,
        const uint64_t* timestamps,
        void* raw_data,
-       int sizeof_per_row,
+       int64_t raw_data_size,
        int64_t count) {
     try {
         auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
         milvus::segcore::RowBasedRawData dataChunk{};
 
         dataChunk.raw_data = raw_data;
-        dataChunk.sizeof_per_row = sizeof_per_row;
+        dataChunk.raw_data_size = raw_data_size;
         dataChunk.count = count;
         segment->Insert(reserved_offset, size, row_ids, timestamps, dataChunk);
         return milvus::SuccessCStatus();
@@ -148,12 +148,18 @@ CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,
        int64_t size,
-       const int64_t* row_ids,
+       const void* ids,
+       int64_t ids_size,
        const uint64_t* timestamps) {
     auto segment = (milvus::segcore::SegmentInterface*)c_segment;
 
     try {
-        auto res = segment->Delete(reserved_offset, size, row_ids, timestamps);
+        milvus::IdArray pks;
+        auto suc = pks.ParseFromArray(ids, ids_size);
+        AssertInfo(suc, "unmarshal primary keys failed");
+        auto row_ids = segment->PrimaryKeysToIds(pks);
+        AssertInfo(row_ids.size() == size, "The size of primary keys is not equal to delete size");
+        auto res = segment->Delete(reserved_offset, size, row_ids.data(), timestamps);
         return milvus::SuccessCStatus();
     } catch (std::exception& e) {
         return milvus::FailureCStatus(UnexpectedError, e.what());
@@ -175,7 +181,8 @@ LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_in
         auto segment = dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
         AssertInfo(segment != nullptr, "segment conversion failed");
         auto load_info =
-            LoadFieldDataInfo{load_field_data_info.field_id, load_field_data_info.blob, load_field_data_info.row_count};
+            LoadFieldDataInfo{load_field_data_info.field_id, load_field_data_info.blob, load_field_data_info.row_count,
+                              load_field_data_info.valid_data, load_field_data_info.offsets};
         segment->LoadFieldData(load_info);
         return milvus::SuccessCStatus();
     } catch (std::exception& e) {
@@ -189,8 +196,9 @@ LoadDeletedRecord(CSegmentInterface c_segment, CLoadDeletedRecordInfo deleted_re
         auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
         auto segment = dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
         AssertInfo(segment != nullptr, "segment conversion failed");
-        auto load_info = LoadDeletedRecordInfo{deleted_record_info.timestamps, deleted_record_info.primary_keys,
-                                               deleted_record_info.row_count};
+        auto load_info =
+            LoadDeletedRecordInfo{deleted_record_info.timestamps, deleted_record_info.primary_keys,
+                                  deleted_record_info.primary_keys_size, deleted_record_info.row_count};
         segment->LoadDeletedRecord(load_info);
         return milvus::SuccessCStatus();
     } catch (std::exception& e) {
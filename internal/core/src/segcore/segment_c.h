@@ -64,17 +64,19 @@ Insert(CSegmentInterface c_segment,
        const int64_t* row_ids,
        const uint64_t* timestamps,
        void* raw_data,
-       int sizeof_per_row,
+       int64_t raw_data_size,
        int64_t count);
 
 CStatus
 PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset);
 
+// ids is the serialized proto::schema::IDs of the primary keys to delete
 CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,
        int64_t size,
-       const int64_t* row_ids,
+       const void* ids,
+       int64_t ids_size,
        const uint64_t* timestamps);
 
 int64_t
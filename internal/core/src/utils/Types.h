@@ -44,8 +44,14 @@ enum class DataType {
 
     STRING = 20,
 
+    ARRAY = 22,
+    JSON = 23,
+
     VECTOR_BINARY = 100,
     VECTOR_FLOAT = 101,
+    VECTOR_FLOAT16 = 102,
+    VECTOR_BFLOAT16 = 103,
+    VECTOR_SPARSE_FLOAT = 104,
 };
 
 }  // namespace milvus::engine
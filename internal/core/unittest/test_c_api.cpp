@@ -208,7 +208,7 @@ TEST(CApiTest, InsertTest) {
     int64_t offset;
     PreInsert(segment, N, &offset);
 
-    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)(line_sizeof * N), N);
     assert(res.error_code == Success);
 
     DeleteCollection(collection);
@@ -219,12 +219,17 @@ TEST(CApiTest, DeleteTest) {
     auto collection = NewCollection(get_default_schema_config());
     auto segment = NewSegment(collection, 0, Growing);
 
-    long delete_row_ids[] = {100000, 100001, 100002};
+    milvus::proto::schema::IDs delete_ids;
+    for (int64_t id : {100000, 100001, 100002}) {
+        delete_ids.mutable_int_id()->add_data(id);
+    }
+    auto delete_ids_blob = delete_ids.SerializeAsString();
     unsigned long delete_timestamps[] = {0, 0, 0};
 
     auto offset = PreDelete(segment, 3);
 
-    auto del_res = Delete(segment, offset, 3, delete_row_ids, delete_timestamps);
+    auto del_res =
+        Delete(segment, offset, 3, delete_ids_blob.data(), delete_ids_blob.size(), delete_timestamps);
     assert(del_res.error_code == Success);
 
     DeleteCollection(collection);
@@ -247,7 +252,7 @@ TEST(CApiTest, SearchTest) {
     int64_t offset;
     PreInsert(segment, N, &offset);
 
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)(line_sizeof * N), N);
     ASSERT_EQ(ins_res.error_code, Success);
 
     const char* dsl_string = R"(
@@ -310,7 +315,7 @@ TEST(CApiTest, SearchTestWithExpr) {
     int64_t offset;
     PreInsert(segment, N, &offset);
 
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)(line_sizeof * N), N);
     ASSERT_EQ(ins_res.error_code, Success);
 
     const char* serialized_expr_plan = R"(vector_anns: <
@@ -366,7 +371,7 @@ TEST(CApiTest, GetMemoryUsageInBytesTest) {
     int64_t offset;
     PreInsert(segment, N, &offset);
 
-    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)(line_sizeof * N), N);
     assert(res.error_code == Success);
 
     auto memory_usage_size = GetMemoryUsageInBytes(segment);
@@ -381,12 +386,17 @@ TEST(CApiTest, GetDeletedCountTest) {
     auto collection = NewCollection(get_default_schema_config());
     auto segment = NewSegment(collection, 0, Growing);
 
-    long delete_row_ids[] = {100000, 100001, 100002};
+    milvus::proto::schema::IDs delete_ids;
+    for (int64_t id : {100000, 100001, 100002}) {
+        delete_ids.mutable_int_id()->add_data(id);
+    }
+    auto delete_ids_blob = delete_ids.SerializeAsString();
     unsigned long delete_timestamps[] = {0, 0, 0};
 
     auto offset = PreDelete(segment, 3);
 
-    auto del_res = Delete(segment, offset, 3, delete_row_ids, delete_timestamps);
+    auto del_res =
+        Delete(segment, offset, 3, delete_ids_blob.data(), delete_ids_blob.size(), delete_timestamps);
     assert(del_res.error_code == Success);
 
     // TODO: assert(deleted_count == len(delete_row_ids))
@@ -407,7 +417,7 @@ TEST(CApiTest, GetRowCountTest) {
 
     int64_t offset;
     PreInsert(segment, N, &offset);
-    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)(line_sizeof * N), N);
     assert(res.error_code == Success);
 
     auto row_count = GetRowCount(segment);
@@ -503,7 +513,7 @@ TEST(CApiTest, ReduceRemoveDuplicates) {
 
     int64_t offset;
     PreInsert(segment, N, &offset);
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)(line_sizeof * N), N);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"(
@@ -594,7 +604,7 @@ TEST(CApiTest, Reduce) {
 
     int64_t offset;
     PreInsert(segment, N, &offset);
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)(line_sizeof * N), N);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"(
@@ -677,7 +687,7 @@ TEST(CApiTest, ReduceSearchWithExpr) {
 
     int64_t offset;
     PreInsert(segment, N, &offset);
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)(line_sizeof * N), N);
     assert(ins_res.error_code == Success);
 
     const char* serialized_expr_plan = R"(vector_anns: <
@@ -852,7 +862,7 @@ TEST(CApiTest, Indexing_Without_Predicate) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.raw_data_size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"(
@@ -976,7 +986,7 @@ TEST(CApiTest, Indexing_Expr_Without_Predicate) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.raw_data_size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* serialized_expr_plan = R"(vector_anns: <
@@ -1095,7 +1105,7 @@ TEST(CApiTest, Indexing_With_float_Predicate_Range) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.raw_data_size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"({
@@ -1234,7 +1244,7 @@ TEST(CApiTest, Indexing_Expr_With_float_Predicate_Range) {
         int64_t offset;
         PreInsert(segment, N, &offset);
         auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                              dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                              dataset.raw_.raw_data, dataset.raw_.raw_data_size, dataset.raw_.count);
         assert(ins_res.error_code == Success);
     }
 
@@ -1385,7 +1395,7 @@ TEST(CApiTest, Indexing_With_float_Predicate_Term) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.raw_data_size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"({
@@ -1521,7 +1531,7 @@ TEST(CApiTest, Indexing_Expr_With_float_Predicate_Term) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.raw_data_size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* serialized_expr_plan = R"(
@@ -1666,7 +1676,7 @@ TEST(CApiTest, Indexing_With_binary_Predicate_Range) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.raw_data_size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"({
@@ -1804,7 +1814,7 @@ TEST(CApiTest, Indexing_Expr_With_binary_Predicate_Range) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.raw_data_size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* serialized_expr_plan = R"(vector_anns: <
@@ -1955,7 +1965,7 @@ TEST(CApiTest, Indexing_With_binary_Predicate_Term) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.raw_data_size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"({
@@ -2097,7 +2107,7 @@ TEST(CApiTest, Indexing_Expr_With_binary_Predicate_Term) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.raw_data_size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* serialized_expr_plan = R"(vector_anns: <
@@ -581,3 +581,185 @@ TEST(Expr, TestCompare) {
         }
     }
 }
+
+TEST(Expr, TestNull) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto age_meta = FieldMeta(FieldName("age"), FieldId(101), DataType::INT64);
+    age_meta.set_nullable(true);
+    schema->AddField(std::move(age_meta));
+    auto age_offset = schema->get_offset(FieldName("age"));
+
+    // the value of the nullable field is led by a validity byte in a row
+    int N = 1000;
+    auto sizeof_per_row = schema->get_total_sizeof();
+    ASSERT_EQ(sizeof_per_row, int(sizeof(float) * 16 + 1 + sizeof(int64_t)));
+    std::vector<char> rows(N * sizeof_per_row);
+    std::vector<bool> valid_col(N);
+    std::vector<int64_t> row_ids(N);
+    std::vector<Timestamp> timestamps(N);
+    for (int i = 0; i < N; ++i) {
+        auto row = rows.data() + i * sizeof_per_row + sizeof(float) * 16;
+        valid_col[i] = i % 3 != 0;
+        row[0] = valid_col[i];
+        int64_t age = i;
+        memcpy(row + 1, &age, sizeof(age));
+        row_ids[i] = i;
+        timestamps[i] = i;
+    }
+    RowBasedRawData raw_data{rows.data(), sizeof_per_row * N, N};
+    auto seg = CreateGrowingSegment(schema);
+    seg->PreInsert(N);
+    seg->Insert(0, N, row_ids.data(), timestamps.data(), raw_data);
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto op : {NullExpr::OpType::IsNull, NullExpr::OpType::IsNotNull}) {
+        NullExpr expr;
+        expr.field_offset_ = age_offset;
+        expr.data_type_ = DataType::INT64;
+        expr.op_type_ = op;
+        auto final = visitor.call_child(expr);
+        EXPECT_EQ(final.size(), N);
+        for (int i = 0; i < N; ++i) {
+            ASSERT_EQ(final[i], op == NullExpr::OpType::IsNull ? !valid_col[i] : valid_col[i]) << i;
+        }
+    }
+
+    // a null value never satisfies a predicate
+    std::string dsl_string = R"({
+        "bool": {
+            "must": [
+                {
+                    "range": {
+                        "age": {
+                            "GE": 500
+                        }
+                    }
+                },
+                {
+                    "vector": {
+                        "fakevec": {
+                            "metric_type": "L2",
+                            "params": {
+                                "nprobe": 10
+                            },
+                            "query": "$0",
+                            "topk": 10,
+                            "round_decimal": 3
+                        }
+                    }
+                }
+            ]
+        }
+    })";
+    auto plan = CreatePlan(*schema, dsl_string);
+    auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+    EXPECT_EQ(final.size(), N);
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(final[i], valid_col[i] && i >= 500) << i;
+    }
+}
+
+TEST(Expr, TestJSON) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto meta_meta = FieldMeta(FieldName("meta"), FieldId(101), DataType::JSON);
+    meta_meta.set_nullable(true);
+    schema->AddField(std::move(meta_meta));
+    auto meta_offset = schema->get_offset(FieldName("meta"));
+
+    // a JSON document is the uint32 length followed by the serialized bytes in a row, a null document is empty
+    int N = 1000;
+    auto doc_of = [](int i) -> std::string {
+        switch (i % 4) {
+            case 0:
+                return "";
+            case 1:
+                return R"({"brand": "acme", "price": )" + std::to_string(i) + "}";
+            case 2:
+                return R"({"brand": "other", "price": )" + std::to_string(i) + ".5}";
+            default:
+                return R"({"tags": [)" + std::to_string(i) + "]}";
+        }
+    };
+    std::vector<char> rows;
+    std::vector<int64_t> row_ids(N);
+    std::vector<Timestamp> timestamps(N);
+    for (int i = 0; i < N; ++i) {
+        rows.resize(rows.size() + sizeof(float) * 16);
+        rows.push_back(i % 4 != 0);
+        auto doc = doc_of(i);
+        uint32_t length = doc.size();
+        rows.insert(rows.end(), reinterpret_cast<char*>(&length), reinterpret_cast<char*>(&length) + sizeof(length));
+        rows.insert(rows.end(), doc.begin(), doc.end());
+        row_ids[i] = i;
+        timestamps[i] = i;
+    }
+    RowBasedRawData raw_data{rows.data(), static_cast<int64_t>(rows.size()), N};
+    auto seg = CreateGrowingSegment(schema);
+    seg->PreInsert(N);
+    seg->Insert(0, N, row_ids.data(), timestamps.data(), raw_data);
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    auto unary_range = [&](std::vector<std::string> nested_path, OpType op, JSONValue value) {
+        UnaryRangeExprImpl<JSONValue> expr;
+        expr.field_offset_ = meta_offset;
+        expr.data_type_ = DataType::JSON;
+        expr.nested_path_ = std::move(nested_path);
+        expr.op_type_ = op;
+        expr.value_ = std::move(value);
+        return visitor.call_child(expr);
+    };
+
+    // the documents without the key, of other types under the key and the null documents never match
+    auto final = unary_range({"brand"}, OpType::Equal, std::string("acme"));
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(final[i], i % 4 == 1) << i;
+    }
+    final = unary_range({"brand"}, OpType::NotEqual, std::string("acme"));
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(final[i], i % 4 == 2) << i;
+    }
+    final = unary_range({"price"}, OpType::Equal, std::string("10"));
+    ASSERT_TRUE(final.none());
+
+    // integers and floats are compared by their numeric values
+    final = unary_range({"price"}, OpType::LessThan, int64_t(10));
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(final[i], (i % 4 == 1 || i % 4 == 2) && i < 10) << i;
+    }
+    final = unary_range({"price"}, OpType::GreaterEqual, 500.5);
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(final[i], (i % 4 == 1 && i >= 501) || (i % 4 == 2 && i >= 500)) << i;
+    }
+
+    BinaryRangeExprImpl<JSONValue> binary_range;
+    binary_range.field_offset_ = meta_offset;
+    binary_range.data_type_ = DataType::JSON;
+    binary_range.nested_path_ = {"price"};
+    binary_range.lower_inclusive_ = true;
+    binary_range.upper_inclusive_ = false;
+    binary_range.lower_value_ = int64_t(100);
+    binary_range.upper_value_ = 200.5;
+    final = visitor.call_child(binary_range);
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(final[i], (i % 4 == 1 || i % 4 == 2) && i >= 100 && i < 200) << i;
+    }
+
+    // an integer key indexes into an array
+    TermExprImpl<JSONValue> term;
+    term.field_offset_ = meta_offset;
+    term.data_type_ = DataType::JSON;
+    term.nested_path_ = {"tags", "0"};
+    term.terms_ = {int64_t(3), std::string("7"), 11.0};
+    final = visitor.call_child(term);
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(final[i], i == 3 || i == 11) << i;
+    }
+}
@@ -200,6 +200,60 @@ TEST(Retrieve2, LargeTimestamp) {
     }
 }
 
+TEST(Retrieve, Limit) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    auto fid_age = schema->AddDebugField("age", DataType::INT32);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 100;
+    int64_t limit = 5;
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+    auto i64_col = dataset.get_col<int64_t>(0);
+    auto age_col = dataset.get_col<int32_t>(2);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->limit_ = limit;
+    plan->field_offsets_ = std::vector<FieldOffset>{FieldOffset(0), FieldOffset(2)};
+
+    // ordered by the primary key
+    auto retrieve_results = segment->Retrieve(plan.get(), N);
+    ASSERT_EQ(retrieve_results->offset_size(), limit);
+    auto pks = retrieve_results->fields_data(0).scalars().long_data();
+    std::vector<int64_t> expected(i64_col.begin(), i64_col.end());
+    std::sort(expected.begin(), expected.end());
+    for (int i = 0; i < limit; ++i) {
+        ASSERT_EQ(pks.data(i), expected[i]);
+    }
+
+    // ordered by the age descending, and then by the primary key
+    plan->plan_node_->order_by_ = FieldOffset(2);
+    plan->plan_node_->descending_ = true;
+    retrieve_results = segment->Retrieve(plan.get(), N);
+    ASSERT_EQ(retrieve_results->offset_size(), limit);
+    pks = retrieve_results->fields_data(0).scalars().long_data();
+    auto ages = retrieve_results->fields_data(1).scalars().int_data();
+    std::vector<std::pair<int32_t, int64_t>> rows;
+    for (int64_t i = 0; i < N; ++i) {
+        rows.emplace_back(-age_col[i], i64_col[i]);
+    }
+    std::sort(rows.begin(), rows.end());
+    for (int i = 0; i < limit; ++i) {
+        ASSERT_EQ(ages.data(i), -rows[i].first);
+        ASSERT_EQ(pks.data(i), rows[i].second);
+    }
+
+    // no rows are truncated below the limit
+    plan->plan_node_->limit_ = N + 1;
+    retrieve_results = segment->Retrieve(plan.get(), N);
+    ASSERT_EQ(retrieve_results->offset_size(), N);
+}
+
 TEST(GetEntityByIds, PrimaryKey) {
     auto schema = std::make_shared<Schema>();
     auto fid_64 = schema->AddDebugField("counter_i64", DataType::INT64);
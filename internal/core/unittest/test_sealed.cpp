@@ -14,6 +14,7 @@
 #include "knowhere/index/vector_index/IndexIVF.h"
 #include "knowhere/index/vector_index/VecIndex.h"
 #include "knowhere/index/vector_index/adapter/VectorAdapter.h"
+#include "query/ExprImpl.h"
 #include "segcore/SegmentSealedImpl.h"
 #include "test_utils/DataGen.h"
 
@@ -370,10 +371,14 @@ TEST(Sealed, Delete) {
     SealedLoader(dataset, *segment);
 
     int64_t row_count = 5;
-    std::vector<idx_t> pks{1, 2, 3, 4, 5};
+    milvus::proto::schema::IDs pks;
+    for (int64_t pk : {1, 2, 3, 4, 5}) {
+        pks.mutable_int_id()->add_data(pk);
+    }
+    auto pks_blob = pks.SerializeAsString();
     std::vector<Timestamp> timestamps{10, 10, 10, 10, 10};
 
-    LoadDeletedRecordInfo info = {timestamps.data(), pks.data(), row_count};
+    LoadDeletedRecordInfo info = {timestamps.data(), pks_blob.data(), (int64_t)pks_blob.size(), row_count};
     segment->LoadDeletedRecord(info);
 
     std::vector<uint8_t> tmp_block{0, 0};
@@ -389,3 +394,101 @@ TEST(Sealed, Delete) {
     segment->Delete(reserved_offset, new_count, reinterpret_cast<const int64_t*>(new_pks.data()),
                     reinterpret_cast<const Timestamp*>(new_timestamps.data()));
 }
+
+TEST(Sealed, DeleteAfterUpsert) {
+    auto dim = 16;
+    auto N = 10;
+    auto schema = std::make_shared<Schema>();
+    auto counter_id = schema->AddDebugField("counter", DataType::INT64);
+    auto fakevec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    // the row with pk i is inserted at timestamp i
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+
+    // pk 2 is deleted after its insert, pk 7 is upserted by the delete and the insert sharing timestamp 7,
+    // so the delete is written into the deltalog of the segment holding the new row, pk 8 is inserted after its delete
+    int64_t row_count = 3;
+    milvus::proto::schema::IDs pks;
+    for (int64_t pk : {2, 8, 7}) {
+        pks.mutable_int_id()->add_data(pk);
+    }
+    auto pks_blob = pks.SerializeAsString();
+    std::vector<Timestamp> timestamps{5, 5, 7};
+    LoadDeletedRecordInfo info = {timestamps.data(), pks_blob.data(), (int64_t)pks_blob.size(), row_count};
+    segment->LoadDeletedRecord(info);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>();
+    term_expr->field_offset_ = FieldOffset(0);
+    term_expr->data_type_ = DataType::INT64;
+    term_expr->terms_ = {2, 7, 8};
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->field_offsets_ = std::vector<FieldOffset>{FieldOffset(0)};
+
+    auto retrieve_results = segment->Retrieve(plan.get(), 100);
+    auto field0_data = retrieve_results->fields_data(0).scalars().long_data();
+    std::vector<int64_t> result_pks(field0_data.data().begin(), field0_data.data().end());
+    std::sort(result_pks.begin(), result_pks.end());
+    ASSERT_EQ(result_pks, std::vector<int64_t>({7, 8}));
+}
+
+TEST(Sealed, DeleteVersionsOfUpsert) {
+    auto dim = 16;
+    auto N = 10;
+    auto schema = std::make_shared<Schema>();
+    auto counter_id = schema->AddDebugField("counter", DataType::INT64);
+    auto fakevec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, MetricType::METRIC_L2);
+    auto version_id = schema->AddDebugField("version", DataType::INT64);
+    schema->set_primary_key(FieldOffset(0));
+
+    // pk 100 is inserted at timestamp 3 and upserted at timestamps 5 and 8, all the versions are flushed into
+    // the segment, each upsert deletes the older versions with its timestamp
+    auto dataset = DataGen(schema, N);
+    auto pk_col = dataset.get_mutable_col<int64_t>(0);
+    auto version_col = dataset.get_mutable_col<int64_t>(2);
+    std::vector<int64_t> version_offsets{3, 5, 8};
+    for (int64_t i = 0; i < version_offsets.size(); ++i) {
+        pk_col[version_offsets[i]] = 100;
+        version_col[version_offsets[i]] = i + 1;
+        dataset.timestamps_[version_offsets[i]] = version_offsets[i];
+    }
+    auto segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+
+    int64_t row_count = 2;
+    milvus::proto::schema::IDs pks;
+    for (int64_t pk : {100, 100}) {
+        pks.mutable_int_id()->add_data(pk);
+    }
+    auto pks_blob = pks.SerializeAsString();
+    std::vector<Timestamp> timestamps{5, 8};
+    LoadDeletedRecordInfo info = {timestamps.data(), pks_blob.data(), (int64_t)pks_blob.size(), row_count};
+    segment->LoadDeletedRecord(info);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>();
+    term_expr->field_offset_ = FieldOffset(0);
+    term_expr->data_type_ = DataType::INT64;
+    term_expr->terms_ = {100};
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->field_offsets_ = std::vector<FieldOffset>{FieldOffset(0), FieldOffset(2)};
+
+    auto retrieve_versions = [&](Timestamp timestamp) {
+        auto retrieve_results = segment->Retrieve(plan.get(), timestamp);
+        auto versions_data = retrieve_results->fields_data(1).scalars().long_data();
+        std::vector<int64_t> versions(versions_data.data().begin(), versions_data.data().end());
+        std::sort(versions.begin(), versions.end());
+        return versions;
+    };
+    // only the latest version is visible, the middle one is deleted by the second upsert as well
+    ASSERT_EQ(retrieve_versions(100), std::vector<int64_t>({3}));
+    // the first version is still deleted by the first upsert when the second one is rolled back
+    auto versions = retrieve_versions(6);
+    ASSERT_EQ(std::count(versions.begin(), versions.end(), 1), 0);
+    ASSERT_EQ(std::count(versions.begin(), versions.end(), 2), 1);
+}
@@ -92,7 +92,7 @@ TEST(SegmentCoreTest, MockTest) {
     // auto index_meta = std::make_shared<IndexMeta>(schema);
     auto segment = CreateGrowingSegment(schema);
 
-    RowBasedRawData data_chunk{raw_data.data(), (int)line_sizeof, N};
+    RowBasedRawData data_chunk{raw_data.data(), (int64_t)(line_sizeof * N), N};
     auto offset = segment->PreInsert(N);
     segment->Insert(offset, N, uids.data(), timestamps.data(), data_chunk);
     SearchResult search_result;
@@ -81,7 +81,7 @@ GeneratedData::generate_rows(int64_t N, SchemaPtr schema) {
     }
     rows_ = std::move(result);
     raw_.raw_data = rows_.data();
-    raw_.sizeof_per_row = schema->get_total_sizeof();
+    raw_.raw_data_size = rows_.size();
     raw_.count = N;
 }
 
@@ -24,7 +24,7 @@ func Test_greedyMergeCompaction(t *testing.T) {
 					{SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 1, MaxRowNum: 100, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}}},
 					{SegmentInfo: &datapb.SegmentInfo{ID: 2, NumOfRows: 1, MaxRowNum: 100, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log3"}}}}},
 				},
-				&timetravel{1000},
+				&timetravel{time: 1000},
 			},
 			[]*datapb.CompactionPlan{
 				{
@@ -46,7 +46,7 @@ func Test_greedyMergeCompaction(t *testing.T) {
 					{SegmentInfo: &datapb.SegmentInfo{ID: 2, NumOfRows: 99, MaxRowNum: 100, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}}}},
 					{SegmentInfo: &datapb.SegmentInfo{ID: 3, NumOfRows: 99, MaxRowNum: 100, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log3"}}}}},
 				},
-				&timetravel{1000},
+				&timetravel{time: 1000},
 			},
 			[]*datapb.CompactionPlan{
 				{
@@ -68,7 +68,7 @@ func Test_greedyMergeCompaction(t *testing.T) {
 					{SegmentInfo: &datapb.SegmentInfo{ID: 3, NumOfRows: 50, MaxRowNum: 100, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log3"}}}}},
 					{SegmentInfo: &datapb.SegmentInfo{ID: 4, NumOfRows: 50, MaxRowNum: 100, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log4"}}}}},
 				},
-				&timetravel{1000},
+				&timetravel{time: 1000},
 			},
 			[]*datapb.CompactionPlan{
 				{
@@ -96,7 +96,7 @@ func Test_greedyMergeCompaction(t *testing.T) {
 					{SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 50, MaxRowNum: 100, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}}},
 					{SegmentInfo: &datapb.SegmentInfo{ID: 2, NumOfRows: 51, MaxRowNum: 100, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log3"}}}}},
 				},
-				&timetravel{1000},
+				&timetravel{time: 1000},
 			},
 			[]*datapb.CompactionPlan{},
 		},
@@ -5,25 +5,39 @@ import (
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"go.uber.org/zap"
 )
 
 const (
-	signalBufferSize                = 100
-	maxLittleSegmentNum             = 10
-	maxCompactionTimeoutInSeconds   = 60
-	singleCompactionRatioThreshold  = 0.2
-	singleCompactionDeltaLogMaxSize = 10 * 1024 * 1024 //10MiB
-	globalCompactionInterval        = 60 * time.Second
-	timetravelRange                 = 5 * 24 * time.Hour
+	signalBufferSize                      = 100
+	maxLittleSegmentNum                   = 10
+	maxCompactionTimeoutInSeconds         = 60
+	singleCompactionRatioThreshold        = 0.2
+	singleCompactionDeltaLogMaxSize       = 10 * 1024 * 1024 //10MiB
+	singleCompactionExpiredRatioThreshold = 0.5
+	globalCompactionInterval              = 60 * time.Second
+	timetravelRange                       = common.TimetravelRange
 )
 
+// timetravel is the timestamp the compaction keeps the data visible at, pinned holds the earlier timestamps
+// of the collections pinned by the query iterators
 type timetravel struct {
-	time Timestamp
+	time   Timestamp
+	pinned map[UniqueID]Timestamp
+}
+
+// forCollection returns the timetravel of the compaction of the collection
+func (tt *timetravel) forCollection(collectionID UniqueID) *timetravel {
+	if ts, ok := tt.pinned[collectionID]; ok && ts < tt.time {
+		return &timetravel{time: ts}
+	}
+	return &timetravel{time: tt.time}
 }
 
 type trigger interface {
@@ -116,7 +130,7 @@ func (t *compactionTrigger) startGlobalCompactionLoop() {
 			return
 		case <-t.globalTrigger.C:
 			cctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			tt, err := getTimetravelReverseTime(cctx, t.allocator)
+			tt, err := getTimetravelReverseTime(cctx, t.allocator, t.meta.client)
 			if err != nil {
 				log.Warn("unbale to get compaction timetravel", zap.Error(err))
 				cancel()
@@ -314,7 +328,7 @@ func (t *compactionTrigger) mergeCompaction(segments []*SegmentInfo, signal *com
 		return nil
 	}
 
-	plans := t.mergeCompactionPolicy.generatePlan(segments, signal.timetravel)
+	plans := t.mergeCompactionPolicy.generatePlan(segments, signal.timetravel.forCollection(segments[0].GetCollectionID()))
 	if len(plans) == 0 {
 		return nil
 	}
@@ -324,6 +338,7 @@ func (t *compactionTrigger) mergeCompaction(segments []*SegmentInfo, signal *com
 		if !isForce && t.compactionHandler.isFull() {
 			return nil
 		}
+		plan.CollectionTtl = t.getCollectionTTL(segments[0].GetCollectionID())
 
 		if err := t.fillOriginPlan(plan); err != nil {
 			log.Warn("failed to fill plan", zap.Error(err))
@@ -393,7 +408,45 @@ func (t *compactionTrigger) shouldDoSingleCompaction(segment *SegmentInfo, timet
 	}
 
 	// currently delta log size and delete ratio policy is applied
-	return float32(totalDeletedRows)/float32(segment.NumOfRows) >= singleCompactionRatioThreshold || totalDeleteLogSize > singleCompactionDeltaLogMaxSize
+	if float32(totalDeletedRows)/float32(segment.NumOfRows) >= singleCompactionRatioThreshold || totalDeleteLogSize > singleCompactionDeltaLogMaxSize {
+		return true
+	}
+
+	// the segment dominated by the rows expired at the timetravel is compacted to drop them
+	ttl := t.getCollectionTTL(segment.GetCollectionID())
+	if ttl > 0 && segment.GetState() == commonpb.SegmentState_Flushed {
+		expireTs := tsoutil.AddPhysicalTimeOnTs(-ttl*1000, timetravel.time)
+		return estimateExpiredRatio(segment, expireTs) >= singleCompactionExpiredRatioThreshold
+	}
+	return false
+}
+
+// getCollectionTTL returns the ttl in seconds of the collection, 0 if its entities never expire
+func (t *compactionTrigger) getCollectionTTL(collectionID UniqueID) int64 {
+	return t.meta.GetCollection(collectionID).GetSchema().GetTtlSeconds()
+}
+
+// estimateExpiredRatio estimates the ratio of the rows of the segment inserted before expireTs, the insert binlogs have
+// no time range info, so the rows are assumed to be inserted evenly between the start position and the dml position
+func estimateExpiredRatio(segment *SegmentInfo, expireTs Timestamp) float64 {
+	startTs := segment.GetStartPosition().GetTimestamp()
+	endTs := segment.GetDmlPosition().GetTimestamp()
+	if endTs == 0 {
+		endTs = segment.GetLastExpireTime()
+	}
+	if expireTs <= startTs {
+		return 0
+	}
+	if expireTs >= endTs {
+		return 1
+	}
+	start, _ := tsoutil.ParseHybridTs(startTs)
+	end, _ := tsoutil.ParseHybridTs(endTs)
+	expire, _ := tsoutil.ParseHybridTs(expireTs)
+	if end <= start {
+		return 1
+	}
+	return float64(expire-start) / float64(end-start)
 }
 
 func (t *compactionTrigger) globalSingleCompaction(segments []*SegmentInfo, isForce bool, signal *compactionSignal) []*datapb.CompactionPlan {
@@ -420,14 +473,16 @@ func (t *compactionTrigger) singleCompaction(segment *SegmentInfo, isForce bool,
 		return nil, nil
 	}
 
-	if !isForce && !t.shouldDoSingleCompaction(segment, signal.timetravel) {
+	timetravel := signal.timetravel.forCollection(segment.GetCollectionID())
+	if !isForce && !t.shouldDoSingleCompaction(segment, timetravel) {
 		return nil, nil
 	}
 
-	plan := t.singleCompactionPolicy.generatePlan(segment, signal.timetravel)
+	plan := t.singleCompactionPolicy.generatePlan(segment, timetravel)
 	if plan == nil {
 		return nil, nil
 	}
+	plan.CollectionTtl = t.getCollectionTTL(segment.GetCollectionID())
 
 	if err := t.fillOriginPlan(plan); err != nil {
 		return nil, err
@@ -9,6 +9,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -345,7 +347,7 @@ func Test_compactionTrigger_triggerCompaction(t *testing.T) {
 				2,
 			},
 			args{
-				&timetravel{200},
+				&timetravel{time: 200},
 			},
 			false,
 			[]*datapb.CompactionPlan{
@@ -759,3 +761,56 @@ func Test_newCompactionTrigger(t *testing.T) {
 		})
 	}
 }
+
+func Test_compactionTrigger_expiredSingleCompaction(t *testing.T) {
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:             101,
+			CollectionID:   1,
+			PartitionID:    10,
+			InsertChannel:  "test_chan_01",
+			NumOfRows:      10000,
+			State:          commonpb.SegmentState_Flushed,
+			MaxRowNum:      12000,
+			LastExpireTime: tsoutil.ComposeTS(1060000, 0),
+			StartPosition:  &internalpb.MsgPosition{Timestamp: tsoutil.ComposeTS(1000000, 0)},
+			DmlPosition:    &internalpb.MsgPosition{Timestamp: tsoutil.ComposeTS(1060000, 0)},
+		},
+	}
+	m := &meta{
+		collections: map[UniqueID]*datapb.CollectionInfo{
+			1: {ID: 1, Schema: &schemapb.CollectionSchema{TtlSeconds: 60}},
+		},
+		segments: &SegmentsInfo{map[int64]*SegmentInfo{101: segment}},
+	}
+	handler := &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 1)}
+	trigger := newCompactionTrigger(m, handler, newMockAllocator())
+
+	// the rows inserted before 1020s are expired at 1080s, which are a third of the segment
+	assert.InDelta(t, 1.0/3, estimateExpiredRatio(segment, tsoutil.ComposeTS(1020000, 0)), 1e-6)
+	assert.Equal(t, float64(0), estimateExpiredRatio(segment, tsoutil.ComposeTS(900000, 0)))
+	assert.Equal(t, float64(1), estimateExpiredRatio(segment, tsoutil.ComposeTS(1100000, 0)))
+
+	assert.False(t, trigger.shouldDoSingleCompaction(segment, &timetravel{time: tsoutil.ComposeTS(1080000, 0)}))
+	assert.True(t, trigger.shouldDoSingleCompaction(segment, &timetravel{time: tsoutil.ComposeTS(1100000, 0)}))
+
+	signal := &compactionSignal{id: 1, segmentID: 101, timetravel: &timetravel{time: tsoutil.ComposeTS(1100000, 0)}}
+	plan, err := trigger.singleCompaction(segment, false, signal)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60), plan.GetCollectionTtl())
+	<-handler.spyChan
+
+	// the query iterators hold back the compaction of their collections only
+	signal.timetravel.pinned = map[UniqueID]Timestamp{1: tsoutil.ComposeTS(1080000, 0)}
+	plan, err = trigger.singleCompaction(segment, false, signal)
+	assert.NoError(t, err)
+	assert.Nil(t, plan)
+	signal.timetravel.pinned = map[UniqueID]Timestamp{2: tsoutil.ComposeTS(1080000, 0)}
+	plan, err = trigger.singleCompaction(segment, false, signal)
+	assert.NoError(t, err)
+	assert.NotNil(t, plan)
+
+	// the rows of the collection without ttl never expire
+	m.collections[1].Schema.TtlSeconds = 0
+	assert.False(t, trigger.shouldDoSingleCompaction(segment, &timetravel{time: tsoutil.ComposeTS(1100000, 0)}))
+}
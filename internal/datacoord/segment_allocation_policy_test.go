@@ -81,6 +81,29 @@ func TestUpperLimitCalBySchema(t *testing.T) {
 			expected:  int(Params.SegmentMaxSize * 1024 * 1024 / float64(524)),
 			expectErr: false,
 		},
+		{
+			schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{
+						DataType: schemapb.DataType_Int64,
+					},
+					{
+						DataType: schemapb.DataType_Float16Vector,
+						TypeParams: []*commonpb.KeyValuePair{
+							{Key: "dim", Value: "128"},
+						},
+					},
+					{
+						DataType: schemapb.DataType_BFloat16Vector,
+						TypeParams: []*commonpb.KeyValuePair{
+							{Key: "dim", Value: "64"},
+						},
+					},
+				},
+			},
+			expected:  int(Params.SegmentMaxSize * 1024 * 1024 / float64(392)),
+			expectErr: false,
+		},
 	}
 	for _, c := range testCases {
 		result, err := calBySchemaPolicy(c.schema)
@@ -349,7 +349,7 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 			cctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
 			defer cancel()
 
-			tt, err := getTimetravelReverseTime(cctx, s.allocator)
+			tt, err := getTimetravelReverseTime(cctx, s.allocator, s.meta.client)
 			if err == nil {
 				if err = s.compactionTrigger.triggerSingleCompaction(segment.GetCollectionID(), segment.GetPartitionID(),
 					segmentID, segment.GetInsertChannel(), tt); err != nil {
@@ -668,7 +668,7 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 		return resp, nil
 	}
 
-	id, err := s.compactionTrigger.forceTriggerCompaction(req.CollectionID, &timetravel{req.Timetravel})
+	id, err := s.compactionTrigger.forceTriggerCompaction(req.CollectionID, &timetravel{time: req.Timetravel})
 	if err != nil {
 		log.Error("failed to trigger manual compaction", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
 		resp.Status.Reason = err.Error()
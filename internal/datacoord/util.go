@@ -22,8 +22,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
@@ -116,7 +122,9 @@ func (c *LongTermChecker) Stop() {
 	close(c.ch)
 }
 
-func getTimetravelReverseTime(ctx context.Context, allocator allocator) (*timetravel, error) {
+// getTimetravelReverseTime returns the timetravel of compaction, which is timetravelRange ago, and the snapshots
+// pinned by the query iterators stored in iteratorKV before it, which only hold back the compaction of their collections
+func getTimetravelReverseTime(ctx context.Context, allocator allocator, iteratorKV kv.BaseKV) (*timetravel, error) {
 	ts, err := allocator.allocTimestamp(ctx)
 	if err != nil {
 		return nil, err
@@ -125,5 +133,23 @@ func getTimetravelReverseTime(ctx context.Context, allocator allocator) (*timetr
 	pts, _ := tsoutil.ParseTS(ts)
 	ttpts := pts.Add(-timetravelRange)
 	tt := tsoutil.ComposeTS(ttpts.UnixNano()/int64(time.Millisecond), 0)
-	return &timetravel{tt}, nil
+
+	pinned := make(map[UniqueID]Timestamp)
+	if iteratorKV != nil {
+		_, values, err := iteratorKV.LoadWithPrefix(common.QueryIteratorPrefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			info := &internalpb.QueryIteratorInfo{}
+			if err := proto.Unmarshal([]byte(value), info); err != nil {
+				log.Warn("failed to unmarshal query iterator", zap.Error(err))
+				continue
+			}
+			if ts, ok := pinned[info.CollectionID]; info.TravelTimestamp < tt && (!ok || info.TravelTimestamp < ts) {
+				pinned[info.CollectionID] = info.TravelTimestamp
+			}
+		}
+	}
+	return &timetravel{time: tt, pinned: pinned}, nil
 }
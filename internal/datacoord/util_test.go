@@ -17,11 +17,17 @@
 package datacoord
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -107,3 +113,32 @@ func TestVerifyResponse(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTimetravelReverseTime(t *testing.T) {
+	ctx := context.Background()
+	kv := memkv.NewMemoryKV()
+	tt, err := getTimetravelReverseTime(ctx, newMockAllocator(), kv)
+	assert.Nil(t, err)
+	assert.NotZero(t, tt.time)
+
+	// the snapshots pinned by the query iterators are kept for their collections only
+	for key, info := range map[string]*internalpb.QueryIteratorInfo{
+		"query_iterator/1": {CollectionID: 1, TravelTimestamp: 200},
+		"query_iterator/2": {CollectionID: 1, TravelTimestamp: 100},
+		"query_iterator/3": {CollectionID: 2, TravelTimestamp: tsoutil.AddPhysicalTimeOnTs(time.Hour.Milliseconds(), tt.time)},
+	} {
+		value, err := proto.Marshal(info)
+		assert.Nil(t, err)
+		assert.Nil(t, kv.Save(key, string(value)))
+	}
+	assert.Nil(t, kv.Save("query_iterator/4", "invalid"))
+	tt, err = getTimetravelReverseTime(ctx, newMockAllocator(), kv)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100), tt.forCollection(1).time)
+	assert.Equal(t, tt.time, tt.forCollection(2).time)
+	assert.Equal(t, tt.time, tt.forCollection(3).time)
+	assert.Less(t, uint64(100), tt.time)
+
+	_, err = getTimetravelReverseTime(ctx, &FailsAllocator{}, kv)
+	assert.NotNil(t, err)
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -93,12 +95,14 @@ func (t *compactionTask) getPlanID() UniqueID {
 	return t.plan.GetPlanID()
 }
 
-func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelTs Timestamp) (map[UniqueID]Timestamp, *DelDataBuf, error) {
+// mergeDeltalogs returns the latest delete timestamp of each pk before timetravelTs, the pk is an `int64` or
+// a `string` which depends on the data type of the primary key.
+func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelTs Timestamp) (map[interface{}]Timestamp, *DelDataBuf, error) {
 
 	dCodec := storage.NewDeleteCodec()
 
 	var (
-		pk2ts = make(map[UniqueID]Timestamp)
+		pk2ts = make(map[interface{}]Timestamp)
 		dbuff = &DelDataBuf{
 			delData: &DeleteData{
 				Pks: make([]UniqueID, 0),
@@ -116,15 +120,27 @@ func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelT
 		}
 
 		for i := int64(0); i < dData.RowCount; i++ {
-			pk := dData.Pks[i]
+			var pk interface{}
+			if len(dData.StringPks) > 0 {
+				pk = dData.StringPks[i]
+			} else {
+				pk = dData.Pks[i]
+			}
 			ts := dData.Tss[i]
 
 			if timetravelTs != Timestamp(0) && Timestamp(dData.Tss[i]) <= timetravelTs {
-				pk2ts[pk] = ts
+				if ts > pk2ts[pk] {
+					pk2ts[pk] = ts
+				}
 				continue
 			}
 
-			dbuff.delData.Append(pk, ts)
+			switch pk := pk.(type) {
+			case string:
+				dbuff.delData.AppendString(pk, ts)
+			case int64:
+				dbuff.delData.Append(pk, ts)
+			}
 
 			if Timestamp(ts) < dbuff.tsFrom {
 				dbuff.tsFrom = Timestamp(ts)
@@ -141,7 +157,17 @@ func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelT
 	return pk2ts, dbuff, nil
 }
 
-func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp, schema *schemapb.CollectionSchema) ([]*InsertData, int64, error) {
+// getExpireTs returns the timestamp before which the rows are expired at the timetravel of the plan,
+// 0 if the rows of the collection never expire
+func (t *compactionTask) getExpireTs() Timestamp {
+	ttl := t.plan.GetCollectionTtl()
+	if ttl <= 0 {
+		return 0
+	}
+	return tsoutil.AddPhysicalTimeOnTs(-ttl*1000, t.plan.GetTimetravel())
+}
+
+func (t *compactionTask) merge(mergeItr iterator, delta map[interface{}]Timestamp, schema *schemapb.CollectionSchema) ([]*InsertData, int64, error) {
 
 	var (
 		dim int // dimension of vector field
@@ -152,13 +178,22 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 		iDatas      = make([]*InsertData, 0)
 		fID2Type    = make(map[UniqueID]schemapb.DataType)
 		fID2Content = make(map[UniqueID][]interface{})
+
+		stringPKField = UniqueID(-1) // field ID of the `string` type PK
+
+		expireTs = t.getExpireTs() // the rows inserted before it are expired
 	)
 
 	// get dim
 	for _, fs := range schema.GetFields() {
 		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		if fs.GetIsPrimaryKey() && fs.GetDataType() == schemapb.DataType_String {
+			stringPKField = fs.GetFieldID()
+		}
 		if fs.GetDataType() == schemapb.DataType_FloatVector ||
-			fs.GetDataType() == schemapb.DataType_BinaryVector {
+			fs.GetDataType() == schemapb.DataType_BinaryVector ||
+			fs.GetDataType() == schemapb.DataType_Float16Vector ||
+			fs.GetDataType() == schemapb.DataType_BFloat16Vector {
 			for _, t := range fs.GetTypeParams() {
 				if t.Key == "dim" {
 					if dim, err = strconv.Atoi(t.Value); err != nil {
@@ -169,6 +204,15 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 				}
 			}
 		}
+		if fs.GetDataType() == schemapb.DataType_SparseFloatVector {
+			maxNnz, err := typeutil.GetMaxNnz(fs)
+			if err != nil {
+				log.Warn("failed to get max nnz", zap.Error(err))
+				return nil, 0, err
+			}
+			// a non-zero element takes an index and a value
+			dim = maxNnz * 2
+		}
 	}
 
 	for mergeItr.HasNext() {
@@ -181,22 +225,40 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 			return nil, 0, errors.New("Unexpected error")
 		}
 
-		if _, ok := delta[v.ID]; ok {
-			continue
-		}
-
 		row, ok := v.Value.(map[UniqueID]interface{})
 		if !ok {
 			log.Warn("transfer interface to map wrong")
 			return nil, 0, errors.New("Unexpected error")
 		}
 
+		// the deletes of `string` type PKs are matched by the PK instead of the row ID
+		var pk interface{} = v.ID
+		if stringPKField != -1 {
+			pk = row[stringPKField]
+		}
+
+		// a delete only applies to the rows inserted before it, the row written
+		// by an upsert shares the timestamp with the delete of the old one
+		if ts, ok := delta[pk]; ok && Timestamp(v.Timestamp) < ts {
+			continue
+		}
+
+		if expireTs != 0 && Timestamp(v.Timestamp) < expireTs {
+			continue
+		}
+
 		for fID, vInter := range row {
 			if _, ok := fID2Content[fID]; !ok {
 				fID2Content[fID] = make([]interface{}, 0)
 			}
 			fID2Content[fID] = append(fID2Content[fID], vInter)
 		}
+		// the segments written before a field was added to the collection have no binlogs of the field
+		for _, fs := range schema.GetFields() {
+			if _, ok := row[fs.GetFieldID()]; !ok && !typeutil.IsVectorType(fs.GetDataType()) {
+				fID2Content[fs.GetFieldID()] = append(fID2Content[fs.GetFieldID()], storage.GetDefaultValue(fs))
+			}
+		}
 	}
 
 	// calculate numRows from rowID field, fieldID 0
@@ -400,7 +462,18 @@ func (t *compactionTask) compact() error {
 	//  Compaction I: update pk range.
 	//  Compaction II: remove the segments and add a new flushed segment with pk range.
 	fd := []UniqueID{}
+	sfd := []string{}
+	stringPKField := UniqueID(-1)
+	for _, fs := range meta.GetSchema().GetFields() {
+		if fs.GetIsPrimaryKey() && fs.GetDataType() == schemapb.DataType_String {
+			stringPKField = fs.GetFieldID()
+		}
+	}
 	for _, iData := range iDatas {
+		if stringPKField != -1 {
+			sfd = append(sfd, iData.Data[stringPKField].(*storage.StringFieldData).Data...)
+			continue
+		}
 		fd = append(fd, iData.Data[0].(*storage.Int64FieldData).Data...)
 	}
 
@@ -414,12 +487,31 @@ func (t *compactionTask) compact() error {
 			t.removeSegment(seg)
 		}
 	}
+	t.updateSegmentStringPKRange(targetSegID, sfd)
 
 	ti.injectOver <- true
 	log.Info("compaction done", zap.Int64("planID", t.plan.GetPlanID()))
 	return nil
 }
 
+// contentValidData returns the validity of the content, a nil value stands for a null row
+func contentValidData(content []interface{}) []bool {
+	var validData []bool
+	for i, c := range content {
+		if c != nil {
+			continue
+		}
+		if validData == nil {
+			validData = make([]bool, len(content))
+			for j := range validData {
+				validData[j] = true
+			}
+		}
+		validData[i] = false
+	}
+	return validData
+}
+
 // TODO copy maybe expensive, but this seems to be the only convinent way.
 func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}, numRows int64) (storage.FieldData, error) {
 	var rst storage.FieldData
@@ -427,13 +519,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 	switch schemaDataType {
 	case schemapb.DataType_Bool:
 		var data = &storage.BoolFieldData{
-			NumRows: numOfRows,
-			Data:    make([]bool, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]bool, 0, len(content)),
+			ValidData: contentValidData(content),
 		}
 
 		for _, c := range content {
 			r, ok := c.(bool)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -442,13 +535,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Int8:
 		var data = &storage.Int8FieldData{
-			NumRows: numOfRows,
-			Data:    make([]int8, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]int8, 0, len(content)),
+			ValidData: contentValidData(content),
 		}
 
 		for _, c := range content {
 			r, ok := c.(int8)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -457,13 +551,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Int16:
 		var data = &storage.Int16FieldData{
-			NumRows: numOfRows,
-			Data:    make([]int16, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]int16, 0, len(content)),
+			ValidData: contentValidData(content),
 		}
 
 		for _, c := range content {
 			r, ok := c.(int16)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -472,13 +567,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Int32:
 		var data = &storage.Int32FieldData{
-			NumRows: numOfRows,
-			Data:    make([]int32, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]int32, 0, len(content)),
+			ValidData: contentValidData(content),
 		}
 
 		for _, c := range content {
 			r, ok := c.(int32)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -487,13 +583,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Int64:
 		var data = &storage.Int64FieldData{
-			NumRows: numOfRows,
-			Data:    make([]int64, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]int64, 0, len(content)),
+			ValidData: contentValidData(content),
 		}
 
 		for _, c := range content {
 			r, ok := c.(int64)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -502,13 +599,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Float:
 		var data = &storage.FloatFieldData{
-			NumRows: numOfRows,
-			Data:    make([]float32, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]float32, 0, len(content)),
+			ValidData: contentValidData(content),
 		}
 
 		for _, c := range content {
 			r, ok := c.(float32)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -517,13 +615,65 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Double:
 		var data = &storage.DoubleFieldData{
-			NumRows: numOfRows,
-			Data:    make([]float64, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]float64, 0, len(content)),
+			ValidData: contentValidData(content),
 		}
 
 		for _, c := range content {
 			r, ok := c.(float64)
-			if !ok {
+			if !ok && c != nil {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+		rst = data
+
+	case schemapb.DataType_String:
+		var data = &storage.StringFieldData{
+			NumRows:   numOfRows,
+			Data:      make([]string, 0, len(content)),
+			ValidData: contentValidData(content),
+		}
+
+		for _, c := range content {
+			r, ok := c.(string)
+			if !ok && c != nil {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+		rst = data
+
+	case schemapb.DataType_Array:
+		var data = &storage.ArrayFieldData{
+			NumRows:   numOfRows,
+			Data:      make([]*schemapb.ScalarField, 0, len(content)),
+			ValidData: contentValidData(content),
+		}
+
+		for _, c := range content {
+			r, ok := c.(*schemapb.ScalarField)
+			if !ok && c != nil {
+				return nil, errTransferType
+			}
+			if r == nil {
+				r = &schemapb.ScalarField{}
+			}
+			data.Data = append(data.Data, r)
+		}
+		rst = data
+
+	case schemapb.DataType_JSON:
+		var data = &storage.JSONFieldData{
+			NumRows:   numOfRows,
+			Data:      make([][]byte, 0, len(content)),
+			ValidData: contentValidData(content),
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -564,6 +714,55 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 		data.Dim = len(data.Data) * 8 / int(numRows)
 		rst = data
 
+	case schemapb.DataType_Float16Vector:
+		var data = &storage.Float16VectorFieldData{
+			NumRows: numOfRows,
+			Data:    make([]byte, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.(byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+
+		data.Dim = len(data.Data) / 2 / int(numRows)
+		rst = data
+
+	case schemapb.DataType_BFloat16Vector:
+		var data = &storage.BFloat16VectorFieldData{
+			NumRows: numOfRows,
+			Data:    make([]byte, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.(byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+
+		data.Dim = len(data.Data) / 2 / int(numRows)
+		rst = data
+
+	case schemapb.DataType_SparseFloatVector:
+		var data = &storage.SparseFloatVectorFieldData{
+			NumRows: numOfRows,
+			Data:    make([][]byte, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+		rst = data
+
 	default:
 		return nil, errUnknownDataType
 	}
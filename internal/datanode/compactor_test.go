@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -75,18 +76,20 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			{true, schemapb.DataType_Int64, []interface{}{int64(1), int64(2)}, "valid int64"},
 			{true, schemapb.DataType_Float, []interface{}{float32(1), float32(2)}, "valid float32"},
 			{true, schemapb.DataType_Double, []interface{}{float64(1), float64(2)}, "valid float64"},
+			{true, schemapb.DataType_String, []interface{}{"a", "b"}, "valid string"},
 			{true, schemapb.DataType_FloatVector, []interface{}{float32(1), float32(2)}, "valid floatvector"},
 			{true, schemapb.DataType_BinaryVector, []interface{}{byte(255), byte(1)}, "valid binaryvector"},
 			{false, schemapb.DataType_Bool, []interface{}{1, 2}, "invalid bool"},
-			{false, schemapb.DataType_Int8, []interface{}{nil, nil}, "invalid int8"},
-			{false, schemapb.DataType_Int16, []interface{}{nil, nil}, "invalid int16"},
-			{false, schemapb.DataType_Int32, []interface{}{nil, nil}, "invalid int32"},
-			{false, schemapb.DataType_Int64, []interface{}{nil, nil}, "invalid int64"},
-			{false, schemapb.DataType_Float, []interface{}{nil, nil}, "invalid float32"},
-			{false, schemapb.DataType_Double, []interface{}{nil, nil}, "invalid float64"},
+			{false, schemapb.DataType_Int8, []interface{}{"a", "b"}, "invalid int8"},
+			{false, schemapb.DataType_Int16, []interface{}{"a", "b"}, "invalid int16"},
+			{false, schemapb.DataType_Int32, []interface{}{"a", "b"}, "invalid int32"},
+			{false, schemapb.DataType_Int64, []interface{}{"a", "b"}, "invalid int64"},
+			{false, schemapb.DataType_Float, []interface{}{"a", "b"}, "invalid float32"},
+			{false, schemapb.DataType_Double, []interface{}{"a", "b"}, "invalid float64"},
+			{false, schemapb.DataType_String, []interface{}{1, 2}, "invalid string"},
 			{false, schemapb.DataType_FloatVector, []interface{}{nil, nil}, "invalid floatvector"},
 			{false, schemapb.DataType_BinaryVector, []interface{}{nil, nil}, "invalid binaryvector"},
-			{false, schemapb.DataType_String, nil, "invalid data type"},
+			{false, schemapb.DataType_None, nil, "invalid data type"},
 		}
 
 		for _, test := range tests {
@@ -103,6 +106,16 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			})
 		}
 
+		t.Run("null values", func(t *testing.T) {
+			fd, err := interface2FieldData(schemapb.DataType_Int64, []interface{}{int64(1), nil}, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{1, 0}, fd.(*storage.Int64FieldData).Data)
+			assert.Equal(t, []bool{true, false}, fd.(*storage.Int64FieldData).ValidData)
+
+			fd, err = interface2FieldData(schemapb.DataType_String, []interface{}{"a", "b"}, 2)
+			assert.NoError(t, err)
+			assert.Nil(t, fd.(*storage.StringFieldData).ValidData)
+		})
 	})
 
 	t.Run("Test mergeDeltalogs", func(t *testing.T) {
@@ -246,8 +259,8 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 
 		mitr := storage.NewMergeIterator([]iterator{iitr})
 
-		dm := map[UniqueID]Timestamp{
-			1: 10000,
+		dm := map[interface{}]Timestamp{
+			int64(1): 10000,
 		}
 
 		ct := &compactionTask{}
@@ -257,6 +270,54 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 		assert.Equal(t, 1, len(idata))
 
 	})
+
+	t.Run("Test merge with delete at the insert timestamp", func(t *testing.T) {
+		iData := genInsertData()
+		meta := NewMetaFactory().GetCollectionMeta(1, "test")
+
+		iblobs, err := getInsertBlobs(100, iData, meta)
+		require.NoError(t, err)
+
+		iitr, err := storage.NewInsertBinlogIterator(iblobs)
+		require.NoError(t, err)
+
+		mitr := storage.NewMergeIterator([]iterator{iitr})
+
+		// row 1 is inserted at ts 3, the delete shares the timestamp so it keeps the row
+		dm := map[interface{}]Timestamp{
+			int64(1): 3,
+		}
+
+		ct := &compactionTask{}
+		_, numOfRow, err := ct.merge(mitr, dm, meta.GetSchema())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), numOfRow)
+	})
+
+	t.Run("Test merge with ttl", func(t *testing.T) {
+		iData := genInsertData()
+		meta := NewMetaFactory().GetCollectionMeta(1, "test")
+
+		iblobs, err := getInsertBlobs(100, iData, meta)
+		require.NoError(t, err)
+
+		iitr, err := storage.NewInsertBinlogIterator(iblobs)
+		require.NoError(t, err)
+
+		mitr := storage.NewMergeIterator([]iterator{iitr})
+
+		// the rows are inserted at ts 3 and 4, the ones before ts 4 are expired at the timetravel
+		ct := &compactionTask{
+			plan: &datapb.CompactionPlan{
+				CollectionTtl: 1,
+				Timetravel:    tsoutil.ComposeTS(1000, 4),
+			},
+		}
+		assert.Equal(t, Timestamp(4), ct.getExpireTs())
+		_, numOfRow, err := ct.merge(mitr, map[interface{}]Timestamp{}, meta.GetSchema())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), numOfRow)
+	})
 }
 
 func getDeltaBlobs(segID UniqueID, pks []UniqueID, tss []Timestamp) ([]*Blob, error) {
@@ -130,7 +130,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 		case commonpb.MsgType_Delete:
 			log.Debug("DDNode receive delete messages")
 			dmsg := msg.(*msgstream.DeleteMsg)
-			for i := 0; i < len(dmsg.PrimaryKeys); i++ {
+			for i := 0; i < len(dmsg.Timestamps); i++ {
 				dmsg.HashValues = append(dmsg.HashValues, uint32(0))
 			}
 			forwardMsgs = append(forwardMsgs, dmsg)
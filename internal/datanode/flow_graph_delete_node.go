@@ -88,9 +88,10 @@ func (dn *deleteNode) Close() {
 }
 
 func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) error {
-	log.Debug("bufferDeleteMsg", zap.Any("primary keys", msg.PrimaryKeys))
+	log.Debug("bufferDeleteMsg", zap.Any("primary keys", msg.PrimaryKeys), zap.Strings("string primary keys", msg.StringPrimaryKeys))
 
 	segIDToPkMap := make(map[UniqueID][]int64)
+	segIDToStringPkMap := make(map[UniqueID][]string)
 	segIDToTsMap := make(map[UniqueID][]uint64)
 
 	m := dn.filterSegmentByPK(msg.PartitionID, msg.PrimaryKeys)
@@ -106,11 +107,25 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) er
 		}
 	}
 
-	for segID, pks := range segIDToPkMap {
-		rows := len(pks)
-		tss, ok := segIDToTsMap[segID]
-		if !ok || rows != len(tss) {
+	sm := dn.filterSegmentByStringPK(msg.PartitionID, msg.StringPrimaryKeys)
+	for i, pk := range msg.StringPrimaryKeys {
+		segIDs, ok := sm[pk]
+		if !ok {
+			log.Warn("primary key not exist in all segments", zap.String("primary key", pk))
+			continue
+		}
+		for _, segID := range segIDs {
+			segIDToStringPkMap[segID] = append(segIDToStringPkMap[segID], pk)
+			segIDToTsMap[segID] = append(segIDToTsMap[segID], msg.Timestamps[i])
+		}
+	}
+
+	for segID, tss := range segIDToTsMap {
+		pks, stringPks := segIDToPkMap[segID], segIDToStringPkMap[segID]
+		rows := len(tss)
+		if rows != len(pks)+len(stringPks) {
 			log.Error("primary keys and timestamp's element num mis-match")
+			continue
 		}
 
 		var delDataBuf *DelDataBuf
@@ -122,11 +137,16 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) er
 		}
 		delData := delDataBuf.delData
 
-		for i := 0; i < rows; i++ {
+		for i := 0; i < len(pks); i++ {
 			delData.Pks = append(delData.Pks, pks[i])
 			delData.Tss = append(delData.Tss, tss[i])
 			log.Debug("delete", zap.Int64("primary key", pks[i]), zap.Uint64("ts", tss[i]))
 		}
+		for i := 0; i < len(stringPks); i++ {
+			delData.StringPks = append(delData.StringPks, stringPks[i])
+			delData.Tss = append(delData.Tss, tss[i])
+			log.Debug("delete", zap.String("primary key", stringPks[i]), zap.Uint64("ts", tss[i]))
+		}
 
 		// store
 		delDataBuf.updateSize(int64(rows))
@@ -148,6 +168,10 @@ func (dn *deleteNode) showDelBuf() {
 			for i := 0; i < length; i++ {
 				log.Debug("del data", zap.Int64("pk", delDataBuf.delData.Pks[i]), zap.Uint64("ts", delDataBuf.delData.Tss[i]))
 			}
+			length = len(delDataBuf.delData.StringPks)
+			for i := 0; i < length; i++ {
+				log.Debug("del data", zap.String("pk", delDataBuf.delData.StringPks[i]), zap.Uint64("ts", delDataBuf.delData.Tss[i]))
+			}
 		} else {
 			log.Error("segment not exist", zap.Int64("segID", segID))
 		}
@@ -235,6 +259,20 @@ func (dn *deleteNode) filterSegmentByPK(partID UniqueID, pks []int64) map[int64]
 	return result
 }
 
+// filterSegmentByStringPK is the same as filterSegmentByPK, but for `string` type PKs.
+func (dn *deleteNode) filterSegmentByStringPK(partID UniqueID, pks []string) map[string][]int64 {
+	result := make(map[string][]int64)
+	segments := dn.replica.filterSegments(dn.channelName, partID)
+	for _, pk := range pks {
+		for _, segment := range segments {
+			if segment.pkFilter.TestString(pk) {
+				result[pk] = append(result[pk], segment.segmentID)
+			}
+		}
+	}
+	return result
+}
+
 func newDeleteNode(ctx context.Context, fm flushManager, config *nodeConfig) (*deleteNode, error) {
 	baseNode := BaseNode{}
 	baseNode.SetMaxQueueLength(config.maxQueueLength)
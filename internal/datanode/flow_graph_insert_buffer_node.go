@@ -36,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -117,7 +118,8 @@ type BufferData struct {
 	limit  int64
 }
 
-// newBufferData needs an input dimension to calculate the limit of this buffer
+// newBufferData needs an input dimension to calculate the limit of this buffer,
+// the dimension is the sum of the dimensions of all the vector fields
 //
 // `limit` is the segment numOfRows a buffer can buffer at most.
 //
@@ -131,7 +133,7 @@ type BufferData struct {
 //   with the same dimension, newBufferData takes the smaller buffer limit
 //   to fit in both types of vector fields
 //
-// * This need to change for string field support and multi-vector fields support.
+// * This need to change for string field support.
 func newBufferData(dimension int64) (*BufferData, error) {
 	if dimension == 0 {
 		return nil, errors.New("Invalid dimension")
@@ -393,25 +395,41 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 		log.Error("Get schema wrong:", zap.Error(err))
 		return err
 	}
+	// the rows written before a field was added to the collection have no value of the field
+	if err := typeutil.AlignRowsToSchema(collSchema, msg.RowData); err != nil {
+		log.Error("Align rows to schema wrong:", zap.Error(err))
+		return err
+	}
 
 	// Get Dimension
-	// TODO GOOSE: under assumption that there's only 1 Vector field in one collection schema
+	// the dimensions of all the vector fields are summed up, so the buffer limit covers the vectors of a row
 	var dimension int
 	for _, field := range collSchema.Fields {
 		if field.DataType == schemapb.DataType_FloatVector ||
-			field.DataType == schemapb.DataType_BinaryVector {
+			field.DataType == schemapb.DataType_BinaryVector ||
+			field.DataType == schemapb.DataType_Float16Vector ||
+			field.DataType == schemapb.DataType_BFloat16Vector {
 
 			for _, t := range field.TypeParams {
 				if t.Key == "dim" {
-					dimension, err = strconv.Atoi(t.Value)
+					dim, err := strconv.Atoi(t.Value)
 					if err != nil {
 						log.Error("strconv wrong on get dim", zap.Error(err))
 						return err
 					}
+					dimension += dim
 					break
 				}
 			}
-			break
+		}
+		if field.DataType == schemapb.DataType_SparseFloatVector {
+			maxNnz, err := typeutil.GetMaxNnz(field)
+			if err != nil {
+				log.Error("failed to get max nnz", zap.Error(err))
+				return err
+			}
+			// a non-zero element takes an index and a value
+			dimension += maxNnz * 2
 		}
 	}
 
@@ -424,6 +442,20 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 	buffer := bd.(*BufferData)
 	idata := buffer.buffer
 
+	// the fields added to the collection after the buffered rows were written take the default values in these rows
+	if tsData, ok := idata.Data[common.TimeStampField]; ok && tsData.Length() > 0 {
+		for _, field := range collSchema.Fields {
+			if _, ok := idata.Data[field.FieldID]; ok || typeutil.IsVectorType(field.DataType) {
+				continue
+			}
+			idata.Data[field.FieldID], err = storage.NewDefaultFieldData(field, tsData.Length())
+			if err != nil {
+				log.Error("Fill field added to the collection wrong:", zap.Error(err))
+				return err
+			}
+		}
+	}
+
 	// 1.2 Get Fields
 	var fieldIDs []int64
 	var fieldTypes []schemapb.DataType
@@ -438,6 +470,17 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 	}
 
 	for _, field := range collSchema.Fields {
+		// the value of a nullable field is led by a validity byte in each row
+		var validData []bool
+		if field.GetNullable() {
+			validData = make([]bool, 0, len(blobReaders))
+			for _, r := range blobReaders {
+				var valid bool
+				readBinary(r, &valid, schemapb.DataType_Bool)
+				validData = append(validData, valid)
+			}
+		}
+
 		switch field.DataType {
 		case schemapb.DataType_FloatVector:
 			var dim int
@@ -502,6 +545,68 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 
 			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
 
+		case schemapb.DataType_Float16Vector:
+			var dim int
+			for _, t := range field.TypeParams {
+				if t.Key == "dim" {
+					dim, err = strconv.Atoi(t.Value)
+					if err != nil {
+						log.Error("strconv wrong on get dim", zap.Error(err))
+						return err
+					}
+					break
+				}
+			}
+
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.Float16VectorFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([]byte, 0),
+					Dim:     dim,
+				}
+			}
+			fieldData := idata.Data[field.FieldID].(*storage.Float16VectorFieldData)
+
+			for _, r := range blobReaders {
+				var v []byte = make([]byte, dim*2)
+				readBinary(r, &v, field.DataType)
+
+				fieldData.Data = append(fieldData.Data, v...)
+			}
+
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+
+		case schemapb.DataType_BFloat16Vector:
+			var dim int
+			for _, t := range field.TypeParams {
+				if t.Key == "dim" {
+					dim, err = strconv.Atoi(t.Value)
+					if err != nil {
+						log.Error("strconv wrong on get dim", zap.Error(err))
+						return err
+					}
+					break
+				}
+			}
+
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.BFloat16VectorFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([]byte, 0),
+					Dim:     dim,
+				}
+			}
+			fieldData := idata.Data[field.FieldID].(*storage.BFloat16VectorFieldData)
+
+			for _, r := range blobReaders {
+				var v []byte = make([]byte, dim*2)
+				readBinary(r, &v, field.DataType)
+
+				fieldData.Data = append(fieldData.Data, v...)
+			}
+
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+
 		case schemapb.DataType_Bool:
 			if _, ok := idata.Data[field.FieldID]; !ok {
 				idata.Data[field.FieldID] = &storage.BoolFieldData{
@@ -519,6 +624,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 
 			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			fieldData.ValidData = storage.AppendValidData(fieldData.ValidData, len(fieldData.Data)-len(msg.RowData), validData, len(msg.RowData))
 
 		case schemapb.DataType_Int8:
 			if _, ok := idata.Data[field.FieldID]; !ok {
@@ -537,6 +643,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 
 			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			fieldData.ValidData = storage.AppendValidData(fieldData.ValidData, len(fieldData.Data)-len(msg.RowData), validData, len(msg.RowData))
 
 		case schemapb.DataType_Int16:
 			if _, ok := idata.Data[field.FieldID]; !ok {
@@ -555,6 +662,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 
 			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			fieldData.ValidData = storage.AppendValidData(fieldData.ValidData, len(fieldData.Data)-len(msg.RowData), validData, len(msg.RowData))
 
 		case schemapb.DataType_Int32:
 			if _, ok := idata.Data[field.FieldID]; !ok {
@@ -573,6 +681,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 
 			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			fieldData.ValidData = storage.AppendValidData(fieldData.ValidData, len(fieldData.Data)-len(msg.RowData), validData, len(msg.RowData))
 
 		case schemapb.DataType_Int64:
 			if _, ok := idata.Data[field.FieldID]; !ok {
@@ -602,6 +711,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 
 				fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
 			}
+			fieldData.ValidData = storage.AppendValidData(fieldData.ValidData, len(fieldData.Data)-len(msg.RowData), validData, len(msg.RowData))
 			if field.IsPrimaryKey {
 				// update segment pk filter
 				ibNode.replica.updateSegmentPKRange(currentSegID, fieldData.Data)
@@ -624,6 +734,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 				fieldData.Data = append(fieldData.Data, v)
 			}
 			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			fieldData.ValidData = storage.AppendValidData(fieldData.ValidData, len(fieldData.Data)-len(msg.RowData), validData, len(msg.RowData))
 
 		case schemapb.DataType_Double:
 			if _, ok := idata.Data[field.FieldID]; !ok {
@@ -642,6 +753,107 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 				fieldData.Data = append(fieldData.Data, v)
 			}
 			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			fieldData.ValidData = storage.AppendValidData(fieldData.ValidData, len(fieldData.Data)-len(msg.RowData), validData, len(msg.RowData))
+
+		case schemapb.DataType_String:
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.StringFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([]string, 0),
+				}
+			}
+
+			fieldData := idata.Data[field.FieldID].(*storage.StringFieldData)
+
+			for _, r := range blobReaders {
+				// the uint32 length followed by the bytes of the string
+				var length uint32
+				readBinary(r, &length, field.DataType)
+				var v = make([]byte, length)
+				readBinary(r, &v, field.DataType)
+
+				fieldData.Data = append(fieldData.Data, string(v))
+			}
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			fieldData.ValidData = storage.AppendValidData(fieldData.ValidData, len(fieldData.Data)-len(msg.RowData), validData, len(msg.RowData))
+			if field.IsPrimaryKey {
+				// update segment pk filter
+				ibNode.replica.updateSegmentStringPKRange(currentSegID, fieldData.Data)
+			}
+
+		case schemapb.DataType_Array:
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.ArrayFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([]*schemapb.ScalarField, 0),
+				}
+			}
+
+			fieldData := idata.Data[field.FieldID].(*storage.ArrayFieldData)
+
+			for _, r := range blobReaders {
+				// the uint32 length followed by the bytes of the array
+				var length uint32
+				readBinary(r, &length, field.DataType)
+				var value = make([]byte, length)
+				readBinary(r, &value, field.DataType)
+
+				v, err := typeutil.DecodeArray(field, value)
+				if err != nil {
+					log.Error("failed to decode array", zap.Error(err))
+					return err
+				}
+				fieldData.Data = append(fieldData.Data, v)
+			}
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			fieldData.ValidData = storage.AppendValidData(fieldData.ValidData, len(fieldData.Data)-len(msg.RowData), validData, len(msg.RowData))
+
+		case schemapb.DataType_JSON:
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.JSONFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([][]byte, 0),
+				}
+			}
+
+			fieldData := idata.Data[field.FieldID].(*storage.JSONFieldData)
+
+			for _, r := range blobReaders {
+				// the uint32 length followed by the bytes of the document
+				var length uint32
+				readBinary(r, &length, field.DataType)
+				var v = make([]byte, length)
+				readBinary(r, &v, field.DataType)
+
+				fieldData.Data = append(fieldData.Data, v)
+			}
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			fieldData.ValidData = storage.AppendValidData(fieldData.ValidData, len(fieldData.Data)-len(msg.RowData), validData, len(msg.RowData))
+
+		case schemapb.DataType_SparseFloatVector:
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.SparseFloatVectorFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([][]byte, 0),
+				}
+			}
+
+			fieldData := idata.Data[field.FieldID].(*storage.SparseFloatVectorFieldData)
+
+			for _, r := range blobReaders {
+				// the uint32 length followed by the (index, value) pairs of the sparse row
+				var length uint32
+				readBinary(r, &length, field.DataType)
+				var v = make([]byte, length)
+				readBinary(r, &v, field.DataType)
+
+				if err := typeutil.ValidateSparseFloatRow(v); err != nil {
+					log.Error("failed to decode sparse float vector", zap.Error(err))
+					return err
+				}
+				fieldData.Data = append(fieldData.Data, v)
+			}
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
 		}
 	}
 
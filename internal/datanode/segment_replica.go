@@ -58,6 +58,7 @@ type Replica interface {
 	updateSegmentEndPosition(segID UniqueID, endPos *internalpb.MsgPosition)
 	updateSegmentCheckPoint(segID UniqueID)
 	updateSegmentPKRange(segID UniqueID, rowIDs []int64)
+	updateSegmentStringPKRange(segID UniqueID, pks []string)
 	refreshFlushedSegmentPKRange(segID UniqueID, rowIDs []int64)
 	addFlushedSegmentWithPKs(segID, collID, partID UniqueID, channelName string, numOfRow int64, rowIDs []int64)
 	hasSegment(segID UniqueID, countFlushed bool) bool
@@ -85,9 +86,8 @@ type Segment struct {
 	endPos     *internalpb.MsgPosition
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
-	// TODO silverxia, needs to change to interface to support `string` type PK
-	minPK int64 //	minimal pk value, shortcut for checking whether a pk is inside this segment
-	maxPK int64 //  maximal pk value, same above
+	minPK    int64              //	minimal pk value, shortcut for checking whether a pk is inside this segment
+	maxPK    int64              //  maximal pk value, same above, only for `int64` type PK
 }
 
 // SegmentReplica is the data replication of persistent data in datanode.
@@ -119,6 +119,13 @@ func (s *Segment) updatePKRange(pks []int64) {
 	}
 }
 
+// updateStringPKRange adds the `string` type PKs into the bloom filter
+func (s *Segment) updateStringPKRange(pks []string) {
+	for _, pk := range pks {
+		s.pkFilter.AddString(pk)
+	}
+}
+
 var _ Replica = &SegmentReplica{}
 
 func newReplica(ctx context.Context, rc types.RootCoord, collID UniqueID) (*SegmentReplica, error) {
@@ -390,9 +397,11 @@ func (replica *SegmentReplica) initPKBloomFilter(s *Segment, statsBinlogs []*dat
 
 	// get pkfield id
 	pkField := int64(-1)
+	pkType := schemapb.DataType_Int64
 	for _, field := range schema.Fields {
 		if field.IsPrimaryKey {
 			pkField = field.FieldID
+			pkType = field.DataType
 			break
 		}
 	}
@@ -415,6 +424,20 @@ func (replica *SegmentReplica) initPKBloomFilter(s *Segment, statsBinlogs []*dat
 		blobs = append(blobs, &Blob{Value: []byte(values[i])})
 	}
 
+	if pkType == schemapb.DataType_String {
+		stats, err := storage.DeserializeStringStats(blobs)
+		if err != nil {
+			return err
+		}
+		for _, stat := range stats {
+			err = s.pkFilter.Merge(stat.BF)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	stats, err := storage.DeserializeStats(blobs)
 	if err != nil {
 		return err
@@ -518,6 +541,31 @@ func (replica *SegmentReplica) updateSegmentPKRange(segID UniqueID, pks []int64)
 	log.Warn("No match segment to update PK range", zap.Int64("ID", segID))
 }
 
+func (replica *SegmentReplica) updateSegmentStringPKRange(segID UniqueID, pks []string) {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	seg, ok := replica.newSegments[segID]
+	if ok {
+		seg.updateStringPKRange(pks)
+		return
+	}
+
+	seg, ok = replica.normalSegments[segID]
+	if ok {
+		seg.updateStringPKRange(pks)
+		return
+	}
+
+	seg, ok = replica.flushedSegments[segID]
+	if ok {
+		seg.updateStringPKRange(pks)
+		return
+	}
+
+	log.Warn("No match segment to update PK range", zap.Int64("ID", segID))
+}
+
 func (replica *SegmentReplica) removeSegment(segID UniqueID) {
 	replica.segMu.Lock()
 	defer replica.segMu.Unlock()
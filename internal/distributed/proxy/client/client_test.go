@@ -21,6 +21,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -28,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 type MockProxyClient struct {
@@ -54,6 +56,14 @@ func (m *MockProxyClient) ReleaseDQLMessageStream(ctx context.Context, in *proxy
 	return &commonpb.Status{}, m.err
 }
 
+func (m *MockProxyClient) InvalidateCredentialCache(ctx context.Context, req *proxypb.InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockProxyClient) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
 func Test_NewClient(t *testing.T) {
 	proxy.Params.InitOnce()
 
@@ -94,6 +104,11 @@ func Test_NewClient(t *testing.T) {
 
 		r4, err := client.ReleaseDQLMessageStream(ctx, nil)
 		retCheck(retNotNil, r4, err)
+
+		r5, err := client.InvalidateCredentialCache(ctx, nil)
+		retCheck(retNotNil, r5, err)
+		r6, err := client.RefreshPolicyInfoCache(ctx, nil)
+		retCheck(retNotNil, r6, err)
 	}
 
 	client.getGrpcClient = func() (proxypb.ProxyClient, error) {
@@ -114,3 +129,22 @@ func Test_NewClient(t *testing.T) {
 	err = client.Stop()
 	assert.Nil(t, err)
 }
+
+func Test_internalTokenInterceptor(t *testing.T) {
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	Params.InternalToken = ""
+	err := internalTokenInterceptor(context.Background(), "method", nil, nil, nil, invoker)
+	assert.Nil(t, err)
+	assert.Empty(t, outgoing.Get(common.HeaderInternalToken))
+
+	Params.InternalToken = "secret"
+	defer func() { Params.InternalToken = "" }()
+	err = internalTokenInterceptor(context.Background(), "method", nil, nil, nil, invoker)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"secret"}, outgoing.Get(common.HeaderInternalToken))
+}
@@ -34,6 +34,7 @@ type ParamTable struct {
 
 	ClientMaxSendSize int
 	ClientMaxRecvSize int
+	InternalToken     string
 }
 
 // Params is a package scoped variable of type ParamTable.
@@ -48,9 +49,16 @@ func (pt *ParamTable) Init() {
 
 		pt.initClientMaxSendSize()
 		pt.initClientMaxRecvSize()
+		pt.initInternalToken()
 	})
 }
 
+// initInternalToken loads the token attached to the requests, the proxy authenticates the callers of its internal
+// service by it when authorization is enabled
+func (pt *ParamTable) initInternalToken() {
+	pt.InternalToken = pt.LoadWithDefault("common.security.internalToken", "")
+}
+
 func (pt *ParamTable) initClientMaxSendSize() {
 	var err error
 
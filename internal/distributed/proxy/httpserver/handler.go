@@ -0,0 +1,266 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+const (
+	// RouterPrefix is the prefix of all the routes of the REST API
+	RouterPrefix = "/api/v1"
+
+	// headerAuthorize is the http header which carries the credential, both "Basic <token>" and "<token>"
+	// are accepted, the token is the base64 encoded "username:password" same as the one of the gRPC metadata
+	headerAuthorize = "Authorization"
+	basicAuthPrefix = "Basic "
+	// headerSessionID is the http header which carries the session of the Session consistency level
+	headerSessionID = "Session-Id"
+)
+
+// decodeFunc translates the body of the http request to the milvuspb request
+type decodeFunc func(r *http.Request) (proto.Message, error)
+
+// callFunc calls the proxy with the decoded request
+type callFunc func(ctx context.Context, req proto.Message) (proto.Message, error)
+
+// Handlers translates the REST/JSON requests to the requests of the MilvusService and calls the proxy
+type Handlers struct {
+	proxy types.ProxyComponent
+}
+
+// NewHandlers creates the handlers of the REST API for the proxy
+func NewHandlers(proxy types.ProxyComponent) *Handlers {
+	return &Handlers{proxy: proxy}
+}
+
+// RegisterRoutesTo registers all the routes of the REST API to the mux
+func (h *Handlers) RegisterRoutesTo(mux *http.ServeMux) {
+	p := h.proxy
+
+	h.route(mux, "/database/create", protoDecoder(func() proto.Message { return &milvuspb.CreateDatabaseRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.CreateDatabase(ctx, req.(*milvuspb.CreateDatabaseRequest))
+		})
+	h.route(mux, "/database/drop", protoDecoder(func() proto.Message { return &milvuspb.DropDatabaseRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.DropDatabase(ctx, req.(*milvuspb.DropDatabaseRequest))
+		})
+	h.route(mux, "/database/list", protoDecoder(func() proto.Message { return &milvuspb.ListDatabasesRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.ListDatabases(ctx, req.(*milvuspb.ListDatabasesRequest))
+		})
+
+	h.route(mux, "/collection/create", decodeCreateCollectionRequest,
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.CreateCollection(ctx, req.(*milvuspb.CreateCollectionRequest))
+		})
+	h.route(mux, "/collection/drop", protoDecoder(func() proto.Message { return &milvuspb.DropCollectionRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.DropCollection(ctx, req.(*milvuspb.DropCollectionRequest))
+		})
+	h.route(mux, "/collection/has", protoDecoder(func() proto.Message { return &milvuspb.HasCollectionRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.HasCollection(ctx, req.(*milvuspb.HasCollectionRequest))
+		})
+	h.route(mux, "/collection/describe", protoDecoder(func() proto.Message { return &milvuspb.DescribeCollectionRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.DescribeCollection(ctx, req.(*milvuspb.DescribeCollectionRequest))
+		})
+	h.route(mux, "/collection/list", protoDecoder(func() proto.Message { return &milvuspb.ShowCollectionsRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.ShowCollections(ctx, req.(*milvuspb.ShowCollectionsRequest))
+		})
+	h.route(mux, "/collection/load", protoDecoder(func() proto.Message { return &milvuspb.LoadCollectionRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.LoadCollection(ctx, req.(*milvuspb.LoadCollectionRequest))
+		})
+	h.route(mux, "/collection/release", protoDecoder(func() proto.Message { return &milvuspb.ReleaseCollectionRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.ReleaseCollection(ctx, req.(*milvuspb.ReleaseCollectionRequest))
+		})
+	h.route(mux, "/collection/statistics", protoDecoder(func() proto.Message { return &milvuspb.GetCollectionStatisticsRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.GetCollectionStatistics(ctx, req.(*milvuspb.GetCollectionStatisticsRequest))
+		})
+	h.route(mux, "/collection/flush", protoDecoder(func() proto.Message { return &milvuspb.FlushRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.Flush(ctx, req.(*milvuspb.FlushRequest))
+		})
+	h.route(mux, "/collection/field/add", decodeAddCollectionFieldRequest,
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.AddCollectionField(ctx, req.(*milvuspb.AddCollectionFieldRequest))
+		})
+
+	h.route(mux, "/partition/create", protoDecoder(func() proto.Message { return &milvuspb.CreatePartitionRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.CreatePartition(ctx, req.(*milvuspb.CreatePartitionRequest))
+		})
+	h.route(mux, "/partition/drop", protoDecoder(func() proto.Message { return &milvuspb.DropPartitionRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.DropPartition(ctx, req.(*milvuspb.DropPartitionRequest))
+		})
+	h.route(mux, "/partition/has", protoDecoder(func() proto.Message { return &milvuspb.HasPartitionRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.HasPartition(ctx, req.(*milvuspb.HasPartitionRequest))
+		})
+	h.route(mux, "/partition/list", protoDecoder(func() proto.Message { return &milvuspb.ShowPartitionsRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.ShowPartitions(ctx, req.(*milvuspb.ShowPartitionsRequest))
+		})
+
+	h.route(mux, "/index/create", protoDecoder(func() proto.Message { return &milvuspb.CreateIndexRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.CreateIndex(ctx, req.(*milvuspb.CreateIndexRequest))
+		})
+	h.route(mux, "/index/describe", protoDecoder(func() proto.Message { return &milvuspb.DescribeIndexRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.DescribeIndex(ctx, req.(*milvuspb.DescribeIndexRequest))
+		})
+	h.route(mux, "/index/drop", protoDecoder(func() proto.Message { return &milvuspb.DropIndexRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.DropIndex(ctx, req.(*milvuspb.DropIndexRequest))
+		})
+
+	h.route(mux, "/entities/insert", decodeInsertRequest,
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.Insert(ctx, req.(*milvuspb.InsertRequest))
+		})
+	h.route(mux, "/entities/delete", protoDecoder(func() proto.Message { return &milvuspb.DeleteRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.Delete(ctx, req.(*milvuspb.DeleteRequest))
+		})
+	h.route(mux, "/entities/upsert", decodeUpsertRequest,
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.Upsert(ctx, req.(*milvuspb.UpsertRequest))
+		})
+	h.route(mux, "/entities/search", decodeSearchRequest,
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.Search(ctx, req.(*milvuspb.SearchRequest))
+		})
+	h.route(mux, "/entities/hybrid_search", decodeHybridSearchRequest,
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.HybridSearch(ctx, req.(*milvuspb.HybridSearchRequest))
+		})
+	h.route(mux, "/entities/query", protoDecoder(func() proto.Message { return &milvuspb.QueryRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.Query(ctx, req.(*milvuspb.QueryRequest))
+		})
+	h.route(mux, "/entities/query_iterator/open", protoDecoder(func() proto.Message { return &milvuspb.OpenQueryIteratorRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.OpenQueryIterator(ctx, req.(*milvuspb.OpenQueryIteratorRequest))
+		})
+	h.route(mux, "/entities/query_iterator/next", protoDecoder(func() proto.Message { return &milvuspb.NextQueryIteratorRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.NextQueryIterator(ctx, req.(*milvuspb.NextQueryIteratorRequest))
+		})
+	h.route(mux, "/entities/query_iterator/close", protoDecoder(func() proto.Message { return &milvuspb.CloseQueryIteratorRequest{} }),
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return p.CloseQueryIterator(ctx, req.(*milvuspb.CloseQueryIteratorRequest))
+		})
+}
+
+func (h *Handlers) route(mux *http.ServeMux, path string, decode decodeFunc, call callFunc) {
+	mux.HandleFunc(RouterPrefix+path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeStatus(w, http.StatusMethodNotAllowed, commonpb.ErrorCode_UnexpectedError, "only POST is supported")
+			return
+		}
+		req, err := decode(r)
+		if err != nil {
+			writeStatus(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+			return
+		}
+
+		ctx := requestContext(r)
+		ctx, err = proxy.AuthenticationInterceptor(ctx)
+		if err != nil {
+			writeStatus(w, http.StatusUnauthorized, commonpb.ErrorCode_PermissionDenied, err.Error())
+			return
+		}
+		ctx, err = proxy.PrivilegeInterceptor(ctx, req)
+		if err != nil {
+			writeStatus(w, http.StatusForbidden, commonpb.ErrorCode_PermissionDenied, err.Error())
+			return
+		}
+
+		resp, err := call(ctx, req)
+		if err != nil {
+			log.Warn("REST request failed", zap.String("path", r.URL.Path), zap.Error(err))
+			writeStatus(w, http.StatusInternalServerError, commonpb.ErrorCode_UnexpectedError, err.Error())
+			return
+		}
+		writeResponse(w, resp)
+	})
+}
+
+// requestContext carries the credential and the session of the http request in the gRPC metadata,
+// so that the interceptors and the consistency levels of the proxy can be reused
+func requestContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	if authorization := r.Header.Get(headerAuthorize); authorization != "" {
+		md.Set(strings.ToLower(headerAuthorize), strings.TrimPrefix(authorization, basicAuthPrefix))
+	}
+	if session := r.Header.Get(headerSessionID); session != "" {
+		md.Set(proxy.HeaderSessionID, session)
+	}
+	return metadata.NewIncomingContext(r.Context(), md)
+}
+
+// HTTPStatusFromErrorCode maps the error code of the proxy to the http status code
+func HTTPStatusFromErrorCode(code commonpb.ErrorCode) int {
+	switch code {
+	case commonpb.ErrorCode_Success:
+		return http.StatusOK
+	case commonpb.ErrorCode_PermissionDenied:
+		return http.StatusForbidden
+	case commonpb.ErrorCode_CollectionNotExists,
+		commonpb.ErrorCode_IndexNotExist,
+		commonpb.ErrorCode_FileNotFound:
+		return http.StatusNotFound
+	case commonpb.ErrorCode_IllegalArgument,
+		commonpb.ErrorCode_IllegalDimension,
+		commonpb.ErrorCode_IllegalIndexType,
+		commonpb.ErrorCode_IllegalCollectionName,
+		commonpb.ErrorCode_IllegalTOPK,
+		commonpb.ErrorCode_IllegalRowRecord,
+		commonpb.ErrorCode_IllegalVectorID,
+		commonpb.ErrorCode_IllegalSearchResult,
+		commonpb.ErrorCode_IllegalNLIST,
+		commonpb.ErrorCode_IllegalMetricType,
+		commonpb.ErrorCode_EmptyCollection:
+		return http.StatusBadRequest
+	case commonpb.ErrorCode_RateLimit:
+		return http.StatusTooManyRequests
+	case commonpb.ErrorCode_ConnectFailed:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,416 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+type mockProxy struct {
+	types.ProxyComponent
+	lastReq proto.Message
+}
+
+func (m *mockProxy) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	m.lastReq = req
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockProxy) AddCollectionField(ctx context.Context, req *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	m.lastReq = req
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockProxy) HasCollection(ctx context.Context, req *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
+	m.lastReq = req
+	return &milvuspb.BoolResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Value:  req.CollectionName == "coll",
+	}, nil
+}
+
+func (m *mockProxy) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	m.lastReq = req
+	return &milvuspb.DescribeCollectionResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists, Reason: "collection not found"},
+	}, nil
+}
+
+func (m *mockProxy) DropCollection(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	return nil, errors.New("mock error")
+}
+
+func (m *mockProxy) Insert(ctx context.Context, req *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	m.lastReq = req
+	return &milvuspb.MutationResult{
+		Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		InsertCnt: int64(req.NumRows),
+	}, nil
+}
+
+func (m *mockProxy) Upsert(ctx context.Context, req *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	m.lastReq = req
+	return &milvuspb.MutationResult{
+		Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		UpsertCnt: int64(req.NumRows),
+	}, nil
+}
+
+func (m *mockProxy) Delete(ctx context.Context, req *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	m.lastReq = req
+	return &milvuspb.MutationResult{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_RateLimit, Reason: "rate limit exceeded"},
+	}, nil
+}
+
+func (m *mockProxy) Search(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	m.lastReq = req
+	return &milvuspb.SearchResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (m *mockProxy) HybridSearch(ctx context.Context, req *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	m.lastReq = req
+	return &milvuspb.SearchResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (m *mockProxy) Query(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	m.lastReq = req
+	return &milvuspb.QueryResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IllegalArgument, Reason: "invalid expr"},
+	}, nil
+}
+
+func (m *mockProxy) OpenQueryIterator(ctx context.Context, req *milvuspb.OpenQueryIteratorRequest) (*milvuspb.OpenQueryIteratorResponse, error) {
+	m.lastReq = req
+	return &milvuspb.OpenQueryIteratorResponse{
+		Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		IteratorID: 7,
+	}, nil
+}
+
+func (m *mockProxy) NextQueryIterator(ctx context.Context, req *milvuspb.NextQueryIteratorRequest) (*milvuspb.QueryIteratorResults, error) {
+	m.lastReq = req
+	return &milvuspb.QueryIteratorResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Token:  "100",
+	}, nil
+}
+
+func (m *mockProxy) CloseQueryIterator(ctx context.Context, req *milvuspb.CloseQueryIteratorRequest) (*commonpb.Status, error) {
+	m.lastReq = req
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func TestHandlers(t *testing.T) {
+	mp := &mockProxy{}
+	mux := http.NewServeMux()
+	NewHandlers(mp).RegisterRoutesTo(mux)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	post := func(path string, body string) (int, map[string]interface{}) {
+		resp, err := http.Post(server.URL+RouterPrefix+path, "application/json", strings.NewReader(body))
+		assert.Nil(t, err)
+		defer resp.Body.Close()
+		ret := make(map[string]interface{})
+		err = json.NewDecoder(resp.Body).Decode(&ret)
+		assert.Nil(t, err)
+		return resp.StatusCode, ret
+	}
+
+	t.Run("method not allowed", func(t *testing.T) {
+		resp, err := http.Get(server.URL + RouterPrefix + "/collection/has")
+		assert.Nil(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		code, ret := post("/collection/has", "{")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument.String(), ret["error_code"])
+
+		code, _ = post("/collection/has", `{"unknown_field": 1}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("create collection", func(t *testing.T) {
+		code, _ := post("/collection/create", `{"collection_name": "coll", "shards_num": 2, "schema": {
+			"fields": [
+				{"name": "id", "is_primary_key": true, "data_type": "Int64"},
+				{"name": "vec", "data_type": "FloatVector", "type_params": [{"key": "dim", "value": "2"}]}
+			]}}`)
+		assert.Equal(t, http.StatusOK, code)
+		req, ok := mp.lastReq.(*milvuspb.CreateCollectionRequest)
+		assert.True(t, ok)
+		assert.Equal(t, "coll", req.CollectionName)
+		assert.Equal(t, int32(2), req.ShardsNum)
+		schema := &schemapb.CollectionSchema{}
+		assert.Nil(t, proto.Unmarshal(req.Schema, schema))
+		assert.Equal(t, "coll", schema.Name)
+		assert.Equal(t, 2, len(schema.Fields))
+		assert.Equal(t, schemapb.DataType_FloatVector, schema.Fields[1].DataType)
+
+		code, _ = post("/collection/create", `{"collection_name": "coll"}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("add collection field", func(t *testing.T) {
+		code, _ := post("/collection/field/add", `{"collection_name": "coll", "schema":
+			{"name": "price", "data_type": "Double", "nullable": true}}`)
+		assert.Equal(t, http.StatusOK, code)
+		req, ok := mp.lastReq.(*milvuspb.AddCollectionFieldRequest)
+		assert.True(t, ok)
+		assert.Equal(t, "coll", req.CollectionName)
+		field := &schemapb.FieldSchema{}
+		assert.Nil(t, proto.Unmarshal(req.Schema, field))
+		assert.Equal(t, "price", field.Name)
+		assert.Equal(t, schemapb.DataType_Double, field.DataType)
+		assert.True(t, field.Nullable)
+
+		code, _ = post("/collection/field/add", `{"collection_name": "coll"}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("has collection", func(t *testing.T) {
+		code, ret := post("/collection/has", `{"db_name": "db", "collection_name": "coll"}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, true, ret["value"])
+		assert.Equal(t, "db", mp.lastReq.(*milvuspb.HasCollectionRequest).DbName)
+	})
+
+	t.Run("error code", func(t *testing.T) {
+		code, ret := post("/collection/describe", `{"collection_name": "coll"}`)
+		assert.Equal(t, http.StatusNotFound, code)
+		status := ret["status"].(map[string]interface{})
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotExists.String(), status["error_code"])
+
+		code, _ = post("/entities/query", `{"collection_name": "coll", "expr": "id in [1]"}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "id in [1]", mp.lastReq.(*milvuspb.QueryRequest).Expr)
+
+		code, ret = post("/entities/delete", `{"collection_name": "coll", "expr": "id in [1]"}`)
+		assert.Equal(t, http.StatusTooManyRequests, code)
+		status = ret["status"].(map[string]interface{})
+		assert.Equal(t, commonpb.ErrorCode_RateLimit.String(), status["error_code"])
+
+		code, ret = post("/collection/drop", `{"collection_name": "coll"}`)
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, "mock error", ret["reason"])
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		code, ret := post("/entities/insert", `{"collection_name": "coll", "fields_data": [
+			{"field_name": "id", "type": "Int64", "field": [1, 2]},
+			{"field_name": "vec", "type": "FloatVector", "field": [[0.1, 0.2], [0.3, 0.4]]},
+			{"field_name": "bin", "type": "BinaryVector", "field": [[1], [255]]}
+		]}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "2", ret["insert_cnt"])
+		req := mp.lastReq.(*milvuspb.InsertRequest)
+		assert.Equal(t, uint32(2), req.NumRows)
+		assert.Equal(t, 3, len(req.FieldsData))
+		assert.Equal(t, []int64{1, 2}, req.FieldsData[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, int64(2), req.FieldsData[1].GetVectors().GetDim())
+		assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, req.FieldsData[1].GetVectors().GetFloatVector().GetData())
+		assert.Equal(t, int64(8), req.FieldsData[2].GetVectors().GetDim())
+		assert.Equal(t, []byte{1, 255}, req.FieldsData[2].GetVectors().GetBinaryVector())
+
+		// mismatched number of rows
+		code, _ = post("/entities/insert", `{"collection_name": "coll", "fields_data": [
+			{"field_name": "id", "type": "Int64", "field": [1, 2]},
+			{"field_name": "vec", "type": "FloatVector", "field": [[0.1, 0.2]]}
+		]}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		// mismatched dimension
+		code, _ = post("/entities/insert", `{"collection_name": "coll", "fields_data": [
+			{"field_name": "vec", "type": "FloatVector", "field": [[0.1, 0.2], [0.3]]}
+		]}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		// unknown type
+		code, _ = post("/entities/insert", `{"collection_name": "coll", "fields_data": [
+			{"field_name": "id", "type": "Int128", "field": [1, 2]}
+		]}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		code, ret := post("/entities/upsert", `{"collection_name": "coll", "partition_name": "p", "fields_data": [
+			{"field_name": "id", "type": "Int64", "field": [1, 2]},
+			{"field_name": "vec", "type": "FloatVector", "field": [[0.1, 0.2], [0.3, 0.4]]}
+		]}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "2", ret["upsert_cnt"])
+		req := mp.lastReq.(*milvuspb.UpsertRequest)
+		assert.Equal(t, "p", req.PartitionName)
+		assert.Equal(t, uint32(2), req.NumRows)
+		assert.Equal(t, []int64{1, 2}, req.FieldsData[0].GetScalars().GetLongData().GetData())
+
+		code, _ = post("/entities/upsert", `{"collection_name": "coll", "fields_data": [
+			{"field_name": "id", "type": "Int64", "field": [1, 2]}
+		], "num_rows": 3}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		code, _ := post("/entities/search", `{"collection_name": "coll", "dsl": "id > 0",
+			"search_params": {"anns_field": "vec", "topk": 10, "metric_type": "L2", "params": {"nprobe": 10}},
+			"vectors": [[0.1, 0.2], [0.3, 0.4]]}`)
+		assert.Equal(t, http.StatusOK, code)
+		req := mp.lastReq.(*milvuspb.SearchRequest)
+		assert.Equal(t, "id > 0", req.Dsl)
+		assert.Equal(t, commonpb.DslType_BoolExprV1, req.DslType)
+		params := make(map[string]string)
+		for _, pair := range req.SearchParams {
+			params[pair.Key] = pair.Value
+		}
+		assert.Equal(t, map[string]string{
+			"anns_field":  "vec",
+			"topk":        "10",
+			"metric_type": "L2",
+			"params":      `{"nprobe": 10}`,
+		}, params)
+
+		placeholderGroup := &milvuspb.PlaceholderGroup{}
+		assert.Nil(t, proto.Unmarshal(req.PlaceholderGroup, placeholderGroup))
+		assert.Equal(t, 1, len(placeholderGroup.Placeholders))
+		placeholder := placeholderGroup.Placeholders[0]
+		assert.Equal(t, placeholderTag, placeholder.Tag)
+		assert.Equal(t, milvuspb.PlaceholderType_FloatVector, placeholder.Type)
+		assert.Equal(t, 2, len(placeholder.Values))
+		vector := make([]float32, 2)
+		assert.Nil(t, binary.Read(bytes.NewReader(placeholder.Values[1]), common.Endian, vector))
+		assert.Equal(t, []float32{0.3, 0.4}, vector)
+
+		code, _ = post("/entities/search", `{"collection_name": "coll", "binary_vectors": [[1, 2]]}`)
+		assert.Equal(t, http.StatusOK, code)
+		req = mp.lastReq.(*milvuspb.SearchRequest)
+		assert.Nil(t, proto.Unmarshal(req.PlaceholderGroup, placeholderGroup))
+		assert.Equal(t, milvuspb.PlaceholderType_BinaryVector, placeholderGroup.Placeholders[0].Type)
+		assert.Equal(t, [][]byte{{1, 2}}, placeholderGroup.Placeholders[0].Values)
+
+		code, _ = post("/entities/search", `{"collection_name": "coll"}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		code, _ = post("/entities/search", `{"collection_name": "coll", "vectors": [[0.1]], "binary_vectors": [[1]]}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("hybrid search", func(t *testing.T) {
+		code, _ := post("/entities/hybrid_search", `{"collection_name": "coll", "output_fields": ["id"],
+			"requests": [
+				{"search_params": {"anns_field": "vec1", "topk": 10, "metric_type": "L2", "params": {"nprobe": 10}}, "vectors": [[0.1, 0.2]]},
+				{"search_params": {"anns_field": "vec2", "topk": 10, "metric_type": "IP", "params": {"nprobe": 10}}, "vectors": [[0.3]]}
+			],
+			"rank_params": {"strategy": "weighted", "weights": [0.6, 0.4], "topk": 5}}`)
+		assert.Equal(t, http.StatusOK, code)
+		req := mp.lastReq.(*milvuspb.HybridSearchRequest)
+		assert.Equal(t, "coll", req.CollectionName)
+		assert.Equal(t, []string{"id"}, req.OutputFields)
+		assert.Equal(t, 2, len(req.Requests))
+		assert.Equal(t, commonpb.DslType_BoolExprV1, req.Requests[1].DslType)
+		params := make(map[string]string)
+		for _, pair := range req.RankParams {
+			params[pair.Key] = pair.Value
+		}
+		assert.Equal(t, map[string]string{
+			"strategy": "weighted",
+			"weights":  "[0.6, 0.4]",
+			"topk":     "5",
+		}, params)
+
+		code, _ = post("/entities/hybrid_search", `{"collection_name": "coll", "requests": [null]}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		code, _ = post("/entities/hybrid_search", `{"collection_name": "coll", "requests": [{"search_params": {}}]}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("query iterator", func(t *testing.T) {
+		code, ret := post("/entities/query_iterator/open", `{"collection_name": "coll", "expr": "id > 1", "batch_size": 10}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "7", ret["iteratorID"])
+		openReq := mp.lastReq.(*milvuspb.OpenQueryIteratorRequest)
+		assert.Equal(t, "id > 1", openReq.Expr)
+		assert.Equal(t, int64(10), openReq.BatchSize)
+
+		code, ret = post("/entities/query_iterator/next", `{"iteratorID": 7, "token": "99"}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "100", ret["token"])
+		assert.Equal(t, "99", mp.lastReq.(*milvuspb.NextQueryIteratorRequest).Token)
+
+		code, _ = post("/entities/query_iterator/close", `{"iteratorID": 7}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int64(7), mp.lastReq.(*milvuspb.CloseQueryIteratorRequest).IteratorID)
+	})
+}
+
+func TestRequestContext(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, RouterPrefix+"/collection/has", nil)
+	_, err := proxy.GetCurUserFromContext(requestContext(r))
+	assert.NotNil(t, err)
+
+	token := "cm9vdDpNaWx2dXM=" // root:Milvus
+	r.Header.Set(headerAuthorize, basicAuthPrefix+token)
+	username, err := proxy.GetCurUserFromContext(requestContext(r))
+	assert.Nil(t, err)
+	assert.Equal(t, "root", username)
+
+	r.Header.Set(headerAuthorize, token)
+	username, err = proxy.GetCurUserFromContext(requestContext(r))
+	assert.Nil(t, err)
+	assert.Equal(t, "root", username)
+
+	md, _ := metadata.FromIncomingContext(requestContext(r))
+	assert.Empty(t, md.Get(proxy.HeaderSessionID))
+	r.Header.Set(headerSessionID, "s1")
+	md, _ = metadata.FromIncomingContext(requestContext(r))
+	assert.Equal(t, []string{"s1"}, md.Get(proxy.HeaderSessionID))
+}
+
+func TestHTTPStatusFromErrorCode(t *testing.T) {
+	assert.Equal(t, http.StatusOK, HTTPStatusFromErrorCode(commonpb.ErrorCode_Success))
+	assert.Equal(t, http.StatusForbidden, HTTPStatusFromErrorCode(commonpb.ErrorCode_PermissionDenied))
+	assert.Equal(t, http.StatusNotFound, HTTPStatusFromErrorCode(commonpb.ErrorCode_CollectionNotExists))
+	assert.Equal(t, http.StatusBadRequest, HTTPStatusFromErrorCode(commonpb.ErrorCode_IllegalDimension))
+	assert.Equal(t, http.StatusTooManyRequests, HTTPStatusFromErrorCode(commonpb.ErrorCode_RateLimit))
+	assert.Equal(t, http.StatusServiceUnavailable, HTTPStatusFromErrorCode(commonpb.ErrorCode_ConnectFailed))
+	assert.Equal(t, http.StatusInternalServerError, HTTPStatusFromErrorCode(commonpb.ErrorCode_UnexpectedError))
+}
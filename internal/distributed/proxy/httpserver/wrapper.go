@@ -0,0 +1,498 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const (
+	// placeholderTag is the tag of the vectors of the search request, it's referred by the dsl
+	placeholderTag = "$0"
+)
+
+var jsonMarshaler = &jsonpb.Marshaler{OrigName: true}
+
+// statusGetter is implemented by all the responses except commonpb.Status
+type statusGetter interface {
+	GetStatus() *commonpb.Status
+}
+
+// protoDecoder decodes the JSON body to the milvuspb request directly, the field names of the proto are used as keys
+func protoDecoder(newReq func() proto.Message) decodeFunc {
+	return func(r *http.Request) (proto.Message, error) {
+		req := newReq()
+		unmarshaler := &jsonpb.Unmarshaler{}
+		if err := unmarshaler.Unmarshal(r.Body, req); err != nil {
+			return nil, fmt.Errorf("invalid request body: %w", err)
+		}
+		return req, nil
+	}
+}
+
+// CreateCollectionRequest is the JSON body of the create collection request,
+// the schema is a JSON object of schemapb.CollectionSchema rather than the serialized bytes
+type CreateCollectionRequest struct {
+	DbName         string          `json:"db_name"`
+	CollectionName string          `json:"collection_name"`
+	ShardsNum      int32           `json:"shards_num"`
+	NumPartitions  int64           `json:"num_partitions"`
+	Schema         json.RawMessage `json:"schema"`
+}
+
+func decodeCreateCollectionRequest(r *http.Request) (proto.Message, error) {
+	body := &CreateCollectionRequest{}
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		return nil, fmt.Errorf("invalid request body: %w", err)
+	}
+	if len(body.Schema) == 0 {
+		return nil, errors.New("schema should not be empty")
+	}
+	schema := &schemapb.CollectionSchema{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(body.Schema), schema); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	if schema.Name == "" {
+		schema.Name = body.CollectionName
+	}
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &milvuspb.CreateCollectionRequest{
+		DbName:         body.DbName,
+		CollectionName: body.CollectionName,
+		ShardsNum:      body.ShardsNum,
+		NumPartitions:  body.NumPartitions,
+		Schema:         schemaBytes,
+	}, nil
+}
+
+// AddCollectionFieldRequest is the JSON body of the add collection field request,
+// the schema is a JSON object of schemapb.FieldSchema rather than the serialized bytes
+type AddCollectionFieldRequest struct {
+	DbName         string          `json:"db_name"`
+	CollectionName string          `json:"collection_name"`
+	Schema         json.RawMessage `json:"schema"`
+}
+
+func decodeAddCollectionFieldRequest(r *http.Request) (proto.Message, error) {
+	body := &AddCollectionFieldRequest{}
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		return nil, fmt.Errorf("invalid request body: %w", err)
+	}
+	if len(body.Schema) == 0 {
+		return nil, errors.New("schema should not be empty")
+	}
+	field := &schemapb.FieldSchema{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(body.Schema), field); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	fieldBytes, err := proto.Marshal(field)
+	if err != nil {
+		return nil, err
+	}
+	return &milvuspb.AddCollectionFieldRequest{
+		DbName:         body.DbName,
+		CollectionName: body.CollectionName,
+		Schema:         fieldBytes,
+	}, nil
+}
+
+// FieldData is the column of the insert request, the type is the name of schemapb.DataType,
+// vectors are arrays of numbers, one array per row
+type FieldData struct {
+	FieldName string          `json:"field_name"`
+	Type      string          `json:"type"`
+	Field     json.RawMessage `json:"field"`
+}
+
+// InsertRequest is the JSON body of the insert request
+type InsertRequest struct {
+	DbName         string      `json:"db_name"`
+	CollectionName string      `json:"collection_name"`
+	PartitionName  string      `json:"partition_name"`
+	FieldsData     []FieldData `json:"fields_data"`
+	NumRows        uint32      `json:"num_rows"`
+}
+
+func decodeInsertRequest(r *http.Request) (proto.Message, error) {
+	body := &InsertRequest{}
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		return nil, fmt.Errorf("invalid request body: %w", err)
+	}
+	return convertInsertRequest(body)
+}
+
+// decodeUpsertRequest decodes the upsert request, which has the same JSON body as the insert request
+func decodeUpsertRequest(r *http.Request) (proto.Message, error) {
+	body := &InsertRequest{}
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		return nil, fmt.Errorf("invalid request body: %w", err)
+	}
+	req, err := convertInsertRequest(body)
+	if err != nil {
+		return nil, err
+	}
+	return &milvuspb.UpsertRequest{
+		DbName:         req.DbName,
+		CollectionName: req.CollectionName,
+		PartitionName:  req.PartitionName,
+		FieldsData:     req.FieldsData,
+		NumRows:        req.NumRows,
+	}, nil
+}
+
+func convertInsertRequest(body *InsertRequest) (*milvuspb.InsertRequest, error) {
+	req := &milvuspb.InsertRequest{
+		DbName:         body.DbName,
+		CollectionName: body.CollectionName,
+		PartitionName:  body.PartitionName,
+		FieldsData:     make([]*schemapb.FieldData, 0, len(body.FieldsData)),
+		NumRows:        body.NumRows,
+	}
+	for i := range body.FieldsData {
+		field := &body.FieldsData[i]
+		fieldData, numRows, err := convertFieldData(field)
+		if err != nil {
+			return nil, err
+		}
+		if req.NumRows == 0 {
+			req.NumRows = numRows
+		}
+		if numRows != req.NumRows {
+			return nil, fmt.Errorf("the number of rows of field %s is %d, expected %d", field.FieldName, numRows, req.NumRows)
+		}
+		req.FieldsData = append(req.FieldsData, fieldData)
+	}
+	return req, nil
+}
+
+// convertFieldData converts the JSON column to schemapb.FieldData, returns the field data and the number of rows
+func convertFieldData(field *FieldData) (*schemapb.FieldData, uint32, error) {
+	dataType, ok := schemapb.DataType_value[field.Type]
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid type %s of field %s", field.Type, field.FieldName)
+	}
+	fieldData := &schemapb.FieldData{
+		Type:      schemapb.DataType(dataType),
+		FieldName: field.FieldName,
+	}
+	invalidErr := func(err error) error {
+		return fmt.Errorf("invalid data of field %s: %w", field.FieldName, err)
+	}
+
+	var numRows int
+	switch fieldData.Type {
+	case schemapb.DataType_Bool:
+		var data []bool
+		if err := json.Unmarshal(field.Field, &data); err != nil {
+			return nil, 0, invalidErr(err)
+		}
+		numRows = len(data)
+		fieldData.Field = scalarField(&schemapb.ScalarField{
+			Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}},
+		})
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		var data []int32
+		if err := json.Unmarshal(field.Field, &data); err != nil {
+			return nil, 0, invalidErr(err)
+		}
+		numRows = len(data)
+		fieldData.Field = scalarField(&schemapb.ScalarField{
+			Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}},
+		})
+	case schemapb.DataType_Int64:
+		var data []int64
+		if err := json.Unmarshal(field.Field, &data); err != nil {
+			return nil, 0, invalidErr(err)
+		}
+		numRows = len(data)
+		fieldData.Field = scalarField(&schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+		})
+	case schemapb.DataType_Float:
+		var data []float32
+		if err := json.Unmarshal(field.Field, &data); err != nil {
+			return nil, 0, invalidErr(err)
+		}
+		numRows = len(data)
+		fieldData.Field = scalarField(&schemapb.ScalarField{
+			Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}},
+		})
+	case schemapb.DataType_Double:
+		var data []float64
+		if err := json.Unmarshal(field.Field, &data); err != nil {
+			return nil, 0, invalidErr(err)
+		}
+		numRows = len(data)
+		fieldData.Field = scalarField(&schemapb.ScalarField{
+			Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}},
+		})
+	case schemapb.DataType_String:
+		var data []string
+		if err := json.Unmarshal(field.Field, &data); err != nil {
+			return nil, 0, invalidErr(err)
+		}
+		numRows = len(data)
+		fieldData.Field = scalarField(&schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}},
+		})
+	case schemapb.DataType_FloatVector:
+		var rows [][]float32
+		if err := json.Unmarshal(field.Field, &rows); err != nil {
+			return nil, 0, invalidErr(err)
+		}
+		dim, data, err := flattenFloatVectors(rows)
+		if err != nil {
+			return nil, 0, invalidErr(err)
+		}
+		numRows = len(rows)
+		fieldData.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  int64(dim),
+			Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}},
+		}}
+	case schemapb.DataType_BinaryVector:
+		var rows [][]byte
+		if err := json.Unmarshal(field.Field, &rows); err != nil {
+			return nil, 0, invalidErr(err)
+		}
+		dim, data, err := flattenBinaryVectors(rows)
+		if err != nil {
+			return nil, 0, invalidErr(err)
+		}
+		numRows = len(rows)
+		fieldData.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  int64(dim),
+			Data: &schemapb.VectorField_BinaryVector{BinaryVector: data},
+		}}
+	default:
+		return nil, 0, fmt.Errorf("unsupported type %s of field %s", field.Type, field.FieldName)
+	}
+	return fieldData, uint32(numRows), nil
+}
+
+func scalarField(scalars *schemapb.ScalarField) *schemapb.FieldData_Scalars {
+	return &schemapb.FieldData_Scalars{Scalars: scalars}
+}
+
+// flattenFloatVectors returns the dimension and the concatenated float vectors
+func flattenFloatVectors(rows [][]float32) (int, []float32, error) {
+	if len(rows) == 0 {
+		return 0, nil, errors.New("vectors should not be empty")
+	}
+	dim := len(rows[0])
+	data := make([]float32, 0, dim*len(rows))
+	for _, row := range rows {
+		if len(row) != dim || dim == 0 {
+			return 0, nil, fmt.Errorf("all the vectors should have the same non-zero dimension %d", dim)
+		}
+		data = append(data, row...)
+	}
+	return dim, data, nil
+}
+
+// flattenBinaryVectors returns the dimension in bits and the concatenated binary vectors
+func flattenBinaryVectors(rows [][]byte) (int, []byte, error) {
+	if len(rows) == 0 {
+		return 0, nil, errors.New("vectors should not be empty")
+	}
+	size := len(rows[0])
+	data := make([]byte, 0, size*len(rows))
+	for _, row := range rows {
+		if len(row) != size || size == 0 {
+			return 0, nil, fmt.Errorf("all the vectors should have the same non-zero size %d", size)
+		}
+		data = append(data, row...)
+	}
+	return size * 8, data, nil
+}
+
+// SearchRequest is the JSON body of the search request, the search params are a JSON object
+// such as {"anns_field": "vec", "topk": 10, "metric_type": "L2", "params": {"nprobe": 10}},
+// the query vectors are arrays of numbers given by either vectors or binary_vectors
+type SearchRequest struct {
+	DbName             string                     `json:"db_name"`
+	CollectionName     string                     `json:"collection_name"`
+	PartitionNames     []string                   `json:"partition_names"`
+	Dsl                string                     `json:"dsl"`
+	OutputFields       []string                   `json:"output_fields"`
+	SearchParams       map[string]json.RawMessage `json:"search_params"`
+	Vectors            [][]float32                `json:"vectors"`
+	BinaryVectors      [][]byte                   `json:"binary_vectors"`
+	TravelTimestamp    uint64                     `json:"travel_timestamp"`
+	GuaranteeTimestamp uint64                     `json:"guarantee_timestamp"`
+}
+
+func decodeSearchRequest(r *http.Request) (proto.Message, error) {
+	body := &SearchRequest{}
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		return nil, fmt.Errorf("invalid request body: %w", err)
+	}
+	return convertSearchRequest(body)
+}
+
+func convertSearchRequest(body *SearchRequest) (*milvuspb.SearchRequest, error) {
+	placeholderGroup, err := convertPlaceholderGroup(body.Vectors, body.BinaryVectors)
+	if err != nil {
+		return nil, err
+	}
+	placeholderGroupBytes, err := proto.Marshal(placeholderGroup)
+	if err != nil {
+		return nil, err
+	}
+	return &milvuspb.SearchRequest{
+		DbName:             body.DbName,
+		CollectionName:     body.CollectionName,
+		PartitionNames:     body.PartitionNames,
+		Dsl:                body.Dsl,
+		PlaceholderGroup:   placeholderGroupBytes,
+		DslType:            commonpb.DslType_BoolExprV1,
+		OutputFields:       body.OutputFields,
+		SearchParams:       convertSearchParams(body.SearchParams),
+		TravelTimestamp:    body.TravelTimestamp,
+		GuaranteeTimestamp: body.GuaranteeTimestamp,
+	}, nil
+}
+
+// HybridSearchRequest is the JSON body of the hybrid search request, each of the requests is a search request
+// on a vector field, and the rank params such as {"strategy": "weighted", "weights": [0.6, 0.4], "topk": 10}
+// are a JSON object as the search params
+type HybridSearchRequest struct {
+	DbName             string                     `json:"db_name"`
+	CollectionName     string                     `json:"collection_name"`
+	PartitionNames     []string                   `json:"partition_names"`
+	Requests           []*SearchRequest           `json:"requests"`
+	RankParams         map[string]json.RawMessage `json:"rank_params"`
+	OutputFields       []string                   `json:"output_fields"`
+	TravelTimestamp    uint64                     `json:"travel_timestamp"`
+	GuaranteeTimestamp uint64                     `json:"guarantee_timestamp"`
+}
+
+func decodeHybridSearchRequest(r *http.Request) (proto.Message, error) {
+	body := &HybridSearchRequest{}
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		return nil, fmt.Errorf("invalid request body: %w", err)
+	}
+	requests := make([]*milvuspb.SearchRequest, 0, len(body.Requests))
+	for _, subBody := range body.Requests {
+		if subBody == nil {
+			return nil, errors.New("search request should not be null")
+		}
+		request, err := convertSearchRequest(subBody)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return &milvuspb.HybridSearchRequest{
+		DbName:             body.DbName,
+		CollectionName:     body.CollectionName,
+		PartitionNames:     body.PartitionNames,
+		Requests:           requests,
+		RankParams:         convertSearchParams(body.RankParams),
+		OutputFields:       body.OutputFields,
+		TravelTimestamp:    body.TravelTimestamp,
+		GuaranteeTimestamp: body.GuaranteeTimestamp,
+	}, nil
+}
+
+// convertSearchParams converts the search params to key value pairs, the string values are unquoted
+// and the other values such as numbers and objects are kept as JSON text
+func convertSearchParams(params map[string]json.RawMessage) []*commonpb.KeyValuePair {
+	pairs := make([]*commonpb.KeyValuePair, 0, len(params))
+	for key, raw := range params {
+		value := string(raw)
+		var str string
+		if err := json.Unmarshal(raw, &str); err == nil {
+			value = str
+		}
+		pairs = append(pairs, &commonpb.KeyValuePair{Key: key, Value: value})
+	}
+	return pairs
+}
+
+func convertPlaceholderGroup(vectors [][]float32, binaryVectors [][]byte) (*milvuspb.PlaceholderGroup, error) {
+	if len(vectors) > 0 && len(binaryVectors) > 0 {
+		return nil, errors.New("only one of vectors and binary_vectors can be set")
+	}
+	placeholder := &milvuspb.PlaceholderValue{Tag: placeholderTag}
+	if len(binaryVectors) > 0 {
+		if _, _, err := flattenBinaryVectors(binaryVectors); err != nil {
+			return nil, err
+		}
+		placeholder.Type = milvuspb.PlaceholderType_BinaryVector
+		placeholder.Values = binaryVectors
+	} else {
+		if _, _, err := flattenFloatVectors(vectors); err != nil {
+			return nil, err
+		}
+		placeholder.Type = milvuspb.PlaceholderType_FloatVector
+		placeholder.Values = make([][]byte, 0, len(vectors))
+		for _, vector := range vectors {
+			var buffer bytes.Buffer
+			if err := binary.Write(&buffer, common.Endian, vector); err != nil {
+				return nil, err
+			}
+			placeholder.Values = append(placeholder.Values, buffer.Bytes())
+		}
+	}
+	return &milvuspb.PlaceholderGroup{Placeholders: []*milvuspb.PlaceholderValue{placeholder}}, nil
+}
+
+// writeResponse writes the response in JSON, the http status code is mapped from the error code of the status
+func writeResponse(w http.ResponseWriter, resp proto.Message) {
+	var status *commonpb.Status
+	switch r := resp.(type) {
+	case *commonpb.Status:
+		status = r
+	case statusGetter:
+		status = r.GetStatus()
+	}
+	code := http.StatusOK
+	if status != nil {
+		code = HTTPStatusFromErrorCode(status.ErrorCode)
+	}
+	writeJSON(w, code, resp)
+}
+
+func writeStatus(w http.ResponseWriter, code int, errorCode commonpb.ErrorCode, reason string) {
+	writeJSON(w, code, &commonpb.Status{ErrorCode: errorCode, Reason: reason})
+}
+
+func writeJSON(w http.ResponseWriter, code int, resp proto.Message) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := jsonMarshaler.Marshal(w, resp); err != nil {
+		log.Warn("failed to write the REST response", zap.Error(err))
+	}
+}
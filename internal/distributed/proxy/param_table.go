@@ -44,6 +44,9 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+
+	HTTPEnabled bool
+	HTTPPort    int
 }
 
 // Params is a package scoped variable of type ParamTable.
@@ -76,6 +79,8 @@ func (pt *ParamTable) loadFromEnv() {
 
 func (pt *ParamTable) initParams() {
 	pt.initPort()
+	pt.initHTTPEnabled()
+	pt.initHTTPPort()
 	pt.initRootCoordAddress()
 	pt.initIndexCoordAddress()
 	pt.initDataCoordAddress()
@@ -123,6 +128,14 @@ func (pt *ParamTable) initPort() {
 	pt.Port = port
 }
 
+func (pt *ParamTable) initHTTPEnabled() {
+	pt.HTTPEnabled = pt.ParseBool("proxy.http.enabled", true)
+}
+
+func (pt *ParamTable) initHTTPPort() {
+	pt.HTTPPort = pt.ParseIntWithDefault("proxy.http.port", DefaultHTTPPort)
+}
+
 func (pt *ParamTable) initServerMaxSendSize() {
 	var err error
 
@@ -40,6 +40,14 @@ func TestParamTable(t *testing.T) {
 	Params.initServerMaxRecvSize()
 	assert.Equal(t, Params.ServerMaxRecvSize, grpcconfigs.DefaultServerMaxRecvSize)
 
+	Params.Remove("proxy.http.enabled")
+	Params.initHTTPEnabled()
+	assert.True(t, Params.HTTPEnabled)
+
+	Params.Remove("proxy.http.port")
+	Params.initHTTPPort()
+	assert.Equal(t, Params.HTTPPort, DefaultHTTPPort)
+
 	Params.loadFromEnv()
 	assert.Equal(t, Params.IP, funcutil.GetLocalIP())
 }
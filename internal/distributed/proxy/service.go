@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
@@ -30,10 +31,13 @@ import (
 
 	grpcdatacoordclient "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	grpcindexcoordclient "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
+	"github.com/milvus-io/milvus/internal/distributed/proxy/httpserver"
 	grpcquerycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/types"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -49,6 +53,9 @@ import (
 
 const (
 	GRPCMaxMagSize = 2 << 30
+
+	// DefaultHTTPPort is the default port of the REST/JSON gateway
+	DefaultHTTPPort = 19121
 )
 
 type Server struct {
@@ -56,6 +63,7 @@ type Server struct {
 	wg         sync.WaitGroup
 	proxy      types.ProxyComponent
 	grpcServer *grpc.Server
+	httpServer *http.Server
 
 	grpcErrChan chan error
 
@@ -104,10 +112,16 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.MaxRecvMsgSize(GRPCMaxMagSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			grpc_opentracing.UnaryServerInterceptor(opts...),
+			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
+			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
+		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_opentracing.StreamServerInterceptor(opts...),
+			grpc_auth.StreamServerInterceptor(proxy.AuthenticationInterceptor),
+			proxy.StreamServerInterceptor(proxy.PrivilegeInterceptor),
+		)))
 	proxypb.RegisterProxyServer(s.grpcServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcServer, s)
 
@@ -118,6 +132,29 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 
 }
 
+// startHTTPServer serves the REST/JSON gateway which translates the http requests to the requests of the MilvusService
+func (s *Server) startHTTPServer(httpPort int) error {
+	lis, err := net.Listen("tcp", ":"+strconv.Itoa(httpPort))
+	if err != nil {
+		log.Warn("proxy failed to listen on http port", zap.Int("port", httpPort), zap.Error(err))
+		return err
+	}
+
+	mux := http.NewServeMux()
+	httpserver.NewHandlers(s.proxy).RegisterRoutesTo(mux)
+	s.httpServer = &http.Server{Handler: mux}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		log.Debug("proxy", zap.Int("http port", httpPort))
+		if err := s.httpServer.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Warn("proxy http server stopped", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
 func (s *Server) Run() error {
 
 	if err := s.init(); err != nil {
@@ -173,6 +210,13 @@ func (s *Server) init() error {
 		return err
 	}
 
+	if Params.HTTPEnabled {
+		if err = s.startHTTPServer(Params.HTTPPort); err != nil {
+			return err
+		}
+		log.Debug("create http server ...")
+	}
+
 	rootCoordAddr := Params.RootCoordAddress
 	log.Debug("Proxy", zap.String("RootCoord address", rootCoordAddr))
 
@@ -277,6 +321,12 @@ func (s *Server) Stop() error {
 		s.grpcServer.GracefulStop()
 	}
 
+	if s.httpServer != nil {
+		if err = s.httpServer.Close(); err != nil {
+			return err
+		}
+	}
+
 	err = s.proxy.Stop()
 	if err != nil {
 		return err
@@ -303,6 +353,16 @@ func (s *Server) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.R
 	return s.proxy.ReleaseDQLMessageStream(ctx, request)
 }
 
+// InvalidateCredentialCache notifies Proxy to clear the cached credential of specific user
+func (s *Server) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.InvalidateCredentialCache(ctx, request)
+}
+
+// RefreshPolicyInfoCache notifies Proxy to refresh the privilege cache
+func (s *Server) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.RefreshPolicyInfoCache(ctx, request)
+}
+
 func (s *Server) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateCollection(ctx, request)
 }
@@ -393,10 +453,18 @@ func (s *Server) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*
 	return s.proxy.Delete(ctx, request)
 }
 
+func (s *Server) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	return s.proxy.Upsert(ctx, request)
+}
+
 func (s *Server) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return s.proxy.Search(ctx, request)
 }
 
+func (s *Server) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	return s.proxy.HybridSearch(ctx, request)
+}
+
 func (s *Server) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	return s.proxy.Flush(ctx, request)
 }
@@ -405,6 +473,18 @@ func (s *Server) Query(ctx context.Context, request *milvuspb.QueryRequest) (*mi
 	return s.proxy.Query(ctx, request)
 }
 
+func (s *Server) OpenQueryIterator(ctx context.Context, request *milvuspb.OpenQueryIteratorRequest) (*milvuspb.OpenQueryIteratorResponse, error) {
+	return s.proxy.OpenQueryIterator(ctx, request)
+}
+
+func (s *Server) NextQueryIterator(ctx context.Context, request *milvuspb.NextQueryIteratorRequest) (*milvuspb.QueryIteratorResults, error) {
+	return s.proxy.NextQueryIterator(ctx, request)
+}
+
+func (s *Server) CloseQueryIterator(ctx context.Context, request *milvuspb.CloseQueryIteratorRequest) (*commonpb.Status, error) {
+	return s.proxy.CloseQueryIterator(ctx, request)
+}
+
 func (s *Server) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return s.proxy.CalcDistance(ctx, request)
 }
@@ -450,6 +530,11 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.proxy.AlterAlias(ctx, request)
 }
 
+// AddCollectionField adds a nullable or defaulted scalar field to an existing collection
+func (s *Server) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddCollectionField(ctx, request)
+}
+
 func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	return s.proxy.GetCompactionState(ctx, req)
 }
@@ -461,3 +546,63 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
 	return s.proxy.GetCompactionStateWithPlans(ctx, req)
 }
+
+// CreateCredential creates a new user
+func (s *Server) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateCredential(ctx, req)
+}
+
+// UpdateCredential updates the password of a user
+func (s *Server) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.UpdateCredential(ctx, req)
+}
+
+// DeleteCredential deletes a user
+func (s *Server) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.DeleteCredential(ctx, req)
+}
+
+// ListCredUsers lists all the usernames
+func (s *Server) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return s.proxy.ListCredUsers(ctx, req)
+}
+
+// CreateRole creates a new role
+func (s *Server) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateRole(ctx, req)
+}
+
+// DropRole drops a role
+func (s *Server) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRole(ctx, req)
+}
+
+// OperateUserRole binds or unbinds a user and a role
+func (s *Server) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.OperateUserRole(ctx, req)
+}
+
+// OperatePrivilege grants or revokes a privilege
+func (s *Server) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return s.proxy.OperatePrivilege(ctx, req)
+}
+
+// SelectGrant lists the grants of a role
+func (s *Server) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return s.proxy.SelectGrant(ctx, req)
+}
+
+// CreateDatabase creates a database
+func (s *Server) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateDatabase(ctx, req)
+}
+
+// DropDatabase drops a database
+func (s *Server) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.DropDatabase(ctx, req)
+}
+
+// ListDatabases lists all the databases
+func (s *Server) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.proxy.ListDatabases(ctx, req)
+}
@@ -136,6 +136,10 @@ func (m *MockRootCoord) AlterAlias(ctx context.Context, req *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockRootCoord) AddCollectionField(ctx context.Context, req *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
 	return nil, nil
 }
@@ -168,6 +172,62 @@ func (m *MockRootCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetrics
 	return nil, nil
 }
 
+func (m *MockRootCoord) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListPolicy(ctx context.Context, req *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockIndexCoord struct {
 	MockBase
@@ -433,6 +493,14 @@ func (m *MockProxy) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.Rel
 	return nil, nil
 }
 
+func (m *MockProxy) InvalidateCredentialCache(ctx context.Context, req *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -521,10 +589,18 @@ func (m *MockProxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return nil, nil
 }
 
+func (m *MockProxy) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	return nil, nil
 }
@@ -533,6 +609,18 @@ func (m *MockProxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (
 	return nil, nil
 }
 
+func (m *MockProxy) OpenQueryIterator(ctx context.Context, request *milvuspb.OpenQueryIteratorRequest) (*milvuspb.OpenQueryIteratorResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) NextQueryIterator(ctx context.Context, request *milvuspb.NextQueryIteratorRequest) (*milvuspb.QueryIteratorResults, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CloseQueryIterator(ctx context.Context, request *milvuspb.CloseQueryIteratorRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return nil, nil
 }
@@ -577,6 +665,10 @@ func (m *MockProxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockProxy) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) SetRootCoordClient(rootCoord types.RootCoord) {
 
 }
@@ -609,6 +701,54 @@ func (m *MockProxy) GetCompactionStateWithPlans(ctx context.Context, req *milvus
 	return nil, nil
 }
 
+func (m *MockProxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 func Test_NewServer(t *testing.T) {
 	ctx := context.Background()
@@ -757,11 +897,21 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Upsert", func(t *testing.T) {
+		_, err := server.Upsert(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Search", func(t *testing.T) {
 		_, err := server.Search(ctx, nil)
 		assert.Nil(t, err)
 	})
 
+	t.Run("HybridSearch", func(t *testing.T) {
+		_, err := server.HybridSearch(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Flush", func(t *testing.T) {
 		_, err := server.Flush(ctx, nil)
 		assert.Nil(t, err)
@@ -772,6 +922,21 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("OpenQueryIterator", func(t *testing.T) {
+		_, err := server.OpenQueryIterator(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("NextQueryIterator", func(t *testing.T) {
+		_, err := server.NextQueryIterator(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CloseQueryIterator", func(t *testing.T) {
+		_, err := server.CloseQueryIterator(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("CalcDistance", func(t *testing.T) {
 		_, err := server.CalcDistance(ctx, nil)
 		assert.Nil(t, err)
@@ -827,6 +992,11 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("AddCollectionField", func(t *testing.T) {
+		_, err := server.AddCollectionField(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("GetCompactionState", func(t *testing.T) {
 		_, err := server.GetCompactionState(ctx, nil)
 		assert.Nil(t, err)
@@ -842,6 +1012,76 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("InvalidateCredentialCache", func(t *testing.T) {
+		_, err := server.InvalidateCredentialCache(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateCredential", func(t *testing.T) {
+		_, err := server.CreateCredential(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("UpdateCredential", func(t *testing.T) {
+		_, err := server.UpdateCredential(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DeleteCredential", func(t *testing.T) {
+		_, err := server.DeleteCredential(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListCredUsers", func(t *testing.T) {
+		_, err := server.ListCredUsers(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("RefreshPolicyInfoCache", func(t *testing.T) {
+		_, err := server.RefreshPolicyInfoCache(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateRole", func(t *testing.T) {
+		_, err := server.CreateRole(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropRole", func(t *testing.T) {
+		_, err := server.DropRole(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("OperateUserRole", func(t *testing.T) {
+		_, err := server.OperateUserRole(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("OperatePrivilege", func(t *testing.T) {
+		_, err := server.OperatePrivilege(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SelectGrant", func(t *testing.T) {
+		_, err := server.SelectGrant(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateDatabase", func(t *testing.T) {
+		_, err := server.CreateDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropDatabase", func(t *testing.T) {
+		_, err := server.DropDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListDatabases", func(t *testing.T) {
+		_, err := server.ListDatabases(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
@@ -74,6 +74,10 @@ func (m *MockRootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.Alter
 	return &commonpb.Status{}, m.err
 }
 
+func (m *MockRootCoordClient) AddCollectionField(ctx context.Context, in *milvuspb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
 func (m *MockRootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	return &milvuspb.ShowCollectionsResponse{}, m.err
 }
@@ -130,6 +134,62 @@ func (m *MockRootCoordClient) ReleaseDQLMessageStream(ctx context.Context, in *p
 	return &commonpb.Status{}, m.err
 }
 
+func (m *MockRootCoordClient) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
+	return &milvuspb.ListCredUsersResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error) {
+	return &rootcoordpb.GetCredentialResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error) {
+	return &milvuspb.SelectGrantResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) ListPolicy(ctx context.Context, req *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error) {
+	return &internalpb.ListPolicyResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+	return &milvuspb.ListDatabasesResponse{}, m.err
+}
+
 func (m *MockRootCoordClient) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.err
 }
@@ -243,6 +303,42 @@ func Test_NewClient(t *testing.T) {
 
 		r26, err := client.AlterAlias(ctx, nil)
 		retCheck(retNotNil, r26, err)
+
+		r26, err = client.AddCollectionField(ctx, nil)
+		retCheck(retNotNil, r26, err)
+
+		r27, err := client.CreateCredential(ctx, nil)
+		retCheck(retNotNil, r27, err)
+
+		r28, err := client.GetCredential(ctx, nil)
+		retCheck(retNotNil, r28, err)
+
+		r29, err := client.UpdateCredential(ctx, nil)
+		retCheck(retNotNil, r29, err)
+
+		r30, err := client.DeleteCredential(ctx, nil)
+		retCheck(retNotNil, r30, err)
+
+		r31, err := client.ListCredUsers(ctx, nil)
+		retCheck(retNotNil, r31, err)
+		r32, err := client.CreateRole(ctx, nil)
+		retCheck(retNotNil, r32, err)
+		r33, err := client.DropRole(ctx, nil)
+		retCheck(retNotNil, r33, err)
+		r34, err := client.OperateUserRole(ctx, nil)
+		retCheck(retNotNil, r34, err)
+		r35, err := client.OperatePrivilege(ctx, nil)
+		retCheck(retNotNil, r35, err)
+		r36, err := client.SelectGrant(ctx, nil)
+		retCheck(retNotNil, r36, err)
+		r37, err := client.ListPolicy(ctx, nil)
+		retCheck(retNotNil, r37, err)
+		r38, err := client.CreateDatabase(ctx, nil)
+		retCheck(retNotNil, r38, err)
+		r39, err := client.DropDatabase(ctx, nil)
+		retCheck(retNotNil, r39, err)
+		r40, err := client.ListDatabases(ctx, nil)
+		retCheck(retNotNil, r40, err)
 	}
 
 	client.getGrpcClient = func() (rootcoordpb.RootCoordClient, error) {
@@ -215,6 +215,9 @@ func TestGrpcService(t *testing.T) {
 	core.CallReleasePartitionService = func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error {
 		return nil
 	}
+	core.CallShowLoadedCollectionsService = func(ctx context.Context, ts typeutil.Timestamp, dbID typeutil.UniqueID) ([]typeutil.UniqueID, error) {
+		return nil, nil
+	}
 
 	rootcoord.Params.Address = Params.Address
 	err = svr.rootCoord.Register()
@@ -296,6 +299,27 @@ func TestGrpcService(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
 	})
 
+	t.Run("create database", func(t *testing.T) {
+		req := &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: dbName,
+		}
+		status, err := cli.CreateDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := cli.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListDatabases,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Contains(t, rsp.DbNames, dbName)
+	})
+
 	t.Run("create collection", func(t *testing.T) {
 		schema := schemapb.CollectionSchema{
 			Name:   collName,
@@ -334,7 +358,7 @@ func TestGrpcService(t *testing.T) {
 
 		status, err := cli.CreateCollection(ctx, req)
 		assert.Nil(t, err)
-		colls, err := core.MetaTable.ListCollections(0)
+		colls, err := core.MetaTable.ListCollections(dbName, 0)
 		assert.Nil(t, err)
 
 		assert.Equal(t, 1, len(colls))
@@ -368,7 +392,7 @@ func TestGrpcService(t *testing.T) {
 		status, err = cli.CreateCollection(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		colls, err = core.MetaTable.ListCollections(0)
+		colls, err = core.MetaTable.ListCollections(dbName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(colls))
 		_, has = colls[collName2]
@@ -398,7 +422,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 110,
 				SourceID:  110,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		rsp, err := cli.HasCollection(ctx, req)
@@ -413,7 +437,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 111,
 				SourceID:  111,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: "testColl2",
 		}
 		rsp, err = cli.HasCollection(ctx, req)
@@ -429,7 +453,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 111,
 				SourceID:  111,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: "testColl2",
 		}
 		rsp, err = cli.HasCollection(ctx, req)
@@ -439,7 +463,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -448,7 +472,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 120,
 				SourceID:  120,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		rsp, err := cli.DescribeCollection(ctx, req)
@@ -466,7 +490,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 130,
 				SourceID:  130,
 			},
-			DbName: "testDb",
+			DbName: dbName,
 		}
 		rsp, err := cli.ShowCollections(ctx, req)
 		assert.Nil(t, err)
@@ -490,7 +514,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.CreatePartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partName2, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -518,7 +542,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -527,7 +551,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 160,
 				SourceID:  160,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 			CollectionID:   coll.ID,
 		}
@@ -539,7 +563,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -584,13 +608,13 @@ func TestGrpcService(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Zero(t, len(collMeta.FieldIndexes))
 		rsp, err := cli.CreateIndex(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 
@@ -606,7 +630,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -646,7 +670,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -707,7 +731,7 @@ func TestGrpcService(t *testing.T) {
 			FieldName:      fieldName,
 			IndexName:      rootcoord.Params.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, rootcoord.Params.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName(dbName, collName, rootcoord.Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, len(idx), 1)
 		rsp, err := cli.DropIndex(ctx, req)
@@ -735,7 +759,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -752,7 +776,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 230,
 				SourceID:  230,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 
@@ -772,7 +796,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 231,
 				SourceID:  231,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		status, err = cli.DropCollection(ctx, req)
@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexcgopb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // Blob is an alias for the storage.Blob type
@@ -51,6 +52,7 @@ type Index interface {
 	Load([]*Blob) error
 	BuildFloatVecIndexWithoutIds(vectors []float32) error
 	BuildBinaryVecIndexWithoutIds(vectors []byte) error
+	BuildSparseFloatVecIndexWithoutIds(rows [][]byte) error
 	Delete() error
 }
 
@@ -154,6 +156,30 @@ func (index *CIndex) BuildBinaryVecIndexWithoutIds(vectors []byte) error {
 	return nil
 }
 
+// BuildSparseFloatVecIndexWithoutIds builds indexes for sparse float vector, rows are the (index, value) pairs of the
+// sparse rows, which are passed to 'C' one after another led by their lengths.
+func (index *CIndex) BuildSparseFloatVecIndexWithoutIds(rows [][]byte) error {
+	/*
+		CStatus
+		BuildSparseFloatVecIndexWithoutIds(CIndex index, int64_t data_size, const uint8_t* vectors);
+	*/
+	vectors := make([]byte, 0, len(rows)*4)
+	for _, row := range rows {
+		vectors = append(vectors, typeutil.EncodeVariableLengthValue(row)...)
+	}
+	if len(vectors) == 0 {
+		return fmt.Errorf("BuildSparseFloatVecIndexWithoutIds failed, no sparse float vector to build index")
+	}
+	status := C.BuildSparseFloatVecIndexWithoutIds(index.indexPtr, (C.int64_t)(len(vectors)), (*C.uint8_t)(&vectors[0]))
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return fmt.Errorf("BuildSparseFloatVecIndexWithoutIds failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	}
+	return nil
+}
+
 // Delete removes the pointer to build the index in 'C'.
 func (index *CIndex) Delete() error {
 	/*
@@ -27,6 +27,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
@@ -37,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
@@ -380,8 +382,48 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 			tr.Record("build binary vector index done")
 		}
 
-		if !fOk && !bOk {
-			return errors.New("we expect FloatVectorFieldData or BinaryVectorFieldData")
+		// half precision vectors are indexed as float vectors
+		float16VectorFieldData, f16Ok := value.(*storage.Float16VectorFieldData)
+		if f16Ok {
+			err = it.index.BuildFloatVecIndexWithoutIds(typeutil.Float16VectorToFloat32(float16VectorFieldData.Data))
+			if err != nil {
+				log.Error("IndexNode BuildFloatVecIndexWithoutIds failed", zap.Error(err))
+				return err
+			}
+			tr.Record("build float16 vector index done")
+		}
+
+		bfloat16VectorFieldData, bf16Ok := value.(*storage.BFloat16VectorFieldData)
+		if bf16Ok {
+			err = it.index.BuildFloatVecIndexWithoutIds(typeutil.BFloat16VectorToFloat32(bfloat16VectorFieldData.Data))
+			if err != nil {
+				log.Error("IndexNode BuildFloatVecIndexWithoutIds failed", zap.Error(err))
+				return err
+			}
+			tr.Record("build bfloat16 vector index done")
+		}
+
+		sparseVectorFieldData, sOk := value.(*storage.SparseFloatVectorFieldData)
+		if sOk {
+			maxNnz, err := strconv.Atoi(typeParams[common.MaxNnzKey])
+			if err != nil {
+				return fmt.Errorf("invalid %s of sparse float vector field: %w", common.MaxNnzKey, err)
+			}
+			for _, row := range sparseVectorFieldData.Data {
+				if err := typeutil.ValidateSparseFloatRowNnz(row, maxNnz); err != nil {
+					return err
+				}
+			}
+			err = it.index.BuildSparseFloatVecIndexWithoutIds(sparseVectorFieldData.Data)
+			if err != nil {
+				log.Error("IndexNode BuildSparseFloatVecIndexWithoutIds failed", zap.Error(err))
+				return err
+			}
+			tr.Record("build sparse float vector index done")
+		}
+
+		if !fOk && !bOk && !f16Ok && !bf16Ok && !sOk {
+			return errors.New("we expect FloatVectorFieldData, BinaryVectorFieldData, Float16VectorFieldData, BFloat16VectorFieldData or SparseFloatVectorFieldData")
 		}
 
 		indexBlobs, err := it.index.Serialize()
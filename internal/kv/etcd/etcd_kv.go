@@ -353,6 +353,15 @@ func (kv *EtcdKV) KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliv
 	return ch, nil
 }
 
+// KeepAliveOnce renews the lease with leaseID once.
+// Implemented in etcd interface.
+func (kv *EtcdKV) KeepAliveOnce(id clientv3.LeaseID) error {
+	start := time.Now()
+	_, err := kv.client.KeepAliveOnce(context.Background(), id)
+	CheckElapseAndWarn(start, "Slow etcd operation keepAliveOnce")
+	return err
+}
+
 // CompareValueAndSwap compares the existing value with compare, and if they are
 // equal, the target is stored in etcd.
 func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) error {
@@ -96,7 +96,7 @@ func DeleteRepackFunc(tsMsgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, e
 		}
 
 		timestampLen := len(deleteRequest.Timestamps)
-		pkLen := len(deleteRequest.PrimaryKeys)
+		pkLen := len(deleteRequest.PrimaryKeys) + len(deleteRequest.StringPrimaryKeys)
 		keysLen := len(keys)
 
 		if keysLen != timestampLen || keysLen != pkLen {
@@ -124,7 +124,11 @@ func DeleteRepackFunc(tsMsgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, e
 				PartitionName:  deleteRequest.PartitionName,
 				ShardName:      deleteRequest.ShardName,
 				Timestamps:     []uint64{deleteRequest.Timestamps[index]},
-				PrimaryKeys:    []int64{deleteRequest.PrimaryKeys[index]},
+			}
+			if len(deleteRequest.StringPrimaryKeys) > 0 {
+				sliceRequest.StringPrimaryKeys = []string{deleteRequest.StringPrimaryKeys[index]}
+			} else {
+				sliceRequest.PrimaryKeys = []int64{deleteRequest.PrimaryKeys[index]}
 			}
 
 			deleteMsg := &DeleteMsg{
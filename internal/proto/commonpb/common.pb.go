@@ -23,32 +23,44 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type ErrorCode int32
 
 const (
-	ErrorCode_Success               ErrorCode = 0
-	ErrorCode_UnexpectedError       ErrorCode = 1
-	ErrorCode_ConnectFailed         ErrorCode = 2
-	ErrorCode_PermissionDenied      ErrorCode = 3
-	ErrorCode_CollectionNotExists   ErrorCode = 4
-	ErrorCode_IllegalArgument       ErrorCode = 5
-	ErrorCode_IllegalDimension      ErrorCode = 7
-	ErrorCode_IllegalIndexType      ErrorCode = 8
-	ErrorCode_IllegalCollectionName ErrorCode = 9
-	ErrorCode_IllegalTOPK           ErrorCode = 10
-	ErrorCode_IllegalRowRecord      ErrorCode = 11
-	ErrorCode_IllegalVectorID       ErrorCode = 12
-	ErrorCode_IllegalSearchResult   ErrorCode = 13
-	ErrorCode_FileNotFound          ErrorCode = 14
-	ErrorCode_MetaFailed            ErrorCode = 15
-	ErrorCode_CacheFailed           ErrorCode = 16
-	ErrorCode_CannotCreateFolder    ErrorCode = 17
-	ErrorCode_CannotCreateFile      ErrorCode = 18
-	ErrorCode_CannotDeleteFolder    ErrorCode = 19
-	ErrorCode_CannotDeleteFile      ErrorCode = 20
-	ErrorCode_BuildIndexError       ErrorCode = 21
-	ErrorCode_IllegalNLIST          ErrorCode = 22
-	ErrorCode_IllegalMetricType     ErrorCode = 23
-	ErrorCode_OutOfMemory           ErrorCode = 24
-	ErrorCode_IndexNotExist         ErrorCode = 25
-	ErrorCode_EmptyCollection       ErrorCode = 26
+	ErrorCode_Success                 ErrorCode = 0
+	ErrorCode_UnexpectedError         ErrorCode = 1
+	ErrorCode_ConnectFailed           ErrorCode = 2
+	ErrorCode_PermissionDenied        ErrorCode = 3
+	ErrorCode_CollectionNotExists     ErrorCode = 4
+	ErrorCode_IllegalArgument         ErrorCode = 5
+	ErrorCode_IllegalDimension        ErrorCode = 7
+	ErrorCode_IllegalIndexType        ErrorCode = 8
+	ErrorCode_IllegalCollectionName   ErrorCode = 9
+	ErrorCode_IllegalTOPK             ErrorCode = 10
+	ErrorCode_IllegalRowRecord        ErrorCode = 11
+	ErrorCode_IllegalVectorID         ErrorCode = 12
+	ErrorCode_IllegalSearchResult     ErrorCode = 13
+	ErrorCode_FileNotFound            ErrorCode = 14
+	ErrorCode_MetaFailed              ErrorCode = 15
+	ErrorCode_CacheFailed             ErrorCode = 16
+	ErrorCode_CannotCreateFolder      ErrorCode = 17
+	ErrorCode_CannotCreateFile        ErrorCode = 18
+	ErrorCode_CannotDeleteFolder      ErrorCode = 19
+	ErrorCode_CannotDeleteFile        ErrorCode = 20
+	ErrorCode_BuildIndexError         ErrorCode = 21
+	ErrorCode_IllegalNLIST            ErrorCode = 22
+	ErrorCode_IllegalMetricType       ErrorCode = 23
+	ErrorCode_OutOfMemory             ErrorCode = 24
+	ErrorCode_IndexNotExist           ErrorCode = 25
+	ErrorCode_EmptyCollection         ErrorCode = 26
+	ErrorCode_CreateCredentialFailure ErrorCode = 27
+	ErrorCode_GetCredentialFailure    ErrorCode = 28
+	ErrorCode_DeleteCredentialFailure ErrorCode = 29
+	ErrorCode_UpdateCredentialFailure ErrorCode = 30
+	ErrorCode_ListCredUsersFailure    ErrorCode = 31
+	ErrorCode_CreateRoleFailure       ErrorCode = 32
+	ErrorCode_DropRoleFailure         ErrorCode = 33
+	ErrorCode_OperateUserRoleFailure  ErrorCode = 34
+	ErrorCode_OperatePrivilegeFailure ErrorCode = 35
+	ErrorCode_SelectGrantFailure      ErrorCode = 36
+	ErrorCode_ListPolicyFailure       ErrorCode = 37
+	ErrorCode_RateLimit               ErrorCode = 38
 	// internal error code.
 	ErrorCode_DDRequestRace ErrorCode = 1000
 )
@@ -80,37 +92,61 @@ var ErrorCode_name = map[int32]string{
 	24:   "OutOfMemory",
 	25:   "IndexNotExist",
 	26:   "EmptyCollection",
+	27:   "CreateCredentialFailure",
+	28:   "GetCredentialFailure",
+	29:   "DeleteCredentialFailure",
+	30:   "UpdateCredentialFailure",
+	31:   "ListCredUsersFailure",
+	32:   "CreateRoleFailure",
+	33:   "DropRoleFailure",
+	34:   "OperateUserRoleFailure",
+	35:   "OperatePrivilegeFailure",
+	36:   "SelectGrantFailure",
+	37:   "ListPolicyFailure",
+	38:   "RateLimit",
 	1000: "DDRequestRace",
 }
 
 var ErrorCode_value = map[string]int32{
-	"Success":               0,
-	"UnexpectedError":       1,
-	"ConnectFailed":         2,
-	"PermissionDenied":      3,
-	"CollectionNotExists":   4,
-	"IllegalArgument":       5,
-	"IllegalDimension":      7,
-	"IllegalIndexType":      8,
-	"IllegalCollectionName": 9,
-	"IllegalTOPK":           10,
-	"IllegalRowRecord":      11,
-	"IllegalVectorID":       12,
-	"IllegalSearchResult":   13,
-	"FileNotFound":          14,
-	"MetaFailed":            15,
-	"CacheFailed":           16,
-	"CannotCreateFolder":    17,
-	"CannotCreateFile":      18,
-	"CannotDeleteFolder":    19,
-	"CannotDeleteFile":      20,
-	"BuildIndexError":       21,
-	"IllegalNLIST":          22,
-	"IllegalMetricType":     23,
-	"OutOfMemory":           24,
-	"IndexNotExist":         25,
-	"EmptyCollection":       26,
-	"DDRequestRace":         1000,
+	"Success":                 0,
+	"UnexpectedError":         1,
+	"ConnectFailed":           2,
+	"PermissionDenied":        3,
+	"CollectionNotExists":     4,
+	"IllegalArgument":         5,
+	"IllegalDimension":        7,
+	"IllegalIndexType":        8,
+	"IllegalCollectionName":   9,
+	"IllegalTOPK":             10,
+	"IllegalRowRecord":        11,
+	"IllegalVectorID":         12,
+	"IllegalSearchResult":     13,
+	"FileNotFound":            14,
+	"MetaFailed":              15,
+	"CacheFailed":             16,
+	"CannotCreateFolder":      17,
+	"CannotCreateFile":        18,
+	"CannotDeleteFolder":      19,
+	"CannotDeleteFile":        20,
+	"BuildIndexError":         21,
+	"IllegalNLIST":            22,
+	"IllegalMetricType":       23,
+	"OutOfMemory":             24,
+	"IndexNotExist":           25,
+	"EmptyCollection":         26,
+	"CreateCredentialFailure": 27,
+	"GetCredentialFailure":    28,
+	"DeleteCredentialFailure": 29,
+	"UpdateCredentialFailure": 30,
+	"ListCredUsersFailure":    31,
+	"CreateRoleFailure":       32,
+	"DropRoleFailure":         33,
+	"OperateUserRoleFailure":  34,
+	"OperatePrivilegeFailure": 35,
+	"SelectGrantFailure":      36,
+	"ListPolicyFailure":       37,
+	"RateLimit":               38,
+	"DDRequestRace":           1000,
 }
 
 func (x ErrorCode) String() string {
@@ -211,6 +247,7 @@ const (
 	MsgType_CreateAlias        MsgType = 108
 	MsgType_DropAlias          MsgType = 109
 	MsgType_AlterAlias         MsgType = 110
+	MsgType_AddCollectionField MsgType = 111
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -234,6 +271,7 @@ const (
 	MsgType_Insert MsgType = 400
 	MsgType_Delete MsgType = 401
 	MsgType_Flush  MsgType = 402
+	MsgType_Upsert MsgType = 403
 	// QUERY
 	MsgType_Search                   MsgType = 500
 	MsgType_SearchResult             MsgType = 501
@@ -262,6 +300,24 @@ const (
 	MsgType_SegmentStatistics MsgType = 1206
 	MsgType_SegmentFlushDone  MsgType = 1207
 	MsgType_DataNodeTt        MsgType = 1208
+	// Credential
+	MsgType_CreateCredential  MsgType = 1500
+	MsgType_GetCredential     MsgType = 1501
+	MsgType_DeleteCredential  MsgType = 1502
+	MsgType_UpdateCredential  MsgType = 1503
+	MsgType_ListCredUsernames MsgType = 1504
+	// RBAC
+	MsgType_CreateRole             MsgType = 1600
+	MsgType_DropRole               MsgType = 1601
+	MsgType_OperateUserRole        MsgType = 1602
+	MsgType_OperatePrivilege       MsgType = 1603
+	MsgType_SelectGrant            MsgType = 1604
+	MsgType_RefreshPolicyInfoCache MsgType = 1605
+	MsgType_ListPolicy             MsgType = 1606
+	// Database
+	MsgType_CreateDatabase MsgType = 1700
+	MsgType_DropDatabase   MsgType = 1701
+	MsgType_ListDatabases  MsgType = 1702
 )
 
 var MsgType_name = map[int32]string{
@@ -277,6 +333,7 @@ var MsgType_name = map[int32]string{
 	108:  "CreateAlias",
 	109:  "DropAlias",
 	110:  "AlterAlias",
+	111:  "AddCollectionField",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -296,6 +353,7 @@ var MsgType_name = map[int32]string{
 	400:  "Insert",
 	401:  "Delete",
 	402:  "Flush",
+	403:  "Upsert",
 	500:  "Search",
 	501:  "SearchResult",
 	502:  "GetIndexState",
@@ -321,6 +379,21 @@ var MsgType_name = map[int32]string{
 	1206: "SegmentStatistics",
 	1207: "SegmentFlushDone",
 	1208: "DataNodeTt",
+	1500: "CreateCredential",
+	1501: "GetCredential",
+	1502: "DeleteCredential",
+	1503: "UpdateCredential",
+	1504: "ListCredUsernames",
+	1600: "CreateRole",
+	1601: "DropRole",
+	1602: "OperateUserRole",
+	1603: "OperatePrivilege",
+	1604: "SelectGrant",
+	1605: "RefreshPolicyInfoCache",
+	1606: "ListPolicy",
+	1700: "CreateDatabase",
+	1701: "DropDatabase",
+	1702: "ListDatabases",
 }
 
 var MsgType_value = map[string]int32{
@@ -336,6 +409,7 @@ var MsgType_value = map[string]int32{
 	"CreateAlias":              108,
 	"DropAlias":                109,
 	"AlterAlias":               110,
+	"AddCollectionField":       111,
 	"CreatePartition":          200,
 	"DropPartition":            201,
 	"HasPartition":             202,
@@ -355,6 +429,7 @@ var MsgType_value = map[string]int32{
 	"Insert":                   400,
 	"Delete":                   401,
 	"Flush":                    402,
+	"Upsert":                   403,
 	"Search":                   500,
 	"SearchResult":             501,
 	"GetIndexState":            502,
@@ -380,6 +455,21 @@ var MsgType_value = map[string]int32{
 	"SegmentStatistics":        1206,
 	"SegmentFlushDone":         1207,
 	"DataNodeTt":               1208,
+	"CreateCredential":         1500,
+	"GetCredential":            1501,
+	"DeleteCredential":         1502,
+	"UpdateCredential":         1503,
+	"ListCredUsernames":        1504,
+	"CreateRole":               1600,
+	"DropRole":                 1601,
+	"OperateUserRole":          1602,
+	"OperatePrivilege":         1603,
+	"SelectGrant":              1604,
+	"RefreshPolicyInfoCache":   1605,
+	"ListPolicy":               1606,
+	"CreateDatabase":           1700,
+	"DropDatabase":             1701,
+	"ListDatabases":            1702,
 }
 
 func (x MsgType) String() string {
@@ -415,6 +505,38 @@ func (DslType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_555bd8c177793206, []int{4}
 }
 
+// ConsistencyLevel decides the guarantee timestamp of the search and query requests
+type ConsistencyLevel int32
+
+const (
+	ConsistencyLevel_Strong     ConsistencyLevel = 0
+	ConsistencyLevel_Session    ConsistencyLevel = 1
+	ConsistencyLevel_Bounded    ConsistencyLevel = 2
+	ConsistencyLevel_Eventually ConsistencyLevel = 3
+)
+
+var ConsistencyLevel_name = map[int32]string{
+	0: "Strong",
+	1: "Session",
+	2: "Bounded",
+	3: "Eventually",
+}
+
+var ConsistencyLevel_value = map[string]int32{
+	"Strong":     0,
+	"Session":    1,
+	"Bounded":    2,
+	"Eventually": 3,
+}
+
+func (x ConsistencyLevel) String() string {
+	return proto.EnumName(ConsistencyLevel_name, int32(x))
+}
+
+func (ConsistencyLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{5}
+}
+
 type CompactionState int32
 
 const (
@@ -440,7 +562,141 @@ func (x CompactionState) String() string {
 }
 
 func (CompactionState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{5}
+	return fileDescriptor_555bd8c177793206, []int{6}
+}
+
+type ObjectType int32
+
+const (
+	ObjectType_Collection ObjectType = 0
+	ObjectType_Global     ObjectType = 1
+	ObjectType_User       ObjectType = 2
+)
+
+var ObjectType_name = map[int32]string{
+	0: "Collection",
+	1: "Global",
+	2: "User",
+}
+
+var ObjectType_value = map[string]int32{
+	"Collection": 0,
+	"Global":     1,
+	"User":       2,
+}
+
+func (x ObjectType) String() string {
+	return proto.EnumName(ObjectType_name, int32(x))
+}
+
+func (ObjectType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{7}
+}
+
+type ObjectPrivilege int32
+
+const (
+	ObjectPrivilege_PrivilegeAll                ObjectPrivilege = 0
+	ObjectPrivilege_PrivilegeCreateCollection   ObjectPrivilege = 1
+	ObjectPrivilege_PrivilegeDropCollection     ObjectPrivilege = 2
+	ObjectPrivilege_PrivilegeDescribeCollection ObjectPrivilege = 3
+	ObjectPrivilege_PrivilegeShowCollections    ObjectPrivilege = 4
+	ObjectPrivilege_PrivilegeLoad               ObjectPrivilege = 5
+	ObjectPrivilege_PrivilegeRelease            ObjectPrivilege = 6
+	ObjectPrivilege_PrivilegeCompaction         ObjectPrivilege = 7
+	ObjectPrivilege_PrivilegeInsert             ObjectPrivilege = 8
+	ObjectPrivilege_PrivilegeDelete             ObjectPrivilege = 9
+	ObjectPrivilege_PrivilegeGetStatistics      ObjectPrivilege = 10
+	ObjectPrivilege_PrivilegeCreateIndex        ObjectPrivilege = 11
+	ObjectPrivilege_PrivilegeIndexDetail        ObjectPrivilege = 12
+	ObjectPrivilege_PrivilegeDropIndex          ObjectPrivilege = 13
+	ObjectPrivilege_PrivilegeSearch             ObjectPrivilege = 14
+	ObjectPrivilege_PrivilegeFlush              ObjectPrivilege = 15
+	ObjectPrivilege_PrivilegeQuery              ObjectPrivilege = 16
+	ObjectPrivilege_PrivilegeLoadBalance        ObjectPrivilege = 17
+	ObjectPrivilege_PrivilegeCreateOwnership    ObjectPrivilege = 18
+	ObjectPrivilege_PrivilegeUpdateUser         ObjectPrivilege = 19
+	ObjectPrivilege_PrivilegeDropOwnership      ObjectPrivilege = 20
+	ObjectPrivilege_PrivilegeSelectOwnership    ObjectPrivilege = 21
+	ObjectPrivilege_PrivilegeManageOwnership    ObjectPrivilege = 22
+	ObjectPrivilege_PrivilegeCreatePartition    ObjectPrivilege = 23
+	ObjectPrivilege_PrivilegeDropPartition      ObjectPrivilege = 24
+	ObjectPrivilege_PrivilegeCreateDatabase     ObjectPrivilege = 25
+	ObjectPrivilege_PrivilegeDropDatabase       ObjectPrivilege = 26
+	ObjectPrivilege_PrivilegeListDatabases      ObjectPrivilege = 27
+	ObjectPrivilege_PrivilegeUpsert             ObjectPrivilege = 28
+)
+
+var ObjectPrivilege_name = map[int32]string{
+	0:  "PrivilegeAll",
+	1:  "PrivilegeCreateCollection",
+	2:  "PrivilegeDropCollection",
+	3:  "PrivilegeDescribeCollection",
+	4:  "PrivilegeShowCollections",
+	5:  "PrivilegeLoad",
+	6:  "PrivilegeRelease",
+	7:  "PrivilegeCompaction",
+	8:  "PrivilegeInsert",
+	9:  "PrivilegeDelete",
+	10: "PrivilegeGetStatistics",
+	11: "PrivilegeCreateIndex",
+	12: "PrivilegeIndexDetail",
+	13: "PrivilegeDropIndex",
+	14: "PrivilegeSearch",
+	15: "PrivilegeFlush",
+	16: "PrivilegeQuery",
+	17: "PrivilegeLoadBalance",
+	18: "PrivilegeCreateOwnership",
+	19: "PrivilegeUpdateUser",
+	20: "PrivilegeDropOwnership",
+	21: "PrivilegeSelectOwnership",
+	22: "PrivilegeManageOwnership",
+	23: "PrivilegeCreatePartition",
+	24: "PrivilegeDropPartition",
+	25: "PrivilegeCreateDatabase",
+	26: "PrivilegeDropDatabase",
+	27: "PrivilegeListDatabases",
+	28: "PrivilegeUpsert",
+}
+
+var ObjectPrivilege_value = map[string]int32{
+	"PrivilegeAll":                0,
+	"PrivilegeCreateCollection":   1,
+	"PrivilegeDropCollection":     2,
+	"PrivilegeDescribeCollection": 3,
+	"PrivilegeShowCollections":    4,
+	"PrivilegeLoad":               5,
+	"PrivilegeRelease":            6,
+	"PrivilegeCompaction":         7,
+	"PrivilegeInsert":             8,
+	"PrivilegeDelete":             9,
+	"PrivilegeGetStatistics":      10,
+	"PrivilegeCreateIndex":        11,
+	"PrivilegeIndexDetail":        12,
+	"PrivilegeDropIndex":          13,
+	"PrivilegeSearch":             14,
+	"PrivilegeFlush":              15,
+	"PrivilegeQuery":              16,
+	"PrivilegeLoadBalance":        17,
+	"PrivilegeCreateOwnership":    18,
+	"PrivilegeUpdateUser":         19,
+	"PrivilegeDropOwnership":      20,
+	"PrivilegeSelectOwnership":    21,
+	"PrivilegeManageOwnership":    22,
+	"PrivilegeCreatePartition":    23,
+	"PrivilegeDropPartition":      24,
+	"PrivilegeCreateDatabase":     25,
+	"PrivilegeDropDatabase":       26,
+	"PrivilegeListDatabases":      27,
+	"PrivilegeUpsert":             28,
+}
+
+func (x ObjectPrivilege) String() string {
+	return proto.EnumName(ObjectPrivilege_name, int32(x))
+}
+
+func (ObjectPrivilege) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{8}
 }
 
 type Status struct {
@@ -827,7 +1083,10 @@ func init() {
 	proto.RegisterEnum("milvus.proto.common.SegmentState", SegmentState_name, SegmentState_value)
 	proto.RegisterEnum("milvus.proto.common.MsgType", MsgType_name, MsgType_value)
 	proto.RegisterEnum("milvus.proto.common.DslType", DslType_name, DslType_value)
+	proto.RegisterEnum("milvus.proto.common.ConsistencyLevel", ConsistencyLevel_name, ConsistencyLevel_value)
 	proto.RegisterEnum("milvus.proto.common.CompactionState", CompactionState_name, CompactionState_value)
+	proto.RegisterEnum("milvus.proto.common.ObjectType", ObjectType_name, ObjectType_value)
+	proto.RegisterEnum("milvus.proto.common.ObjectPrivilege", ObjectPrivilege_name, ObjectPrivilege_value)
 	proto.RegisterType((*Status)(nil), "milvus.proto.common.Status")
 	proto.RegisterType((*KeyValuePair)(nil), "milvus.proto.common.KeyValuePair")
 	proto.RegisterType((*KeyDataPair)(nil), "milvus.proto.common.KeyDataPair")
@@ -841,95 +1100,131 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x73, 0x1b, 0xc7,
-	0x11, 0xe6, 0x62, 0x41, 0x82, 0x68, 0x82, 0xe4, 0x70, 0xf8, 0x10, 0xa5, 0x30, 0x29, 0x15, 0x4e,
-	0x2a, 0x56, 0x89, 0x4c, 0xa2, 0x4a, 0x72, 0xd2, 0x81, 0xc4, 0xf2, 0x81, 0x92, 0xf8, 0xc8, 0x82,
-	0x52, 0x52, 0x39, 0x44, 0x35, 0xdc, 0x6d, 0x02, 0x13, 0xed, 0xee, 0x20, 0x3b, 0xb3, 0x14, 0x71,
-	0x4b, 0xfe, 0x81, 0xad, 0xdf, 0x61, 0xbb, 0xfc, 0xb6, 0xcb, 0xbf, 0xc0, 0xef, 0xb3, 0xfd, 0x0f,
-	0xfc, 0x03, 0xfc, 0xd4, 0xd3, 0xd5, 0xb3, 0x0b, 0x60, 0x55, 0x25, 0x9d, 0x7c, 0x9b, 0xfe, 0xa6,
-	0xfb, 0x9b, 0x9e, 0xaf, 0x7b, 0x7a, 0x17, 0x1a, 0x81, 0x8a, 0x63, 0x95, 0x6c, 0xf4, 0x53, 0x65,
-	0x14, 0x5f, 0x8c, 0x65, 0x74, 0x9e, 0xe9, 0xdc, 0xda, 0xc8, 0xb7, 0x9a, 0xf7, 0x60, 0xaa, 0x63,
-	0x84, 0xc9, 0x34, 0xbf, 0x09, 0x80, 0x69, 0xaa, 0xd2, 0x7b, 0x81, 0x0a, 0x71, 0xd5, 0xb9, 0xea,
-	0x5c, 0x9b, 0xfb, 0xf3, 0x1f, 0x36, 0x5e, 0x12, 0xb3, 0xb1, 0x43, 0x6e, 0x2d, 0x15, 0xa2, 0x5f,
-	0xc7, 0xe1, 0x92, 0xaf, 0xc0, 0x54, 0x8a, 0x42, 0xab, 0x64, 0xb5, 0x72, 0xd5, 0xb9, 0x56, 0xf7,
-	0x0b, 0xab, 0xf9, 0x57, 0x68, 0xdc, 0xc2, 0xc1, 0x5d, 0x11, 0x65, 0x78, 0x2c, 0x64, 0xca, 0x19,
-	0xb8, 0xf7, 0x71, 0x60, 0xf9, 0xeb, 0x3e, 0x2d, 0xf9, 0x12, 0x4c, 0x9e, 0xd3, 0x76, 0x11, 0x98,
-	0x1b, 0xcd, 0x1b, 0x30, 0x73, 0x0b, 0x07, 0x9e, 0x30, 0xe2, 0x15, 0x61, 0x1c, 0xaa, 0xa1, 0x30,
-	0xc2, 0x46, 0x35, 0x7c, 0xbb, 0x6e, 0xae, 0x41, 0x75, 0x3b, 0x52, 0xa7, 0x63, 0x4a, 0xc7, 0x6e,
-	0x16, 0x94, 0xd7, 0xa1, 0xb6, 0x15, 0x86, 0x29, 0x6a, 0xcd, 0xe7, 0xa0, 0x22, 0xfb, 0x05, 0x5b,
-	0x45, 0xf6, 0x89, 0xac, 0xaf, 0x52, 0x63, 0xc9, 0x5c, 0xdf, 0xae, 0x9b, 0x0f, 0x1d, 0xa8, 0x1d,
-	0xe8, 0xee, 0xb6, 0xd0, 0xc8, 0xff, 0x06, 0xd3, 0xb1, 0xee, 0xde, 0x33, 0x83, 0xfe, 0x50, 0x9a,
-	0xb5, 0x97, 0x4a, 0x73, 0xa0, 0xbb, 0x27, 0x83, 0x3e, 0xfa, 0xb5, 0x38, 0x5f, 0x50, 0x26, 0xb1,
-	0xee, 0xb6, 0xbd, 0x82, 0x39, 0x37, 0xf8, 0x1a, 0xd4, 0x8d, 0x8c, 0x51, 0x1b, 0x11, 0xf7, 0x57,
-	0xdd, 0xab, 0xce, 0xb5, 0xaa, 0x3f, 0x06, 0xf8, 0x15, 0x98, 0xd6, 0x2a, 0x4b, 0x03, 0x6c, 0x7b,
-	0xab, 0x55, 0x1b, 0x36, 0xb2, 0x9b, 0x37, 0xa1, 0x7e, 0xa0, 0xbb, 0xfb, 0x28, 0x42, 0x4c, 0xf9,
-	0x1f, 0xa1, 0x7a, 0x2a, 0x74, 0x9e, 0xd1, 0xcc, 0xab, 0x33, 0xa2, 0x1b, 0xf8, 0xd6, 0xb3, 0xf9,
-	0x6f, 0x68, 0x78, 0x07, 0xb7, 0x7f, 0x03, 0x03, 0xa5, 0xae, 0x7b, 0x22, 0x0d, 0x0f, 0x45, 0x3c,
-	0xac, 0xd8, 0x18, 0x58, 0xff, 0xa4, 0x0a, 0xf5, 0x51, 0x7b, 0xf0, 0x19, 0xa8, 0x75, 0xb2, 0x20,
-	0x40, 0xad, 0xd9, 0x04, 0x5f, 0x84, 0xf9, 0x3b, 0x09, 0x5e, 0xf4, 0x31, 0x30, 0x18, 0x5a, 0x1f,
-	0xe6, 0xf0, 0x05, 0x98, 0x6d, 0xa9, 0x24, 0xc1, 0xc0, 0xec, 0x0a, 0x19, 0x61, 0xc8, 0x2a, 0x7c,
-	0x09, 0xd8, 0x31, 0xa6, 0xb1, 0xd4, 0x5a, 0xaa, 0xc4, 0xc3, 0x44, 0x62, 0xc8, 0x5c, 0x7e, 0x09,
-	0x16, 0x5b, 0x2a, 0x8a, 0x30, 0x30, 0x52, 0x25, 0x87, 0xca, 0xec, 0x5c, 0x48, 0x6d, 0x34, 0xab,
-	0x12, 0x6d, 0x3b, 0x8a, 0xb0, 0x2b, 0xa2, 0xad, 0xb4, 0x9b, 0xc5, 0x98, 0x18, 0x36, 0x49, 0x1c,
-	0x05, 0xe8, 0xc9, 0x18, 0x13, 0x62, 0x62, 0xb5, 0x12, 0xda, 0x4e, 0x42, 0xbc, 0xa0, 0xfa, 0xb0,
-	0x69, 0x7e, 0x19, 0x96, 0x0b, 0xb4, 0x74, 0x80, 0x88, 0x91, 0xd5, 0xf9, 0x3c, 0xcc, 0x14, 0x5b,
-	0x27, 0x47, 0xc7, 0xb7, 0x18, 0x94, 0x18, 0x7c, 0xf5, 0xc0, 0xc7, 0x40, 0xa5, 0x21, 0x9b, 0x29,
-	0xa5, 0x70, 0x17, 0x03, 0xa3, 0xd2, 0xb6, 0xc7, 0x1a, 0x94, 0x70, 0x01, 0x76, 0x50, 0xa4, 0x41,
-	0xcf, 0x47, 0x9d, 0x45, 0x86, 0xcd, 0x72, 0x06, 0x8d, 0x5d, 0x19, 0xe1, 0xa1, 0x32, 0xbb, 0x2a,
-	0x4b, 0x42, 0x36, 0xc7, 0xe7, 0x00, 0x0e, 0xd0, 0x88, 0x42, 0x81, 0x79, 0x3a, 0xb6, 0x25, 0x82,
-	0x1e, 0x16, 0x00, 0xe3, 0x2b, 0xc0, 0x5b, 0x22, 0x49, 0x94, 0x69, 0xa5, 0x28, 0x0c, 0xee, 0xaa,
-	0x28, 0xc4, 0x94, 0x2d, 0x50, 0x3a, 0x2f, 0xe0, 0x32, 0x42, 0xc6, 0xc7, 0xde, 0x1e, 0x46, 0x38,
-	0xf2, 0x5e, 0x1c, 0x7b, 0x17, 0x38, 0x79, 0x2f, 0x51, 0xf2, 0xdb, 0x99, 0x8c, 0x42, 0x2b, 0x49,
-	0x5e, 0x96, 0x65, 0xca, 0xb1, 0x48, 0xfe, 0xf0, 0x76, 0xbb, 0x73, 0xc2, 0x56, 0xf8, 0x32, 0x2c,
-	0x14, 0xc8, 0x01, 0x9a, 0x54, 0x06, 0x56, 0xbc, 0x4b, 0x94, 0xea, 0x51, 0x66, 0x8e, 0xce, 0x0e,
-	0x30, 0x56, 0xe9, 0x80, 0xad, 0x52, 0x41, 0x2d, 0xd3, 0xb0, 0x44, 0xec, 0x32, 0x9d, 0xb0, 0x13,
-	0xf7, 0xcd, 0x60, 0x2c, 0x2f, 0xbb, 0xc2, 0x39, 0xcc, 0x7a, 0x9e, 0x8f, 0xff, 0xcd, 0x50, 0x1b,
-	0x5f, 0x04, 0xc8, 0xbe, 0xab, 0xad, 0xff, 0x13, 0xc0, 0xc6, 0xd2, 0x40, 0x42, 0xce, 0x61, 0x6e,
-	0x6c, 0x1d, 0xaa, 0x04, 0xd9, 0x04, 0x6f, 0xc0, 0xf4, 0x9d, 0x44, 0x6a, 0x9d, 0x61, 0xc8, 0x1c,
-	0xd2, 0xad, 0x9d, 0x1c, 0xa7, 0xaa, 0x4b, 0x4f, 0x9a, 0x55, 0x68, 0x77, 0x57, 0x26, 0x52, 0xf7,
-	0x6c, 0xc7, 0x00, 0x4c, 0x15, 0x02, 0x56, 0xd7, 0x35, 0x34, 0x3a, 0xd8, 0xa5, 0xe6, 0xc8, 0xb9,
-	0x97, 0x80, 0x95, 0xed, 0x31, 0xfb, 0x28, 0x6d, 0x87, 0x9a, 0x77, 0x2f, 0x55, 0x0f, 0x64, 0xd2,
-	0x65, 0x15, 0x22, 0xeb, 0xa0, 0x88, 0x2c, 0xf1, 0x0c, 0xd4, 0x76, 0xa3, 0xcc, 0x9e, 0x52, 0xb5,
-	0x67, 0x92, 0x41, 0x6e, 0x93, 0xb4, 0xe5, 0xa5, 0xaa, 0xdf, 0xc7, 0x90, 0x4d, 0xad, 0x3f, 0x9d,
-	0xb6, 0xf3, 0xc3, 0x8e, 0x81, 0x59, 0xa8, 0xdf, 0x49, 0x42, 0x3c, 0x93, 0x09, 0x86, 0x6c, 0xc2,
-	0x96, 0xc2, 0x96, 0xac, 0xa4, 0x49, 0x48, 0x37, 0xa6, 0xe8, 0x12, 0x86, 0xa4, 0xe7, 0xbe, 0xd0,
-	0x25, 0xe8, 0x8c, 0xea, 0xeb, 0xa1, 0x0e, 0x52, 0x79, 0x5a, 0x0e, 0xef, 0x92, 0xce, 0x9d, 0x9e,
-	0x7a, 0x30, 0xc6, 0x34, 0xeb, 0xd1, 0x49, 0x7b, 0x68, 0x3a, 0x03, 0x6d, 0x30, 0x6e, 0xa9, 0xe4,
-	0x4c, 0x76, 0x35, 0x93, 0x74, 0xd2, 0x6d, 0x25, 0xc2, 0x52, 0xf8, 0x7f, 0xa8, 0xc2, 0x3e, 0x46,
-	0x28, 0x74, 0x99, 0xf5, 0xbe, 0x6d, 0x46, 0x9b, 0xea, 0x56, 0x24, 0x85, 0x66, 0x11, 0x5d, 0x85,
-	0xb2, 0xcc, 0xcd, 0x98, 0x8a, 0xb0, 0x15, 0x19, 0x4c, 0x73, 0x3b, 0xe1, 0x4b, 0x30, 0x9f, 0xfb,
-	0x1f, 0x8b, 0xd4, 0x48, 0x4b, 0xf2, 0xa9, 0x63, 0xcb, 0x9d, 0xaa, 0xfe, 0x18, 0xfb, 0x8c, 0xde,
-	0x7e, 0x63, 0x5f, 0xe8, 0x31, 0xf4, 0xb9, 0xc3, 0x57, 0x60, 0x61, 0x78, 0xb5, 0x31, 0xfe, 0x85,
-	0xc3, 0x17, 0x61, 0x8e, 0xae, 0x36, 0xc2, 0x34, 0xfb, 0xd2, 0x82, 0x74, 0x89, 0x12, 0xf8, 0x95,
-	0x65, 0x28, 0x6e, 0x51, 0xc2, 0xbf, 0xb6, 0x87, 0x11, 0x43, 0x51, 0x75, 0xcd, 0x1e, 0x39, 0x94,
-	0xe9, 0xf0, 0xb0, 0x02, 0x66, 0x8f, 0xad, 0x23, 0xb1, 0x8e, 0x1c, 0x9f, 0x58, 0xc7, 0x82, 0x73,
-	0x84, 0x3e, 0xb5, 0xe8, 0xbe, 0x48, 0x42, 0x75, 0x76, 0x36, 0x42, 0x9f, 0x39, 0x7c, 0x15, 0x16,
-	0x29, 0x7c, 0x5b, 0x44, 0x22, 0x09, 0xc6, 0xfe, 0xcf, 0x1d, 0xce, 0x86, 0x42, 0xda, 0xae, 0x66,
-	0x6f, 0x54, 0xac, 0x28, 0x45, 0x02, 0x39, 0xf6, 0x66, 0x85, 0xcf, 0xe5, 0xea, 0xe6, 0xf6, 0x5b,
-	0x15, 0x3e, 0x03, 0x53, 0xed, 0x44, 0x63, 0x6a, 0xd8, 0x6b, 0xd4, 0x79, 0x53, 0xf9, 0xdb, 0x65,
-	0xaf, 0x53, 0x7f, 0x4f, 0xda, 0xce, 0x63, 0x0f, 0xed, 0x46, 0x3e, 0x65, 0xd8, 0xf7, 0xae, 0xbd,
-	0x6a, 0x79, 0xe4, 0xfc, 0xe0, 0xd2, 0x49, 0x7b, 0x68, 0xc6, 0xcf, 0x89, 0xfd, 0xe8, 0xf2, 0x2b,
-	0xb0, 0x3c, 0xc4, 0xec, 0x00, 0x18, 0x3d, 0xa4, 0x9f, 0x5c, 0xbe, 0x06, 0x97, 0xf6, 0xd0, 0x8c,
-	0xfb, 0x80, 0x82, 0xa4, 0x36, 0x32, 0xd0, 0xec, 0x67, 0x97, 0xff, 0x0e, 0x56, 0xf6, 0xd0, 0x8c,
-	0xf4, 0x2d, 0x6d, 0xfe, 0xe2, 0xf2, 0x59, 0x98, 0xf6, 0x69, 0x42, 0xe0, 0x39, 0xb2, 0x47, 0x2e,
-	0x15, 0x69, 0x68, 0x16, 0xe9, 0x3c, 0x76, 0x49, 0xba, 0x7f, 0x08, 0x13, 0xf4, 0xbc, 0xb8, 0xd5,
-	0x13, 0x49, 0x82, 0x91, 0x66, 0x4f, 0x5c, 0xbe, 0x0c, 0xcc, 0xc7, 0x58, 0x9d, 0x63, 0x09, 0x7e,
-	0x4a, 0x93, 0x9f, 0x5b, 0xe7, 0xbf, 0x67, 0x98, 0x0e, 0x46, 0x1b, 0xcf, 0x5c, 0x92, 0x3a, 0xf7,
-	0x7f, 0x71, 0xe7, 0xb9, 0xcb, 0x7f, 0x0f, 0xab, 0xf9, 0x6b, 0x1d, 0xea, 0x4f, 0x9b, 0x5d, 0x6c,
-	0x27, 0x67, 0x8a, 0xfd, 0xaf, 0x3a, 0x62, 0xf4, 0x30, 0x32, 0x62, 0x14, 0xf7, 0xff, 0x2a, 0x95,
-	0xa8, 0x88, 0xb0, 0xae, 0xdf, 0x54, 0xf9, 0x3c, 0x40, 0xfe, 0x76, 0x2c, 0xf0, 0x6d, 0x95, 0xae,
-	0x77, 0x22, 0x63, 0x3c, 0x91, 0xc1, 0x7d, 0xf6, 0x76, 0x9d, 0xae, 0x67, 0x4f, 0x3f, 0x54, 0x21,
-	0x92, 0x0e, 0x9a, 0xbd, 0x53, 0xa7, 0x1a, 0x52, 0x0f, 0xe4, 0x35, 0x7c, 0xd7, 0xda, 0xc5, 0xa4,
-	0x6b, 0x7b, 0xec, 0x3d, 0xfa, 0xac, 0x40, 0x61, 0x9f, 0x74, 0x8e, 0xd8, 0xfb, 0x75, 0xd2, 0x63,
-	0x2b, 0x8a, 0x54, 0x20, 0xcc, 0xa8, 0x13, 0x3f, 0xa8, 0x53, 0x2b, 0x97, 0x86, 0x54, 0xa1, 0xf0,
-	0x87, 0x75, 0xd2, 0xa9, 0xc0, 0x6d, 0xfd, 0x3d, 0x1a, 0x5e, 0x1f, 0x59, 0x56, 0xfa, 0x5b, 0xa2,
-	0x4c, 0x4e, 0x0c, 0xfb, 0xb8, 0xbe, 0xde, 0x84, 0x9a, 0xa7, 0x23, 0x3b, 0x7e, 0x6a, 0xe0, 0x7a,
-	0x3a, 0x62, 0x13, 0xf4, 0x5a, 0xb7, 0x95, 0x8a, 0x76, 0x2e, 0xfa, 0xe9, 0xdd, 0x3f, 0x31, 0x67,
-	0x7d, 0x1b, 0xe6, 0x5b, 0x2a, 0xee, 0x8b, 0x51, 0x95, 0xed, 0xc4, 0xc9, 0x47, 0x15, 0x86, 0x79,
-	0xaf, 0x4c, 0xd0, 0x93, 0xdf, 0xb9, 0xc0, 0x20, 0x33, 0x34, 0xe5, 0x1c, 0x32, 0x29, 0x88, 0x1a,
-	0x31, 0x64, 0x95, 0xed, 0xbf, 0xfc, 0xeb, 0x46, 0x57, 0x9a, 0x5e, 0x76, 0x4a, 0x3f, 0x0c, 0x9b,
-	0xf9, 0x1f, 0xc4, 0x75, 0xa9, 0x8a, 0xd5, 0xa6, 0x4c, 0x0c, 0xa6, 0x89, 0x88, 0x36, 0xed, 0x4f,
-	0xc5, 0x66, 0xfe, 0x53, 0xd1, 0x3f, 0x3d, 0x9d, 0xb2, 0xf6, 0x8d, 0x5f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x87, 0x06, 0x40, 0xd1, 0xa5, 0x0a, 0x00, 0x00,
+	// 2007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x49, 0x73, 0x1c, 0x49,
+	0x15, 0x56, 0x2f, 0x96, 0xd4, 0xd9, 0x2d, 0xe9, 0x39, 0xb5, 0x58, 0xb6, 0x35, 0x33, 0x46, 0x2c,
+	0xe1, 0x50, 0xc4, 0xd8, 0x30, 0x0e, 0xe0, 0x34, 0x07, 0xa9, 0x5b, 0x92, 0x3b, 0xac, 0x8d, 0x6e,
+	0xc9, 0x10, 0x1c, 0x70, 0xa4, 0xaa, 0x9e, 0xba, 0x73, 0x9c, 0x55, 0xd9, 0x64, 0x66, 0xcb, 0xee,
+	0x1b, 0xfc, 0x03, 0x18, 0xfe, 0x02, 0x70, 0x02, 0x82, 0x1d, 0x8e, 0xec, 0xc1, 0x7e, 0x86, 0x88,
+	0x61, 0x39, 0xce, 0x0f, 0x60, 0x1d, 0xcf, 0x42, 0xbc, 0xac, 0xea, 0xaa, 0x6a, 0xc9, 0x73, 0xe2,
+	0x56, 0xef, 0x7b, 0x6b, 0xbe, 0xf7, 0xf2, 0xbd, 0x2c, 0xd6, 0x08, 0x74, 0x14, 0xe9, 0xf8, 0xce,
+	0xc0, 0x68, 0xa7, 0xf9, 0x62, 0x24, 0xd5, 0xf9, 0xd0, 0x26, 0xd4, 0x9d, 0x84, 0xb5, 0xfe, 0x88,
+	0x4d, 0x77, 0x9d, 0x70, 0x43, 0xcb, 0x5f, 0x65, 0x0c, 0x8d, 0xd1, 0xe6, 0x51, 0xa0, 0x43, 0x5c,
+	0x2d, 0xdd, 0x2a, 0xdd, 0x9e, 0x7f, 0xe5, 0xc5, 0x3b, 0xcf, 0xd1, 0xb9, 0xb3, 0x4d, 0x62, 0x4d,
+	0x1d, 0x62, 0xa7, 0x86, 0xe3, 0x4f, 0xbe, 0xc2, 0xa6, 0x0d, 0x0a, 0xab, 0xe3, 0xd5, 0xf2, 0xad,
+	0xd2, 0xed, 0x5a, 0x27, 0xa5, 0xd6, 0x3f, 0xc1, 0x1a, 0x0f, 0x70, 0xf4, 0x50, 0xa8, 0x21, 0x1e,
+	0x09, 0x69, 0x38, 0xb0, 0xca, 0x63, 0x1c, 0x79, 0xfb, 0xb5, 0x0e, 0x7d, 0xf2, 0x25, 0x76, 0xe5,
+	0x9c, 0xd8, 0xa9, 0x62, 0x42, 0xac, 0xdf, 0x63, 0xf5, 0x07, 0x38, 0x6a, 0x09, 0x27, 0xde, 0x47,
+	0x8d, 0xb3, 0x6a, 0x28, 0x9c, 0xf0, 0x5a, 0x8d, 0x8e, 0xff, 0x5e, 0x5f, 0x63, 0xd5, 0x2d, 0xa5,
+	0x4f, 0x73, 0x93, 0x25, 0xcf, 0x4c, 0x4d, 0xbe, 0xcc, 0x66, 0x36, 0xc3, 0xd0, 0xa0, 0xb5, 0x7c,
+	0x9e, 0x95, 0xe5, 0x20, 0xb5, 0x56, 0x96, 0x03, 0x32, 0x36, 0xd0, 0xc6, 0x79, 0x63, 0x95, 0x8e,
+	0xff, 0x5e, 0x7f, 0xbd, 0xc4, 0x66, 0xf6, 0x6d, 0x6f, 0x4b, 0x58, 0xe4, 0x9f, 0x64, 0xb3, 0x91,
+	0xed, 0x3d, 0x72, 0xa3, 0xc1, 0x38, 0x35, 0x6b, 0xcf, 0x4d, 0xcd, 0xbe, 0xed, 0x1d, 0x8f, 0x06,
+	0xd8, 0x99, 0x89, 0x92, 0x0f, 0x8a, 0x24, 0xb2, 0xbd, 0x76, 0x2b, 0xb5, 0x9c, 0x10, 0x7c, 0x8d,
+	0xd5, 0x9c, 0x8c, 0xd0, 0x3a, 0x11, 0x0d, 0x56, 0x2b, 0xb7, 0x4a, 0xb7, 0xab, 0x9d, 0x1c, 0xe0,
+	0x37, 0xd8, 0xac, 0xd5, 0x43, 0x13, 0x60, 0xbb, 0xb5, 0x5a, 0xf5, 0x6a, 0x19, 0xbd, 0xfe, 0x2a,
+	0xab, 0xed, 0xdb, 0xde, 0x7d, 0x14, 0x21, 0x1a, 0xfe, 0x51, 0x56, 0x3d, 0x15, 0x36, 0x89, 0xa8,
+	0xfe, 0xfe, 0x11, 0xd1, 0x09, 0x3a, 0x5e, 0x72, 0xfd, 0x73, 0xac, 0xd1, 0xda, 0xdf, 0xfb, 0x3f,
+	0x2c, 0x50, 0xe8, 0xb6, 0x2f, 0x4c, 0x78, 0x20, 0xa2, 0x71, 0xc5, 0x72, 0x60, 0xe3, 0xcd, 0x69,
+	0x56, 0xcb, 0xda, 0x83, 0xd7, 0xd9, 0x4c, 0x77, 0x18, 0x04, 0x68, 0x2d, 0x4c, 0xf1, 0x45, 0xb6,
+	0x70, 0x12, 0xe3, 0xd3, 0x01, 0x06, 0x0e, 0x43, 0x2f, 0x03, 0x25, 0x7e, 0x95, 0xcd, 0x35, 0x75,
+	0x1c, 0x63, 0xe0, 0x76, 0x84, 0x54, 0x18, 0x42, 0x99, 0x2f, 0x31, 0x38, 0x42, 0x13, 0x49, 0x6b,
+	0xa5, 0x8e, 0x5b, 0x18, 0x4b, 0x0c, 0xa1, 0xc2, 0xaf, 0xb1, 0xc5, 0xa6, 0x56, 0x0a, 0x03, 0x27,
+	0x75, 0x7c, 0xa0, 0xdd, 0xf6, 0x53, 0x69, 0x9d, 0x85, 0x2a, 0x99, 0x6d, 0x2b, 0x85, 0x3d, 0xa1,
+	0x36, 0x4d, 0x6f, 0x18, 0x61, 0xec, 0xe0, 0x0a, 0xd9, 0x48, 0xc1, 0x96, 0x8c, 0x30, 0x26, 0x4b,
+	0x30, 0x53, 0x40, 0xdb, 0x71, 0x88, 0x4f, 0xa9, 0x3e, 0x30, 0xcb, 0xaf, 0xb3, 0xe5, 0x14, 0x2d,
+	0x38, 0x10, 0x11, 0x42, 0x8d, 0x2f, 0xb0, 0x7a, 0xca, 0x3a, 0x3e, 0x3c, 0x7a, 0x00, 0xac, 0x60,
+	0xa1, 0xa3, 0x9f, 0x74, 0x30, 0xd0, 0x26, 0x84, 0x7a, 0x21, 0x84, 0x87, 0x18, 0x38, 0x6d, 0xda,
+	0x2d, 0x68, 0x50, 0xc0, 0x29, 0xd8, 0x45, 0x61, 0x82, 0x7e, 0x07, 0xed, 0x50, 0x39, 0x98, 0xe3,
+	0xc0, 0x1a, 0x3b, 0x52, 0xe1, 0x81, 0x76, 0x3b, 0x7a, 0x18, 0x87, 0x30, 0xcf, 0xe7, 0x19, 0xdb,
+	0x47, 0x27, 0xd2, 0x0c, 0x2c, 0x90, 0xdb, 0xa6, 0x08, 0xfa, 0x98, 0x02, 0xc0, 0x57, 0x18, 0x6f,
+	0x8a, 0x38, 0xd6, 0xae, 0x69, 0x50, 0x38, 0xdc, 0xd1, 0x2a, 0x44, 0x03, 0x57, 0x29, 0x9c, 0x09,
+	0x5c, 0x2a, 0x04, 0x9e, 0x4b, 0xb7, 0x50, 0x61, 0x26, 0xbd, 0x98, 0x4b, 0xa7, 0x38, 0x49, 0x2f,
+	0x51, 0xf0, 0x5b, 0x43, 0xa9, 0x42, 0x9f, 0x92, 0xa4, 0x2c, 0xcb, 0x14, 0x63, 0x1a, 0xfc, 0xc1,
+	0x5e, 0xbb, 0x7b, 0x0c, 0x2b, 0x7c, 0x99, 0x5d, 0x4d, 0x91, 0x7d, 0x74, 0x46, 0x06, 0x3e, 0x79,
+	0xd7, 0x28, 0xd4, 0xc3, 0xa1, 0x3b, 0x3c, 0xdb, 0xc7, 0x48, 0x9b, 0x11, 0xac, 0x52, 0x41, 0xbd,
+	0xa5, 0x71, 0x89, 0xe0, 0x3a, 0x79, 0xd8, 0x8e, 0x06, 0x6e, 0x94, 0xa7, 0x17, 0x6e, 0xf0, 0x9b,
+	0xec, 0x5a, 0x12, 0x74, 0xd3, 0x60, 0x88, 0xb1, 0x93, 0x42, 0xd1, 0x71, 0x87, 0x06, 0xe1, 0x26,
+	0x5f, 0x65, 0x4b, 0xbb, 0xe8, 0x2e, 0x73, 0xd6, 0x48, 0x2d, 0x89, 0xfe, 0x32, 0xf3, 0x05, 0x62,
+	0x9e, 0x0c, 0xc2, 0xe7, 0xda, 0x7c, 0x91, 0x6c, 0xee, 0x49, 0xeb, 0x8d, 0x9e, 0x58, 0x34, 0x76,
+	0xcc, 0x79, 0x89, 0x8e, 0x96, 0x84, 0xd2, 0xd1, 0x0a, 0xc7, 0xf0, 0x2d, 0x0a, 0xbb, 0x65, 0xf4,
+	0xa0, 0x08, 0x7e, 0x80, 0xdf, 0x60, 0x2b, 0x87, 0x03, 0x34, 0xc2, 0x21, 0x19, 0x29, 0xf2, 0xd6,
+	0xc9, 0x7d, 0xca, 0x3b, 0x32, 0xf2, 0x5c, 0x2a, 0xec, 0x65, 0xcc, 0x0f, 0x52, 0x51, 0xba, 0x48,
+	0xc7, 0xdf, 0x35, 0x22, 0x76, 0x63, 0xfc, 0x43, 0xe4, 0x9c, 0xc2, 0x3a, 0xd2, 0x4a, 0x06, 0xa3,
+	0x31, 0xfc, 0x61, 0x3e, 0xc7, 0x6a, 0x1d, 0xe1, 0x70, 0x4f, 0x46, 0xd2, 0xc1, 0x47, 0x38, 0x67,
+	0x73, 0xad, 0x56, 0x07, 0x3f, 0x3f, 0x44, 0xeb, 0x3a, 0x22, 0x40, 0x78, 0x73, 0x66, 0xe3, 0x33,
+	0x8c, 0xf9, 0x4c, 0xd3, 0xf8, 0x46, 0xce, 0xd9, 0x7c, 0x4e, 0x1d, 0xe8, 0x18, 0x61, 0x8a, 0x37,
+	0xd8, 0xec, 0x49, 0x2c, 0xad, 0x1d, 0x62, 0x08, 0x25, 0xea, 0xb2, 0x76, 0x7c, 0x64, 0x74, 0x8f,
+	0x06, 0x20, 0x94, 0x89, 0xbb, 0x23, 0x63, 0x69, 0xfb, 0xfe, 0x7e, 0x31, 0x36, 0x9d, 0xb6, 0x5b,
+	0x75, 0xc3, 0xb2, 0x46, 0x17, 0x7b, 0x74, 0x95, 0x12, 0xdb, 0x4b, 0x0c, 0x8a, 0x74, 0x6e, 0x3d,
+	0x2b, 0x72, 0x89, 0xae, 0xfa, 0xae, 0xd1, 0x4f, 0x64, 0xdc, 0x83, 0x32, 0x19, 0xeb, 0xa2, 0x50,
+	0xde, 0x70, 0x9d, 0xcd, 0xec, 0xa8, 0xa1, 0xf7, 0x52, 0xf5, 0x3e, 0x89, 0x20, 0xb1, 0x2b, 0xc4,
+	0xa2, 0x0c, 0x0f, 0x30, 0x84, 0xe9, 0x8d, 0x37, 0xea, 0x7e, 0xda, 0xfa, 0xa1, 0x39, 0xc7, 0x6a,
+	0x27, 0x71, 0x88, 0x67, 0x32, 0xc6, 0x10, 0xa6, 0x7c, 0xe3, 0x26, 0xbd, 0x92, 0x77, 0x50, 0x48,
+	0x27, 0x26, 0xed, 0x02, 0x86, 0xd4, 0x7d, 0xf7, 0x85, 0x2d, 0x40, 0x67, 0x94, 0xf8, 0x16, 0xda,
+	0xc0, 0xc8, 0xd3, 0xa2, 0x7a, 0x8f, 0xca, 0xdb, 0xed, 0xeb, 0x27, 0x39, 0x66, 0xa1, 0x4f, 0x9e,
+	0x76, 0xd1, 0x75, 0x47, 0xd6, 0x61, 0xd4, 0xd4, 0xf1, 0x99, 0xec, 0x59, 0x90, 0xe4, 0x69, 0x4f,
+	0x8b, 0xb0, 0xa0, 0xfe, 0x1a, 0xd5, 0xad, 0x83, 0x0a, 0x85, 0x2d, 0x5a, 0x7d, 0xec, 0xaf, 0xae,
+	0x0f, 0x75, 0x53, 0x49, 0x61, 0x41, 0xd1, 0x51, 0x28, 0xca, 0x84, 0x8c, 0xa8, 0x08, 0x9b, 0xca,
+	0xa1, 0x49, 0xe8, 0x98, 0xa2, 0xdb, 0x0c, 0x0b, 0x96, 0x77, 0x24, 0xaa, 0x10, 0x34, 0x5f, 0x62,
+	0x0b, 0x89, 0x9d, 0x23, 0x61, 0x9c, 0xf4, 0xc6, 0x7f, 0x5d, 0xf2, 0x6d, 0x60, 0xf4, 0x20, 0xc7,
+	0x7e, 0x43, 0x13, 0xb4, 0x71, 0x5f, 0xd8, 0x1c, 0xfa, 0x6d, 0x89, 0xaf, 0xb0, 0xab, 0xe3, 0x23,
+	0xe7, 0xf8, 0xef, 0x4a, 0x7c, 0x91, 0xcd, 0xd3, 0x91, 0x33, 0xcc, 0xc2, 0xef, 0x3d, 0x48, 0x87,
+	0x2b, 0x80, 0x7f, 0xf0, 0x16, 0xd2, 0xd3, 0x15, 0xf0, 0x3f, 0x7a, 0x67, 0x64, 0x21, 0xed, 0x06,
+	0x0b, 0x6f, 0x95, 0x28, 0xd2, 0xb1, 0xb3, 0x14, 0x86, 0x67, 0x5e, 0x90, 0xac, 0x66, 0x82, 0x6f,
+	0x7b, 0xc1, 0xd4, 0x66, 0x86, 0xbe, 0xe3, 0xd1, 0xfb, 0x22, 0x0e, 0xf5, 0xd9, 0x59, 0x86, 0xbe,
+	0x5b, 0xe2, 0xab, 0x6c, 0x91, 0xd4, 0xb7, 0x84, 0x12, 0x71, 0x90, 0xcb, 0xbf, 0x57, 0xe2, 0x30,
+	0x4e, 0xb0, 0xef, 0x76, 0xf8, 0x46, 0xd9, 0x27, 0x25, 0x0d, 0x20, 0xc1, 0xbe, 0x59, 0xe6, 0xf3,
+	0x49, 0xd6, 0x13, 0xfa, 0x5b, 0x65, 0x5e, 0x67, 0xd3, 0xed, 0xd8, 0xa2, 0x71, 0xf0, 0x25, 0xea,
+	0xc8, 0xe9, 0x64, 0x86, 0xc0, 0x97, 0xa9, 0xef, 0xaf, 0xf8, 0x8e, 0x84, 0xd7, 0x3d, 0xe3, 0x64,
+	0xe0, 0xa5, 0xbe, 0xe2, 0x89, 0x64, 0x70, 0xc3, 0x3f, 0x2a, 0xfe, 0xdc, 0xc5, 0x29, 0xfe, 0xcf,
+	0x0a, 0xb9, 0xdd, 0x45, 0x97, 0xdf, 0x39, 0xf8, 0x57, 0x85, 0xdf, 0x60, 0xcb, 0x63, 0xcc, 0xcf,
+	0xd4, 0xec, 0xb6, 0xfd, 0xbb, 0xc2, 0xd7, 0xd8, 0x35, 0x9a, 0x69, 0x59, 0xa5, 0x49, 0x49, 0x5a,
+	0x27, 0x03, 0x0b, 0xff, 0xa9, 0xf0, 0x9b, 0x6c, 0x65, 0x17, 0x5d, 0x96, 0xec, 0x02, 0xf3, 0xbf,
+	0x15, 0x3e, 0xc7, 0x66, 0x3b, 0xe8, 0x8c, 0xc4, 0x73, 0x84, 0xb7, 0x2a, 0x54, 0xb1, 0x31, 0x99,
+	0x86, 0xf3, 0xac, 0x42, 0x79, 0xfc, 0xb4, 0x70, 0x41, 0xbf, 0x15, 0x35, 0xfb, 0x22, 0x8e, 0x51,
+	0x59, 0x78, 0xbb, 0xc2, 0x97, 0x19, 0x74, 0x30, 0xd2, 0xe7, 0x58, 0x80, 0xdf, 0xa1, 0x65, 0xca,
+	0xbd, 0xf0, 0xa7, 0x86, 0x68, 0x46, 0x19, 0xe3, 0xdd, 0x0a, 0xe5, 0x3d, 0x91, 0x9f, 0xe4, 0xbc,
+	0x57, 0xe1, 0x2f, 0xb0, 0xd5, 0xe4, 0x4a, 0x8f, 0x8b, 0x41, 0xcc, 0x1e, 0xb6, 0xe3, 0x33, 0x0d,
+	0x5f, 0xa8, 0x66, 0x16, 0x5b, 0xa8, 0x9c, 0xc8, 0xf4, 0xbe, 0x58, 0xa5, 0x7a, 0xa5, 0x1a, 0x5e,
+	0xf4, 0x4f, 0x55, 0xbe, 0xc0, 0x58, 0x72, 0xc1, 0x3c, 0xf0, 0xe7, 0x2a, 0x1d, 0xef, 0x58, 0x46,
+	0x78, 0x2c, 0x83, 0xc7, 0xf0, 0xed, 0x1a, 0x1d, 0xcf, 0x7b, 0x3f, 0xd0, 0x21, 0x52, 0x1e, 0x2c,
+	0x7c, 0xa7, 0x46, 0x05, 0xa5, 0x86, 0x48, 0x0a, 0xfa, 0x5d, 0x4f, 0xa7, 0xe3, 0xb0, 0xdd, 0x82,
+	0xef, 0xd1, 0xa6, 0x66, 0x29, 0x7d, 0xdc, 0x3d, 0x84, 0xef, 0xd7, 0x28, 0x1f, 0x9b, 0x4a, 0xe9,
+	0x40, 0xb8, 0xac, 0x2d, 0x7f, 0x50, 0xa3, 0xbe, 0x2e, 0x4c, 0xb2, 0x34, 0xc3, 0x3f, 0xac, 0x51,
+	0x9e, 0x52, 0xdc, 0x37, 0x43, 0x8b, 0x26, 0xdc, 0x8f, 0xbc, 0x55, 0x7a, 0x80, 0x52, 0x24, 0xc7,
+	0x0e, 0x7e, 0xec, 0xe5, 0x2e, 0x6e, 0x2d, 0x78, 0xa3, 0x9e, 0xf6, 0x42, 0x01, 0xfb, 0x4b, 0x9d,
+	0x44, 0x2f, 0x6e, 0x2a, 0xf8, 0xab, 0x87, 0x2f, 0xee, 0x28, 0xf8, 0x5b, 0x9d, 0xaf, 0x24, 0x6b,
+	0x60, 0xbc, 0x9d, 0x62, 0x11, 0xa1, 0x85, 0xbf, 0xd7, 0x29, 0x82, 0x7c, 0x37, 0xc1, 0x4f, 0x1a,
+	0x94, 0xac, 0xf1, 0x56, 0x82, 0x9f, 0x36, 0xe8, 0x98, 0x17, 0xf6, 0x11, 0xfc, 0xac, 0x41, 0x4e,
+	0x2e, 0x6e, 0x22, 0xf8, 0x79, 0x23, 0xa9, 0x45, 0xb6, 0x83, 0xe0, 0x17, 0x0d, 0x6a, 0xbb, 0x0e,
+	0x9e, 0x19, 0xb4, 0xfd, 0x64, 0x01, 0x51, 0x49, 0xfc, 0xd3, 0x03, 0x7e, 0xd9, 0x20, 0xdf, 0xf9,
+	0x6a, 0x82, 0x5f, 0x35, 0xa8, 0x32, 0x49, 0x30, 0x94, 0x14, 0x7a, 0x0c, 0xc2, 0x57, 0xe7, 0xe8,
+	0x6a, 0x50, 0x40, 0x19, 0xf4, 0xb5, 0x39, 0x4a, 0x07, 0x29, 0x8e, 0x21, 0x0b, 0x5f, 0x9f, 0xdb,
+	0x58, 0x67, 0x33, 0x2d, 0xab, 0xfc, 0x74, 0x9f, 0x61, 0x95, 0x96, 0x55, 0x30, 0x45, 0xc3, 0x70,
+	0x4b, 0x6b, 0xb5, 0xfd, 0x74, 0x60, 0x1e, 0x7e, 0x0c, 0x4a, 0x1b, 0xf7, 0x19, 0x34, 0x75, 0x6c,
+	0xa5, 0x75, 0x18, 0x07, 0xa3, 0x3d, 0x3c, 0x47, 0xe5, 0x57, 0x89, 0x33, 0x3a, 0xee, 0xc1, 0x94,
+	0x7f, 0x4e, 0xa2, 0x7f, 0x16, 0x26, 0x0b, 0x67, 0x8b, 0xde, 0x4f, 0xfe, 0xcd, 0x38, 0xcf, 0xd8,
+	0xf6, 0x39, 0xc6, 0x6e, 0x28, 0x94, 0x1a, 0x41, 0x65, 0x63, 0x8b, 0x2d, 0x34, 0x75, 0x34, 0x10,
+	0xd9, 0x4d, 0xf3, 0xab, 0x21, 0xd9, 0x29, 0x18, 0x7a, 0x00, 0xa6, 0x68, 0x36, 0x6f, 0x3f, 0xc5,
+	0x60, 0xe8, 0x68, 0x1d, 0x95, 0x88, 0x24, 0x25, 0xaa, 0x59, 0x08, 0xe5, 0x8d, 0x57, 0x18, 0x3b,
+	0x3c, 0x7d, 0x0d, 0x03, 0xe7, 0x83, 0x9e, 0x67, 0xac, 0x30, 0xe8, 0xa7, 0x28, 0xae, 0x5d, 0xa5,
+	0x4f, 0x85, 0x82, 0x12, 0x9f, 0x65, 0x55, 0xca, 0x3e, 0x94, 0x37, 0x9e, 0x5d, 0x61, 0x0b, 0x89,
+	0x52, 0x96, 0x78, 0x7a, 0x4b, 0x65, 0xc4, 0xa6, 0xa2, 0x73, 0xbf, 0xc0, 0xae, 0x67, 0xc8, 0xa5,
+	0xc5, 0x56, 0xa2, 0x77, 0x44, 0xc6, 0xbe, 0xb0, 0xe1, 0xca, 0xfc, 0x25, 0x76, 0x33, 0x67, 0x5e,
+	0xde, 0x6b, 0x34, 0x67, 0x56, 0x33, 0x81, 0x8b, 0x0b, 0xae, 0x4a, 0x59, 0xc8, 0xb8, 0x74, 0xa1,
+	0x92, 0xb7, 0x72, 0x06, 0xa5, 0x03, 0x1a, 0xa6, 0xe9, 0xf9, 0x9a, 0xc7, 0x98, 0xa5, 0x12, 0x66,
+	0x68, 0x6f, 0x66, 0x8c, 0x74, 0xa6, 0xce, 0x4e, 0x80, 0xe9, 0x6c, 0xad, 0xd1, 0x5b, 0x29, 0x03,
+	0x77, 0xb1, 0x78, 0xe3, 0x18, 0xbd, 0xc6, 0x2e, 0xa4, 0x20, 0xb9, 0xda, 0xf5, 0x09, 0x8e, 0xc7,
+	0x5a, 0xe8, 0x84, 0x54, 0xd0, 0xa0, 0x5d, 0x39, 0x91, 0x97, 0x44, 0x63, 0x6e, 0xc2, 0x79, 0x3a,
+	0xb2, 0xe7, 0x69, 0x67, 0x67, 0x60, 0x32, 0xe0, 0x17, 0x26, 0x30, 0x3f, 0x62, 0x00, 0x26, 0xdc,
+	0x15, 0x56, 0x0e, 0x5c, 0x9d, 0x48, 0x64, 0x12, 0xe2, 0xe1, 0x93, 0x18, 0x8d, 0xed, 0xcb, 0x01,
+	0xf0, 0x89, 0xfc, 0x24, 0x17, 0xda, 0xb7, 0xc0, 0xe2, 0xc4, 0xa9, 0x29, 0xca, 0x5c, 0x69, 0x69,
+	0xb2, 0x36, 0xfe, 0x26, 0xe6, 0xdc, 0xe5, 0x09, 0xee, 0xbe, 0x88, 0x45, 0xaf, 0xe0, 0x70, 0xe5,
+	0x39, 0xe1, 0xe4, 0xbb, 0xfd, 0xda, 0x25, 0xaf, 0x39, 0x6f, 0x75, 0xa2, 0x9f, 0x2e, 0xdc, 0xdf,
+	0xeb, 0xf4, 0xf7, 0x33, 0xa1, 0x98, 0xb1, 0x6e, 0x4c, 0xd8, 0x9c, 0xbc, 0xcf, 0x37, 0x27, 0x72,
+	0x9e, 0xee, 0xcc, 0xb5, 0xad, 0x8f, 0x7f, 0xf6, 0x5e, 0x4f, 0xba, 0xfe, 0xf0, 0x94, 0xfe, 0x1c,
+	0xef, 0x26, 0xbf, 0x92, 0x2f, 0x4b, 0x9d, 0x7e, 0xdd, 0x95, 0xb1, 0xa3, 0xc9, 0xa6, 0xee, 0xfa,
+	0xbf, 0xcb, 0xbb, 0xc9, 0xdf, 0xe5, 0xe0, 0xf4, 0x74, 0xda, 0xd3, 0xf7, 0xfe, 0x37, 0x00, 0xce,
+	0xcd, 0x52, 0xbf, 0xae, 0x10, 0x00, 0x00,
 }
@@ -2458,6 +2458,7 @@ type CompactionPlan struct {
 	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
 	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
 	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	CollectionTtl        int64                       `protobuf:"varint,8,opt,name=collection_ttl,json=collectionTtl,proto3" json:"collection_ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
 	XXX_sizecache        int32                       `json:"-"`
@@ -2537,6 +2538,13 @@ func (m *CompactionPlan) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionPlan) GetCollectionTtl() int64 {
+	if m != nil {
+		return m.CollectionTtl
+	}
+	return 0
+}
+
 type CompactionResult struct {
 	PlanID               int64           `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
 	SegmentID            int64           `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -2803,169 +2811,170 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 2588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xdf, 0x6f, 0x1b, 0xc7,
-	0xf1, 0xf7, 0xf1, 0x87, 0x44, 0x0e, 0x29, 0x8a, 0x5a, 0x3b, 0x32, 0xbf, 0xb4, 0x2d, 0xcb, 0x97,
-	0xc4, 0x56, 0x1c, 0x47, 0xb2, 0xe5, 0x6f, 0xd0, 0xa0, 0x4e, 0x1a, 0x44, 0x96, 0xad, 0x12, 0x95,
-	0x5c, 0xf5, 0xa8, 0xc4, 0x45, 0x03, 0x94, 0x38, 0xf1, 0x56, 0xd4, 0xd5, 0xf7, 0x83, 0xbe, 0x3d,
-	0xca, 0x56, 0x5e, 0x62, 0xa4, 0x40, 0x81, 0x16, 0x6d, 0xd3, 0xa2, 0xaf, 0x05, 0x5a, 0xf4, 0xa9,
-	0x40, 0x5f, 0xda, 0x02, 0x7d, 0x69, 0xff, 0x81, 0xa2, 0x7d, 0xe9, 0x53, 0xff, 0x9e, 0x62, 0x7f,
-	0xdc, 0xde, 0x0f, 0x1e, 0xc9, 0xa3, 0x64, 0x5b, 0x6f, 0xdc, 0xbd, 0xd9, 0x99, 0xd9, 0xd9, 0x99,
-	0xcf, 0xcc, 0xec, 0x12, 0xea, 0x86, 0xee, 0xeb, 0x9d, 0xae, 0xeb, 0x7a, 0xc6, 0x6a, 0xdf, 0x73,
-	0x7d, 0x17, 0x2d, 0xd8, 0xa6, 0x75, 0x34, 0x20, 0x7c, 0xb4, 0x4a, 0x3f, 0x37, 0xab, 0x5d, 0xd7,
-	0xb6, 0x5d, 0x87, 0x4f, 0x35, 0x6b, 0xa6, 0xe3, 0x63, 0xcf, 0xd1, 0x2d, 0x31, 0xae, 0x46, 0x17,
-	0x34, 0xab, 0xa4, 0x7b, 0x88, 0x6d, 0x9d, 0x8f, 0xd4, 0xe7, 0x50, 0x7d, 0x68, 0x0d, 0xc8, 0xa1,
-	0x86, 0x9f, 0x0e, 0x30, 0xf1, 0xd1, 0x6d, 0x28, 0xec, 0xeb, 0x04, 0x37, 0x94, 0x65, 0x65, 0xa5,
-	0xb2, 0x7e, 0x79, 0x35, 0x26, 0x4b, 0x48, 0xd9, 0x21, 0xbd, 0x0d, 0x9d, 0x60, 0x8d, 0x51, 0x22,
-	0x04, 0x05, 0x63, 0xbf, 0xb5, 0xd9, 0xc8, 0x2d, 0x2b, 0x2b, 0x79, 0x8d, 0xfd, 0x46, 0x2a, 0x54,
-	0xbb, 0xae, 0x65, 0xe1, 0xae, 0x6f, 0xba, 0x4e, 0x6b, 0xb3, 0x51, 0x60, 0xdf, 0x62, 0x73, 0xea,
-	0x6f, 0x15, 0x98, 0x13, 0xa2, 0x49, 0xdf, 0x75, 0x08, 0x46, 0x77, 0x61, 0x86, 0xf8, 0xba, 0x3f,
-	0x20, 0x42, 0xfa, 0xa5, 0x54, 0xe9, 0x6d, 0x46, 0xa2, 0x09, 0xd2, 0x4c, 0xe2, 0xf3, 0xc3, 0xe2,
-	0xd1, 0x12, 0x00, 0xc1, 0x3d, 0x1b, 0x3b, 0x7e, 0x6b, 0x93, 0x34, 0x0a, 0xcb, 0xf9, 0x95, 0xbc,
-	0x16, 0x99, 0x51, 0x7f, 0xad, 0x40, 0xbd, 0x1d, 0x0c, 0x03, 0xeb, 0x5c, 0x80, 0x62, 0xd7, 0x1d,
-	0x38, 0x3e, 0x53, 0x70, 0x4e, 0xe3, 0x03, 0x74, 0x0d, 0xaa, 0xdd, 0x43, 0xdd, 0x71, 0xb0, 0xd5,
-	0x71, 0x74, 0x1b, 0x33, 0x55, 0xca, 0x5a, 0x45, 0xcc, 0x3d, 0xd2, 0x6d, 0x9c, 0x49, 0xa3, 0x65,
-	0xa8, 0xf4, 0x75, 0xcf, 0x37, 0x63, 0x36, 0x8b, 0x4e, 0xa9, 0xbf, 0x57, 0x60, 0xf1, 0x13, 0x42,
-	0xcc, 0x9e, 0x33, 0xa4, 0xd9, 0x22, 0xcc, 0x38, 0xae, 0x81, 0x5b, 0x9b, 0x4c, 0xb5, 0xbc, 0x26,
-	0x46, 0xe8, 0x12, 0x94, 0xfb, 0x18, 0x7b, 0x1d, 0xcf, 0xb5, 0x02, 0xc5, 0x4a, 0x74, 0x42, 0x73,
-	0x2d, 0x8c, 0xbe, 0x07, 0x0b, 0x24, 0xc1, 0x88, 0x34, 0xf2, 0xcb, 0xf9, 0x95, 0xca, 0xfa, 0x9b,
-	0xab, 0x43, 0x5e, 0xb6, 0x9a, 0x14, 0xaa, 0x0d, 0xaf, 0x56, 0x5f, 0xe4, 0xe0, 0xbc, 0xa4, 0xe3,
-	0xba, 0xd2, 0xdf, 0xd4, 0x72, 0x04, 0xf7, 0xa4, 0x7a, 0x7c, 0x90, 0xc5, 0x72, 0xd2, 0xe4, 0xf9,
-	0xa8, 0xc9, 0x33, 0x38, 0x58, 0xd2, 0x9e, 0xc5, 0x21, 0x7b, 0xa2, 0xab, 0x50, 0xc1, 0xcf, 0xfb,
-	0xa6, 0x87, 0x3b, 0xbe, 0x69, 0xe3, 0xc6, 0xcc, 0xb2, 0xb2, 0x52, 0xd0, 0x80, 0x4f, 0xed, 0x99,
-	0x76, 0xd4, 0x23, 0x67, 0x33, 0x7b, 0xa4, 0xfa, 0x07, 0x05, 0x2e, 0x0e, 0x9d, 0x92, 0x70, 0x71,
-	0x0d, 0xea, 0x6c, 0xe7, 0xa1, 0x65, 0xa8, 0xb3, 0x53, 0x83, 0x5f, 0x1f, 0x67, 0xf0, 0x90, 0x5c,
-	0x1b, 0x5a, 0x1f, 0x51, 0x32, 0x97, 0x5d, 0xc9, 0x27, 0x70, 0x71, 0x0b, 0xfb, 0x42, 0x00, 0xfd,
-	0x86, 0xc9, 0xc9, 0x21, 0x20, 0x1e, 0x4b, 0xb9, 0xa1, 0x58, 0xfa, 0x73, 0x4e, 0xc6, 0x12, 0x13,
-	0xd5, 0x72, 0x0e, 0x5c, 0x74, 0x19, 0xca, 0x92, 0x44, 0x78, 0x45, 0x38, 0x81, 0xbe, 0x01, 0x45,
-	0xaa, 0x29, 0x77, 0x89, 0xda, 0xfa, 0xb5, 0xf4, 0x3d, 0x45, 0x78, 0x6a, 0x9c, 0x1e, 0xb5, 0xa0,
-	0x46, 0x7c, 0xdd, 0xf3, 0x3b, 0x7d, 0x97, 0xb0, 0x73, 0x66, 0x8e, 0x53, 0x59, 0x57, 0xe3, 0x1c,
-	0x24, 0x44, 0xee, 0x90, 0xde, 0xae, 0xa0, 0xd4, 0xe6, 0xd8, 0xca, 0x60, 0x88, 0x1e, 0x40, 0x15,
-	0x3b, 0x46, 0xc8, 0xa8, 0x90, 0x99, 0x51, 0x05, 0x3b, 0x86, 0x64, 0x13, 0x9e, 0x4f, 0x31, 0xfb,
-	0xf9, 0xfc, 0x5c, 0x81, 0xc6, 0xf0, 0x01, 0x9d, 0x06, 0x28, 0xef, 0xf1, 0x45, 0x98, 0x1f, 0xd0,
-	0xd8, 0x08, 0x97, 0x87, 0xa4, 0x89, 0x25, 0xaa, 0x09, 0x6f, 0x84, 0xda, 0xb0, 0x2f, 0xaf, 0xcc,
-	0x59, 0x7e, 0xac, 0xc0, 0x62, 0x52, 0xd6, 0x69, 0xf6, 0xfd, 0xff, 0x50, 0x34, 0x9d, 0x03, 0x37,
-	0xd8, 0xf6, 0xd2, 0x98, 0x38, 0xa3, 0xb2, 0x38, 0xb1, 0x6a, 0xc3, 0xa5, 0x2d, 0xec, 0xb7, 0x1c,
-	0x82, 0x3d, 0x7f, 0xc3, 0x74, 0x2c, 0xb7, 0xb7, 0xab, 0xfb, 0x87, 0xa7, 0x88, 0x91, 0x98, 0xbb,
-	0xe7, 0x12, 0xee, 0xae, 0xfe, 0x51, 0x81, 0xcb, 0xe9, 0xf2, 0xc4, 0xd6, 0x9b, 0x50, 0x3a, 0x30,
-	0xb1, 0x65, 0x50, 0x9b, 0x29, 0xcc, 0x66, 0x72, 0x4c, 0x63, 0xa5, 0x4f, 0x89, 0xc5, 0x0e, 0xaf,
-	0x8d, 0x70, 0xd0, 0xb6, 0xef, 0x99, 0x4e, 0x6f, 0xdb, 0x24, 0xbe, 0xc6, 0xe9, 0x23, 0xf6, 0xcc,
-	0x67, 0xf7, 0xcc, 0x9f, 0x29, 0xb0, 0xb4, 0x85, 0xfd, 0xfb, 0x12, 0x6a, 0xe9, 0x77, 0x93, 0xf8,
-	0x66, 0x97, 0xbc, 0xda, 0x22, 0x22, 0x25, 0x67, 0xaa, 0x5f, 0x2b, 0x70, 0x75, 0xa4, 0x32, 0xc2,
-	0x74, 0x02, 0x4a, 0x02, 0xa0, 0x4d, 0x87, 0x92, 0xef, 0xe0, 0xe3, 0xcf, 0x74, 0x6b, 0x80, 0x77,
-	0x75, 0xd3, 0xe3, 0x50, 0x72, 0x42, 0x60, 0xfd, 0x93, 0x02, 0x57, 0xb6, 0xb0, 0xbf, 0x1b, 0xa4,
-	0x99, 0x33, 0xb4, 0x4e, 0x86, 0x8a, 0xe2, 0x97, 0xfc, 0x30, 0x53, 0xb5, 0x3d, 0x13, 0xf3, 0x2d,
-	0xb1, 0x38, 0x88, 0x04, 0xe4, 0x7d, 0x5e, 0x0b, 0x08, 0xe3, 0xa9, 0x7f, 0xcb, 0x41, 0xf5, 0x33,
-	0x51, 0x1f, 0xb0, 0x34, 0x92, 0xb4, 0x83, 0x92, 0x6e, 0x87, 0x48, 0x49, 0x91, 0x56, 0x65, 0x6c,
-	0xc1, 0x1c, 0xc1, 0xf8, 0xc9, 0x49, 0x92, 0x46, 0x95, 0x2e, 0x94, 0x60, 0xbf, 0x0d, 0x0b, 0x03,
-	0xe7, 0x80, 0x96, 0xb5, 0xd8, 0x10, 0xbb, 0xe0, 0xd5, 0xe5, 0x64, 0xe4, 0x19, 0x5e, 0x88, 0xbe,
-	0x0d, 0xf3, 0x49, 0x5e, 0xc5, 0x4c, 0xbc, 0x92, 0xcb, 0xd4, 0x9f, 0x2a, 0xb0, 0xf8, 0x58, 0xf7,
-	0xbb, 0x87, 0x9b, 0xb6, 0xb0, 0xe8, 0x29, 0xfc, 0xf1, 0x23, 0x28, 0x1f, 0x09, 0xeb, 0x05, 0xa0,
-	0x73, 0x35, 0x45, 0xa1, 0xe8, 0x39, 0x69, 0xe1, 0x0a, 0xf5, 0x9f, 0x0a, 0x5c, 0x60, 0x95, 0x7f,
-	0xa0, 0xdd, 0xeb, 0x8f, 0x8c, 0x09, 0xd5, 0x3f, 0xba, 0x0e, 0x35, 0x5b, 0xf7, 0x9e, 0xb4, 0x43,
-	0x9a, 0x22, 0xa3, 0x49, 0xcc, 0xaa, 0xcf, 0x01, 0xc4, 0x68, 0x87, 0xf4, 0x4e, 0xa0, 0xff, 0x07,
-	0x30, 0x2b, 0xa4, 0x8a, 0x20, 0x99, 0x74, 0xb0, 0x01, 0xb9, 0xfa, 0x2f, 0x05, 0x6a, 0x21, 0xec,
-	0xb1, 0x50, 0xa8, 0x41, 0x4e, 0x06, 0x40, 0xae, 0xb5, 0x89, 0x3e, 0x82, 0x19, 0xde, 0xeb, 0x09,
-	0xde, 0x6f, 0xc7, 0x79, 0x8b, 0x3e, 0x30, 0x82, 0x9d, 0x6c, 0x42, 0x13, 0x8b, 0xa8, 0x8d, 0x24,
-	0x54, 0xf0, 0xb6, 0x20, 0xaf, 0x45, 0x66, 0x50, 0x0b, 0xe6, 0xe3, 0x95, 0x56, 0xe0, 0xe8, 0xcb,
-	0xa3, 0x20, 0x62, 0x53, 0xf7, 0x75, 0x86, 0x10, 0xb5, 0x58, 0xa1, 0x45, 0xd4, 0xff, 0x14, 0xa1,
-	0x12, 0xd9, 0xe5, 0xd0, 0x4e, 0x92, 0x47, 0x9a, 0x9b, 0x0c, 0x76, 0xf9, 0xe1, 0x72, 0xff, 0x6d,
-	0xa8, 0x99, 0x2c, 0xc1, 0x76, 0x84, 0x2b, 0x32, 0x44, 0x2c, 0x6b, 0x73, 0x7c, 0x56, 0xc4, 0x05,
-	0x5a, 0x82, 0x8a, 0x33, 0xb0, 0x3b, 0xee, 0x41, 0xc7, 0x73, 0x9f, 0x11, 0xd1, 0x37, 0x94, 0x9d,
-	0x81, 0xfd, 0xdd, 0x03, 0xcd, 0x7d, 0x46, 0xc2, 0xd2, 0x74, 0x66, 0xca, 0xd2, 0x74, 0x09, 0x2a,
-	0xb6, 0xfe, 0x9c, 0x72, 0xed, 0x38, 0x03, 0x9b, 0xb5, 0x14, 0x79, 0xad, 0x6c, 0xeb, 0xcf, 0x35,
-	0xf7, 0xd9, 0xa3, 0x81, 0x8d, 0x56, 0xa0, 0x6e, 0xe9, 0xc4, 0xef, 0x44, 0x7b, 0x92, 0x12, 0xeb,
-	0x49, 0x6a, 0x74, 0xfe, 0x41, 0xd8, 0x97, 0x0c, 0x17, 0xb9, 0xe5, 0x53, 0x14, 0xb9, 0x86, 0x6d,
-	0x85, 0x8c, 0x20, 0x7b, 0x91, 0x6b, 0xd8, 0x96, 0x64, 0xf3, 0x01, 0xcc, 0xee, 0xb3, 0xb2, 0x85,
-	0x34, 0x2a, 0x23, 0x11, 0xea, 0x21, 0xad, 0x58, 0x78, 0x75, 0xa3, 0x05, 0xe4, 0xe8, 0x43, 0x28,
-	0xb3, 0x7c, 0xc1, 0xd6, 0x56, 0x33, 0xad, 0x0d, 0x17, 0x50, 0x28, 0x32, 0xb0, 0xe5, 0xeb, 0x6c,
-	0xf5, 0xdc, 0x48, 0x28, 0xda, 0xa4, 0x34, 0xdb, 0x6e, 0x8f, 0x43, 0x91, 0x5c, 0x81, 0x6e, 0xc3,
-	0xf9, 0xae, 0x87, 0x75, 0x1f, 0x1b, 0x1b, 0xc7, 0xf7, 0x5d, 0xbb, 0xaf, 0x33, 0x6f, 0x6a, 0xd4,
-	0x96, 0x95, 0x95, 0x92, 0x96, 0xf6, 0x89, 0x22, 0x43, 0x57, 0x8e, 0x1e, 0x7a, 0xae, 0xdd, 0x98,
-	0xe7, 0xc8, 0x10, 0x9f, 0x55, 0xbf, 0x84, 0x0b, 0xa1, 0x0f, 0x44, 0xec, 0x3d, 0x7c, 0x74, 0xca,
-	0x49, 0x8f, 0x6e, 0x7c, 0x49, 0xf9, 0xd7, 0x02, 0x2c, 0xb6, 0xf5, 0x23, 0xfc, 0xea, 0xab, 0xd7,
-	0x4c, 0x88, 0xbb, 0x0d, 0x0b, 0xac, 0x60, 0x5d, 0x8f, 0xe8, 0x33, 0x26, 0x31, 0x46, 0x8f, 0x7b,
-	0x78, 0x21, 0xfa, 0x98, 0x66, 0x74, 0xdc, 0x7d, 0xb2, 0xeb, 0x9a, 0x61, 0x52, 0xbc, 0x92, 0xc2,
-	0xe7, 0xbe, 0xa4, 0xd2, 0xa2, 0x2b, 0xd0, 0xee, 0x30, 0x78, 0xcd, 0x30, 0x26, 0x37, 0xc6, 0xb6,
-	0x45, 0xa1, 0xf5, 0x93, 0x18, 0x86, 0x1a, 0x30, 0x2b, 0x92, 0x2e, 0x8b, 0xec, 0x92, 0x16, 0x0c,
-	0xd1, 0x2e, 0x9c, 0xe7, 0x3b, 0x68, 0x0b, 0xb7, 0xe5, 0x9b, 0x2f, 0x65, 0xda, 0x7c, 0xda, 0xd2,
-	0xb8, 0xd7, 0x97, 0xa7, 0xf6, 0xfa, 0x06, 0xcc, 0x1a, 0x9e, 0xdb, 0xef, 0x63, 0x83, 0x85, 0x7b,
-	0x49, 0x0b, 0x86, 0xb4, 0xb8, 0x87, 0xd0, 0x64, 0x13, 0x7a, 0xf4, 0x6f, 0x41, 0x49, 0x3a, 0x71,
-	0x2e, 0xb3, 0x13, 0xcb, 0x35, 0x49, 0xa0, 0xcd, 0x27, 0x80, 0x56, 0xfd, 0xb7, 0x02, 0xd5, 0xe8,
-	0x16, 0x28, 0x80, 0x7b, 0xb8, 0xeb, 0x7a, 0x46, 0x07, 0x3b, 0xbe, 0x67, 0x62, 0xde, 0x07, 0x16,
-	0xb4, 0x39, 0x3e, 0xfb, 0x80, 0x4f, 0x52, 0x32, 0x8a, 0x9d, 0xc4, 0xd7, 0xed, 0x7e, 0xe7, 0x80,
-	0x86, 0x68, 0x8e, 0x93, 0xc9, 0x59, 0x1a, 0xa1, 0xe8, 0x1a, 0x54, 0x43, 0x32, 0xdf, 0x65, 0xf2,
-	0x0b, 0x5a, 0x45, 0xce, 0xed, 0xb9, 0xe8, 0x2d, 0xa8, 0x31, 0xab, 0x75, 0x2c, 0xb7, 0xd7, 0xa1,
-	0x3d, 0x93, 0xc8, 0x18, 0x55, 0x43, 0xa8, 0x45, 0x8f, 0x23, 0x4e, 0x45, 0xcc, 0x2f, 0xb0, 0xc8,
-	0x19, 0x92, 0xaa, 0x6d, 0x7e, 0x81, 0xd5, 0xaf, 0x14, 0x98, 0xa3, 0x09, 0xf0, 0x91, 0x6b, 0xe0,
-	0xbd, 0x13, 0x96, 0x0b, 0x19, 0xee, 0xcb, 0x2e, 0x43, 0x59, 0xee, 0x40, 0x6c, 0x29, 0x9c, 0xa0,
-	0xcd, 0xf5, 0x9c, 0xc8, 0x73, 0x6d, 0x79, 0x7f, 0xca, 0x58, 0x29, 0x8c, 0x15, 0xfb, 0x8d, 0xbe,
-	0x19, 0xbf, 0x7c, 0x79, 0x2b, 0x35, 0xae, 0x18, 0x13, 0x56, 0x52, 0xc6, 0x92, 0x5c, 0x96, 0xae,
-	0xed, 0x05, 0x3d, 0x58, 0x61, 0x0a, 0x76, 0xb0, 0x0d, 0x98, 0xd5, 0x0d, 0xc3, 0xc3, 0x84, 0x08,
-	0x3d, 0x82, 0x21, 0xfd, 0x72, 0x84, 0x3d, 0x12, 0xb8, 0x58, 0x5e, 0x0b, 0x86, 0xe8, 0x43, 0x28,
-	0xc9, 0x1a, 0x34, 0x9f, 0x56, 0x77, 0x44, 0xf5, 0x14, 0x5d, 0x86, 0x5c, 0xa1, 0x7e, 0x9d, 0x83,
-	0x9a, 0x08, 0xeb, 0x0d, 0x91, 0x88, 0xc6, 0x3b, 0xfb, 0x06, 0x54, 0x0f, 0xc2, 0xb0, 0x1c, 0x77,
-	0x9b, 0x10, 0x8d, 0xde, 0xd8, 0x9a, 0x49, 0x0e, 0x1f, 0x4f, 0x85, 0x85, 0x53, 0xa5, 0xc2, 0xe2,
-	0xb4, 0xa0, 0xa0, 0x7e, 0x02, 0x95, 0x08, 0x63, 0x06, 0x67, 0xfc, 0x82, 0x41, 0xd8, 0x22, 0x18,
-	0xd2, 0x2f, 0xfb, 0x11, 0x23, 0x94, 0x65, 0x2a, 0xa7, 0x85, 0xfd, 0xc5, 0x2d, 0xec, 0x6b, 0xb8,
-	0xeb, 0x1e, 0x61, 0xef, 0xf8, 0xf4, 0x77, 0x37, 0xf7, 0x22, 0x67, 0x9c, 0xb1, 0xcf, 0x90, 0x0b,
-	0xd0, 0xbd, 0x50, 0xcf, 0x7c, 0x5a, 0xeb, 0x1a, 0x85, 0x76, 0x71, 0x42, 0xe1, 0x56, 0x7e, 0xc5,
-	0x6f, 0xa1, 0xe2, 0x5b, 0x39, 0x69, 0xf6, 0x7c, 0x29, 0xe5, 0xab, 0xfa, 0x1b, 0x05, 0xfe, 0x6f,
-	0x0b, 0xfb, 0x0f, 0xe3, 0x9d, 0xdd, 0x59, 0x6b, 0x65, 0x43, 0x33, 0x4d, 0xa9, 0xd3, 0x9c, 0x7a,
-	0x13, 0x4a, 0x24, 0x68, 0x77, 0xf9, 0xfd, 0xa0, 0x1c, 0xab, 0x3f, 0x51, 0xa0, 0x21, 0xa4, 0x30,
-	0x99, 0xb4, 0x32, 0xb3, 0xb0, 0x8f, 0x8d, 0xd7, 0xdd, 0x7f, 0xfd, 0x4e, 0x81, 0x7a, 0x14, 0x04,
-	0x19, 0x8e, 0xbd, 0x0f, 0x45, 0xd6, 0xe6, 0x0a, 0x0d, 0x26, 0x3a, 0x2b, 0xa7, 0xa6, 0x11, 0xc5,
-	0x8a, 0x89, 0x3d, 0x12, 0x80, 0x9c, 0x18, 0x86, 0x48, 0x9c, 0x9f, 0x1a, 0x89, 0xd5, 0x5f, 0xe4,
-	0xa0, 0x11, 0x16, 0xae, 0xaf, 0x1d, 0xec, 0x46, 0x54, 0x3d, 0xf9, 0x97, 0x54, 0xf5, 0x14, 0xa6,
-	0x06, 0xb8, 0x7f, 0xe4, 0x68, 0xc7, 0x1c, 0xd8, 0x63, 0xd7, 0xd2, 0x1d, 0xb4, 0x08, 0x33, 0x7d,
-	0x4b, 0x0f, 0xaf, 0x8d, 0xc4, 0x08, 0xb5, 0xa1, 0x46, 0x62, 0xf6, 0x12, 0x16, 0x78, 0x37, 0xcd,
-	0xfe, 0x23, 0x4c, 0xac, 0x25, 0x58, 0xa0, 0x2b, 0x00, 0xbc, 0xe4, 0x64, 0x8d, 0x9d, 0x48, 0xcd,
-	0xfc, 0xa0, 0x69, 0x4f, 0x77, 0x0b, 0x10, 0xfd, 0xe0, 0x0e, 0xfc, 0x8e, 0xe9, 0x74, 0x08, 0xee,
-	0xba, 0x8e, 0x41, 0x58, 0xbd, 0x51, 0xd4, 0xea, 0xe2, 0x4b, 0xcb, 0x69, 0xf3, 0x79, 0xf4, 0x3e,
-	0x14, 0xfc, 0xe3, 0x3e, 0xaf, 0x34, 0x6a, 0xa9, 0xc8, 0x16, 0xea, 0xb5, 0x77, 0xdc, 0xc7, 0x1a,
-	0x23, 0xa7, 0x3d, 0x3d, 0x65, 0xe5, 0x7b, 0xfa, 0x11, 0xb6, 0x82, 0x07, 0xaf, 0x70, 0x86, 0x7a,
-	0x62, 0xd0, 0x1b, 0xcf, 0xf2, 0x44, 0x2c, 0x86, 0xea, 0xdf, 0x73, 0x50, 0x0f, 0x59, 0x6a, 0x98,
-	0x0c, 0x2c, 0x7f, 0xa4, 0xfd, 0xc6, 0xb7, 0x0b, 0x93, 0xd2, 0xe0, 0xc7, 0x50, 0x11, 0x7d, 0xfa,
-	0x14, 0x89, 0x10, 0xf8, 0x92, 0xed, 0x31, 0xae, 0x57, 0x7c, 0x49, 0xae, 0x37, 0x33, 0xb5, 0xeb,
-	0xb5, 0x61, 0x31, 0x00, 0xad, 0x50, 0xd2, 0x0e, 0xf6, 0xf5, 0x31, 0x69, 0xf6, 0x2a, 0x54, 0x78,
-	0x32, 0xe2, 0x85, 0x27, 0x2f, 0xf5, 0x60, 0x5f, 0x36, 0x41, 0xea, 0x0f, 0xe1, 0x02, 0x0b, 0xfa,
-	0xe4, 0x7d, 0x5e, 0x96, 0x1b, 0x51, 0x55, 0x16, 0x92, 0xb4, 0x68, 0x0c, 0x12, 0x79, 0x6c, 0x4e,
-	0xdd, 0x86, 0x37, 0x12, 0xfc, 0x4f, 0x01, 0xea, 0x37, 0xef, 0xc0, 0xc2, 0x10, 0x52, 0xa1, 0x1a,
-	0xc0, 0xa7, 0x4e, 0x57, 0x40, 0x78, 0xfd, 0x1c, 0xaa, 0x42, 0x29, 0x00, 0xf4, 0xba, 0x72, 0xb3,
-	0x1d, 0x8d, 0x57, 0xea, 0xc4, 0xe8, 0x22, 0x9c, 0xff, 0xd4, 0x31, 0xf0, 0x81, 0xe9, 0x60, 0x23,
-	0xfc, 0x54, 0x3f, 0x87, 0xce, 0xc3, 0x7c, 0xcb, 0x71, 0xb0, 0x17, 0x99, 0x54, 0xe8, 0xe4, 0x0e,
-	0xf6, 0x7a, 0x38, 0x32, 0x99, 0x5b, 0xff, 0xcb, 0x02, 0x94, 0x69, 0xed, 0x79, 0xdf, 0x75, 0x3d,
-	0x03, 0xf5, 0x01, 0xb1, 0xe7, 0x03, 0xbb, 0xef, 0x3a, 0xf2, 0x9d, 0x0d, 0xdd, 0x1e, 0xd1, 0xc6,
-	0x0c, 0x93, 0x0a, 0x9b, 0x37, 0xaf, 0x8f, 0x58, 0x91, 0x20, 0x57, 0xcf, 0x21, 0x9b, 0x49, 0xa4,
-	0x11, 0xbf, 0x67, 0x76, 0x9f, 0x04, 0x77, 0x4e, 0x63, 0x24, 0x26, 0x48, 0x03, 0x89, 0x89, 0xe7,
-	0x3b, 0x31, 0xe0, 0x6f, 0x3c, 0xc1, 0x49, 0xa9, 0xe7, 0xd0, 0x53, 0xb8, 0xb0, 0x85, 0xfd, 0xf0,
-	0x5a, 0x3f, 0x10, 0xb8, 0x3e, 0x5a, 0xe0, 0x10, 0xf1, 0x94, 0x22, 0xb7, 0xa1, 0xc8, 0x52, 0x33,
-	0x4a, 0x8b, 0x90, 0xe8, 0x9f, 0x4d, 0x9a, 0xcb, 0xa3, 0x09, 0x24, 0xb7, 0x1f, 0xc1, 0x7c, 0xe2,
-	0x31, 0x1d, 0xbd, 0x93, 0xb2, 0x2c, 0xfd, 0x6f, 0x11, 0xcd, 0x9b, 0x59, 0x48, 0xa5, 0xac, 0x1e,
-	0xd4, 0xe2, 0x8f, 0x0f, 0x68, 0x25, 0x65, 0x7d, 0xea, 0x43, 0x68, 0xf3, 0x9d, 0x0c, 0x94, 0x52,
-	0x90, 0x0d, 0xf5, 0xe4, 0xe3, 0x2e, 0xba, 0x39, 0x96, 0x41, 0xdc, 0xdd, 0xde, 0xcd, 0x44, 0x2b,
-	0xc5, 0x1d, 0x33, 0x27, 0x18, 0x7a, 0x5c, 0x44, 0xab, 0xe9, 0x6c, 0x46, 0xbd, 0x7a, 0x36, 0xd7,
-	0x32, 0xd3, 0x4b, 0xd1, 0x5f, 0xf1, 0x96, 0x20, 0xed, 0x81, 0x0e, 0xdd, 0x49, 0x67, 0x37, 0xe6,
-	0x65, 0xb1, 0xb9, 0x3e, 0xcd, 0x12, 0xa9, 0xc4, 0x97, 0xac, 0x96, 0x4f, 0x79, 0xe4, 0x4a, 0xc6,
-	0x5d, 0xc0, 0x6f, 0xf4, 0xeb, 0x5d, 0xf3, 0xce, 0x14, 0x2b, 0xa4, 0x02, 0x6e, 0xf2, 0xf9, 0x3c,
-	0x08, 0xc3, 0xb5, 0x89, 0x5e, 0x73, 0xb2, 0x18, 0xfc, 0x1c, 0xe6, 0x13, 0x77, 0x7f, 0xa9, 0x51,
-	0x93, 0x7e, 0x3f, 0xd8, 0x1c, 0x07, 0xe8, 0x3c, 0x24, 0x13, 0xad, 0x11, 0x1a, 0xe1, 0xfd, 0x29,
-	0xed, 0x53, 0xf3, 0x66, 0x16, 0x52, 0xb9, 0x11, 0xc2, 0xe0, 0x32, 0xd1, 0x5e, 0xa0, 0x5b, 0xe9,
-	0x3c, 0xd2, 0x5b, 0xa3, 0xe6, 0x7b, 0x19, 0xa9, 0xa5, 0xd0, 0x0e, 0xc0, 0x16, 0xf6, 0x77, 0xb0,
-	0xef, 0x51, 0x1f, 0xb9, 0x9e, 0x6a, 0xf2, 0x90, 0x20, 0x10, 0x73, 0x63, 0x22, 0x9d, 0x14, 0xf0,
-	0x7d, 0x40, 0x41, 0x9e, 0x8b, 0x5c, 0x2d, 0xbf, 0x39, 0xb6, 0x8a, 0xe3, 0x25, 0xd7, 0xa4, 0xb3,
-	0x79, 0x0a, 0xf5, 0x1d, 0xdd, 0x19, 0xe8, 0x56, 0x84, 0xef, 0xad, 0x54, 0xc5, 0x92, 0x64, 0x23,
-	0xac, 0x35, 0x92, 0x5a, 0x6e, 0xe6, 0x99, 0xcc, 0xa1, 0xba, 0x0c, 0x41, 0x9c, 0xc4, 0x96, 0xd0,
-	0x1a, 0x09, 0xc2, 0x11, 0xd8, 0x32, 0x86, 0x5e, 0x0a, 0x7e, 0xa1, 0xb0, 0x3f, 0x69, 0x24, 0x08,
-	0x1e, 0x9b, 0xfe, 0x21, 0x2d, 0xee, 0x49, 0x16, 0x15, 0x18, 0xe1, 0x14, 0x2a, 0x08, 0x7a, 0xa9,
-	0x82, 0x01, 0x73, 0xb1, 0x1a, 0x09, 0xa5, 0x5d, 0x1f, 0xa7, 0x55, 0x69, 0xcd, 0x95, 0xc9, 0x84,
-	0x81, 0x94, 0xf5, 0xff, 0x16, 0xa0, 0x14, 0xdc, 0x97, 0x9d, 0x41, 0xc9, 0x72, 0x06, 0x35, 0xc4,
-	0xe7, 0x30, 0x9f, 0x78, 0xad, 0x4e, 0x85, 0x98, 0xf4, 0x17, 0xed, 0x49, 0x31, 0xf2, 0x58, 0xfc,
-	0xf1, 0x54, 0xc2, 0xc9, 0x8d, 0x51, 0x75, 0x48, 0x12, 0x49, 0x26, 0x30, 0x7e, 0xe5, 0xb8, 0xf1,
-	0x08, 0x20, 0x12, 0xd7, 0xe3, 0xbb, 0x3e, 0xea, 0xaa, 0x13, 0x14, 0xde, 0xb8, 0xfb, 0x83, 0x3b,
-	0x3d, 0xd3, 0x3f, 0x1c, 0xec, 0xd3, 0x2f, 0x6b, 0x9c, 0xf4, 0x3d, 0xd3, 0x15, 0xbf, 0xd6, 0x82,
-	0x13, 0x5d, 0x63, 0xab, 0xd7, 0xa8, 0x80, 0xfe, 0xfe, 0xfe, 0x0c, 0x1b, 0xdd, 0xfd, 0x5f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x15, 0x42, 0x87, 0xcd, 0x9a, 0x2c, 0x00, 0x00,
+	// 2593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0xf2, 0x22, 0x91, 0x87, 0x14, 0x45, 0x8d, 0x15, 0x99, 0x7f, 0xda, 0x96, 0xe5, 0x4d,
+	0x62, 0x2b, 0x8e, 0x23, 0xd9, 0xf2, 0x3f, 0x68, 0x50, 0x27, 0x0d, 0x22, 0xcb, 0x56, 0x89, 0x4a,
+	0xae, 0xba, 0x54, 0xe2, 0xa2, 0x01, 0x4a, 0xac, 0xb8, 0x23, 0x6a, 0xeb, 0xbd, 0xd0, 0x3b, 0x4b,
+	0xd9, 0xca, 0x4b, 0x8c, 0x14, 0x28, 0xd0, 0xa2, 0x6d, 0x5a, 0xf4, 0xb5, 0x40, 0x8b, 0x3e, 0x15,
+	0xe8, 0x4b, 0x5b, 0xa0, 0x2f, 0xfd, 0x04, 0x45, 0xfb, 0xd2, 0xa7, 0x7e, 0x80, 0x7e, 0x92, 0x62,
+	0x2e, 0x3b, 0x7b, 0xe1, 0x92, 0x5c, 0x4a, 0xbe, 0xbc, 0x71, 0x66, 0xcf, 0x6d, 0xce, 0x9c, 0xf3,
+	0x9b, 0x73, 0x66, 0x08, 0x75, 0x43, 0xf7, 0xf5, 0x4e, 0xd7, 0x75, 0x3d, 0x63, 0xad, 0xef, 0xb9,
+	0xbe, 0x8b, 0x16, 0x6c, 0xd3, 0x3a, 0x1e, 0x10, 0x3e, 0x5a, 0xa3, 0x9f, 0x9b, 0xd5, 0xae, 0x6b,
+	0xdb, 0xae, 0xc3, 0xa7, 0x9a, 0x35, 0xd3, 0xf1, 0xb1, 0xe7, 0xe8, 0x96, 0x18, 0x57, 0xa3, 0x0c,
+	0xcd, 0x2a, 0xe9, 0x1e, 0x61, 0x5b, 0xe7, 0x23, 0xf5, 0x19, 0x54, 0x1f, 0x58, 0x03, 0x72, 0xa4,
+	0xe1, 0x27, 0x03, 0x4c, 0x7c, 0x74, 0x0b, 0x0a, 0x07, 0x3a, 0xc1, 0x0d, 0x65, 0x45, 0x59, 0xad,
+	0x6c, 0x5c, 0x5a, 0x8b, 0xe9, 0x12, 0x5a, 0x76, 0x49, 0x6f, 0x53, 0x27, 0x58, 0x63, 0x94, 0x08,
+	0x41, 0xc1, 0x38, 0x68, 0x6d, 0x35, 0x72, 0x2b, 0xca, 0x6a, 0x5e, 0x63, 0xbf, 0x91, 0x0a, 0xd5,
+	0xae, 0x6b, 0x59, 0xb8, 0xeb, 0x9b, 0xae, 0xd3, 0xda, 0x6a, 0x14, 0xd8, 0xb7, 0xd8, 0x9c, 0xfa,
+	0x5b, 0x05, 0xe6, 0x84, 0x6a, 0xd2, 0x77, 0x1d, 0x82, 0xd1, 0x1d, 0x98, 0x21, 0xbe, 0xee, 0x0f,
+	0x88, 0xd0, 0x7e, 0x31, 0x55, 0x7b, 0x9b, 0x91, 0x68, 0x82, 0x34, 0x93, 0xfa, 0xfc, 0xb0, 0x7a,
+	0xb4, 0x0c, 0x40, 0x70, 0xcf, 0xc6, 0x8e, 0xdf, 0xda, 0x22, 0x8d, 0xc2, 0x4a, 0x7e, 0x35, 0xaf,
+	0x45, 0x66, 0xd4, 0x5f, 0x2b, 0x50, 0x6f, 0x07, 0xc3, 0xc0, 0x3b, 0x8b, 0x50, 0xec, 0xba, 0x03,
+	0xc7, 0x67, 0x06, 0xce, 0x69, 0x7c, 0x80, 0xae, 0x42, 0xb5, 0x7b, 0xa4, 0x3b, 0x0e, 0xb6, 0x3a,
+	0x8e, 0x6e, 0x63, 0x66, 0x4a, 0x59, 0xab, 0x88, 0xb9, 0x87, 0xba, 0x8d, 0x33, 0x59, 0xb4, 0x02,
+	0x95, 0xbe, 0xee, 0xf9, 0x66, 0xcc, 0x67, 0xd1, 0x29, 0xf5, 0xf7, 0x0a, 0x2c, 0x7d, 0x42, 0x88,
+	0xd9, 0x73, 0x86, 0x2c, 0x5b, 0x82, 0x19, 0xc7, 0x35, 0x70, 0x6b, 0x8b, 0x99, 0x96, 0xd7, 0xc4,
+	0x08, 0x5d, 0x84, 0x72, 0x1f, 0x63, 0xaf, 0xe3, 0xb9, 0x56, 0x60, 0x58, 0x89, 0x4e, 0x68, 0xae,
+	0x85, 0xd1, 0xf7, 0x60, 0x81, 0x24, 0x04, 0x91, 0x46, 0x7e, 0x25, 0xbf, 0x5a, 0xd9, 0x78, 0x73,
+	0x6d, 0x28, 0xca, 0xd6, 0x92, 0x4a, 0xb5, 0x61, 0x6e, 0xf5, 0x79, 0x0e, 0xce, 0x4b, 0x3a, 0x6e,
+	0x2b, 0xfd, 0x4d, 0x3d, 0x47, 0x70, 0x4f, 0x9a, 0xc7, 0x07, 0x59, 0x3c, 0x27, 0x5d, 0x9e, 0x8f,
+	0xba, 0x3c, 0x43, 0x80, 0x25, 0xfd, 0x59, 0x1c, 0xf2, 0x27, 0xba, 0x02, 0x15, 0xfc, 0xac, 0x6f,
+	0x7a, 0xb8, 0xe3, 0x9b, 0x36, 0x6e, 0xcc, 0xac, 0x28, 0xab, 0x05, 0x0d, 0xf8, 0xd4, 0xbe, 0x69,
+	0x47, 0x23, 0x72, 0x36, 0x73, 0x44, 0xaa, 0x7f, 0x50, 0xe0, 0xc2, 0xd0, 0x2e, 0x89, 0x10, 0xd7,
+	0xa0, 0xce, 0x56, 0x1e, 0x7a, 0x86, 0x06, 0x3b, 0x75, 0xf8, 0xb5, 0x71, 0x0e, 0x0f, 0xc9, 0xb5,
+	0x21, 0xfe, 0x88, 0x91, 0xb9, 0xec, 0x46, 0x3e, 0x86, 0x0b, 0xdb, 0xd8, 0x17, 0x0a, 0xe8, 0x37,
+	0x4c, 0x4e, 0x0f, 0x01, 0xf1, 0x5c, 0xca, 0x0d, 0xe5, 0xd2, 0x9f, 0x73, 0x50, 0x8f, 0xaa, 0x6a,
+	0x39, 0x87, 0x2e, 0xba, 0x04, 0x65, 0x49, 0x22, 0xa2, 0x22, 0x9c, 0x40, 0xdf, 0x80, 0x22, 0xb5,
+	0x94, 0x87, 0x44, 0x6d, 0xe3, 0x6a, 0xfa, 0x9a, 0x22, 0x32, 0x35, 0x4e, 0x8f, 0x5a, 0x50, 0x23,
+	0xbe, 0xee, 0xf9, 0x9d, 0xbe, 0x4b, 0xd8, 0x3e, 0xb3, 0xc0, 0xa9, 0x6c, 0xa8, 0x71, 0x09, 0x12,
+	0x22, 0x77, 0x49, 0x6f, 0x4f, 0x50, 0x6a, 0x73, 0x8c, 0x33, 0x18, 0xa2, 0xfb, 0x50, 0xc5, 0x8e,
+	0x11, 0x0a, 0x2a, 0x64, 0x16, 0x54, 0xc1, 0x8e, 0x21, 0xc5, 0x84, 0xfb, 0x53, 0xcc, 0xbe, 0x3f,
+	0x3f, 0x57, 0xa0, 0x31, 0xbc, 0x41, 0x67, 0x01, 0xca, 0xbb, 0x9c, 0x09, 0xf3, 0x0d, 0x1a, 0x9b,
+	0xe1, 0x72, 0x93, 0x34, 0xc1, 0xa2, 0x9a, 0xf0, 0x46, 0x68, 0x0d, 0xfb, 0xf2, 0xd2, 0x82, 0xe5,
+	0xc7, 0x0a, 0x2c, 0x25, 0x75, 0x9d, 0x65, 0xdd, 0xff, 0x0f, 0x45, 0xd3, 0x39, 0x74, 0x83, 0x65,
+	0x2f, 0x8f, 0xc9, 0x33, 0xaa, 0x8b, 0x13, 0xab, 0x36, 0x5c, 0xdc, 0xc6, 0x7e, 0xcb, 0x21, 0xd8,
+	0xf3, 0x37, 0x4d, 0xc7, 0x72, 0x7b, 0x7b, 0xba, 0x7f, 0x74, 0x86, 0x1c, 0x89, 0x85, 0x7b, 0x2e,
+	0x11, 0xee, 0xea, 0x1f, 0x15, 0xb8, 0x94, 0xae, 0x4f, 0x2c, 0xbd, 0x09, 0xa5, 0x43, 0x13, 0x5b,
+	0x46, 0x6b, 0x8b, 0x03, 0x46, 0x5e, 0x93, 0x63, 0x9a, 0x2b, 0x7d, 0x4a, 0x2c, 0x56, 0x78, 0x75,
+	0x44, 0x80, 0xb6, 0x7d, 0xcf, 0x74, 0x7a, 0x3b, 0x26, 0xf1, 0x35, 0x4e, 0x1f, 0xf1, 0x67, 0x3e,
+	0x7b, 0x64, 0xfe, 0x4c, 0x81, 0xe5, 0x6d, 0xec, 0xdf, 0x93, 0x50, 0x4b, 0xbf, 0x9b, 0xc4, 0x37,
+	0xbb, 0xe4, 0xe5, 0x16, 0x11, 0x29, 0x67, 0xa6, 0xfa, 0xb5, 0x02, 0x57, 0x46, 0x1a, 0x23, 0x5c,
+	0x27, 0xa0, 0x24, 0x00, 0xda, 0x74, 0x28, 0xf9, 0x0e, 0x3e, 0xf9, 0x4c, 0xb7, 0x06, 0x78, 0x4f,
+	0x37, 0x3d, 0x0e, 0x25, 0xa7, 0x04, 0xd6, 0x3f, 0x29, 0x70, 0x79, 0x1b, 0xfb, 0x7b, 0xc1, 0x31,
+	0xf3, 0x1a, 0xbd, 0x93, 0xa1, 0xa2, 0xf8, 0x25, 0xdf, 0xcc, 0x54, 0x6b, 0x5f, 0x8b, 0xfb, 0x96,
+	0x59, 0x1e, 0x44, 0x12, 0xf2, 0x1e, 0xaf, 0x05, 0x84, 0xf3, 0xd4, 0xbf, 0xe5, 0xa0, 0xfa, 0x99,
+	0xa8, 0x0f, 0xe8, 0xe7, 0x21, 0x3f, 0x28, 0xe9, 0x7e, 0x88, 0x94, 0x14, 0x69, 0x55, 0xc6, 0x36,
+	0xcc, 0x11, 0x8c, 0x1f, 0x9f, 0xe6, 0xd0, 0xa8, 0x52, 0xc6, 0x60, 0x84, 0x76, 0x60, 0x61, 0xe0,
+	0x1c, 0xd2, 0xb2, 0x16, 0x1b, 0x62, 0x15, 0xbc, 0xba, 0x9c, 0x8c, 0x3c, 0xc3, 0x8c, 0xe8, 0xdb,
+	0x30, 0x9f, 0x94, 0x55, 0xcc, 0x24, 0x2b, 0xc9, 0xa6, 0xfe, 0x54, 0x81, 0xa5, 0x47, 0xba, 0xdf,
+	0x3d, 0xda, 0xb2, 0x85, 0x47, 0xcf, 0x10, 0x8f, 0x1f, 0x41, 0xf9, 0x58, 0x78, 0x2f, 0x00, 0x9d,
+	0x2b, 0x29, 0x06, 0x45, 0xf7, 0x49, 0x0b, 0x39, 0xd4, 0x7f, 0x28, 0xb0, 0xc8, 0x2a, 0xff, 0xc0,
+	0xba, 0x57, 0x9f, 0x19, 0x13, 0xaa, 0x7f, 0x74, 0x0d, 0x6a, 0xb6, 0xee, 0x3d, 0x6e, 0x87, 0x34,
+	0x45, 0x46, 0x93, 0x98, 0x55, 0x9f, 0x01, 0x88, 0xd1, 0x2e, 0xe9, 0x9d, 0xc2, 0xfe, 0x0f, 0x60,
+	0x56, 0x68, 0x15, 0x49, 0x32, 0x69, 0x63, 0x03, 0x72, 0xf5, 0x9f, 0x0a, 0xd4, 0x42, 0xd8, 0x63,
+	0xa9, 0x50, 0x83, 0x9c, 0x4c, 0x80, 0x5c, 0x6b, 0x0b, 0x7d, 0x04, 0x33, 0xbc, 0xd7, 0x13, 0xb2,
+	0xdf, 0x8e, 0xcb, 0xe6, 0xdf, 0xd6, 0x22, 0xd8, 0xc9, 0x26, 0x34, 0xc1, 0x44, 0x7d, 0x24, 0xa1,
+	0x82, 0xb7, 0x05, 0x79, 0x2d, 0x32, 0x83, 0x5a, 0x30, 0x1f, 0xaf, 0xb4, 0x82, 0x40, 0x5f, 0x19,
+	0x05, 0x11, 0x5b, 0xba, 0xaf, 0x33, 0x84, 0xa8, 0xc5, 0x0a, 0x2d, 0xa2, 0xfe, 0xbb, 0x08, 0x95,
+	0xc8, 0x2a, 0x87, 0x56, 0x92, 0xdc, 0xd2, 0xdc, 0x64, 0xb0, 0xcb, 0x0f, 0x97, 0xfb, 0x6f, 0x43,
+	0xcd, 0x64, 0x07, 0x6c, 0x47, 0x84, 0x22, 0x43, 0xc4, 0xb2, 0x36, 0xc7, 0x67, 0x45, 0x5e, 0xa0,
+	0x65, 0xa8, 0x38, 0x03, 0xbb, 0xe3, 0x1e, 0x76, 0x3c, 0xf7, 0x29, 0x11, 0x7d, 0x43, 0xd9, 0x19,
+	0xd8, 0xdf, 0x3d, 0xd4, 0xdc, 0xa7, 0x24, 0x2c, 0x4d, 0x67, 0xa6, 0x2c, 0x4d, 0x97, 0xa1, 0x62,
+	0xeb, 0xcf, 0xa8, 0xd4, 0x8e, 0x33, 0xb0, 0x59, 0x4b, 0x91, 0xd7, 0xca, 0xb6, 0xfe, 0x4c, 0x73,
+	0x9f, 0x3e, 0x1c, 0xd8, 0x68, 0x15, 0xea, 0x96, 0x4e, 0xfc, 0x4e, 0xb4, 0x27, 0x29, 0xb1, 0x9e,
+	0xa4, 0x46, 0xe7, 0xef, 0x87, 0x7d, 0xc9, 0x70, 0x91, 0x5b, 0x3e, 0x43, 0x91, 0x6b, 0xd8, 0x56,
+	0x28, 0x08, 0xb2, 0x17, 0xb9, 0x86, 0x6d, 0x49, 0x31, 0x1f, 0xc0, 0xec, 0x01, 0x2b, 0x5b, 0x48,
+	0xa3, 0x32, 0x12, 0xa1, 0x1e, 0xd0, 0x8a, 0x85, 0x57, 0x37, 0x5a, 0x40, 0x8e, 0x3e, 0x84, 0x32,
+	0x3b, 0x2f, 0x18, 0x6f, 0x35, 0x13, 0x6f, 0xc8, 0x40, 0xa1, 0xc8, 0xc0, 0x96, 0xaf, 0x33, 0xee,
+	0xb9, 0x91, 0x50, 0xb4, 0x45, 0x69, 0x76, 0xdc, 0x1e, 0x87, 0x22, 0xc9, 0x81, 0x6e, 0xc1, 0xf9,
+	0xae, 0x87, 0x75, 0x1f, 0x1b, 0x9b, 0x27, 0xf7, 0x5c, 0xbb, 0xaf, 0xb3, 0x68, 0x6a, 0xd4, 0x56,
+	0x94, 0xd5, 0x92, 0x96, 0xf6, 0x89, 0x22, 0x43, 0x57, 0x8e, 0x1e, 0x78, 0xae, 0xdd, 0x98, 0xe7,
+	0xc8, 0x10, 0x9f, 0x55, 0xbf, 0x84, 0xc5, 0x30, 0x06, 0x22, 0xfe, 0x1e, 0xde, 0x3a, 0xe5, 0xb4,
+	0x5b, 0x37, 0xbe, 0xa4, 0xfc, 0x6b, 0x01, 0x96, 0xda, 0xfa, 0x31, 0x7e, 0xf9, 0xd5, 0x6b, 0x26,
+	0xc4, 0xdd, 0x81, 0x05, 0x56, 0xb0, 0x6e, 0x44, 0xec, 0x69, 0x14, 0x32, 0x6d, 0xf7, 0x30, 0x23,
+	0xfa, 0x98, 0x9e, 0xe8, 0xb8, 0xfb, 0x78, 0xcf, 0x35, 0xc3, 0x43, 0xf1, 0x72, 0x8a, 0x9c, 0x7b,
+	0x92, 0x4a, 0x8b, 0x72, 0xa0, 0xbd, 0x61, 0xf0, 0x9a, 0x61, 0x42, 0xae, 0x8f, 0x6d, 0x8b, 0x42,
+	0xef, 0x27, 0x31, 0x0c, 0x35, 0x60, 0x56, 0x1c, 0xba, 0x2c, 0xb3, 0x4b, 0x5a, 0x30, 0x44, 0x7b,
+	0x70, 0x9e, 0xaf, 0xa0, 0x2d, 0xc2, 0x96, 0x2f, 0xbe, 0x94, 0x69, 0xf1, 0x69, 0xac, 0xf1, 0xa8,
+	0x2f, 0x4f, 0x1d, 0xf5, 0x0d, 0x98, 0x35, 0x3c, 0xb7, 0xdf, 0xc7, 0x06, 0x4b, 0xf7, 0x92, 0x16,
+	0x0c, 0x69, 0x71, 0x0f, 0xa1, 0xcb, 0x26, 0xf4, 0xe8, 0xdf, 0x82, 0x92, 0x0c, 0xe2, 0x5c, 0xe6,
+	0x20, 0x96, 0x3c, 0x49, 0xa0, 0xcd, 0x27, 0x80, 0x56, 0xfd, 0x97, 0x02, 0xd5, 0xe8, 0x12, 0x28,
+	0x80, 0x7b, 0xb8, 0xeb, 0x7a, 0x46, 0x07, 0x3b, 0xbe, 0x67, 0x62, 0xde, 0x07, 0x16, 0xb4, 0x39,
+	0x3e, 0x7b, 0x9f, 0x4f, 0x52, 0x32, 0x8a, 0x9d, 0xc4, 0xd7, 0xed, 0x7e, 0xe7, 0x90, 0xa6, 0x68,
+	0x8e, 0x93, 0xc9, 0x59, 0x9a, 0xa1, 0xf4, 0xf2, 0x29, 0x24, 0xf3, 0x5d, 0xa6, 0xbf, 0xa0, 0x55,
+	0xe4, 0xdc, 0xbe, 0x8b, 0xde, 0x82, 0x1a, 0xf3, 0x5a, 0xc7, 0x72, 0x7b, 0x1d, 0xda, 0x33, 0x89,
+	0x13, 0xa3, 0x6a, 0x08, 0xb3, 0xe8, 0x76, 0xc4, 0xa9, 0x88, 0xf9, 0x05, 0x16, 0x67, 0x86, 0xa4,
+	0x6a, 0x9b, 0x5f, 0x60, 0xf5, 0x2b, 0x05, 0xe6, 0xe8, 0x01, 0xf8, 0xd0, 0x35, 0xf0, 0xfe, 0x29,
+	0xcb, 0x85, 0x0c, 0xf7, 0x65, 0x97, 0xa0, 0x2c, 0x57, 0x20, 0x96, 0x14, 0x4e, 0xd0, 0xe6, 0x7a,
+	0x4e, 0x9c, 0x73, 0x6d, 0x79, 0x7f, 0xca, 0x44, 0x29, 0x4c, 0x14, 0xfb, 0x8d, 0xbe, 0x19, 0xbf,
+	0x7c, 0x79, 0x2b, 0x35, 0xaf, 0x98, 0x10, 0x56, 0x52, 0xc6, 0x0e, 0xb9, 0x2c, 0x5d, 0xdb, 0x73,
+	0xba, 0xb1, 0xc2, 0x15, 0x6c, 0x63, 0x1b, 0x30, 0xab, 0x1b, 0x86, 0x87, 0x09, 0x11, 0x76, 0x04,
+	0x43, 0xfa, 0xe5, 0x18, 0x7b, 0x24, 0x08, 0xb1, 0xbc, 0x16, 0x0c, 0xd1, 0x87, 0x50, 0x92, 0x35,
+	0x68, 0x3e, 0xad, 0xee, 0x88, 0xda, 0x29, 0xba, 0x0c, 0xc9, 0xa1, 0x7e, 0x9d, 0x83, 0x9a, 0x48,
+	0xeb, 0x4d, 0x71, 0x10, 0x8d, 0x0f, 0xf6, 0x4d, 0xa8, 0x1e, 0x86, 0x69, 0x39, 0xee, 0x36, 0x21,
+	0x9a, 0xbd, 0x31, 0x9e, 0x49, 0x01, 0x1f, 0x3f, 0x0a, 0x0b, 0x67, 0x3a, 0x0a, 0x8b, 0xd3, 0x82,
+	0x82, 0xfa, 0x09, 0x54, 0x22, 0x82, 0x19, 0x9c, 0xf1, 0x0b, 0x06, 0xe1, 0x8b, 0x60, 0x48, 0xbf,
+	0x1c, 0x44, 0x9c, 0x50, 0x96, 0x47, 0x39, 0x2d, 0xec, 0xe9, 0xad, 0xa2, 0x86, 0xbb, 0xee, 0x31,
+	0xf6, 0x4e, 0xce, 0x7e, 0x77, 0x73, 0x37, 0xb2, 0xc7, 0x19, 0xfb, 0x0c, 0xc9, 0x80, 0xee, 0x86,
+	0x76, 0xe6, 0xd3, 0x5a, 0xd7, 0x28, 0xb4, 0x8b, 0x1d, 0x0a, 0x97, 0xf2, 0x2b, 0x7e, 0x0b, 0x15,
+	0x5f, 0xca, 0x69, 0x4f, 0xcf, 0x17, 0x52, 0xbe, 0xaa, 0xbf, 0x51, 0xe0, 0xff, 0xb6, 0xb1, 0xff,
+	0x20, 0xde, 0xd9, 0xbd, 0x6e, 0xab, 0x6c, 0x68, 0xa6, 0x19, 0x75, 0x96, 0x5d, 0x6f, 0x42, 0x89,
+	0x04, 0xed, 0x2e, 0xbf, 0x1f, 0x94, 0x63, 0xf5, 0x27, 0x0a, 0x34, 0x84, 0x16, 0xa6, 0x93, 0x56,
+	0x66, 0x16, 0xf6, 0xb1, 0xf1, 0xaa, 0xfb, 0xaf, 0xdf, 0x29, 0x50, 0x8f, 0x82, 0x20, 0xfd, 0x8a,
+	0xde, 0x87, 0x22, 0x6b, 0x73, 0x85, 0x05, 0x13, 0x83, 0x95, 0x53, 0xd3, 0x8c, 0x62, 0xc5, 0xc4,
+	0x3e, 0x09, 0x40, 0x4e, 0x0c, 0x43, 0x24, 0xce, 0x4f, 0x8d, 0xc4, 0xea, 0x2f, 0x72, 0xd0, 0x08,
+	0x0b, 0xd7, 0x57, 0x0e, 0x76, 0x23, 0xaa, 0x9e, 0xfc, 0x0b, 0xaa, 0x7a, 0x0a, 0x53, 0x03, 0xdc,
+	0x7f, 0x73, 0x50, 0x0b, 0xfd, 0xb1, 0x67, 0xe9, 0x0e, 0x7d, 0x35, 0xeb, 0x5b, 0x7a, 0x78, 0x6d,
+	0x24, 0x46, 0xa8, 0x0d, 0x35, 0x12, 0xf3, 0x97, 0xf0, 0xc0, 0xbb, 0x69, 0xfe, 0x1f, 0xe1, 0x62,
+	0x2d, 0x21, 0x02, 0x5d, 0x06, 0xe0, 0x25, 0x27, 0x6b, 0xec, 0xc4, 0xd1, 0xcc, 0x37, 0x9a, 0xf6,
+	0x74, 0x37, 0x01, 0xd1, 0x0f, 0xee, 0xc0, 0xef, 0x98, 0x4e, 0x87, 0xe0, 0xae, 0xeb, 0x18, 0x84,
+	0xd5, 0x1b, 0x45, 0xad, 0x2e, 0xbe, 0xb4, 0x9c, 0x36, 0x9f, 0x47, 0xef, 0x43, 0xc1, 0x3f, 0xe9,
+	0xf3, 0x4a, 0xa3, 0xb6, 0x71, 0x75, 0xac, 0x5d, 0xfb, 0x27, 0x7d, 0xac, 0x31, 0x72, 0xda, 0xd3,
+	0x53, 0x51, 0xbe, 0xa7, 0x1f, 0x63, 0x2b, 0x78, 0xf0, 0x0a, 0x67, 0x68, 0x24, 0x06, 0xbd, 0xf1,
+	0x2c, 0x3f, 0x88, 0xc5, 0x90, 0x16, 0x55, 0x21, 0x32, 0x74, 0x7c, 0xdf, 0x62, 0xad, 0x69, 0x5e,
+	0x9b, 0x0b, 0x67, 0xf7, 0x7d, 0x4b, 0xfd, 0x7b, 0x0e, 0xea, 0xa1, 0x66, 0x0d, 0x93, 0x81, 0xe5,
+	0x8f, 0x74, 0xf3, 0xf8, 0xae, 0x62, 0xd2, 0x69, 0xf9, 0x31, 0x54, 0x44, 0x3b, 0x3f, 0xc5, 0x79,
+	0x09, 0x9c, 0x65, 0x67, 0x4c, 0x84, 0x16, 0x5f, 0x50, 0x84, 0xce, 0x4c, 0x1d, 0xa1, 0x6d, 0x58,
+	0x0a, 0xb0, 0x2d, 0xd4, 0xb4, 0x8b, 0x7d, 0x7d, 0xcc, 0x69, 0x7c, 0x05, 0x2a, 0xfc, 0xcc, 0xe2,
+	0xf5, 0x29, 0xaf, 0x08, 0xe1, 0x40, 0xf6, 0x4a, 0xea, 0x0f, 0x61, 0x91, 0x61, 0x43, 0xf2, 0xda,
+	0x2f, 0xcb, 0xc5, 0xa9, 0x0a, 0xd5, 0x48, 0x6d, 0x19, 0x9c, 0xf7, 0xb1, 0x39, 0x75, 0x07, 0xde,
+	0x48, 0xc8, 0x3f, 0x03, 0xf6, 0xdf, 0xb8, 0x0d, 0x0b, 0x43, 0x80, 0x86, 0x6a, 0x00, 0x9f, 0x3a,
+	0x5d, 0x81, 0xf4, 0xf5, 0x73, 0xa8, 0x0a, 0xa5, 0x00, 0xf7, 0xeb, 0xca, 0x8d, 0x36, 0xd4, 0xe2,
+	0xb1, 0x8e, 0x2e, 0xc0, 0xf9, 0x4f, 0x1d, 0x03, 0x1f, 0x9a, 0x0e, 0x36, 0xc2, 0x4f, 0xf5, 0x73,
+	0xe8, 0x3c, 0xcc, 0xb7, 0x1c, 0x07, 0x7b, 0x91, 0x49, 0x85, 0x4e, 0xee, 0x62, 0xaf, 0x87, 0x23,
+	0x93, 0xb9, 0x8d, 0xbf, 0x2c, 0x40, 0x99, 0x96, 0xa8, 0xf7, 0xe8, 0x1f, 0x2f, 0x50, 0x1f, 0x10,
+	0x7b, 0x65, 0xb0, 0xfb, 0xae, 0x23, 0x9f, 0xe3, 0xd0, 0xad, 0x11, 0xdd, 0xce, 0x30, 0xa9, 0xf0,
+	0x79, 0xf3, 0xda, 0x08, 0x8e, 0x04, 0xb9, 0x7a, 0x0e, 0xd9, 0x4c, 0x23, 0x05, 0x86, 0x7d, 0xb3,
+	0xfb, 0x38, 0xb8, 0x9a, 0x1a, 0xa3, 0x31, 0x41, 0x1a, 0x68, 0x4c, 0xbc, 0xf2, 0x89, 0x01, 0x7f,
+	0x0a, 0x0a, 0x76, 0x4a, 0x3d, 0x87, 0x9e, 0xc0, 0x22, 0xbd, 0x76, 0x97, 0xb7, 0xff, 0x81, 0xc2,
+	0x8d, 0xd1, 0x0a, 0x87, 0x88, 0xa7, 0x54, 0xb9, 0x03, 0x45, 0x76, 0x82, 0xa3, 0xb4, 0x0c, 0x89,
+	0xfe, 0x27, 0xa5, 0xb9, 0x32, 0x9a, 0x40, 0x4a, 0xfb, 0x11, 0xcc, 0x27, 0xde, 0xdc, 0xd1, 0x3b,
+	0x29, 0x6c, 0xe9, 0xff, 0x9e, 0x68, 0xde, 0xc8, 0x42, 0x2a, 0x75, 0xf5, 0xa0, 0x16, 0x7f, 0xa3,
+	0x40, 0xab, 0x29, 0xfc, 0xa9, 0xef, 0xa5, 0xcd, 0x77, 0x32, 0x50, 0x4a, 0x45, 0x36, 0xd4, 0x93,
+	0x6f, 0xc0, 0xe8, 0xc6, 0x58, 0x01, 0xf1, 0x70, 0x7b, 0x37, 0x13, 0xad, 0x54, 0x77, 0x02, 0x8b,
+	0x69, 0x6f, 0x90, 0x68, 0x2d, 0x5d, 0xcc, 0xa8, 0xc7, 0xd1, 0xe6, 0x7a, 0x66, 0x7a, 0xa9, 0xfa,
+	0x2b, 0xde, 0x39, 0xa4, 0xbd, 0xe3, 0xa1, 0xdb, 0xe9, 0xe2, 0xc6, 0x3c, 0x40, 0x36, 0x37, 0xa6,
+	0x61, 0x91, 0x46, 0x7c, 0x09, 0x4b, 0xe9, 0x6f, 0x61, 0xe8, 0x56, 0xba, 0xbc, 0xd1, 0x8f, 0x7c,
+	0xcd, 0xdb, 0x53, 0x70, 0x48, 0x03, 0xdc, 0xe4, 0x2b, 0x7b, 0x90, 0x86, 0xeb, 0x13, 0xa3, 0xe6,
+	0x74, 0x39, 0xf8, 0x39, 0xcc, 0x27, 0xae, 0x08, 0x53, 0xb3, 0x26, 0xfd, 0x1a, 0xb1, 0x39, 0x0e,
+	0xd0, 0x79, 0x4a, 0x26, 0x3a, 0x28, 0x34, 0x22, 0xfa, 0x53, 0xba, 0xac, 0xe6, 0x8d, 0x2c, 0xa4,
+	0x72, 0x21, 0x84, 0xc1, 0x65, 0xa2, 0x0b, 0x41, 0x37, 0xd3, 0x65, 0xa4, 0x77, 0x50, 0xcd, 0xf7,
+	0x32, 0x52, 0x4b, 0xa5, 0x1d, 0x80, 0x6d, 0xec, 0xef, 0x62, 0xdf, 0xa3, 0x31, 0x72, 0x2d, 0xd5,
+	0xe5, 0x21, 0x41, 0xa0, 0xe6, 0xfa, 0x44, 0x3a, 0xa9, 0xe0, 0xfb, 0x80, 0x82, 0x73, 0x2e, 0x72,
+	0x03, 0xfd, 0xe6, 0xd8, 0x62, 0x8f, 0x97, 0x5c, 0x93, 0xf6, 0xe6, 0x09, 0xd4, 0x77, 0x75, 0x67,
+	0xa0, 0x5b, 0x11, 0xb9, 0x37, 0x53, 0x0d, 0x4b, 0x92, 0x8d, 0xf0, 0xd6, 0x48, 0x6a, 0xb9, 0x98,
+	0xa7, 0xf2, 0x0c, 0xd5, 0x65, 0x0a, 0x62, 0xb4, 0x96, 0x2a, 0x66, 0x98, 0x70, 0x04, 0xb6, 0x8c,
+	0xa1, 0x97, 0x8a, 0x9f, 0x2b, 0x70, 0x71, 0x98, 0xe0, 0x91, 0xe9, 0x1f, 0xd1, 0x1e, 0x80, 0x64,
+	0x31, 0x81, 0x11, 0x4e, 0x61, 0x82, 0xa0, 0x97, 0x26, 0x18, 0x30, 0x17, 0xab, 0x91, 0x50, 0xda,
+	0x2d, 0x73, 0x5a, 0x95, 0xd6, 0x5c, 0x9d, 0x4c, 0x18, 0x68, 0xd9, 0xf8, 0x4f, 0x01, 0x4a, 0xc1,
+	0xb5, 0xda, 0x6b, 0x28, 0x59, 0x5e, 0x43, 0x0d, 0xf1, 0x39, 0xcc, 0x27, 0x1e, 0xb5, 0x53, 0x21,
+	0x26, 0xfd, 0xe1, 0x7b, 0x52, 0x8e, 0x3c, 0x12, 0xff, 0x4f, 0x95, 0x70, 0x72, 0x7d, 0x54, 0x1d,
+	0x92, 0x44, 0x92, 0x09, 0x82, 0x5f, 0x3a, 0x6e, 0x3c, 0x04, 0x88, 0xe4, 0xf5, 0xf8, 0xe6, 0x90,
+	0x86, 0xea, 0x04, 0x83, 0x37, 0xef, 0xfc, 0xe0, 0x76, 0xcf, 0xf4, 0x8f, 0x06, 0x07, 0xf4, 0xcb,
+	0x3a, 0x27, 0x7d, 0xcf, 0x74, 0xc5, 0xaf, 0xf5, 0x60, 0x47, 0xd7, 0x19, 0xf7, 0x3a, 0x55, 0xd0,
+	0x3f, 0x38, 0x98, 0x61, 0xa3, 0x3b, 0xff, 0x1b, 0x00, 0x00, 0x1e, 0xca, 0x71, 0xc1, 0x2c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
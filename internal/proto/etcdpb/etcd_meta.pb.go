@@ -254,9 +254,13 @@ type CollectionInfo struct {
 	PartitionCreatedTimestamps []uint64                   `protobuf:"varint,9,rep,packed,name=partition_created_timestamps,json=partitionCreatedTimestamps,proto3" json:"partition_created_timestamps,omitempty"`
 	ShardsNum                  int32                      `protobuf:"varint,10,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
 	StartPositions             []*commonpb.KeyDataPair    `protobuf:"bytes,11,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                   `json:"-"`
-	XXX_unrecognized           []byte                     `json:"-"`
-	XXX_sizecache              int32                      `json:"-"`
+	DbId                       int64                      `protobuf:"varint,12,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
+	ConsistencyLevel           commonpb.ConsistencyLevel  `protobuf:"varint,13,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// increased every time the schema is altered, e.g. a field is added
+	SchemaVersion        int32    `protobuf:"varint,14,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
@@ -361,6 +365,82 @@ func (m *CollectionInfo) GetStartPositions() []*commonpb.KeyDataPair {
 	return nil
 }
 
+func (m *CollectionInfo) GetDbId() int64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+func (m *CollectionInfo) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *CollectionInfo) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+type DatabaseInfo struct {
+	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedTime          uint64   `protobuf:"varint,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseInfo) Reset()         { *m = DatabaseInfo{} }
+func (m *DatabaseInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseInfo) ProtoMessage()    {}
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DatabaseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseInfo.Unmarshal(m, b)
+}
+func (m *DatabaseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseInfo.Merge(m, src)
+}
+func (m *DatabaseInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseInfo.Size(m)
+}
+func (m *DatabaseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseInfo proto.InternalMessageInfo
+
+func (m *DatabaseInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *DatabaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetCreatedTime() uint64 {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return 0
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -378,7 +458,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{6}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -464,7 +544,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{7}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -533,6 +613,7 @@ func init() {
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.etcd.IndexInfo")
 	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.etcd.FieldIndexInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
+	proto.RegisterType((*DatabaseInfo)(nil), "milvus.proto.etcd.DatabaseInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 }
@@ -540,52 +621,58 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xe3, 0x36,
-	0x10, 0x86, 0x22, 0xc7, 0x8e, 0xc6, 0x8e, 0x9d, 0xb0, 0x3f, 0x20, 0x82, 0xb4, 0x55, 0x04, 0x24,
-	0x15, 0x50, 0xd4, 0x46, 0x93, 0xa2, 0xb7, 0x02, 0x6d, 0x23, 0x04, 0x30, 0x8a, 0x06, 0xae, 0x62,
-	0xf4, 0xd0, 0x8b, 0x40, 0x4b, 0xb4, 0x4d, 0x40, 0xa4, 0xbc, 0x22, 0x15, 0xc4, 0xb7, 0x3d, 0xef,
-	0x23, 0xec, 0x23, 0xec, 0x8b, 0xed, 0x61, 0x5f, 0x62, 0x21, 0x52, 0x92, 0xed, 0xc4, 0x39, 0xee,
-	0x4d, 0xf3, 0xcd, 0x0c, 0xf9, 0xcd, 0xc7, 0x6f, 0x04, 0x03, 0xaa, 0xe2, 0x24, 0xe2, 0x54, 0x91,
-	0xe1, 0x2a, 0xcf, 0x54, 0x86, 0x4e, 0x39, 0x4b, 0x1f, 0x0b, 0x69, 0xa2, 0x61, 0x99, 0x3d, 0xeb,
-	0xc5, 0x19, 0xe7, 0x99, 0x30, 0xd0, 0x59, 0x4f, 0xc6, 0x4b, 0xca, 0xab, 0x72, 0xef, 0xbd, 0x05,
-	0x30, 0xa5, 0x82, 0x08, 0xf5, 0x0f, 0x55, 0x04, 0xf5, 0xe1, 0x60, 0x1c, 0x60, 0xcb, 0xb5, 0x7c,
-	0x3b, 0x3c, 0x18, 0x07, 0xe8, 0x0a, 0x06, 0xa2, 0xe0, 0xd1, 0x9b, 0x82, 0xe6, 0xeb, 0x48, 0x64,
-	0x09, 0x95, 0xf8, 0x40, 0x27, 0x8f, 0x45, 0xc1, 0xff, 0x2d, 0xd1, 0xfb, 0x12, 0x44, 0x3f, 0xc1,
-	0x29, 0x13, 0x92, 0xe6, 0x2a, 0x8a, 0x97, 0x44, 0x08, 0x9a, 0x8e, 0x03, 0x89, 0x6d, 0xd7, 0xf6,
-	0x9d, 0xf0, 0xc4, 0x24, 0x6e, 0x1b, 0x1c, 0xfd, 0x08, 0x03, 0x73, 0x60, 0x53, 0x8b, 0x5b, 0xae,
-	0xe5, 0x3b, 0x61, 0x5f, 0xc3, 0x4d, 0xa5, 0xf7, 0xd6, 0x02, 0x67, 0x92, 0x67, 0x4f, 0xeb, 0xbd,
-	0xdc, 0x7e, 0x83, 0x0e, 0x49, 0x92, 0x9c, 0x4a, 0xc3, 0xa9, 0x7b, 0x7d, 0x3e, 0xdc, 0x99, 0xbd,
-	0x9a, 0xfa, 0x4f, 0x53, 0x13, 0xd6, 0xc5, 0x25, 0xd7, 0x9c, 0xca, 0x22, 0xdd, 0xc7, 0xd5, 0x24,
-	0x36, 0x5c, 0xbd, 0x77, 0x16, 0x38, 0x63, 0x91, 0xd0, 0xa7, 0xb1, 0x98, 0x67, 0xe8, 0x3b, 0x00,
-	0x56, 0x06, 0x91, 0x20, 0x9c, 0x6a, 0x2a, 0x4e, 0xe8, 0x68, 0xe4, 0x9e, 0x70, 0x8a, 0x30, 0x74,
-	0x74, 0x30, 0x0e, 0x2a, 0x95, 0xea, 0x10, 0x05, 0xd0, 0x33, 0x8d, 0x2b, 0x92, 0x13, 0x6e, 0xae,
-	0xeb, 0x5e, 0x5f, 0xec, 0x25, 0xfc, 0x37, 0x5d, 0xff, 0x47, 0xd2, 0x82, 0x4e, 0x08, 0xcb, 0xc3,
-	0xae, 0x6e, 0x9b, 0xe8, 0x2e, 0x2f, 0x80, 0xfe, 0x1d, 0xa3, 0x69, 0xb2, 0x21, 0x84, 0xa1, 0x33,
-	0x67, 0x29, 0x4d, 0x1a, 0x61, 0xea, 0xf0, 0x75, 0x2e, 0xde, 0x87, 0x16, 0xf4, 0x6f, 0xb3, 0x34,
-	0xa5, 0xb1, 0x62, 0x99, 0xd0, 0xc7, 0x3c, 0x97, 0xf6, 0x77, 0x68, 0x1b, 0x97, 0x54, 0xca, 0x5e,
-	0xee, 0x12, 0xad, 0x1c, 0xb4, 0x39, 0xe4, 0x41, 0x03, 0x61, 0xd5, 0x84, 0x7e, 0x80, 0x6e, 0x9c,
-	0x53, 0xa2, 0x68, 0xa4, 0x18, 0xa7, 0xd8, 0x76, 0x2d, 0xbf, 0x15, 0x82, 0x81, 0xa6, 0x8c, 0x53,
-	0xe4, 0x41, 0x6f, 0x45, 0x72, 0xc5, 0x34, 0x81, 0x40, 0xe2, 0x96, 0x6b, 0xfb, 0x76, 0xb8, 0x83,
-	0xa1, 0x2b, 0xe8, 0x37, 0x71, 0xa9, 0xae, 0xc4, 0x87, 0xfa, 0x8d, 0x9e, 0xa1, 0xe8, 0x0e, 0x8e,
-	0xe7, 0xa5, 0x28, 0x91, 0x9e, 0x8f, 0x4a, 0xdc, 0xde, 0xa7, 0x6d, 0xb9, 0x08, 0xc3, 0x5d, 0xf1,
-	0xc2, 0xde, 0xbc, 0x89, 0xa9, 0x44, 0xd7, 0xf0, 0xcd, 0x23, 0xcb, 0x55, 0x41, 0xd2, 0xda, 0x17,
-	0xfa, 0x95, 0x25, 0xee, 0xe8, 0x6b, 0xbf, 0xaa, 0x92, 0x95, 0x37, 0xcc, 0xdd, 0xbf, 0xc2, 0xb7,
-	0xab, 0xe5, 0x5a, 0xb2, 0xf8, 0x45, 0xd3, 0x91, 0x6e, 0xfa, 0xba, 0xce, 0xee, 0x74, 0xfd, 0x01,
-	0xe7, 0xcd, 0x0c, 0x91, 0x51, 0x25, 0xd1, 0x4a, 0x49, 0x45, 0xf8, 0x4a, 0x62, 0xc7, 0xb5, 0xfd,
-	0x56, 0x78, 0xd6, 0xd4, 0xdc, 0x9a, 0x92, 0x69, 0x53, 0x51, 0xfa, 0x50, 0x2e, 0x49, 0x9e, 0xc8,
-	0x48, 0x14, 0x1c, 0x83, 0x6b, 0xf9, 0x87, 0xa1, 0x63, 0x90, 0xfb, 0x82, 0xa3, 0x31, 0x0c, 0xa4,
-	0x22, 0xb9, 0x8a, 0x56, 0x99, 0xd4, 0x27, 0x48, 0xdc, 0xd5, 0xa2, 0xb8, 0xaf, 0x19, 0x2e, 0x20,
-	0x8a, 0x68, 0xbf, 0xf5, 0x75, 0xe3, 0xa4, 0xee, 0xf3, 0x3e, 0x5a, 0x70, 0xf2, 0x40, 0x17, 0x9c,
-	0x0a, 0xb5, 0x71, 0x9d, 0x07, 0xbd, 0x78, 0x63, 0xa0, 0xda, 0x38, 0x3b, 0x18, 0x72, 0xa1, 0xbb,
-	0xf5, 0x9c, 0x95, 0x07, 0xb7, 0x21, 0x74, 0x0e, 0x8e, 0xac, 0x4e, 0x0e, 0xb4, 0x47, 0xec, 0x70,
-	0x03, 0x18, 0x67, 0x97, 0xcf, 0x63, 0x7e, 0x0e, 0xda, 0xd9, 0x3a, 0xdc, 0x76, 0xf6, 0xe1, 0xee,
-	0x96, 0x61, 0xe8, 0xcc, 0x0a, 0xa6, 0x7b, 0xda, 0x26, 0x53, 0x85, 0xe8, 0x02, 0x7a, 0x54, 0x90,
-	0x59, 0x4a, 0x8d, 0x4b, 0x70, 0xc7, 0xb5, 0xfc, 0xa3, 0xb0, 0x6b, 0x30, 0x3d, 0x98, 0xf7, 0xc9,
-	0xda, 0x5e, 0x8b, 0xbd, 0x7f, 0x9c, 0x2f, 0xbd, 0x16, 0xdf, 0x03, 0x34, 0x02, 0xd4, 0x4b, 0xb1,
-	0x85, 0xa0, 0xcb, 0xad, 0x95, 0x88, 0x14, 0x59, 0xd4, 0x2b, 0x71, 0xdc, 0xa0, 0x53, 0xb2, 0x90,
-	0x2f, 0xb6, 0xab, 0xfd, 0x72, 0xbb, 0xfe, 0xba, 0xf9, 0xff, 0x97, 0x05, 0x53, 0xcb, 0x62, 0x56,
-	0x9a, 0x60, 0x64, 0xc6, 0xf8, 0x99, 0x65, 0xd5, 0xd7, 0x88, 0x09, 0x45, 0x73, 0x41, 0xd2, 0x91,
-	0x9e, 0x6c, 0x54, 0x6e, 0xcf, 0x6a, 0x36, 0x6b, 0xeb, 0xe8, 0xe6, 0x73, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x9e, 0xc5, 0x2c, 0x1a, 0x75, 0x06, 0x00, 0x00,
+	// 838 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xe4, 0x44,
+	0x10, 0x96, 0xe3, 0xf9, 0x59, 0xd7, 0x78, 0x9c, 0xa4, 0x17, 0x50, 0x2b, 0x0a, 0xe0, 0xb5, 0x94,
+	0xc5, 0x12, 0x22, 0x11, 0x59, 0xc4, 0x0d, 0x09, 0x88, 0xb5, 0xd2, 0x08, 0x88, 0x82, 0x37, 0xec,
+	0x81, 0x8b, 0xd5, 0x63, 0x57, 0x32, 0x2d, 0xd9, 0xed, 0xc1, 0xdd, 0x8e, 0x76, 0x6e, 0x9c, 0x79,
+	0x04, 0x1e, 0x85, 0x17, 0xe2, 0xc0, 0x4b, 0x20, 0x77, 0xfb, 0x67, 0x26, 0x99, 0x3d, 0xee, 0xcd,
+	0xf5, 0x55, 0x55, 0x77, 0xd5, 0xe7, 0xef, 0x6b, 0x38, 0x44, 0x95, 0x66, 0x49, 0x81, 0x8a, 0x9d,
+	0xaf, 0xab, 0x52, 0x95, 0xe4, 0xb8, 0xe0, 0xf9, 0x43, 0x2d, 0x4d, 0x74, 0xde, 0x64, 0x4f, 0xdc,
+	0xb4, 0x2c, 0x8a, 0x52, 0x18, 0xe8, 0xc4, 0x95, 0xe9, 0x0a, 0x8b, 0xb6, 0x3c, 0xf8, 0xdb, 0x02,
+	0xb8, 0x45, 0xc1, 0x84, 0xfa, 0x05, 0x15, 0x23, 0x1e, 0x1c, 0x2c, 0x22, 0x6a, 0xf9, 0x56, 0x68,
+	0xc7, 0x07, 0x8b, 0x88, 0xbc, 0x84, 0x43, 0x51, 0x17, 0xc9, 0x1f, 0x35, 0x56, 0x9b, 0x44, 0x94,
+	0x19, 0x4a, 0x7a, 0xa0, 0x93, 0x73, 0x51, 0x17, 0xbf, 0x36, 0xe8, 0x75, 0x03, 0x92, 0x2f, 0xe1,
+	0x98, 0x0b, 0x89, 0x95, 0x4a, 0xd2, 0x15, 0x13, 0x02, 0xf3, 0x45, 0x24, 0xa9, 0xed, 0xdb, 0xa1,
+	0x13, 0x1f, 0x99, 0xc4, 0x55, 0x8f, 0x93, 0x2f, 0xe0, 0xd0, 0x1c, 0xd8, 0xd7, 0xd2, 0x91, 0x6f,
+	0x85, 0x4e, 0xec, 0x69, 0xb8, 0xaf, 0x0c, 0xfe, 0xb4, 0xc0, 0xb9, 0xa9, 0xca, 0x77, 0x9b, 0xbd,
+	0xb3, 0x7d, 0x0b, 0x53, 0x96, 0x65, 0x15, 0x4a, 0x33, 0xd3, 0xec, 0xf2, 0xf4, 0x7c, 0x67, 0xf7,
+	0x76, 0xeb, 0x1f, 0x4c, 0x4d, 0xdc, 0x15, 0x37, 0xb3, 0x56, 0x28, 0xeb, 0x7c, 0xdf, 0xac, 0x26,
+	0x31, 0xcc, 0x1a, 0xfc, 0x65, 0x81, 0xb3, 0x10, 0x19, 0xbe, 0x5b, 0x88, 0xbb, 0x92, 0x7c, 0x0a,
+	0xc0, 0x9b, 0x20, 0x11, 0xac, 0x40, 0x3d, 0x8a, 0x13, 0x3b, 0x1a, 0xb9, 0x66, 0x05, 0x12, 0x0a,
+	0x53, 0x1d, 0x2c, 0xa2, 0x96, 0xa5, 0x2e, 0x24, 0x11, 0xb8, 0xa6, 0x71, 0xcd, 0x2a, 0x56, 0x98,
+	0xeb, 0x66, 0x97, 0x2f, 0xf6, 0x0e, 0xfc, 0x13, 0x6e, 0xde, 0xb2, 0xbc, 0xc6, 0x1b, 0xc6, 0xab,
+	0x78, 0xa6, 0xdb, 0x6e, 0x74, 0x57, 0x10, 0x81, 0xf7, 0x9a, 0x63, 0x9e, 0x0d, 0x03, 0x51, 0x98,
+	0xde, 0xf1, 0x1c, 0xb3, 0x9e, 0x98, 0x2e, 0x7c, 0xff, 0x2c, 0xc1, 0x3f, 0x63, 0xf0, 0xae, 0xca,
+	0x3c, 0xc7, 0x54, 0xf1, 0x52, 0xe8, 0x63, 0x1e, 0x53, 0xfb, 0x1d, 0x4c, 0x8c, 0x4a, 0x5a, 0x66,
+	0xcf, 0x76, 0x07, 0x6d, 0x15, 0x34, 0x1c, 0xf2, 0x46, 0x03, 0x71, 0xdb, 0x44, 0x3e, 0x87, 0x59,
+	0x5a, 0x21, 0x53, 0x98, 0x28, 0x5e, 0x20, 0xb5, 0x7d, 0x2b, 0x1c, 0xc5, 0x60, 0xa0, 0x5b, 0x5e,
+	0x20, 0x09, 0xc0, 0x5d, 0xb3, 0x4a, 0x71, 0x3d, 0x40, 0x24, 0xe9, 0xc8, 0xb7, 0x43, 0x3b, 0xde,
+	0xc1, 0xc8, 0x4b, 0xf0, 0xfa, 0xb8, 0x61, 0x57, 0xd2, 0xb1, 0xfe, 0x47, 0x8f, 0x50, 0xf2, 0x1a,
+	0xe6, 0x77, 0x0d, 0x29, 0x89, 0xde, 0x0f, 0x25, 0x9d, 0xec, 0xe3, 0xb6, 0x31, 0xc2, 0xf9, 0x2e,
+	0x79, 0xb1, 0x7b, 0xd7, 0xc7, 0x28, 0xc9, 0x25, 0x7c, 0xfc, 0xc0, 0x2b, 0x55, 0xb3, 0xbc, 0xd3,
+	0x85, 0xfe, 0xcb, 0x92, 0x4e, 0xf5, 0xb5, 0xcf, 0xdb, 0x64, 0xab, 0x0d, 0x73, 0xf7, 0x37, 0xf0,
+	0xc9, 0x7a, 0xb5, 0x91, 0x3c, 0x7d, 0xd2, 0xf4, 0x4c, 0x37, 0x7d, 0xd4, 0x65, 0x77, 0xba, 0xbe,
+	0x87, 0xd3, 0x7e, 0x87, 0xc4, 0xb0, 0x92, 0x69, 0xa6, 0xa4, 0x62, 0xc5, 0x5a, 0x52, 0xc7, 0xb7,
+	0xc3, 0x51, 0x7c, 0xd2, 0xd7, 0x5c, 0x99, 0x92, 0xdb, 0xbe, 0xa2, 0xd1, 0xa1, 0x5c, 0xb1, 0x2a,
+	0x93, 0x89, 0xa8, 0x0b, 0x0a, 0xbe, 0x15, 0x8e, 0x63, 0xc7, 0x20, 0xd7, 0x75, 0x41, 0x16, 0x70,
+	0x28, 0x15, 0xab, 0x54, 0xb2, 0x2e, 0xa5, 0x3e, 0x41, 0xd2, 0x99, 0x26, 0xc5, 0x7f, 0x9f, 0xe0,
+	0x22, 0xa6, 0x98, 0xd6, 0x9b, 0xa7, 0x1b, 0x6f, 0xba, 0x3e, 0xf2, 0x1c, 0xc6, 0xd9, 0x32, 0xe1,
+	0x19, 0x75, 0xb5, 0x38, 0x46, 0xd9, 0x72, 0x91, 0x91, 0x18, 0x8e, 0xd3, 0x52, 0x48, 0x2e, 0x15,
+	0x8a, 0x74, 0x93, 0xe4, 0xf8, 0x80, 0x39, 0x9d, 0xfb, 0x56, 0xe8, 0x5d, 0x9e, 0xed, 0xbd, 0xe1,
+	0x6a, 0xa8, 0xfe, 0xb9, 0x29, 0x8e, 0x8f, 0xd2, 0x47, 0x08, 0x39, 0x03, 0xcf, 0xa8, 0x27, 0x79,
+	0xc0, 0x4a, 0xf2, 0x52, 0x50, 0x4f, 0xaf, 0x35, 0x37, 0xe8, 0x5b, 0x03, 0x06, 0xbf, 0x81, 0xdb,
+	0xcc, 0xba, 0x64, 0x12, 0xf7, 0x2a, 0x97, 0xc0, 0x48, 0x7b, 0xf3, 0x40, 0x7b, 0x53, 0x7f, 0x93,
+	0x17, 0xe0, 0x6e, 0xb3, 0xdc, 0xea, 0x71, 0x96, 0x0e, 0xb4, 0x06, 0xff, 0x5a, 0x70, 0xf4, 0x06,
+	0xef, 0x0b, 0x14, 0x6a, 0x30, 0x57, 0x00, 0x6e, 0x3a, 0xf8, 0xa4, 0xbb, 0x65, 0x07, 0x23, 0x3e,
+	0xcc, 0xb6, 0x54, 0xdb, 0x5a, 0x6d, 0x1b, 0x22, 0xa7, 0xe0, 0xc8, 0xf6, 0xe4, 0x48, 0x5f, 0x6d,
+	0xc7, 0x03, 0x60, 0x0c, 0xdc, 0xa8, 0xd0, 0xbc, 0x81, 0x76, 0xdc, 0x85, 0xdb, 0x06, 0x1e, 0xef,
+	0x3e, 0x26, 0x14, 0xa6, 0xcb, 0x9a, 0xeb, 0x9e, 0x89, 0xc9, 0xb4, 0x61, 0xb3, 0x29, 0x0a, 0xb6,
+	0xcc, 0xd1, 0x98, 0x81, 0x4e, 0x7d, 0x2b, 0x7c, 0x16, 0xcf, 0x0c, 0xa6, 0x17, 0x0b, 0xfe, 0xb3,
+	0xb6, 0xdd, 0xbf, 0xf7, 0x61, 0xfd, 0xd0, 0xee, 0xff, 0x0c, 0xa0, 0x27, 0xa0, 0xf3, 0xfe, 0x16,
+	0xd2, 0x48, 0x61, 0xf0, 0x87, 0x62, 0xf7, 0x9d, 0xf3, 0xe7, 0x3d, 0x7a, 0xcb, 0xee, 0xe5, 0x93,
+	0x47, 0x64, 0xf2, 0xf4, 0x11, 0xf9, 0xf1, 0xd5, 0xef, 0x5f, 0xdf, 0x73, 0xb5, 0xaa, 0x97, 0x8d,
+	0x12, 0x2f, 0xcc, 0x1a, 0x5f, 0xf1, 0xb2, 0xfd, 0xba, 0xe0, 0x42, 0x61, 0x25, 0x58, 0x7e, 0xa1,
+	0x37, 0xbb, 0x68, 0x1e, 0x89, 0xf5, 0x72, 0x39, 0xd1, 0xd1, 0xab, 0xff, 0x07, 0x00, 0x22, 0x51,
+	0xa9, 0xbb, 0x5c, 0x07, 0x00, 0x00,
 }
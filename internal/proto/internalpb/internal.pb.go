@@ -50,6 +50,40 @@ func (StateCode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_41f4a519b878ee3b, []int{0}
 }
 
+type AggregateOp int32
+
+const (
+	AggregateOp_Count AggregateOp = 0
+	AggregateOp_Min   AggregateOp = 1
+	AggregateOp_Max   AggregateOp = 2
+	AggregateOp_Sum   AggregateOp = 3
+	AggregateOp_Avg   AggregateOp = 4
+)
+
+var AggregateOp_name = map[int32]string{
+	0: "Count",
+	1: "Min",
+	2: "Max",
+	3: "Sum",
+	4: "Avg",
+}
+
+var AggregateOp_value = map[string]int32{
+	"Count": 0,
+	"Min":   1,
+	"Max":   2,
+	"Sum":   3,
+	"Avg":   4,
+}
+
+func (x AggregateOp) String() string {
+	return proto.EnumName(AggregateOp_name, int32(x))
+}
+
+func (AggregateOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{1}
+}
+
 type ComponentInfo struct {
 	NodeID               int64                    `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Role                 string                   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
@@ -1498,6 +1532,11 @@ type RetrieveRequest struct {
 	OutputFieldsId       []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
 	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	Limit                int64             `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderByFieldID       int64             `protobuf:"varint,11,opt,name=order_by_fieldID,json=orderByFieldID,proto3" json:"order_by_fieldID,omitempty"`
+	Descending           bool              `protobuf:"varint,12,opt,name=descending,proto3" json:"descending,omitempty"`
+	Aggregates           []*Aggregate      `protobuf:"bytes,13,rep,name=aggregates,proto3" json:"aggregates,omitempty"`
+	GroupByFieldID       int64             `protobuf:"varint,14,opt,name=group_by_fieldID,json=groupByFieldID,proto3" json:"group_by_fieldID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1591,6 +1630,88 @@ func (m *RetrieveRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RetrieveRequest) GetOrderByFieldID() int64 {
+	if m != nil {
+		return m.OrderByFieldID
+	}
+	return 0
+}
+
+func (m *RetrieveRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *RetrieveRequest) GetAggregates() []*Aggregate {
+	if m != nil {
+		return m.Aggregates
+	}
+	return nil
+}
+
+func (m *RetrieveRequest) GetGroupByFieldID() int64 {
+	if m != nil {
+		return m.GroupByFieldID
+	}
+	return 0
+}
+
+type Aggregate struct {
+	Op                   AggregateOp `protobuf:"varint,1,opt,name=op,proto3,enum=milvus.proto.internal.AggregateOp" json:"op,omitempty"`
+	FieldID              int64       `protobuf:"varint,2,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Aggregate) Reset()         { *m = Aggregate{} }
+func (m *Aggregate) String() string { return proto.CompactTextString(m) }
+func (*Aggregate) ProtoMessage()    {}
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
+}
+
+func (m *Aggregate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Aggregate.Unmarshal(m, b)
+}
+func (m *Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Aggregate.Marshal(b, m, deterministic)
+}
+func (m *Aggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Aggregate.Merge(m, src)
+}
+func (m *Aggregate) XXX_Size() int {
+	return xxx_messageInfo_Aggregate.Size(m)
+}
+func (m *Aggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Aggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Aggregate proto.InternalMessageInfo
+
+func (m *Aggregate) GetOp() AggregateOp {
+	if m != nil {
+		return m.Op
+	}
+	return AggregateOp_Count
+}
+
+func (m *Aggregate) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1600,6 +1721,7 @@ type RetrieveResults struct {
 	SealedSegmentIDsRetrieved []int64               `protobuf:"varint,6,rep,packed,name=sealed_segmentIDs_retrieved,json=sealedSegmentIDsRetrieved,proto3" json:"sealed_segmentIDs_retrieved,omitempty"`
 	ChannelIDsRetrieved       []string              `protobuf:"bytes,7,rep,name=channelIDs_retrieved,json=channelIDsRetrieved,proto3" json:"channelIDs_retrieved,omitempty"`
 	GlobalSealedSegmentIDs    []int64               `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
+	AggregatePartials         []*schemapb.FieldData `protobuf:"bytes,9,rep,name=aggregate_partials,json=aggregatePartials,proto3" json:"aggregate_partials,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}              `json:"-"`
 	XXX_unrecognized          []byte                `json:"-"`
 	XXX_sizecache             int32                 `json:"-"`
@@ -1609,7 +1731,7 @@ func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -1686,6 +1808,13 @@ func (m *RetrieveResults) GetGlobalSealedSegmentIDs() []int64 {
 	return nil
 }
 
+func (m *RetrieveResults) GetAggregatePartials() []*schemapb.FieldData {
+	if m != nil {
+		return m.AggregatePartials
+	}
+	return nil
+}
+
 type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ShardName            string            `protobuf:"bytes,2,opt,name=shardName,proto3" json:"shardName,omitempty"`
@@ -1697,6 +1826,7 @@ type DeleteRequest struct {
 	PartitionID          int64             `protobuf:"varint,8,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	PrimaryKeys          []int64           `protobuf:"varint,9,rep,packed,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
 	Timestamps           []uint64          `protobuf:"varint,10,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	StringPrimaryKeys    []string          `protobuf:"bytes,11,rep,name=string_primary_keys,json=stringPrimaryKeys,proto3" json:"string_primary_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1706,7 +1836,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1797,6 +1927,13 @@ func (m *DeleteRequest) GetTimestamps() []uint64 {
 	return nil
 }
 
+func (m *DeleteRequest) GetStringPrimaryKeys() []string {
+	if m != nil {
+		return m.StringPrimaryKeys
+	}
+	return nil
+}
+
 type LoadIndex struct {
 	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentID            int64                    `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1813,7 +1950,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -1893,7 +2030,7 @@ func (m *SegmentStatisticsUpdates) Reset()         { *m = SegmentStatisticsUpdat
 func (m *SegmentStatisticsUpdates) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatisticsUpdates) ProtoMessage()    {}
 func (*SegmentStatisticsUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *SegmentStatisticsUpdates) XXX_Unmarshal(b []byte) error {
@@ -1975,7 +2112,7 @@ func (m *SegmentStatistics) Reset()         { *m = SegmentStatistics{} }
 func (m *SegmentStatistics) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatistics) ProtoMessage()    {}
 func (*SegmentStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *SegmentStatistics) XXX_Unmarshal(b []byte) error {
@@ -2022,7 +2159,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -2070,7 +2207,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -2126,7 +2263,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2188,7 +2325,7 @@ func (m *QueryNodeStats) Reset()         { *m = QueryNodeStats{} }
 func (m *QueryNodeStats) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeStats) ProtoMessage()    {}
 func (*QueryNodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *QueryNodeStats) XXX_Unmarshal(b []byte) error {
@@ -2244,7 +2381,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{31}
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2307,7 +2444,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{32}
+	return fileDescriptor_41f4a519b878ee3b, []int{33}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2356,8 +2493,284 @@ func (m *ChannelTimeTickMsg) GetDefaultTimestamp() uint64 {
 	return 0
 }
 
+type CredentialInfo struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// encrypted by bcrypt (for higher security level)
+	EncryptedPassword string `protobuf:"bytes,2,opt,name=encrypted_password,json=encryptedPassword,proto3" json:"encrypted_password,omitempty"`
+	// sha256 of the raw password, only cached in proxy memory for fast verification
+	Sha256Password       string   `protobuf:"bytes,3,opt,name=sha256_password,json=sha256Password,proto3" json:"sha256_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
+func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
+func (*CredentialInfo) ProtoMessage()    {}
+func (*CredentialInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{34}
+}
+
+func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CredentialInfo.Unmarshal(m, b)
+}
+func (m *CredentialInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CredentialInfo.Marshal(b, m, deterministic)
+}
+func (m *CredentialInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialInfo.Merge(m, src)
+}
+func (m *CredentialInfo) XXX_Size() int {
+	return xxx_messageInfo_CredentialInfo.Size(m)
+}
+func (m *CredentialInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialInfo proto.InternalMessageInfo
+
+func (m *CredentialInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetEncryptedPassword() string {
+	if m != nil {
+		return m.EncryptedPassword
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetSha256Password() string {
+	if m != nil {
+		return m.Sha256Password
+	}
+	return ""
+}
+
+type ListPolicyRequest struct {
+	// Not useful for now
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListPolicyRequest) Reset()         { *m = ListPolicyRequest{} }
+func (m *ListPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyRequest) ProtoMessage()    {}
+func (*ListPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{35}
+}
+
+func (m *ListPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPolicyRequest.Unmarshal(m, b)
+}
+func (m *ListPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyRequest.Merge(m, src)
+}
+func (m *ListPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPolicyRequest.Size(m)
+}
+func (m *ListPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyRequest proto.InternalMessageInfo
+
+func (m *ListPolicyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListPolicyResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// all the grants, encoded by funcutil.PolicyForPrivilege
+	PolicyInfos []string `protobuf:"bytes,2,rep,name=policy_infos,json=policyInfos,proto3" json:"policy_infos,omitempty"`
+	// all the user-role bindings, encoded by funcutil.EncodeUserRoleCache
+	UserRoles            []string `protobuf:"bytes,3,rep,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPolicyResponse) Reset()         { *m = ListPolicyResponse{} }
+func (m *ListPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyResponse) ProtoMessage()    {}
+func (*ListPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{36}
+}
+
+func (m *ListPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPolicyResponse.Unmarshal(m, b)
+}
+func (m *ListPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPolicyResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyResponse.Merge(m, src)
+}
+func (m *ListPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPolicyResponse.Size(m)
+}
+func (m *ListPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyResponse proto.InternalMessageInfo
+
+func (m *ListPolicyResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListPolicyResponse) GetPolicyInfos() []string {
+	if m != nil {
+		return m.PolicyInfos
+	}
+	return nil
+}
+
+func (m *ListPolicyResponse) GetUserRoles() []string {
+	if m != nil {
+		return m.UserRoles
+	}
+	return nil
+}
+
+// QueryIteratorInfo is the query iterator stored in etcd, the compaction keeps the data visible at its travel timestamp
+type QueryIteratorInfo struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                 string   `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields         []string `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames       []string `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp      uint64   `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	BatchSize            int64    `protobuf:"varint,8,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Username             string   `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
+	SecretSha256         string   `protobuf:"bytes,10,opt,name=secret_sha256,json=secretSha256,proto3" json:"secret_sha256,omitempty"`
+	LeaseID              int64    `protobuf:"varint,11,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryIteratorInfo) Reset()         { *m = QueryIteratorInfo{} }
+func (m *QueryIteratorInfo) String() string { return proto.CompactTextString(m) }
+func (*QueryIteratorInfo) ProtoMessage()    {}
+func (*QueryIteratorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{37}
+}
+
+func (m *QueryIteratorInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryIteratorInfo.Unmarshal(m, b)
+}
+func (m *QueryIteratorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryIteratorInfo.Marshal(b, m, deterministic)
+}
+func (m *QueryIteratorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIteratorInfo.Merge(m, src)
+}
+func (m *QueryIteratorInfo) XXX_Size() int {
+	return xxx_messageInfo_QueryIteratorInfo.Size(m)
+}
+func (m *QueryIteratorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIteratorInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIteratorInfo proto.InternalMessageInfo
+
+func (m *QueryIteratorInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *QueryIteratorInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *QueryIteratorInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *QueryIteratorInfo) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+func (m *QueryIteratorInfo) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+func (m *QueryIteratorInfo) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *QueryIteratorInfo) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *QueryIteratorInfo) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *QueryIteratorInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *QueryIteratorInfo) GetSecretSha256() string {
+	if m != nil {
+		return m.SecretSha256
+	}
+	return ""
+}
+
+func (m *QueryIteratorInfo) GetLeaseID() int64 {
+	if m != nil {
+		return m.LeaseID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.internal.StateCode", StateCode_name, StateCode_value)
+	proto.RegisterEnum("milvus.proto.internal.AggregateOp", AggregateOp_name, AggregateOp_value)
 	proto.RegisterType((*ComponentInfo)(nil), "milvus.proto.internal.ComponentInfo")
 	proto.RegisterType((*ComponentStates)(nil), "milvus.proto.internal.ComponentStates")
 	proto.RegisterType((*GetComponentStatesRequest)(nil), "milvus.proto.internal.GetComponentStatesRequest")
@@ -2380,6 +2793,7 @@ func init() {
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.internal.SearchRequest")
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.internal.SearchResults")
 	proto.RegisterType((*RetrieveRequest)(nil), "milvus.proto.internal.RetrieveRequest")
+	proto.RegisterType((*Aggregate)(nil), "milvus.proto.internal.Aggregate")
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.internal.RetrieveResults")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.internal.DeleteRequest")
 	proto.RegisterType((*LoadIndex)(nil), "milvus.proto.internal.LoadIndex")
@@ -2391,135 +2805,165 @@ func init() {
 	proto.RegisterType((*QueryNodeStats)(nil), "milvus.proto.internal.QueryNodeStats")
 	proto.RegisterType((*MsgPosition)(nil), "milvus.proto.internal.MsgPosition")
 	proto.RegisterType((*ChannelTimeTickMsg)(nil), "milvus.proto.internal.ChannelTimeTickMsg")
+	proto.RegisterType((*CredentialInfo)(nil), "milvus.proto.internal.CredentialInfo")
+	proto.RegisterType((*ListPolicyRequest)(nil), "milvus.proto.internal.ListPolicyRequest")
+	proto.RegisterType((*ListPolicyResponse)(nil), "milvus.proto.internal.ListPolicyResponse")
+	proto.RegisterType((*QueryIteratorInfo)(nil), "milvus.proto.internal.QueryIteratorInfo")
 }
 
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1998 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xdd, 0x72, 0x1c, 0x47,
-	0xf5, 0xff, 0xcf, 0xce, 0xae, 0x76, 0xf7, 0xcc, 0x4a, 0x5e, 0xb5, 0x64, 0x67, 0x64, 0x3b, 0xb1,
-	0x32, 0xc9, 0x1f, 0x44, 0x5c, 0x58, 0x46, 0x01, 0x92, 0xa2, 0x28, 0x1c, 0x5b, 0x1b, 0xcc, 0x96,
-	0x23, 0x21, 0x46, 0x4e, 0xaa, 0xe0, 0x66, 0xaa, 0x77, 0xa7, 0xb5, 0x1a, 0x3c, 0x5f, 0x99, 0xee,
-	0x91, 0xbd, 0xb9, 0xa2, 0x28, 0xae, 0xa0, 0xa0, 0x0a, 0xaa, 0xb8, 0x84, 0x47, 0xe0, 0x96, 0x2b,
-	0x3e, 0x8a, 0x2b, 0x5e, 0x81, 0x07, 0xe0, 0x1d, 0x28, 0xae, 0xa8, 0x3e, 0xdd, 0xf3, 0xb1, 0xab,
-	0x95, 0x2c, 0x2b, 0x15, 0x62, 0xaa, 0x72, 0x37, 0x7d, 0xce, 0xe9, 0x8f, 0xf3, 0x3b, 0xbf, 0x73,
-	0xfa, 0x6c, 0x2f, 0xac, 0x04, 0xb1, 0x60, 0x59, 0x4c, 0xc3, 0x3b, 0x69, 0x96, 0x88, 0x84, 0x5c,
-	0x8d, 0x82, 0xf0, 0x24, 0xe7, 0x6a, 0x74, 0xa7, 0x50, 0x5e, 0xef, 0x8d, 0x93, 0x28, 0x4a, 0x62,
-	0x25, 0xbe, 0xde, 0xe3, 0xe3, 0x63, 0x16, 0x51, 0x35, 0x72, 0xfe, 0x6c, 0xc0, 0xf2, 0x6e, 0x12,
-	0xa5, 0x49, 0xcc, 0x62, 0x31, 0x8c, 0x8f, 0x12, 0x72, 0x0d, 0x96, 0xe2, 0xc4, 0x67, 0xc3, 0x81,
-	0x6d, 0x6c, 0x1a, 0x5b, 0xa6, 0xab, 0x47, 0x84, 0x40, 0x33, 0x4b, 0x42, 0x66, 0x37, 0x36, 0x8d,
-	0xad, 0xae, 0x8b, 0xdf, 0xe4, 0x1e, 0x00, 0x17, 0x54, 0x30, 0x6f, 0x9c, 0xf8, 0xcc, 0x36, 0x37,
-	0x8d, 0xad, 0x95, 0x9d, 0xcd, 0x3b, 0x0b, 0x4f, 0x71, 0xe7, 0x50, 0x1a, 0xee, 0x26, 0x3e, 0x73,
-	0xbb, 0xbc, 0xf8, 0x24, 0xef, 0x01, 0xb0, 0x67, 0x22, 0xa3, 0x5e, 0x10, 0x1f, 0x25, 0x76, 0x73,
-	0xd3, 0xdc, 0xb2, 0x76, 0x5e, 0x9f, 0x5d, 0x40, 0x1f, 0xfe, 0x11, 0x9b, 0x7e, 0x44, 0xc3, 0x9c,
-	0x1d, 0xd0, 0x20, 0x73, 0xbb, 0x38, 0x49, 0x1e, 0xd7, 0xf9, 0x87, 0x01, 0x57, 0x4a, 0x07, 0x70,
-	0x0f, 0x4e, 0xbe, 0x05, 0x2d, 0xdc, 0x02, 0x3d, 0xb0, 0x76, 0xde, 0x3c, 0xe3, 0x44, 0x33, 0x7e,
-	0xbb, 0x6a, 0x0a, 0xf9, 0x10, 0xd6, 0x78, 0x3e, 0x1a, 0x17, 0x2a, 0x0f, 0xa5, 0xdc, 0x6e, 0xe0,
-	0xd1, 0x2e, 0xb6, 0x12, 0xa9, 0x2f, 0xa0, 0x8f, 0xf4, 0x36, 0x2c, 0xc9, 0x95, 0x72, 0x8e, 0x28,
-	0x59, 0x3b, 0x37, 0x16, 0x3a, 0x79, 0x88, 0x26, 0xae, 0x36, 0x75, 0x6e, 0xc0, 0xc6, 0x43, 0x26,
-	0xe6, 0xbc, 0x73, 0xd9, 0xc7, 0x39, 0xe3, 0x42, 0x2b, 0x1f, 0x07, 0x11, 0x7b, 0x1c, 0x8c, 0x9f,
-	0xec, 0x1e, 0xd3, 0x38, 0x66, 0x61, 0xa1, 0x7c, 0x15, 0x6e, 0x3c, 0x64, 0x38, 0x21, 0xe0, 0x22,
-	0x18, 0xf3, 0x39, 0xf5, 0x55, 0x58, 0x7b, 0xc8, 0xc4, 0xc0, 0x9f, 0x13, 0x7f, 0x04, 0x9d, 0x7d,
-	0x19, 0x6c, 0x49, 0x83, 0x6f, 0x42, 0x9b, 0xfa, 0x7e, 0xc6, 0x38, 0xd7, 0x28, 0xde, 0x5c, 0x78,
-	0xe2, 0xfb, 0xca, 0xc6, 0x2d, 0x8c, 0x17, 0xd1, 0xc4, 0xf9, 0x31, 0xc0, 0x30, 0x0e, 0xc4, 0x01,
-	0xcd, 0x68, 0xc4, 0xcf, 0x24, 0xd8, 0x00, 0x7a, 0x5c, 0xd0, 0x4c, 0x78, 0x29, 0xda, 0x69, 0xc8,
-	0x2f, 0xc0, 0x06, 0x0b, 0xa7, 0xa9, 0xd5, 0x9d, 0x1f, 0x02, 0x1c, 0x8a, 0x2c, 0x88, 0x27, 0x1f,
-	0x04, 0x5c, 0xc8, 0xbd, 0x4e, 0xa4, 0x9d, 0x74, 0xc2, 0xdc, 0xea, 0xba, 0x7a, 0x54, 0x0b, 0x47,
-	0xe3, 0xe2, 0xe1, 0xb8, 0x07, 0x56, 0x01, 0xf7, 0x1e, 0x9f, 0x90, 0xbb, 0xd0, 0x1c, 0x51, 0xce,
-	0xce, 0x85, 0x67, 0x8f, 0x4f, 0x1e, 0x50, 0xce, 0x5c, 0xb4, 0x74, 0x7e, 0x6e, 0xc2, 0x2b, 0xbb,
-	0x19, 0x43, 0xf2, 0x87, 0x21, 0x1b, 0x8b, 0x20, 0x89, 0x35, 0xf6, 0x2f, 0xbe, 0x1a, 0x79, 0x05,
-	0xda, 0xfe, 0xc8, 0x8b, 0x69, 0x54, 0x80, 0xbd, 0xe4, 0x8f, 0xf6, 0x69, 0xc4, 0xc8, 0x97, 0x60,
-	0x65, 0x5c, 0xae, 0x2f, 0x25, 0xc8, 0xb9, 0xae, 0x3b, 0x27, 0x25, 0x6f, 0xc2, 0x72, 0x4a, 0x33,
-	0x11, 0x94, 0x66, 0x4d, 0x34, 0x9b, 0x15, 0xca, 0x80, 0xfa, 0xa3, 0xe1, 0xc0, 0x6e, 0x61, 0xb0,
-	0xf0, 0x9b, 0x38, 0xd0, 0xab, 0xd6, 0x1a, 0x0e, 0xec, 0x25, 0xd4, 0xcd, 0xc8, 0xc8, 0x26, 0x58,
-	0xe5, 0x42, 0xc3, 0x81, 0xdd, 0x46, 0x93, 0xba, 0x48, 0x06, 0x47, 0xd5, 0x22, 0xbb, 0xb3, 0x69,
-	0x6c, 0xf5, 0x5c, 0x3d, 0x22, 0x77, 0x61, 0xed, 0x24, 0xc8, 0x44, 0x4e, 0x43, 0xcd, 0x4f, 0x79,
-	0x0e, 0x6e, 0x77, 0x31, 0x82, 0x8b, 0x54, 0x64, 0x07, 0xd6, 0xd3, 0xe3, 0x29, 0x0f, 0xc6, 0x73,
-	0x53, 0x00, 0xa7, 0x2c, 0xd4, 0x39, 0x7f, 0x33, 0xe0, 0xea, 0x20, 0x4b, 0xd2, 0x97, 0x22, 0x14,
-	0x05, 0xc8, 0xcd, 0x73, 0x40, 0x6e, 0x9d, 0x06, 0xd9, 0xf9, 0x65, 0x03, 0xae, 0x29, 0x46, 0x1d,
-	0x14, 0xc0, 0x7e, 0x06, 0x5e, 0x7c, 0x19, 0xae, 0x54, 0xbb, 0x2a, 0x83, 0xc5, 0x6e, 0xfc, 0x3f,
-	0xac, 0x94, 0x01, 0x56, 0x76, 0xff, 0x5d, 0x4a, 0x39, 0xbf, 0x68, 0xc0, 0xba, 0x0c, 0xea, 0x17,
-	0x68, 0x48, 0x34, 0x7e, 0x6f, 0x00, 0x51, 0xec, 0xb8, 0x1f, 0x06, 0x94, 0x7f, 0x9e, 0x58, 0xac,
-	0x43, 0x8b, 0xca, 0x33, 0x68, 0x08, 0xd4, 0xc0, 0xe1, 0xd0, 0x97, 0xd1, 0xfa, 0xac, 0x4e, 0x57,
-	0x6e, 0x6a, 0xd6, 0x37, 0xfd, 0x9d, 0x01, 0xab, 0xf7, 0x43, 0xc1, 0xb2, 0x97, 0x14, 0x94, 0xbf,
-	0x34, 0x8a, 0xa8, 0x0d, 0x63, 0x9f, 0x3d, 0xfb, 0x3c, 0x0f, 0xf8, 0x2a, 0xc0, 0x51, 0xc0, 0x42,
-	0xbf, 0xce, 0xde, 0x2e, 0x4a, 0x3e, 0x15, 0x73, 0x6d, 0x68, 0xe3, 0x22, 0x25, 0x6b, 0x8b, 0xa1,
-	0xec, 0x01, 0x54, 0x3f, 0xa8, 0x7b, 0x80, 0xce, 0x85, 0x7b, 0x00, 0x9c, 0xa6, 0x7b, 0x80, 0x3f,
-	0x98, 0xb0, 0x3c, 0x8c, 0x39, 0xcb, 0xc4, 0xe5, 0xc1, 0xbb, 0x09, 0x5d, 0x7e, 0x4c, 0x33, 0x74,
-	0x54, 0xc3, 0x57, 0x09, 0xea, 0xd0, 0x9a, 0xcf, 0x83, 0xb6, 0x79, 0xc1, 0xe2, 0xd0, 0x3a, 0xaf,
-	0x38, 0x2c, 0x9d, 0x03, 0x71, 0xfb, 0xf9, 0xc5, 0xa1, 0x73, 0xfa, 0xf6, 0x95, 0x0e, 0xb2, 0x49,
-	0x24, 0x9b, 0xd6, 0x81, 0xdd, 0x45, 0x7d, 0x25, 0x20, 0xaf, 0x01, 0x88, 0x20, 0x62, 0x5c, 0xd0,
-	0x28, 0x55, 0xf7, 0x68, 0xd3, 0xad, 0x49, 0xe4, 0xdd, 0x9d, 0x25, 0x4f, 0x87, 0x03, 0x6e, 0x5b,
-	0x9b, 0xa6, 0x6c, 0xe2, 0xd4, 0x88, 0x7c, 0x1d, 0x3a, 0x59, 0xf2, 0xd4, 0xf3, 0xa9, 0xa0, 0x76,
-	0x0f, 0x83, 0xb7, 0xb1, 0x10, 0xec, 0x07, 0x61, 0x32, 0x72, 0xdb, 0x59, 0xf2, 0x74, 0x40, 0x05,
-	0x75, 0xfe, 0x65, 0xc2, 0xf2, 0x21, 0xa3, 0xd9, 0xf8, 0xf8, 0xf2, 0x01, 0xfb, 0x0a, 0xf4, 0x33,
-	0xc6, 0xf3, 0x50, 0x78, 0x63, 0x75, 0xcd, 0x0f, 0x07, 0x3a, 0x6e, 0x57, 0x94, 0x7c, 0xb7, 0x10,
-	0x97, 0xa0, 0x9a, 0xe7, 0x80, 0xda, 0x5c, 0x00, 0xaa, 0x03, 0xbd, 0x1a, 0x82, 0xdc, 0x6e, 0xa1,
-	0xeb, 0x33, 0x32, 0xd2, 0x07, 0xd3, 0xe7, 0x21, 0xc6, 0xab, 0xeb, 0xca, 0x4f, 0x72, 0x1b, 0x56,
-	0xd3, 0x90, 0x8e, 0xd9, 0x71, 0x12, 0xfa, 0x2c, 0xf3, 0x26, 0x59, 0x92, 0xa7, 0x18, 0xb3, 0x9e,
-	0xdb, 0xaf, 0x29, 0x1e, 0x4a, 0x39, 0x79, 0x07, 0x3a, 0x3e, 0x0f, 0x3d, 0x31, 0x4d, 0x19, 0x06,
-	0x6d, 0xe5, 0x0c, 0xdf, 0x07, 0x3c, 0x7c, 0x3c, 0x4d, 0x99, 0xdb, 0xf6, 0xd5, 0x07, 0xb9, 0x0b,
-	0xeb, 0x9c, 0x65, 0x01, 0x0d, 0x83, 0x4f, 0x98, 0xef, 0xb1, 0x67, 0x69, 0xe6, 0xa5, 0x21, 0x8d,
-	0x31, 0xb2, 0x3d, 0x97, 0x54, 0xba, 0xf7, 0x9f, 0xa5, 0xd9, 0x41, 0x48, 0x63, 0xb2, 0x05, 0xfd,
-	0x24, 0x17, 0x69, 0x2e, 0x3c, 0xcc, 0x3e, 0xee, 0x05, 0x3e, 0x06, 0xda, 0x74, 0x57, 0x94, 0xfc,
-	0xbb, 0x28, 0x1e, 0xfa, 0x12, 0x5a, 0x91, 0xd1, 0x13, 0x16, 0x7a, 0x25, 0x03, 0x6c, 0x6b, 0xd3,
-	0xd8, 0x6a, 0xba, 0x57, 0x94, 0xfc, 0x71, 0x21, 0x26, 0xdb, 0xb0, 0x36, 0xc9, 0x69, 0x46, 0x63,
-	0xc1, 0x58, 0xcd, 0xba, 0x87, 0xd6, 0xa4, 0x54, 0x95, 0x13, 0x9c, 0x5f, 0x37, 0xab, 0xd0, 0xcb,
-	0x28, 0xf1, 0x4b, 0x84, 0xfe, 0x32, 0xdd, 0xfc, 0x42, 0xbe, 0x98, 0x8b, 0xf9, 0x72, 0x0b, 0xac,
-	0x88, 0x89, 0x2c, 0x18, 0xab, 0xb8, 0xa8, 0x84, 0x06, 0x25, 0x42, 0xf0, 0x6f, 0x81, 0x15, 0xe7,
-	0x91, 0xf7, 0x71, 0xce, 0xb2, 0x80, 0x71, 0x5d, 0x0f, 0x21, 0xce, 0xa3, 0x1f, 0x28, 0x09, 0x59,
-	0x83, 0x96, 0x48, 0x52, 0xef, 0x49, 0x91, 0xc7, 0x22, 0x49, 0x1f, 0x91, 0x6f, 0xc3, 0x75, 0xce,
-	0x68, 0xc8, 0x7c, 0xaf, 0xcc, 0x3b, 0xee, 0x71, 0xc4, 0x82, 0xf9, 0x76, 0x1b, 0x43, 0x61, 0x2b,
-	0x8b, 0xc3, 0xd2, 0xe0, 0x50, 0xeb, 0x25, 0xd2, 0xe5, 0xc1, 0x6b, 0xd3, 0x3a, 0xd8, 0xf2, 0x92,
-	0x4a, 0x55, 0x4e, 0x78, 0x17, 0xec, 0x49, 0x98, 0x8c, 0x68, 0xe8, 0x9d, 0xda, 0x15, 0x7b, 0x6b,
-	0xd3, 0xbd, 0xa6, 0xf4, 0x87, 0x73, 0x5b, 0x4a, 0xf7, 0x78, 0x18, 0x8c, 0x99, 0xef, 0x8d, 0xc2,
-	0x64, 0x64, 0x03, 0x52, 0x0a, 0x94, 0x48, 0x26, 0xb2, 0xa4, 0x92, 0x36, 0x90, 0x30, 0x8c, 0x93,
-	0x3c, 0x16, 0x48, 0x10, 0xd3, 0x5d, 0x51, 0xf2, 0xfd, 0x3c, 0xda, 0x95, 0x52, 0xf2, 0x06, 0x2c,
-	0x6b, 0xcb, 0xe4, 0xe8, 0x88, 0x33, 0x81, 0xcc, 0x30, 0xdd, 0x9e, 0x12, 0x7e, 0x1f, 0x65, 0xce,
-	0x4f, 0x4d, 0xb8, 0xe2, 0x4a, 0x74, 0xd9, 0x09, 0xfb, 0x9f, 0x2f, 0x08, 0x67, 0x25, 0xe6, 0xd2,
-	0x0b, 0x25, 0x66, 0xfb, 0xc2, 0x89, 0xd9, 0x79, 0xa1, 0xc4, 0xec, 0x9e, 0x99, 0x98, 0x7f, 0x9a,
-	0x09, 0xc2, 0xcb, 0x9a, 0x9a, 0x6f, 0x81, 0x19, 0xf8, 0xaa, 0x81, 0xb2, 0x76, 0xec, 0xd9, 0xc5,
-	0xf5, 0x43, 0xd7, 0x70, 0xc0, 0x5d, 0x69, 0x44, 0xee, 0x81, 0xa5, 0x01, 0xc5, 0xeb, 0xa9, 0x85,
-	0xd7, 0xd3, 0x6b, 0x0b, 0xe7, 0x20, 0xc2, 0xf2, 0x6a, 0x72, 0x55, 0x03, 0xc4, 0xe5, 0x37, 0xf9,
-	0x0e, 0xdc, 0x38, 0x9d, 0xb0, 0x99, 0xc6, 0xc8, 0xb7, 0x97, 0x30, 0x46, 0x1b, 0xf3, 0x19, 0x5b,
-	0x80, 0xe8, 0x93, 0xaf, 0xc1, 0x7a, 0x2d, 0x65, 0xab, 0x89, 0x6d, 0xf5, 0xcb, 0xb6, 0xd2, 0x55,
-	0x53, 0xce, 0x4b, 0xda, 0xce, 0x79, 0x49, 0xeb, 0xfc, 0xb3, 0x01, 0xcb, 0x03, 0x16, 0x32, 0xc1,
-	0xbe, 0x68, 0x82, 0xce, 0x6c, 0x82, 0x5e, 0x87, 0x5e, 0x9a, 0x05, 0x11, 0xcd, 0xa6, 0xde, 0x13,
-	0x36, 0x2d, 0xea, 0xa0, 0xa5, 0x65, 0x8f, 0xd8, 0x94, 0x3f, 0xaf, 0x13, 0x72, 0xfe, 0x6d, 0x40,
-	0xf7, 0x83, 0x84, 0xfa, 0xd8, 0xac, 0x5f, 0x12, 0xe3, 0xb2, 0x0f, 0x6b, 0xcc, 0xf7, 0x61, 0x37,
-	0xa1, 0xea, 0xb7, 0x35, 0xca, 0xb5, 0x06, 0xbc, 0xd6, 0x48, 0x37, 0x67, 0x1b, 0xe9, 0x5b, 0x60,
-	0x05, 0xf2, 0x40, 0x5e, 0x4a, 0xc5, 0xb1, 0x2a, 0x4c, 0x5d, 0x17, 0x50, 0x74, 0x20, 0x25, 0xb2,
-	0xd3, 0x2e, 0x0c, 0xb0, 0xd3, 0x5e, 0xba, 0x70, 0xa7, 0xad, 0x17, 0xc1, 0x4e, 0xfb, 0xaf, 0x0d,
-	0xb0, 0x35, 0xe7, 0xaa, 0xc7, 0xc6, 0x0f, 0x53, 0x1f, 0xdf, 0x3c, 0x6f, 0x42, 0xb7, 0xe4, 0xa3,
-	0x7e, 0xeb, 0xab, 0x04, 0x12, 0xd7, 0x3d, 0x16, 0x25, 0xd9, 0xf4, 0x30, 0xf8, 0x84, 0x69, 0xc7,
-	0x6b, 0x12, 0xe9, 0xdb, 0x7e, 0x1e, 0xb9, 0xc9, 0x53, 0xae, 0xcb, 0x72, 0x31, 0x94, 0xbe, 0x8d,
-	0xf1, 0xf7, 0x11, 0xd6, 0x31, 0xf4, 0xbc, 0xe9, 0x82, 0x12, 0xc9, 0xfa, 0x45, 0x36, 0xa0, 0xc3,
-	0x62, 0x5f, 0x69, 0x5b, 0xa8, 0x6d, 0xb3, 0xd8, 0x47, 0xd5, 0x10, 0x56, 0xf4, 0x23, 0x63, 0xc2,
-	0x91, 0x04, 0x48, 0x2a, 0x6b, 0xc7, 0x39, 0xe3, 0x65, 0x77, 0x8f, 0x4f, 0x0e, 0xb4, 0xa5, 0xbb,
-	0xac, 0xde, 0x19, 0xf5, 0x90, 0xbc, 0x0f, 0x3d, 0xb9, 0x4b, 0xb9, 0x50, 0xfb, 0xc2, 0x0b, 0x59,
-	0x2c, 0xf6, 0x8b, 0x81, 0xf3, 0x1b, 0x03, 0x56, 0x4f, 0x41, 0x78, 0x09, 0x1e, 0x3d, 0x82, 0xce,
-	0x21, 0x9b, 0xc8, 0x25, 0x8a, 0xa7, 0xd3, 0xed, 0xb3, 0x5e, 0xe2, 0xcf, 0x08, 0x98, 0x5b, 0x2e,
-	0xe0, 0xfc, 0xcc, 0x00, 0x40, 0x42, 0xe3, 0xf0, 0x14, 0x59, 0x8c, 0xcb, 0x90, 0x45, 0xde, 0x84,
-	0xb2, 0x3d, 0xc8, 0x58, 0x48, 0x45, 0x55, 0xc9, 0xb8, 0x8e, 0x3d, 0x89, 0xf3, 0xc8, 0x55, 0x2a,
-	0x7d, 0x40, 0xee, 0xfc, 0xca, 0x00, 0xc0, 0x52, 0xac, 0x8e, 0x31, 0x9f, 0xf3, 0xc6, 0xf9, 0xbf,
-	0x2d, 0x1b, 0xb3, 0x29, 0xf1, 0xa0, 0x48, 0x09, 0x8e, 0x18, 0x99, 0x8b, 0x7c, 0x28, 0x31, 0xaa,
-	0x9c, 0xd7, 0x59, 0xa3, 0x70, 0xf9, 0xad, 0x01, 0xbd, 0x1a, 0x7c, 0x7c, 0x36, 0x7b, 0x8d, 0xf9,
-	0xec, 0xc5, 0xc6, 0x51, 0x32, 0xda, 0xe3, 0x35, 0x92, 0x47, 0x15, 0xc9, 0x37, 0xa0, 0x83, 0x90,
-	0xd4, 0x58, 0x1e, 0x6b, 0x96, 0xdf, 0x86, 0xd5, 0x8c, 0x8d, 0x59, 0x2c, 0xc2, 0xa9, 0x17, 0x25,
-	0x7e, 0x70, 0x14, 0x30, 0x1f, 0xb9, 0xde, 0x71, 0xfb, 0x85, 0x62, 0x4f, 0xcb, 0x9d, 0xbf, 0x1b,
-	0xb0, 0x22, 0x7b, 0xcd, 0xe9, 0x7e, 0xe2, 0x33, 0x75, 0xb2, 0x17, 0x67, 0xd0, 0x7b, 0xe8, 0x8b,
-	0x86, 0x47, 0x51, 0xe8, 0x8d, 0xe7, 0x53, 0x88, 0xbb, 0x1d, 0xae, 0x69, 0x23, 0x21, 0x56, 0xef,
-	0x05, 0x17, 0x81, 0xb8, 0x0a, 0xac, 0xbe, 0x64, 0x15, 0xc4, 0x3f, 0x31, 0xc0, 0xaa, 0x25, 0x8b,
-	0x2c, 0xd1, 0xfa, 0x62, 0x54, 0x37, 0x84, 0x81, 0x45, 0xd0, 0x1a, 0x57, 0x6f, 0xb9, 0x64, 0x1d,
-	0x5a, 0x11, 0x9f, 0xe8, 0x88, 0xf7, 0x5c, 0x35, 0x20, 0xd7, 0xa1, 0x13, 0xf1, 0x09, 0xfe, 0xac,
-	0xd2, 0x95, 0xb3, 0x1c, 0xcb, 0xb0, 0x55, 0x3d, 0x90, 0x2a, 0x20, 0x95, 0xc0, 0xf9, 0xa3, 0x01,
-	0x44, 0xb7, 0x18, 0x9f, 0xea, 0xc1, 0x1f, 0x09, 0x5b, 0x7f, 0x8f, 0x6e, 0x60, 0x19, 0x9e, 0x91,
-	0xcd, 0xdd, 0x2f, 0xe6, 0xa9, 0x5f, 0xda, 0xb7, 0x61, 0xd5, 0x67, 0x47, 0x54, 0x76, 0x43, 0xf3,
-	0x47, 0xee, 0x6b, 0x45, 0xd9, 0xb4, 0xbd, 0xf5, 0x2e, 0x74, 0xcb, 0xff, 0xd9, 0x48, 0x1f, 0x7a,
-	0xc3, 0x38, 0x10, 0xd8, 0x5e, 0x06, 0xf1, 0xa4, 0xff, 0x7f, 0xc4, 0x82, 0xf6, 0xf7, 0x18, 0x0d,
-	0xc5, 0xf1, 0xb4, 0x6f, 0x90, 0x1e, 0x74, 0xee, 0x8f, 0xe2, 0x24, 0x8b, 0x68, 0xd8, 0x6f, 0x3c,
-	0x78, 0xe7, 0x47, 0xdf, 0x98, 0x04, 0xe2, 0x38, 0x1f, 0x49, 0x4f, 0xb6, 0x95, 0x6b, 0x5f, 0x0d,
-	0x12, 0xfd, 0xb5, 0x5d, 0x44, 0x6d, 0x1b, 0xbd, 0x2d, 0x87, 0xe9, 0x68, 0xb4, 0x84, 0x92, 0xb7,
-	0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x62, 0xb9, 0xd2, 0xdf, 0x8d, 0x1c, 0x00, 0x00,
+	// 2401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xcf, 0x72, 0x29, 0x91, 0xfc, 0x48, 0xd1, 0xd4, 0xc8, 0x76, 0xd6, 0xaf, 0x58, 0xd9, 0xa4,
+	0xad, 0x6a, 0x23, 0xb6, 0xab, 0x34, 0x0f, 0x14, 0x41, 0x1d, 0x59, 0x74, 0x5c, 0xc2, 0x91, 0xa3,
+	0xae, 0x9c, 0x00, 0xe9, 0x65, 0x31, 0xdc, 0x1d, 0x51, 0x5b, 0xef, 0x2b, 0x33, 0x4b, 0x59, 0xcc,
+	0x29, 0x87, 0x9c, 0x9a, 0xb6, 0x40, 0x0b, 0xe4, 0xd8, 0xfe, 0x09, 0xbd, 0xf6, 0xd6, 0x16, 0x3d,
+	0xf5, 0xd4, 0x7b, 0xff, 0x93, 0xa2, 0xa7, 0x62, 0xbe, 0x99, 0x7d, 0x90, 0xa2, 0x1e, 0x96, 0x91,
+	0xc6, 0x05, 0x72, 0xdb, 0xf9, 0x7d, 0xf3, 0xfc, 0x7d, 0xbf, 0xf9, 0xe6, 0x9b, 0x59, 0xe8, 0x06,
+	0x71, 0xc6, 0x78, 0x4c, 0xc3, 0x5b, 0x29, 0x4f, 0xb2, 0x84, 0x5c, 0x88, 0x82, 0x70, 0x7f, 0x2c,
+	0x54, 0xe9, 0x56, 0x6e, 0xbc, 0xdc, 0xf1, 0x92, 0x28, 0x4a, 0x62, 0x05, 0x5f, 0xee, 0x08, 0x6f,
+	0x8f, 0x45, 0x54, 0x95, 0xec, 0xbf, 0x18, 0xb0, 0xb4, 0x99, 0x44, 0x69, 0x12, 0xb3, 0x38, 0x1b,
+	0xc4, 0xbb, 0x09, 0xb9, 0x08, 0x8b, 0x71, 0xe2, 0xb3, 0x41, 0xdf, 0x32, 0x56, 0x8d, 0x35, 0xd3,
+	0xd1, 0x25, 0x42, 0xa0, 0xce, 0x93, 0x90, 0x59, 0xb5, 0x55, 0x63, 0xad, 0xe5, 0xe0, 0x37, 0xb9,
+	0x0b, 0x20, 0x32, 0x9a, 0x31, 0xd7, 0x4b, 0x7c, 0x66, 0x99, 0xab, 0xc6, 0x5a, 0x77, 0x7d, 0xf5,
+	0xd6, 0xdc, 0x59, 0xdc, 0xda, 0x91, 0x15, 0x37, 0x13, 0x9f, 0x39, 0x2d, 0x91, 0x7f, 0x92, 0xf7,
+	0x01, 0xd8, 0x41, 0xc6, 0xa9, 0x1b, 0xc4, 0xbb, 0x89, 0x55, 0x5f, 0x35, 0xd7, 0xda, 0xeb, 0xaf,
+	0x4e, 0x77, 0xa0, 0x27, 0xff, 0x90, 0x4d, 0x3e, 0xa1, 0xe1, 0x98, 0x6d, 0xd3, 0x80, 0x3b, 0x2d,
+	0x6c, 0x24, 0xa7, 0x6b, 0xff, 0xcb, 0x80, 0x73, 0xc5, 0x02, 0x70, 0x0c, 0x41, 0x7e, 0x02, 0x0b,
+	0x38, 0x04, 0xae, 0xa0, 0xbd, 0xfe, 0xfa, 0x11, 0x33, 0x9a, 0x5a, 0xb7, 0xa3, 0x9a, 0x90, 0x8f,
+	0x61, 0x45, 0x8c, 0x87, 0x5e, 0x6e, 0x72, 0x11, 0x15, 0x56, 0x6d, 0xd5, 0x3c, 0x75, 0x4f, 0xa4,
+	0xda, 0x81, 0x9e, 0xd2, 0x9b, 0xb0, 0x28, 0x7b, 0x1a, 0x0b, 0x64, 0xa9, 0xbd, 0x7e, 0x65, 0xee,
+	0x22, 0x77, 0xb0, 0x8a, 0xa3, 0xab, 0xda, 0x57, 0xe0, 0xd2, 0x03, 0x96, 0xcd, 0xac, 0xce, 0x61,
+	0x9f, 0x8d, 0x99, 0xc8, 0xb4, 0xf1, 0x71, 0x10, 0xb1, 0xc7, 0x81, 0xf7, 0x64, 0x73, 0x8f, 0xc6,
+	0x31, 0x0b, 0x73, 0xe3, 0x35, 0xb8, 0xf2, 0x80, 0x61, 0x83, 0x40, 0x64, 0x81, 0x27, 0x66, 0xcc,
+	0x17, 0x60, 0xe5, 0x01, 0xcb, 0xfa, 0xfe, 0x0c, 0xfc, 0x09, 0x34, 0x1f, 0x49, 0x67, 0x4b, 0x19,
+	0xbc, 0x0d, 0x0d, 0xea, 0xfb, 0x9c, 0x09, 0xa1, 0x59, 0xbc, 0x3a, 0x77, 0xc6, 0x1b, 0xaa, 0x8e,
+	0x93, 0x57, 0x9e, 0x27, 0x13, 0xfb, 0x97, 0x00, 0x83, 0x38, 0xc8, 0xb6, 0x29, 0xa7, 0x91, 0x38,
+	0x52, 0x60, 0x7d, 0xe8, 0x88, 0x8c, 0xf2, 0xcc, 0x4d, 0xb1, 0x9e, 0x55, 0x3b, 0xad, 0x1a, 0xda,
+	0xd8, 0x4c, 0xf5, 0x6e, 0x7f, 0x0a, 0xb0, 0x93, 0xf1, 0x20, 0x1e, 0x7d, 0x18, 0x88, 0x4c, 0x8e,
+	0xb5, 0x2f, 0xeb, 0xc9, 0x45, 0x98, 0x6b, 0x2d, 0x47, 0x97, 0x2a, 0xee, 0xa8, 0x9d, 0xde, 0x1d,
+	0x77, 0xa1, 0x9d, 0xd3, 0xbd, 0x25, 0x46, 0xe4, 0x0e, 0xd4, 0x87, 0x54, 0xb0, 0x63, 0xe9, 0xd9,
+	0x12, 0xa3, 0x7b, 0x54, 0x30, 0x07, 0x6b, 0xda, 0xbf, 0x32, 0xe1, 0xe5, 0x4d, 0xce, 0x50, 0xfc,
+	0x61, 0xc8, 0xbc, 0x2c, 0x48, 0x62, 0xcd, 0xfd, 0xb3, 0xf7, 0x46, 0x5e, 0x86, 0x86, 0x3f, 0x74,
+	0x63, 0x1a, 0xe5, 0x64, 0x2f, 0xfa, 0xc3, 0x47, 0x34, 0x62, 0xe4, 0xfb, 0xd0, 0xf5, 0x8a, 0xfe,
+	0x25, 0x82, 0x9a, 0x6b, 0x39, 0x33, 0x28, 0x79, 0x1d, 0x96, 0x52, 0xca, 0xb3, 0xa0, 0xa8, 0x56,
+	0xc7, 0x6a, 0xd3, 0xa0, 0x74, 0xa8, 0x3f, 0x1c, 0xf4, 0xad, 0x05, 0x74, 0x16, 0x7e, 0x13, 0x1b,
+	0x3a, 0x65, 0x5f, 0x83, 0xbe, 0xb5, 0x88, 0xb6, 0x29, 0x8c, 0xac, 0x42, 0xbb, 0xe8, 0x68, 0xd0,
+	0xb7, 0x1a, 0x58, 0xa5, 0x0a, 0x49, 0xe7, 0xa8, 0x58, 0x64, 0x35, 0x57, 0x8d, 0xb5, 0x8e, 0xa3,
+	0x4b, 0xe4, 0x0e, 0xac, 0xec, 0x07, 0x3c, 0x1b, 0xd3, 0x50, 0xeb, 0x53, 0xce, 0x43, 0x58, 0x2d,
+	0xf4, 0xe0, 0x3c, 0x13, 0x59, 0x87, 0xf3, 0xe9, 0xde, 0x44, 0x04, 0xde, 0x4c, 0x13, 0xc0, 0x26,
+	0x73, 0x6d, 0xf6, 0xdf, 0x0d, 0xb8, 0xd0, 0xe7, 0x49, 0xfa, 0x42, 0xb8, 0x22, 0x27, 0xb9, 0x7e,
+	0x0c, 0xc9, 0x0b, 0x87, 0x49, 0xb6, 0x7f, 0x53, 0x83, 0x8b, 0x4a, 0x51, 0xdb, 0x39, 0xb1, 0xdf,
+	0xc0, 0x2a, 0x7e, 0x00, 0xe7, 0xca, 0x51, 0xdd, 0xf8, 0xe8, 0x65, 0x7c, 0x0f, 0xba, 0x85, 0x83,
+	0x55, 0xbd, 0xff, 0xad, 0xa4, 0xec, 0xaf, 0x6a, 0x70, 0x5e, 0x3a, 0xf5, 0x3b, 0x36, 0x24, 0x1b,
+	0x7f, 0x34, 0x80, 0x28, 0x75, 0x6c, 0x84, 0x01, 0x15, 0xdf, 0x26, 0x17, 0xe7, 0x61, 0x81, 0xca,
+	0x39, 0x68, 0x0a, 0x54, 0xc1, 0x16, 0xd0, 0x93, 0xde, 0xfa, 0xa6, 0x66, 0x57, 0x0c, 0x6a, 0x56,
+	0x07, 0xfd, 0x83, 0x01, 0xcb, 0x1b, 0x61, 0xc6, 0xf8, 0x0b, 0x4a, 0xca, 0x5f, 0x6b, 0xb9, 0xd7,
+	0x06, 0xb1, 0xcf, 0x0e, 0xbe, 0xcd, 0x09, 0x5e, 0x03, 0xd8, 0x0d, 0x58, 0xe8, 0x57, 0xd5, 0xdb,
+	0x42, 0xe4, 0xb9, 0x94, 0x6b, 0x41, 0x03, 0x3b, 0x29, 0x54, 0x9b, 0x17, 0x65, 0x0e, 0xa0, 0xf2,
+	0x41, 0x9d, 0x03, 0x34, 0x4f, 0x9d, 0x03, 0x60, 0x33, 0x9d, 0x03, 0xfc, 0xc9, 0x84, 0xa5, 0x41,
+	0x2c, 0x18, 0xcf, 0xce, 0x4e, 0xde, 0x55, 0x68, 0x89, 0x3d, 0xca, 0xfd, 0x47, 0x25, 0x7d, 0x25,
+	0x50, 0xa5, 0xd6, 0x3c, 0x89, 0xda, 0xfa, 0x29, 0x83, 0xc3, 0xc2, 0x71, 0xc1, 0x61, 0xf1, 0x18,
+	0x8a, 0x1b, 0x27, 0x07, 0x87, 0xe6, 0xe1, 0xd3, 0x57, 0x2e, 0x90, 0x8d, 0x22, 0x99, 0xb4, 0xf6,
+	0xad, 0x16, 0xda, 0x4b, 0x80, 0xbc, 0x02, 0x90, 0x05, 0x11, 0x13, 0x19, 0x8d, 0x52, 0x75, 0x8e,
+	0xd6, 0x9d, 0x0a, 0x22, 0xcf, 0x6e, 0x9e, 0x3c, 0x1d, 0xf4, 0x85, 0xd5, 0x5e, 0x35, 0x65, 0x12,
+	0xa7, 0x4a, 0xe4, 0xc7, 0xd0, 0xe4, 0xc9, 0x53, 0xd7, 0xa7, 0x19, 0xb5, 0x3a, 0xe8, 0xbc, 0x4b,
+	0x73, 0xc9, 0xbe, 0x17, 0x26, 0x43, 0xa7, 0xc1, 0x93, 0xa7, 0x7d, 0x9a, 0x51, 0xfb, 0xdf, 0x26,
+	0x2c, 0xed, 0x30, 0xca, 0xbd, 0xbd, 0xb3, 0x3b, 0xec, 0x87, 0xd0, 0xe3, 0x4c, 0x8c, 0xc3, 0xcc,
+	0xf5, 0xd4, 0x31, 0x3f, 0xe8, 0x6b, 0xbf, 0x9d, 0x53, 0xf8, 0x66, 0x0e, 0x17, 0xa4, 0x9a, 0xc7,
+	0x90, 0x5a, 0x9f, 0x43, 0xaa, 0x0d, 0x9d, 0x0a, 0x83, 0xc2, 0x5a, 0xc0, 0xa5, 0x4f, 0x61, 0xa4,
+	0x07, 0xa6, 0x2f, 0x42, 0xf4, 0x57, 0xcb, 0x91, 0x9f, 0xe4, 0x26, 0x2c, 0xa7, 0x21, 0xf5, 0xd8,
+	0x5e, 0x12, 0xfa, 0x8c, 0xbb, 0x23, 0x9e, 0x8c, 0x53, 0xf4, 0x59, 0xc7, 0xe9, 0x55, 0x0c, 0x0f,
+	0x24, 0x4e, 0xde, 0x81, 0xa6, 0x2f, 0x42, 0x37, 0x9b, 0xa4, 0x0c, 0x9d, 0xd6, 0x3d, 0x62, 0xed,
+	0x7d, 0x11, 0x3e, 0x9e, 0xa4, 0xcc, 0x69, 0xf8, 0xea, 0x83, 0xdc, 0x81, 0xf3, 0x82, 0xf1, 0x80,
+	0x86, 0xc1, 0xe7, 0xcc, 0x77, 0xd9, 0x41, 0xca, 0xdd, 0x34, 0xa4, 0x31, 0x7a, 0xb6, 0xe3, 0x90,
+	0xd2, 0x76, 0xff, 0x20, 0xe5, 0xdb, 0x21, 0x8d, 0xc9, 0x1a, 0xf4, 0x92, 0x71, 0x96, 0x8e, 0x33,
+	0x17, 0x77, 0x9f, 0x70, 0x03, 0x1f, 0x1d, 0x6d, 0x3a, 0x5d, 0x85, 0x7f, 0x80, 0xf0, 0xc0, 0x97,
+	0xd4, 0x66, 0x9c, 0xee, 0xb3, 0xd0, 0x2d, 0x14, 0x60, 0xb5, 0x57, 0x8d, 0xb5, 0xba, 0x73, 0x4e,
+	0xe1, 0x8f, 0x73, 0x98, 0xdc, 0x86, 0x95, 0xd1, 0x98, 0x72, 0x1a, 0x67, 0x8c, 0x55, 0x6a, 0x77,
+	0xb0, 0x36, 0x29, 0x4c, 0x45, 0x03, 0xfb, 0x77, 0xf5, 0xd2, 0xf5, 0xd2, 0x4b, 0xe2, 0x0c, 0xae,
+	0x3f, 0x4b, 0x36, 0x3f, 0x57, 0x2f, 0xe6, 0x7c, 0xbd, 0x5c, 0x87, 0x76, 0xc4, 0x32, 0x1e, 0x78,
+	0xca, 0x2f, 0x6a, 0x43, 0x83, 0x82, 0x90, 0xfc, 0xeb, 0xd0, 0x8e, 0xc7, 0x91, 0xfb, 0xd9, 0x98,
+	0xf1, 0x80, 0x09, 0x1d, 0x0f, 0x21, 0x1e, 0x47, 0x3f, 0x57, 0x08, 0x59, 0x81, 0x85, 0x2c, 0x49,
+	0xdd, 0x27, 0xf9, 0x3e, 0xce, 0x92, 0xf4, 0x21, 0x79, 0x0f, 0x2e, 0x0b, 0x46, 0x43, 0xe6, 0xbb,
+	0xc5, 0xbe, 0x13, 0xae, 0x40, 0x2e, 0x98, 0x6f, 0x35, 0xd0, 0x15, 0x96, 0xaa, 0xb1, 0x53, 0x54,
+	0xd8, 0xd1, 0x76, 0xc9, 0x74, 0x31, 0xf1, 0x4a, 0xb3, 0x26, 0xa6, 0xbc, 0xa4, 0x34, 0x15, 0x0d,
+	0xde, 0x05, 0x6b, 0x14, 0x26, 0x43, 0x1a, 0xba, 0x87, 0x46, 0xc5, 0xdc, 0xda, 0x74, 0x2e, 0x2a,
+	0xfb, 0xce, 0xcc, 0x90, 0x72, 0x79, 0x22, 0x0c, 0x3c, 0xe6, 0xbb, 0xc3, 0x30, 0x19, 0x5a, 0x80,
+	0x92, 0x02, 0x05, 0xc9, 0x8d, 0x2c, 0xa5, 0xa4, 0x2b, 0x48, 0x1a, 0xbc, 0x64, 0x1c, 0x67, 0x28,
+	0x10, 0xd3, 0xe9, 0x2a, 0xfc, 0xd1, 0x38, 0xda, 0x94, 0x28, 0x79, 0x0d, 0x96, 0x74, 0xcd, 0x64,
+	0x77, 0x57, 0xb0, 0x0c, 0x95, 0x61, 0x3a, 0x1d, 0x05, 0x7e, 0x84, 0x98, 0xfd, 0xcf, 0x3a, 0x9c,
+	0x73, 0x24, 0xbb, 0x6c, 0x9f, 0xfd, 0xdf, 0x07, 0x84, 0xa3, 0x36, 0xe6, 0xe2, 0x33, 0x6d, 0xcc,
+	0xc6, 0xa9, 0x37, 0x66, 0xf3, 0x99, 0x36, 0x66, 0xeb, 0xa8, 0x8d, 0x29, 0x93, 0x93, 0x30, 0x88,
+	0x82, 0x0c, 0xdd, 0x6d, 0x3a, 0xaa, 0x80, 0x73, 0xe3, 0x32, 0x8c, 0x0d, 0x27, 0x6e, 0x7e, 0x86,
+	0x6b, 0x4f, 0x23, 0x7e, 0x6f, 0xf2, 0x81, 0x42, 0xe5, 0x09, 0xe2, 0x33, 0xe1, 0xb1, 0xd8, 0x0f,
+	0xe2, 0x11, 0xba, 0xb9, 0xe9, 0x54, 0x10, 0xf9, 0xf4, 0x43, 0x47, 0x23, 0xce, 0x46, 0xf8, 0xbe,
+	0xb2, 0x84, 0x67, 0xc5, 0x51, 0x6f, 0x47, 0x1b, 0x79, 0x45, 0xa7, 0xd2, 0x46, 0xce, 0x05, 0x83,
+	0x69, 0x75, 0x2e, 0x5d, 0x35, 0x17, 0xc4, 0x8b, 0xb9, 0xd8, 0x9f, 0x42, 0xab, 0xe8, 0x82, 0xac,
+	0x43, 0x2d, 0x49, 0x51, 0x47, 0xdd, 0x75, 0xfb, 0xa4, 0x01, 0x3f, 0x4a, 0x9d, 0x5a, 0x92, 0x56,
+	0x33, 0x96, 0xda, 0x54, 0xc6, 0x62, 0xff, 0x7a, 0x4a, 0xab, 0x2f, 0x6a, 0x04, 0xbb, 0x01, 0x66,
+	0xe0, 0xab, 0x3c, 0xb3, 0xbd, 0x6e, 0x4d, 0x77, 0xae, 0xdf, 0x03, 0x07, 0x7d, 0xe1, 0xc8, 0x4a,
+	0xe4, 0x2e, 0xb4, 0xb5, 0xee, 0xf0, 0x14, 0x5f, 0x40, 0xcf, 0xbc, 0x32, 0xb7, 0x0d, 0xf2, 0x2b,
+	0x4f, 0x70, 0x47, 0xe5, 0x89, 0x42, 0x7e, 0x93, 0x9f, 0xc2, 0x95, 0xc3, 0x71, 0x8d, 0x6b, 0x8e,
+	0x7c, 0x6b, 0x11, 0xa5, 0x7c, 0x69, 0x36, 0xb0, 0xe5, 0x24, 0xfa, 0xe4, 0x47, 0x70, 0xbe, 0x12,
+	0xd9, 0xca, 0x86, 0x0d, 0xf5, 0x00, 0x50, 0xda, 0xca, 0x26, 0xc7, 0xc5, 0xb6, 0xe6, 0xb1, 0xb1,
+	0x6d, 0x0b, 0x48, 0x21, 0x29, 0x17, 0x37, 0x2e, 0x0d, 0x55, 0x3c, 0x3c, 0x79, 0xd1, 0xcb, 0x45,
+	0xcb, 0x6d, 0xdd, 0xd0, 0xfe, 0xda, 0x84, 0xa5, 0x3e, 0x0b, 0x59, 0xc6, 0xbe, 0x4b, 0x3d, 0x8f,
+	0x4c, 0x3d, 0x5f, 0x85, 0x4e, 0xca, 0x83, 0x88, 0xf2, 0x89, 0xfb, 0x84, 0x4d, 0xf2, 0xd3, 0xa7,
+	0xad, 0xb1, 0x87, 0x6c, 0x22, 0x4e, 0xcc, 0x3f, 0x6f, 0xc1, 0x8a, 0xc0, 0x67, 0x3e, 0x77, 0xaa,
+	0xa7, 0x36, 0x4a, 0x64, 0x59, 0x99, 0xb6, 0xcb, 0xfe, 0xec, 0xff, 0x18, 0xd0, 0xfa, 0x30, 0xa1,
+	0x3e, 0x5e, 0xa9, 0xce, 0xe8, 0x93, 0x22, 0x5b, 0xae, 0xcd, 0x66, 0xcb, 0x57, 0xa1, 0xbc, 0x15,
+	0x69, 0xaf, 0x94, 0x40, 0x35, 0x78, 0xd4, 0xa7, 0xaf, 0x3b, 0xd7, 0xa1, 0x1d, 0xc8, 0x09, 0xb9,
+	0x29, 0xcd, 0xf6, 0xd4, 0xf1, 0xd1, 0x72, 0x00, 0xa1, 0x6d, 0x89, 0xc8, 0xfb, 0x50, 0x5e, 0x01,
+	0xef, 0x43, 0x8b, 0xa7, 0xbe, 0x0f, 0xe9, 0x4e, 0xf0, 0x3e, 0xf4, 0xb7, 0x1a, 0x58, 0x5a, 0xf2,
+	0xe5, 0x93, 0xf0, 0xc7, 0xa9, 0x8f, 0x51, 0xf4, 0x2a, 0xb4, 0x8a, 0xed, 0xa0, 0x5f, 0x64, 0x4b,
+	0x40, 0xfa, 0x61, 0x8b, 0x45, 0x09, 0x9f, 0xec, 0x04, 0x9f, 0x33, 0xbd, 0xf0, 0x0a, 0x22, 0xd7,
+	0xf6, 0x68, 0x1c, 0x39, 0xc9, 0x53, 0xa1, 0x0f, 0xcf, 0xbc, 0x28, 0xd7, 0xe6, 0xe1, 0x2d, 0x16,
+	0x4f, 0x1b, 0x5c, 0x79, 0xdd, 0x01, 0x05, 0xc9, 0x53, 0x86, 0x5c, 0x82, 0x26, 0x8b, 0x7d, 0x65,
+	0x5d, 0x40, 0x6b, 0x83, 0xc5, 0x3e, 0x9a, 0x06, 0xd0, 0xd5, 0x4f, 0xc1, 0x89, 0x40, 0xd1, 0xa0,
+	0x08, 0xdb, 0x47, 0x86, 0xeb, 0x2d, 0x31, 0xda, 0xd6, 0x35, 0x9d, 0x25, 0xf5, 0x1a, 0xac, 0x8b,
+	0xe4, 0x3e, 0x74, 0xe4, 0x28, 0x45, 0x47, 0x8d, 0x53, 0x77, 0xd4, 0x66, 0xb1, 0x9f, 0x17, 0xec,
+	0xdf, 0x1b, 0xb0, 0x7c, 0x88, 0xc2, 0x33, 0xe8, 0xe8, 0x21, 0x34, 0x77, 0xd8, 0x48, 0x76, 0x91,
+	0x3f, 0x70, 0xdf, 0x3e, 0xea, 0x7f, 0xc9, 0x11, 0x0e, 0x73, 0x8a, 0x0e, 0xec, 0x2f, 0x0d, 0xf9,
+	0xb0, 0xee, 0xb3, 0x03, 0x2c, 0x1e, 0x12, 0x8b, 0x71, 0x16, 0xb1, 0xc8, 0x7c, 0x45, 0x26, 0x71,
+	0x9c, 0x85, 0x34, 0x2b, 0x03, 0xa9, 0xd0, 0xbe, 0x27, 0xf1, 0x38, 0x72, 0x94, 0x49, 0x4f, 0x50,
+	0xd8, 0xbf, 0x35, 0x00, 0x30, 0x28, 0xaa, 0x69, 0xcc, 0xc6, 0x08, 0xe3, 0xf8, 0x17, 0x80, 0xe9,
+	0xf3, 0x94, 0xdc, 0xcb, 0xb7, 0x84, 0x40, 0x8e, 0xcc, 0x79, 0x6b, 0x28, 0x38, 0x2a, 0x17, 0xaf,
+	0x77, 0x8d, 0xe2, 0xe5, 0x6b, 0x03, 0x3a, 0x15, 0xfa, 0xc4, 0xf4, 0xee, 0x35, 0x66, 0x77, 0x2f,
+	0xa6, 0xf7, 0x52, 0xd1, 0xae, 0xa8, 0x88, 0x3c, 0x2a, 0x45, 0x7e, 0x09, 0x9a, 0x48, 0x49, 0x45,
+	0xe5, 0xb1, 0x56, 0xf9, 0x4d, 0x58, 0xe6, 0xcc, 0x63, 0x71, 0x16, 0x4e, 0xdc, 0x28, 0xf1, 0x83,
+	0xdd, 0x80, 0xf9, 0xa8, 0xf5, 0xa6, 0xd3, 0xcb, 0x0d, 0x5b, 0x1a, 0xb7, 0xff, 0x61, 0x40, 0x57,
+	0xde, 0x08, 0x26, 0xf2, 0x2f, 0x8b, 0x9a, 0xd9, 0xb3, 0x2b, 0xe8, 0x7d, 0x5c, 0x8b, 0x2b, 0x2a,
+	0x12, 0x7a, 0xed, 0x64, 0x09, 0x09, 0xa7, 0x29, 0xb4, 0x6c, 0x24, 0xc5, 0xea, 0x55, 0xe7, 0x34,
+	0x14, 0x97, 0x8e, 0xd5, 0x67, 0xbc, 0xa2, 0xf8, 0x0b, 0x03, 0xda, 0x95, 0xcd, 0x22, 0x43, 0xba,
+	0x3e, 0x97, 0xd5, 0x89, 0x62, 0x60, 0x10, 0x6c, 0x7b, 0xe5, 0x8b, 0xbb, 0x4c, 0x28, 0x23, 0x31,
+	0xd2, 0x1e, 0xef, 0x38, 0xaa, 0x40, 0x2e, 0x43, 0x33, 0x12, 0x23, 0xbc, 0xfc, 0xea, 0xc8, 0x59,
+	0x94, 0xa5, 0xdb, 0xca, 0x4c, 0x55, 0x05, 0x90, 0x12, 0xb0, 0xff, 0x2c, 0x5f, 0x37, 0x55, 0xff,
+	0xcf, 0xf5, 0x5b, 0x06, 0x05, 0x5b, 0xfd, 0x6b, 0x50, 0xc3, 0x30, 0x3c, 0x85, 0xcd, 0x9c, 0x47,
+	0xe6, 0xa1, 0xf3, 0xe8, 0x26, 0x2c, 0xfb, 0x6c, 0x97, 0xca, 0x64, 0x6c, 0x76, 0xca, 0x3d, 0x6d,
+	0x28, 0xef, 0xbc, 0x5f, 0x1a, 0xd0, 0xdd, 0xe4, 0xcc, 0x67, 0xb1, 0x4c, 0x1a, 0xf0, 0x77, 0xdb,
+	0x65, 0x68, 0x8e, 0x05, 0xe3, 0x15, 0xee, 0x8a, 0x32, 0x79, 0x03, 0x08, 0x8b, 0x3d, 0x3e, 0x49,
+	0xe5, 0x7e, 0x4c, 0xa9, 0x10, 0x4f, 0x13, 0xee, 0xeb, 0xc4, 0x60, 0xb9, 0xb0, 0x6c, 0x6b, 0x83,
+	0xcc, 0x03, 0xc4, 0x1e, 0x5d, 0x7f, 0xeb, 0xed, 0xb2, 0xae, 0x7e, 0xdd, 0x53, 0x70, 0x5e, 0xd1,
+	0xbe, 0x0f, 0xcb, 0xf2, 0x27, 0xd9, 0x76, 0x12, 0x06, 0xde, 0xe4, 0xcc, 0xe9, 0x8a, 0xfd, 0x95,
+	0x01, 0xa4, 0xda, 0x8f, 0x48, 0x93, 0x78, 0x2a, 0xa5, 0x35, 0x4e, 0x9f, 0xd2, 0xca, 0xcc, 0x00,
+	0xbb, 0xc1, 0x1f, 0xc2, 0xb9, 0x2b, 0xda, 0x0a, 0x93, 0x44, 0x09, 0xf9, 0x26, 0x29, 0x99, 0x71,
+	0x79, 0x12, 0x32, 0xe5, 0x89, 0x96, 0xd3, 0x92, 0x88, 0x23, 0x01, 0xfb, 0x0b, 0x13, 0x96, 0x71,
+	0x8f, 0x0d, 0x32, 0xc6, 0x69, 0x96, 0x70, 0xa4, 0xf7, 0x34, 0x31, 0xe9, 0xf9, 0x9f, 0x4b, 0x09,
+	0xd4, 0xe5, 0xfd, 0x4e, 0xa7, 0x5d, 0xf8, 0x2d, 0x2f, 0xbc, 0x53, 0x97, 0x39, 0x7d, 0xc8, 0x77,
+	0xaa, 0x37, 0x39, 0x39, 0xc2, 0x74, 0x46, 0xa6, 0x4e, 0xfa, 0x96, 0xd3, 0x9d, 0x4a, 0xc9, 0xc4,
+	0xdc, 0x0b, 0x5f, 0x63, 0xfe, 0x85, 0xef, 0x1a, 0xc0, 0x90, 0x66, 0xde, 0x9e, 0x0a, 0x6a, 0x2a,
+	0x0b, 0x6b, 0x21, 0x82, 0x31, 0xad, 0x2a, 0xb8, 0xd6, 0x8c, 0xe0, 0xe4, 0x25, 0x9d, 0x79, 0x9c,
+	0x65, 0xae, 0x52, 0x0c, 0x5e, 0x01, 0x5b, 0x4e, 0x47, 0x81, 0x3b, 0x88, 0xc9, 0x10, 0x1e, 0x32,
+	0x2a, 0x58, 0x71, 0x01, 0xcc, 0x8b, 0x37, 0xde, 0x85, 0x56, 0xf1, 0xb3, 0x9f, 0xf4, 0xa0, 0x23,
+	0xff, 0xfd, 0xe2, 0x1d, 0x37, 0x88, 0x47, 0xbd, 0x97, 0x48, 0x1b, 0x1a, 0x3f, 0x63, 0x34, 0xcc,
+	0xf6, 0x26, 0x3d, 0x83, 0x74, 0xa0, 0xb9, 0x31, 0x8c, 0x13, 0x1e, 0xd1, 0xb0, 0x57, 0xbb, 0xf1,
+	0x1e, 0xb4, 0x2b, 0x37, 0x2f, 0xd2, 0x82, 0x05, 0x7c, 0x35, 0xe8, 0xbd, 0x44, 0x1a, 0x60, 0x6e,
+	0x05, 0x71, 0xcf, 0xc0, 0x0f, 0x7a, 0xd0, 0xab, 0xc9, 0x8f, 0x9d, 0x71, 0xd4, 0x33, 0xe5, 0xc7,
+	0xc6, 0xfe, 0xa8, 0x57, 0xbf, 0xf7, 0xce, 0x2f, 0xde, 0x1a, 0x05, 0xd9, 0xde, 0x78, 0x28, 0xc5,
+	0x75, 0x5b, 0xa9, 0xed, 0x8d, 0x20, 0xd1, 0x5f, 0xb7, 0xf3, 0x90, 0x76, 0x1b, 0x05, 0x58, 0x14,
+	0xd3, 0xe1, 0x70, 0x11, 0x91, 0x37, 0xff, 0x3b, 0x00, 0xb6, 0xe9, 0x40, 0xc0, 0x50, 0x21, 0x00,
+	0x00,
 }
@@ -58,21 +58,30 @@ func (ShowType) EnumDescriptor() ([]byte, []int) {
 type PlaceholderType int32
 
 const (
-	PlaceholderType_None         PlaceholderType = 0
-	PlaceholderType_BinaryVector PlaceholderType = 100
-	PlaceholderType_FloatVector  PlaceholderType = 101
+	PlaceholderType_None              PlaceholderType = 0
+	PlaceholderType_BinaryVector      PlaceholderType = 100
+	PlaceholderType_FloatVector       PlaceholderType = 101
+	PlaceholderType_Float16Vector     PlaceholderType = 102
+	PlaceholderType_BFloat16Vector    PlaceholderType = 103
+	PlaceholderType_SparseFloatVector PlaceholderType = 104
 )
 
 var PlaceholderType_name = map[int32]string{
 	0:   "None",
 	100: "BinaryVector",
 	101: "FloatVector",
+	102: "Float16Vector",
+	103: "BFloat16Vector",
+	104: "SparseFloatVector",
 }
 
 var PlaceholderType_value = map[string]int32{
-	"None":         0,
-	"BinaryVector": 100,
-	"FloatVector":  101,
+	"None":              0,
+	"BinaryVector":      100,
+	"FloatVector":       101,
+	"Float16Vector":     102,
+	"BFloat16Vector":    103,
+	"SparseFloatVector": 104,
 }
 
 func (x PlaceholderType) String() string {
@@ -83,6 +92,57 @@ func (PlaceholderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{1}
 }
 
+// unify operation for user and role
+type OperateUserRoleType int32
+
+const (
+	OperateUserRoleType_AddUserToRole      OperateUserRoleType = 0
+	OperateUserRoleType_RemoveUserFromRole OperateUserRoleType = 1
+)
+
+var OperateUserRoleType_name = map[int32]string{
+	0: "AddUserToRole",
+	1: "RemoveUserFromRole",
+}
+
+var OperateUserRoleType_value = map[string]int32{
+	"AddUserToRole":      0,
+	"RemoveUserFromRole": 1,
+}
+
+func (x OperateUserRoleType) String() string {
+	return proto.EnumName(OperateUserRoleType_name, int32(x))
+}
+
+func (OperateUserRoleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{2}
+}
+
+type OperatePrivilegeType int32
+
+const (
+	OperatePrivilegeType_Grant  OperatePrivilegeType = 0
+	OperatePrivilegeType_Revoke OperatePrivilegeType = 1
+)
+
+var OperatePrivilegeType_name = map[int32]string{
+	0: "Grant",
+	1: "Revoke",
+}
+
+var OperatePrivilegeType_value = map[string]int32{
+	"Grant":  0,
+	"Revoke": 1,
+}
+
+func (x OperatePrivilegeType) String() string {
+	return proto.EnumName(OperatePrivilegeType_name, int32(x))
+}
+
+func (OperatePrivilegeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{3}
+}
+
 type CreateAliasRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -264,6 +324,74 @@ func (m *AlterAliasRequest) GetAlias() string {
 	return ""
 }
 
+//*
+// Add a field to an existing collection, the field must be a nullable scalar field or have a default value.
+// The existing entities take the default value of the field, or null if the field has no default value.
+type AddCollectionFieldRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The name of the collection to add the field to.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The serialized `schema.FieldSchema` of the field to add.(Required)
+	Schema               []byte   `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCollectionFieldRequest) Reset()         { *m = AddCollectionFieldRequest{} }
+func (m *AddCollectionFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddCollectionFieldRequest) ProtoMessage()    {}
+func (*AddCollectionFieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{3}
+}
+
+func (m *AddCollectionFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCollectionFieldRequest.Unmarshal(m, b)
+}
+func (m *AddCollectionFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCollectionFieldRequest.Marshal(b, m, deterministic)
+}
+func (m *AddCollectionFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCollectionFieldRequest.Merge(m, src)
+}
+func (m *AddCollectionFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddCollectionFieldRequest.Size(m)
+}
+func (m *AddCollectionFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCollectionFieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCollectionFieldRequest proto.InternalMessageInfo
+
+func (m *AddCollectionFieldRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AddCollectionFieldRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AddCollectionFieldRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AddCollectionFieldRequest) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 //*
 // Create collection in milvus
 type CreateCollectionRequest struct {
@@ -277,7 +405,11 @@ type CreateCollectionRequest struct {
 	Schema []byte `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 	// Once set, no modification is allowed (Optional)
 	// https://github.com/milvus-io/milvus/issues/6690
-	ShardsNum            int32    `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	// The default consistency level of the search and query requests (Optional)
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// The number of partitions the rows are routed to by the partition key field (Optional)
+	NumPartitions        int64    `protobuf:"varint,7,opt,name=num_partitions,json=numPartitions,proto3" json:"num_partitions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -287,7 +419,7 @@ func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest
 func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateCollectionRequest) ProtoMessage()    {}
 func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{3}
+	return fileDescriptor_02345ba45cc0e303, []int{4}
 }
 
 func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -343,6 +475,20 @@ func (m *CreateCollectionRequest) GetShardsNum() int32 {
 	return 0
 }
 
+func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *CreateCollectionRequest) GetNumPartitions() int64 {
+	if m != nil {
+		return m.NumPartitions
+	}
+	return 0
+}
+
 //*
 // Drop collection in milvus, also will drop data in collection.
 type DropCollectionRequest struct {
@@ -361,7 +507,7 @@ func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
 func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*DropCollectionRequest) ProtoMessage()    {}
 func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{4}
+	return fileDescriptor_02345ba45cc0e303, []int{5}
 }
 
 func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -423,7 +569,7 @@ func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
 func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*HasCollectionRequest) ProtoMessage()    {}
 func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{5}
+	return fileDescriptor_02345ba45cc0e303, []int{6}
 }
 
 func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -484,7 +630,7 @@ func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
 func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
 func (*BoolResponse) ProtoMessage()    {}
 func (*BoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{6}
+	return fileDescriptor_02345ba45cc0e303, []int{7}
 }
 
 func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
@@ -531,7 +677,7 @@ func (m *StringResponse) Reset()         { *m = StringResponse{} }
 func (m *StringResponse) String() string { return proto.CompactTextString(m) }
 func (*StringResponse) ProtoMessage()    {}
 func (*StringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{7}
+	return fileDescriptor_02345ba45cc0e303, []int{8}
 }
 
 func (m *StringResponse) XXX_Unmarshal(b []byte) error {
@@ -588,7 +734,7 @@ func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionReq
 func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeCollectionRequest) ProtoMessage()    {}
 func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{8}
+	return fileDescriptor_02345ba45cc0e303, []int{9}
 }
 
 func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -666,17 +812,23 @@ type DescribeCollectionResponse struct {
 	// The aliases of this collection
 	Aliases []string `protobuf:"bytes,9,rep,name=aliases,proto3" json:"aliases,omitempty"`
 	// The message ID/posititon when collection is created
-	StartPositions       []*commonpb.KeyDataPair `protobuf:"bytes,10,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	StartPositions []*commonpb.KeyDataPair `protobuf:"bytes,10,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	// The id of the database which the collection belongs to
+	DbId int64 `protobuf:"varint,11,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
+	// The default consistency level of the search and query requests
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// The number of partitions the rows are routed to by the partition key field, 0 if there is no partition key
+	NumPartitions        int64    `protobuf:"varint,13,opt,name=num_partitions,json=numPartitions,proto3" json:"num_partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionResponse{} }
 func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeCollectionResponse) ProtoMessage()    {}
 func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{9}
+	return fileDescriptor_02345ba45cc0e303, []int{10}
 }
 
 func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
@@ -767,6 +919,27 @@ func (m *DescribeCollectionResponse) GetStartPositions() []*commonpb.KeyDataPair
 	return nil
 }
 
+func (m *DescribeCollectionResponse) GetDbId() int64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+func (m *DescribeCollectionResponse) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *DescribeCollectionResponse) GetNumPartitions() int64 {
+	if m != nil {
+		return m.NumPartitions
+	}
+	return 0
+}
+
 //*
 // Load collection data into query nodes, then you can do vector search on this collection.
 type LoadCollectionRequest struct {
@@ -785,7 +958,7 @@ func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
 func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadCollectionRequest) ProtoMessage()    {}
 func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{10}
+	return fileDescriptor_02345ba45cc0e303, []int{11}
 }
 
 func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -845,7 +1018,7 @@ func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionReque
 func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseCollectionRequest) ProtoMessage()    {}
 func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{11}
+	return fileDescriptor_02345ba45cc0e303, []int{12}
 }
 
 func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -905,7 +1078,7 @@ func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionSta
 func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
 func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{12}
+	return fileDescriptor_02345ba45cc0e303, []int{13}
 }
 
 func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -963,7 +1136,7 @@ func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionSt
 func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
 func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{13}
+	return fileDescriptor_02345ba45cc0e303, []int{14}
 }
 
 func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1020,7 +1193,7 @@ func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{}
 func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowCollectionsRequest) ProtoMessage()    {}
 func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{14}
+	return fileDescriptor_02345ba45cc0e303, []int{15}
 }
 
 func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1100,7 +1273,7 @@ func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse
 func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowCollectionsResponse) ProtoMessage()    {}
 func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{15}
+	return fileDescriptor_02345ba45cc0e303, []int{16}
 }
 
 func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
@@ -1183,7 +1356,7 @@ func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{}
 func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePartitionRequest) ProtoMessage()    {}
 func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{16}
+	return fileDescriptor_02345ba45cc0e303, []int{17}
 }
 
 func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1252,7 +1425,7 @@ func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
 func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*DropPartitionRequest) ProtoMessage()    {}
 func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{17}
+	return fileDescriptor_02345ba45cc0e303, []int{18}
 }
 
 func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1321,7 +1494,7 @@ func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
 func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*HasPartitionRequest) ProtoMessage()    {}
 func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{18}
+	return fileDescriptor_02345ba45cc0e303, []int{19}
 }
 
 func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1391,7 +1564,7 @@ func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
 func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadPartitionsRequest) ProtoMessage()    {}
 func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{19}
+	return fileDescriptor_02345ba45cc0e303, []int{20}
 }
 
 func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1461,7 +1634,7 @@ func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsReque
 func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleasePartitionsRequest) ProtoMessage()    {}
 func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+	return fileDescriptor_02345ba45cc0e303, []int{21}
 }
 
 func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1530,7 +1703,7 @@ func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStati
 func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
 func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+	return fileDescriptor_02345ba45cc0e303, []int{22}
 }
 
 func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1591,7 +1764,7 @@ func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStat
 func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
 func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
+	return fileDescriptor_02345ba45cc0e303, []int{23}
 }
 
 func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1650,7 +1823,7 @@ func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
 func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsRequest) ProtoMessage()    {}
 func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{23}
+	return fileDescriptor_02345ba45cc0e303, []int{24}
 }
 
 func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1738,7 +1911,7 @@ func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{}
 func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsResponse) ProtoMessage()    {}
 func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+	return fileDescriptor_02345ba45cc0e303, []int{25}
 }
 
 func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
@@ -1805,6 +1978,7 @@ type DescribeSegmentRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldID              int64             `protobuf:"varint,4,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1814,7 +1988,7 @@ func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{}
 func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentRequest) ProtoMessage()    {}
 func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+	return fileDescriptor_02345ba45cc0e303, []int{26}
 }
 
 func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -1856,11 +2030,19 @@ func (m *DescribeSegmentRequest) GetSegmentID() int64 {
 	return 0
 }
 
+func (m *DescribeSegmentRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 type DescribeSegmentResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	BuildID              int64            `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
 	EnableIndex          bool             `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	FieldID              int64            `protobuf:"varint,5,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1870,7 +2052,7 @@ func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse
 func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentResponse) ProtoMessage()    {}
 func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+	return fileDescriptor_02345ba45cc0e303, []int{27}
 }
 
 func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -1919,6 +2101,13 @@ func (m *DescribeSegmentResponse) GetEnableIndex() bool {
 	return false
 }
 
+func (m *DescribeSegmentResponse) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 type ShowSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -1932,7 +2121,7 @@ func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
 func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsRequest) ProtoMessage()    {}
 func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+	return fileDescriptor_02345ba45cc0e303, []int{28}
 }
 
 func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1986,7 +2175,7 @@ func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
 func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsResponse) ProtoMessage()    {}
 func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
+	return fileDescriptor_02345ba45cc0e303, []int{29}
 }
 
 func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2043,7 +2232,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+	return fileDescriptor_02345ba45cc0e303, []int{30}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2122,7 +2311,7 @@ func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
 func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexRequest) ProtoMessage()    {}
 func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+	return fileDescriptor_02345ba45cc0e303, []int{31}
 }
 
 func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2198,7 +2387,7 @@ func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
 func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
 func (*IndexDescription) ProtoMessage()    {}
 func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+	return fileDescriptor_02345ba45cc0e303, []int{32}
 }
 
 func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
@@ -2263,7 +2452,7 @@ func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
 func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexResponse) ProtoMessage()    {}
 func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+	return fileDescriptor_02345ba45cc0e303, []int{33}
 }
 
 func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -2313,7 +2502,7 @@ func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgr
 func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
 func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
 
 func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -2382,7 +2571,7 @@ func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProg
 func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
 func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
+	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
 
 func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -2439,7 +2628,7 @@ func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
 func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateRequest) ProtoMessage()    {}
 func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
+	return fileDescriptor_02345ba45cc0e303, []int{36}
 }
 
 func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2508,7 +2697,7 @@ func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
 func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateResponse) ProtoMessage()    {}
 func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
+	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
 func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -2565,7 +2754,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{37}
+	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2638,7 +2827,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{38}
+	return fileDescriptor_02345ba45cc0e303, []int{39}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -2708,6 +2897,94 @@ func (m *InsertRequest) GetNumRows() uint32 {
 	return 0
 }
 
+// UpsertRequest replaces the entities with the same primary keys, the primary keys must be provided
+type UpsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpsertRequest) Reset()         { *m = UpsertRequest{} }
+func (m *UpsertRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertRequest) ProtoMessage()    {}
+func (*UpsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{40}
+}
+
+func (m *UpsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertRequest.Unmarshal(m, b)
+}
+func (m *UpsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertRequest.Merge(m, src)
+}
+func (m *UpsertRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertRequest.Size(m)
+}
+func (m *UpsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertRequest proto.InternalMessageInfo
+
+func (m *UpsertRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetHashKeys() []uint32 {
+	if m != nil {
+		return m.HashKeys
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetNumRows() uint32 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
 type MutationResult struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
@@ -2727,7 +3004,7 @@ func (m *MutationResult) Reset()         { *m = MutationResult{} }
 func (m *MutationResult) String() string { return proto.CompactTextString(m) }
 func (*MutationResult) ProtoMessage()    {}
 func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+	return fileDescriptor_02345ba45cc0e303, []int{41}
 }
 
 func (m *MutationResult) XXX_Unmarshal(b []byte) error {
@@ -2827,7 +3104,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -2904,7 +3181,7 @@ func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
 func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderValue) ProtoMessage()    {}
 func (*PlaceholderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
 
 func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
@@ -2957,7 +3234,7 @@ func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
 func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderGroup) ProtoMessage()    {}
 func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
 func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
@@ -2992,22 +3269,25 @@ type SearchRequest struct {
 	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	PlaceholderGroup     []byte                    `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType              commonpb.DslType          `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	OutputFields         []string                  `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	SearchParams         []*commonpb.KeyValuePair  `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	TravelTimestamp      uint64                    `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                    `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	GracefulTime         int64                     `protobuf:"varint,14,opt,name=graceful_time,json=gracefulTime,proto3" json:"graceful_time,omitempty"`
+	ExplicitConsistency  bool                      `protobuf:"varint,15,opt,name=explicit_consistency,json=explicitConsistency,proto3" json:"explicit_consistency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -3105,10 +3385,161 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
-type Hits struct {
-	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
-	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
-	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+func (m *SearchRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *SearchRequest) GetGracefulTime() int64 {
+	if m != nil {
+		return m.GracefulTime
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetExplicitConsistency() bool {
+	if m != nil {
+		return m.ExplicitConsistency
+	}
+	return false
+}
+
+type HybridSearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// one ANNS search for each vector field, the collection, partitions and output fields are ignored
+	Requests []*SearchRequest `protobuf:"bytes,5,rep,name=requests,proto3" json:"requests,omitempty"`
+	// topk, offset, strategy (rrf or weighted), k for rrf and weights for weighted
+	RankParams           []*commonpb.KeyValuePair  `protobuf:"bytes,6,rep,name=rank_params,json=rankParams,proto3" json:"rank_params,omitempty"`
+	OutputFields         []string                  `protobuf:"bytes,7,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	TravelTimestamp      uint64                    `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                    `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,10,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	GracefulTime         int64                     `protobuf:"varint,12,opt,name=graceful_time,json=gracefulTime,proto3" json:"graceful_time,omitempty"`
+	ExplicitConsistency  bool                      `protobuf:"varint,13,opt,name=explicit_consistency,json=explicitConsistency,proto3" json:"explicit_consistency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *HybridSearchRequest) Reset()         { *m = HybridSearchRequest{} }
+func (m *HybridSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*HybridSearchRequest) ProtoMessage()    {}
+func (*HybridSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{46}
+}
+
+func (m *HybridSearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridSearchRequest.Unmarshal(m, b)
+}
+func (m *HybridSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridSearchRequest.Marshal(b, m, deterministic)
+}
+func (m *HybridSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridSearchRequest.Merge(m, src)
+}
+func (m *HybridSearchRequest) XXX_Size() int {
+	return xxx_messageInfo_HybridSearchRequest.Size(m)
+}
+func (m *HybridSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridSearchRequest proto.InternalMessageInfo
+
+func (m *HybridSearchRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *HybridSearchRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *HybridSearchRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetRequests() []*SearchRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetRankParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.RankParams
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *HybridSearchRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
+func (m *HybridSearchRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *HybridSearchRequest) GetGracefulTime() int64 {
+	if m != nil {
+		return m.GracefulTime
+	}
+	return 0
+}
+
+func (m *HybridSearchRequest) GetExplicitConsistency() bool {
+	if m != nil {
+		return m.ExplicitConsistency
+	}
+	return false
+}
+
+type Hits struct {
+	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
+	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	StrIds               []string  `protobuf:"bytes,4,rep,name=str_ids,json=strIds,proto3" json:"str_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -3118,7 +3549,7 @@ func (m *Hits) Reset()         { *m = Hits{} }
 func (m *Hits) String() string { return proto.CompactTextString(m) }
 func (*Hits) ProtoMessage()    {}
 func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
 func (m *Hits) XXX_Unmarshal(b []byte) error {
@@ -3160,6 +3591,13 @@ func (m *Hits) GetScores() []float32 {
 	return nil
 }
 
+func (m *Hits) GetStrIds() []string {
+	if m != nil {
+		return m.StrIds
+	}
+	return nil
+}
+
 type SearchResults struct {
 	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
@@ -3172,7 +3610,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -3220,7 +3658,7 @@ func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
 func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushRequest) ProtoMessage()    {}
 func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
 func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
@@ -3275,7 +3713,7 @@ func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
 func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
 func (*FlushResponse) ProtoMessage()    {}
 func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
 func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
@@ -3318,24 +3756,32 @@ func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
 }
 
 type QueryRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base                 *commonpb.MsgBase         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                    `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                    `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                 string                    `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields         []string                  `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames       []string                  `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp      uint64                    `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                    `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	Limit                int64                     `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int64                     `protobuf:"varint,10,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy              string                    `protobuf:"bytes,11,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Descending           bool                      `protobuf:"varint,12,opt,name=descending,proto3" json:"descending,omitempty"`
+	GroupBy              string                    `protobuf:"bytes,13,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,14,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	GracefulTime         int64                     `protobuf:"varint,16,opt,name=graceful_time,json=gracefulTime,proto3" json:"graceful_time,omitempty"`
+	ExplicitConsistency  bool                      `protobuf:"varint,17,opt,name=explicit_consistency,json=explicitConsistency,proto3" json:"explicit_consistency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+	return fileDescriptor_02345ba45cc0e303, []int{51}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -3412,6 +3858,62 @@ func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *QueryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *QueryRequest) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *QueryRequest) GetGracefulTime() int64 {
+	if m != nil {
+		return m.GracefulTime
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetExplicitConsistency() bool {
+	if m != nil {
+		return m.ExplicitConsistency
+	}
+	return false
+}
+
 type QueryResults struct {
 	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
@@ -3424,7 +3926,7 @@ func (m *QueryResults) Reset()         { *m = QueryResults{} }
 func (m *QueryResults) String() string { return proto.CompactTextString(m) }
 func (*QueryResults) ProtoMessage()    {}
 func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+	return fileDescriptor_02345ba45cc0e303, []int{52}
 }
 
 func (m *QueryResults) XXX_Unmarshal(b []byte) error {
@@ -3459,100 +3961,439 @@ func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
 	return nil
 }
 
-type VectorIDs struct {
-	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IdArray              *schemapb.IDs `protobuf:"bytes,3,opt,name=id_array,json=idArray,proto3" json:"id_array,omitempty"`
-	PartitionNames       []string      `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type OpenQueryIteratorRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	BatchSize            int64             `protobuf:"varint,8,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
-func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
-func (*VectorIDs) ProtoMessage()    {}
-func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
+func (m *OpenQueryIteratorRequest) Reset()         { *m = OpenQueryIteratorRequest{} }
+func (m *OpenQueryIteratorRequest) String() string { return proto.CompactTextString(m) }
+func (*OpenQueryIteratorRequest) ProtoMessage()    {}
+func (*OpenQueryIteratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{53}
 }
 
-func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VectorIDs.Unmarshal(m, b)
+func (m *OpenQueryIteratorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenQueryIteratorRequest.Unmarshal(m, b)
 }
-func (m *VectorIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VectorIDs.Marshal(b, m, deterministic)
+func (m *OpenQueryIteratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenQueryIteratorRequest.Marshal(b, m, deterministic)
 }
-func (m *VectorIDs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorIDs.Merge(m, src)
+func (m *OpenQueryIteratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenQueryIteratorRequest.Merge(m, src)
 }
-func (m *VectorIDs) XXX_Size() int {
-	return xxx_messageInfo_VectorIDs.Size(m)
+func (m *OpenQueryIteratorRequest) XXX_Size() int {
+	return xxx_messageInfo_OpenQueryIteratorRequest.Size(m)
 }
-func (m *VectorIDs) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorIDs.DiscardUnknown(m)
+func (m *OpenQueryIteratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenQueryIteratorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VectorIDs proto.InternalMessageInfo
+var xxx_messageInfo_OpenQueryIteratorRequest proto.InternalMessageInfo
 
-func (m *VectorIDs) GetCollectionName() string {
+func (m *OpenQueryIteratorRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OpenQueryIteratorRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *OpenQueryIteratorRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *VectorIDs) GetFieldName() string {
+func (m *OpenQueryIteratorRequest) GetExpr() string {
 	if m != nil {
-		return m.FieldName
+		return m.Expr
 	}
 	return ""
 }
 
-func (m *VectorIDs) GetIdArray() *schemapb.IDs {
+func (m *OpenQueryIteratorRequest) GetOutputFields() []string {
 	if m != nil {
-		return m.IdArray
+		return m.OutputFields
 	}
 	return nil
 }
 
-func (m *VectorIDs) GetPartitionNames() []string {
+func (m *OpenQueryIteratorRequest) GetPartitionNames() []string {
 	if m != nil {
 		return m.PartitionNames
 	}
 	return nil
 }
 
-type VectorsArray struct {
-	// Types that are valid to be assigned to Array:
-	//	*VectorsArray_IdArray
-	//	*VectorsArray_DataArray
-	Array                isVectorsArray_Array `protobuf_oneof:"array"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *OpenQueryIteratorRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
 }
 
-func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
-func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
-func (*VectorsArray) ProtoMessage()    {}
-func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+func (m *OpenQueryIteratorRequest) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
 }
 
-func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VectorsArray.Unmarshal(m, b)
+type OpenQueryIteratorResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IteratorID           int64            `protobuf:"varint,2,opt,name=iteratorID,proto3" json:"iteratorID,omitempty"`
+	TravelTimestamp      uint64           `protobuf:"varint,3,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	Secret               string           `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *VectorsArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VectorsArray.Marshal(b, m, deterministic)
+
+func (m *OpenQueryIteratorResponse) Reset()         { *m = OpenQueryIteratorResponse{} }
+func (m *OpenQueryIteratorResponse) String() string { return proto.CompactTextString(m) }
+func (*OpenQueryIteratorResponse) ProtoMessage()    {}
+func (*OpenQueryIteratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
-func (m *VectorsArray) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorsArray.Merge(m, src)
+
+func (m *OpenQueryIteratorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenQueryIteratorResponse.Unmarshal(m, b)
 }
-func (m *VectorsArray) XXX_Size() int {
-	return xxx_messageInfo_VectorsArray.Size(m)
+func (m *OpenQueryIteratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenQueryIteratorResponse.Marshal(b, m, deterministic)
 }
-func (m *VectorsArray) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorsArray.DiscardUnknown(m)
+func (m *OpenQueryIteratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenQueryIteratorResponse.Merge(m, src)
+}
+func (m *OpenQueryIteratorResponse) XXX_Size() int {
+	return xxx_messageInfo_OpenQueryIteratorResponse.Size(m)
+}
+func (m *OpenQueryIteratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenQueryIteratorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenQueryIteratorResponse proto.InternalMessageInfo
+
+func (m *OpenQueryIteratorResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *OpenQueryIteratorResponse) GetIteratorID() int64 {
+	if m != nil {
+		return m.IteratorID
+	}
+	return 0
+}
+
+func (m *OpenQueryIteratorResponse) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *OpenQueryIteratorResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type NextQueryIteratorRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	IteratorID           int64             `protobuf:"varint,2,opt,name=iteratorID,proto3" json:"iteratorID,omitempty"`
+	Token                string            `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Secret               string            `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *NextQueryIteratorRequest) Reset()         { *m = NextQueryIteratorRequest{} }
+func (m *NextQueryIteratorRequest) String() string { return proto.CompactTextString(m) }
+func (*NextQueryIteratorRequest) ProtoMessage()    {}
+func (*NextQueryIteratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{55}
+}
+
+func (m *NextQueryIteratorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NextQueryIteratorRequest.Unmarshal(m, b)
+}
+func (m *NextQueryIteratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NextQueryIteratorRequest.Marshal(b, m, deterministic)
+}
+func (m *NextQueryIteratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextQueryIteratorRequest.Merge(m, src)
+}
+func (m *NextQueryIteratorRequest) XXX_Size() int {
+	return xxx_messageInfo_NextQueryIteratorRequest.Size(m)
+}
+func (m *NextQueryIteratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextQueryIteratorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextQueryIteratorRequest proto.InternalMessageInfo
+
+func (m *NextQueryIteratorRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *NextQueryIteratorRequest) GetIteratorID() int64 {
+	if m != nil {
+		return m.IteratorID
+	}
+	return 0
+}
+
+func (m *NextQueryIteratorRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *NextQueryIteratorRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type QueryIteratorResults struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	Token                string                `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Done                 bool                  `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *QueryIteratorResults) Reset()         { *m = QueryIteratorResults{} }
+func (m *QueryIteratorResults) String() string { return proto.CompactTextString(m) }
+func (*QueryIteratorResults) ProtoMessage()    {}
+func (*QueryIteratorResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{56}
+}
+
+func (m *QueryIteratorResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryIteratorResults.Unmarshal(m, b)
+}
+func (m *QueryIteratorResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryIteratorResults.Marshal(b, m, deterministic)
+}
+func (m *QueryIteratorResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIteratorResults.Merge(m, src)
+}
+func (m *QueryIteratorResults) XXX_Size() int {
+	return xxx_messageInfo_QueryIteratorResults.Size(m)
+}
+func (m *QueryIteratorResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIteratorResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIteratorResults proto.InternalMessageInfo
+
+func (m *QueryIteratorResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *QueryIteratorResults) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *QueryIteratorResults) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *QueryIteratorResults) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+type CloseQueryIteratorRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	IteratorID           int64             `protobuf:"varint,2,opt,name=iteratorID,proto3" json:"iteratorID,omitempty"`
+	Secret               string            `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CloseQueryIteratorRequest) Reset()         { *m = CloseQueryIteratorRequest{} }
+func (m *CloseQueryIteratorRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseQueryIteratorRequest) ProtoMessage()    {}
+func (*CloseQueryIteratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{57}
+}
+
+func (m *CloseQueryIteratorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseQueryIteratorRequest.Unmarshal(m, b)
+}
+func (m *CloseQueryIteratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseQueryIteratorRequest.Marshal(b, m, deterministic)
+}
+func (m *CloseQueryIteratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseQueryIteratorRequest.Merge(m, src)
+}
+func (m *CloseQueryIteratorRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseQueryIteratorRequest.Size(m)
+}
+func (m *CloseQueryIteratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseQueryIteratorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseQueryIteratorRequest proto.InternalMessageInfo
+
+func (m *CloseQueryIteratorRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CloseQueryIteratorRequest) GetIteratorID() int64 {
+	if m != nil {
+		return m.IteratorID
+	}
+	return 0
+}
+
+func (m *CloseQueryIteratorRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type VectorIDs struct {
+	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IdArray              *schemapb.IDs `protobuf:"bytes,3,opt,name=id_array,json=idArray,proto3" json:"id_array,omitempty"`
+	PartitionNames       []string      `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
+func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
+func (*VectorIDs) ProtoMessage()    {}
+func (*VectorIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{58}
+}
+
+func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorIDs.Unmarshal(m, b)
+}
+func (m *VectorIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorIDs.Marshal(b, m, deterministic)
+}
+func (m *VectorIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorIDs.Merge(m, src)
+}
+func (m *VectorIDs) XXX_Size() int {
+	return xxx_messageInfo_VectorIDs.Size(m)
+}
+func (m *VectorIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorIDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VectorIDs proto.InternalMessageInfo
+
+func (m *VectorIDs) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *VectorIDs) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *VectorIDs) GetIdArray() *schemapb.IDs {
+	if m != nil {
+		return m.IdArray
+	}
+	return nil
+}
+
+func (m *VectorIDs) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+type VectorsArray struct {
+	// Types that are valid to be assigned to Array:
+	//	*VectorsArray_IdArray
+	//	*VectorsArray_DataArray
+	Array                isVectorsArray_Array `protobuf_oneof:"array"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
+func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
+func (*VectorsArray) ProtoMessage()    {}
+func (*VectorsArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{59}
+}
+
+func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorsArray.Unmarshal(m, b)
+}
+func (m *VectorsArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorsArray.Marshal(b, m, deterministic)
+}
+func (m *VectorsArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorsArray.Merge(m, src)
+}
+func (m *VectorsArray) XXX_Size() int {
+	return xxx_messageInfo_VectorsArray.Size(m)
+}
+func (m *VectorsArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorsArray.DiscardUnknown(m)
 }
 
 var xxx_messageInfo_VectorsArray proto.InternalMessageInfo
@@ -3616,7 +4457,7 @@ func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
 func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceRequest) ProtoMessage()    {}
 func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+	return fileDescriptor_02345ba45cc0e303, []int{60}
 }
 
 func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3682,7 +4523,7 @@ func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
 func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceResults) ProtoMessage()    {}
 func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+	return fileDescriptor_02345ba45cc0e303, []int{61}
 }
 
 func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
@@ -3770,7 +4611,7 @@ func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
 func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistentSegmentInfo) ProtoMessage()    {}
 func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+	return fileDescriptor_02345ba45cc0e303, []int{62}
 }
 
 func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3839,7 +4680,7 @@ func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSe
 func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+	return fileDescriptor_02345ba45cc0e303, []int{63}
 }
 
 func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3893,7 +4734,7 @@ func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentS
 func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+	return fileDescriptor_02345ba45cc0e303, []int{64}
 }
 
 func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3947,7 +4788,7 @@ func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
 func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*QuerySegmentInfo) ProtoMessage()    {}
 func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+	return fileDescriptor_02345ba45cc0e303, []int{65}
 }
 
 func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -4044,7 +4885,7 @@ func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoR
 func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
 func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
 func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -4098,7 +4939,7 @@ func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfo
 func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
 func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+	return fileDescriptor_02345ba45cc0e303, []int{67}
 }
 
 func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -4144,7 +4985,7 @@ func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
 func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
 func (*DummyRequest) ProtoMessage()    {}
 func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+	return fileDescriptor_02345ba45cc0e303, []int{68}
 }
 
 func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
@@ -4183,7 +5024,7 @@ func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
 func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
 func (*DummyResponse) ProtoMessage()    {}
 func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+	return fileDescriptor_02345ba45cc0e303, []int{69}
 }
 
 func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
@@ -4221,7 +5062,7 @@ func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
 func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkRequest) ProtoMessage()    {}
 func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{62}
+	return fileDescriptor_02345ba45cc0e303, []int{70}
 }
 
 func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
@@ -4254,7 +5095,7 @@ func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
 func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkResponse) ProtoMessage()    {}
 func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{63}
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
 
 func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
@@ -4301,7 +5142,7 @@ func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
 func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsRequest) ProtoMessage()    {}
 func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{64}
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
 func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
@@ -4349,7 +5190,7 @@ func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
 func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsResponse) ProtoMessage()    {}
 func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{65}
+	return fileDescriptor_02345ba45cc0e303, []int{73}
 }
 
 func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
@@ -4407,7 +5248,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{66}
+	return fileDescriptor_02345ba45cc0e303, []int{74}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -4468,7 +5309,7 @@ func (m *ManualCompactionRequest) Reset()         { *m = ManualCompactionRequest
 func (m *ManualCompactionRequest) String() string { return proto.CompactTextString(m) }
 func (*ManualCompactionRequest) ProtoMessage()    {}
 func (*ManualCompactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{67}
+	return fileDescriptor_02345ba45cc0e303, []int{75}
 }
 
 func (m *ManualCompactionRequest) XXX_Unmarshal(b []byte) error {
@@ -4515,7 +5356,7 @@ func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionRespon
 func (m *ManualCompactionResponse) String() string { return proto.CompactTextString(m) }
 func (*ManualCompactionResponse) ProtoMessage()    {}
 func (*ManualCompactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{68}
+	return fileDescriptor_02345ba45cc0e303, []int{76}
 }
 
 func (m *ManualCompactionResponse) XXX_Unmarshal(b []byte) error {
@@ -4561,7 +5402,7 @@ func (m *GetCompactionStateRequest) Reset()         { *m = GetCompactionStateReq
 func (m *GetCompactionStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionStateRequest) ProtoMessage()    {}
 func (*GetCompactionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{69}
+	return fileDescriptor_02345ba45cc0e303, []int{77}
 }
 
 func (m *GetCompactionStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4604,7 +5445,7 @@ func (m *GetCompactionStateResponse) Reset()         { *m = GetCompactionStateRe
 func (m *GetCompactionStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionStateResponse) ProtoMessage()    {}
 func (*GetCompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{70}
+	return fileDescriptor_02345ba45cc0e303, []int{78}
 }
 
 func (m *GetCompactionStateResponse) XXX_Unmarshal(b []byte) error {
@@ -4671,7 +5512,7 @@ func (m *GetCompactionPlansRequest) Reset()         { *m = GetCompactionPlansReq
 func (m *GetCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionPlansRequest) ProtoMessage()    {}
 func (*GetCompactionPlansRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{71}
+	return fileDescriptor_02345ba45cc0e303, []int{79}
 }
 
 func (m *GetCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
@@ -4712,7 +5553,7 @@ func (m *GetCompactionPlansResponse) Reset()         { *m = GetCompactionPlansRe
 func (m *GetCompactionPlansResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionPlansResponse) ProtoMessage()    {}
 func (*GetCompactionPlansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{72}
+	return fileDescriptor_02345ba45cc0e303, []int{80}
 }
 
 func (m *GetCompactionPlansResponse) XXX_Unmarshal(b []byte) error {
@@ -4766,7 +5607,7 @@ func (m *CompactionMergeInfo) Reset()         { *m = CompactionMergeInfo{} }
 func (m *CompactionMergeInfo) String() string { return proto.CompactTextString(m) }
 func (*CompactionMergeInfo) ProtoMessage()    {}
 func (*CompactionMergeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{73}
+	return fileDescriptor_02345ba45cc0e303, []int{81}
 }
 
 func (m *CompactionMergeInfo) XXX_Unmarshal(b []byte) error {
@@ -4801,476 +5642,1665 @@ func (m *CompactionMergeInfo) GetTarget() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
-	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
-	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
-	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
-	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
-	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
-	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
-	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
-	proto.RegisterType((*BoolResponse)(nil), "milvus.proto.milvus.BoolResponse")
-	proto.RegisterType((*StringResponse)(nil), "milvus.proto.milvus.StringResponse")
-	proto.RegisterType((*DescribeCollectionRequest)(nil), "milvus.proto.milvus.DescribeCollectionRequest")
-	proto.RegisterType((*DescribeCollectionResponse)(nil), "milvus.proto.milvus.DescribeCollectionResponse")
-	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.milvus.LoadCollectionRequest")
-	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.milvus.ReleaseCollectionRequest")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.milvus.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
-	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
-	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
-	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
-	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
-	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
-	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.milvus.LoadPartitionsRequest")
-	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.milvus.ReleasePartitionsRequest")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.milvus.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.milvus.GetPartitionStatisticsResponse")
-	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.milvus.ShowPartitionsRequest")
-	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.milvus.ShowPartitionsResponse")
-	proto.RegisterType((*DescribeSegmentRequest)(nil), "milvus.proto.milvus.DescribeSegmentRequest")
-	proto.RegisterType((*DescribeSegmentResponse)(nil), "milvus.proto.milvus.DescribeSegmentResponse")
-	proto.RegisterType((*ShowSegmentsRequest)(nil), "milvus.proto.milvus.ShowSegmentsRequest")
-	proto.RegisterType((*ShowSegmentsResponse)(nil), "milvus.proto.milvus.ShowSegmentsResponse")
-	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.milvus.CreateIndexRequest")
-	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.milvus.DescribeIndexRequest")
-	proto.RegisterType((*IndexDescription)(nil), "milvus.proto.milvus.IndexDescription")
-	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.milvus.DescribeIndexResponse")
-	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.milvus.GetIndexBuildProgressRequest")
-	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.milvus.GetIndexBuildProgressResponse")
-	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.milvus.GetIndexStateRequest")
-	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.milvus.GetIndexStateResponse")
-	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.milvus.DropIndexRequest")
-	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
-	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
-	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
-	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
-	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
-	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
-	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
-	proto.RegisterType((*SearchResults)(nil), "milvus.proto.milvus.SearchResults")
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.milvus.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
-	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
-	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
-	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
-	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
-	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
-	proto.RegisterType((*CalcDistanceRequest)(nil), "milvus.proto.milvus.CalcDistanceRequest")
-	proto.RegisterType((*CalcDistanceResults)(nil), "milvus.proto.milvus.CalcDistanceResults")
-	proto.RegisterType((*PersistentSegmentInfo)(nil), "milvus.proto.milvus.PersistentSegmentInfo")
-	proto.RegisterType((*GetPersistentSegmentInfoRequest)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoRequest")
-	proto.RegisterType((*GetPersistentSegmentInfoResponse)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoResponse")
-	proto.RegisterType((*QuerySegmentInfo)(nil), "milvus.proto.milvus.QuerySegmentInfo")
-	proto.RegisterType((*GetQuerySegmentInfoRequest)(nil), "milvus.proto.milvus.GetQuerySegmentInfoRequest")
-	proto.RegisterType((*GetQuerySegmentInfoResponse)(nil), "milvus.proto.milvus.GetQuerySegmentInfoResponse")
-	proto.RegisterType((*DummyRequest)(nil), "milvus.proto.milvus.DummyRequest")
-	proto.RegisterType((*DummyResponse)(nil), "milvus.proto.milvus.DummyResponse")
-	proto.RegisterType((*RegisterLinkRequest)(nil), "milvus.proto.milvus.RegisterLinkRequest")
-	proto.RegisterType((*RegisterLinkResponse)(nil), "milvus.proto.milvus.RegisterLinkResponse")
-	proto.RegisterType((*GetMetricsRequest)(nil), "milvus.proto.milvus.GetMetricsRequest")
-	proto.RegisterType((*GetMetricsResponse)(nil), "milvus.proto.milvus.GetMetricsResponse")
-	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.milvus.LoadBalanceRequest")
-	proto.RegisterType((*ManualCompactionRequest)(nil), "milvus.proto.milvus.ManualCompactionRequest")
-	proto.RegisterType((*ManualCompactionResponse)(nil), "milvus.proto.milvus.ManualCompactionResponse")
-	proto.RegisterType((*GetCompactionStateRequest)(nil), "milvus.proto.milvus.GetCompactionStateRequest")
-	proto.RegisterType((*GetCompactionStateResponse)(nil), "milvus.proto.milvus.GetCompactionStateResponse")
-	proto.RegisterType((*GetCompactionPlansRequest)(nil), "milvus.proto.milvus.GetCompactionPlansRequest")
-	proto.RegisterType((*GetCompactionPlansResponse)(nil), "milvus.proto.milvus.GetCompactionPlansResponse")
-	proto.RegisterType((*CompactionMergeInfo)(nil), "milvus.proto.milvus.CompactionMergeInfo")
+type CreateCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password in plain text, it is hashed by proxy before being persisted
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
-
-var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 3498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4d, 0x73, 0x1b, 0xc7,
-	0xb1, 0x5c, 0x80, 0x24, 0x80, 0x06, 0x40, 0x42, 0x43, 0x8a, 0x82, 0xa0, 0x2f, 0x6a, 0x6d, 0x59,
-	0x94, 0x64, 0x49, 0x16, 0x65, 0x3f, 0xfb, 0xc9, 0xef, 0x3d, 0x5b, 0x12, 0x9f, 0x25, 0x96, 0x25,
-	0x3d, 0x7a, 0x69, 0xfb, 0x95, 0x9f, 0x4b, 0x85, 0x1a, 0x62, 0x47, 0xe0, 0x96, 0x16, 0xbb, 0xf0,
-	0xce, 0x40, 0x12, 0x7d, 0x7a, 0x55, 0x7e, 0x1f, 0x95, 0xb2, 0x63, 0x57, 0x2a, 0xa9, 0xa4, 0x52,
-	0xa9, 0xe4, 0x90, 0xc4, 0x87, 0xdc, 0xe2, 0x38, 0x95, 0xa4, 0x72, 0xca, 0xc1, 0x87, 0x1c, 0x52,
-	0x95, 0x8f, 0x4b, 0x0e, 0xb9, 0xe4, 0x0f, 0xf8, 0x1f, 0xe4, 0x90, 0x9a, 0x8f, 0x5d, 0xec, 0x2e,
-	0x66, 0x41, 0x50, 0xb0, 0x42, 0xf2, 0xb6, 0xd3, 0xd3, 0xdd, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3,
-	0xd3, 0x0b, 0x95, 0x8e, 0xe3, 0x3e, 0xe8, 0xd1, 0x0b, 0xdd, 0xc0, 0x67, 0x3e, 0x9a, 0x8b, 0xb7,
-	0x2e, 0xc8, 0x46, 0xa3, 0xd2, 0xf2, 0x3b, 0x1d, 0xdf, 0x93, 0xc0, 0x46, 0x85, 0xb6, 0x36, 0x49,
-	0x07, 0xcb, 0x96, 0xf9, 0x03, 0x03, 0xd0, 0xf5, 0x80, 0x60, 0x46, 0xae, 0xba, 0x0e, 0xa6, 0x16,
-	0x79, 0xaf, 0x47, 0x28, 0x43, 0xcf, 0xc1, 0xe4, 0x06, 0xa6, 0xa4, 0x6e, 0x2c, 0x1a, 0x4b, 0xe5,
-	0xe5, 0xa3, 0x17, 0x12, 0x6c, 0x15, 0xbb, 0xdb, 0xb4, 0x7d, 0x0d, 0x53, 0x62, 0x09, 0x4c, 0x74,
-	0x08, 0x0a, 0xf6, 0x46, 0xd3, 0xc3, 0x1d, 0x52, 0xcf, 0x2d, 0x1a, 0x4b, 0x25, 0x6b, 0xda, 0xde,
-	0xb8, 0x83, 0x3b, 0x04, 0x9d, 0x86, 0xd9, 0x96, 0xef, 0xba, 0xa4, 0xc5, 0x1c, 0xdf, 0x93, 0x08,
-	0x79, 0x81, 0x30, 0xd3, 0x07, 0x0b, 0xc4, 0x79, 0x98, 0xc2, 0x5c, 0x86, 0xfa, 0xa4, 0xe8, 0x96,
-	0x0d, 0x93, 0x42, 0x6d, 0x25, 0xf0, 0xbb, 0x4f, 0x4a, 0xba, 0x68, 0xd0, 0x7c, 0x7c, 0xd0, 0xef,
-	0x1b, 0x70, 0xe0, 0xaa, 0xcb, 0x48, 0xb0, 0x47, 0x95, 0xf2, 0x85, 0x01, 0x87, 0xe4, 0xaa, 0x5d,
-	0x8f, 0xd0, 0x77, 0x53, 0xca, 0x05, 0x98, 0x96, 0x56, 0x25, 0xc4, 0xac, 0x58, 0xaa, 0x85, 0x8e,
-	0x01, 0xd0, 0x4d, 0x1c, 0xd8, 0xb4, 0xe9, 0xf5, 0x3a, 0xf5, 0xa9, 0x45, 0x63, 0x69, 0xca, 0x2a,
-	0x49, 0xc8, 0x9d, 0x5e, 0xc7, 0xfc, 0xd0, 0x80, 0x83, 0x7c, 0x71, 0xf7, 0xc4, 0x24, 0xcc, 0x9f,
-	0x18, 0x30, 0x7f, 0x13, 0xd3, 0xbd, 0xa1, 0xd1, 0x63, 0x00, 0xcc, 0xe9, 0x90, 0x26, 0x65, 0xb8,
-	0xd3, 0x15, 0x5a, 0x9d, 0xb4, 0x4a, 0x1c, 0xb2, 0xce, 0x01, 0xe6, 0x3b, 0x50, 0xb9, 0xe6, 0xfb,
-	0xae, 0x45, 0x68, 0xd7, 0xf7, 0x28, 0x41, 0x97, 0x61, 0x9a, 0x32, 0xcc, 0x7a, 0x54, 0x09, 0x79,
-	0x44, 0x2b, 0xe4, 0xba, 0x40, 0xb1, 0x14, 0x2a, 0xb7, 0xad, 0x07, 0xd8, 0xed, 0x49, 0x19, 0x8b,
-	0x96, 0x6c, 0x98, 0xef, 0xc2, 0xcc, 0x3a, 0x0b, 0x1c, 0xaf, 0xfd, 0x15, 0x32, 0x2f, 0x85, 0xcc,
-	0xff, 0x64, 0xc0, 0xe1, 0x15, 0x42, 0x5b, 0x81, 0xb3, 0xb1, 0x47, 0x4c, 0xd7, 0x84, 0x4a, 0x1f,
-	0xb2, 0xba, 0x22, 0x54, 0x9d, 0xb7, 0x12, 0xb0, 0xd4, 0x62, 0x4c, 0xa5, 0x17, 0xe3, 0x83, 0x49,
-	0x68, 0xe8, 0x26, 0x35, 0x8e, 0xfa, 0xfe, 0x35, 0xda, 0x51, 0x39, 0x41, 0x74, 0x2a, 0x49, 0xa4,
-	0x7c, 0x78, 0x7f, 0xb4, 0x75, 0x01, 0x88, 0x36, 0x5e, 0x7a, 0x56, 0x79, 0xcd, 0xac, 0x96, 0xe1,
-	0xe0, 0x03, 0x27, 0x60, 0x3d, 0xec, 0x36, 0x5b, 0x9b, 0xd8, 0xf3, 0x88, 0x2b, 0xf4, 0xc4, 0x5d,
-	0x4d, 0x7e, 0xa9, 0x64, 0xcd, 0xa9, 0xce, 0xeb, 0xb2, 0x8f, 0x2b, 0x8b, 0xa2, 0xe7, 0x61, 0xa1,
-	0xbb, 0xb9, 0x45, 0x9d, 0xd6, 0x00, 0xd1, 0x94, 0x20, 0x9a, 0x0f, 0x7b, 0x13, 0x54, 0xe7, 0xe0,
-	0x40, 0x4b, 0x78, 0x2b, 0xbb, 0xc9, 0xb5, 0x26, 0xd5, 0x38, 0x2d, 0xd4, 0x58, 0x53, 0x1d, 0x6f,
-	0x86, 0x70, 0x2e, 0x56, 0x88, 0xdc, 0x63, 0xad, 0x18, 0x41, 0x41, 0x10, 0xcc, 0xa9, 0xce, 0xb7,
-	0x58, 0xab, 0x4f, 0x93, 0xf4, 0x33, 0xc5, 0x94, 0x9f, 0x41, 0x75, 0x28, 0x08, 0xbf, 0x49, 0x68,
-	0xbd, 0x24, 0xc4, 0x0c, 0x9b, 0x68, 0x15, 0x66, 0x29, 0xc3, 0x01, 0x6b, 0x76, 0x7d, 0xea, 0x70,
-	0xbd, 0xd0, 0x3a, 0x2c, 0xe6, 0x97, 0xca, 0xcb, 0x8b, 0xda, 0x45, 0x7a, 0x9d, 0x6c, 0xad, 0x60,
-	0x86, 0xd7, 0xb0, 0x13, 0x58, 0x33, 0x82, 0x70, 0x2d, 0xa4, 0x13, 0xce, 0xec, 0x96, 0x8f, 0xed,
-	0xbd, 0xe1, 0xcc, 0x3e, 0x36, 0xa0, 0x6e, 0x11, 0x97, 0x60, 0xba, 0x37, 0xf6, 0x99, 0xf9, 0x2d,
-	0x03, 0x8e, 0xdf, 0x20, 0x2c, 0x66, 0xb1, 0x0c, 0x33, 0x87, 0x32, 0xa7, 0xb5, 0x9b, 0xe7, 0xab,
-	0xf9, 0x89, 0x01, 0x27, 0x32, 0xc5, 0x1a, 0x67, 0x03, 0xbf, 0x08, 0x53, 0xfc, 0x8b, 0xd6, 0x73,
-	0xc2, 0x9e, 0x4e, 0x66, 0xd9, 0xd3, 0xdb, 0xdc, 0x2f, 0x0a, 0x83, 0x92, 0xf8, 0xe6, 0x5f, 0x0d,
-	0x58, 0x58, 0xdf, 0xf4, 0x1f, 0xf6, 0x45, 0x7a, 0x12, 0x0a, 0x4a, 0xba, 0xb4, 0x7c, 0xca, 0xa5,
-	0xa1, 0x4b, 0x30, 0xc9, 0xb6, 0xba, 0x44, 0x78, 0xc3, 0x99, 0xe5, 0x63, 0x17, 0x34, 0x61, 0xe5,
-	0x05, 0x2e, 0xe4, 0x9b, 0x5b, 0x5d, 0x62, 0x09, 0x54, 0x74, 0x06, 0x6a, 0x29, 0x95, 0x87, 0x4e,
-	0x61, 0x36, 0xa9, 0x73, 0x6a, 0xfe, 0x2a, 0x07, 0x87, 0x06, 0xa6, 0x38, 0x8e, 0xb2, 0x75, 0x63,
-	0xe7, 0xb4, 0x63, 0xa3, 0x53, 0x10, 0x33, 0x81, 0xa6, 0x63, 0xf3, 0xc8, 0x2f, 0xbf, 0x94, 0xb7,
-	0xaa, 0x31, 0xdf, 0x68, 0x53, 0x74, 0x1e, 0xd0, 0x80, 0xcb, 0x92, 0x9e, 0x71, 0xd2, 0x3a, 0x90,
-	0xf6, 0x59, 0xc2, 0x2f, 0x6a, 0x9d, 0x96, 0x54, 0xc1, 0xa4, 0x35, 0xaf, 0xf1, 0x5a, 0x14, 0x5d,
-	0x82, 0x79, 0xc7, 0xbb, 0x4d, 0x3a, 0x7e, 0xb0, 0xd5, 0xec, 0x92, 0xa0, 0x45, 0x3c, 0x86, 0xdb,
-	0x84, 0xd6, 0xa7, 0x85, 0x44, 0x73, 0x61, 0xdf, 0x5a, 0xbf, 0xcb, 0xfc, 0xdc, 0x80, 0x05, 0x19,
-	0xf9, 0xad, 0xe1, 0x80, 0x39, 0xbb, 0x7d, 0x7a, 0x9e, 0x82, 0x99, 0x6e, 0x28, 0x87, 0xc4, 0x93,
-	0x71, 0x6a, 0x35, 0x82, 0x8a, 0x5d, 0xf6, 0x99, 0x01, 0xf3, 0x3c, 0xd0, 0xdb, 0x4f, 0x32, 0xff,
-	0xd4, 0x80, 0xb9, 0x9b, 0x98, 0xee, 0x27, 0x91, 0x7f, 0xae, 0x8e, 0xa0, 0x48, 0xe6, 0x5d, 0xbd,
-	0xba, 0x9c, 0x86, 0xd9, 0xa4, 0xd0, 0x61, 0x64, 0x31, 0x93, 0x90, 0x9a, 0x9a, 0xbf, 0xec, 0x9f,
-	0x55, 0xfb, 0x4c, 0xf2, 0x5f, 0x1b, 0x70, 0xec, 0x06, 0x61, 0x91, 0xd4, 0x7b, 0xe2, 0x4c, 0x1b,
-	0xd5, 0x5a, 0x3e, 0x96, 0x27, 0xb2, 0x56, 0xf8, 0x5d, 0x39, 0xf9, 0x3e, 0xcc, 0xc1, 0x41, 0x7e,
-	0x2c, 0xec, 0x0d, 0x23, 0x18, 0xe5, 0x62, 0xa0, 0x31, 0x94, 0x29, 0x9d, 0xa1, 0x44, 0xe7, 0xe9,
-	0xf4, 0xc8, 0xe7, 0xa9, 0xf9, 0xb3, 0x9c, 0x8c, 0x03, 0xe2, 0xda, 0x18, 0x67, 0x59, 0x34, 0xb2,
-	0xe6, 0xb4, 0xb2, 0x9a, 0x50, 0x89, 0x20, 0xab, 0x2b, 0xe1, 0xf9, 0x98, 0x80, 0xed, 0xd9, 0xe3,
-	0xf1, 0x23, 0x03, 0x16, 0xc2, 0xab, 0xd8, 0x3a, 0x69, 0x77, 0x88, 0xc7, 0x1e, 0xdf, 0x86, 0xd2,
-	0x16, 0x90, 0xd3, 0x58, 0xc0, 0x51, 0x28, 0x51, 0x39, 0x4e, 0x74, 0xcb, 0xea, 0x03, 0xcc, 0x4f,
-	0x0d, 0x38, 0x34, 0x20, 0xce, 0x38, 0x8b, 0x58, 0x87, 0x82, 0xe3, 0xd9, 0xe4, 0x51, 0x24, 0x4d,
-	0xd8, 0xe4, 0x3d, 0x1b, 0x3d, 0xc7, 0xb5, 0x23, 0x31, 0xc2, 0x26, 0x3a, 0x09, 0x15, 0xe2, 0xe1,
-	0x0d, 0x97, 0x34, 0x05, 0xae, 0x30, 0xe4, 0xa2, 0x55, 0x96, 0xb0, 0x55, 0x0e, 0x32, 0xbf, 0x6e,
-	0xc0, 0x1c, 0xb7, 0x35, 0x25, 0x23, 0x7d, 0xb2, 0x3a, 0x5b, 0x84, 0x72, 0xcc, 0x98, 0x94, 0xb8,
-	0x71, 0x90, 0x79, 0x1f, 0xe6, 0x93, 0xe2, 0x8c, 0xa3, 0xb3, 0xe3, 0x00, 0xd1, 0x8a, 0x48, 0x9b,
-	0xcf, 0x5b, 0x31, 0x88, 0xf9, 0x65, 0x94, 0x02, 0x15, 0xca, 0xd8, 0xe5, 0xac, 0xcf, 0x3d, 0x87,
-	0xb8, 0x76, 0xdc, 0x6b, 0x97, 0x04, 0x44, 0x74, 0xaf, 0x40, 0x85, 0x3c, 0x62, 0x01, 0x6e, 0x76,
-	0x71, 0x80, 0x3b, 0x72, 0xf3, 0x8c, 0xe4, 0x60, 0xcb, 0x82, 0x6c, 0x4d, 0x50, 0x99, 0xbf, 0xe5,
-	0xc1, 0x98, 0x32, 0xca, 0xbd, 0x3e, 0xe3, 0x63, 0x00, 0xc2, 0x68, 0x65, 0xf7, 0x94, 0xec, 0x16,
-	0x10, 0x71, 0x84, 0x7d, 0x6a, 0x40, 0x4d, 0x4c, 0x41, 0xce, 0xa7, 0xcb, 0xd9, 0xa6, 0x68, 0x8c,
-	0x14, 0xcd, 0x90, 0x2d, 0xf4, 0xcf, 0x30, 0xad, 0x14, 0x9b, 0x1f, 0x55, 0xb1, 0x8a, 0x60, 0x9b,
-	0x69, 0x98, 0x3f, 0x34, 0xe0, 0x60, 0x4a, 0xe5, 0xe3, 0x58, 0xf4, 0x9b, 0x80, 0xe4, 0x0c, 0xed,
-	0xfe, 0xb4, 0xc3, 0xe3, 0xf6, 0x94, 0xf6, 0x6c, 0x49, 0x2b, 0xc9, 0x3a, 0xe0, 0xa4, 0x20, 0xd4,
-	0xfc, 0x83, 0x01, 0x47, 0x6f, 0x10, 0x26, 0x50, 0xaf, 0x71, 0xdf, 0xb1, 0x16, 0xf8, 0xed, 0x80,
-	0x50, 0xba, 0x7f, 0xed, 0xe3, 0xdb, 0x32, 0x3e, 0xd3, 0x4d, 0x69, 0x1c, 0xfd, 0x9f, 0x84, 0x8a,
-	0x18, 0x83, 0xd8, 0xcd, 0xc0, 0x7f, 0x48, 0x95, 0x1d, 0x95, 0x15, 0xcc, 0xf2, 0x1f, 0x0a, 0x83,
-	0x60, 0x3e, 0xc3, 0xae, 0x44, 0x50, 0x07, 0x83, 0x80, 0xf0, 0x6e, 0xb1, 0x07, 0x43, 0xc1, 0x38,
-	0x73, 0xb2, 0x7f, 0x75, 0xfc, 0x63, 0x03, 0x0e, 0xa6, 0xa6, 0x32, 0x8e, 0x6e, 0x5f, 0x90, 0xd1,
-	0xa3, 0x9c, 0xcc, 0xcc, 0xf2, 0x09, 0x2d, 0x4d, 0x6c, 0x30, 0x89, 0x8d, 0x4e, 0x40, 0xf9, 0x1e,
-	0x76, 0xdc, 0x66, 0x40, 0x30, 0xf5, 0x3d, 0x35, 0x51, 0xe0, 0x20, 0x4b, 0x40, 0xcc, 0x2f, 0x0c,
-	0xf9, 0x90, 0xb4, 0xcf, 0x3d, 0xde, 0x8f, 0x72, 0x50, 0x5d, 0xf5, 0x28, 0x09, 0xd8, 0xde, 0xbf,
-	0x61, 0xa0, 0x57, 0xa0, 0x2c, 0x26, 0x46, 0x9b, 0x36, 0x66, 0x58, 0x1d, 0x57, 0xc7, 0xb5, 0x99,
-	0xec, 0xd7, 0x38, 0xde, 0x0a, 0x66, 0xd8, 0x92, 0xda, 0xa1, 0xfc, 0x1b, 0x1d, 0x81, 0xd2, 0x26,
-	0xa6, 0x9b, 0xcd, 0xfb, 0x64, 0x4b, 0x86, 0x7d, 0x55, 0xab, 0xc8, 0x01, 0xaf, 0x93, 0x2d, 0x8a,
-	0x0e, 0x43, 0xd1, 0xeb, 0x75, 0xe4, 0x06, 0x2b, 0x2c, 0x1a, 0x4b, 0x55, 0xab, 0xe0, 0xf5, 0x3a,
-	0x62, 0x7b, 0xfd, 0x2e, 0x07, 0x33, 0xb7, 0x7b, 0xfc, 0x3e, 0x23, 0xf2, 0xf0, 0x3d, 0x97, 0x3d,
-	0x9e, 0x31, 0x9e, 0x85, 0xbc, 0x8c, 0x19, 0x38, 0x45, 0x5d, 0x2b, 0xf8, 0xea, 0x0a, 0xb5, 0x38,
-	0x92, 0xc8, 0x41, 0xf7, 0x5a, 0x2d, 0x15, 0x64, 0xe5, 0x85, 0xb0, 0x25, 0x0e, 0x11, 0x16, 0xc7,
-	0xa7, 0x42, 0x82, 0x20, 0x0a, 0xc1, 0xc4, 0x54, 0x48, 0x10, 0xc8, 0x4e, 0x13, 0x2a, 0xb8, 0x75,
-	0xdf, 0xf3, 0x1f, 0xba, 0xc4, 0x6e, 0x13, 0x5b, 0x2c, 0x7b, 0xd1, 0x4a, 0xc0, 0xa4, 0x61, 0xf0,
-	0x85, 0x6f, 0xb6, 0x3c, 0x26, 0x2e, 0x12, 0x79, 0x6e, 0x18, 0x1c, 0x72, 0xdd, 0x63, 0xbc, 0xdb,
-	0x26, 0x2e, 0x61, 0x44, 0x74, 0x17, 0x64, 0xb7, 0x84, 0xa8, 0xee, 0x5e, 0x37, 0xa2, 0x2e, 0xca,
-	0x6e, 0x09, 0xe1, 0xdd, 0x47, 0xa1, 0xd4, 0x4f, 0xb4, 0x97, 0xfa, 0xd9, 0x40, 0x01, 0x30, 0xff,
-	0x62, 0x40, 0x75, 0x45, 0xb0, 0xda, 0x07, 0x46, 0x87, 0x60, 0x92, 0x3c, 0xea, 0x06, 0x6a, 0xeb,
-	0x88, 0xef, 0xa1, 0x76, 0x64, 0x3e, 0x80, 0xda, 0x9a, 0x8b, 0x5b, 0x64, 0xd3, 0x77, 0x6d, 0x12,
-	0x88, 0xb3, 0x1d, 0xd5, 0x20, 0xcf, 0x70, 0x5b, 0x05, 0x0f, 0xfc, 0x13, 0xbd, 0xa4, 0x6e, 0x70,
-	0xd2, 0x2d, 0x3d, 0xad, 0x3d, 0x65, 0x63, 0x6c, 0x62, 0x89, 0xd1, 0x05, 0x98, 0x16, 0x8f, 0x5f,
-	0x32, 0xac, 0xa8, 0x58, 0xaa, 0x65, 0xde, 0x4d, 0x8c, 0x7b, 0x23, 0xf0, 0x7b, 0x5d, 0xb4, 0x0a,
-	0x95, 0x6e, 0x1f, 0xc6, 0x6d, 0x35, 0xfb, 0x4c, 0x4f, 0x0b, 0x6d, 0x25, 0x48, 0xcd, 0x2f, 0xf3,
-	0x50, 0x5d, 0x27, 0x38, 0x68, 0x6d, 0xee, 0x87, 0x54, 0x0a, 0xd7, 0xb8, 0x4d, 0x5d, 0xb5, 0x6a,
-	0xfc, 0x13, 0x9d, 0x83, 0x03, 0xb1, 0x09, 0x35, 0xdb, 0x5c, 0x41, 0xc2, 0xee, 0x2b, 0x56, 0xad,
-	0x9b, 0x56, 0xdc, 0x8b, 0x50, 0xb4, 0xa9, 0xdb, 0x14, 0x4b, 0x54, 0x10, 0x4b, 0xa4, 0x9f, 0xdf,
-	0x0a, 0x75, 0xc5, 0xd2, 0x14, 0x6c, 0xf9, 0x81, 0x9e, 0x82, 0xaa, 0xdf, 0x63, 0xdd, 0x1e, 0x6b,
-	0x4a, 0xbf, 0x53, 0x2f, 0x0a, 0xf1, 0x2a, 0x12, 0x28, 0xdc, 0x12, 0x45, 0xaf, 0x41, 0x95, 0x0a,
-	0x55, 0x86, 0x91, 0x77, 0x69, 0xd4, 0x00, 0xb1, 0x22, 0xe9, 0x64, 0xe8, 0x8d, 0xce, 0x40, 0x8d,
-	0x05, 0xf8, 0x01, 0x71, 0x63, 0xcf, 0x5a, 0x20, 0x76, 0xdb, 0xac, 0x84, 0xf7, 0x9f, 0xb4, 0x2e,
-	0xc2, 0x5c, 0xbb, 0x87, 0x03, 0xec, 0x31, 0x42, 0x62, 0xd8, 0x65, 0x81, 0x8d, 0xa2, 0xae, 0x88,
-	0xc0, 0x7c, 0x1d, 0x26, 0x6f, 0x3a, 0x4c, 0x28, 0x92, 0xfb, 0x2c, 0x43, 0xdc, 0x73, 0x84, 0x67,
-	0x3a, 0x0c, 0xc5, 0xc0, 0x7f, 0x28, 0x7d, 0x70, 0x4e, 0x98, 0x60, 0x21, 0xf0, 0x1f, 0x0a, 0x07,
-	0x2b, 0x1e, 0xee, 0xfd, 0x40, 0xd9, 0x66, 0xce, 0x52, 0x2d, 0xf3, 0x7f, 0x8d, 0xbe, 0xf1, 0x70,
-	0xf7, 0x49, 0x1f, 0xcf, 0x7f, 0xbe, 0x02, 0x85, 0x40, 0xd2, 0x0f, 0x7d, 0xc6, 0x8c, 0x8f, 0x24,
-	0xce, 0x80, 0x90, 0xca, 0xfc, 0x1f, 0x03, 0x2a, 0xaf, 0xb9, 0x3d, 0xfa, 0x24, 0x6c, 0x58, 0xf7,
-	0x68, 0x90, 0xd7, 0x3f, 0x58, 0x7c, 0x23, 0x07, 0x55, 0x25, 0xc6, 0x38, 0xb1, 0x4d, 0xa6, 0x28,
-	0xeb, 0x50, 0xe6, 0x43, 0x36, 0x29, 0x69, 0x87, 0x19, 0x97, 0xf2, 0xf2, 0xb2, 0x76, 0xd7, 0x27,
-	0xc4, 0x10, 0x0f, 0xc0, 0xeb, 0x82, 0xe8, 0xdf, 0x3d, 0x16, 0x6c, 0x59, 0xd0, 0x8a, 0x00, 0x8d,
-	0xbb, 0x30, 0x9b, 0xea, 0xe6, 0xb6, 0x71, 0x9f, 0x6c, 0x85, 0x6e, 0xed, 0x3e, 0xd9, 0x42, 0xcf,
-	0xc7, 0x9f, 0xe9, 0xb3, 0x0e, 0xe7, 0x5b, 0xbe, 0xd7, 0xbe, 0x1a, 0x04, 0x78, 0x4b, 0x3d, 0xe3,
-	0x5f, 0xc9, 0xbd, 0x64, 0x98, 0xbf, 0xc9, 0x41, 0xe5, 0x8d, 0x1e, 0x09, 0xb6, 0x76, 0xd3, 0xbd,
-	0x84, 0xce, 0x7e, 0x32, 0xe6, 0xec, 0x07, 0x76, 0xf4, 0x94, 0x66, 0x47, 0x6b, 0xfc, 0xd2, 0xb4,
-	0xd6, 0x2f, 0xe9, 0xb6, 0x6c, 0x61, 0x47, 0x5b, 0xb6, 0x98, 0xb9, 0x65, 0xb9, 0x75, 0x2b, 0x15,
-	0x8e, 0xb5, 0xc9, 0x12, 0x51, 0x56, 0x6e, 0xa7, 0x51, 0x96, 0xf9, 0x99, 0x01, 0xa5, 0xb7, 0x49,
-	0x8b, 0xf9, 0x01, 0xf7, 0x16, 0x1a, 0xdd, 0x1b, 0x23, 0x04, 0xb2, 0xb9, 0x74, 0x20, 0x7b, 0x19,
-	0x8a, 0x8e, 0xdd, 0xc4, 0xdc, 0x6c, 0xc4, 0xe2, 0x0d, 0x0b, 0xa0, 0x0a, 0x8e, 0x2d, 0xec, 0x6b,
-	0xf4, 0xcc, 0xfb, 0x77, 0x0c, 0xa8, 0x48, 0x99, 0xa9, 0xa4, 0x7c, 0x39, 0x36, 0x9c, 0xa1, 0xb3,
-	0x65, 0xd5, 0x88, 0x26, 0x7a, 0x73, 0xa2, 0x3f, 0xec, 0x55, 0x00, 0xae, 0x3b, 0x45, 0x2e, 0xb7,
-	0xc2, 0xa2, 0x56, 0x5a, 0x49, 0x2e, 0xf4, 0x78, 0x73, 0xc2, 0x2a, 0x71, 0x2a, 0xc1, 0xe2, 0x5a,
-	0x01, 0xa6, 0x04, 0xb5, 0xf9, 0x37, 0x03, 0xe6, 0xae, 0x63, 0xb7, 0xb5, 0xe2, 0x50, 0x86, 0xbd,
-	0xd6, 0x18, 0x21, 0xd3, 0x15, 0x28, 0xf8, 0xdd, 0xa6, 0x4b, 0xee, 0x31, 0x25, 0xd2, 0xc9, 0x21,
-	0x33, 0x92, 0x6a, 0xb0, 0xa6, 0xfd, 0xee, 0x2d, 0x72, 0x8f, 0xa1, 0x7f, 0x81, 0xa2, 0xdf, 0x6d,
-	0x06, 0x4e, 0x7b, 0x93, 0x29, 0xed, 0x8f, 0x40, 0x5c, 0xf0, 0xbb, 0x16, 0xa7, 0x88, 0x65, 0x42,
-	0x26, 0x77, 0x98, 0x09, 0x31, 0xff, 0x38, 0x30, 0xfd, 0x31, 0x4c, 0xfb, 0x0a, 0x14, 0x1d, 0x8f,
-	0x35, 0x6d, 0x87, 0x86, 0x2a, 0x38, 0xa6, 0xb7, 0x21, 0x8f, 0x89, 0x19, 0x88, 0x35, 0xf5, 0x18,
-	0x1f, 0x1b, 0xbd, 0x0a, 0x70, 0xcf, 0xf5, 0xb1, 0xa2, 0x96, 0x3a, 0x38, 0xa1, 0xdf, 0x15, 0x1c,
-	0x2d, 0xa4, 0x2f, 0x09, 0x22, 0xce, 0xa1, 0xbf, 0xa4, 0xbf, 0x37, 0xe0, 0xe0, 0x1a, 0x09, 0xa8,
-	0x43, 0x19, 0xf1, 0x98, 0xca, 0x4a, 0xae, 0x7a, 0xf7, 0xfc, 0x64, 0xfa, 0xd7, 0x48, 0xa5, 0x7f,
-	0xbf, 0x9a, 0x64, 0x68, 0xe2, 0x9e, 0x23, 0x1f, 0x21, 0xc2, 0x7b, 0x4e, 0xf8, 0xd4, 0x22, 0xef,
-	0x89, 0x33, 0x19, 0xcb, 0xa4, 0xe4, 0x8d, 0x5f, 0x97, 0xcd, 0x6f, 0xca, 0xb2, 0x07, 0xed, 0xa4,
-	0x1e, 0xdf, 0x60, 0x17, 0x40, 0x39, 0xf0, 0x94, 0x3b, 0x7f, 0x06, 0x52, 0xbe, 0x23, 0xa3, 0x18,
-	0xe3, 0xbb, 0x06, 0x2c, 0x66, 0x4b, 0x35, 0xce, 0xc9, 0xfb, 0x2a, 0x4c, 0x39, 0xde, 0x3d, 0x3f,
-	0x4c, 0x92, 0x9d, 0xd5, 0x07, 0xd4, 0xda, 0x71, 0x25, 0xa1, 0xf9, 0x8b, 0x1c, 0xd4, 0x84, 0xaf,
-	0xde, 0x85, 0xe5, 0xef, 0x90, 0x4e, 0x93, 0x3a, 0xef, 0x93, 0x70, 0xf9, 0x3b, 0xa4, 0xb3, 0xee,
-	0xbc, 0x4f, 0x12, 0x96, 0x31, 0x95, 0xb4, 0x8c, 0x64, 0x1a, 0x61, 0x7a, 0x48, 0x12, 0xb4, 0x90,
-	0x4c, 0x82, 0x2e, 0xc0, 0xb4, 0xe7, 0xdb, 0x64, 0x75, 0x45, 0x5d, 0x12, 0x55, 0xab, 0x6f, 0x6a,
-	0xa5, 0x1d, 0x9a, 0xda, 0xc7, 0x06, 0x34, 0x6e, 0x10, 0x96, 0xd6, 0xdd, 0xee, 0x59, 0xd9, 0x27,
-	0x06, 0x1c, 0xd1, 0x0a, 0x34, 0x8e, 0x81, 0xbd, 0x9c, 0x34, 0x30, 0xfd, 0x8d, 0x6d, 0x60, 0x48,
-	0x65, 0x5b, 0x97, 0xa0, 0xb2, 0xd2, 0xeb, 0x74, 0xa2, 0x48, 0xea, 0x24, 0x54, 0x02, 0xf9, 0x29,
-	0x2f, 0x34, 0xf2, 0xfc, 0x2d, 0x2b, 0x18, 0xbf, 0xb6, 0x98, 0xe7, 0xa0, 0xaa, 0x48, 0x94, 0xd4,
-	0x0d, 0x28, 0x06, 0xea, 0x5b, 0xe1, 0x47, 0x6d, 0xf3, 0x20, 0xcc, 0x59, 0xa4, 0xcd, 0x4d, 0x3b,
-	0xb8, 0xe5, 0x78, 0xf7, 0xd5, 0x30, 0xe6, 0x07, 0x06, 0xcc, 0x27, 0xe1, 0x8a, 0xd7, 0x3f, 0x41,
-	0x01, 0xdb, 0x76, 0x40, 0x28, 0x1d, 0xba, 0x2c, 0x57, 0x25, 0x8e, 0x15, 0x22, 0xc7, 0x34, 0x97,
-	0x1b, 0x59, 0x73, 0x66, 0x13, 0x0e, 0xdc, 0x20, 0xec, 0x36, 0x61, 0xc1, 0x58, 0xcf, 0xe6, 0x75,
-	0x7e, 0xd5, 0x10, 0xc4, 0xca, 0x2c, 0xc2, 0xa6, 0xf9, 0x91, 0x01, 0x28, 0x3e, 0xc2, 0x38, 0xcb,
-	0x1c, 0xd7, 0x72, 0x2e, 0xa9, 0x65, 0x59, 0x59, 0xd4, 0xe9, 0xfa, 0x1e, 0xf1, 0x58, 0x3c, 0x66,
-	0xad, 0x46, 0x50, 0x61, 0x7e, 0x9f, 0x1b, 0x80, 0x6e, 0xf9, 0xd8, 0xbe, 0x86, 0xdd, 0xf1, 0xc2,
-	0x83, 0x63, 0x00, 0x34, 0x68, 0x35, 0xd5, 0x6e, 0xcd, 0x29, 0xef, 0x13, 0xb4, 0xee, 0xc8, 0x0d,
-	0x7b, 0x02, 0xca, 0x36, 0x65, 0xaa, 0x3b, 0x7c, 0xc5, 0x05, 0x9b, 0x32, 0xd9, 0x2f, 0xaa, 0x32,
-	0x29, 0xc1, 0x2e, 0xb1, 0x9b, 0xb1, 0xe7, 0xb1, 0x49, 0x81, 0x56, 0x93, 0x1d, 0xeb, 0xfd, 0x47,
-	0xb2, 0xbb, 0x70, 0xe8, 0x36, 0xf6, 0x7a, 0xd8, 0xbd, 0xee, 0x77, 0xba, 0x38, 0x51, 0x4d, 0x98,
-	0x76, 0x73, 0x86, 0xc6, 0xcd, 0x1d, 0x97, 0xe5, 0x66, 0x32, 0x62, 0x16, 0xb2, 0x4e, 0x5a, 0x31,
-	0x88, 0x49, 0xa1, 0x3e, 0xc8, 0x7e, 0x9c, 0x85, 0x12, 0x42, 0x85, 0xac, 0xe2, 0xbe, 0xb7, 0x0f,
-	0x33, 0x5f, 0x81, 0xc3, 0xa2, 0xf4, 0x2f, 0x04, 0x25, 0x12, 0xf1, 0x69, 0x06, 0x86, 0x86, 0xc1,
-	0xff, 0xe7, 0x84, 0x6b, 0x1b, 0xe0, 0x30, 0x8e, 0xe0, 0x57, 0x92, 0xf9, 0xef, 0xa7, 0xb5, 0x34,
-	0xe9, 0x11, 0x55, 0x12, 0x7c, 0x09, 0x66, 0xc9, 0x23, 0xd2, 0xea, 0x31, 0xc7, 0x6b, 0xaf, 0xb9,
-	0xd8, 0xbb, 0xe3, 0xab, 0x03, 0x25, 0x0d, 0x46, 0x4f, 0x43, 0x95, 0x6b, 0xdf, 0xef, 0x31, 0x85,
-	0x27, 0x4f, 0x96, 0x24, 0x90, 0xf3, 0xe3, 0xf3, 0x75, 0x09, 0x23, 0xb6, 0xc2, 0x93, 0xc7, 0x4c,
-	0x1a, 0x3c, 0xa0, 0x4a, 0x0e, 0xa6, 0x3b, 0x51, 0xe5, 0x9f, 0x8d, 0x94, 0x2a, 0x15, 0x87, 0xdd,
-	0x52, 0xe5, 0x4d, 0x80, 0x0e, 0x09, 0xda, 0x64, 0x55, 0x38, 0x75, 0x79, 0x21, 0x5f, 0xd2, 0x3a,
-	0xf5, 0x3e, 0x83, 0xdb, 0x21, 0x81, 0x15, 0xa3, 0x35, 0x6f, 0xc0, 0x9c, 0x06, 0x85, 0xfb, 0x2b,
-	0xea, 0xf7, 0x82, 0x16, 0x09, 0x53, 0x35, 0x61, 0x93, 0x9f, 0x6f, 0x0c, 0x07, 0x6d, 0xc2, 0x94,
-	0xd1, 0xaa, 0xd6, 0xd9, 0x93, 0x50, 0x0c, 0x4b, 0x44, 0x50, 0x01, 0xf2, 0x57, 0x5d, 0xb7, 0x36,
-	0x81, 0x2a, 0x50, 0x5c, 0x55, 0x75, 0x10, 0x35, 0xe3, 0xec, 0xbf, 0xc1, 0x6c, 0x2a, 0x07, 0x89,
-	0x8a, 0x30, 0x79, 0xc7, 0xf7, 0x48, 0x6d, 0x02, 0xd5, 0xa0, 0x72, 0xcd, 0xf1, 0x70, 0xb0, 0x25,
-	0x63, 0xfe, 0x9a, 0x8d, 0x66, 0xa1, 0x2c, 0x62, 0x5f, 0x05, 0x20, 0xcb, 0xdf, 0x3b, 0x0e, 0xd5,
-	0xdb, 0x62, 0x5a, 0xeb, 0x24, 0x78, 0xe0, 0xb4, 0x08, 0x6a, 0x42, 0x2d, 0xfd, 0xa3, 0x09, 0x7a,
-	0x56, 0xaf, 0x07, 0xfd, 0xff, 0x28, 0x8d, 0x61, 0x4b, 0x65, 0x4e, 0xa0, 0x77, 0x61, 0x26, 0xf9,
-	0x0b, 0x08, 0xd2, 0x07, 0x67, 0xda, 0xff, 0x44, 0xb6, 0x63, 0xde, 0x84, 0x6a, 0xe2, 0x8f, 0x0e,
-	0x74, 0x46, 0xcb, 0x5b, 0xf7, 0xd7, 0x47, 0x43, 0x7f, 0x5f, 0x8a, 0xff, 0x75, 0x21, 0xa5, 0x4f,
-	0xd6, 0x7c, 0x67, 0x48, 0xaf, 0x2d, 0x0c, 0xdf, 0x4e, 0x7a, 0x0c, 0x07, 0x06, 0x4a, 0xb8, 0xd1,
-	0x79, 0x2d, 0xff, 0xac, 0x52, 0xef, 0xed, 0x86, 0x78, 0x08, 0x68, 0xf0, 0xcf, 0x05, 0x74, 0x41,
-	0xbf, 0x02, 0x59, 0xff, 0x6d, 0x34, 0x2e, 0x8e, 0x8c, 0x1f, 0x29, 0xee, 0xff, 0x0c, 0x38, 0x94,
-	0x51, 0x77, 0x8d, 0x2e, 0x6b, 0xd9, 0x0d, 0x2f, 0x1e, 0x6f, 0x3c, 0xbf, 0x33, 0xa2, 0x48, 0x10,
-	0x0f, 0x66, 0x53, 0xa5, 0xc8, 0xe8, 0x5c, 0x66, 0x79, 0xd6, 0x60, 0x4d, 0x76, 0xe3, 0xd9, 0xd1,
-	0x90, 0xa3, 0xf1, 0xee, 0xc2, 0x6c, 0xaa, 0x7e, 0x37, 0x63, 0x3c, 0x7d, 0x95, 0xef, 0x76, 0x0b,
-	0xfa, 0x0e, 0x54, 0x13, 0x85, 0xb6, 0x19, 0x16, 0xaf, 0x2b, 0xc6, 0xdd, 0x8e, 0xf5, 0x5d, 0xa8,
-	0xc4, 0xeb, 0x61, 0xd1, 0x52, 0xd6, 0x5e, 0x1a, 0x60, 0xbc, 0x93, 0xad, 0xd4, 0x2f, 0x77, 0x1b,
-	0xb2, 0x95, 0x06, 0x2a, 0x04, 0x47, 0xdf, 0x4a, 0x31, 0xfe, 0x43, 0xb7, 0xd2, 0x8e, 0x87, 0xf8,
-	0xc0, 0x80, 0x05, 0x7d, 0x39, 0x25, 0x5a, 0xce, 0xb2, 0xcd, 0xec, 0xc2, 0xd1, 0xc6, 0xe5, 0x1d,
-	0xd1, 0x44, 0x5a, 0xbc, 0x0f, 0x33, 0xc9, 0xa2, 0xc1, 0x0c, 0x2d, 0x6a, 0xeb, 0x2c, 0x1b, 0xe7,
-	0x46, 0xc2, 0x8d, 0x06, 0x7b, 0x0b, 0xca, 0xb1, 0x7f, 0x47, 0xd1, 0xe9, 0x21, 0x76, 0x1c, 0xff,
-	0x91, 0x72, 0x3b, 0x4d, 0xbe, 0x01, 0xa5, 0xe8, 0x97, 0x4f, 0x74, 0x2a, 0xd3, 0x7e, 0x77, 0xc2,
-	0x72, 0x1d, 0xa0, 0xff, 0x3f, 0x27, 0x7a, 0x46, 0xcb, 0x73, 0xe0, 0x87, 0xcf, 0xed, 0x98, 0x46,
-	0xd3, 0x97, 0x8f, 0xb8, 0xc3, 0xa6, 0x1f, 0xaf, 0x3a, 0xd8, 0x8e, 0xed, 0x26, 0x54, 0x13, 0xb5,
-	0x42, 0x59, 0x5b, 0x58, 0x53, 0xc2, 0xd5, 0x38, 0x3b, 0x0a, 0x6a, 0xb4, 0x7e, 0x9b, 0x50, 0x4d,
-	0x54, 0x6e, 0x64, 0x8c, 0xa4, 0x2b, 0x54, 0xc9, 0x18, 0x49, 0x5b, 0x08, 0x62, 0x4e, 0xa0, 0xff,
-	0x8e, 0x15, 0x89, 0x24, 0x0a, 0x71, 0xd0, 0xa5, 0xa1, 0x7c, 0x74, 0x75, 0x48, 0x8d, 0xe5, 0x9d,
-	0x90, 0x44, 0x22, 0x28, 0xab, 0x92, 0x2a, 0xcd, 0xb6, 0xaa, 0x9d, 0xac, 0xd4, 0x3a, 0x4c, 0xcb,
-	0x5a, 0x0c, 0x64, 0x66, 0x54, 0x5d, 0xc5, 0x0a, 0x35, 0x1a, 0x4f, 0x69, 0x71, 0x92, 0x65, 0x0a,
-	0x92, 0xa9, 0x7c, 0x6b, 0xcf, 0x60, 0x9a, 0x78, 0x88, 0x1f, 0x95, 0xa9, 0x05, 0xd3, 0xf2, 0x91,
-	0x2d, 0x83, 0x69, 0xe2, 0xa1, 0xb8, 0x31, 0x1c, 0x47, 0xbe, 0xcc, 0x4d, 0xa0, 0x35, 0x98, 0x12,
-	0x8f, 0x51, 0xe8, 0xe4, 0xb0, 0x87, 0xaa, 0x61, 0x1c, 0x13, 0x6f, 0x59, 0xe6, 0x04, 0xfa, 0x0f,
-	0x98, 0x12, 0x29, 0x92, 0x0c, 0x8e, 0xf1, 0xd7, 0xa6, 0xc6, 0x50, 0x94, 0x50, 0x44, 0x1b, 0x2a,
-	0xf1, 0x5c, 0x74, 0xc6, 0x91, 0xa5, 0xc9, 0xd6, 0x37, 0x46, 0xc1, 0x0c, 0x47, 0xf9, 0x9a, 0x01,
-	0xf5, 0xac, 0xb4, 0x25, 0xca, 0x8c, 0x4b, 0x86, 0xe5, 0x5e, 0x1b, 0x2f, 0xec, 0x90, 0x2a, 0x52,
-	0xe1, 0xfb, 0x30, 0xa7, 0xc9, 0x6d, 0xa1, 0x8b, 0x59, 0xfc, 0x32, 0xd2, 0x72, 0x8d, 0xe7, 0x46,
-	0x27, 0x88, 0xc6, 0x5e, 0x83, 0x29, 0x91, 0x93, 0xca, 0x58, 0xbe, 0x78, 0x8a, 0x2b, 0xc3, 0x20,
-	0x12, 0x29, 0x2d, 0x73, 0x02, 0x11, 0xa8, 0xc4, 0x13, 0x54, 0x19, 0xeb, 0xa7, 0xc9, 0x6d, 0x35,
-	0xce, 0x8c, 0x80, 0x19, 0x0d, 0xd3, 0x04, 0xe8, 0x27, 0x88, 0x32, 0x4e, 0x87, 0x81, 0x1c, 0x55,
-	0xe3, 0xf4, 0xb6, 0x78, 0xf1, 0x83, 0x32, 0x96, 0xf2, 0xc9, 0x38, 0x29, 0x06, 0x93, 0x42, 0x23,
-	0x44, 0xef, 0x83, 0xe9, 0x87, 0x8c, 0xe8, 0x3d, 0x33, 0xd3, 0xd1, 0xb8, 0x38, 0x32, 0x7e, 0x34,
-	0x9f, 0xf7, 0xa0, 0x96, 0x4e, 0xd7, 0x64, 0xdc, 0x0a, 0x33, 0x92, 0x46, 0x8d, 0xf3, 0x23, 0x62,
-	0xc7, 0x4f, 0x90, 0x23, 0x83, 0x32, 0xfd, 0xa7, 0xc3, 0x36, 0x45, 0xa6, 0x60, 0x94, 0x59, 0xc7,
-	0x93, 0x12, 0xa3, 0xcc, 0x3a, 0x91, 0x82, 0x30, 0x27, 0x96, 0x7b, 0x50, 0x59, 0x0b, 0xfc, 0x47,
-	0x5b, 0xe1, 0xdd, 0xf8, 0x1f, 0x63, 0x9d, 0xd7, 0x5e, 0xf8, 0xaf, 0xcb, 0x6d, 0x87, 0x6d, 0xf6,
-	0x36, 0xf8, 0xfa, 0x5f, 0x94, 0xb8, 0xe7, 0x1d, 0x5f, 0x7d, 0x5d, 0x74, 0x3c, 0x46, 0x02, 0x0f,
-	0xbb, 0x17, 0x05, 0x2f, 0x05, 0xed, 0x6e, 0x6c, 0x4c, 0x8b, 0xf6, 0xe5, 0xbf, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x24, 0x45, 0x91, 0xd1, 0x21, 0x44, 0x00, 0x00,
+func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest{} }
+func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCredentialRequest) ProtoMessage()    {}
+func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{82}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MilvusServiceClient is the client API for MilvusService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MilvusServiceClient interface {
-	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
-	LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
-	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
-	LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error)
-	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
-	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
-	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
-	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
-	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
-	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
-	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
-	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
-	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
-	// TODO: remove
-	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
-	LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error)
-	ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error)
-	GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error)
+func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCredentialRequest.Unmarshal(m, b)
 }
-
-type milvusServiceClient struct {
-	cc *grpc.ClientConn
+func (m *CreateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCredentialRequest.Marshal(b, m, deterministic)
 }
-
-func NewMilvusServiceClient(cc *grpc.ClientConn) MilvusServiceClient {
-	return &milvusServiceClient{cc}
+func (m *CreateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCredentialRequest.Merge(m, src)
 }
-
-func (c *milvusServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CreateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCredentialRequest.Size(m)
+}
+func (m *CreateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCredentialRequest.DiscardUnknown(m)
 }
 
-func (c *milvusServiceClient) DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateCredentialRequest proto.InternalMessageInfo
+
+func (m *CreateCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
-	out := new(BoolResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateCredentialRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReleaseCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UpdateCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// old password in plain text, must match the stored one
+	OldPassword string `protobuf:"bytes,3,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	// new password in plain text
+	NewPassword          string   `protobuf:"bytes,4,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *milvusServiceClient) DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error) {
-	out := new(DescribeCollectionResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DescribeCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest{} }
+func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCredentialRequest) ProtoMessage()    {}
+func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{83}
 }
 
-func (c *milvusServiceClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
-	out := new(GetCollectionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCollectionStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCredentialRequest.Unmarshal(m, b)
+}
+func (m *UpdateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCredentialRequest.Merge(m, src)
+}
+func (m *UpdateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCredentialRequest.Size(m)
+}
+func (m *UpdateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCredentialRequest.DiscardUnknown(m)
 }
 
-func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error) {
-	out := new(ShowCollectionsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ShowCollections", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateCredentialRequest proto.InternalMessageInfo
+
+func (m *UpdateCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropPartition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateCredentialRequest) GetOldPassword() string {
+	if m != nil {
+		return m.OldPassword
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
-	out := new(BoolResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasPartition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateCredentialRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadPartitions", in, out, opts...)
-	if err != nil {
+type DeleteCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest{} }
+func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCredentialRequest) ProtoMessage()    {}
+func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{84}
+}
+
+func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCredentialRequest.Unmarshal(m, b)
+}
+func (m *DeleteCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCredentialRequest.Merge(m, src)
+}
+func (m *DeleteCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCredentialRequest.Size(m)
+}
+func (m *DeleteCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCredentialRequest proto.InternalMessageInfo
+
+func (m *DeleteCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeleteCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type ListCredUsersRequest struct {
+	// Not useful for now
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListCredUsersRequest) Reset()         { *m = ListCredUsersRequest{} }
+func (m *ListCredUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersRequest) ProtoMessage()    {}
+func (*ListCredUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{85}
+}
+
+func (m *ListCredUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersRequest.Unmarshal(m, b)
+}
+func (m *ListCredUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCredUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersRequest.Merge(m, src)
+}
+func (m *ListCredUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersRequest.Size(m)
+}
+func (m *ListCredUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCredUsersRequest proto.InternalMessageInfo
+
+func (m *ListCredUsersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListCredUsersResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// username array
+	Usernames            []string `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCredUsersResponse) Reset()         { *m = ListCredUsersResponse{} }
+func (m *ListCredUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersResponse) ProtoMessage()    {}
+func (*ListCredUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{86}
+}
+
+func (m *ListCredUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersResponse.Unmarshal(m, b)
+}
+func (m *ListCredUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCredUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersResponse.Merge(m, src)
+}
+func (m *ListCredUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersResponse.Size(m)
+}
+func (m *ListCredUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCredUsersResponse proto.InternalMessageInfo
+
+func (m *ListCredUsersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListCredUsersResponse) GetUsernames() []string {
+	if m != nil {
+		return m.Usernames
+	}
+	return nil
+}
+
+type RoleEntity struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleEntity) Reset()         { *m = RoleEntity{} }
+func (m *RoleEntity) String() string { return proto.CompactTextString(m) }
+func (*RoleEntity) ProtoMessage()    {}
+func (*RoleEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{87}
+}
+
+func (m *RoleEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleEntity.Unmarshal(m, b)
+}
+func (m *RoleEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleEntity.Marshal(b, m, deterministic)
+}
+func (m *RoleEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleEntity.Merge(m, src)
+}
+func (m *RoleEntity) XXX_Size() int {
+	return xxx_messageInfo_RoleEntity.Size(m)
+}
+func (m *RoleEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleEntity proto.InternalMessageInfo
+
+func (m *RoleEntity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type UserEntity struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEntity) Reset()         { *m = UserEntity{} }
+func (m *UserEntity) String() string { return proto.CompactTextString(m) }
+func (*UserEntity) ProtoMessage()    {}
+func (*UserEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{88}
+}
+
+func (m *UserEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEntity.Unmarshal(m, b)
+}
+func (m *UserEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEntity.Marshal(b, m, deterministic)
+}
+func (m *UserEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEntity.Merge(m, src)
+}
+func (m *UserEntity) XXX_Size() int {
+	return xxx_messageInfo_UserEntity.Size(m)
+}
+func (m *UserEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEntity proto.InternalMessageInfo
+
+func (m *UserEntity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CreateRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role
+	Entity               *RoleEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{89}
+}
+
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleRequest.Unmarshal(m, b)
+}
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
+}
+func (m *CreateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleRequest.Size(m)
+}
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
+
+func (m *CreateRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateRoleRequest) GetEntity() *RoleEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+type DropRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role name
+	RoleName             string   `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
+func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DropRoleRequest) ProtoMessage()    {}
+func (*DropRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{90}
+}
+
+func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleRequest.Unmarshal(m, b)
+}
+func (m *DropRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *DropRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleRequest.Merge(m, src)
+}
+func (m *DropRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_DropRoleRequest.Size(m)
+}
+func (m *DropRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropRoleRequest proto.InternalMessageInfo
+
+func (m *DropRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type OperateUserRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// role name
+	RoleName string `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// operation type
+	Type                 OperateUserRoleType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.OperateUserRoleType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *OperateUserRoleRequest) Reset()         { *m = OperateUserRoleRequest{} }
+func (m *OperateUserRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateUserRoleRequest) ProtoMessage()    {}
+func (*OperateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{91}
+}
+
+func (m *OperateUserRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateUserRoleRequest.Unmarshal(m, b)
+}
+func (m *OperateUserRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateUserRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *OperateUserRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateUserRoleRequest.Merge(m, src)
+}
+func (m *OperateUserRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateUserRoleRequest.Size(m)
+}
+func (m *OperateUserRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateUserRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateUserRoleRequest proto.InternalMessageInfo
+
+func (m *OperateUserRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperateUserRoleRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *OperateUserRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *OperateUserRoleRequest) GetType() OperateUserRoleType {
+	if m != nil {
+		return m.Type
+	}
+	return OperateUserRoleType_AddUserToRole
+}
+
+type ObjectEntity struct {
+	// the name of common.ObjectType, e.g. Collection, Global, User
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectEntity) Reset()         { *m = ObjectEntity{} }
+func (m *ObjectEntity) String() string { return proto.CompactTextString(m) }
+func (*ObjectEntity) ProtoMessage()    {}
+func (*ObjectEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{92}
+}
+
+func (m *ObjectEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectEntity.Unmarshal(m, b)
+}
+func (m *ObjectEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectEntity.Marshal(b, m, deterministic)
+}
+func (m *ObjectEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectEntity.Merge(m, src)
+}
+func (m *ObjectEntity) XXX_Size() int {
+	return xxx_messageInfo_ObjectEntity.Size(m)
+}
+func (m *ObjectEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectEntity proto.InternalMessageInfo
+
+func (m *ObjectEntity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type PrivilegeEntity struct {
+	// the name of common.ObjectPrivilege without the "Privilege" prefix, e.g. Insert, Search
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrivilegeEntity) Reset()         { *m = PrivilegeEntity{} }
+func (m *PrivilegeEntity) String() string { return proto.CompactTextString(m) }
+func (*PrivilegeEntity) ProtoMessage()    {}
+func (*PrivilegeEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{93}
+}
+
+func (m *PrivilegeEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrivilegeEntity.Unmarshal(m, b)
+}
+func (m *PrivilegeEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrivilegeEntity.Marshal(b, m, deterministic)
+}
+func (m *PrivilegeEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivilegeEntity.Merge(m, src)
+}
+func (m *PrivilegeEntity) XXX_Size() int {
+	return xxx_messageInfo_PrivilegeEntity.Size(m)
+}
+func (m *PrivilegeEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivilegeEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivilegeEntity proto.InternalMessageInfo
+
+func (m *PrivilegeEntity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GrantorEntity struct {
+	User                 *UserEntity      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Privilege            *PrivilegeEntity `protobuf:"bytes,2,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GrantorEntity) Reset()         { *m = GrantorEntity{} }
+func (m *GrantorEntity) String() string { return proto.CompactTextString(m) }
+func (*GrantorEntity) ProtoMessage()    {}
+func (*GrantorEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{94}
+}
+
+func (m *GrantorEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantorEntity.Unmarshal(m, b)
+}
+func (m *GrantorEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantorEntity.Marshal(b, m, deterministic)
+}
+func (m *GrantorEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantorEntity.Merge(m, src)
+}
+func (m *GrantorEntity) XXX_Size() int {
+	return xxx_messageInfo_GrantorEntity.Size(m)
+}
+func (m *GrantorEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantorEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantorEntity proto.InternalMessageInfo
+
+func (m *GrantorEntity) GetUser() *UserEntity {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *GrantorEntity) GetPrivilege() *PrivilegeEntity {
+	if m != nil {
+		return m.Privilege
+	}
+	return nil
+}
+
+type GrantEntity struct {
+	// role
+	Role *RoleEntity `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// object
+	Object *ObjectEntity `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	// object name, "*" means all the objects of the object type
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// privilege and the user who grants it
+	Grantor *GrantorEntity `protobuf:"bytes,4,opt,name=grantor,proto3" json:"grantor,omitempty"`
+	// the database of the collection object, the default database if not set
+	DbName               string   `protobuf:"bytes,5,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
+func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
+func (*GrantEntity) ProtoMessage()    {}
+func (*GrantEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{95}
+}
+
+func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantEntity.Unmarshal(m, b)
+}
+func (m *GrantEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantEntity.Marshal(b, m, deterministic)
+}
+func (m *GrantEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantEntity.Merge(m, src)
+}
+func (m *GrantEntity) XXX_Size() int {
+	return xxx_messageInfo_GrantEntity.Size(m)
+}
+func (m *GrantEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantEntity proto.InternalMessageInfo
+
+func (m *GrantEntity) GetRole() *RoleEntity {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *GrantEntity) GetObject() *ObjectEntity {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *GrantEntity) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetGrantor() *GrantorEntity {
+	if m != nil {
+		return m.Grantor
+	}
+	return nil
+}
+
+func (m *GrantEntity) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type SelectGrantRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the role is required, the object and object name are optional filters
+	Entity               *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SelectGrantRequest) Reset()         { *m = SelectGrantRequest{} }
+func (m *SelectGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantRequest) ProtoMessage()    {}
+func (*SelectGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{96}
+}
+
+func (m *SelectGrantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantRequest.Unmarshal(m, b)
+}
+func (m *SelectGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantRequest.Merge(m, src)
+}
+func (m *SelectGrantRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantRequest.Size(m)
+}
+func (m *SelectGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantRequest proto.InternalMessageInfo
+
+func (m *SelectGrantRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SelectGrantRequest) GetEntity() *GrantEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+type SelectGrantResponse struct {
+	// Not useful for now
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// grant info array
+	Entities             []*GrantEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SelectGrantResponse) Reset()         { *m = SelectGrantResponse{} }
+func (m *SelectGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantResponse) ProtoMessage()    {}
+func (*SelectGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{97}
+}
+
+func (m *SelectGrantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantResponse.Unmarshal(m, b)
+}
+func (m *SelectGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantResponse.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantResponse.Merge(m, src)
+}
+func (m *SelectGrantResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantResponse.Size(m)
+}
+func (m *SelectGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantResponse proto.InternalMessageInfo
+
+func (m *SelectGrantResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SelectGrantResponse) GetEntities() []*GrantEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+type OperatePrivilegeRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// grant
+	Entity *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// operation type
+	Type                 OperatePrivilegeType `protobuf:"varint,3,opt,name=type,proto3,enum=milvus.proto.milvus.OperatePrivilegeType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OperatePrivilegeRequest) Reset()         { *m = OperatePrivilegeRequest{} }
+func (m *OperatePrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*OperatePrivilegeRequest) ProtoMessage()    {}
+func (*OperatePrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{98}
+}
+
+func (m *OperatePrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperatePrivilegeRequest.Unmarshal(m, b)
+}
+func (m *OperatePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperatePrivilegeRequest.Marshal(b, m, deterministic)
+}
+func (m *OperatePrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatePrivilegeRequest.Merge(m, src)
+}
+func (m *OperatePrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_OperatePrivilegeRequest.Size(m)
+}
+func (m *OperatePrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatePrivilegeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatePrivilegeRequest proto.InternalMessageInfo
+
+func (m *OperatePrivilegeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetEntity() *GrantEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetType() OperatePrivilegeType {
+	if m != nil {
+		return m.Type
+	}
+	return OperatePrivilegeType_Grant
+}
+
+type CreateDatabaseRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The unique database name in milvus.(Required)
+	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{99}
+}
+
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
+}
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
+}
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
+}
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
+
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type DropDatabaseRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The unique database name in milvus.(Required)
+	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{100}
+}
+
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
+}
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
+}
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
+}
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
+
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type ListDatabasesRequest struct {
+	// Not useful for now
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{101}
+}
+
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
+}
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
+}
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
+}
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
+
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListDatabasesResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Database name array
+	DbNames []string `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	// Hybrid timestamps in milvus
+	CreatedTimestamps    []uint64 `protobuf:"varint,3,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{102}
+}
+
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
+}
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
+}
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
+}
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
+
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetDbNames() []string {
+	if m != nil {
+		return m.DbNames
+	}
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetCreatedTimestamps() []uint64 {
+	if m != nil {
+		return m.CreatedTimestamps
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
+	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
+	proto.RegisterEnum("milvus.proto.milvus.OperateUserRoleType", OperateUserRoleType_name, OperateUserRoleType_value)
+	proto.RegisterEnum("milvus.proto.milvus.OperatePrivilegeType", OperatePrivilegeType_name, OperatePrivilegeType_value)
+	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
+	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
+	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
+	proto.RegisterType((*AddCollectionFieldRequest)(nil), "milvus.proto.milvus.AddCollectionFieldRequest")
+	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
+	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
+	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
+	proto.RegisterType((*BoolResponse)(nil), "milvus.proto.milvus.BoolResponse")
+	proto.RegisterType((*StringResponse)(nil), "milvus.proto.milvus.StringResponse")
+	proto.RegisterType((*DescribeCollectionRequest)(nil), "milvus.proto.milvus.DescribeCollectionRequest")
+	proto.RegisterType((*DescribeCollectionResponse)(nil), "milvus.proto.milvus.DescribeCollectionResponse")
+	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.milvus.LoadCollectionRequest")
+	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.milvus.ReleaseCollectionRequest")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.milvus.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
+	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
+	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
+	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
+	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
+	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
+	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.milvus.LoadPartitionsRequest")
+	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.milvus.ReleasePartitionsRequest")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.milvus.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.milvus.GetPartitionStatisticsResponse")
+	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.milvus.ShowPartitionsRequest")
+	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.milvus.ShowPartitionsResponse")
+	proto.RegisterType((*DescribeSegmentRequest)(nil), "milvus.proto.milvus.DescribeSegmentRequest")
+	proto.RegisterType((*DescribeSegmentResponse)(nil), "milvus.proto.milvus.DescribeSegmentResponse")
+	proto.RegisterType((*ShowSegmentsRequest)(nil), "milvus.proto.milvus.ShowSegmentsRequest")
+	proto.RegisterType((*ShowSegmentsResponse)(nil), "milvus.proto.milvus.ShowSegmentsResponse")
+	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.milvus.CreateIndexRequest")
+	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.milvus.DescribeIndexRequest")
+	proto.RegisterType((*IndexDescription)(nil), "milvus.proto.milvus.IndexDescription")
+	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.milvus.DescribeIndexResponse")
+	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.milvus.GetIndexBuildProgressRequest")
+	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.milvus.GetIndexBuildProgressResponse")
+	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.milvus.GetIndexStateRequest")
+	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.milvus.GetIndexStateResponse")
+	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.milvus.DropIndexRequest")
+	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
+	proto.RegisterType((*UpsertRequest)(nil), "milvus.proto.milvus.UpsertRequest")
+	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
+	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
+	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
+	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
+	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
+	proto.RegisterType((*HybridSearchRequest)(nil), "milvus.proto.milvus.HybridSearchRequest")
+	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
+	proto.RegisterType((*SearchResults)(nil), "milvus.proto.milvus.SearchResults")
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.milvus.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
+	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
+	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
+	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
+	proto.RegisterType((*OpenQueryIteratorRequest)(nil), "milvus.proto.milvus.OpenQueryIteratorRequest")
+	proto.RegisterType((*OpenQueryIteratorResponse)(nil), "milvus.proto.milvus.OpenQueryIteratorResponse")
+	proto.RegisterType((*NextQueryIteratorRequest)(nil), "milvus.proto.milvus.NextQueryIteratorRequest")
+	proto.RegisterType((*QueryIteratorResults)(nil), "milvus.proto.milvus.QueryIteratorResults")
+	proto.RegisterType((*CloseQueryIteratorRequest)(nil), "milvus.proto.milvus.CloseQueryIteratorRequest")
+	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
+	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
+	proto.RegisterType((*CalcDistanceRequest)(nil), "milvus.proto.milvus.CalcDistanceRequest")
+	proto.RegisterType((*CalcDistanceResults)(nil), "milvus.proto.milvus.CalcDistanceResults")
+	proto.RegisterType((*PersistentSegmentInfo)(nil), "milvus.proto.milvus.PersistentSegmentInfo")
+	proto.RegisterType((*GetPersistentSegmentInfoRequest)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoRequest")
+	proto.RegisterType((*GetPersistentSegmentInfoResponse)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoResponse")
+	proto.RegisterType((*QuerySegmentInfo)(nil), "milvus.proto.milvus.QuerySegmentInfo")
+	proto.RegisterType((*GetQuerySegmentInfoRequest)(nil), "milvus.proto.milvus.GetQuerySegmentInfoRequest")
+	proto.RegisterType((*GetQuerySegmentInfoResponse)(nil), "milvus.proto.milvus.GetQuerySegmentInfoResponse")
+	proto.RegisterType((*DummyRequest)(nil), "milvus.proto.milvus.DummyRequest")
+	proto.RegisterType((*DummyResponse)(nil), "milvus.proto.milvus.DummyResponse")
+	proto.RegisterType((*RegisterLinkRequest)(nil), "milvus.proto.milvus.RegisterLinkRequest")
+	proto.RegisterType((*RegisterLinkResponse)(nil), "milvus.proto.milvus.RegisterLinkResponse")
+	proto.RegisterType((*GetMetricsRequest)(nil), "milvus.proto.milvus.GetMetricsRequest")
+	proto.RegisterType((*GetMetricsResponse)(nil), "milvus.proto.milvus.GetMetricsResponse")
+	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.milvus.LoadBalanceRequest")
+	proto.RegisterType((*ManualCompactionRequest)(nil), "milvus.proto.milvus.ManualCompactionRequest")
+	proto.RegisterType((*ManualCompactionResponse)(nil), "milvus.proto.milvus.ManualCompactionResponse")
+	proto.RegisterType((*GetCompactionStateRequest)(nil), "milvus.proto.milvus.GetCompactionStateRequest")
+	proto.RegisterType((*GetCompactionStateResponse)(nil), "milvus.proto.milvus.GetCompactionStateResponse")
+	proto.RegisterType((*GetCompactionPlansRequest)(nil), "milvus.proto.milvus.GetCompactionPlansRequest")
+	proto.RegisterType((*GetCompactionPlansResponse)(nil), "milvus.proto.milvus.GetCompactionPlansResponse")
+	proto.RegisterType((*CompactionMergeInfo)(nil), "milvus.proto.milvus.CompactionMergeInfo")
+	proto.RegisterType((*CreateCredentialRequest)(nil), "milvus.proto.milvus.CreateCredentialRequest")
+	proto.RegisterType((*UpdateCredentialRequest)(nil), "milvus.proto.milvus.UpdateCredentialRequest")
+	proto.RegisterType((*DeleteCredentialRequest)(nil), "milvus.proto.milvus.DeleteCredentialRequest")
+	proto.RegisterType((*ListCredUsersRequest)(nil), "milvus.proto.milvus.ListCredUsersRequest")
+	proto.RegisterType((*ListCredUsersResponse)(nil), "milvus.proto.milvus.ListCredUsersResponse")
+	proto.RegisterType((*RoleEntity)(nil), "milvus.proto.milvus.RoleEntity")
+	proto.RegisterType((*UserEntity)(nil), "milvus.proto.milvus.UserEntity")
+	proto.RegisterType((*CreateRoleRequest)(nil), "milvus.proto.milvus.CreateRoleRequest")
+	proto.RegisterType((*DropRoleRequest)(nil), "milvus.proto.milvus.DropRoleRequest")
+	proto.RegisterType((*OperateUserRoleRequest)(nil), "milvus.proto.milvus.OperateUserRoleRequest")
+	proto.RegisterType((*ObjectEntity)(nil), "milvus.proto.milvus.ObjectEntity")
+	proto.RegisterType((*PrivilegeEntity)(nil), "milvus.proto.milvus.PrivilegeEntity")
+	proto.RegisterType((*GrantorEntity)(nil), "milvus.proto.milvus.GrantorEntity")
+	proto.RegisterType((*GrantEntity)(nil), "milvus.proto.milvus.GrantEntity")
+	proto.RegisterType((*SelectGrantRequest)(nil), "milvus.proto.milvus.SelectGrantRequest")
+	proto.RegisterType((*SelectGrantResponse)(nil), "milvus.proto.milvus.SelectGrantResponse")
+	proto.RegisterType((*OperatePrivilegeRequest)(nil), "milvus.proto.milvus.OperatePrivilegeRequest")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.milvus.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.milvus.DropDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.milvus.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.milvus.ListDatabasesResponse")
+}
+
+func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
+
+var fileDescriptor_02345ba45cc0e303 = []byte{
+	// 4761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0xc9,
+	0x75, 0xea, 0x19, 0xce, 0xef, 0xcd, 0x0c, 0x39, 0x2c, 0x7e, 0x34, 0x1a, 0x49, 0xbb, 0x54, 0xef,
+	0xca, 0x4b, 0x51, 0x96, 0x64, 0x51, 0xbb, 0xde, 0xcd, 0x7a, 0x9d, 0x5d, 0x51, 0xf4, 0x4a, 0x8c,
+	0xf5, 0xa1, 0x9b, 0x2b, 0x1b, 0x8e, 0xa1, 0x8c, 0x9b, 0xd3, 0xc5, 0x61, 0x9b, 0x3d, 0xdd, 0xe3,
+	0xae, 0x1a, 0x4a, 0xb3, 0x08, 0x02, 0x03, 0xce, 0xc7, 0x81, 0x93, 0x35, 0x02, 0x07, 0x0e, 0x12,
+	0x20, 0x09, 0x90, 0x2f, 0x82, 0x5c, 0xe2, 0x38, 0x70, 0x02, 0x5f, 0x8c, 0x00, 0x39, 0xe4, 0x10,
+	0x20, 0x9f, 0x4b, 0x0e, 0xb9, 0xe4, 0x9a, 0x43, 0x8e, 0x09, 0x72, 0xc8, 0xc1, 0xa8, 0x4f, 0xf7,
+	0x74, 0xf7, 0x54, 0xcf, 0x0c, 0x35, 0xd2, 0x92, 0x02, 0x7c, 0xeb, 0x7a, 0xf5, 0xde, 0xab, 0x57,
+	0xaf, 0x5e, 0xbd, 0xaa, 0x7a, 0xaf, 0xaa, 0xa1, 0xd2, 0xb1, 0x9d, 0xc3, 0x1e, 0xb9, 0xda, 0xf5,
+	0x3d, 0xea, 0xa1, 0x85, 0x68, 0xe9, 0xaa, 0x28, 0x34, 0x2a, 0x2d, 0xaf, 0xd3, 0xf1, 0x5c, 0x01,
+	0x6c, 0x54, 0x48, 0x6b, 0x1f, 0x77, 0x4c, 0x51, 0xd2, 0xff, 0x40, 0x03, 0x74, 0xcb, 0xc7, 0x26,
+	0xc5, 0x37, 0x1d, 0xdb, 0x24, 0x06, 0xfe, 0x7a, 0x0f, 0x13, 0x8a, 0x3e, 0x05, 0x33, 0xbb, 0x26,
+	0xc1, 0x75, 0x6d, 0x45, 0x5b, 0x2d, 0xaf, 0x9f, 0xbb, 0x1a, 0x63, 0x2b, 0xd9, 0xdd, 0x23, 0xed,
+	0x0d, 0x93, 0x60, 0x83, 0x63, 0xa2, 0xd3, 0x50, 0xb0, 0x76, 0x9b, 0xae, 0xd9, 0xc1, 0xf5, 0xcc,
+	0x8a, 0xb6, 0x5a, 0x32, 0xf2, 0xd6, 0xee, 0x7d, 0xb3, 0x83, 0xd1, 0x6b, 0x30, 0xd7, 0xf2, 0x1c,
+	0x07, 0xb7, 0xa8, 0xed, 0xb9, 0x02, 0x21, 0xcb, 0x11, 0x66, 0x07, 0x60, 0x8e, 0xb8, 0x08, 0x39,
+	0x93, 0xc9, 0x50, 0x9f, 0xe1, 0xd5, 0xa2, 0xa0, 0x13, 0xa8, 0x6d, 0xfa, 0x5e, 0xf7, 0x79, 0x49,
+	0x17, 0x36, 0x9a, 0x8d, 0x36, 0xfa, 0xfb, 0x1a, 0xcc, 0xdf, 0x74, 0x28, 0xf6, 0x4f, 0xa8, 0x52,
+	0xfe, 0x5c, 0x83, 0x33, 0x37, 0x2d, 0xeb, 0x56, 0x88, 0xfb, 0xbe, 0x8d, 0x1d, 0xeb, 0x38, 0xe5,
+	0x5c, 0x86, 0xbc, 0xb0, 0x2b, 0x2e, 0x68, 0xc5, 0x90, 0x25, 0xfd, 0xc7, 0x19, 0x38, 0x2d, 0xec,
+	0x6b, 0x20, 0xec, 0x09, 0x94, 0x13, 0x9d, 0x07, 0x20, 0xfb, 0xa6, 0x6f, 0x91, 0xa6, 0xdb, 0xeb,
+	0xd4, 0x73, 0x2b, 0xda, 0x6a, 0xce, 0x28, 0x09, 0xc8, 0xfd, 0x5e, 0x07, 0x19, 0x30, 0xdf, 0xf2,
+	0x5c, 0x62, 0x13, 0x8a, 0xdd, 0x56, 0xbf, 0xe9, 0xe0, 0x43, 0xec, 0xd4, 0xf3, 0x2b, 0xda, 0xea,
+	0xec, 0xfa, 0x45, 0xa5, 0xdc, 0xb7, 0x06, 0xd8, 0x77, 0x19, 0xb2, 0x51, 0x6b, 0x25, 0x20, 0xe8,
+	0x22, 0xcc, 0xba, 0xbd, 0x4e, 0xb3, 0x6b, 0xfa, 0xd4, 0x66, 0xf2, 0x91, 0x7a, 0x61, 0x45, 0x5b,
+	0xcd, 0x1a, 0x55, 0xb7, 0xd7, 0xd9, 0x0e, 0x81, 0xfa, 0xb7, 0x35, 0x58, 0x62, 0x33, 0xe0, 0x44,
+	0xe8, 0x4f, 0xff, 0x0b, 0x0d, 0x16, 0xef, 0x98, 0xe4, 0x64, 0x0c, 0xe6, 0x79, 0x00, 0x6a, 0x77,
+	0x70, 0x93, 0x50, 0xb3, 0xd3, 0xe5, 0x03, 0x3a, 0x63, 0x94, 0x18, 0x64, 0x87, 0x01, 0xf4, 0x2f,
+	0x43, 0x65, 0xc3, 0xf3, 0x1c, 0x03, 0x93, 0xae, 0xe7, 0x12, 0x8c, 0x6e, 0x40, 0x9e, 0x50, 0x93,
+	0xf6, 0x88, 0x14, 0xf2, 0xac, 0x52, 0xc8, 0x1d, 0x8e, 0x62, 0x48, 0x54, 0x36, 0x01, 0x0f, 0x4d,
+	0xa7, 0x27, 0x64, 0x2c, 0x1a, 0xa2, 0xa0, 0x7f, 0x05, 0x66, 0x77, 0xa8, 0x6f, 0xbb, 0xed, 0x67,
+	0xc8, 0xbc, 0x14, 0x30, 0xff, 0x37, 0x0d, 0xce, 0x6c, 0x62, 0xd2, 0xf2, 0xed, 0xdd, 0x13, 0x32,
+	0x6b, 0x74, 0xa8, 0x0c, 0x20, 0x5b, 0x9b, 0x5c, 0xd5, 0x59, 0x23, 0x06, 0x4b, 0x0c, 0x46, 0x2e,
+	0x39, 0x18, 0xdf, 0xcd, 0x41, 0x43, 0xd5, 0xa9, 0x69, 0xd4, 0xf7, 0xd9, 0x70, 0x32, 0x67, 0x38,
+	0x51, 0x62, 0x2a, 0x8a, 0xba, 0xab, 0x83, 0xd6, 0x76, 0x38, 0x20, 0x9c, 0xf3, 0xc9, 0x5e, 0x65,
+	0x15, 0xbd, 0x5a, 0x87, 0xa5, 0x43, 0xdb, 0xa7, 0x3d, 0xd3, 0x69, 0xb6, 0xf6, 0x4d, 0xd7, 0xc5,
+	0x0e, 0xd7, 0x13, 0xf3, 0xc7, 0xd9, 0xd5, 0x92, 0xb1, 0x20, 0x2b, 0x6f, 0x89, 0x3a, 0xa6, 0x2c,
+	0x82, 0x5e, 0x87, 0xe5, 0xee, 0x7e, 0x9f, 0xd8, 0xad, 0x21, 0xa2, 0x1c, 0x27, 0x5a, 0x0c, 0x6a,
+	0x63, 0x54, 0x97, 0x61, 0xbe, 0xc5, 0x1d, 0xa5, 0xd5, 0x64, 0x5a, 0x13, 0x6a, 0xcc, 0x73, 0x35,
+	0xd6, 0x64, 0xc5, 0x07, 0x01, 0x9c, 0x89, 0x15, 0x20, 0xf7, 0x68, 0x2b, 0x42, 0x50, 0xe0, 0x04,
+	0x0b, 0xb2, 0xf2, 0x21, 0x6d, 0x0d, 0x68, 0xe2, 0x2e, 0xae, 0x98, 0x74, 0x71, 0x75, 0x28, 0xf0,
+	0xc5, 0x05, 0x93, 0x7a, 0x89, 0x8b, 0x19, 0x14, 0xd1, 0x16, 0xcc, 0x11, 0x6a, 0xfa, 0xb4, 0xd9,
+	0xf5, 0x88, 0xf4, 0x54, 0xb0, 0x92, 0x5d, 0x2d, 0xaf, 0xaf, 0x28, 0x07, 0xe9, 0xf3, 0xb8, 0xbf,
+	0x69, 0x52, 0x73, 0xdb, 0xb4, 0x7d, 0x63, 0x96, 0x13, 0x6e, 0x07, 0x74, 0x68, 0x01, 0x72, 0xd6,
+	0x6e, 0xd3, 0xb6, 0xea, 0x65, 0xae, 0xeb, 0x19, 0x6b, 0x77, 0xcb, 0x52, 0x3b, 0xd7, 0xca, 0xb3,
+	0x76, 0xae, 0xd5, 0x34, 0xe7, 0x7a, 0xd7, 0x33, 0xad, 0x93, 0xe1, 0x5c, 0x3f, 0xd2, 0xa0, 0x6e,
+	0x60, 0x07, 0x9b, 0xe4, 0x64, 0xcc, 0x7b, 0xfd, 0xb7, 0x35, 0x78, 0xe9, 0x36, 0xa6, 0x91, 0x19,
+	0x44, 0x4d, 0x6a, 0x13, 0x6a, 0xb7, 0x8e, 0x73, 0x53, 0xa4, 0x7f, 0x47, 0x83, 0x97, 0x53, 0xc5,
+	0x9a, 0xc6, 0xa1, 0xbc, 0x09, 0x39, 0xf6, 0x45, 0xea, 0x19, 0x6e, 0xdf, 0x17, 0xd2, 0xec, 0xfb,
+	0x8b, 0xcc, 0x4f, 0x73, 0x03, 0x17, 0xf8, 0xfa, 0x7f, 0x6a, 0xb0, 0xbc, 0xb3, 0xef, 0x3d, 0x1e,
+	0x88, 0xf4, 0x3c, 0x14, 0x14, 0x77, 0xb1, 0xd9, 0x84, 0x8b, 0x45, 0xd7, 0x61, 0x86, 0xf6, 0xbb,
+	0x98, 0x7b, 0xe7, 0xd9, 0xf5, 0xf3, 0x57, 0x15, 0x67, 0x81, 0xab, 0x4c, 0xc8, 0x0f, 0xfa, 0x5d,
+	0x6c, 0x70, 0x54, 0x74, 0x09, 0x6a, 0x09, 0x95, 0x07, 0x4e, 0x6a, 0x2e, 0xae, 0x73, 0xa2, 0xff,
+	0x5d, 0x06, 0x4e, 0x0f, 0x75, 0x71, 0x1a, 0x65, 0xab, 0xda, 0xce, 0x28, 0xdb, 0x66, 0xb3, 0x39,
+	0x82, 0x6a, 0x5b, 0x6c, 0xbb, 0x9e, 0x65, 0xb3, 0x79, 0x00, 0xdd, 0xb2, 0x08, 0xba, 0x02, 0x68,
+	0xc8, 0x85, 0x0a, 0x4f, 0x3d, 0x63, 0xcc, 0x27, 0x7d, 0x28, 0xf7, 0xd3, 0x4a, 0x27, 0x2a, 0x54,
+	0x30, 0x63, 0x2c, 0x2a, 0xbc, 0x28, 0x41, 0xd7, 0x61, 0xd1, 0x76, 0xef, 0xe1, 0x8e, 0xe7, 0xf7,
+	0x9b, 0x5d, 0xec, 0xb7, 0xb0, 0x4b, 0xcd, 0x36, 0x26, 0xf5, 0x3c, 0x97, 0x68, 0x21, 0xa8, 0xdb,
+	0x1e, 0x54, 0xe9, 0x3f, 0xd0, 0x60, 0x59, 0x6c, 0x82, 0x43, 0xd7, 0x73, 0x9c, 0xab, 0xf9, 0x45,
+	0x98, 0x0d, 0xfd, 0xa2, 0xc0, 0x13, 0x87, 0x8b, 0x6a, 0x08, 0xe5, 0xb3, 0xec, 0xfb, 0x1a, 0x2c,
+	0xb2, 0x8d, 0xe7, 0x8b, 0x24, 0xf3, 0x5f, 0x69, 0xb0, 0x70, 0xc7, 0x24, 0x2f, 0x92, 0xc8, 0x7f,
+	0x23, 0x97, 0xa0, 0xc1, 0xaa, 0x74, 0x9c, 0x42, 0xbf, 0x06, 0x73, 0x71, 0xa1, 0x83, 0x9d, 0xce,
+	0x6c, 0x4c, 0x6a, 0xa2, 0xff, 0xed, 0x60, 0xad, 0x7a, 0xc1, 0x24, 0xff, 0x91, 0x06, 0xe7, 0x6f,
+	0x63, 0x1a, 0x4a, 0x7d, 0x22, 0xd6, 0xb4, 0x49, 0xad, 0xe5, 0x23, 0xb1, 0x22, 0x2b, 0x85, 0x3f,
+	0x96, 0x95, 0xef, 0xdb, 0x19, 0x58, 0x62, 0xcb, 0xc2, 0xc9, 0x30, 0x82, 0x49, 0x0e, 0x2a, 0x0a,
+	0x43, 0xc9, 0xa9, 0x0c, 0x25, 0x5c, 0x4f, 0xf3, 0x13, 0xaf, 0xa7, 0xfa, 0x5f, 0x67, 0x60, 0x39,
+	0xa9, 0x8d, 0x69, 0x86, 0x45, 0x21, 0x6b, 0x46, 0x29, 0xab, 0x0e, 0x95, 0x10, 0xb2, 0xb5, 0x19,
+	0xac, 0x8f, 0x31, 0xd8, 0x89, 0x5d, 0x1e, 0xff, 0x4c, 0x83, 0xe5, 0xe0, 0x68, 0xb8, 0x83, 0xdb,
+	0x1d, 0xec, 0xd2, 0xa7, 0xb7, 0xa1, 0xa4, 0x05, 0x64, 0x14, 0x16, 0x70, 0x0e, 0x4a, 0x44, 0xb4,
+	0x13, 0x9e, 0xfa, 0x06, 0x00, 0x76, 0x10, 0xda, 0x63, 0xe1, 0xb4, 0xd0, 0x7c, 0x82, 0xa2, 0xfe,
+	0x63, 0x0d, 0x4e, 0x0f, 0x09, 0x3a, 0xcd, 0xf0, 0xd6, 0xa1, 0x60, 0xbb, 0x16, 0x7e, 0x12, 0xca,
+	0x19, 0x14, 0x59, 0xcd, 0x6e, 0xcf, 0x76, 0xac, 0x50, 0xc0, 0xa0, 0x88, 0x2e, 0x40, 0x05, 0xbb,
+	0xe6, 0xae, 0x83, 0x9b, 0x1c, 0x97, 0xcb, 0x58, 0x34, 0xca, 0x02, 0xb6, 0xc5, 0x40, 0xd1, 0x1e,
+	0xe4, 0xe2, 0x3d, 0xf8, 0x4d, 0x0d, 0x16, 0x98, 0x7d, 0x4a, 0xe9, 0xc9, 0xf3, 0xd5, 0xf3, 0x0a,
+	0x94, 0x23, 0x06, 0x28, 0x3b, 0x12, 0x05, 0xe9, 0x07, 0xb0, 0x18, 0x17, 0x67, 0x1a, 0x6d, 0xbe,
+	0x04, 0x10, 0x8e, 0xa2, 0x98, 0x27, 0x59, 0x23, 0x02, 0xd1, 0xff, 0x3b, 0x8c, 0x75, 0x73, 0x35,
+	0x1d, 0x73, 0xe4, 0x8a, 0x0f, 0x49, 0xd4, 0xd3, 0x97, 0x38, 0x84, 0x57, 0x6f, 0x42, 0x05, 0x3f,
+	0xa1, 0xbe, 0xc9, 0xce, 0xaf, 0x66, 0x47, 0x4c, 0xb8, 0x89, 0x9c, 0x72, 0x99, 0x93, 0x6d, 0x73,
+	0x2a, 0xfd, 0x1f, 0xd9, 0x06, 0x4e, 0x9a, 0xeb, 0x49, 0xef, 0xf1, 0x79, 0x00, 0x6e, 0xce, 0xa2,
+	0x3a, 0x27, 0xaa, 0x39, 0x84, 0x2f, 0x7b, 0x7f, 0xaa, 0x41, 0x8d, 0x77, 0x41, 0xf4, 0xa7, 0xcb,
+	0xd8, 0x26, 0x68, 0xb4, 0x04, 0xcd, 0x88, 0xc9, 0xf5, 0x33, 0x90, 0x97, 0x8a, 0xcd, 0x4e, 0xaa,
+	0x58, 0x49, 0x30, 0xa6, 0x1b, 0xfa, 0x1f, 0xb1, 0x60, 0x6d, 0x5c, 0xe5, 0xd3, 0x58, 0xf4, 0x07,
+	0x80, 0x44, 0x0f, 0xad, 0x41, 0xb7, 0x83, 0x25, 0xfa, 0xa2, 0x72, 0x3d, 0x4a, 0x2a, 0xc9, 0x98,
+	0xb7, 0x13, 0x10, 0xa2, 0xff, 0x8b, 0x06, 0xe7, 0x6e, 0x63, 0xca, 0x51, 0x37, 0x98, 0x57, 0xd9,
+	0xf6, 0xbd, 0xb6, 0x8f, 0x09, 0x79, 0x71, 0xed, 0xe3, 0x7b, 0x62, 0x4f, 0xa7, 0xea, 0xd2, 0x34,
+	0xfa, 0xbf, 0x00, 0x15, 0xde, 0x06, 0xb6, 0x9a, 0xbe, 0xf7, 0x98, 0x48, 0x3b, 0x2a, 0x4b, 0x98,
+	0xe1, 0x3d, 0xe6, 0x06, 0x41, 0x3d, 0x6a, 0x3a, 0x02, 0x41, 0x2e, 0x26, 0x1c, 0xc2, 0xaa, 0xf9,
+	0x1c, 0x0c, 0x04, 0x63, 0xcc, 0xf1, 0x8b, 0xab, 0xe3, 0x3f, 0xd1, 0x60, 0x29, 0xd1, 0x95, 0x69,
+	0x74, 0xfb, 0x86, 0xd8, 0x71, 0x8a, 0xce, 0xcc, 0xae, 0xbf, 0xac, 0xa4, 0x89, 0x34, 0x26, 0xb0,
+	0xd1, 0xcb, 0x50, 0xde, 0x33, 0x6d, 0xa7, 0xe9, 0x63, 0x93, 0x78, 0xae, 0xec, 0x28, 0x30, 0x90,
+	0xc1, 0x21, 0xfa, 0x3f, 0x68, 0x22, 0x63, 0xf8, 0x82, 0x7b, 0xbc, 0x3f, 0xce, 0x40, 0x75, 0xcb,
+	0x25, 0xd8, 0xa7, 0x27, 0xff, 0x54, 0x82, 0xde, 0x85, 0x32, 0xef, 0x18, 0x69, 0x5a, 0x26, 0x35,
+	0xe5, 0x72, 0xf5, 0x92, 0x32, 0x1a, 0xcf, 0x33, 0x95, 0x2c, 0x3e, 0x6c, 0x08, 0xed, 0x10, 0xf6,
+	0x8d, 0xce, 0x42, 0x69, 0xdf, 0x24, 0xfb, 0xcd, 0x03, 0xdc, 0x17, 0x5b, 0xc5, 0xaa, 0x51, 0x64,
+	0x80, 0xcf, 0xe3, 0x3e, 0x41, 0x67, 0xa0, 0xc8, 0x62, 0xb9, 0x7c, 0x82, 0xb1, 0xf8, 0x76, 0xd5,
+	0x28, 0xb8, 0xbd, 0x0e, 0x9f, 0x5e, 0x4c, 0x4b, 0x0f, 0xbb, 0x3f, 0xd5, 0xd2, 0x68, 0x2d, 0xfd,
+	0x53, 0x06, 0x66, 0xef, 0xf5, 0xa8, 0x29, 0x33, 0x2e, 0x3d, 0x87, 0x3e, 0xdd, 0x94, 0x5d, 0x83,
+	0xac, 0xd8, 0x59, 0x31, 0x8a, 0xba, 0x52, 0xf0, 0xad, 0x4d, 0x62, 0x30, 0x24, 0x9e, 0x6d, 0xe8,
+	0xb5, 0x5a, 0x72, 0x93, 0x9a, 0xe5, 0xc2, 0x96, 0x18, 0x44, 0x6c, 0x51, 0xcf, 0x42, 0x09, 0xfb,
+	0x7e, 0xb8, 0x85, 0xe5, 0x5d, 0xc1, 0xbe, 0x2f, 0x2a, 0x75, 0xa8, 0x98, 0xad, 0x03, 0xd7, 0x7b,
+	0xec, 0x60, 0xab, 0x8d, 0x2d, 0x3e, 0x39, 0x8a, 0x46, 0x0c, 0x26, 0xa6, 0x0f, 0x1b, 0xf8, 0x66,
+	0xcb, 0xa5, 0xfc, 0x88, 0x96, 0x35, 0x4a, 0x02, 0x72, 0xcb, 0xa5, 0xac, 0xda, 0xc2, 0x0e, 0xa6,
+	0x98, 0x57, 0x8b, 0xc4, 0x6a, 0x49, 0x40, 0x64, 0x75, 0xaf, 0x1b, 0x52, 0x17, 0x45, 0xb5, 0x80,
+	0xb0, 0xea, 0x73, 0x50, 0x1a, 0xa4, 0x54, 0x4a, 0x83, 0x38, 0x2b, 0x07, 0xe8, 0xff, 0xa1, 0x41,
+	0x75, 0x93, 0xb3, 0x7a, 0x01, 0x8c, 0x0e, 0xc1, 0x0c, 0x7e, 0xd2, 0xf5, 0xa5, 0x83, 0xe1, 0xdf,
+	0x23, 0xed, 0x48, 0x3f, 0x84, 0xda, 0xb6, 0x63, 0xb6, 0xf0, 0xbe, 0xe7, 0x58, 0xd8, 0xe7, 0x3b,
+	0x20, 0x54, 0x83, 0x2c, 0x35, 0xdb, 0x72, 0x8b, 0xc5, 0x3e, 0xd1, 0x5b, 0xf2, 0x6c, 0x2c, 0x9c,
+	0xf7, 0xab, 0xca, 0xbd, 0x48, 0x84, 0x4d, 0x24, 0xe4, 0xbc, 0x0c, 0x79, 0x9e, 0xe6, 0x14, 0x9b,
+	0xaf, 0x8a, 0x21, 0x4b, 0xfa, 0xa3, 0x58, 0xbb, 0xb7, 0x7d, 0xaf, 0xd7, 0x45, 0x5b, 0x50, 0xe9,
+	0x0e, 0x60, 0xcc, 0x56, 0xd3, 0x77, 0x3e, 0x49, 0xa1, 0x8d, 0x18, 0xa9, 0xfe, 0x87, 0x39, 0xa8,
+	0xee, 0x60, 0xd3, 0x6f, 0xed, 0xbf, 0x08, 0x41, 0x2a, 0xa6, 0x71, 0x8b, 0x38, 0x72, 0xd4, 0xd8,
+	0x27, 0xcb, 0x0f, 0x46, 0x3a, 0xd4, 0x6c, 0x33, 0x05, 0x71, 0xbb, 0xaf, 0x18, 0xb5, 0x6e, 0x52,
+	0x71, 0x6f, 0x42, 0xd1, 0x22, 0x4e, 0x93, 0x0f, 0x51, 0x81, 0x0f, 0x91, 0xba, 0x7f, 0x9b, 0xc4,
+	0xe1, 0x43, 0x53, 0xb0, 0xc4, 0x07, 0x7a, 0x05, 0xaa, 0x5e, 0x8f, 0x76, 0x7b, 0xb4, 0x29, 0xfc,
+	0x4e, 0xbd, 0xc8, 0xc5, 0xab, 0x08, 0x20, 0x77, 0x4b, 0x04, 0xbd, 0x0f, 0x55, 0xc2, 0x55, 0x19,
+	0x9c, 0x4f, 0x4a, 0x93, 0x6e, 0xa3, 0x2b, 0x82, 0x4e, 0x1c, 0x50, 0x58, 0x06, 0x80, 0xfa, 0xe6,
+	0x21, 0x76, 0x22, 0x09, 0x4c, 0xe0, 0xb3, 0x6d, 0x4e, 0xc0, 0x07, 0xc9, 0xcb, 0x6b, 0xb0, 0xd0,
+	0xee, 0x99, 0xbe, 0xe9, 0x52, 0x8c, 0x23, 0xd8, 0x65, 0x8e, 0x8d, 0xc2, 0xaa, 0x01, 0xc1, 0xf3,
+	0x48, 0x2a, 0xbe, 0x02, 0xd5, 0xb6, 0x6f, 0xb6, 0xf0, 0x5e, 0x4f, 0x48, 0x5c, 0x9f, 0x15, 0x87,
+	0xde, 0x00, 0xc8, 0x5a, 0x67, 0x01, 0x10, 0xfc, 0xa4, 0xeb, 0xd8, 0x2d, 0x9b, 0x36, 0x23, 0x1c,
+	0xea, 0x73, 0xdc, 0x89, 0x2d, 0x04, 0x75, 0x91, 0xe6, 0x7e, 0x6e, 0xa6, 0x58, 0xad, 0xcd, 0xea,
+	0xff, 0x35, 0x03, 0x0b, 0x77, 0xfa, 0xbb, 0xbe, 0x6d, 0xbd, 0x40, 0x76, 0xfa, 0xb3, 0x50, 0xf4,
+	0x85, 0x9c, 0xc1, 0x29, 0x55, 0x57, 0xc7, 0xc9, 0xa2, 0x5d, 0x32, 0x42, 0x1a, 0xb4, 0x01, 0x65,
+	0xdf, 0x74, 0x0f, 0x02, 0x43, 0xca, 0x4f, 0x6a, 0x48, 0xc0, 0xa8, 0xa4, 0x19, 0x0d, 0xd9, 0x6c,
+	0x41, 0x61, 0xb3, 0x2a, 0x5b, 0x2b, 0x1e, 0xc9, 0xd6, 0x4a, 0x47, 0xb3, 0x35, 0x78, 0xc6, 0xb6,
+	0x56, 0x39, 0x82, 0xad, 0x55, 0x47, 0xd9, 0x5a, 0xb9, 0x56, 0xd1, 0x2d, 0x98, 0xb9, 0x63, 0x53,
+	0xee, 0x66, 0xb6, 0x36, 0x85, 0x5f, 0xcd, 0x8a, 0x75, 0xfb, 0x0c, 0x14, 0x7d, 0xef, 0xb1, 0xd8,
+	0xa1, 0x64, 0xb8, 0x83, 0x2e, 0xf8, 0xde, 0x63, 0xbe, 0xfd, 0xe0, 0x77, 0xa7, 0x3c, 0x5f, 0x7a,
+	0xee, 0x8c, 0x21, 0x4b, 0xcc, 0xdc, 0x08, 0xf5, 0x79, 0x5a, 0x4e, 0x18, 0x49, 0x9e, 0x50, 0x7f,
+	0xcb, 0x22, 0xfa, 0xaf, 0x68, 0x03, 0x9f, 0xcb, 0x76, 0x1d, 0xe4, 0xe9, 0xb6, 0x1d, 0xef, 0x42,
+	0xc1, 0x17, 0xf4, 0x23, 0xef, 0x79, 0x44, 0x5b, 0xe2, 0x5b, 0xa7, 0x80, 0x4a, 0xff, 0x65, 0x0d,
+	0x2a, 0xef, 0x3b, 0x3d, 0xf2, 0x3c, 0xa6, 0x94, 0x2a, 0x8b, 0x99, 0x55, 0x67, 0x50, 0x7f, 0x2b,
+	0x03, 0x55, 0x29, 0xc6, 0x34, 0x07, 0xa7, 0x54, 0x51, 0x76, 0xa0, 0xcc, 0x9a, 0x6c, 0x12, 0xdc,
+	0x0e, 0x42, 0xc0, 0xe5, 0xf5, 0x75, 0xe5, 0x74, 0x8c, 0x89, 0xc1, 0x6f, 0xc8, 0xec, 0x70, 0xa2,
+	0xcf, 0xb9, 0xd4, 0xef, 0x1b, 0xd0, 0x0a, 0x01, 0x8d, 0x47, 0x30, 0x97, 0xa8, 0x66, 0x46, 0x73,
+	0x80, 0xfb, 0xc1, 0x6e, 0xe0, 0x00, 0xf7, 0xd1, 0xeb, 0xd1, 0x7b, 0x4c, 0x69, 0x7b, 0xda, 0xbb,
+	0x9e, 0xdb, 0xbe, 0xe9, 0xfb, 0x66, 0x5f, 0xde, 0x73, 0x7a, 0x3b, 0xf3, 0x96, 0xa6, 0xff, 0xef,
+	0x0c, 0x54, 0xbe, 0xd0, 0xc3, 0x7e, 0xff, 0x38, 0xbd, 0x5d, 0xb0, 0x47, 0x9a, 0x89, 0xec, 0x91,
+	0x86, 0x9c, 0x4a, 0x4e, 0xe1, 0x54, 0x14, 0x6e, 0x32, 0xaf, 0x74, 0x93, 0x2a, 0xef, 0x53, 0x38,
+	0x92, 0xf7, 0x29, 0xa6, 0x7a, 0x9f, 0x45, 0xc8, 0x39, 0x76, 0xc7, 0xa6, 0xdc, 0x41, 0x65, 0x0d,
+	0x51, 0x60, 0x93, 0xd5, 0xdb, 0xdb, 0x23, 0x98, 0x72, 0x47, 0x94, 0x35, 0x64, 0x89, 0xcd, 0x6f,
+	0xcf, 0x67, 0x1b, 0x88, 0xdd, 0x3e, 0x5f, 0x3d, 0x4b, 0x46, 0x81, 0x97, 0x37, 0xfa, 0x2c, 0x7e,
+	0xca, 0xe2, 0x4c, 0xd8, 0xb5, 0x6c, 0xb7, 0xcd, 0xfd, 0x4d, 0xd1, 0x88, 0x40, 0x18, 0x29, 0xdf,
+	0x75, 0x34, 0x77, 0x85, 0x87, 0x29, 0x19, 0x05, 0x5e, 0xde, 0xe8, 0xab, 0x3d, 0xe0, 0xec, 0x33,
+	0xf6, 0x80, 0xb5, 0x23, 0x78, 0xc0, 0xf9, 0x51, 0x1e, 0x70, 0xae, 0x56, 0xe3, 0x3e, 0x41, 0x1a,
+	0xde, 0x54, 0xae, 0x29, 0x76, 0xa4, 0xcb, 0x1c, 0xf5, 0x48, 0xa7, 0xff, 0x28, 0x03, 0xf5, 0x07,
+	0x5d, 0xec, 0x72, 0x51, 0xb6, 0x28, 0xf6, 0x4d, 0xea, 0xf9, 0x3f, 0x9d, 0x0b, 0x83, 0x2b, 0x6b,
+	0xbb, 0x26, 0x6d, 0xed, 0x37, 0x89, 0xfd, 0x21, 0x0e, 0x8e, 0x69, 0x1c, 0xb2, 0x63, 0x7f, 0x88,
+	0xd9, 0xbd, 0x8a, 0x33, 0x0a, 0xe5, 0x4d, 0x99, 0x43, 0xb0, 0x25, 0xa3, 0x30, 0x6e, 0x1c, 0x81,
+	0x28, 0x85, 0xcf, 0xaa, 0x85, 0x67, 0xcb, 0x25, 0x6e, 0xf9, 0x98, 0x4a, 0x5d, 0xca, 0x92, 0xfe,
+	0x7b, 0x1a, 0xd4, 0xef, 0xe3, 0x27, 0xf4, 0x19, 0x0d, 0xf9, 0x38, 0x89, 0x17, 0x21, 0x47, 0xbd,
+	0x03, 0x1c, 0x84, 0xca, 0x44, 0x21, 0x55, 0xb8, 0x1f, 0x6a, 0xb0, 0x98, 0x54, 0xe7, 0xf1, 0x4d,
+	0x8f, 0x14, 0xe1, 0x11, 0xcc, 0x58, 0x9e, 0x8b, 0x65, 0xea, 0x8b, 0x7f, 0xb3, 0xbd, 0xc6, 0x99,
+	0x5b, 0x8e, 0x47, 0xf0, 0xc7, 0xa4, 0xd6, 0x81, 0x02, 0xb3, 0x31, 0x05, 0x7e, 0x5f, 0x83, 0xd2,
+	0x17, 0x71, 0x8b, 0x23, 0x11, 0xd5, 0xb4, 0xd3, 0x26, 0x08, 0x16, 0x66, 0x92, 0xc1, 0xc2, 0x1b,
+	0x50, 0xb4, 0xad, 0xa6, 0xc9, 0x56, 0xcf, 0x7a, 0x76, 0x4c, 0xf8, 0xa5, 0x60, 0x5b, 0x7c, 0x99,
+	0x9d, 0xfc, 0x46, 0xc4, 0xef, 0x68, 0x50, 0x11, 0x32, 0x13, 0x41, 0xf9, 0x99, 0x48, 0x73, 0x9a,
+	0x6a, 0x49, 0x97, 0x85, 0xb0, 0xa3, 0x77, 0x4e, 0x0d, 0x9a, 0xbd, 0x09, 0xc0, 0x46, 0x5b, 0x92,
+	0x8b, 0x1d, 0xc1, 0x8a, 0x52, 0x5a, 0x41, 0xce, 0x47, 0xfe, 0xce, 0x29, 0xa3, 0xc4, 0xa8, 0x38,
+	0x8b, 0x8d, 0x02, 0xe4, 0x38, 0xb5, 0xfe, 0xff, 0x1a, 0x2c, 0xdc, 0x32, 0x9d, 0xd6, 0xa6, 0x4d,
+	0xa8, 0xe9, 0xb6, 0xa6, 0x08, 0xb8, 0xbc, 0x0d, 0x05, 0xaf, 0xdb, 0x74, 0xf0, 0x1e, 0x95, 0x22,
+	0x5d, 0x18, 0xd1, 0x23, 0xa1, 0x06, 0x23, 0xef, 0x75, 0xef, 0xe2, 0x3d, 0x8a, 0xde, 0x81, 0xa2,
+	0xd7, 0x6d, 0xfa, 0x76, 0x7b, 0x9f, 0xd6, 0xb3, 0x93, 0x12, 0x17, 0xbc, 0xae, 0xc1, 0x28, 0x22,
+	0xd9, 0xa6, 0x99, 0x23, 0x66, 0x9b, 0xf4, 0x7f, 0x1d, 0xea, 0xfe, 0x14, 0x93, 0xf1, 0x6d, 0x28,
+	0xda, 0x2e, 0x6d, 0x5a, 0x36, 0x09, 0x54, 0x70, 0x5e, 0x6d, 0x43, 0x2e, 0xe5, 0x3d, 0xe0, 0x63,
+	0xea, 0x52, 0xd6, 0x36, 0x7a, 0x0f, 0x60, 0xcf, 0xf1, 0x4c, 0x49, 0x2d, 0x74, 0xf0, 0xb2, 0x7a,
+	0x1e, 0x33, 0xb4, 0x80, 0xbe, 0xc4, 0x89, 0x18, 0x87, 0xc1, 0x90, 0xfe, 0xb3, 0x06, 0x4b, 0xdb,
+	0xd8, 0x17, 0xcb, 0x31, 0x95, 0x99, 0xdf, 0x2d, 0x77, 0xcf, 0x8b, 0xa7, 0xe5, 0xb5, 0x64, 0x5a,
+	0xfe, 0x99, 0x24, 0x9c, 0x63, 0x51, 0x52, 0x99, 0xdd, 0x97, 0x51, 0xd2, 0xe0, 0x0a, 0x8c, 0x88,
+	0xc5, 0xcf, 0xa6, 0x0c, 0x93, 0x94, 0x37, 0x9a, 0x92, 0xd0, 0xbf, 0x2b, 0xae, 0xa3, 0x2a, 0x3b,
+	0xf5, 0xf4, 0x06, 0xbb, 0x0c, 0x72, 0xed, 0x4e, 0xac, 0xe4, 0x9f, 0x80, 0x84, 0xef, 0x48, 0xb9,
+	0x24, 0xfb, 0xbb, 0x1a, 0xac, 0xa4, 0x4b, 0x35, 0xcd, 0x1a, 0xf9, 0x1e, 0xe4, 0x6c, 0x77, 0xcf,
+	0x0b, 0x12, 0x91, 0x6b, 0xea, 0x70, 0x9c, 0xb2, 0x5d, 0x41, 0xa8, 0xff, 0x30, 0x03, 0x35, 0xee,
+	0xa7, 0x8f, 0x61, 0xf8, 0x3b, 0xb8, 0x23, 0xb6, 0x13, 0x72, 0xf8, 0x3b, 0xb8, 0xc3, 0x36, 0x13,
+	0x31, 0xcb, 0xc8, 0xc5, 0x2d, 0x23, 0x9e, 0xaa, 0xc9, 0x8f, 0x48, 0x34, 0x17, 0xe2, 0x89, 0xe6,
+	0x65, 0xc8, 0xbb, 0x9e, 0x85, 0xb7, 0x36, 0xe5, 0xde, 0x45, 0x96, 0x06, 0xa6, 0x56, 0x3a, 0xa2,
+	0xa9, 0x7d, 0xa4, 0x41, 0xe3, 0x36, 0xa6, 0x49, 0xdd, 0x1d, 0x9f, 0x95, 0x7d, 0x47, 0x83, 0xb3,
+	0x4a, 0x81, 0xa6, 0x31, 0xb0, 0xcf, 0xc4, 0x0d, 0x4c, 0x1d, 0xef, 0x1d, 0x6a, 0x52, 0xda, 0xd6,
+	0x75, 0xa8, 0x6c, 0xf6, 0x3a, 0x9d, 0xf0, 0x40, 0x79, 0x01, 0x2a, 0x32, 0xda, 0x24, 0xc2, 0xa1,
+	0x62, 0xfd, 0x2d, 0x4b, 0x18, 0x0b, 0x7a, 0xea, 0x97, 0xa1, 0x2a, 0x49, 0xa4, 0xd4, 0x0d, 0x16,
+	0xd5, 0x12, 0xdf, 0x12, 0x3f, 0x2c, 0xeb, 0x4b, 0xb0, 0x60, 0xe0, 0x36, 0x33, 0x6d, 0xff, 0xae,
+	0xed, 0x1e, 0xc8, 0x66, 0xf4, 0x6f, 0x6a, 0xb0, 0x18, 0x87, 0x4b, 0x5e, 0x9f, 0x86, 0x82, 0x69,
+	0x59, 0x3e, 0x26, 0x64, 0xe4, 0xb0, 0xdc, 0x14, 0x38, 0x46, 0x80, 0x1c, 0xd1, 0x5c, 0x66, 0x62,
+	0xcd, 0xe9, 0x4d, 0x98, 0xbf, 0x8d, 0xe9, 0x3d, 0x4c, 0xfd, 0xa9, 0xae, 0x33, 0xd6, 0x59, 0xc4,
+	0x85, 0x13, 0x4b, 0xb3, 0x08, 0x8a, 0xfa, 0x6f, 0x68, 0x80, 0xa2, 0x2d, 0x4c, 0x33, 0xcc, 0x51,
+	0x2d, 0x67, 0xe2, 0x5a, 0x16, 0x37, 0xbe, 0x3b, 0x5d, 0xcf, 0xc5, 0x2e, 0x8d, 0x1e, 0x57, 0xaa,
+	0x21, 0x94, 0x9b, 0xdf, 0x0f, 0x34, 0x40, 0xec, 0xf2, 0xec, 0x86, 0xe9, 0x4c, 0xb7, 0x3d, 0x60,
+	0xe9, 0x2a, 0xbf, 0xd5, 0x94, 0xb3, 0x35, 0x23, 0xbd, 0x8f, 0xdf, 0xba, 0xcf, 0x01, 0x2c, 0xeb,
+	0x6c, 0x11, 0x2a, 0xab, 0x83, 0xdb, 0x75, 0x60, 0x11, 0x2a, 0xea, 0xf9, 0xeb, 0x1d, 0x82, 0x4d,
+	0x07, 0x5b, 0xcd, 0xc8, 0x15, 0xa4, 0x19, 0x8e, 0x56, 0x13, 0x15, 0x3b, 0x21, 0x5c, 0x7f, 0x04,
+	0xa7, 0xef, 0x99, 0x2e, 0x7b, 0x36, 0xe4, 0x75, 0xba, 0x66, 0xec, 0x95, 0x47, 0xd2, 0xcd, 0x69,
+	0x0a, 0x37, 0xf7, 0x92, 0x78, 0x06, 0x20, 0xce, 0x1b, 0x5c, 0xd6, 0x19, 0x23, 0x02, 0xd1, 0x09,
+	0xd4, 0x87, 0xd9, 0x4f, 0x33, 0x50, 0x5c, 0xa8, 0x80, 0x55, 0xd4, 0xf7, 0x0e, 0x60, 0xfa, 0xbb,
+	0x70, 0x86, 0x3f, 0xc9, 0x08, 0x40, 0xb1, 0xcb, 0x0e, 0x49, 0x06, 0x9a, 0x82, 0xc1, 0xaf, 0x65,
+	0xa0, 0xa1, 0xe2, 0x30, 0x8d, 0xe0, 0x6f, 0xc7, 0xef, 0x18, 0xbc, 0x9a, 0x12, 0x8a, 0x88, 0xb7,
+	0x28, 0x48, 0xd0, 0x2a, 0xcc, 0xe1, 0x27, 0xb8, 0xd5, 0xa3, 0xb6, 0xdb, 0xde, 0x76, 0x4c, 0xf7,
+	0xbe, 0x27, 0x17, 0x94, 0x24, 0x18, 0xbd, 0x0a, 0x55, 0xa6, 0x7d, 0xaf, 0x47, 0x25, 0x9e, 0x58,
+	0x59, 0xe2, 0x40, 0xc6, 0x8f, 0xf5, 0xd7, 0xc1, 0x14, 0x5b, 0x12, 0x4f, 0x2c, 0x33, 0x49, 0xf0,
+	0x90, 0x2a, 0x19, 0x98, 0x1c, 0x45, 0x95, 0xff, 0xae, 0x41, 0x43, 0xc5, 0xe1, 0xb8, 0x54, 0x79,
+	0x07, 0xa0, 0x83, 0xfd, 0x36, 0xde, 0xe2, 0x4e, 0x5d, 0xc4, 0x25, 0x57, 0x95, 0x4e, 0x7d, 0xc0,
+	0xe0, 0x5e, 0x40, 0x60, 0x44, 0x68, 0xf5, 0xdb, 0xb0, 0xa0, 0x40, 0x61, 0xfe, 0x8a, 0x78, 0x3d,
+	0xbf, 0x85, 0x83, 0x50, 0x76, 0x50, 0x64, 0xeb, 0x1b, 0x35, 0xfd, 0x36, 0xa6, 0xd2, 0x68, 0x65,
+	0x89, 0xb9, 0xeb, 0xe0, 0x5d, 0xb2, 0x8f, 0x2d, 0xec, 0x52, 0xdb, 0x74, 0x9e, 0xde, 0x7b, 0x34,
+	0xa0, 0xd8, 0x23, 0xd8, 0x8f, 0x9c, 0xdd, 0xc2, 0x32, 0xab, 0xeb, 0x9a, 0x84, 0x3c, 0xf6, 0x7c,
+	0x4b, 0xfa, 0xb0, 0xb0, 0xac, 0xff, 0xa5, 0x06, 0xa7, 0x1f, 0x76, 0xad, 0x8f, 0x41, 0x8a, 0x15,
+	0x28, 0x7b, 0x8e, 0xb5, 0x1d, 0x17, 0x24, 0x0a, 0x62, 0x18, 0x2e, 0x7e, 0x1c, 0x62, 0x88, 0xb0,
+	0x40, 0x14, 0xa4, 0xb7, 0xd9, 0xed, 0x57, 0x07, 0x3f, 0x77, 0x61, 0xf5, 0x3b, 0xb0, 0x78, 0xd7,
+	0x26, 0x94, 0x35, 0xf3, 0x90, 0x60, 0xff, 0xe9, 0x17, 0x32, 0xfd, 0x6b, 0xb0, 0x94, 0xe0, 0x34,
+	0xcd, 0x1c, 0x38, 0x07, 0xa5, 0x40, 0xc6, 0xe0, 0x1e, 0xf6, 0x00, 0xa0, 0xaf, 0x00, 0x18, 0x9e,
+	0x83, 0x3f, 0xe7, 0x52, 0x9b, 0xf6, 0x59, 0x8c, 0x22, 0x72, 0xdc, 0xe7, 0xdf, 0x0c, 0x83, 0x49,
+	0x31, 0x02, 0xe3, 0x97, 0x60, 0x5e, 0x58, 0x25, 0xe3, 0xf4, 0xf4, 0xca, 0x7d, 0x13, 0xf2, 0x98,
+	0x37, 0x52, 0xcf, 0xa8, 0x8e, 0x6a, 0xb2, 0x30, 0x90, 0xd6, 0x90, 0xe8, 0xfa, 0x57, 0x61, 0x8e,
+	0xdd, 0x9d, 0x9a, 0xae, 0xf5, 0xb3, 0x50, 0xf2, 0x3d, 0x07, 0x47, 0x43, 0x19, 0x45, 0x06, 0xe0,
+	0x2b, 0xf6, 0xdf, 0x6b, 0xb0, 0xfc, 0xa0, 0x8b, 0x7d, 0x93, 0x62, 0xa6, 0x8b, 0xe9, 0x5a, 0x1a,
+	0x65, 0xf1, 0x31, 0x29, 0xb2, 0x71, 0x29, 0xd0, 0x3b, 0xb1, 0xa7, 0x72, 0x6a, 0x5f, 0x94, 0x90,
+	0x32, 0x72, 0xcb, 0x5f, 0x87, 0xca, 0x83, 0xdd, 0xaf, 0xe1, 0x16, 0x1d, 0x31, 0x92, 0x17, 0x61,
+	0x6e, 0xdb, 0xb7, 0x0f, 0x6d, 0x07, 0xb7, 0x47, 0x99, 0xc4, 0xb7, 0x34, 0xa8, 0xde, 0xf6, 0x4d,
+	0x97, 0x7a, 0x81, 0x59, 0xdc, 0x80, 0x19, 0xd6, 0x87, 0xba, 0x36, 0x62, 0xe4, 0x06, 0x56, 0x64,
+	0x70, 0x64, 0xb4, 0x01, 0xa5, 0x6e, 0xd0, 0x9a, 0x1c, 0xf3, 0x94, 0x3b, 0x19, 0x71, 0x99, 0x8c,
+	0x01, 0x99, 0xfe, 0x3f, 0x1a, 0x94, 0xb9, 0x28, 0x03, 0x41, 0x98, 0xbe, 0x46, 0x0a, 0x12, 0x31,
+	0x21, 0x8e, 0xcc, 0x82, 0x1d, 0x1e, 0x57, 0xcd, 0xc8, 0x28, 0x4b, 0x54, 0x7b, 0x86, 0x24, 0x60,
+	0x7b, 0x2c, 0xf1, 0x15, 0x1d, 0x32, 0x10, 0x20, 0x39, 0x68, 0x85, 0xb6, 0x50, 0x15, 0x1f, 0xb7,
+	0xb4, 0x54, 0x73, 0x4c, 0x9d, 0x46, 0x40, 0x12, 0x0d, 0x8d, 0xe7, 0xa2, 0x47, 0x1d, 0xfd, 0x1b,
+	0x1a, 0xa0, 0x1d, 0xcc, 0xb6, 0x57, 0x9c, 0xf2, 0xe9, 0xad, 0xf1, 0xad, 0xc4, 0xac, 0x5b, 0x49,
+	0x17, 0x2f, 0x31, 0xed, 0xbe, 0xc5, 0xae, 0xe5, 0x47, 0x45, 0x98, 0xc6, 0x4b, 0xbd, 0x03, 0x45,
+	0xce, 0xd6, 0xc6, 0xc1, 0x01, 0x6a, 0xbc, 0x20, 0x21, 0x05, 0xbb, 0x3e, 0x79, 0x5a, 0x5a, 0x7e,
+	0x68, 0x2b, 0xc7, 0xa0, 0x12, 0xf4, 0x59, 0x39, 0x43, 0xb3, 0x7c, 0x86, 0x5e, 0x1a, 0x35, 0x43,
+	0x43, 0x39, 0x23, 0x53, 0x74, 0x17, 0x96, 0x84, 0x23, 0x65, 0x61, 0x64, 0x26, 0xca, 0xb3, 0xcf,
+	0xa9, 0xe8, 0x5f, 0x85, 0x05, 0xe6, 0x2c, 0x9f, 0x63, 0x0b, 0x72, 0x21, 0x0c, 0x5a, 0x98, 0x62,
+	0x21, 0xfc, 0x9e, 0x06, 0x4b, 0x09, 0x56, 0xd3, 0xd8, 0xd8, 0x19, 0x28, 0x4a, 0x89, 0x83, 0x85,
+	0xb0, 0x20, 0x44, 0x4e, 0x7b, 0x65, 0x94, 0x4d, 0x79, 0x65, 0xb4, 0x76, 0x01, 0x8a, 0xc1, 0x1b,
+	0x2a, 0x54, 0x80, 0xec, 0x4d, 0xc7, 0xa9, 0x9d, 0x42, 0x15, 0x28, 0x6e, 0xc9, 0x87, 0x42, 0x35,
+	0x6d, 0xed, 0x17, 0x61, 0x2e, 0x71, 0x95, 0x0c, 0x15, 0x61, 0xe6, 0xbe, 0xe7, 0xe2, 0xda, 0x29,
+	0x54, 0x83, 0xca, 0x86, 0xed, 0x9a, 0x7e, 0x5f, 0x04, 0x5f, 0x6b, 0x16, 0x9a, 0x83, 0x32, 0x0f,
+	0x42, 0x4a, 0x00, 0x46, 0xf3, 0x2c, 0xed, 0xee, 0x99, 0xf4, 0xfa, 0xa7, 0x25, 0x68, 0x0f, 0x21,
+	0x98, 0xdd, 0x88, 0xc3, 0xda, 0x68, 0x09, 0xe6, 0x77, 0xba, 0xa6, 0x4f, 0x70, 0x94, 0x7a, 0x7f,
+	0xed, 0x3d, 0x58, 0x50, 0xac, 0x04, 0x8c, 0xe9, 0x4d, 0x8b, 0x6f, 0x2a, 0x3e, 0xf0, 0x18, 0xb0,
+	0x76, 0x0a, 0x2d, 0x03, 0x32, 0x70, 0xc7, 0x3b, 0xe4, 0x88, 0xef, 0xfb, 0x5e, 0x87, 0xc3, 0xb5,
+	0xb5, 0x2b, 0xb0, 0xa8, 0xb2, 0x54, 0x54, 0x82, 0x1c, 0xb7, 0xfc, 0xda, 0x29, 0x04, 0x90, 0x37,
+	0xf0, 0xa1, 0x77, 0x80, 0x6b, 0xda, 0xfa, 0xff, 0x5d, 0x86, 0xea, 0x3d, 0x3e, 0x04, 0x3b, 0xd8,
+	0x3f, 0xb4, 0x5b, 0x18, 0x35, 0xa1, 0x96, 0xfc, 0x87, 0x0e, 0xfa, 0xa4, 0x7a, 0xff, 0xac, 0xfe,
+	0xd5, 0x4e, 0x63, 0xd4, 0xa0, 0xea, 0xa7, 0xd0, 0x57, 0x60, 0x36, 0xfe, 0x8b, 0x19, 0xa4, 0x0e,
+	0xea, 0x29, 0xff, 0x43, 0x33, 0x8e, 0x79, 0x13, 0xaa, 0xb1, 0x3f, 0xc6, 0x20, 0xf5, 0x64, 0x56,
+	0xfd, 0x55, 0xa6, 0xa1, 0x5e, 0x3e, 0xa2, 0x7f, 0x75, 0x11, 0xd2, 0xc7, 0xff, 0xe1, 0x90, 0x22,
+	0xbd, 0xf2, 0x47, 0x0f, 0xe3, 0xa4, 0x37, 0x61, 0x7e, 0xe8, 0x97, 0x0c, 0xe8, 0x8a, 0x7a, 0x31,
+	0x4c, 0xf9, 0x75, 0xc3, 0xb8, 0x26, 0x1e, 0x03, 0x1a, 0xfe, 0x33, 0x0a, 0xba, 0xaa, 0x1e, 0x81,
+	0xb4, 0xff, 0xc2, 0x34, 0xae, 0x4d, 0x8c, 0x1f, 0x2a, 0xee, 0x57, 0x35, 0x38, 0x9d, 0xf2, 0x1f,
+	0x05, 0x74, 0x43, 0xed, 0xa9, 0x47, 0xfe, 0x0c, 0xa2, 0xf1, 0xfa, 0xd1, 0x88, 0x42, 0x41, 0x5c,
+	0x98, 0x4b, 0xfc, 0x5a, 0x00, 0x5d, 0x4e, 0x7d, 0x6e, 0x39, 0xfc, 0x8f, 0x85, 0xc6, 0x27, 0x27,
+	0x43, 0x0e, 0xdb, 0x63, 0x97, 0x5a, 0xe2, 0xef, 0xf1, 0x53, 0xda, 0x53, 0xbf, 0xda, 0x1f, 0x37,
+	0xa0, 0x5f, 0x86, 0x6a, 0xec, 0xe1, 0x7c, 0x8a, 0xc5, 0xab, 0x1e, 0xd7, 0x8f, 0x63, 0xfd, 0x08,
+	0x2a, 0xd1, 0xf7, 0xed, 0x68, 0x35, 0x6d, 0x2e, 0x0d, 0x31, 0x3e, 0xca, 0x54, 0x0a, 0x89, 0xc9,
+	0x88, 0xa9, 0x34, 0xf4, 0xe2, 0x77, 0xf2, 0xa9, 0x14, 0xe1, 0x3f, 0x72, 0x2a, 0x1d, 0xb9, 0x89,
+	0x6f, 0x6a, 0xb0, 0xac, 0x7e, 0x1e, 0x8d, 0xd6, 0xd3, 0x6c, 0x33, 0xfd, 0x21, 0x78, 0xe3, 0xc6,
+	0x91, 0x68, 0x42, 0x2d, 0x1e, 0xc0, 0x6c, 0xfc, 0x11, 0x70, 0x8a, 0x16, 0x95, 0xef, 0xa6, 0x1b,
+	0x97, 0x27, 0xc2, 0x0d, 0x1b, 0x7b, 0x08, 0xe5, 0xc8, 0x0f, 0xfc, 0xd0, 0x6b, 0x23, 0xec, 0x38,
+	0xfa, 0x37, 0xbb, 0x71, 0x9a, 0xfc, 0x02, 0x94, 0xc2, 0xff, 0xee, 0xa1, 0x8b, 0xa9, 0xf6, 0x7b,
+	0x14, 0x96, 0x3b, 0x00, 0x83, 0x9f, 0xea, 0xa1, 0x4f, 0x28, 0x79, 0x0e, 0xfd, 0x75, 0x6f, 0x1c,
+	0xd3, 0x16, 0xa0, 0xe1, 0x3f, 0xe1, 0xa5, 0x38, 0xcf, 0xd4, 0x5f, 0xe6, 0x8d, 0x6b, 0x24, 0xd4,
+	0xb1, 0x78, 0x9f, 0x30, 0x4a, 0xc7, 0xd1, 0x67, 0x47, 0xe3, 0xd8, 0xee, 0x43, 0x35, 0xf0, 0xcf,
+	0x82, 0xf1, 0xa5, 0x91, 0x3e, 0x3c, 0xc6, 0x7a, 0x6d, 0x12, 0xd4, 0xd0, 0x48, 0xf6, 0xa1, 0x1a,
+	0x7b, 0xba, 0x95, 0xd2, 0x92, 0xea, 0xa5, 0x5a, 0x63, 0x6d, 0x12, 0xd4, 0xb0, 0xa5, 0x6f, 0x44,
+	0x5e, 0x89, 0xc5, 0x5e, 0xe2, 0xa1, 0xeb, 0x23, 0xf9, 0xa8, 0x1e, 0x22, 0x36, 0xd6, 0x8f, 0x42,
+	0x12, 0x8a, 0x20, 0x4d, 0x57, 0xa8, 0x34, 0xdd, 0x74, 0x8f, 0x32, 0x52, 0x3b, 0x90, 0x17, 0x8f,
+	0xb1, 0x90, 0x9e, 0xf2, 0xec, 0x32, 0xf2, 0x06, 0xa9, 0xf1, 0x8a, 0x12, 0x27, 0xfe, 0x02, 0x47,
+	0x30, 0x15, 0xf1, 0xb4, 0x14, 0xa6, 0xb1, 0x37, 0x26, 0x47, 0x60, 0x2a, 0x1e, 0x44, 0xa5, 0x30,
+	0x8d, 0xbd, 0x96, 0x9a, 0x94, 0xa9, 0x01, 0x79, 0x71, 0xbb, 0x16, 0x4d, 0x70, 0xbb, 0xbb, 0x31,
+	0x1a, 0x47, 0x5c, 0xc9, 0x3d, 0x85, 0x7e, 0x01, 0x2a, 0xd1, 0xdb, 0xee, 0x69, 0x2b, 0xd9, 0xf0,
+	0x85, 0xf8, 0x09, 0xf9, 0x6f, 0x43, 0x8e, 0xdf, 0x72, 0x45, 0x17, 0x46, 0xdd, 0x80, 0x1d, 0xc5,
+	0x31, 0x76, 0x49, 0x56, 0x3f, 0x85, 0x1e, 0x40, 0x8e, 0x27, 0x1d, 0x53, 0x38, 0x46, 0xaf, 0xb1,
+	0x36, 0x46, 0xa2, 0x04, 0x22, 0x52, 0x98, 0x1f, 0xba, 0xbe, 0x96, 0xb2, 0x20, 0xa6, 0xdd, 0x11,
+	0x6c, 0x5c, 0x9d, 0x14, 0x3d, 0xec, 0x86, 0x07, 0xf3, 0x43, 0xd7, 0xcf, 0x52, 0x5a, 0x4d, 0xbb,
+	0xa6, 0xd6, 0xb8, 0x94, 0xde, 0xbd, 0xc4, 0xc5, 0x31, 0xe1, 0xa2, 0x87, 0x6f, 0x66, 0xa5, 0xb8,
+	0xe8, 0xd4, 0x2b, 0x5c, 0xe3, 0x66, 0xa8, 0x05, 0x95, 0xe8, 0x4d, 0x99, 0x14, 0x73, 0x52, 0xdc,
+	0x25, 0x6a, 0x4c, 0x82, 0x19, 0x74, 0xe5, 0xd7, 0x35, 0xa8, 0xa7, 0x5d, 0xaa, 0x40, 0xa9, 0xbb,
+	0xdf, 0x51, 0x37, 0x43, 0x1a, 0x6f, 0x1c, 0x91, 0x2a, 0x1c, 0xc7, 0x0f, 0x61, 0x41, 0x91, 0x79,
+	0x47, 0xd7, 0xd2, 0xf8, 0xa5, 0x5c, 0x1a, 0x68, 0x7c, 0x6a, 0x72, 0x82, 0xb0, 0xed, 0x6d, 0xc8,
+	0xf1, 0x8c, 0x79, 0xca, 0x54, 0x88, 0x26, 0xe0, 0x1b, 0xfa, 0x28, 0x94, 0x90, 0x23, 0x86, 0x4a,
+	0x34, 0x7d, 0x9e, 0x32, 0x7e, 0x8a, 0xcc, 0x7b, 0xe3, 0xd2, 0x04, 0x98, 0x61, 0x33, 0x4d, 0x80,
+	0x41, 0xfa, 0x3a, 0x65, 0x0f, 0x32, 0x94, 0x41, 0x6f, 0xbc, 0x36, 0x16, 0x2f, 0xba, 0x1d, 0x8b,
+	0x24, 0xa4, 0x53, 0xb6, 0x0a, 0xc3, 0x29, 0xeb, 0x09, 0xce, 0x88, 0xc3, 0xc9, 0xd1, 0x94, 0x39,
+	0x94, 0x9a, 0x87, 0x6d, 0x5c, 0x9b, 0x18, 0x3f, 0xec, 0xcf, 0xd7, 0xa1, 0x96, 0x4c, 0x26, 0xa7,
+	0xc4, 0x1e, 0x52, 0x52, 0xda, 0x8d, 0x2b, 0x13, 0x62, 0x47, 0xb7, 0x10, 0x67, 0x87, 0x65, 0xfa,
+	0x92, 0x4d, 0xf7, 0x79, 0x1e, 0x73, 0x92, 0x5e, 0x47, 0x53, 0xa6, 0x8d, 0x6b, 0x13, 0xe3, 0x47,
+	0xcc, 0xa4, 0x96, 0xcc, 0x0e, 0x8e, 0x8e, 0xb8, 0x24, 0x33, 0x62, 0xe3, 0x83, 0x22, 0xb5, 0x64,
+	0xe2, 0x2f, 0xa5, 0x81, 0x94, 0xfc, 0xe0, 0x04, 0x0d, 0x24, 0x93, 0x75, 0x29, 0x0d, 0xa4, 0xe4,
+	0xf4, 0x26, 0xd8, 0xbc, 0xc6, 0x52, 0x6b, 0x29, 0x5b, 0x4a, 0x55, 0x22, 0xaf, 0xb1, 0x36, 0x09,
+	0x6a, 0x38, 0x18, 0x3b, 0x00, 0x83, 0xa4, 0x58, 0xca, 0x9c, 0x1d, 0xca, 0x9a, 0x8d, 0x13, 0xff,
+	0x01, 0x14, 0x83, 0x4c, 0x17, 0x7a, 0x35, 0x75, 0x8f, 0x78, 0x04, 0x86, 0x8f, 0x60, 0x2e, 0x11,
+	0x27, 0x4c, 0x89, 0x29, 0xa8, 0xb3, 0x5f, 0x13, 0x8c, 0x67, 0x32, 0x88, 0x98, 0x32, 0x9e, 0x29,
+	0xd1, 0xfb, 0x71, 0x0d, 0xec, 0x42, 0x39, 0x92, 0x82, 0x48, 0x71, 0x5c, 0xc3, 0x79, 0x92, 0xc6,
+	0xea, 0x78, 0xc4, 0x68, 0x78, 0x21, 0x1e, 0x95, 0x4f, 0x39, 0x18, 0x2b, 0x43, 0xf7, 0xe3, 0x3a,
+	0xf0, 0x25, 0xa8, 0x44, 0xc3, 0xf1, 0x29, 0x2b, 0x88, 0x22, 0x62, 0x3f, 0xa1, 0xa5, 0x07, 0x54,
+	0xa3, 0x2c, 0x3d, 0x19, 0xa9, 0x6f, 0xac, 0x4d, 0x82, 0x1a, 0xe8, 0x67, 0xbd, 0x07, 0x95, 0x6d,
+	0xdf, 0x7b, 0xd2, 0x0f, 0x02, 0xbf, 0x1f, 0xcf, 0xa2, 0xb8, 0xf1, 0xc6, 0xcf, 0xdf, 0x68, 0xdb,
+	0x74, 0xbf, 0xb7, 0xcb, 0xba, 0x7e, 0x4d, 0xe0, 0x5e, 0xb1, 0x3d, 0xf9, 0x75, 0xcd, 0x76, 0x29,
+	0xf6, 0x5d, 0xd3, 0xb9, 0xc6, 0x79, 0x49, 0x68, 0x77, 0x77, 0x37, 0xcf, 0xcb, 0x37, 0x7e, 0x32,
+	0x00, 0xdf, 0x90, 0x47, 0xdc, 0x83, 0x60, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MilvusServiceClient is the client API for MilvusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MilvusServiceClient interface {
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
+	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error)
+	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddCollectionField(ctx context.Context, in *AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
+	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
+	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
+	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
+	HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
+	OpenQueryIterator(ctx context.Context, in *OpenQueryIteratorRequest, opts ...grpc.CallOption) (*OpenQueryIteratorResponse, error)
+	NextQueryIterator(ctx context.Context, in *NextQueryIteratorRequest, opts ...grpc.CallOption) (*QueryIteratorResults, error)
+	CloseQueryIterator(ctx context.Context, in *CloseQueryIteratorRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
+	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
+	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
+	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
+	// TODO: remove
+	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
+	LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error)
+	ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error)
+	GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error)
+	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+}
+
+type milvusServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMilvusServiceClient(cc *grpc.ClientConn) MilvusServiceClient {
+	return &milvusServiceClient{cc}
+}
+
+func (c *milvusServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReleaseCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error) {
+	out := new(DescribeCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DescribeCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
+	out := new(GetCollectionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCollectionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error) {
+	out := new(ShowCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ShowCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadPartitions", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
 	return out, nil
@@ -5330,6 +7360,15 @@ func (c *milvusServiceClient) AlterAlias(ctx context.Context, in *AlterAliasRequ
 	return out, nil
 }
 
+func (c *milvusServiceClient) AddCollectionField(ctx context.Context, in *AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AddCollectionField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateIndex", in, out, opts...)
@@ -5375,135 +7414,288 @@ func (c *milvusServiceClient) DropIndex(ctx context.Context, in *DropIndexReques
 	return out, nil
 }
 
-func (c *milvusServiceClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
-	out := new(MutationResult)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Insert", in, out, opts...)
+func (c *milvusServiceClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Insert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HybridSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error) {
+	out := new(QueryResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) OpenQueryIterator(ctx context.Context, in *OpenQueryIteratorRequest, opts ...grpc.CallOption) (*OpenQueryIteratorResponse, error) {
+	out := new(OpenQueryIteratorResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OpenQueryIterator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) NextQueryIterator(ctx context.Context, in *NextQueryIteratorRequest, opts ...grpc.CallOption) (*QueryIteratorResults, error) {
+	out := new(QueryIteratorResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/NextQueryIterator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CloseQueryIterator(ctx context.Context, in *CloseQueryIteratorRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CloseQueryIterator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error) {
+	out := new(CalcDistanceResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CalcDistance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error) {
+	out := new(GetPersistentSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error) {
+	out := new(GetQuerySegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetQuerySegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
+	out := new(DummyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Dummy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error) {
+	out := new(RegisterLinkResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RegisterLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
+	out := new(GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error) {
+	out := new(GetCompactionStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error) {
-	out := new(MutationResult)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Delete", in, out, opts...)
+func (c *milvusServiceClient) ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error) {
+	out := new(ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ManualCompaction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
-	out := new(SearchResults)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
+func (c *milvusServiceClient) GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error) {
+	out := new(GetCompactionPlansResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionStateWithPlans", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
-	out := new(FlushResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Flush", in, out, opts...)
+func (c *milvusServiceClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error) {
-	out := new(QueryResults)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Query", in, out, opts...)
+func (c *milvusServiceClient) UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/UpdateCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error) {
-	out := new(CalcDistanceResults)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CalcDistance", in, out, opts...)
+func (c *milvusServiceClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DeleteCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error) {
-	out := new(GetPersistentSegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo", in, out, opts...)
+func (c *milvusServiceClient) ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error) {
+	out := new(ListCredUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListCredUsers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error) {
-	out := new(GetQuerySegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetQuerySegmentInfo", in, out, opts...)
+func (c *milvusServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
-	out := new(DummyResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Dummy", in, out, opts...)
+func (c *milvusServiceClient) DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error) {
-	out := new(RegisterLinkResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RegisterLink", in, out, opts...)
+func (c *milvusServiceClient) OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperateUserRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
-	out := new(GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetMetrics", in, out, opts...)
+func (c *milvusServiceClient) OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperatePrivilege", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadBalance", in, out, opts...)
+func (c *milvusServiceClient) SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error) {
+	out := new(SelectGrantResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SelectGrant", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error) {
-	out := new(GetCompactionStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionState", in, out, opts...)
+func (c *milvusServiceClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateDatabase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error) {
-	out := new(ManualCompactionResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ManualCompaction", in, out, opts...)
+func (c *milvusServiceClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropDatabase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error) {
-	out := new(GetCompactionPlansResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionStateWithPlans", in, out, opts...)
+func (c *milvusServiceClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDatabases", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5530,6 +7722,7 @@ type MilvusServiceServer interface {
 	CreateAlias(context.Context, *CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *AlterAliasRequest) (*commonpb.Status, error)
+	AddCollectionField(context.Context, *AddCollectionFieldRequest) (*commonpb.Status, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
 	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
 	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
@@ -5537,9 +7730,14 @@ type MilvusServiceServer interface {
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
 	Delete(context.Context, *DeleteRequest) (*MutationResult, error)
+	Upsert(context.Context, *UpsertRequest) (*MutationResult, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
+	HybridSearch(context.Context, *HybridSearchRequest) (*SearchResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
+	OpenQueryIterator(context.Context, *OpenQueryIteratorRequest) (*OpenQueryIteratorResponse, error)
+	NextQueryIterator(context.Context, *NextQueryIteratorRequest) (*QueryIteratorResults, error)
+	CloseQueryIterator(context.Context, *CloseQueryIteratorRequest) (*commonpb.Status, error)
 	CalcDistance(context.Context, *CalcDistanceRequest) (*CalcDistanceResults, error)
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(context.Context, *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error)
@@ -5552,6 +7750,18 @@ type MilvusServiceServer interface {
 	GetCompactionState(context.Context, *GetCompactionStateRequest) (*GetCompactionStateResponse, error)
 	ManualCompaction(context.Context, *ManualCompactionRequest) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(context.Context, *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error)
+	CreateCredential(context.Context, *CreateCredentialRequest) (*commonpb.Status, error)
+	UpdateCredential(context.Context, *UpdateCredentialRequest) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *DeleteCredentialRequest) (*commonpb.Status, error)
+	ListCredUsers(context.Context, *ListCredUsersRequest) (*ListCredUsersResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(context.Context, *OperateUserRoleRequest) (*commonpb.Status, error)
+	OperatePrivilege(context.Context, *OperatePrivilegeRequest) (*commonpb.Status, error)
+	SelectGrant(context.Context, *SelectGrantRequest) (*SelectGrantResponse, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
 }
 
 // UnimplementedMilvusServiceServer can be embedded to have forward compatible implementations.
@@ -5612,6 +7822,9 @@ func (*UnimplementedMilvusServiceServer) DropAlias(ctx context.Context, req *Dro
 func (*UnimplementedMilvusServiceServer) AlterAlias(ctx context.Context, req *AlterAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
 }
+func (*UnimplementedMilvusServiceServer) AddCollectionField(ctx context.Context, req *AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionField not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
@@ -5633,730 +7846,1105 @@ func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *Insert
 func (*UnimplementedMilvusServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedMilvusServiceServer) Upsert(ctx context.Context, req *UpsertRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (*UnimplementedMilvusServiceServer) HybridSearch(ctx context.Context, req *HybridSearchRequest) (*SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HybridSearch not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
 }
 func (*UnimplementedMilvusServiceServer) Query(ctx context.Context, req *QueryRequest) (*QueryResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (*UnimplementedMilvusServiceServer) OpenQueryIterator(ctx context.Context, req *OpenQueryIteratorRequest) (*OpenQueryIteratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenQueryIterator not implemented")
+}
+func (*UnimplementedMilvusServiceServer) NextQueryIterator(ctx context.Context, req *NextQueryIteratorRequest) (*QueryIteratorResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextQueryIterator not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CloseQueryIterator(ctx context.Context, req *CloseQueryIteratorRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseQueryIterator not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CalcDistance(ctx context.Context, req *CalcDistanceRequest) (*CalcDistanceResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalcDistance not implemented")
 }
 func (*UnimplementedMilvusServiceServer) GetPersistentSegmentInfo(ctx context.Context, req *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPersistentSegmentInfo not implemented")
 }
-func (*UnimplementedMilvusServiceServer) GetQuerySegmentInfo(ctx context.Context, req *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQuerySegmentInfo not implemented")
+func (*UnimplementedMilvusServiceServer) GetQuerySegmentInfo(ctx context.Context, req *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuerySegmentInfo not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Dummy(ctx context.Context, req *DummyRequest) (*DummyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RegisterLink(ctx context.Context, req *RegisterLinkRequest) (*RegisterLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterLink not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetMetrics(ctx context.Context, req *GetMetricsRequest) (*GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (*UnimplementedMilvusServiceServer) LoadBalance(ctx context.Context, req *LoadBalanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadBalance not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetCompactionState(ctx context.Context, req *GetCompactionStateRequest) (*GetCompactionStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ManualCompaction(ctx context.Context, req *ManualCompactionRequest) (*ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetCompactionStateWithPlans(ctx context.Context, req *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateCredential(ctx context.Context, req *CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) UpdateCredential(ctx context.Context, req *UpdateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DeleteCredential(ctx context.Context, req *DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListCredUsers(ctx context.Context, req *ListCredUsersRequest) (*ListCredUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredUsers not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropRole(ctx context.Context, req *DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperateUserRole(ctx context.Context, req *OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperatePrivilege(ctx context.Context, req *OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatePrivilege not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SelectGrant(ctx context.Context, req *SelectGrantRequest) (*SelectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+
+func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
+	s.RegisterService(&_MilvusService_serviceDesc, srv)
+}
+
+func _MilvusService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropCollection(ctx, req.(*DropCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_HasCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).HasCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HasCollection(ctx, req.(*HasCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_LoadCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).LoadCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/LoadCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).LoadCollection(ctx, req.(*LoadCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ReleaseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ReleaseCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ReleaseCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ReleaseCollection(ctx, req.(*ReleaseCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DescribeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DescribeCollection(ctx, req.(*DescribeCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCollectionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ShowCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ShowCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ShowCollections(ctx, req.(*ShowCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreatePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreatePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreatePartition(ctx, req.(*CreatePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropPartition(ctx, req.(*DropPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) Dummy(ctx context.Context, req *DummyRequest) (*DummyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
+
+func _MilvusService_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).HasPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HasPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HasPartition(ctx, req.(*HasPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) RegisterLink(ctx context.Context, req *RegisterLinkRequest) (*RegisterLinkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterLink not implemented")
+
+func _MilvusService_LoadPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).LoadPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/LoadPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).LoadPartitions(ctx, req.(*LoadPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) GetMetrics(ctx context.Context, req *GetMetricsRequest) (*GetMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+
+func _MilvusService_ReleasePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ReleasePartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ReleasePartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ReleasePartitions(ctx, req.(*ReleasePartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) LoadBalance(ctx context.Context, req *LoadBalanceRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoadBalance not implemented")
+
+func _MilvusService_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetPartitionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) GetCompactionState(ctx context.Context, req *GetCompactionStateRequest) (*GetCompactionStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
+
+func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ShowPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ShowPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ShowPartitions(ctx, req.(*ShowPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) ManualCompaction(ctx context.Context, req *ManualCompactionRequest) (*ManualCompactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+
+func _MilvusService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateAlias(ctx, req.(*CreateAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) GetCompactionStateWithPlans(ctx context.Context, req *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
+
+func _MilvusService_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropAlias(ctx, req.(*DropAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
-	s.RegisterService(&_MilvusService_serviceDesc, srv)
+func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AlterAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AlterAlias(ctx, req.(*AlterAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCollectionRequest)
+func _MilvusService_AddCollectionField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionFieldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreateCollection(ctx, in)
+		return srv.(MilvusServiceServer).AddCollectionField(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/AddCollectionField",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+		return srv.(MilvusServiceServer).AddCollectionField(ctx, req.(*AddCollectionFieldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropCollectionRequest)
+func _MilvusService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropCollection(ctx, in)
+		return srv.(MilvusServiceServer).CreateIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropCollection(ctx, req.(*DropCollectionRequest))
+		return srv.(MilvusServiceServer).CreateIndex(ctx, req.(*CreateIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_HasCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HasCollectionRequest)
+func _MilvusService_DescribeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).HasCollection(ctx, in)
+		return srv.(MilvusServiceServer).DescribeIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).HasCollection(ctx, req.(*HasCollectionRequest))
+		return srv.(MilvusServiceServer).DescribeIndex(ctx, req.(*DescribeIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_LoadCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadCollectionRequest)
+func _MilvusService_GetIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).LoadCollection(ctx, in)
+		return srv.(MilvusServiceServer).GetIndexState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/LoadCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetIndexState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).LoadCollection(ctx, req.(*LoadCollectionRequest))
+		return srv.(MilvusServiceServer).GetIndexState(ctx, req.(*GetIndexStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ReleaseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleaseCollectionRequest)
+func _MilvusService_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexBuildProgressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ReleaseCollection(ctx, in)
+		return srv.(MilvusServiceServer).GetIndexBuildProgress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ReleaseCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetIndexBuildProgress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ReleaseCollection(ctx, req.(*ReleaseCollectionRequest))
+		return srv.(MilvusServiceServer).GetIndexBuildProgress(ctx, req.(*GetIndexBuildProgressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeCollectionRequest)
+func _MilvusService_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DescribeCollection(ctx, in)
+		return srv.(MilvusServiceServer).DropIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DescribeCollection(ctx, req.(*DescribeCollectionRequest))
+		return srv.(MilvusServiceServer).DropIndex(ctx, req.(*DropIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionStatisticsRequest)
+func _MilvusService_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, in)
+		return srv.(MilvusServiceServer).Insert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetCollectionStatistics",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Insert",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+		return srv.(MilvusServiceServer).Insert(ctx, req.(*InsertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShowCollectionsRequest)
+func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ShowCollections(ctx, in)
+		return srv.(MilvusServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ShowCollections",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Delete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ShowCollections(ctx, req.(*ShowCollectionsRequest))
+		return srv.(MilvusServiceServer).Delete(ctx, req.(*DeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePartitionRequest)
+func _MilvusService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreatePartition(ctx, in)
+		return srv.(MilvusServiceServer).Upsert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreatePartition",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Upsert",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreatePartition(ctx, req.(*CreatePartitionRequest))
+		return srv.(MilvusServiceServer).Upsert(ctx, req.(*UpsertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropPartitionRequest)
+func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropPartition(ctx, in)
+		return srv.(MilvusServiceServer).Search(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropPartition",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Search",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropPartition(ctx, req.(*DropPartitionRequest))
+		return srv.(MilvusServiceServer).Search(ctx, req.(*SearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HasPartitionRequest)
+func _MilvusService_HybridSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HybridSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).HasPartition(ctx, in)
+		return srv.(MilvusServiceServer).HybridSearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/HasPartition",
+		FullMethod: "/milvus.proto.milvus.MilvusService/HybridSearch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).HasPartition(ctx, req.(*HasPartitionRequest))
+		return srv.(MilvusServiceServer).HybridSearch(ctx, req.(*HybridSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_LoadPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadPartitionsRequest)
+func _MilvusService_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).LoadPartitions(ctx, in)
+		return srv.(MilvusServiceServer).Flush(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/LoadPartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Flush",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).LoadPartitions(ctx, req.(*LoadPartitionsRequest))
+		return srv.(MilvusServiceServer).Flush(ctx, req.(*FlushRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ReleasePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleasePartitionsRequest)
+func _MilvusService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ReleasePartitions(ctx, in)
+		return srv.(MilvusServiceServer).Query(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ReleasePartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Query",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ReleasePartitions(ctx, req.(*ReleasePartitionsRequest))
+		return srv.(MilvusServiceServer).Query(ctx, req.(*QueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartitionStatisticsRequest)
+func _MilvusService_OpenQueryIterator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenQueryIteratorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, in)
+		return srv.(MilvusServiceServer).OpenQueryIterator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetPartitionStatistics",
+		FullMethod: "/milvus.proto.milvus.MilvusService/OpenQueryIterator",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+		return srv.(MilvusServiceServer).OpenQueryIterator(ctx, req.(*OpenQueryIteratorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShowPartitionsRequest)
+func _MilvusService_NextQueryIterator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextQueryIteratorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ShowPartitions(ctx, in)
+		return srv.(MilvusServiceServer).NextQueryIterator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ShowPartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/NextQueryIterator",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ShowPartitions(ctx, req.(*ShowPartitionsRequest))
+		return srv.(MilvusServiceServer).NextQueryIterator(ctx, req.(*NextQueryIteratorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAliasRequest)
+func _MilvusService_CloseQueryIterator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseQueryIteratorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreateAlias(ctx, in)
+		return srv.(MilvusServiceServer).CloseQueryIterator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreateAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CloseQueryIterator",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreateAlias(ctx, req.(*CreateAliasRequest))
+		return srv.(MilvusServiceServer).CloseQueryIterator(ctx, req.(*CloseQueryIteratorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropAliasRequest)
+func _MilvusService_CalcDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalcDistanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropAlias(ctx, in)
+		return srv.(MilvusServiceServer).CalcDistance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CalcDistance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropAlias(ctx, req.(*DropAliasRequest))
+		return srv.(MilvusServiceServer).CalcDistance(ctx, req.(*CalcDistanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AlterAliasRequest)
+func _MilvusService_GetPersistentSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersistentSegmentInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).AlterAlias(ctx, in)
+		return srv.(MilvusServiceServer).GetPersistentSegmentInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/AlterAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).AlterAlias(ctx, req.(*AlterAliasRequest))
+		return srv.(MilvusServiceServer).GetPersistentSegmentInfo(ctx, req.(*GetPersistentSegmentInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateIndexRequest)
+func _MilvusService_GetQuerySegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuerySegmentInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreateIndex(ctx, in)
+		return srv.(MilvusServiceServer).GetQuerySegmentInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreateIndex",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetQuerySegmentInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+		return srv.(MilvusServiceServer).GetQuerySegmentInfo(ctx, req.(*GetQuerySegmentInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DescribeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeIndexRequest)
+func _MilvusService_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DummyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DescribeIndex(ctx, in)
+		return srv.(MilvusServiceServer).Dummy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeIndex",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Dummy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DescribeIndex(ctx, req.(*DescribeIndexRequest))
+		return srv.(MilvusServiceServer).Dummy(ctx, req.(*DummyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIndexStateRequest)
+func _MilvusService_RegisterLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetIndexState(ctx, in)
+		return srv.(MilvusServiceServer).RegisterLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetIndexState",
+		FullMethod: "/milvus.proto.milvus.MilvusService/RegisterLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetIndexState(ctx, req.(*GetIndexStateRequest))
+		return srv.(MilvusServiceServer).RegisterLink(ctx, req.(*RegisterLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIndexBuildProgressRequest)
+func _MilvusService_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetIndexBuildProgress(ctx, in)
+		return srv.(MilvusServiceServer).GetMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetIndexBuildProgress",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetMetrics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetIndexBuildProgress(ctx, req.(*GetIndexBuildProgressRequest))
+		return srv.(MilvusServiceServer).GetMetrics(ctx, req.(*GetMetricsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropIndexRequest)
+func _MilvusService_LoadBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadBalanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropIndex(ctx, in)
+		return srv.(MilvusServiceServer).LoadBalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropIndex",
+		FullMethod: "/milvus.proto.milvus.MilvusService/LoadBalance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropIndex(ctx, req.(*DropIndexRequest))
+		return srv.(MilvusServiceServer).LoadBalance(ctx, req.(*LoadBalanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertRequest)
+func _MilvusService_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Insert(ctx, in)
+		return srv.(MilvusServiceServer).GetCompactionState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Insert",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCompactionState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Insert(ctx, req.(*InsertRequest))
+		return srv.(MilvusServiceServer).GetCompactionState(ctx, req.(*GetCompactionStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _MilvusService_ManualCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManualCompactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Delete(ctx, in)
+		return srv.(MilvusServiceServer).ManualCompaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Delete",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ManualCompaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(MilvusServiceServer).ManualCompaction(ctx, req.(*ManualCompactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
+func _MilvusService_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionPlansRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Search(ctx, in)
+		return srv.(MilvusServiceServer).GetCompactionStateWithPlans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Search",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCompactionStateWithPlans",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Search(ctx, req.(*SearchRequest))
+		return srv.(MilvusServiceServer).GetCompactionStateWithPlans(ctx, req.(*GetCompactionPlansRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FlushRequest)
+func _MilvusService_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Flush(ctx, in)
+		return srv.(MilvusServiceServer).CreateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Flush",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Flush(ctx, req.(*FlushRequest))
+		return srv.(MilvusServiceServer).CreateCredential(ctx, req.(*CreateCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequest)
+func _MilvusService_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Query(ctx, in)
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Query",
+		FullMethod: "/milvus.proto.milvus.MilvusService/UpdateCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Query(ctx, req.(*QueryRequest))
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, req.(*UpdateCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CalcDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalcDistanceRequest)
+func _MilvusService_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CalcDistance(ctx, in)
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CalcDistance",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DeleteCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CalcDistance(ctx, req.(*CalcDistanceRequest))
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, req.(*DeleteCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetPersistentSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPersistentSegmentInfoRequest)
+func _MilvusService_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetPersistentSegmentInfo(ctx, in)
+		return srv.(MilvusServiceServer).ListCredUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListCredUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetPersistentSegmentInfo(ctx, req.(*GetPersistentSegmentInfoRequest))
+		return srv.(MilvusServiceServer).ListCredUsers(ctx, req.(*ListCredUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetQuerySegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetQuerySegmentInfoRequest)
+func _MilvusService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetQuerySegmentInfo(ctx, in)
+		return srv.(MilvusServiceServer).CreateRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetQuerySegmentInfo",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetQuerySegmentInfo(ctx, req.(*GetQuerySegmentInfoRequest))
+		return srv.(MilvusServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DummyRequest)
+func _MilvusService_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Dummy(ctx, in)
+		return srv.(MilvusServiceServer).DropRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Dummy",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Dummy(ctx, req.(*DummyRequest))
+		return srv.(MilvusServiceServer).DropRole(ctx, req.(*DropRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_RegisterLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterLinkRequest)
+func _MilvusService_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateUserRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).RegisterLink(ctx, in)
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/RegisterLink",
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperateUserRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).RegisterLink(ctx, req.(*RegisterLinkRequest))
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, req.(*OperateUserRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetricsRequest)
+func _MilvusService_OperatePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperatePrivilegeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetMetrics(ctx, in)
+		return srv.(MilvusServiceServer).OperatePrivilege(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetMetrics",
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperatePrivilege",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetMetrics(ctx, req.(*GetMetricsRequest))
+		return srv.(MilvusServiceServer).OperatePrivilege(ctx, req.(*OperatePrivilegeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_LoadBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadBalanceRequest)
+func _MilvusService_SelectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).LoadBalance(ctx, in)
+		return srv.(MilvusServiceServer).SelectGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/LoadBalance",
+		FullMethod: "/milvus.proto.milvus.MilvusService/SelectGrant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).LoadBalance(ctx, req.(*LoadBalanceRequest))
+		return srv.(MilvusServiceServer).SelectGrant(ctx, req.(*SelectGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCompactionStateRequest)
+func _MilvusService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetCompactionState(ctx, in)
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetCompactionState",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetCompactionState(ctx, req.(*GetCompactionStateRequest))
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ManualCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ManualCompactionRequest)
+func _MilvusService_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ManualCompaction(ctx, in)
+		return srv.(MilvusServiceServer).DropDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ManualCompaction",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ManualCompaction(ctx, req.(*ManualCompactionRequest))
+		return srv.(MilvusServiceServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCompactionPlansRequest)
+func _MilvusService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetCompactionStateWithPlans(ctx, in)
+		return srv.(MilvusServiceServer).ListDatabases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetCompactionStateWithPlans",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDatabases",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetCompactionStateWithPlans(ctx, req.(*GetCompactionPlansRequest))
+		return srv.(MilvusServiceServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -6437,6 +9025,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AlterAlias",
 			Handler:    _MilvusService_AlterAlias_Handler,
 		},
+		{
+			MethodName: "AddCollectionField",
+			Handler:    _MilvusService_AddCollectionField_Handler,
+		},
 		{
 			MethodName: "CreateIndex",
 			Handler:    _MilvusService_CreateIndex_Handler,
@@ -6465,10 +9057,18 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _MilvusService_Delete_Handler,
 		},
+		{
+			MethodName: "Upsert",
+			Handler:    _MilvusService_Upsert_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _MilvusService_Search_Handler,
 		},
+		{
+			MethodName: "HybridSearch",
+			Handler:    _MilvusService_HybridSearch_Handler,
+		},
 		{
 			MethodName: "Flush",
 			Handler:    _MilvusService_Flush_Handler,
@@ -6477,6 +9077,18 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _MilvusService_Query_Handler,
 		},
+		{
+			MethodName: "OpenQueryIterator",
+			Handler:    _MilvusService_OpenQueryIterator_Handler,
+		},
+		{
+			MethodName: "NextQueryIterator",
+			Handler:    _MilvusService_NextQueryIterator_Handler,
+		},
+		{
+			MethodName: "CloseQueryIterator",
+			Handler:    _MilvusService_CloseQueryIterator_Handler,
+		},
 		{
 			MethodName: "CalcDistance",
 			Handler:    _MilvusService_CalcDistance_Handler,
@@ -6517,6 +9129,54 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompactionStateWithPlans",
 			Handler:    _MilvusService_GetCompactionStateWithPlans_Handler,
 		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _MilvusService_CreateCredential_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _MilvusService_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _MilvusService_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListCredUsers",
+			Handler:    _MilvusService_ListCredUsers_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _MilvusService_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _MilvusService_DropRole_Handler,
+		},
+		{
+			MethodName: "OperateUserRole",
+			Handler:    _MilvusService_OperateUserRole_Handler,
+		},
+		{
+			MethodName: "OperatePrivilege",
+			Handler:    _MilvusService_OperatePrivilege_Handler,
+		},
+		{
+			MethodName: "SelectGrant",
+			Handler:    _MilvusService_SelectGrant_Handler,
+		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MilvusService_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MilvusService_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MilvusService_ListDatabases_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milvus.proto",
@@ -61,6 +61,65 @@ func (OpType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{0}
 }
 
+type NullExpr_NullOp int32
+
+const (
+	NullExpr_Invalid   NullExpr_NullOp = 0
+	NullExpr_IsNull    NullExpr_NullOp = 1
+	NullExpr_IsNotNull NullExpr_NullOp = 2
+)
+
+var NullExpr_NullOp_name = map[int32]string{
+	0: "Invalid",
+	1: "IsNull",
+	2: "IsNotNull",
+}
+
+var NullExpr_NullOp_value = map[string]int32{
+	"Invalid":   0,
+	"IsNull":    1,
+	"IsNotNull": 2,
+}
+
+func (x NullExpr_NullOp) String() string {
+	return proto.EnumName(NullExpr_NullOp_name, int32(x))
+}
+
+func (NullExpr_NullOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{7, 0}
+}
+
+type ArrayContainsExpr_ContainsOp int32
+
+const (
+	ArrayContainsExpr_Invalid     ArrayContainsExpr_ContainsOp = 0
+	ArrayContainsExpr_Contains    ArrayContainsExpr_ContainsOp = 1
+	ArrayContainsExpr_ContainsAny ArrayContainsExpr_ContainsOp = 2
+	ArrayContainsExpr_ContainsAll ArrayContainsExpr_ContainsOp = 3
+)
+
+var ArrayContainsExpr_ContainsOp_name = map[int32]string{
+	0: "Invalid",
+	1: "Contains",
+	2: "ContainsAny",
+	3: "ContainsAll",
+}
+
+var ArrayContainsExpr_ContainsOp_value = map[string]int32{
+	"Invalid":     0,
+	"Contains":    1,
+	"ContainsAny": 2,
+	"ContainsAll": 3,
+}
+
+func (x ArrayContainsExpr_ContainsOp) String() string {
+	return proto.EnumName(ArrayContainsExpr_ContainsOp_name, int32(x))
+}
+
+func (ArrayContainsExpr_ContainsOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{8, 0}
+}
+
 type UnaryExpr_UnaryOp int32
 
 const (
@@ -83,7 +142,7 @@ func (x UnaryExpr_UnaryOp) String() string {
 }
 
 func (UnaryExpr_UnaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
 }
 
 type BinaryExpr_BinaryOp int32
@@ -111,7 +170,7 @@ func (x BinaryExpr_BinaryOp) String() string {
 }
 
 func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{11, 0}
 }
 
 type GenericValue struct {
@@ -119,6 +178,7 @@ type GenericValue struct {
 	//	*GenericValue_BoolVal
 	//	*GenericValue_Int64Val
 	//	*GenericValue_FloatVal
+	//	*GenericValue_StringVal
 	Val                  isGenericValue_Val `protobuf_oneof:"val"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -166,12 +226,18 @@ type GenericValue_FloatVal struct {
 	FloatVal float64 `protobuf:"fixed64,3,opt,name=float_val,json=floatVal,proto3,oneof"`
 }
 
+type GenericValue_StringVal struct {
+	StringVal string `protobuf:"bytes,4,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
 func (*GenericValue_BoolVal) isGenericValue_Val() {}
 
 func (*GenericValue_Int64Val) isGenericValue_Val() {}
 
 func (*GenericValue_FloatVal) isGenericValue_Val() {}
 
+func (*GenericValue_StringVal) isGenericValue_Val() {}
+
 func (m *GenericValue) GetVal() isGenericValue_Val {
 	if m != nil {
 		return m.Val
@@ -200,12 +266,20 @@ func (m *GenericValue) GetFloatVal() float64 {
 	return 0
 }
 
+func (m *GenericValue) GetStringVal() string {
+	if x, ok := m.GetVal().(*GenericValue_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GenericValue) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*GenericValue_BoolVal)(nil),
 		(*GenericValue_Int64Val)(nil),
 		(*GenericValue_FloatVal)(nil),
+		(*GenericValue_StringVal)(nil),
 	}
 }
 
@@ -214,6 +288,12 @@ type QueryInfo struct {
 	MetricType           string   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
 	SearchParams         string   `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
 	RoundDecimal         int64    `protobuf:"varint,5,opt,name=round_decimal,json=roundDecimal,proto3" json:"round_decimal,omitempty"`
+	Offset               int64    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	RangeSearch          bool     `protobuf:"varint,7,opt,name=range_search,json=rangeSearch,proto3" json:"range_search,omitempty"`
+	Radius               float64  `protobuf:"fixed64,8,opt,name=radius,proto3" json:"radius,omitempty"`
+	RangeFilter          float64  `protobuf:"fixed64,9,opt,name=range_filter,json=rangeFilter,proto3" json:"range_filter,omitempty"`
+	GroupByFieldId       int64    `protobuf:"varint,10,opt,name=group_by_field_id,json=groupByFieldId,proto3" json:"group_by_field_id,omitempty"`
+	GroupTopk            int64    `protobuf:"varint,11,opt,name=group_topk,json=groupTopk,proto3" json:"group_topk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -272,11 +352,55 @@ func (m *QueryInfo) GetRoundDecimal() int64 {
 	return 0
 }
 
+func (m *QueryInfo) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetRangeSearch() bool {
+	if m != nil {
+		return m.RangeSearch
+	}
+	return false
+}
+
+func (m *QueryInfo) GetRadius() float64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetRangeFilter() float64 {
+	if m != nil {
+		return m.RangeFilter
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetGroupByFieldId() int64 {
+	if m != nil {
+		return m.GroupByFieldId
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetGroupTopk() int64 {
+	if m != nil {
+		return m.GroupTopk
+	}
+	return 0
+}
+
 type ColumnInfo struct {
 	FieldId              int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
 	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
 	IsPrimaryKey         bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
 	IsAutoID             bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
+	ElementType          schemapb.DataType `protobuf:"varint,5,opt,name=element_type,json=elementType,proto3,enum=milvus.proto.schema.DataType" json:"element_type,omitempty"`
+	NestedPath           []string          `protobuf:"bytes,6,rep,name=nested_path,json=nestedPath,proto3" json:"nested_path,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -335,6 +459,20 @@ func (m *ColumnInfo) GetIsAutoID() bool {
 	return false
 }
 
+func (m *ColumnInfo) GetElementType() schemapb.DataType {
+	if m != nil {
+		return m.ElementType
+	}
+	return schemapb.DataType_None
+}
+
+func (m *ColumnInfo) GetNestedPath() []string {
+	if m != nil {
+		return m.NestedPath
+	}
+	return nil
+}
+
 type UnaryRangeExpr struct {
 	ColumnInfo           *ColumnInfo   `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
 	Op                   OpType        `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
@@ -563,6 +701,165 @@ func (m *TermExpr) GetValues() []*GenericValue {
 	return nil
 }
 
+type NullExpr struct {
+	ColumnInfo           *ColumnInfo     `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   NullExpr_NullOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.NullExpr_NullOp" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NullExpr) Reset()         { *m = NullExpr{} }
+func (m *NullExpr) String() string { return proto.CompactTextString(m) }
+func (*NullExpr) ProtoMessage()    {}
+func (*NullExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{7}
+}
+
+func (m *NullExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NullExpr.Unmarshal(m, b)
+}
+func (m *NullExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NullExpr.Marshal(b, m, deterministic)
+}
+func (m *NullExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullExpr.Merge(m, src)
+}
+func (m *NullExpr) XXX_Size() int {
+	return xxx_messageInfo_NullExpr.Size(m)
+}
+func (m *NullExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NullExpr proto.InternalMessageInfo
+
+func (m *NullExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *NullExpr) GetOp() NullExpr_NullOp {
+	if m != nil {
+		return m.Op
+	}
+	return NullExpr_Invalid
+}
+
+// ArrayContainsExpr checks whether an Array column contains the elements
+type ArrayContainsExpr struct {
+	ColumnInfo           *ColumnInfo                  `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   ArrayContainsExpr_ContainsOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.ArrayContainsExpr_ContainsOp" json:"op,omitempty"`
+	Elements             []*GenericValue              `protobuf:"bytes,3,rep,name=elements,proto3" json:"elements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ArrayContainsExpr) Reset()         { *m = ArrayContainsExpr{} }
+func (m *ArrayContainsExpr) String() string { return proto.CompactTextString(m) }
+func (*ArrayContainsExpr) ProtoMessage()    {}
+func (*ArrayContainsExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{8}
+}
+
+func (m *ArrayContainsExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayContainsExpr.Unmarshal(m, b)
+}
+func (m *ArrayContainsExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayContainsExpr.Marshal(b, m, deterministic)
+}
+func (m *ArrayContainsExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayContainsExpr.Merge(m, src)
+}
+func (m *ArrayContainsExpr) XXX_Size() int {
+	return xxx_messageInfo_ArrayContainsExpr.Size(m)
+}
+func (m *ArrayContainsExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayContainsExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayContainsExpr proto.InternalMessageInfo
+
+func (m *ArrayContainsExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *ArrayContainsExpr) GetOp() ArrayContainsExpr_ContainsOp {
+	if m != nil {
+		return m.Op
+	}
+	return ArrayContainsExpr_Invalid
+}
+
+func (m *ArrayContainsExpr) GetElements() []*GenericValue {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+// ArrayLengthExpr compares the number of elements of an Array column with the length
+type ArrayLengthExpr struct {
+	ColumnInfo           *ColumnInfo `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   OpType      `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
+	Length               int64       `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ArrayLengthExpr) Reset()         { *m = ArrayLengthExpr{} }
+func (m *ArrayLengthExpr) String() string { return proto.CompactTextString(m) }
+func (*ArrayLengthExpr) ProtoMessage()    {}
+func (*ArrayLengthExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
+}
+
+func (m *ArrayLengthExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayLengthExpr.Unmarshal(m, b)
+}
+func (m *ArrayLengthExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayLengthExpr.Marshal(b, m, deterministic)
+}
+func (m *ArrayLengthExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayLengthExpr.Merge(m, src)
+}
+func (m *ArrayLengthExpr) XXX_Size() int {
+	return xxx_messageInfo_ArrayLengthExpr.Size(m)
+}
+func (m *ArrayLengthExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayLengthExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayLengthExpr proto.InternalMessageInfo
+
+func (m *ArrayLengthExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *ArrayLengthExpr) GetOp() OpType {
+	if m != nil {
+		return m.Op
+	}
+	return OpType_Invalid
+}
+
+func (m *ArrayLengthExpr) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
 type UnaryExpr struct {
 	Op                   UnaryExpr_UnaryOp `protobuf:"varint,1,opt,name=op,proto3,enum=milvus.proto.plan.UnaryExpr_UnaryOp" json:"op,omitempty"`
 	Child                *Expr             `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty"`
@@ -575,7 +872,7 @@ func (m *UnaryExpr) Reset()         { *m = UnaryExpr{} }
 func (m *UnaryExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryExpr) ProtoMessage()    {}
 func (*UnaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7}
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
 }
 
 func (m *UnaryExpr) XXX_Unmarshal(b []byte) error {
@@ -623,7 +920,7 @@ func (m *BinaryExpr) Reset()         { *m = BinaryExpr{} }
 func (m *BinaryExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryExpr) ProtoMessage()    {}
 func (*BinaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *BinaryExpr) XXX_Unmarshal(b []byte) error {
@@ -673,6 +970,9 @@ type Expr struct {
 	//	*Expr_CompareExpr
 	//	*Expr_UnaryRangeExpr
 	//	*Expr_BinaryRangeExpr
+	//	*Expr_NullExpr
+	//	*Expr_ArrayContainsExpr
+	//	*Expr_ArrayLengthExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -683,7 +983,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -732,6 +1032,18 @@ type Expr_BinaryRangeExpr struct {
 	BinaryRangeExpr *BinaryRangeExpr `protobuf:"bytes,6,opt,name=binary_range_expr,json=binaryRangeExpr,proto3,oneof"`
 }
 
+type Expr_NullExpr struct {
+	NullExpr *NullExpr `protobuf:"bytes,7,opt,name=null_expr,json=nullExpr,proto3,oneof"`
+}
+
+type Expr_ArrayContainsExpr struct {
+	ArrayContainsExpr *ArrayContainsExpr `protobuf:"bytes,8,opt,name=array_contains_expr,json=arrayContainsExpr,proto3,oneof"`
+}
+
+type Expr_ArrayLengthExpr struct {
+	ArrayLengthExpr *ArrayLengthExpr `protobuf:"bytes,9,opt,name=array_length_expr,json=arrayLengthExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -744,6 +1056,12 @@ func (*Expr_UnaryRangeExpr) isExpr_Expr() {}
 
 func (*Expr_BinaryRangeExpr) isExpr_Expr() {}
 
+func (*Expr_NullExpr) isExpr_Expr() {}
+
+func (*Expr_ArrayContainsExpr) isExpr_Expr() {}
+
+func (*Expr_ArrayLengthExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -793,6 +1111,27 @@ func (m *Expr) GetBinaryRangeExpr() *BinaryRangeExpr {
 	return nil
 }
 
+func (m *Expr) GetNullExpr() *NullExpr {
+	if x, ok := m.GetExpr().(*Expr_NullExpr); ok {
+		return x.NullExpr
+	}
+	return nil
+}
+
+func (m *Expr) GetArrayContainsExpr() *ArrayContainsExpr {
+	if x, ok := m.GetExpr().(*Expr_ArrayContainsExpr); ok {
+		return x.ArrayContainsExpr
+	}
+	return nil
+}
+
+func (m *Expr) GetArrayLengthExpr() *ArrayLengthExpr {
+	if x, ok := m.GetExpr().(*Expr_ArrayLengthExpr); ok {
+		return x.ArrayLengthExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -802,6 +1141,9 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_CompareExpr)(nil),
 		(*Expr_UnaryRangeExpr)(nil),
 		(*Expr_BinaryRangeExpr)(nil),
+		(*Expr_NullExpr)(nil),
+		(*Expr_ArrayContainsExpr)(nil),
+		(*Expr_ArrayLengthExpr)(nil),
 	}
 }
 
@@ -820,7 +1162,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -880,18 +1222,23 @@ type PlanNode struct {
 	// Types that are valid to be assigned to Node:
 	//	*PlanNode_VectorAnns
 	//	*PlanNode_Predicates
-	Node                 isPlanNode_Node `protobuf_oneof:"node"`
-	OutputFieldIds       []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Node           isPlanNode_Node `protobuf_oneof:"node"`
+	OutputFieldIds []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
+	// the number of rows a retrieve keeps in each segment, ordered by the field of order_by_field_id, or by the
+	// primary key if order_by_field_id is 0; 0 means no limit
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderByFieldId       int64    `protobuf:"varint,5,opt,name=order_by_field_id,json=orderByFieldId,proto3" json:"order_by_field_id,omitempty"`
+	Descending           bool     `protobuf:"varint,6,opt,name=descending,proto3" json:"descending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -956,6 +1303,27 @@ func (m *PlanNode) GetOutputFieldIds() []int64 {
 	return nil
 }
 
+func (m *PlanNode) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *PlanNode) GetOrderByFieldId() int64 {
+	if m != nil {
+		return m.OrderByFieldId
+	}
+	return 0
+}
+
+func (m *PlanNode) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*PlanNode) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -966,6 +1334,8 @@ func (*PlanNode) XXX_OneofWrappers() []interface{} {
 
 func init() {
 	proto.RegisterEnum("milvus.proto.plan.OpType", OpType_name, OpType_value)
+	proto.RegisterEnum("milvus.proto.plan.NullExpr_NullOp", NullExpr_NullOp_name, NullExpr_NullOp_value)
+	proto.RegisterEnum("milvus.proto.plan.ArrayContainsExpr_ContainsOp", ArrayContainsExpr_ContainsOp_name, ArrayContainsExpr_ContainsOp_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
@@ -975,6 +1345,9 @@ func init() {
 	proto.RegisterType((*BinaryRangeExpr)(nil), "milvus.proto.plan.BinaryRangeExpr")
 	proto.RegisterType((*CompareExpr)(nil), "milvus.proto.plan.CompareExpr")
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
+	proto.RegisterType((*NullExpr)(nil), "milvus.proto.plan.NullExpr")
+	proto.RegisterType((*ArrayContainsExpr)(nil), "milvus.proto.plan.ArrayContainsExpr")
+	proto.RegisterType((*ArrayLengthExpr)(nil), "milvus.proto.plan.ArrayLengthExpr")
 	proto.RegisterType((*UnaryExpr)(nil), "milvus.proto.plan.UnaryExpr")
 	proto.RegisterType((*BinaryExpr)(nil), "milvus.proto.plan.BinaryExpr")
 	proto.RegisterType((*Expr)(nil), "milvus.proto.plan.Expr")
@@ -985,74 +1358,98 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1091 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x72, 0x1b, 0xc5,
-	0x13, 0xd7, 0x6a, 0x25, 0x79, 0xb7, 0xa5, 0x48, 0xca, 0x5e, 0xfe, 0xce, 0x3f, 0x04, 0x9b, 0x25,
-	0x45, 0x0c, 0x54, 0xec, 0x22, 0x09, 0x4e, 0x11, 0x0a, 0xca, 0x5f, 0xc1, 0x52, 0x11, 0x6c, 0xb3,
-	0x18, 0x1f, 0xb8, 0x6c, 0x8d, 0x76, 0xc7, 0xd2, 0x54, 0x66, 0x77, 0xd6, 0xb3, 0xb3, 0x22, 0x3a,
-	0x73, 0xe3, 0xc6, 0x4b, 0xc0, 0x19, 0x6e, 0xbc, 0x03, 0x0f, 0xc0, 0x9d, 0x17, 0xa1, 0xa6, 0x67,
-	0xad, 0x8f, 0x94, 0xec, 0x98, 0xaa, 0xdc, 0x66, 0x7e, 0xd3, 0xdd, 0xd3, 0xbf, 0xfe, 0x9a, 0x01,
-	0xc8, 0x38, 0x49, 0x37, 0x33, 0x29, 0x94, 0xf0, 0x6e, 0x27, 0x8c, 0x8f, 0x8b, 0xdc, 0xec, 0x36,
-	0xf5, 0xc1, 0xff, 0x5b, 0x79, 0x34, 0xa2, 0x09, 0x31, 0x90, 0x9f, 0x41, 0xeb, 0x90, 0xa6, 0x54,
-	0xb2, 0xe8, 0x8c, 0xf0, 0x82, 0x7a, 0x77, 0xc1, 0x19, 0x08, 0xc1, 0xc3, 0x31, 0xe1, 0xab, 0xd6,
-	0xba, 0xb5, 0xe1, 0xf4, 0x2a, 0xc1, 0x8a, 0x46, 0xce, 0x08, 0xf7, 0xee, 0x81, 0xcb, 0x52, 0xb5,
-	0xfd, 0x04, 0x4f, 0xab, 0xeb, 0xd6, 0x86, 0xdd, 0xab, 0x04, 0x0e, 0x42, 0xe5, 0xf1, 0x39, 0x17,
-	0x44, 0xe1, 0xb1, 0xbd, 0x6e, 0x6d, 0x58, 0xfa, 0x18, 0xa1, 0x33, 0xc2, 0xf7, 0xea, 0x60, 0x8f,
-	0x09, 0xf7, 0x7f, 0xb6, 0xc0, 0xfd, 0xb6, 0xa0, 0x72, 0xd2, 0x4f, 0xcf, 0x85, 0xe7, 0x41, 0x4d,
-	0x89, 0xec, 0x25, 0xde, 0x65, 0x07, 0xb8, 0xf6, 0xd6, 0xa0, 0x99, 0x50, 0x25, 0x59, 0x14, 0xaa,
-	0x49, 0x46, 0xd1, 0x92, 0x1b, 0x80, 0x81, 0x4e, 0x27, 0x19, 0xf5, 0xde, 0x87, 0x5b, 0x39, 0x25,
-	0x32, 0x1a, 0x85, 0x19, 0x91, 0x24, 0xc9, 0x57, 0x6b, 0x28, 0xd2, 0x32, 0xe0, 0x09, 0x62, 0x5a,
-	0x48, 0x8a, 0x22, 0x8d, 0xc3, 0x98, 0x46, 0x2c, 0x21, 0x7c, 0xb5, 0x8e, 0x57, 0xb4, 0x10, 0x3c,
-	0x30, 0x98, 0xff, 0xab, 0x05, 0xb0, 0x2f, 0x78, 0x91, 0xa4, 0xe8, 0xcd, 0x1d, 0x70, 0xce, 0x19,
-	0xe5, 0x71, 0xc8, 0xe2, 0xd2, 0xa3, 0x15, 0xdc, 0xf7, 0x63, 0xef, 0x19, 0xb8, 0x31, 0x51, 0xc4,
-	0xb8, 0xa4, 0xb9, 0xb7, 0x1f, 0xdd, 0xdb, 0x5c, 0x88, 0x6e, 0x19, 0xd7, 0x03, 0xa2, 0x88, 0xf6,
-	0x32, 0x70, 0xe2, 0x72, 0xe5, 0xdd, 0x87, 0x36, 0xcb, 0xc3, 0x4c, 0xb2, 0x84, 0xc8, 0x49, 0xf8,
-	0x92, 0x4e, 0x90, 0x93, 0x13, 0xb4, 0x58, 0x7e, 0x62, 0xc0, 0xaf, 0xe9, 0xc4, 0xbb, 0x0b, 0x2e,
-	0xcb, 0x43, 0x52, 0x28, 0xd1, 0x3f, 0x40, 0x46, 0x4e, 0xe0, 0xb0, 0x7c, 0x17, 0xf7, 0xfe, 0x1f,
-	0x16, 0xb4, 0xbf, 0x4f, 0x89, 0x9c, 0x04, 0x24, 0x1d, 0xd2, 0xe7, 0xaf, 0x32, 0xe9, 0x7d, 0x09,
-	0xcd, 0x08, 0x5d, 0x0f, 0x59, 0x7a, 0x2e, 0xd0, 0xdf, 0xe6, 0xeb, 0x3e, 0x61, 0x29, 0xcc, 0x08,
-	0x06, 0x10, 0xcd, 0xc8, 0x7e, 0x08, 0x55, 0x91, 0x95, 0x54, 0xee, 0x2c, 0x51, 0x3b, 0xce, 0x90,
-	0x46, 0x55, 0x64, 0xde, 0xa7, 0x50, 0x1f, 0xeb, 0xf2, 0x40, 0xbf, 0x9b, 0x8f, 0xd6, 0x96, 0x48,
-	0xcf, 0x57, 0x51, 0x60, 0xa4, 0xfd, 0xdf, 0xaa, 0xd0, 0xd9, 0x63, 0x6f, 0xd7, 0xeb, 0x07, 0xd0,
-	0xe1, 0xe2, 0x47, 0x2a, 0x43, 0x96, 0x46, 0xbc, 0xc8, 0xd9, 0xd8, 0x64, 0xc3, 0x09, 0xda, 0x08,
-	0xf7, 0x2f, 0x51, 0x2d, 0x58, 0x64, 0xd9, 0x82, 0xa0, 0x89, 0x7a, 0x1b, 0xe1, 0x99, 0xe0, 0x0e,
-	0x34, 0x8d, 0x45, 0x43, 0xb1, 0x76, 0x33, 0x8a, 0x80, 0x3a, 0xa6, 0x69, 0x76, 0xa0, 0x69, 0xae,
-	0x32, 0x16, 0xea, 0x37, 0xb4, 0x80, 0x3a, 0xb8, 0xf6, 0xff, 0xb2, 0xa0, 0xb9, 0x2f, 0x92, 0x8c,
-	0x48, 0x13, 0xa5, 0x43, 0xe8, 0x72, 0x7a, 0xae, 0xc2, 0xff, 0x1c, 0xaa, 0xb6, 0x56, 0x9b, 0xab,
-	0xe8, 0x3e, 0xdc, 0x96, 0x6c, 0x38, 0x5a, 0xb4, 0x54, 0xbd, 0x89, 0xa5, 0x0e, 0xea, 0xed, 0xbf,
-	0x5e, 0x2f, 0xf6, 0x0d, 0xea, 0xc5, 0xff, 0xc9, 0x02, 0xe7, 0x94, 0xca, 0xe4, 0xad, 0x64, 0xfc,
-	0x29, 0x34, 0x30, 0xae, 0xf9, 0x6a, 0x75, 0xdd, 0xbe, 0x49, 0x60, 0x4b, 0x71, 0xff, 0x17, 0x0b,
-	0x5c, 0xec, 0x19, 0x74, 0xe3, 0x09, 0xba, 0x6f, 0xa1, 0xfb, 0xf7, 0x97, 0x98, 0x98, 0x4a, 0x9a,
-	0xd5, 0x71, 0x86, 0x95, 0xff, 0x10, 0xea, 0xd1, 0x88, 0xf1, 0xb8, 0x8c, 0xd9, 0xff, 0x96, 0x28,
-	0x6a, 0x9d, 0xc0, 0x48, 0xf9, 0x6b, 0xb0, 0x52, 0x6a, 0x7b, 0x4d, 0x58, 0xe9, 0xa7, 0x63, 0xc2,
-	0x59, 0xdc, 0xad, 0x78, 0x2b, 0x60, 0x1f, 0x09, 0xd5, 0xb5, 0xfc, 0xbf, 0x2d, 0x00, 0xd3, 0x12,
-	0xe8, 0xd4, 0xf6, 0x9c, 0x53, 0x1f, 0x2c, 0xb1, 0x3d, 0x13, 0x2d, 0x97, 0xa5, 0x5b, 0x1f, 0x43,
-	0x4d, 0x27, 0xfa, 0x4d, 0x5e, 0xa1, 0x90, 0xe6, 0x80, 0xb9, 0x2c, 0xbb, 0xf7, 0x6a, 0x0e, 0x28,
-	0xe5, 0x6f, 0x83, 0x73, 0x79, 0xd7, 0x22, 0x89, 0x36, 0xc0, 0x0b, 0x31, 0x64, 0x11, 0xe1, 0xbb,
-	0x69, 0xdc, 0xb5, 0xbc, 0x5b, 0xe0, 0x96, 0xfb, 0x63, 0xd9, 0xad, 0xfa, 0xbf, 0xdb, 0x50, 0x43,
-	0x52, 0xcf, 0xc0, 0x55, 0x54, 0x26, 0x21, 0x7d, 0x95, 0xc9, 0x32, 0xdd, 0x77, 0x97, 0xdc, 0x79,
-	0x59, 0x20, 0xfa, 0x91, 0x50, 0x97, 0xc5, 0xf2, 0x05, 0x40, 0xa1, 0xef, 0x36, 0xca, 0x86, 0xde,
-	0x3b, 0xd7, 0x65, 0xab, 0x57, 0x09, 0xdc, 0x62, 0x1a, 0xcf, 0x1d, 0x68, 0x0e, 0xd8, 0x4c, 0xdf,
-	0xbe, 0xb2, 0xd6, 0x66, 0x81, 0xed, 0x55, 0x02, 0x18, 0xcc, 0x32, 0xb2, 0x0f, 0xad, 0xc8, 0x34,
-	0xa2, 0x31, 0x61, 0xc6, 0xc1, 0xbb, 0x4b, 0xcb, 0x75, 0xda, 0xaf, 0xbd, 0x4a, 0xd0, 0x8c, 0xe6,
-	0xda, 0xf7, 0x1b, 0xe8, 0x1a, 0x16, 0x52, 0xcf, 0x3d, 0x63, 0xc8, 0x4c, 0x85, 0xf7, 0xae, 0xe2,
-	0x32, 0x9d, 0x90, 0xbd, 0x4a, 0xd0, 0x2e, 0x16, 0x67, 0xe6, 0x09, 0xdc, 0x2e, 0x59, 0xcd, 0xd9,
-	0x6b, 0xa0, 0x3d, 0xff, 0x4a, 0x6e, 0xf3, 0x06, 0x3b, 0x83, 0x45, 0x68, 0xaf, 0x01, 0x35, 0x6d,
-	0xc4, 0xff, 0xc7, 0x02, 0x38, 0xa3, 0x91, 0x12, 0x72, 0xf7, 0xe8, 0xe8, 0xbb, 0xf2, 0x09, 0x32,
-	0xc2, 0xe6, 0xf9, 0xd7, 0x4f, 0x90, 0xb1, 0xb7, 0xf0, 0x38, 0x56, 0x17, 0x1f, 0xc7, 0xa7, 0x00,
-	0x99, 0xa4, 0x31, 0x8b, 0x88, 0xa2, 0xf9, 0x9b, 0xca, 0x6c, 0x4e, 0xd4, 0xfb, 0x1c, 0xe0, 0x42,
-	0xff, 0x05, 0xcc, 0x68, 0xa8, 0x5d, 0x99, 0xee, 0xe9, 0x87, 0x21, 0x70, 0x2f, 0xa6, 0x7f, 0x87,
-	0x07, 0xd0, 0xc9, 0x38, 0x89, 0xe8, 0x48, 0xf0, 0x98, 0xca, 0x50, 0x91, 0x21, 0x06, 0xd9, 0x0d,
-	0xda, 0x73, 0xf0, 0x29, 0x19, 0xfa, 0x7f, 0x5a, 0xe0, 0x9c, 0x70, 0x92, 0x1e, 0x89, 0x18, 0x87,
-	0xf5, 0x18, 0x19, 0x87, 0x24, 0x4d, 0xf3, 0x6b, 0xc6, 0xd1, 0x2c, 0x2e, 0xba, 0x44, 0x8c, 0xce,
-	0x6e, 0x9a, 0xe6, 0xde, 0x67, 0x0b, 0x6c, 0xaf, 0x6f, 0x41, 0xad, 0x3a, 0xc7, 0x77, 0x03, 0xba,
-	0xa2, 0x50, 0x59, 0xa1, 0xc2, 0xcb, 0x50, 0xea, 0x70, 0xd9, 0x1b, 0x76, 0xd0, 0x36, 0xf8, 0x57,
-	0x26, 0xa2, 0xb9, 0xce, 0x50, 0x2a, 0x62, 0xfa, 0x51, 0x0a, 0x0d, 0x33, 0x58, 0x17, 0x7b, 0xb1,
-	0x03, 0xcd, 0x43, 0x49, 0x89, 0xa2, 0xf2, 0x74, 0x44, 0xd2, 0xae, 0xe5, 0x75, 0xa1, 0x55, 0x02,
-	0xcf, 0x2f, 0x0a, 0xc2, 0xbb, 0x55, 0xaf, 0x05, 0xce, 0x0b, 0x9a, 0xe7, 0x78, 0x6e, 0x63, 0xb3,
-	0xd2, 0x3c, 0x37, 0x87, 0x35, 0xcf, 0x85, 0xba, 0x59, 0xd6, 0xb5, 0xdc, 0x91, 0x50, 0x66, 0xd7,
-	0xd8, 0x7b, 0xfc, 0xc3, 0x27, 0x43, 0xa6, 0x46, 0xc5, 0x60, 0x33, 0x12, 0xc9, 0x96, 0x21, 0xf5,
-	0x90, 0x89, 0x72, 0xb5, 0xc5, 0x52, 0x45, 0x65, 0x4a, 0xf8, 0x16, 0xf2, 0xdc, 0xd2, 0x3c, 0xb3,
-	0xc1, 0xa0, 0x81, 0xbb, 0xc7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x21, 0x9d, 0x5f, 0x11, 0x7b,
-	0x0a, 0x00, 0x00,
+	// 1482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x37,
+	0x16, 0xd7, 0x68, 0x24, 0x79, 0xe6, 0x49, 0x91, 0xe5, 0xd9, 0x45, 0x56, 0xd9, 0x6c, 0x62, 0x67,
+	0x36, 0xd8, 0x75, 0x76, 0x11, 0x7b, 0x37, 0xc9, 0x26, 0xd8, 0x04, 0x6d, 0x63, 0x3b, 0x7f, 0x2c,
+	0x34, 0x75, 0xdc, 0x89, 0xeb, 0x43, 0x2f, 0x03, 0x6a, 0x86, 0x92, 0x88, 0x50, 0x9c, 0x09, 0x87,
+	0xe3, 0x46, 0xe7, 0x9e, 0x7a, 0x2c, 0x50, 0xa0, 0x40, 0xd1, 0x7b, 0xaf, 0x45, 0x3f, 0x46, 0xd1,
+	0x0f, 0xd0, 0x7b, 0xbf, 0x48, 0xc1, 0xc7, 0xd1, 0x3f, 0x57, 0x76, 0x54, 0xc0, 0xe8, 0x8d, 0xef,
+	0xf7, 0xfe, 0xf0, 0xbd, 0x1f, 0xc9, 0x47, 0x12, 0x20, 0xe5, 0x44, 0x6c, 0xa5, 0x32, 0x51, 0x89,
+	0xb7, 0x36, 0x64, 0xfc, 0x24, 0xcf, 0x8c, 0xb4, 0xa5, 0x15, 0x7f, 0x6d, 0x64, 0xd1, 0x80, 0x0e,
+	0x89, 0x81, 0xfc, 0x2f, 0x2d, 0x68, 0x3c, 0xa7, 0x82, 0x4a, 0x16, 0x1d, 0x13, 0x9e, 0x53, 0xef,
+	0x2a, 0x38, 0xdd, 0x24, 0xe1, 0xe1, 0x09, 0xe1, 0x6d, 0x6b, 0xc3, 0xda, 0x74, 0xf6, 0x4b, 0xc1,
+	0x8a, 0x46, 0x8e, 0x09, 0xf7, 0xae, 0x81, 0xcb, 0x84, 0xba, 0x7f, 0x0f, 0xb5, 0xe5, 0x0d, 0x6b,
+	0xd3, 0xde, 0x2f, 0x05, 0x0e, 0x42, 0x85, 0xba, 0xc7, 0x13, 0xa2, 0x50, 0x6d, 0x6f, 0x58, 0x9b,
+	0x96, 0x56, 0x23, 0xa4, 0xd5, 0xeb, 0x00, 0x99, 0x92, 0x4c, 0xf4, 0x51, 0x5f, 0xd9, 0xb0, 0x36,
+	0xdd, 0xfd, 0x52, 0xe0, 0x1a, 0xec, 0x98, 0xf0, 0xdd, 0x2a, 0xd8, 0x27, 0x84, 0xfb, 0x3f, 0x96,
+	0xc1, 0xfd, 0x38, 0xa7, 0x72, 0xd4, 0x11, 0xbd, 0xc4, 0xf3, 0xa0, 0xa2, 0x92, 0xf4, 0x35, 0x26,
+	0x63, 0x07, 0x38, 0xf6, 0xd6, 0xa1, 0x3e, 0xa4, 0x4a, 0xb2, 0x28, 0x54, 0xa3, 0x94, 0xe2, 0x54,
+	0x6e, 0x00, 0x06, 0x3a, 0x1a, 0xa5, 0xd4, 0xfb, 0x3b, 0x5c, 0xca, 0x28, 0x91, 0xd1, 0x20, 0x4c,
+	0x89, 0x24, 0xc3, 0xcc, 0xcc, 0x16, 0x34, 0x0c, 0x78, 0x88, 0x98, 0x36, 0x92, 0x49, 0x2e, 0xe2,
+	0x30, 0xa6, 0x11, 0x1b, 0x12, 0xde, 0xae, 0xe2, 0x14, 0x0d, 0x04, 0x9f, 0x18, 0xcc, 0xbb, 0x0c,
+	0xb5, 0xa4, 0xd7, 0xcb, 0xa8, 0x6a, 0xd7, 0x50, 0x5b, 0x48, 0xde, 0x0d, 0x68, 0x48, 0x22, 0xfa,
+	0x34, 0x34, 0x21, 0xdb, 0x2b, 0x9a, 0xab, 0xa0, 0x8e, 0xd8, 0x2b, 0x84, 0xb4, 0xab, 0x24, 0x31,
+	0xcb, 0xb3, 0xb6, 0xa3, 0xb9, 0x08, 0x0a, 0x69, 0xea, 0xda, 0x63, 0x5c, 0x51, 0xd9, 0x76, 0x51,
+	0x6b, 0x5c, 0x9f, 0x21, 0xe4, 0xdd, 0x82, 0xb5, 0xbe, 0x4c, 0xf2, 0x34, 0xec, 0x8e, 0xc2, 0x1e,
+	0xa3, 0x3c, 0x0e, 0x59, 0xdc, 0x06, 0x4c, 0xa0, 0x89, 0x8a, 0xdd, 0xd1, 0x33, 0x0d, 0x77, 0x62,
+	0xef, 0x1a, 0x80, 0x31, 0x45, 0x96, 0xea, 0x68, 0xe3, 0x22, 0x72, 0x94, 0xa4, 0xaf, 0xfd, 0x2f,
+	0xca, 0x00, 0x7b, 0x09, 0xcf, 0x87, 0x02, 0xd9, 0xbc, 0x02, 0xce, 0x24, 0x9e, 0x61, 0x74, 0xa5,
+	0x57, 0x04, 0x7a, 0x08, 0x6e, 0x4c, 0x14, 0x31, 0x94, 0xea, 0xc5, 0x6d, 0xde, 0xb9, 0xb6, 0x35,
+	0xb7, 0x7f, 0x8a, 0x9d, 0xf3, 0x84, 0x28, 0xa2, 0x59, 0x0e, 0x9c, 0xb8, 0x18, 0x79, 0x37, 0xa1,
+	0xc9, 0xb2, 0x30, 0x95, 0x6c, 0x48, 0xe4, 0x28, 0x7c, 0x4d, 0x47, 0xb8, 0x26, 0x4e, 0xd0, 0x60,
+	0xd9, 0xa1, 0x01, 0x3f, 0xa4, 0x23, 0xef, 0x2a, 0xb8, 0x2c, 0x0b, 0x49, 0xae, 0x92, 0xce, 0x13,
+	0x5c, 0x11, 0x27, 0x70, 0x58, 0xb6, 0x83, 0xb2, 0xf7, 0x18, 0x1a, 0x94, 0xd3, 0x21, 0x15, 0xca,
+	0x64, 0x50, 0x5d, 0x26, 0x83, 0x7a, 0xe1, 0x82, 0x49, 0xac, 0x43, 0x5d, 0xd0, 0x4c, 0xd1, 0x38,
+	0x4c, 0x89, 0x1a, 0xb4, 0x6b, 0x1b, 0xb6, 0xde, 0x15, 0x06, 0x3a, 0x24, 0x6a, 0xe0, 0xff, 0x60,
+	0x41, 0xf3, 0x13, 0x41, 0xe4, 0x28, 0xd0, 0x54, 0x3f, 0x7d, 0x9b, 0x4a, 0xef, 0x7d, 0xa8, 0x47,
+	0xc8, 0x4e, 0xc8, 0x44, 0x2f, 0x41, 0x4a, 0xea, 0xa7, 0x27, 0xc5, 0xf3, 0x34, 0xe5, 0x30, 0x80,
+	0x68, 0xca, 0xe7, 0x2d, 0x28, 0x27, 0x69, 0xc1, 0xd6, 0x95, 0x05, 0x6e, 0x2f, 0x53, 0xcc, 0xb3,
+	0x9c, 0xa4, 0xde, 0xff, 0xa0, 0x7a, 0xa2, 0x8f, 0x18, 0x52, 0x53, 0xbf, 0xb3, 0xbe, 0xc0, 0x7a,
+	0xf6, 0x24, 0x06, 0xc6, 0xda, 0xff, 0xae, 0x0c, 0xab, 0xbb, 0xec, 0x62, 0xb3, 0xfe, 0x27, 0xac,
+	0xf2, 0xe4, 0x33, 0x2a, 0x43, 0x26, 0x22, 0x9e, 0x67, 0xec, 0xc4, 0x2c, 0xb8, 0x13, 0x34, 0x11,
+	0xee, 0x8c, 0x51, 0x6d, 0x98, 0xa7, 0xe9, 0x9c, 0xa1, 0x59, 0xd8, 0x26, 0xc2, 0x53, 0xc3, 0xc7,
+	0x50, 0x37, 0x11, 0x4d, 0x89, 0x95, 0xe5, 0x4a, 0x04, 0xf4, 0xc1, 0xb1, 0x8e, 0x60, 0xa6, 0x32,
+	0x11, 0xaa, 0x4b, 0x46, 0x40, 0x1f, 0x1c, 0xfb, 0x3f, 0x59, 0x50, 0xdf, 0x4b, 0x86, 0x29, 0x91,
+	0x86, 0xa5, 0xe7, 0xd0, 0xe2, 0xb4, 0xa7, 0xc2, 0xdf, 0x4d, 0x55, 0x53, 0xbb, 0x4d, 0x65, 0xaf,
+	0x03, 0x6b, 0x92, 0xf5, 0x07, 0xf3, 0x91, 0xca, 0xcb, 0x44, 0x5a, 0x45, 0xbf, 0xbd, 0xd3, 0xfb,
+	0xc5, 0x5e, 0x62, 0xbf, 0xf8, 0x9f, 0x5b, 0xe0, 0x1c, 0x51, 0x39, 0xbc, 0x90, 0x15, 0x7f, 0x00,
+	0x35, 0xe4, 0x35, 0x6b, 0x97, 0x37, 0xec, 0x65, 0x88, 0x2d, 0xcc, 0xfd, 0xef, 0x2d, 0x70, 0x0e,
+	0x72, 0xce, 0x2f, 0x24, 0x8b, 0x3b, 0x33, 0xa7, 0xc5, 0x5f, 0xe0, 0x36, 0x9e, 0x08, 0x07, 0x2f,
+	0x53, 0xa4, 0xe1, 0x3f, 0x50, 0x33, 0x92, 0x57, 0x87, 0x95, 0x8e, 0x38, 0x21, 0x9c, 0xc5, 0xad,
+	0x92, 0x07, 0x50, 0xeb, 0x64, 0x5a, 0xd1, 0xb2, 0xbc, 0x4b, 0xe0, 0x76, 0xb2, 0x83, 0x44, 0xa1,
+	0x58, 0xf6, 0xbf, 0x2d, 0xc3, 0xda, 0x8e, 0x94, 0x64, 0xb4, 0x97, 0x08, 0x45, 0x98, 0xc8, 0x2e,
+	0x24, 0xf7, 0x0f, 0x66, 0x72, 0xdf, 0x5e, 0xe0, 0xf6, 0x9b, 0x19, 0xb7, 0xc6, 0x82, 0x29, 0xc4,
+	0x7b, 0x04, 0x4e, 0xd1, 0xad, 0xb2, 0xb6, 0xbd, 0xdc, 0x22, 0x4c, 0x1c, 0xfc, 0x0e, 0xc0, 0x34,
+	0xdc, 0x3c, 0x13, 0x0d, 0x70, 0xc6, 0xaa, 0x96, 0xe5, 0xad, 0x42, 0x7d, 0x2c, 0xed, 0x88, 0x51,
+	0xab, 0x3c, 0x07, 0x70, 0xde, 0xb2, 0xfd, 0xaf, 0x2c, 0x58, 0xc5, 0x64, 0x5f, 0x50, 0xd1, 0x57,
+	0x83, 0x3f, 0xba, 0x0d, 0x5e, 0x86, 0x1a, 0xc7, 0x89, 0xf1, 0x14, 0xd8, 0x41, 0x21, 0xe9, 0x97,
+	0x88, 0x8b, 0xcd, 0x19, 0x13, 0xba, 0x87, 0x01, 0x2d, 0x0c, 0x78, 0x73, 0x41, 0xc0, 0x89, 0xa5,
+	0x19, 0x15, 0x14, 0xdf, 0x86, 0x6a, 0x34, 0x60, 0x3c, 0x2e, 0x0e, 0xe7, 0x5f, 0x16, 0x38, 0x6a,
+	0x9f, 0xc0, 0x58, 0xf9, 0xeb, 0xb0, 0x52, 0x78, 0xcf, 0x33, 0xba, 0x02, 0xf6, 0x41, 0xa2, 0x5a,
+	0x96, 0xff, 0xb3, 0x05, 0x60, 0x7a, 0x2f, 0x26, 0x75, 0x7f, 0x26, 0xa9, 0x7f, 0x2c, 0x88, 0x3d,
+	0x35, 0x2d, 0x86, 0x45, 0x5a, 0xff, 0x86, 0x8a, 0xee, 0x28, 0xef, 0xca, 0x0a, 0x8d, 0x74, 0x0d,
+	0xd8, 0x34, 0xda, 0xf6, 0xf9, 0xd6, 0xc6, 0xca, 0xbf, 0x0f, 0xce, 0x2e, 0x5b, 0x54, 0x44, 0x13,
+	0xe0, 0x45, 0xd2, 0x67, 0x11, 0xe1, 0x3b, 0x22, 0x36, 0x87, 0xa4, 0x90, 0x5f, 0xca, 0x56, 0xd9,
+	0xff, 0xba, 0x0a, 0x15, 0x2c, 0xea, 0x21, 0xb8, 0x8a, 0xca, 0x61, 0x48, 0xdf, 0xa6, 0xb2, 0x58,
+	0xf8, 0xab, 0x0b, 0xe6, 0x1c, 0x77, 0x22, 0xfd, 0xa2, 0x53, 0xc5, 0xd8, 0x7b, 0x0f, 0x20, 0xd7,
+	0x73, 0x1b, 0x67, 0x53, 0xde, 0xdf, 0xce, 0x5b, 0x2d, 0xfd, 0xde, 0xcb, 0x27, 0x7c, 0x3e, 0x86,
+	0x7a, 0x97, 0x4d, 0xfd, 0xed, 0x33, 0x77, 0xdd, 0x94, 0xd8, 0xfd, 0x52, 0x00, 0xdd, 0xe9, 0x8a,
+	0xec, 0x41, 0x23, 0x32, 0x1d, 0xdf, 0x84, 0x30, 0xf7, 0xce, 0xf5, 0x85, 0x1b, 0x77, 0x72, 0x31,
+	0xec, 0x97, 0x82, 0x7a, 0x34, 0x15, 0xbd, 0x8f, 0xa0, 0x65, 0xaa, 0x30, 0xaf, 0x32, 0x0c, 0x64,
+	0xae, 0x9f, 0x1b, 0x67, 0xd5, 0x32, 0xb9, 0x8a, 0xf7, 0x4b, 0x41, 0x33, 0x9f, 0x43, 0xbc, 0x43,
+	0x58, 0xeb, 0xb2, 0xd3, 0xf1, 0x6a, 0x18, 0xcf, 0x3f, 0xb3, 0xb6, 0xd9, 0x80, 0xab, 0xdd, 0x79,
+	0x48, 0x2f, 0x91, 0xc8, 0x39, 0x37, 0x91, 0x56, 0xce, 0x5c, 0xa2, 0x71, 0xf7, 0xd4, 0x4b, 0x24,
+	0x8a, 0xb1, 0x77, 0x0c, 0x7f, 0x22, 0xfa, 0xb0, 0x87, 0x51, 0xd1, 0x04, 0x4c, 0x14, 0x07, 0xa3,
+	0xdc, 0x5c, 0xa6, 0x8f, 0xed, 0x97, 0x82, 0x35, 0x72, 0x1a, 0xd4, 0x55, 0x9a, 0xb8, 0xe6, 0xf8,
+	0x9a, 0xa8, 0xee, 0x99, 0x55, 0x9e, 0x6a, 0x38, 0xba, 0x4a, 0x32, 0x0f, 0xed, 0xd6, 0xa0, 0xa2,
+	0x83, 0xf8, 0xbf, 0x58, 0x00, 0xc7, 0x34, 0x52, 0x89, 0xdc, 0x39, 0x38, 0x78, 0x55, 0x3c, 0x1a,
+	0x0d, 0x25, 0x6d, 0x6b, 0xfc, 0x68, 0x34, 0xac, 0xcd, 0x3d, 0x67, 0xcb, 0xf3, 0xcf, 0xd9, 0x07,
+	0x00, 0xa9, 0xa4, 0x31, 0x8b, 0x88, 0xa2, 0xd9, 0xbb, 0x0e, 0xd3, 0x8c, 0xa9, 0xf7, 0x08, 0xe0,
+	0x8d, 0xfe, 0x7d, 0x98, 0x56, 0x58, 0x39, 0x73, 0x53, 0x4f, 0xbe, 0x28, 0x81, 0xfb, 0x66, 0x3c,
+	0xd4, 0x0f, 0xa6, 0x94, 0x93, 0x88, 0x0e, 0x12, 0x1e, 0x53, 0x19, 0x2a, 0xd2, 0xc7, 0xad, 0xe4,
+	0x06, 0xcd, 0x19, 0xf8, 0x88, 0xf4, 0xfd, 0x6f, 0xca, 0xe0, 0x1c, 0x72, 0x22, 0x0e, 0x92, 0x18,
+	0xdf, 0x3e, 0x27, 0x58, 0x71, 0x48, 0x84, 0xc8, 0xce, 0x69, 0xbf, 0x53, 0x5e, 0xf4, 0x41, 0x30,
+	0x3e, 0x3b, 0x42, 0x64, 0xde, 0xff, 0xe7, 0xaa, 0x3d, 0xbf, 0xd1, 0x68, 0xd7, 0x99, 0x7a, 0x37,
+	0xa1, 0x95, 0xe4, 0x2a, 0xcd, 0xd5, 0xe4, 0xa7, 0x61, 0xee, 0x27, 0x3b, 0x68, 0x1a, 0xbc, 0xf8,
+	0x69, 0x64, 0xde, 0x9f, 0xa1, 0xca, 0xd9, 0x90, 0x29, 0x24, 0xc5, 0x0e, 0x8c, 0xa0, 0xff, 0x2a,
+	0x89, 0xd4, 0xc5, 0xce, 0xfe, 0x55, 0xcc, 0x57, 0xaa, 0x89, 0x8a, 0xe9, 0x5f, 0xe5, 0x3a, 0x40,
+	0x4c, 0xb3, 0x88, 0x8a, 0x98, 0x89, 0x3e, 0x9e, 0x09, 0x27, 0x98, 0x41, 0xf4, 0x16, 0x10, 0x49,
+	0x4c, 0xff, 0x25, 0xa0, 0x66, 0x6e, 0x8c, 0xf9, 0x96, 0xb6, 0x0a, 0xf5, 0xe7, 0x92, 0x12, 0x45,
+	0xe5, 0xd1, 0x80, 0x88, 0x96, 0xe5, 0xb5, 0xa0, 0x51, 0x00, 0x4f, 0xdf, 0xe4, 0x84, 0xb7, 0xca,
+	0xfa, 0x32, 0x7c, 0x41, 0xb3, 0x0c, 0xf5, 0x36, 0xf6, 0x3c, 0x9a, 0x65, 0x46, 0x59, 0xf1, 0x5c,
+	0xa8, 0x9a, 0x61, 0x55, 0xdb, 0x1d, 0x24, 0xca, 0x48, 0xb5, 0xdd, 0xbb, 0x9f, 0xfe, 0xb7, 0xcf,
+	0xd4, 0x20, 0xef, 0x6e, 0x45, 0xc9, 0x70, 0xdb, 0xb0, 0x76, 0x9b, 0x25, 0xc5, 0x68, 0x9b, 0x09,
+	0x45, 0xa5, 0x20, 0x7c, 0x1b, 0x89, 0xdc, 0xd6, 0x44, 0xa6, 0xdd, 0x6e, 0x0d, 0xa5, 0xbb, 0xbf,
+	0x0e, 0x00, 0x9d, 0xd7, 0xfe, 0x11, 0x70, 0x0f, 0x00, 0x00,
 }
@@ -26,17 +26,22 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type DataType int32
 
 const (
-	DataType_None         DataType = 0
-	DataType_Bool         DataType = 1
-	DataType_Int8         DataType = 2
-	DataType_Int16        DataType = 3
-	DataType_Int32        DataType = 4
-	DataType_Int64        DataType = 5
-	DataType_Float        DataType = 10
-	DataType_Double       DataType = 11
-	DataType_String       DataType = 20
-	DataType_BinaryVector DataType = 100
-	DataType_FloatVector  DataType = 101
+	DataType_None              DataType = 0
+	DataType_Bool              DataType = 1
+	DataType_Int8              DataType = 2
+	DataType_Int16             DataType = 3
+	DataType_Int32             DataType = 4
+	DataType_Int64             DataType = 5
+	DataType_Float             DataType = 10
+	DataType_Double            DataType = 11
+	DataType_String            DataType = 20
+	DataType_Array             DataType = 22
+	DataType_JSON              DataType = 23
+	DataType_BinaryVector      DataType = 100
+	DataType_FloatVector       DataType = 101
+	DataType_Float16Vector     DataType = 102
+	DataType_BFloat16Vector    DataType = 103
+	DataType_SparseFloatVector DataType = 104
 )
 
 var DataType_name = map[int32]string{
@@ -49,22 +54,32 @@ var DataType_name = map[int32]string{
 	10:  "Float",
 	11:  "Double",
 	20:  "String",
+	22:  "Array",
+	23:  "JSON",
 	100: "BinaryVector",
 	101: "FloatVector",
+	102: "Float16Vector",
+	103: "BFloat16Vector",
+	104: "SparseFloatVector",
 }
 
 var DataType_value = map[string]int32{
-	"None":         0,
-	"Bool":         1,
-	"Int8":         2,
-	"Int16":        3,
-	"Int32":        4,
-	"Int64":        5,
-	"Float":        10,
-	"Double":       11,
-	"String":       20,
-	"BinaryVector": 100,
-	"FloatVector":  101,
+	"None":              0,
+	"Bool":              1,
+	"Int8":              2,
+	"Int16":             3,
+	"Int32":             4,
+	"Int64":             5,
+	"Float":             10,
+	"Double":            11,
+	"String":            20,
+	"Array":             22,
+	"JSON":              23,
+	"BinaryVector":      100,
+	"FloatVector":       101,
+	"Float16Vector":     102,
+	"BFloat16Vector":    103,
+	"SparseFloatVector": 104,
 }
 
 func (x DataType) String() string {
@@ -86,6 +101,10 @@ type FieldSchema struct {
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	AutoID               bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	Nullable             bool                     `protobuf:"varint,9,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	DefaultValue         *ValueField              `protobuf:"bytes,10,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	ElementType          DataType                 `protobuf:"varint,11,opt,name=element_type,json=elementType,proto3,enum=milvus.proto.schema.DataType" json:"element_type,omitempty"`
+	IsPartitionKey       bool                     `protobuf:"varint,12,opt,name=is_partition_key,json=isPartitionKey,proto3" json:"is_partition_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -172,6 +191,34 @@ func (m *FieldSchema) GetAutoID() bool {
 	return false
 }
 
+func (m *FieldSchema) GetNullable() bool {
+	if m != nil {
+		return m.Nullable
+	}
+	return false
+}
+
+func (m *FieldSchema) GetDefaultValue() *ValueField {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+func (m *FieldSchema) GetElementType() DataType {
+	if m != nil {
+		return m.ElementType
+	}
+	return DataType_None
+}
+
+func (m *FieldSchema) GetIsPartitionKey() bool {
+	if m != nil {
+		return m.IsPartitionKey
+	}
+	return false
+}
+
 //*
 // @brief Collection schema
 type CollectionSchema struct {
@@ -179,6 +226,7 @@ type CollectionSchema struct {
 	Description          string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	AutoID               bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
 	Fields               []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	TtlSeconds           int64          `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -237,6 +285,13 @@ func (m *CollectionSchema) GetFields() []*FieldSchema {
 	return nil
 }
 
+func (m *CollectionSchema) GetTtlSeconds() int64 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
+}
+
 type BoolArray struct {
 	Data                 []bool   `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -511,6 +566,94 @@ func (m *StringArray) GetData() []string {
 	return nil
 }
 
+// ArrayArray is the values of an Array field, each row is an array of the element type
+type ArrayArray struct {
+	Data                 []*ScalarField `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	ElementType          DataType       `protobuf:"varint,2,opt,name=element_type,json=elementType,proto3,enum=milvus.proto.schema.DataType" json:"element_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ArrayArray) Reset()         { *m = ArrayArray{} }
+func (m *ArrayArray) String() string { return proto.CompactTextString(m) }
+func (*ArrayArray) ProtoMessage()    {}
+func (*ArrayArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+}
+
+func (m *ArrayArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayArray.Unmarshal(m, b)
+}
+func (m *ArrayArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayArray.Marshal(b, m, deterministic)
+}
+func (m *ArrayArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayArray.Merge(m, src)
+}
+func (m *ArrayArray) XXX_Size() int {
+	return xxx_messageInfo_ArrayArray.Size(m)
+}
+func (m *ArrayArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayArray proto.InternalMessageInfo
+
+func (m *ArrayArray) GetData() []*ScalarField {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ArrayArray) GetElementType() DataType {
+	if m != nil {
+		return m.ElementType
+	}
+	return DataType_None
+}
+
+// JSONArray is the values of a JSON field, each row is a serialized JSON document
+type JSONArray struct {
+	Data                 [][]byte `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JSONArray) Reset()         { *m = JSONArray{} }
+func (m *JSONArray) String() string { return proto.CompactTextString(m) }
+func (*JSONArray) ProtoMessage()    {}
+func (*JSONArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+}
+
+func (m *JSONArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JSONArray.Unmarshal(m, b)
+}
+func (m *JSONArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JSONArray.Marshal(b, m, deterministic)
+}
+func (m *JSONArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONArray.Merge(m, src)
+}
+func (m *JSONArray) XXX_Size() int {
+	return xxx_messageInfo_JSONArray.Size(m)
+}
+func (m *JSONArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONArray proto.InternalMessageInfo
+
+func (m *JSONArray) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type ScalarField struct {
 	// Types that are valid to be assigned to Data:
 	//	*ScalarField_BoolData
@@ -520,6 +663,8 @@ type ScalarField struct {
 	//	*ScalarField_DoubleData
 	//	*ScalarField_StringData
 	//	*ScalarField_BytesData
+	//	*ScalarField_ArrayData
+	//	*ScalarField_JsonData
 	Data                 isScalarField_Data `protobuf_oneof:"data"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -530,7 +675,7 @@ func (m *ScalarField) Reset()         { *m = ScalarField{} }
 func (m *ScalarField) String() string { return proto.CompactTextString(m) }
 func (*ScalarField) ProtoMessage()    {}
 func (*ScalarField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
 }
 
 func (m *ScalarField) XXX_Unmarshal(b []byte) error {
@@ -583,6 +728,14 @@ type ScalarField_BytesData struct {
 	BytesData *BytesArray `protobuf:"bytes,7,opt,name=bytes_data,json=bytesData,proto3,oneof"`
 }
 
+type ScalarField_ArrayData struct {
+	ArrayData *ArrayArray `protobuf:"bytes,8,opt,name=array_data,json=arrayData,proto3,oneof"`
+}
+
+type ScalarField_JsonData struct {
+	JsonData *JSONArray `protobuf:"bytes,9,opt,name=json_data,json=jsonData,proto3,oneof"`
+}
+
 func (*ScalarField_BoolData) isScalarField_Data() {}
 
 func (*ScalarField_IntData) isScalarField_Data() {}
@@ -597,6 +750,10 @@ func (*ScalarField_StringData) isScalarField_Data() {}
 
 func (*ScalarField_BytesData) isScalarField_Data() {}
 
+func (*ScalarField_ArrayData) isScalarField_Data() {}
+
+func (*ScalarField_JsonData) isScalarField_Data() {}
+
 func (m *ScalarField) GetData() isScalarField_Data {
 	if m != nil {
 		return m.Data
@@ -653,6 +810,20 @@ func (m *ScalarField) GetBytesData() *BytesArray {
 	return nil
 }
 
+func (m *ScalarField) GetArrayData() *ArrayArray {
+	if x, ok := m.GetData().(*ScalarField_ArrayData); ok {
+		return x.ArrayData
+	}
+	return nil
+}
+
+func (m *ScalarField) GetJsonData() *JSONArray {
+	if x, ok := m.GetData().(*ScalarField_JsonData); ok {
+		return x.JsonData
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ScalarField) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -663,7 +834,214 @@ func (*ScalarField) XXX_OneofWrappers() []interface{} {
 		(*ScalarField_DoubleData)(nil),
 		(*ScalarField_StringData)(nil),
 		(*ScalarField_BytesData)(nil),
+		(*ScalarField_ArrayData)(nil),
+		(*ScalarField_JsonData)(nil),
+	}
+}
+
+// ValueField is a single scalar value, such as the default value of a field
+type ValueField struct {
+	// Types that are valid to be assigned to Data:
+	//	*ValueField_BoolData
+	//	*ValueField_IntData
+	//	*ValueField_LongData
+	//	*ValueField_FloatData
+	//	*ValueField_DoubleData
+	//	*ValueField_StringData
+	//	*ValueField_BytesData
+	Data                 isValueField_Data `protobuf_oneof:"data"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValueField) Reset()         { *m = ValueField{} }
+func (m *ValueField) String() string { return proto.CompactTextString(m) }
+func (*ValueField) ProtoMessage()    {}
+func (*ValueField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
+}
+
+func (m *ValueField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueField.Unmarshal(m, b)
+}
+func (m *ValueField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueField.Marshal(b, m, deterministic)
+}
+func (m *ValueField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueField.Merge(m, src)
+}
+func (m *ValueField) XXX_Size() int {
+	return xxx_messageInfo_ValueField.Size(m)
+}
+func (m *ValueField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueField proto.InternalMessageInfo
+
+type isValueField_Data interface {
+	isValueField_Data()
+}
+
+type ValueField_BoolData struct {
+	BoolData bool `protobuf:"varint,1,opt,name=bool_data,json=boolData,proto3,oneof"`
+}
+
+type ValueField_IntData struct {
+	IntData int32 `protobuf:"varint,2,opt,name=int_data,json=intData,proto3,oneof"`
+}
+
+type ValueField_LongData struct {
+	LongData int64 `protobuf:"varint,3,opt,name=long_data,json=longData,proto3,oneof"`
+}
+
+type ValueField_FloatData struct {
+	FloatData float32 `protobuf:"fixed32,4,opt,name=float_data,json=floatData,proto3,oneof"`
+}
+
+type ValueField_DoubleData struct {
+	DoubleData float64 `protobuf:"fixed64,5,opt,name=double_data,json=doubleData,proto3,oneof"`
+}
+
+type ValueField_StringData struct {
+	StringData string `protobuf:"bytes,6,opt,name=string_data,json=stringData,proto3,oneof"`
+}
+
+type ValueField_BytesData struct {
+	BytesData []byte `protobuf:"bytes,7,opt,name=bytes_data,json=bytesData,proto3,oneof"`
+}
+
+func (*ValueField_BoolData) isValueField_Data() {}
+
+func (*ValueField_IntData) isValueField_Data() {}
+
+func (*ValueField_LongData) isValueField_Data() {}
+
+func (*ValueField_FloatData) isValueField_Data() {}
+
+func (*ValueField_DoubleData) isValueField_Data() {}
+
+func (*ValueField_StringData) isValueField_Data() {}
+
+func (*ValueField_BytesData) isValueField_Data() {}
+
+func (m *ValueField) GetData() isValueField_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ValueField) GetBoolData() bool {
+	if x, ok := m.GetData().(*ValueField_BoolData); ok {
+		return x.BoolData
+	}
+	return false
+}
+
+func (m *ValueField) GetIntData() int32 {
+	if x, ok := m.GetData().(*ValueField_IntData); ok {
+		return x.IntData
+	}
+	return 0
+}
+
+func (m *ValueField) GetLongData() int64 {
+	if x, ok := m.GetData().(*ValueField_LongData); ok {
+		return x.LongData
 	}
+	return 0
+}
+
+func (m *ValueField) GetFloatData() float32 {
+	if x, ok := m.GetData().(*ValueField_FloatData); ok {
+		return x.FloatData
+	}
+	return 0
+}
+
+func (m *ValueField) GetDoubleData() float64 {
+	if x, ok := m.GetData().(*ValueField_DoubleData); ok {
+		return x.DoubleData
+	}
+	return 0
+}
+
+func (m *ValueField) GetStringData() string {
+	if x, ok := m.GetData().(*ValueField_StringData); ok {
+		return x.StringData
+	}
+	return ""
+}
+
+func (m *ValueField) GetBytesData() []byte {
+	if x, ok := m.GetData().(*ValueField_BytesData); ok {
+		return x.BytesData
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ValueField) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ValueField_BoolData)(nil),
+		(*ValueField_IntData)(nil),
+		(*ValueField_LongData)(nil),
+		(*ValueField_FloatData)(nil),
+		(*ValueField_DoubleData)(nil),
+		(*ValueField_StringData)(nil),
+		(*ValueField_BytesData)(nil),
+	}
+}
+
+// SparseFloatArray is the values of a sparse float vector field, each content is a row
+// encoded as (uint32 index, float32 value) little endian pairs sorted by index
+type SparseFloatArray struct {
+	Contents             [][]byte `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	Dim                  int64    `protobuf:"varint,2,opt,name=dim,proto3" json:"dim,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SparseFloatArray) Reset()         { *m = SparseFloatArray{} }
+func (m *SparseFloatArray) String() string { return proto.CompactTextString(m) }
+func (*SparseFloatArray) ProtoMessage()    {}
+func (*SparseFloatArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
+}
+
+func (m *SparseFloatArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SparseFloatArray.Unmarshal(m, b)
+}
+func (m *SparseFloatArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SparseFloatArray.Marshal(b, m, deterministic)
+}
+func (m *SparseFloatArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SparseFloatArray.Merge(m, src)
+}
+func (m *SparseFloatArray) XXX_Size() int {
+	return xxx_messageInfo_SparseFloatArray.Size(m)
+}
+func (m *SparseFloatArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_SparseFloatArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SparseFloatArray proto.InternalMessageInfo
+
+func (m *SparseFloatArray) GetContents() [][]byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *SparseFloatArray) GetDim() int64 {
+	if m != nil {
+		return m.Dim
+	}
+	return 0
 }
 
 type VectorField struct {
@@ -671,6 +1049,9 @@ type VectorField struct {
 	// Types that are valid to be assigned to Data:
 	//	*VectorField_FloatVector
 	//	*VectorField_BinaryVector
+	//	*VectorField_SparseFloatVector
+	//	*VectorField_Float16Vector
+	//	*VectorField_Bfloat16Vector
 	Data                 isVectorField_Data `protobuf_oneof:"data"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -681,7 +1062,7 @@ func (m *VectorField) Reset()         { *m = VectorField{} }
 func (m *VectorField) String() string { return proto.CompactTextString(m) }
 func (*VectorField) ProtoMessage()    {}
 func (*VectorField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
 }
 
 func (m *VectorField) XXX_Unmarshal(b []byte) error {
@@ -721,10 +1102,28 @@ type VectorField_BinaryVector struct {
 	BinaryVector []byte `protobuf:"bytes,3,opt,name=binary_vector,json=binaryVector,proto3,oneof"`
 }
 
+type VectorField_SparseFloatVector struct {
+	SparseFloatVector *SparseFloatArray `protobuf:"bytes,4,opt,name=sparse_float_vector,json=sparseFloatVector,proto3,oneof"`
+}
+
+type VectorField_Float16Vector struct {
+	Float16Vector []byte `protobuf:"bytes,5,opt,name=float16_vector,json=float16Vector,proto3,oneof"`
+}
+
+type VectorField_Bfloat16Vector struct {
+	Bfloat16Vector []byte `protobuf:"bytes,6,opt,name=bfloat16_vector,json=bfloat16Vector,proto3,oneof"`
+}
+
 func (*VectorField_FloatVector) isVectorField_Data() {}
 
 func (*VectorField_BinaryVector) isVectorField_Data() {}
 
+func (*VectorField_SparseFloatVector) isVectorField_Data() {}
+
+func (*VectorField_Float16Vector) isVectorField_Data() {}
+
+func (*VectorField_Bfloat16Vector) isVectorField_Data() {}
+
 func (m *VectorField) GetData() isVectorField_Data {
 	if m != nil {
 		return m.Data
@@ -746,11 +1145,35 @@ func (m *VectorField) GetBinaryVector() []byte {
 	return nil
 }
 
+func (m *VectorField) GetSparseFloatVector() *SparseFloatArray {
+	if x, ok := m.GetData().(*VectorField_SparseFloatVector); ok {
+		return x.SparseFloatVector
+	}
+	return nil
+}
+
+func (m *VectorField) GetFloat16Vector() []byte {
+	if x, ok := m.GetData().(*VectorField_Float16Vector); ok {
+		return x.Float16Vector
+	}
+	return nil
+}
+
+func (m *VectorField) GetBfloat16Vector() []byte {
+	if x, ok := m.GetData().(*VectorField_Bfloat16Vector); ok {
+		return x.Bfloat16Vector
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*VectorField) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*VectorField_FloatVector)(nil),
 		(*VectorField_BinaryVector)(nil),
+		(*VectorField_SparseFloatVector)(nil),
+		(*VectorField_Float16Vector)(nil),
+		(*VectorField_Bfloat16Vector)(nil),
 	}
 }
 
@@ -762,6 +1185,7 @@ type FieldData struct {
 	//	*FieldData_Vectors
 	Field                isFieldData_Field `protobuf_oneof:"field"`
 	FieldId              int64             `protobuf:"varint,5,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	ValidData            []bool            `protobuf:"varint,6,rep,packed,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -771,7 +1195,7 @@ func (m *FieldData) Reset()         { *m = FieldData{} }
 func (m *FieldData) String() string { return proto.CompactTextString(m) }
 func (*FieldData) ProtoMessage()    {}
 func (*FieldData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{15}
 }
 
 func (m *FieldData) XXX_Unmarshal(b []byte) error {
@@ -850,6 +1274,13 @@ func (m *FieldData) GetFieldId() int64 {
 	return 0
 }
 
+func (m *FieldData) GetValidData() []bool {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*FieldData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -872,7 +1303,7 @@ func (m *IDs) Reset()         { *m = IDs{} }
 func (m *IDs) String() string { return proto.CompactTextString(m) }
 func (*IDs) ProtoMessage()    {}
 func (*IDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{16}
 }
 
 func (m *IDs) XXX_Unmarshal(b []byte) error {
@@ -954,7 +1385,7 @@ func (m *SearchResultData) Reset()         { *m = SearchResultData{} }
 func (m *SearchResultData) String() string { return proto.CompactTextString(m) }
 func (*SearchResultData) ProtoMessage()    {}
 func (*SearchResultData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{17}
 }
 
 func (m *SearchResultData) XXX_Unmarshal(b []byte) error {
@@ -1028,7 +1459,11 @@ func init() {
 	proto.RegisterType((*DoubleArray)(nil), "milvus.proto.schema.DoubleArray")
 	proto.RegisterType((*BytesArray)(nil), "milvus.proto.schema.BytesArray")
 	proto.RegisterType((*StringArray)(nil), "milvus.proto.schema.StringArray")
+	proto.RegisterType((*ArrayArray)(nil), "milvus.proto.schema.ArrayArray")
+	proto.RegisterType((*JSONArray)(nil), "milvus.proto.schema.JSONArray")
 	proto.RegisterType((*ScalarField)(nil), "milvus.proto.schema.ScalarField")
+	proto.RegisterType((*ValueField)(nil), "milvus.proto.schema.ValueField")
+	proto.RegisterType((*SparseFloatArray)(nil), "milvus.proto.schema.SparseFloatArray")
 	proto.RegisterType((*VectorField)(nil), "milvus.proto.schema.VectorField")
 	proto.RegisterType((*FieldData)(nil), "milvus.proto.schema.FieldData")
 	proto.RegisterType((*IDs)(nil), "milvus.proto.schema.IDs")
@@ -1038,66 +1473,87 @@ func init() {
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0xe3, 0xfc, 0xd8, 0xc7, 0xa1, 0x58, 0xd3, 0x0a, 0x19, 0xa4, 0x76, 0xd3, 0x08, 0xa4,
-	0xa8, 0x12, 0xbb, 0xea, 0x2e, 0x94, 0x52, 0x51, 0x01, 0x69, 0xb4, 0x4a, 0xb4, 0xa8, 0x5a, 0xbc,
-	0xa8, 0x17, 0xdc, 0x44, 0x93, 0x78, 0xba, 0x3b, 0x5a, 0x7b, 0x26, 0x78, 0x26, 0x15, 0x79, 0x00,
-	0xae, 0xb9, 0xe1, 0x8a, 0x77, 0xe3, 0x02, 0xf1, 0x1c, 0x48, 0x68, 0xce, 0x4c, 0x12, 0xd3, 0x78,
-	0x57, 0x7b, 0x77, 0x66, 0x7c, 0xbe, 0x6f, 0xce, 0xf9, 0xce, 0x8f, 0xa1, 0xa7, 0x16, 0x57, 0xac,
-	0xa0, 0x87, 0xcb, 0x52, 0x6a, 0x49, 0xee, 0x17, 0x3c, 0x7f, 0xb7, 0x52, 0xf6, 0x74, 0x68, 0x3f,
-	0x7d, 0xd2, 0x5b, 0xc8, 0xa2, 0x90, 0xc2, 0x5e, 0x0e, 0xfe, 0x6e, 0x42, 0x74, 0xca, 0x59, 0x9e,
-	0x5d, 0xe0, 0x57, 0x92, 0x40, 0xf7, 0xad, 0x39, 0x4e, 0xc7, 0x89, 0xd7, 0xf7, 0x86, 0x7e, 0xba,
-	0x39, 0x12, 0x02, 0x2d, 0x41, 0x0b, 0x96, 0x34, 0xfb, 0xde, 0x30, 0x4c, 0xd1, 0x26, 0x9f, 0xc2,
-	0x3d, 0xae, 0x66, 0xcb, 0x92, 0x17, 0xb4, 0x5c, 0xcf, 0xae, 0xd9, 0x3a, 0xf1, 0xfb, 0xde, 0x30,
-	0x48, 0x7b, 0x5c, 0x9d, 0xdb, 0xcb, 0x33, 0xb6, 0x26, 0x7d, 0x88, 0x32, 0xa6, 0x16, 0x25, 0x5f,
-	0x6a, 0x2e, 0x45, 0xd2, 0x42, 0x82, 0xea, 0x15, 0x79, 0x01, 0x61, 0x46, 0x35, 0x9d, 0xe9, 0xf5,
-	0x92, 0x25, 0xed, 0xbe, 0x37, 0xbc, 0x77, 0xfc, 0xf0, 0xb0, 0x26, 0xf8, 0xc3, 0x31, 0xd5, 0xf4,
-	0xa7, 0xf5, 0x92, 0xa5, 0x41, 0xe6, 0x2c, 0x32, 0x82, 0xc8, 0xc0, 0x66, 0x4b, 0x5a, 0xd2, 0x42,
-	0x25, 0x9d, 0xbe, 0x3f, 0x8c, 0x8e, 0x1f, 0xff, 0x1f, 0xed, 0x52, 0x3e, 0x63, 0xeb, 0x37, 0x34,
-	0x5f, 0xb1, 0x73, 0xca, 0xcb, 0x14, 0x0c, 0xea, 0x1c, 0x41, 0x64, 0x0c, 0x3d, 0x2e, 0x32, 0xf6,
-	0xeb, 0x86, 0xa4, 0x7b, 0x57, 0x92, 0x08, 0x61, 0x8e, 0xe5, 0x23, 0xe8, 0xd0, 0x95, 0x96, 0xd3,
-	0x71, 0x12, 0xa0, 0x0a, 0xee, 0x34, 0xf8, 0xd3, 0x83, 0xf8, 0x95, 0xcc, 0x73, 0xb6, 0x30, 0xc9,
-	0x3a, 0xa1, 0x37, 0x72, 0x7a, 0x15, 0x39, 0xdf, 0x13, 0xaa, 0xb9, 0x2f, 0xd4, 0xee, 0x09, 0xbf,
-	0xfa, 0x04, 0x79, 0x0e, 0x1d, 0xac, 0x93, 0x4a, 0x5a, 0x18, 0x7a, 0xbf, 0x56, 0xbd, 0x4a, 0xa1,
-	0x53, 0xe7, 0x3f, 0x38, 0x80, 0x70, 0x24, 0x65, 0xfe, 0x7d, 0x59, 0xd2, 0xb5, 0x09, 0xca, 0xe8,
-	0x9a, 0x78, 0x7d, 0x7f, 0x18, 0xa4, 0x68, 0x0f, 0x1e, 0x41, 0x30, 0x15, 0x7a, 0xff, 0x7b, 0xdb,
-	0x7d, 0x3f, 0x80, 0xf0, 0x07, 0x29, 0x2e, 0xf7, 0x1d, 0x7c, 0xe7, 0xd0, 0x07, 0x38, 0xcd, 0x25,
-	0xad, 0xa1, 0x68, 0x3a, 0x8f, 0xc7, 0x10, 0x8d, 0xe5, 0x6a, 0x9e, 0xb3, 0x7d, 0x17, 0x6f, 0x47,
-	0x32, 0x5a, 0x6b, 0xa6, 0xf6, 0x3d, 0x7a, 0x3b, 0x92, 0x0b, 0x5d, 0xf2, 0xba, 0x48, 0x42, 0xe7,
-	0xf2, 0x97, 0x0f, 0xd1, 0xc5, 0x82, 0xe6, 0xb4, 0x44, 0x25, 0xc8, 0x4b, 0x08, 0xe7, 0x52, 0xe6,
-	0x33, 0xe7, 0xe8, 0x0d, 0xa3, 0xe3, 0x47, 0xb5, 0xc2, 0x6d, 0x15, 0x9a, 0x34, 0xd2, 0xc0, 0x40,
-	0x4c, 0x1f, 0x92, 0x17, 0x10, 0x70, 0xa1, 0x2d, 0xba, 0x89, 0xe8, 0xfa, 0xa6, 0xdd, 0xc8, 0x37,
-	0x69, 0xa4, 0x5d, 0x2e, 0x34, 0x62, 0x5f, 0x42, 0x98, 0x4b, 0x71, 0x69, 0xc1, 0xfe, 0x2d, 0x4f,
-	0x6f, 0xb5, 0x35, 0x4f, 0x1b, 0x08, 0xc2, 0xbf, 0x03, 0x78, 0x6b, 0x34, 0xb5, 0xf8, 0x16, 0xe2,
-	0x0f, 0xea, 0x6b, 0xbe, 0x95, 0x7e, 0xd2, 0x48, 0x43, 0x04, 0x21, 0xc3, 0x2b, 0x88, 0x32, 0xd4,
-	0xdc, 0x52, 0xb4, 0x91, 0xa2, 0xbe, 0x6d, 0x2a, 0xb5, 0x99, 0x34, 0x52, 0xb0, 0xb0, 0x0d, 0x89,
-	0x42, 0xcd, 0x2d, 0x49, 0xe7, 0x16, 0x92, 0x4a, 0x6d, 0x0c, 0x89, 0x85, 0x6d, 0x72, 0x99, 0x9b,
-	0xd2, 0x5a, 0x8e, 0xee, 0x2d, 0xb9, 0xec, 0x3a, 0xc0, 0xe4, 0x82, 0x20, 0xc3, 0x30, 0xea, 0xd8,
-	0x5a, 0x0f, 0xfe, 0xf0, 0x20, 0x7a, 0xc3, 0x16, 0x5a, 0xba, 0xfa, 0xc6, 0xe0, 0x67, 0xbc, 0x70,
-	0x8b, 0xcc, 0x98, 0x66, 0xd0, 0xad, 0x6e, 0xef, 0xd0, 0xcd, 0x95, 0xed, 0x0e, 0xca, 0x45, 0x08,
-	0xb3, 0xe4, 0xe4, 0x33, 0xf8, 0x60, 0xce, 0x85, 0x59, 0x79, 0x8e, 0xc6, 0x14, 0xb0, 0x37, 0x69,
-	0xa4, 0x3d, 0x7b, 0x6d, 0xdd, 0xb6, 0x61, 0xfd, 0xeb, 0x41, 0x88, 0x01, 0x61, 0xba, 0x4f, 0xa1,
-	0x85, 0x6b, 0xce, 0xbb, 0xcb, 0x9a, 0x43, 0x57, 0xf2, 0x10, 0x00, 0xa7, 0x75, 0x56, 0x59, 0xc0,
-	0x21, 0xde, 0xbc, 0x36, 0x6b, 0xe3, 0x1b, 0xe8, 0x2a, 0xec, 0x6a, 0xe5, 0x3a, 0xe9, 0x86, 0x0a,
-	0xec, 0x3a, 0xdf, 0x74, 0xa2, 0x83, 0x18, 0xb4, 0xcd, 0x42, 0xb9, 0x3e, 0xaa, 0x47, 0x57, 0x74,
-	0x35, 0x68, 0x07, 0x21, 0x1f, 0x43, 0x60, 0x43, 0xe3, 0x19, 0xf6, 0xd0, 0xf6, 0x87, 0x91, 0x8d,
-	0xba, 0xd0, 0x46, 0x73, 0xf0, 0x9b, 0x07, 0xfe, 0x74, 0xac, 0xc8, 0x57, 0xd0, 0x31, 0xf3, 0xc2,
-	0xb3, 0x5b, 0x67, 0xad, 0xda, 0xf0, 0x6d, 0x2e, 0xf4, 0x34, 0x23, 0x5f, 0x43, 0x47, 0xe9, 0xd2,
-	0x00, 0x9b, 0x77, 0xee, 0xb0, 0xb6, 0xd2, 0xe5, 0x34, 0x1b, 0x01, 0x04, 0x3c, 0x9b, 0xd9, 0x38,
-	0xfe, 0xf1, 0x20, 0xbe, 0x60, 0xb4, 0x5c, 0x5c, 0xa5, 0x4c, 0xad, 0x72, 0x3b, 0x07, 0x07, 0x10,
-	0x89, 0x55, 0x31, 0xfb, 0x65, 0xc5, 0x4a, 0xce, 0x94, 0xeb, 0x15, 0x10, 0xab, 0xe2, 0x47, 0x7b,
-	0x43, 0xee, 0x43, 0x5b, 0xcb, 0xe5, 0xec, 0x1a, 0xdf, 0xf6, 0xd3, 0x96, 0x96, 0xcb, 0x33, 0xf2,
-	0x2d, 0x44, 0x76, 0x7f, 0x6e, 0x06, 0xd8, 0xbf, 0x31, 0x9f, 0x6d, 0xe5, 0x53, 0x5b, 0x44, 0x6c,
-	0x59, 0xb3, 0xc8, 0xd5, 0x42, 0x96, 0xcc, 0x2e, 0xec, 0x66, 0xea, 0x4e, 0xe4, 0x09, 0xf8, 0x3c,
-	0x53, 0x6e, 0x1c, 0x93, 0xfa, 0x75, 0x32, 0x56, 0xa9, 0x71, 0x22, 0x0f, 0x30, 0xb2, 0x6b, 0xfb,
-	0xcf, 0xf3, 0x53, 0x7b, 0x78, 0xf2, 0xbb, 0x07, 0xc1, 0xa6, 0x7f, 0x48, 0x00, 0xad, 0xd7, 0x52,
-	0xb0, 0xb8, 0x61, 0x2c, 0xb3, 0xc5, 0x62, 0xcf, 0x58, 0x53, 0xa1, 0x9f, 0xc7, 0x4d, 0x12, 0x42,
-	0x7b, 0x2a, 0xf4, 0xd3, 0x67, 0xb1, 0xef, 0xcc, 0x93, 0xe3, 0xb8, 0xe5, 0xcc, 0x67, 0x5f, 0xc4,
-	0x6d, 0x63, 0xe2, 0x14, 0xc4, 0x40, 0x00, 0x3a, 0x76, 0x0f, 0xc4, 0x91, 0xb1, 0xad, 0xd8, 0xf1,
-	0x03, 0x12, 0x43, 0x6f, 0x54, 0x69, 0xfa, 0x38, 0x23, 0x1f, 0x42, 0x74, 0xba, 0x1b, 0x96, 0x98,
-	0x8d, 0xbe, 0xfc, 0xf9, 0xe4, 0x92, 0xeb, 0xab, 0xd5, 0xdc, 0xfc, 0x42, 0x8f, 0x6c, 0x4a, 0x9f,
-	0x73, 0xe9, 0xac, 0x23, 0x2e, 0x34, 0x2b, 0x05, 0xcd, 0x8f, 0x30, 0xcb, 0x23, 0x9b, 0xe5, 0x72,
-	0x3e, 0xef, 0xe0, 0xf9, 0xe4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x3c, 0xbb, 0x94, 0xd4,
-	0x08, 0x00, 0x00,
+	// 1308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0x7a, 0x6d, 0x67, 0xf7, 0xac, 0x93, 0x6e, 0xa7, 0xa5, 0x2c, 0x45, 0xa9, 0x1d, 0x8b,
+	0x0a, 0x53, 0x89, 0x44, 0x4d, 0x4b, 0x29, 0x15, 0x15, 0xc5, 0xb5, 0xaa, 0x98, 0xa0, 0x12, 0xd6,
+	0xa8, 0x48, 0xdc, 0x58, 0x63, 0xef, 0x24, 0x19, 0xba, 0x9e, 0x31, 0x3b, 0xe3, 0x08, 0xdf, 0xc3,
+	0x5b, 0xf0, 0x0e, 0x3c, 0x00, 0x57, 0xbc, 0x08, 0x17, 0xf0, 0x10, 0xdc, 0xa2, 0xf9, 0x59, 0x7b,
+	0xfd, 0x13, 0x2b, 0xdc, 0xcd, 0x9c, 0x39, 0xdf, 0x37, 0x73, 0xce, 0xf9, 0xce, 0xcc, 0x40, 0x4d,
+	0x0c, 0x2f, 0xc8, 0x08, 0x1f, 0x8c, 0x33, 0x2e, 0x39, 0xba, 0x35, 0xa2, 0xe9, 0xe5, 0x44, 0x98,
+	0xd9, 0x81, 0x59, 0xba, 0x5b, 0x1b, 0xf2, 0xd1, 0x88, 0x33, 0x63, 0x6c, 0xfe, 0x5e, 0x86, 0xe0,
+	0x15, 0x25, 0x69, 0xd2, 0xd3, 0xab, 0x28, 0x82, 0xed, 0x33, 0x35, 0xed, 0x76, 0x22, 0xa7, 0xe1,
+	0xb4, 0xdc, 0x38, 0x9f, 0x22, 0x04, 0x65, 0x86, 0x47, 0x24, 0x2a, 0x35, 0x9c, 0x96, 0x1f, 0xeb,
+	0x31, 0xfa, 0x00, 0x76, 0xa9, 0xe8, 0x8f, 0x33, 0x3a, 0xc2, 0xd9, 0xb4, 0xff, 0x96, 0x4c, 0x23,
+	0xb7, 0xe1, 0xb4, 0xbc, 0xb8, 0x46, 0xc5, 0xa9, 0x31, 0x9e, 0x90, 0x29, 0x6a, 0x40, 0x90, 0x10,
+	0x31, 0xcc, 0xe8, 0x58, 0x52, 0xce, 0xa2, 0xb2, 0x26, 0x28, 0x9a, 0xd0, 0x33, 0xf0, 0x13, 0x2c,
+	0x71, 0x5f, 0x4e, 0xc7, 0x24, 0xaa, 0x34, 0x9c, 0xd6, 0xee, 0xd1, 0xde, 0xc1, 0x9a, 0xc3, 0x1f,
+	0x74, 0xb0, 0xc4, 0xdf, 0x4d, 0xc7, 0x24, 0xf6, 0x12, 0x3b, 0x42, 0x6d, 0x08, 0x14, 0xac, 0x3f,
+	0xc6, 0x19, 0x1e, 0x89, 0xa8, 0xda, 0x70, 0x5b, 0xc1, 0xd1, 0xfe, 0x22, 0xda, 0x86, 0x7c, 0x42,
+	0xa6, 0x6f, 0x70, 0x3a, 0x21, 0xa7, 0x98, 0x66, 0x31, 0x28, 0xd4, 0xa9, 0x06, 0xa1, 0x0e, 0xd4,
+	0x28, 0x4b, 0xc8, 0xcf, 0x39, 0xc9, 0xf6, 0x75, 0x49, 0x02, 0x0d, 0xb3, 0x2c, 0x77, 0xa0, 0x8a,
+	0x27, 0x92, 0x77, 0x3b, 0x91, 0xa7, 0xb3, 0x60, 0x67, 0xe8, 0x2e, 0x78, 0x6c, 0x92, 0xa6, 0x78,
+	0x90, 0x92, 0xc8, 0xd7, 0x2b, 0xb3, 0x39, 0xea, 0xc0, 0x4e, 0x42, 0xce, 0xf0, 0x24, 0x95, 0xfd,
+	0x4b, 0xc5, 0x1a, 0x41, 0xc3, 0x69, 0x05, 0x47, 0xf5, 0xb5, 0xd1, 0xeb, 0x7d, 0x75, 0xb5, 0xe2,
+	0x9a, 0x45, 0x69, 0x13, 0x7a, 0x01, 0x35, 0x92, 0x92, 0x11, 0x61, 0xd2, 0xa4, 0x30, 0xb8, 0x4e,
+	0x0a, 0x03, 0x0b, 0x51, 0x13, 0xd4, 0x82, 0x50, 0x55, 0x12, 0x67, 0x92, 0xaa, 0x8a, 0xe8, 0x5a,
+	0xd6, 0xf4, 0x59, 0x77, 0xa9, 0x38, 0xcd, 0xcd, 0x27, 0x64, 0xda, 0xfc, 0xc3, 0x81, 0xf0, 0x25,
+	0x4f, 0x53, 0x32, 0x54, 0x16, 0x2b, 0x9b, 0x5c, 0x1c, 0x4e, 0x41, 0x1c, 0x4b, 0x65, 0x2f, 0xad,
+	0x96, 0x7d, 0x9e, 0x30, 0x77, 0x21, 0x61, 0x4f, 0xa1, 0xaa, 0x55, 0x27, 0xa2, 0xb2, 0x2e, 0x44,
+	0x63, 0x6d, 0x20, 0x05, 0xd9, 0xc6, 0xd6, 0x1f, 0xd5, 0x21, 0x90, 0x32, 0xed, 0x0b, 0x32, 0xe4,
+	0x2c, 0x11, 0x5a, 0x4a, 0x6e, 0x0c, 0x52, 0xa6, 0x3d, 0x63, 0x69, 0xd6, 0xc1, 0x6f, 0x73, 0x9e,
+	0x7e, 0x99, 0x65, 0x78, 0xaa, 0x4e, 0xad, 0x64, 0x14, 0x39, 0x0d, 0xb7, 0xe5, 0xc5, 0x7a, 0xdc,
+	0xbc, 0x07, 0x5e, 0x97, 0xc9, 0xd5, 0xf5, 0x8a, 0x5d, 0xaf, 0x83, 0xff, 0x35, 0x67, 0xe7, 0xab,
+	0x0e, 0xae, 0x75, 0x68, 0x00, 0xbc, 0x4a, 0x39, 0x5e, 0x43, 0x51, 0xb2, 0x1e, 0xfb, 0x10, 0x74,
+	0xf8, 0x64, 0x90, 0x92, 0x55, 0x17, 0x67, 0x4e, 0xd2, 0x9e, 0x4a, 0x22, 0x56, 0x3d, 0x6a, 0x73,
+	0x92, 0x9e, 0xcc, 0xe8, 0xba, 0x93, 0xf8, 0xd6, 0xe5, 0x17, 0x07, 0x40, 0xaf, 0x1a, 0x97, 0xc7,
+	0x05, 0x97, 0xab, 0x72, 0xda, 0x1b, 0xe2, 0x14, 0x67, 0x46, 0x62, 0xda, 0x7b, 0x45, 0x5a, 0xa5,
+	0xff, 0x2b, 0x2d, 0x95, 0xb1, 0xaf, 0x7a, 0xdf, 0xbc, 0xbe, 0x3a, 0x94, 0xbf, 0xca, 0x10, 0x14,
+	0x36, 0x46, 0xcf, 0xc1, 0x1f, 0x70, 0x9e, 0xf6, 0xad, 0xa3, 0xea, 0x87, 0x7b, 0x6b, 0xf7, 0x9b,
+	0x55, 0xf2, 0x78, 0x2b, 0xf6, 0x14, 0x44, 0x1d, 0x00, 0x3d, 0x03, 0x8f, 0x32, 0x69, 0xd0, 0x25,
+	0x8d, 0x5e, 0x7f, 0xda, 0xbc, 0xcc, 0xc7, 0x5b, 0xf1, 0x36, 0x65, 0x52, 0x63, 0x9f, 0x83, 0x9f,
+	0x72, 0x76, 0x6e, 0xc0, 0xee, 0x86, 0xad, 0x67, 0x1a, 0x50, 0x5b, 0x2b, 0x48, 0xc7, 0x24, 0x0b,
+	0xce, 0x54, 0xed, 0x0d, 0xbe, 0xbc, 0xa1, 0x95, 0xe7, 0x12, 0x39, 0xde, 0x8a, 0x7d, 0x0d, 0xd2,
+	0x0c, 0x2f, 0x21, 0x48, 0xb4, 0x36, 0x0c, 0x45, 0xa5, 0xe1, 0x5c, 0x59, 0xab, 0x82, 0x86, 0x8e,
+	0xb7, 0x62, 0x30, 0xb0, 0x9c, 0x44, 0x68, 0x6d, 0x18, 0x92, 0xea, 0x06, 0x92, 0x82, 0x86, 0x14,
+	0x89, 0x81, 0xe5, 0xb1, 0x0c, 0x94, 0x04, 0x0d, 0xc7, 0xf6, 0x86, 0x58, 0xe6, 0x4a, 0x55, 0xb1,
+	0x68, 0x50, 0xce, 0x80, 0x95, 0xd5, 0x30, 0x78, 0x1b, 0x18, 0xe6, 0x2a, 0x55, 0x0c, 0x1a, 0x94,
+	0x97, 0xe3, 0x47, 0xc1, 0x99, 0x21, 0xf0, 0x37, 0x94, 0x63, 0x26, 0x30, 0x55, 0x0e, 0x05, 0x51,
+	0xf0, 0x76, 0xd5, 0x88, 0xad, 0xf9, 0xaf, 0x03, 0x30, 0xbf, 0x3b, 0xd1, 0xde, 0xb2, 0xbe, 0xbc,
+	0x05, 0xfd, 0xbc, 0xbf, 0xa4, 0x9f, 0x4a, 0x51, 0x20, 0x7b, 0xcb, 0x02, 0x71, 0x17, 0x04, 0x50,
+	0x5f, 0x11, 0x40, 0x69, 0xb1, 0xbe, 0xfb, 0xab, 0xf5, 0x75, 0x96, 0xaa, 0xb7, 0xbf, 0x5a, 0x3d,
+	0x7f, 0xa9, 0x36, 0xf5, 0x95, 0xda, 0xd4, 0x16, 0x52, 0x3f, 0x8b, 0xfc, 0x05, 0x84, 0xbd, 0x31,
+	0xce, 0x04, 0x29, 0x5c, 0x49, 0x77, 0xc1, 0x1b, 0x72, 0x26, 0x09, 0x93, 0xc2, 0xb6, 0xe1, 0x6c,
+	0x8e, 0x42, 0x70, 0x13, 0x3a, 0xd2, 0x61, 0xbb, 0xb1, 0x1a, 0x36, 0xff, 0x2c, 0x41, 0xf0, 0x86,
+	0x0c, 0x25, 0xb7, 0xcd, 0x69, 0x3d, 0x9c, 0x99, 0x87, 0x7a, 0x3c, 0x4d, 0xcc, 0x97, 0xda, 0x2d,
+	0x2a, 0x6d, 0x28, 0xf4, 0x82, 0xec, 0x03, 0x0d, 0x33, 0xe4, 0xe8, 0x3e, 0xec, 0x0c, 0x28, 0x53,
+	0xdf, 0x08, 0x4b, 0xe3, 0xda, 0xa8, 0x6a, 0xc6, 0x6c, 0xdd, 0xbe, 0x87, 0x5b, 0x42, 0x07, 0xd4,
+	0x5f, 0xd8, 0xd3, 0xb4, 0xda, 0xfd, 0xf5, 0x12, 0x5f, 0x4a, 0xc0, 0xf1, 0x56, 0x7c, 0x53, 0xcc,
+	0x6d, 0x96, 0xf8, 0x43, 0xd8, 0xd5, 0x8c, 0x0f, 0x9f, 0xe4, 0x9c, 0x15, 0x7b, 0x80, 0x1d, 0x6b,
+	0xb7, 0x8e, 0x1f, 0xc1, 0x8d, 0xc1, 0x92, 0x67, 0xd5, 0x7a, 0xee, 0x0e, 0x16, 0x5c, 0x67, 0x55,
+	0xf8, 0xad, 0x04, 0xbe, 0xce, 0x9e, 0x2e, 0xde, 0x43, 0x28, 0xeb, 0x9b, 0xd4, 0xb9, 0xce, 0x4d,
+	0xaa, 0x5d, 0xd1, 0x1e, 0x80, 0x7e, 0xe0, 0xfa, 0x85, 0x1f, 0x98, 0xaf, 0x2d, 0xaf, 0xd5, 0x4b,
+	0xfb, 0x39, 0x6c, 0x0b, 0x7d, 0x7f, 0x8a, 0xc8, 0xdd, 0xd4, 0xeb, 0xf3, 0x3b, 0x56, 0x49, 0xda,
+	0x42, 0x14, 0xda, 0xc4, 0x21, 0xa2, 0xf2, 0x06, 0x74, 0x41, 0x04, 0x0a, 0x6d, 0x21, 0xe8, 0x3d,
+	0xf0, 0xcc, 0xd1, 0x68, 0x12, 0x55, 0x8a, 0x3f, 0x46, 0xd5, 0x67, 0x70, 0x89, 0x53, 0x9a, 0xe4,
+	0x3a, 0x56, 0x8f, 0xac, 0xaf, 0x2d, 0x5a, 0xa3, 0xdb, 0x50, 0xd1, 0x9e, 0xcd, 0x5f, 0x1d, 0x70,
+	0xbb, 0x1d, 0x81, 0x3e, 0x85, 0xaa, 0x6a, 0x3c, 0x9a, 0x44, 0xce, 0x35, 0x6f, 0xde, 0x0a, 0x65,
+	0xb2, 0x9b, 0xa0, 0xcf, 0xa0, 0x2a, 0x64, 0xa6, 0x80, 0xa5, 0x6b, 0x5f, 0x75, 0x15, 0x21, 0xb3,
+	0x6e, 0xd2, 0x06, 0xf0, 0x68, 0xd2, 0x37, 0xe7, 0xf8, 0xc7, 0x81, 0xb0, 0x47, 0x70, 0x36, 0xbc,
+	0x88, 0x89, 0x98, 0xa4, 0xd2, 0xb6, 0x5a, 0xc0, 0x26, 0xa3, 0xfe, 0x4f, 0x13, 0x92, 0x51, 0x22,
+	0xac, 0xee, 0x81, 0x4d, 0x46, 0xdf, 0x1a, 0x0b, 0xba, 0x05, 0x15, 0xc9, 0xc7, 0xfd, 0xb7, 0xb6,
+	0x69, 0xca, 0x92, 0x8f, 0x4f, 0xd0, 0x17, 0x10, 0x68, 0x4e, 0x91, 0x5f, 0x14, 0xee, 0x95, 0xf1,
+	0xcc, 0x84, 0x11, 0x9b, 0x1a, 0x9b, 0xbb, 0xf3, 0x0e, 0x54, 0xc5, 0x90, 0x67, 0xc4, 0x7c, 0x81,
+	0x4a, 0xb1, 0x9d, 0xa1, 0x07, 0xe0, 0x52, 0xfb, 0xb1, 0x09, 0x8e, 0xa2, 0xf5, 0xef, 0x5a, 0x47,
+	0xc4, 0xca, 0x09, 0xdd, 0xd6, 0x27, 0x7b, 0x6b, 0xfe, 0xc4, 0x6e, 0x6c, 0x26, 0x0f, 0xfe, 0x76,
+	0xc0, 0xcb, 0xe5, 0x85, 0x3c, 0x28, 0xbf, 0xe6, 0x8c, 0x84, 0x5b, 0x6a, 0xa4, 0x9e, 0xd3, 0xd0,
+	0x51, 0xa3, 0x2e, 0x93, 0x4f, 0xc3, 0x12, 0xf2, 0xa1, 0xd2, 0x65, 0xf2, 0xe1, 0x93, 0xd0, 0xb5,
+	0xc3, 0x47, 0x47, 0x61, 0xd9, 0x0e, 0x9f, 0x3c, 0x0e, 0x2b, 0x6a, 0xa8, 0x7b, 0x28, 0x04, 0x04,
+	0x50, 0x35, 0x0f, 0x52, 0x18, 0xa8, 0xb1, 0x49, 0x76, 0x78, 0x5b, 0xb9, 0xe8, 0x94, 0x87, 0x77,
+	0x14, 0xb1, 0xba, 0xa7, 0xc3, 0x77, 0x51, 0x08, 0xb5, 0x76, 0xa1, 0xab, 0xc3, 0x04, 0xdd, 0x80,
+	0xa0, 0xd0, 0x8d, 0x21, 0x41, 0x37, 0x61, 0xe7, 0x55, 0xb1, 0x99, 0xc2, 0x33, 0x84, 0x60, 0xb7,
+	0xbd, 0x68, 0x3b, 0x47, 0xef, 0xc0, 0xcd, 0xde, 0x72, 0x2f, 0x87, 0x17, 0xed, 0x4f, 0x7e, 0x78,
+	0x74, 0x4e, 0xe5, 0xc5, 0x64, 0xa0, 0x7e, 0xed, 0x87, 0x26, 0x4b, 0x1f, 0x53, 0x6e, 0x47, 0x87,
+	0x94, 0x49, 0x92, 0x31, 0x9c, 0x1e, 0xea, 0xc4, 0x1d, 0x9a, 0xc4, 0x8d, 0x07, 0x83, 0xaa, 0x9e,
+	0x3f, 0xfa, 0x6f, 0x00, 0xe0, 0x56, 0x74, 0xe3, 0x47, 0x0d, 0x00, 0x00,
 }
@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+const (
+	// headerAuthorize is the metadata key which carries the base64 encoded "username:password"
+	headerAuthorize = "authorization"
+	// credentialSeparator separates the username and the password in the authorization header
+	credentialSeparator = ":"
+	// internalServicePrefix is the prefix of the full method names of the internal Proxy service,
+	// which is only called by the other components and is authenticated by the internal token
+	internalServicePrefix = "/milvus.proto.proxy.Proxy/"
+)
+
+// isInternalMethod checks whether the request comes from the internal Proxy service.
+func isInternalMethod(ctx context.Context) bool {
+	method, ok := grpc.Method(ctx)
+	return ok && strings.HasPrefix(method, internalServicePrefix)
+}
+
+// validInternalToken checks whether the request carries the internal token shared by the components,
+// no request is accepted if the token isn't configured.
+func validInternalToken(md metadata.MD) bool {
+	tokens := md[common.HeaderInternalToken]
+	if Params.InternalToken == "" || len(tokens) < 1 {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(Params.InternalToken)) == 1
+}
+
+// passwordVerify verifies the raw password against the credential cached in globalMetaCache.
+// The sha256 of the raw password is cached once bcrypt verification succeeds, since bcrypt is too slow
+// to be done on every request.
+func passwordVerify(ctx context.Context, username, rawPwd string, globalMetaCache Cache) bool {
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		log.Error("found no credential", zap.String("username", username), zap.Error(err))
+		return false
+	}
+
+	sha256Pwd := crypto.SHA256(rawPwd, credInfo.Username)
+	if credInfo.Sha256Password != "" {
+		return subtle.ConstantTimeCompare([]byte(sha256Pwd), []byte(credInfo.Sha256Password)) == 1
+	}
+
+	if !crypto.PasswordVerify(rawPwd, credInfo.EncryptedPassword) {
+		return false
+	}
+	credInfo.Sha256Password = sha256Pwd
+	globalMetaCache.UpdateCredential(credInfo)
+	return true
+}
+
+// parseAuthorization decodes the username and the password from the authorization header.
+func parseAuthorization(authorization []string) (string, string, error) {
+	if len(authorization) < 1 {
+		return "", "", fmt.Errorf("missing %s in metadata", headerAuthorize)
+	}
+	rawToken, err := crypto.Base64Decode(authorization[0])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid %s in metadata", headerAuthorize)
+	}
+	secrets := strings.SplitN(rawToken, credentialSeparator, 2)
+	if len(secrets) < 2 {
+		return "", "", fmt.Errorf("invalid %s in metadata", headerAuthorize)
+	}
+	return secrets[0], secrets[1], nil
+}
+
+// validAuth checks whether the authorization header carries a valid username and password.
+func validAuth(ctx context.Context, authorization []string) bool {
+	username, password, err := parseAuthorization(authorization)
+	if err != nil {
+		return false
+	}
+	return passwordVerify(ctx, username, password, globalMetaCache)
+}
+
+// GetCurUserFromContext returns the username in the authorization header of the request.
+func GetCurUserFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("missing metadata")
+	}
+	username, _, err := parseAuthorization(md[headerAuthorize])
+	return username, err
+}
+
+// AuthenticationInterceptor verifies the username and password in the request metadata, which is
+// the kv pair <"authorization": base64("username:password")>.
+// The verification is skipped when authorization isn't enabled, and the requests sent to the internal Proxy service
+// are verified by the internal token instead.
+func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
+	if !Params.AuthorizationEnabled {
+		return ctx, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	if isInternalMethod(ctx) {
+		if !validInternalToken(md) {
+			return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid %s in metadata", common.HeaderInternalToken))
+		}
+		return ctx, nil
+	}
+	// The keys within metadata.MD are normalized to lowercase.
+	// See: https://godoc.org/google.golang.org/grpc/metadata#New
+	if !validAuth(ctx, md[headerAuthorize]) {
+		return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid %s in metadata", headerAuthorize))
+	}
+	return ctx, nil
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+// mockServerTransportStream carries the full method name of the request in the context
+type mockServerTransportStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (m *mockServerTransportStream) Method() string {
+	return m.method
+}
+
+func TestValidAuth(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	// no authorization
+	assert.False(t, validAuth(ctx, nil))
+	// invalid base64
+	assert.False(t, validAuth(ctx, []string{"(*&^%"}))
+	// no separator
+	assert.False(t, validAuth(ctx, []string{crypto.Base64Encode("mockUser")}))
+	// wrong password
+	assert.False(t, validAuth(ctx, []string{crypto.Base64Encode("mockUser:wrongPass")}))
+	// non-existent user
+	assert.False(t, validAuth(ctx, []string{crypto.Base64Encode("nonExistUser:mockPass")}))
+
+	// verified by bcrypt, then by the cached sha256
+	assert.True(t, validAuth(ctx, []string{crypto.Base64Encode("mockUser:mockPass")}))
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.SHA256("mockPass", "mockUser"), credInfo.Sha256Password)
+	assert.True(t, validAuth(ctx, []string{crypto.Base64Encode("mockUser:mockPass")}))
+	assert.False(t, validAuth(ctx, []string{crypto.Base64Encode("mockUser:wrongPass")}))
+}
+
+func TestAuthenticationInterceptor(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	// authorization disabled
+	Params.AuthorizationEnabled = false
+	_, err = AuthenticationInterceptor(ctx)
+	assert.Nil(t, err)
+
+	Params.AuthorizationEnabled = true
+	defer func() { Params.AuthorizationEnabled = false }()
+	// no metadata
+	_, err = AuthenticationInterceptor(ctx)
+	assert.NotNil(t, err)
+
+	// invalid authorization
+	md := metadata.Pairs(headerAuthorize, crypto.Base64Encode("mockUser:wrongPass"))
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(ctx, md))
+	assert.NotNil(t, err)
+
+	// valid authorization
+	md = metadata.Pairs(headerAuthorize, crypto.Base64Encode("mockUser:mockPass"))
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(ctx, md))
+	assert.Nil(t, err)
+}
+
+func TestAuthenticationInterceptor_InternalMethod(t *testing.T) {
+	Params.Init()
+	Params.AuthorizationEnabled = true
+	defer func() { Params.AuthorizationEnabled = false }()
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(),
+		&mockServerTransportStream{method: internalServicePrefix + "InvalidateCredentialCache"})
+	assert.True(t, isInternalMethod(ctx))
+
+	// anonymous caller
+	_, err := AuthenticationInterceptor(metadata.NewIncomingContext(ctx, metadata.MD{}))
+	assert.NotNil(t, err)
+
+	// the internal token isn't configured
+	Params.InternalToken = ""
+	md := metadata.Pairs(common.HeaderInternalToken, "")
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(ctx, md))
+	assert.NotNil(t, err)
+
+	Params.InternalToken = "secret"
+	defer func() { Params.InternalToken = "" }()
+	md = metadata.Pairs(common.HeaderInternalToken, "wrong")
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(ctx, md))
+	assert.NotNil(t, err)
+
+	// a user credential doesn't authorize the internal service
+	md = metadata.Pairs(headerAuthorize, crypto.Base64Encode("mockUser:mockPass"))
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(ctx, md))
+	assert.NotNil(t, err)
+
+	md = metadata.Pairs(common.HeaderInternalToken, "secret")
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(ctx, md))
+	assert.Nil(t, err)
+}
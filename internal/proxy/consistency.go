@@ -0,0 +1,172 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// eventuallyTs is the guarantee timestamp of the Eventually consistency level, which never waits
+const eventuallyTs = Timestamp(1)
+
+// HeaderSessionID is the metadata key of the session of a request for the Session consistency level
+const HeaderSessionID = "session_id"
+
+// sessionIdleTime is the time a session is tracked after its last write
+const sessionIdleTime = 10 * time.Minute
+
+// writeTsTracker records the timestamp of the last write of each session for the Session consistency level.
+// The sessions idle for sessionIdleTime are evicted, and the untracked sessions read the latest evicted write,
+// which keeps the guarantee of the evicted sessions at the cost of waiting for a write long visible in practice.
+type writeTsTracker struct {
+	mu        sync.RWMutex
+	idleTime  time.Duration
+	tss       map[string]Timestamp
+	evictedTs Timestamp
+	lastEvict time.Time
+}
+
+func newWriteTsTracker() *writeTsTracker {
+	return &writeTsTracker{idleTime: sessionIdleTime, tss: make(map[string]Timestamp)}
+}
+
+// update records ts as the last write of session if it is newer, the writes without a session are not tracked
+func (w *writeTsTracker) update(session string, ts Timestamp) {
+	if w == nil || session == "" {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if ts > w.tss[session] {
+		w.tss[session] = ts
+	}
+	w.evict(time.Now())
+}
+
+// evict removes the sessions without any write for idleTime, the caller must hold the write lock
+func (w *writeTsTracker) evict(now time.Time) {
+	if now.Sub(w.lastEvict) < w.idleTime {
+		return
+	}
+	w.lastEvict = now
+	for session, ts := range w.tss {
+		if physical, _ := tsoutil.ParseTS(ts); now.Sub(physical) >= w.idleTime {
+			if ts > w.evictedTs {
+				w.evictedTs = ts
+			}
+			delete(w.tss, session)
+		}
+	}
+}
+
+// get returns the timestamp of the last write of session, or the latest evicted write if the session is not tracked
+func (w *writeTsTracker) get(session string) Timestamp {
+	if w == nil || session == "" {
+		return 0
+	}
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if ts, ok := w.tss[session]; ok {
+		return ts
+	}
+	return w.evictedTs
+}
+
+// getSessionID returns the session of the request for the Session consistency level, which is the session_id in
+// the metadata. The connections can't identify the sessions, since the clients behind a load balancer or the REST
+// gateway share them.
+func getSessionID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(HeaderSessionID); len(ids) > 0 {
+			return ids[0]
+		}
+	}
+	return ""
+}
+
+// validateSession checks the request using the Session consistency level carries a session,
+// unless the guarantee timestamp is set by the client.
+func validateSession(level commonpb.ConsistencyLevel, guaranteeTs Timestamp, session string) error {
+	if level == commonpb.ConsistencyLevel_Session && guaranteeTs == 0 && session == "" {
+		return fmt.Errorf("%s is required in the metadata by the Session consistency level", HeaderSessionID)
+	}
+	return nil
+}
+
+// validateConsistencyLevel checks the consistency level is defined
+func validateConsistencyLevel(level commonpb.ConsistencyLevel) error {
+	if _, ok := commonpb.ConsistencyLevel_name[int32(level)]; !ok {
+		return fmt.Errorf("invalid consistency level: %d", level)
+	}
+	return nil
+}
+
+// getConsistencyLevel returns the consistency level of the request. Strong is the zero value of the level, so an
+// unset level can't be told from it, a Strong level inherits the default level of the collection unless explicit
+// is set.
+func getConsistencyLevel(ctx context.Context, dbName, collectionName string,
+	level commonpb.ConsistencyLevel, explicit bool) (commonpb.ConsistencyLevel, error) {
+	if explicit || level != commonpb.ConsistencyLevel_Strong {
+		return level, validateConsistencyLevel(level)
+	}
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName)
+	if err != nil {
+		return level, err
+	}
+	return collInfo.consistencyLevel, nil
+}
+
+// parseGuaranteeTs derives the guarantee timestamp from the consistency level, beginTs is the timestamp of the
+// request allocated from TSO, sessionTs is the last write of the session and gracefulTime is the staleness in
+// milliseconds of the Bounded level, proxy.gracefulTime if not positive. A guarantee timestamp set by the client
+// overrides the consistency level.
+func parseGuaranteeTs(level commonpb.ConsistencyLevel, guaranteeTs, beginTs, sessionTs Timestamp, gracefulTime int64) Timestamp {
+	if guaranteeTs != 0 {
+		return guaranteeTs
+	}
+	switch level {
+	case commonpb.ConsistencyLevel_Session:
+		if sessionTs == 0 {
+			return eventuallyTs
+		}
+		return sessionTs
+	case commonpb.ConsistencyLevel_Bounded:
+		if gracefulTime <= 0 {
+			gracefulTime = Params.GracefulTime
+		}
+		ts := tsoutil.AddPhysicalTimeOnTs(-gracefulTime, beginTs)
+		if ts > beginTs || ts < eventuallyTs {
+			return eventuallyTs
+		}
+		return ts
+	case commonpb.ConsistencyLevel_Eventually:
+		return eventuallyTs
+	default:
+		return beginTs
+	}
+}
@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestWriteTsTracker(t *testing.T) {
+	now := time.Now()
+	ts := func(d time.Duration) Timestamp {
+		return tsoutil.ComposeTS(now.Add(d).UnixNano()/int64(time.Millisecond), 0)
+	}
+
+	w := newWriteTsTracker()
+	assert.Equal(t, Timestamp(0), w.get("c1"))
+	w.update("c1", ts(0))
+	w.update("c1", ts(-time.Second))
+	w.update("c2", ts(-time.Second))
+	assert.Equal(t, ts(0), w.get("c1"))
+	assert.Equal(t, ts(-time.Second), w.get("c2"))
+
+	// the idle sessions are evicted, the untracked sessions read the latest evicted write
+	w.lastEvict = time.Time{}
+	w.update("c3", ts(-2*sessionIdleTime))
+	w.lastEvict = time.Time{}
+	w.update("c4", ts(-3*sessionIdleTime))
+	assert.Equal(t, 2, len(w.tss))
+	assert.Equal(t, ts(-2*sessionIdleTime), w.get("c3"))
+	assert.Equal(t, ts(-2*sessionIdleTime), w.get("c5"))
+	assert.Equal(t, ts(0), w.get("c1"))
+
+	// the writes without a session are not tracked
+	w.update("", ts(0))
+	assert.Equal(t, 2, len(w.tss))
+	assert.Equal(t, Timestamp(0), w.get(""))
+
+	var nilTracker *writeTsTracker
+	nilTracker.update("c1", 100)
+	assert.Equal(t, Timestamp(0), nilTracker.get("c1"))
+}
+
+func TestGetSessionID(t *testing.T) {
+	assert.Equal(t, "", getSessionID(context.Background()))
+
+	// the connections are not sessions, the clients behind a load balancer share them
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	assert.Equal(t, "", getSessionID(ctx))
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(headerClientID, "job"))
+	assert.Equal(t, "", getSessionID(ctx))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(HeaderSessionID, "s1"))
+	assert.Equal(t, "s1", getSessionID(ctx))
+}
+
+func TestValidateSession(t *testing.T) {
+	assert.Error(t, validateSession(commonpb.ConsistencyLevel_Session, 0, ""))
+	assert.NoError(t, validateSession(commonpb.ConsistencyLevel_Session, 0, "s1"))
+	assert.NoError(t, validateSession(commonpb.ConsistencyLevel_Session, 100, ""))
+	assert.NoError(t, validateSession(commonpb.ConsistencyLevel_Bounded, 0, ""))
+}
+
+func TestParseGuaranteeTs(t *testing.T) {
+	Params.Init()
+	beginTs := tsoutil.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0)
+
+	assert.Equal(t, beginTs, parseGuaranteeTs(commonpb.ConsistencyLevel_Strong, 0, beginTs, 10, 0))
+	assert.Equal(t, Timestamp(10), parseGuaranteeTs(commonpb.ConsistencyLevel_Session, 0, beginTs, 10, 0))
+	assert.Equal(t, eventuallyTs, parseGuaranteeTs(commonpb.ConsistencyLevel_Session, 0, beginTs, 0, 0))
+	assert.Equal(t, eventuallyTs, parseGuaranteeTs(commonpb.ConsistencyLevel_Eventually, 0, beginTs, 10, 0))
+
+	bounded := parseGuaranteeTs(commonpb.ConsistencyLevel_Bounded, 0, beginTs, 10, 0)
+	assert.Equal(t, tsoutil.AddPhysicalTimeOnTs(-Params.GracefulTime, beginTs), bounded)
+	assert.Less(t, bounded, beginTs)
+	assert.Equal(t, tsoutil.AddPhysicalTimeOnTs(-100, beginTs),
+		parseGuaranteeTs(commonpb.ConsistencyLevel_Bounded, 0, beginTs, 10, 100))
+	assert.Equal(t, eventuallyTs, parseGuaranteeTs(commonpb.ConsistencyLevel_Bounded, 0, 100, 10, 0))
+
+	// the guarantee timestamp of the client overrides the consistency level
+	assert.Equal(t, Timestamp(5), parseGuaranteeTs(commonpb.ConsistencyLevel_Strong, 5, beginTs, 10, 0))
+}
+
+func TestGetConsistencyLevel(t *testing.T) {
+	ctx := context.Background()
+	assert.NoError(t, InitMetaCache(&MockRootCoordClientInterface{}))
+
+	// an unset level inherits the level of the collection
+	level, err := getConsistencyLevel(ctx, "", "collection2", commonpb.ConsistencyLevel_Strong, false)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Bounded, level)
+
+	// the levels set by the request are kept
+	level, err = getConsistencyLevel(ctx, "", "collection2", commonpb.ConsistencyLevel_Strong, true)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Strong, level)
+	level, err = getConsistencyLevel(ctx, "", "collection2", commonpb.ConsistencyLevel_Eventually, false)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Eventually, level)
+
+	_, err = getConsistencyLevel(ctx, "", "collection2", commonpb.ConsistencyLevel(100), false)
+	assert.Error(t, err)
+	_, err = getConsistencyLevel(ctx, "", "collection_not_exist", commonpb.ConsistencyLevel_Strong, false)
+	assert.Error(t, err)
+}
+
+func TestValidateConsistencyLevel(t *testing.T) {
+	assert.NoError(t, validateConsistencyLevel(commonpb.ConsistencyLevel_Eventually))
+	assert.Error(t, validateConsistencyLevel(commonpb.ConsistencyLevel(100)))
+}
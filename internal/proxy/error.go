@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
@@ -37,12 +38,24 @@ func errNumRowsOfFieldDataMismatchPassed(idx int, fieldNumRows, passedNumRows ui
 	return fmt.Errorf("the num_rows(%d) of %dth field is not equal to passed NumRows(%d)", fieldNumRows, idx, passedNumRows)
 }
 
+func errNumOfValidDataMismatchPassed(idx int, validNum, passedNumRows uint32) error {
+	return fmt.Errorf("the length(%d) of valid data of %dth field is not equal to passed NumRows(%d)", validNum, idx, passedNumRows)
+}
+
 var errEmptyFieldData = errors.New("empty field data")
 
 func errFieldsLessThanNeeded(fieldsNum, needed int) error {
 	return fmt.Errorf("the length(%d) of passed fields is less than needed(%d)", fieldsNum, needed)
 }
 
+func errFieldDataRequired(fieldName string) error {
+	return fmt.Errorf("the data of field %s is required, it is neither nullable nor has a default value", fieldName)
+}
+
+func errNullValueOfNotNullableField(fieldName string) error {
+	return fmt.Errorf("field %s is not nullable, but got null values", fieldName)
+}
+
 func errUnsupportedDataType(dType schemapb.DataType) error {
 	return fmt.Errorf("%v is not supported now", dType)
 }
@@ -71,3 +84,19 @@ func msgProxyIsUnhealthy(id UniqueID) string {
 func errProxyIsUnhealthy(id UniqueID) error {
 	return errors.New(msgProxyIsUnhealthy(id))
 }
+
+// errRateLimit is the error wrapped by all the errors of rate limited requests
+var errRateLimit = errors.New("rate limit exceeded")
+
+// errRateLimited returns an error represent the rate of the request exceeds the limit
+func errRateLimited(rt rateType, scope string, limit float64) error {
+	return fmt.Errorf("%w: %s of %s exceeds %v per second", errRateLimit, rt, scope, limit)
+}
+
+// enqueueErrorCode returns the error code of the error failing to enqueue a task
+func enqueueErrorCode(err error) commonpb.ErrorCode {
+	if errors.Is(err, errRateLimit) {
+		return commonpb.ErrorCode_RateLimit
+	}
+	return commonpb.ErrorCode_UnexpectedError
+}
@@ -69,6 +69,21 @@ func Test_errFieldsLessThanNeeded(t *testing.T) {
 	}
 }
 
+func Test_errNumOfValidDataMismatchPassed(t *testing.T) {
+	log.Info("Test_errNumOfValidDataMismatchPassed",
+		zap.Error(errNumOfValidDataMismatchPassed(1, 2, 3)))
+}
+
+func Test_errFieldDataRequired(t *testing.T) {
+	log.Info("Test_errFieldDataRequired",
+		zap.Error(errFieldDataRequired("age")))
+}
+
+func Test_errNullValueOfNotNullableField(t *testing.T) {
+	log.Info("Test_errNullValueOfNotNullableField",
+		zap.Error(errNullValueOfNotNullableField("age")))
+}
+
 func Test_errUnsupportedDataType(t *testing.T) {
 	unsupportedDTypes := []schemapb.DataType{
 		schemapb.DataType_None,
@@ -31,6 +31,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -40,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -94,7 +96,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 
 	collectionName := request.CollectionName
 	if globalMetaCache != nil {
-		globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+		globalMetaCache.RemoveCollection(ctx, request.GetDbName(), collectionName) // no need to return error, though collection may be not cached
 	}
 	log.Debug("InvalidateCollectionMetaCache Done",
 		zap.String("role", Params.RoleName),
@@ -131,6 +133,53 @@ func (node *Proxy) ReleaseDQLMessageStream(ctx context.Context, request *proxypb
 	}, nil
 }
 
+// InvalidateCredentialCache invalidate the credential cache of specified username.
+func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	log.Debug("InvalidateCredentialCache",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+
+	username := request.Username
+	if globalMetaCache != nil {
+		globalMetaCache.RemoveCredential(username) // no need to return error, though credential may be not cached
+	}
+	log.Debug("InvalidateCredentialCache Done",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// RefreshPolicyInfoCache applies the change of the roles and the grants to the policy cache of Proxy.
+func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	log.Debug("RefreshPolicyInfoCache",
+		zap.String("role", Params.RoleName),
+		zap.Int32("opType", req.OpType),
+		zap.String("opKey", req.OpKey))
+
+	if globalMetaCache != nil {
+		if err := globalMetaCache.RefreshPolicyInfo(typeutil.CacheOpType(req.OpType), req.OpKey); err != nil {
+			log.Error("RefreshPolicyInfoCache failed", zap.Error(err))
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			}, nil
+		}
+	}
+	log.Debug("RefreshPolicyInfoCache Done",
+		zap.String("role", Params.RoleName),
+		zap.Int32("opType", req.OpType),
+		zap.String("opKey", req.OpKey))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 // CreateCollection create a collection by the schema.
 func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
@@ -1284,6 +1333,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 					MsgType: commonpb.MsgType_Insert,
 					MsgID:   0,
 				},
+				DbName:         request.DbName,
 				CollectionName: request.CollectionName,
 				PartitionName:  request.PartitionName,
 				// RowData: transfer column based request to this
@@ -1339,7 +1389,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	)
 
 	if err != nil {
-		result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		result.Status.ErrorCode = enqueueErrorCode(err)
 		result.Status.Reason = err.Error()
 		numRows := it.req.NumRows
 		errIndex := make([]uint32, numRows)
@@ -1369,6 +1419,8 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 			errIndex[i] = i
 		}
 		it.result.ErrIndex = errIndex
+	} else {
+		node.writeTs.update(getSessionID(ctx), it.result.Timestamp)
 	}
 	it.result.InsertCnt = int64(it.req.NumRows)
 	return it.result, nil
@@ -1412,8 +1464,9 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 				// RowData: transfer column based request to this
 			},
 		},
-		chMgr:    node.chMgr,
-		chTicker: node.chTicker,
+		chMgr:     node.chMgr,
+		chTicker:  node.chTicker,
+		queryFunc: node.Query,
 	}
 
 	log.Debug("Enqueue delete request in Proxy",
@@ -1428,7 +1481,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		log.Error("Failed to enqueue delete task: "+err.Error(), zap.String("traceID", traceID))
 		return &milvuspb.MutationResult{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: enqueueErrorCode(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1454,9 +1507,139 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		}, nil
 	}
 
+	node.writeTs.update(getSessionID(ctx), dt.result.Timestamp)
 	return dt.result, nil
 }
 
+// Upsert replaces the entities with the same primary keys in the collection.
+func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Upsert")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+	log.Info("Start processing upsert request in Proxy", zap.String("traceID", traceID))
+	defer log.Info("Finish processing upsert request in Proxy", zap.String("traceID", traceID))
+
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	partitionName := request.PartitionName
+	if len(partitionName) <= 0 {
+		partitionName = Params.DefaultPartitionName
+	}
+
+	ut := &upsertTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		req:       request,
+		insertTask: &insertTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			req: &milvuspb.InsertRequest{
+				DbName:         request.DbName,
+				CollectionName: request.CollectionName,
+				PartitionName:  request.PartitionName,
+				FieldsData:     request.FieldsData,
+				HashKeys:       request.HashKeys,
+				NumRows:        request.NumRows,
+			},
+			BaseInsertTask: BaseInsertTask{
+				BaseMsg: msgstream.BaseMsg{
+					HashValues: request.HashKeys,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType: commonpb.MsgType_Insert,
+						MsgID:   0,
+					},
+					DbName:         request.DbName,
+					CollectionName: request.CollectionName,
+					PartitionName:  partitionName,
+				},
+			},
+			rowIDAllocator: node.idAllocator,
+			segIDAssigner:  node.segAssigner,
+			chMgr:          node.chMgr,
+			chTicker:       node.chTicker,
+		},
+		deleteTask: &deleteTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			BaseDeleteTask: BaseDeleteTask{
+				DeleteRequest: internalpb.DeleteRequest{
+					Base: &commonpb.MsgBase{
+						MsgType: commonpb.MsgType_Delete,
+						MsgID:   0,
+					},
+					DbName:         request.DbName,
+					CollectionName: request.CollectionName,
+					PartitionName:  request.PartitionName,
+				},
+			},
+			chMgr:    node.chMgr,
+			chTicker: node.chTicker,
+		},
+		chMgr: node.chMgr,
+	}
+
+	log.Debug("Enqueue upsert request in Proxy",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("NumRows", request.NumRows))
+
+	errIndex := func() []uint32 {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+		return errIndex
+	}
+
+	// MsgID will be set by Enqueue()
+	if err := node.sched.dmQueue.Enqueue(ut); err != nil {
+		log.Error("Failed to enqueue upsert task: "+err.Error(), zap.String("traceID", traceID))
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: enqueueErrorCode(err),
+				Reason:    err.Error(),
+			},
+			ErrIndex: errIndex(),
+		}, nil
+	}
+
+	log.Debug("Detail of upsert request in Proxy",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", ut.ID()),
+		zap.Uint64("timestamp", ut.BeginTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.String("traceID", traceID))
+
+	if err := ut.WaitToFinish(); err != nil {
+		log.Error("Failed to execute upsert task in task scheduler: "+err.Error(), zap.String("traceID", traceID))
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ErrIndex: errIndex(),
+		}, nil
+	}
+	if ut.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		ut.result.ErrIndex = errIndex()
+	} else {
+		node.writeTs.update(getSessionID(ctx), ut.result.Timestamp)
+	}
+
+	return ut.result, nil
+}
+
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.SearchResults{
@@ -1479,6 +1662,8 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		query:     request,
 		chMgr:     node.chMgr,
 		qc:        node.queryCoord,
+		session:   getSessionID(ctx),
+		sessionTs: node.writeTs.get(getSessionID(ctx)),
 	}
 
 	log.Debug("Search enqueue",
@@ -1493,7 +1678,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	if err != nil {
 		return &milvuspb.SearchResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: enqueueErrorCode(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1547,6 +1732,75 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return qt.result, nil
 }
 
+func (node *Proxy) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.SearchResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-HybridSearch")
+	defer sp.Finish()
+	ht := &hybridSearchTask{
+		ctx:        ctx,
+		Condition:  NewTaskCondition(ctx),
+		req:        request,
+		searchFunc: node.Search,
+		queryFunc:  node.Query,
+		session:    getSessionID(ctx),
+		sessionTs:  node.writeTs.get(getSessionID(ctx)),
+	}
+
+	log.Debug("HybridSearch enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("partitions", request.PartitionNames),
+		zap.Int("len(Requests)", len(request.Requests)),
+		zap.Any("RankParams", request.RankParams),
+		zap.Any("OutputFields", request.OutputFields))
+	err := node.sched.dqQueue.Enqueue(ht)
+	if err != nil {
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: enqueueErrorCode(err),
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("HybridSearch",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", ht.ID()),
+		zap.Uint64("timestamp", ht.BeginTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("partitions", request.PartitionNames),
+		zap.Int("len(Requests)", len(request.Requests)),
+		zap.Any("RankParams", request.RankParams),
+		zap.Any("OutputFields", request.OutputFields))
+	defer func() {
+		log.Debug("HybridSearch Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", ht.ID()),
+			zap.Uint64("timestamp", ht.BeginTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+	}()
+
+	err = ht.WaitToFinish()
+	if err != nil {
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	return ht.result, nil
+}
+
 func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	resp := &milvuspb.FlushResponse{
 		Status: &commonpb.Status{
@@ -1609,11 +1863,21 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	}
 
 	queryRequest := &milvuspb.QueryRequest{
-		DbName:         request.DbName,
-		CollectionName: request.CollectionName,
-		PartitionNames: request.PartitionNames,
-		Expr:           request.Expr,
-		OutputFields:   request.OutputFields,
+		DbName:              request.DbName,
+		CollectionName:      request.CollectionName,
+		PartitionNames:      request.PartitionNames,
+		Expr:                request.Expr,
+		OutputFields:        request.OutputFields,
+		TravelTimestamp:     request.TravelTimestamp,
+		GuaranteeTimestamp:  request.GuaranteeTimestamp,
+		Limit:               request.Limit,
+		Offset:              request.Offset,
+		OrderBy:             request.OrderBy,
+		Descending:          request.Descending,
+		GroupBy:             request.GroupBy,
+		ConsistencyLevel:    request.ConsistencyLevel,
+		ExplicitConsistency: request.ExplicitConsistency,
+		GracefulTime:        request.GracefulTime,
 	}
 
 	qt := &queryTask{
@@ -1630,6 +1894,8 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		query:     queryRequest,
 		chMgr:     node.chMgr,
 		qc:        node.queryCoord,
+		session:   getSessionID(ctx),
+		sessionTs: node.writeTs.get(getSessionID(ctx)),
 	}
 
 	log.Debug("Query enqueue",
@@ -1642,7 +1908,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	if err != nil {
 		return &milvuspb.QueryResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: enqueueErrorCode(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1681,6 +1947,93 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	}, nil
 }
 
+// OpenQueryIterator opens an iterator on the entities matching the expr, which are read at a pinned snapshot
+// in batches sorted by primary key.
+func (node *Proxy) OpenQueryIterator(ctx context.Context, request *milvuspb.OpenQueryIteratorRequest) (*milvuspb.OpenQueryIteratorResponse, error) {
+	log.Debug("OpenQueryIterator",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("expr", request.Expr),
+		zap.Uint64("travel_timestamp", request.TravelTimestamp))
+	if !node.checkHealthy() {
+		return &milvuspb.OpenQueryIteratorResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	iteratorID, secret, info, err := node.openQueryIterator(ctx, request)
+	if err != nil {
+		log.Error("failed to open query iterator", zap.String("collection", request.CollectionName), zap.Error(err))
+		return &milvuspb.OpenQueryIteratorResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.OpenQueryIteratorResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IteratorID:      iteratorID,
+		TravelTimestamp: info.TravelTimestamp,
+		Secret:          secret,
+	}, nil
+}
+
+// NextQueryIterator fetches the batch after the token of a query iterator, and extends the expiration of it.
+func (node *Proxy) NextQueryIterator(ctx context.Context, request *milvuspb.NextQueryIteratorRequest) (*milvuspb.QueryIteratorResults, error) {
+	log.Debug("NextQueryIterator",
+		zap.String("role", Params.RoleName),
+		zap.Int64("iteratorID", request.IteratorID),
+		zap.String("token", request.Token))
+	if !node.checkHealthy() {
+		return &milvuspb.QueryIteratorResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	result, err := node.nextQueryIterator(ctx, request)
+	if err != nil {
+		log.Error("failed to fetch query iterator", zap.Int64("iteratorID", request.IteratorID), zap.Error(err))
+		return &milvuspb.QueryIteratorResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return result, nil
+}
+
+// CloseQueryIterator closes a query iterator, its snapshot is no longer kept from compaction.
+func (node *Proxy) CloseQueryIterator(ctx context.Context, request *milvuspb.CloseQueryIteratorRequest) (*commonpb.Status, error) {
+	log.Debug("CloseQueryIterator",
+		zap.String("role", Params.RoleName),
+		zap.Int64("iteratorID", request.IteratorID))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	if _, err := node.loadQueryIterator(ctx, request.IteratorID, request.Secret); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := node.etcdKV.Remove(queryIteratorKey(request.IteratorID)); err != nil {
+		log.Error("failed to close query iterator", zap.Int64("iteratorID", request.IteratorID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
@@ -1860,7 +2213,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 		if err != nil {
 			return &milvuspb.QueryResults{
 				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					ErrorCode: enqueueErrorCode(err),
 					Reason:    err.Error(),
 				},
 			}, err
@@ -1884,14 +2237,14 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 
 	// the vectors retrieved are random order, we need re-arrange the vectors by the order of input ids
 	arrangeFunc := func(ids *milvuspb.VectorIDs, retrievedFields []*schemapb.FieldData) (*schemapb.VectorField, error) {
-		var retrievedIds *schemapb.ScalarField
+		var retrievedIds *schemapb.IDs
 		var retrievedVectors *schemapb.VectorField
 		for _, fieldData := range retrievedFields {
 			if fieldData.FieldName == ids.FieldName {
 				retrievedVectors = fieldData.GetVectors()
 			}
-			if fieldData.Type == schemapb.DataType_Int64 {
-				retrievedIds = fieldData.GetScalars()
+			if typeutil.IsPrimaryFieldType(fieldData.Type) {
+				retrievedIds, _ = typeutil.GetPKsFromFieldData(fieldData)
 			}
 		}
 
@@ -1899,12 +2252,15 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			return nil, errors.New("Failed to fetch vectors")
 		}
 
-		dict := make(map[int64]int)
-		for index, id := range retrievedIds.GetLongData().Data {
-			dict[id] = index
+		dict := make(map[interface{}]int)
+		for index := 0; index < typeutil.GetSizeOfIDs(retrievedIds); index++ {
+			dict[typeutil.GetPK(retrievedIds, int64(index))] = index
 		}
 
-		inputIds := ids.IdArray.GetIntId().Data
+		inputIds := make([]interface{}, 0, typeutil.GetSizeOfIDs(ids.IdArray))
+		for index := 0; index < typeutil.GetSizeOfIDs(ids.IdArray); index++ {
+			inputIds = append(inputIds, typeutil.GetPK(ids.IdArray, int64(index)))
+		}
 		if retrievedVectors.GetFloatVector() != nil {
 			floatArr := retrievedVectors.GetFloatVector().Data
 			element := retrievedVectors.GetDim()
@@ -1912,7 +2268,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			for _, id := range inputIds {
 				index, ok := dict[id]
 				if !ok {
-					log.Error("id not found in CalcDistance", zap.Int64("id", id))
+					log.Error("id not found in CalcDistance", zap.Any("id", id))
 					return nil, errors.New("Failed to fetch vectors by id: " + fmt.Sprintln(id))
 				}
 				result = append(result, floatArr[int64(index)*element:int64(index+1)*element]...)
@@ -1939,7 +2295,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			for _, id := range inputIds {
 				index, ok := dict[id]
 				if !ok {
-					log.Error("id not found in CalcDistance", zap.Int64("id", id))
+					log.Error("id not found in CalcDistance", zap.Any("id", id))
 					return nil, errors.New("Failed to fetch vectors by id: " + fmt.Sprintln(id))
 				}
 				result = append(result, binaryArr[int64(index)*element:int64(index+1)*element]...)
@@ -1953,9 +2309,57 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			}, nil
 		}
 
+		if retrievedVectors.GetFloat16Vector() != nil || retrievedVectors.GetBfloat16Vector() != nil {
+			halfArr := retrievedVectors.GetFloat16Vector()
+			if halfArr == nil {
+				halfArr = retrievedVectors.GetBfloat16Vector()
+			}
+			element := retrievedVectors.GetDim() * 2
+			result := make([]byte, 0, int64(len(inputIds))*element)
+			for _, id := range inputIds {
+				index, ok := dict[id]
+				if !ok {
+					log.Error("id not found in CalcDistance", zap.Any("id", id))
+					return nil, errors.New("Failed to fetch vectors by id: " + fmt.Sprintln(id))
+				}
+				result = append(result, halfArr[int64(index)*element:int64(index+1)*element]...)
+			}
+
+			if retrievedVectors.GetFloat16Vector() != nil {
+				return &schemapb.VectorField{
+					Dim:  retrievedVectors.GetDim(),
+					Data: &schemapb.VectorField_Float16Vector{Float16Vector: result},
+				}, nil
+			}
+			return &schemapb.VectorField{
+				Dim:  retrievedVectors.GetDim(),
+				Data: &schemapb.VectorField_Bfloat16Vector{Bfloat16Vector: result},
+			}, nil
+		}
+
 		return nil, errors.New("Failed to fetch vectors")
 	}
 
+	// the distances of float16 and bfloat16 vectors are calculated on their float32 values
+	convertFunc := func(vectors *schemapb.VectorField) *schemapb.VectorField {
+		var data []float32
+		if vectors.GetFloat16Vector() != nil {
+			data = typeutil.Float16VectorToFloat32(vectors.GetFloat16Vector())
+		} else if vectors.GetBfloat16Vector() != nil {
+			data = typeutil.BFloat16VectorToFloat32(vectors.GetBfloat16Vector())
+		} else {
+			return vectors
+		}
+		return &schemapb.VectorField{
+			Dim: vectors.Dim,
+			Data: &schemapb.VectorField_FloatVector{
+				FloatVector: &schemapb.FloatArray{
+					Data: data,
+				},
+			},
+		}
+	}
+
 	vectorsLeft := request.GetOpLeft().GetDataArray()
 	opLeft := request.GetOpLeft().GetIdArray()
 	if opLeft != nil {
@@ -2031,6 +2435,9 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 		}, nil
 	}
 
+	vectorsLeft = convertFunc(vectorsLeft)
+	vectorsRight = convertFunc(vectorsRight)
+
 	if vectorsLeft.GetFloatVector() != nil && vectorsRight.GetFloatVector() != nil {
 		distances, err := distance.CalcFloatDistance(vectorsLeft.Dim, vectorsLeft.GetFloatVector().Data, vectorsRight.GetFloatVector().Data, metric)
 		if err != nil {
@@ -2186,7 +2593,7 @@ func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQue
 		resp.Status.Reason = err.Error()
 		return resp, nil
 	}
-	collID, err := globalMetaCache.GetCollectionID(ctx, req.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.CollectionName)
 	if err != nil {
 		resp.Status.Reason = err.Error()
 		return resp, nil
@@ -2336,6 +2743,7 @@ func (node *Proxy) RegisterLink(ctx context.Context, req *milvuspb.RegisterLinkR
 	code := node.stateCode.Load().(internalpb.StateCode)
 	log.Debug("RegisterLink",
 		zap.String("role", Params.RoleName),
+		zap.String("client", getClientID(ctx)),
 		zap.Any("state code of proxy", code))
 
 	if code != internalpb.StateCode_Healthy {
@@ -2512,6 +2920,511 @@ func (node *Proxy) GetCompactionStateWithPlans(ctx context.Context, req *milvusp
 	return resp, err
 }
 
+// CreateCredential creates a new user with the username and the password.
+func (node *Proxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("CreateCredential", zap.String("role", Params.RoleName), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	// validate params
+	username := req.Username
+	if err := ValidateUsername(username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	rawPassword := req.Password
+	if err := ValidatePassword(rawPassword); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	encryptedPassword, err := crypto.PasswordEncrypt(rawPassword)
+	if err != nil {
+		log.Error("encrypt password fail", zap.String("username", username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateCredentialFailure,
+			Reason:    "encrypt password fail key:" + username,
+		}, nil
+	}
+	credInfo := &internalpb.CredentialInfo{
+		Username:          username,
+		EncryptedPassword: encryptedPassword,
+	}
+	result, err := node.rootCoord.CreateCredential(ctx, credInfo)
+	if err != nil { // for error like context timeout etc.
+		log.Error("create credential fail", zap.String("username", username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+// UpdateCredential replaces the password of an existing user, the old password must be correct.
+func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("UpdateCredential", zap.String("role", Params.RoleName), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	username := req.Username
+	if err := ValidateUsername(username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	newPassword := req.NewPassword
+	if err := ValidatePassword(newPassword); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if !passwordVerify(ctx, username, req.OldPassword, globalMetaCache) {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    "old password is not correct:" + username,
+		}, nil
+	}
+	encryptedPassword, err := crypto.PasswordEncrypt(newPassword)
+	if err != nil {
+		log.Error("encrypt password fail", zap.String("username", username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    "encrypt password fail key:" + username,
+		}, nil
+	}
+	credInfo := &internalpb.CredentialInfo{
+		Username:          username,
+		EncryptedPassword: encryptedPassword,
+	}
+	result, err := node.rootCoord.UpdateCredential(ctx, credInfo)
+	if err != nil { // for error like context timeout etc.
+		log.Error("update credential fail", zap.String("username", username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+// DeleteCredential deletes the user, the root user can't be deleted.
+func (node *Proxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("DeleteCredential", zap.String("role", Params.RoleName), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if req.Username == common.DefaultRootUser {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DeleteCredentialFailure,
+			Reason:    "user root cannot be deleted",
+		}, nil
+	}
+	result, err := node.rootCoord.DeleteCredential(ctx, req)
+	if err != nil { // for error like context timeout etc.
+		log.Error("delete credential fail", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+// ListCredUsers lists the names of all the users.
+func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	log.Debug("ListCredUsers", zap.String("role", Params.RoleName))
+	if !node.checkHealthy() {
+		return &milvuspb.ListCredUsersResponse{Status: unhealthyStatus()}, nil
+	}
+	resp, err := node.rootCoord.ListCredUsers(ctx, req)
+	if err != nil {
+		log.Error("list credential users fail", zap.Error(err))
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// CreateRole creates a role.
+func (node *Proxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	log.Debug("CreateRole", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if req.Entity == nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "role entity should not be empty",
+		}, nil
+	}
+	if err := ValidateRoleName(req.Entity.Name); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.CreateRole(ctx, req)
+	if err != nil {
+		log.Error("create role fail", zap.String("role_name", req.Entity.Name), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+// DropRole drops a role, the bindings and the grants of the role are removed as well.
+func (node *Proxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	log.Debug("DropRole", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if req.RoleName == common.RoleAdmin || req.RoleName == common.RolePublic {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    fmt.Sprintf("the role[%s] is a default role, which can't be dropped", req.RoleName),
+		}, nil
+	}
+	result, err := node.rootCoord.DropRole(ctx, req)
+	if err != nil {
+		log.Error("drop role fail", zap.String("role_name", req.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+// OperateUserRole adds the user to the role, or removes the user from the role.
+func (node *Proxy) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	log.Debug("OperateUserRole", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.OperateUserRole(ctx, req)
+	if err != nil {
+		log.Error("operate user role fail", zap.String("username", req.Username), zap.String("role_name", req.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+func validGrantEntity(entity *milvuspb.GrantEntity) error {
+	if entity == nil {
+		return errors.New("grant entity should not be empty")
+	}
+	if entity.Object == nil || !funcutil.IsValidObjectType(entity.Object.Name) {
+		return fmt.Errorf("invalid object type, the object type should be one of %v", commonpb.ObjectType_value)
+	}
+	if entity.ObjectName == "" {
+		return errors.New("object name should not be empty")
+	}
+	if entity.DbName != "" {
+		if err := ValidateDatabaseName(entity.DbName); err != nil {
+			return err
+		}
+	}
+	if entity.Role == nil {
+		return errors.New("role should not be empty")
+	}
+	if err := ValidateRoleName(entity.Role.Name); err != nil {
+		return err
+	}
+	if entity.Grantor == nil || entity.Grantor.Privilege == nil || !funcutil.IsValidPrivilegeName(entity.Grantor.Privilege.Name) {
+		return errors.New("invalid privilege name")
+	}
+	return nil
+}
+
+// OperatePrivilege grants the privilege to the role, or revokes the privilege from the role.
+func (node *Proxy) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	log.Debug("OperatePrivilege", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := validGrantEntity(req.Entity); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	curUser, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		curUser = common.DefaultRootUser
+	}
+	req.Entity.Grantor.User = &milvuspb.UserEntity{Name: curUser}
+	result, err := node.rootCoord.OperatePrivilege(ctx, req)
+	if err != nil {
+		log.Error("operate privilege fail", zap.Any("entity", req.Entity), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+// SelectGrant lists the grants of the role, optionally filtered by the object.
+func (node *Proxy) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	log.Debug("SelectGrant", zap.String("role", Params.RoleName), zap.Any("req", req))
+	if !node.checkHealthy() {
+		return &milvuspb.SelectGrantResponse{Status: unhealthyStatus()}, nil
+	}
+	if req.Entity == nil || req.Entity.Role == nil {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    "role should not be empty",
+			},
+		}, nil
+	}
+	if err := ValidateRoleName(req.Entity.Role.Name); err != nil {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	if req.Entity.Object != nil && !funcutil.IsValidObjectType(req.Entity.Object.Name) {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    fmt.Sprintf("invalid object type, the object type should be one of %v", commonpb.ObjectType_value),
+			},
+		}, nil
+	}
+	resp, err := node.rootCoord.SelectGrant(ctx, req)
+	if err != nil {
+		log.Error("select grant fail", zap.Any("entity", req.Entity), zap.Error(err))
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// AddCollectionField adds a nullable or defaulted scalar field to an existing collection, the existing entities take
+// the default value of the field or null.
+func (node *Proxy) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	act := &addCollectionFieldTask{
+		ctx:                       ctx,
+		Condition:                 NewTaskCondition(ctx),
+		AddCollectionFieldRequest: request,
+		rootCoord:                 node.rootCoord,
+	}
+
+	err := node.sched.ddQueue.Enqueue(act)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("AddCollectionField",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+	defer func() {
+		log.Debug("AddCollectionField Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+	}()
+
+	err = act.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return act.result, nil
+}
+
+// CreateDatabase creates a new database namespace for collections.
+func (node *Proxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	cdt := &CreateDatabaseTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: request,
+		rootCoord:             node.rootCoord,
+	}
+
+	err := node.sched.ddQueue.Enqueue(cdt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("CreateDatabase",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("CreateDatabase Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
+
+	err = cdt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return cdt.result, nil
+}
+
+// DropDatabase drops an empty database, the default database can't be dropped.
+func (node *Proxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	ddt := &DropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	err := node.sched.ddQueue.Enqueue(ddt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("DropDatabase",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("DropDatabase Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
+
+	err = ddt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return ddt.result, nil
+}
+
+// ListDatabases lists all the databases in the cluster.
+func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ListDatabasesResponse{Status: unhealthyStatus()}, nil
+	}
+	ldt := &ListDatabasesTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: request,
+		rootCoord:            node.rootCoord,
+	}
+
+	err := node.sched.ddQueue.Enqueue(ldt)
+	if err != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("ListDatabases",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp))
+	defer func() {
+		log.Debug("ListDatabases Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp))
+	}()
+
+	err = ldt.WaitToFinish()
+	if err != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	return ldt.result, nil
+}
+
 // checkHealthy checks proxy state is Healthy
 func (node *Proxy) checkHealthy() bool {
 	code := node.stateCode.Load().(internalpb.StateCode)
@@ -29,31 +29,50 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type Cache interface {
-	// GetCollectionID get collection's id by name.
-	GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
-
-	GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error)
-	GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
-	GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
-	GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error)
-	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
-	RemoveCollection(ctx context.Context, collectionName string)
-	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	// GetCollectionID get collection's id by name, the collections are scoped by the database,
+	// and the default database is used if the database is empty.
+	GetCollectionID(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error)
+
+	GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error)
+	GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error)
+	GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error)
+	GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error)
+	GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error)
+	RemoveCollection(ctx context.Context, database, collectionName string)
+	RemovePartition(ctx context.Context, database, collectionName string, partitionName string)
+
+	// GetCredentialInfo returns the credential of the user, and fetches it from RootCoord if it's not cached.
+	GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error)
+	RemoveCredential(username string)
+	UpdateCredential(credInfo *internalpb.CredentialInfo)
+
+	// GetUserRoles returns the roles bound to the user, and loads the privilege cache from RootCoord if it's not loaded.
+	GetUserRoles(ctx context.Context, username string) ([]string, error)
+	// HasPolicy returns whether the grant encoded by funcutil.PolicyForPrivilege exists.
+	HasPolicy(ctx context.Context, policy string) (bool, error)
+	// RefreshPolicyInfo applies the change of the grants or the user-role bindings to the privilege cache.
+	RefreshPolicyInfo(opType typeutil.CacheOpType, opKey string) error
 }
 
 type collectionInfo struct {
 	collID              typeutil.UniqueID
+	dbID                typeutil.UniqueID
 	schema              *schemapb.CollectionSchema
 	partInfo            map[string]*partitionInfo
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
+	consistencyLevel    commonpb.ConsistencyLevel
+	numPartitions       int64 // the number of partitions the rows are routed to by the partition key, 0 without partition key
 }
 
 type partitionInfo struct {
@@ -65,8 +84,16 @@ type partitionInfo struct {
 type MetaCache struct {
 	client types.RootCoord
 
-	collInfo map[string]*collectionInfo
+	collInfo map[string]map[string]*collectionInfo // database -> collection name -> collection info
 	mu       sync.RWMutex
+
+	credMap map[string]*internalpb.CredentialInfo // cache for credential, lazy load
+	credMut sync.RWMutex
+
+	privilegeInfos map[string]struct{}            // cache for the grants, lazy load
+	userToRoles    map[string]map[string]struct{} // cache for the user-role bindings, lazy load
+	policyLoaded   bool
+	privilegeMut   sync.RWMutex
 }
 
 var globalMetaCache Cache
@@ -83,24 +110,39 @@ func InitMetaCache(client types.RootCoord) error {
 func NewMetaCache(client types.RootCoord) (*MetaCache, error) {
 	return &MetaCache{
 		client:   client,
-		collInfo: map[string]*collectionInfo{},
+		collInfo: map[string]map[string]*collectionInfo{},
+		credMap:  map[string]*internalpb.CredentialInfo{},
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+// getDatabaseName returns the default database name if the database isn't specified.
+func getDatabaseName(database string) string {
+	if database == "" {
+		return common.DefaultDBName
+	}
+	return database
+}
+
+// getCollection returns the cached collection, the caller must hold the lock.
+func (m *MetaCache) getCollection(database, collectionName string) (*collectionInfo, bool) {
+	collInfo, ok := m.collInfo[database][collectionName]
+	return collInfo, ok
+}
+
+func (m *MetaCache) GetCollectionID(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
+	database = getDatabaseName(database)
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return 0, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, database, collectionName)
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
@@ -108,51 +150,56 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string)
 	return collInfo.collID, nil
 }
 
-func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
+func (m *MetaCache) GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
+	database = getDatabaseName(database)
 	m.mu.RLock()
 	var collInfo *collectionInfo
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	m.mu.RUnlock()
 
 	if !ok {
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, database, collectionName)
 	}
 
 	return &collectionInfo{
 		collID:              collInfo.collID,
+		dbID:                collInfo.dbID,
 		schema:              collInfo.schema,
 		partInfo:            collInfo.partInfo,
 		createdTimestamp:    collInfo.createdTimestamp,
 		createdUtcTimestamp: collInfo.createdUtcTimestamp,
+		consistencyLevel:    collInfo.consistencyLevel,
+		numPartitions:       collInfo.numPartitions,
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *MetaCache) GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
+	database = getDatabaseName(database)
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 
 	if !ok {
 		t0 := time.Now()
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			log.Warn("Failed to load collection from rootcoord ",
+				zap.String("database", database),
 				zap.String("collection name ", collectionName),
 				zap.Error(err))
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, database, collectionName)
 		log.Debug("Reload collection from rootcoord ",
+			zap.String("database", database),
 			zap.String("collection name ", collectionName),
 			zap.Any("time take ", time.Since(t0)))
 		return collInfo.schema, nil
@@ -162,34 +209,44 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
+// updateCollection caches the collection, the caller must hold the write lock.
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, database, collectionName string) *collectionInfo {
+	if _, ok := m.collInfo[database]; !ok {
+		m.collInfo[database] = map[string]*collectionInfo{}
+	}
+	collInfo, ok := m.collInfo[database][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{}
+		collInfo = &collectionInfo{}
+		m.collInfo[database][collectionName] = collInfo
 	}
-	m.collInfo[collectionName].schema = coll.Schema
-	m.collInfo[collectionName].collID = coll.CollectionID
-	m.collInfo[collectionName].createdTimestamp = coll.CreatedTimestamp
-	m.collInfo[collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	collInfo.schema = coll.Schema
+	collInfo.collID = coll.CollectionID
+	collInfo.dbID = coll.DbId
+	collInfo.createdTimestamp = coll.CreatedTimestamp
+	collInfo.createdUtcTimestamp = coll.CreatedUtcTimestamp
+	collInfo.consistencyLevel = coll.ConsistencyLevel
+	collInfo.numPartitions = coll.NumPartitions
+	return collInfo
 }
 
-func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
-	partInfo, err := m.GetPartitionInfo(ctx, collectionName, partitionName)
+func (m *MetaCache) GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	partInfo, err := m.GetPartitionInfo(ctx, database, collectionName, partitionName)
 	if err != nil {
 		return 0, err
 	}
 	return partInfo.partitionID, nil
 }
 
-func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error) {
+	database = getDatabaseName(database)
+	_, err := m.GetCollectionID(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -198,7 +255,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	if collInfo.partInfo == nil || len(collInfo.partInfo) == 0 {
 		m.mu.RUnlock()
 
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -206,14 +263,13 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		err = m.updatePartitions(partitions, collectionName)
+		collInfo, err = m.updatePartitions(partitions, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		log.Debug("proxy", zap.Any("GetPartitions:partitions after update", partitions), zap.Any("collectionName", collectionName))
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[collectionName].partInfo
-		for k, v := range partInfo {
+		for k, v := range collInfo.partInfo {
 			ret[k] = v.partitionID
 		}
 		return ret, nil
@@ -222,23 +278,23 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	defer m.mu.RUnlock()
 
 	ret := make(map[string]typeutil.UniqueID)
-	partInfo := m.collInfo[collectionName].partInfo
-	for k, v := range partInfo {
+	for k, v := range collInfo.partInfo {
 		ret[k] = v.partitionID
 	}
 
 	return ret, nil
 }
 
-func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error) {
+	database = getDatabaseName(database)
+	_, err := m.GetCollectionID(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -249,20 +305,20 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	m.mu.RUnlock()
 
 	if !ok {
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		err = m.updatePartitions(partitions, collectionName)
+		collInfo, err = m.updatePartitions(partitions, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.Any("collectionName", collectionName))
 
-		partInfo, ok = m.collInfo[collectionName].partInfo[partitionName]
+		partInfo, ok = collInfo.partInfo[partitionName]
 		if !ok {
 			return nil, fmt.Errorf("partitionID of partitionName:%s can not be find", partitionName)
 		}
@@ -274,11 +330,12 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	}, nil
 }
 
-func (m *MetaCache) describeCollection(ctx context.Context, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+func (m *MetaCache) describeCollection(ctx context.Context, database, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
 	req := &milvuspb.DescribeCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_DescribeCollection,
 		},
+		DbName:         database,
 		CollectionName: collectionName,
 	}
 	coll, err := m.client.DescribeCollection(ctx, req)
@@ -294,6 +351,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 			Name:        coll.Schema.Name,
 			Description: coll.Schema.Description,
 			AutoID:      coll.Schema.AutoID,
+			TtlSeconds:  coll.Schema.TtlSeconds,
 			Fields:      make([]*schemapb.FieldSchema, 0),
 		},
 		CollectionID:         coll.CollectionID,
@@ -301,6 +359,9 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 		PhysicalChannelNames: coll.PhysicalChannelNames,
 		CreatedTimestamp:     coll.CreatedTimestamp,
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
+		DbId:                 coll.DbId,
+		ConsistencyLevel:     coll.ConsistencyLevel,
+		NumPartitions:        coll.NumPartitions,
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= common.StartOfUserFieldID {
@@ -310,11 +371,12 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 	return resp, nil
 }
 
-func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
+func (m *MetaCache) showPartitions(ctx context.Context, database, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
 	req := &milvuspb.ShowPartitionsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_ShowPartitions,
 		},
+		DbName:         database,
 		CollectionName: collectionName,
 	}
 
@@ -334,21 +396,26 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string) error {
-	_, ok := m.collInfo[collectionName]
+// updatePartitions caches the partitions of the collection, the caller must hold the write lock.
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, database, collectionName string) (*collectionInfo, error) {
+	if _, ok := m.collInfo[database]; !ok {
+		m.collInfo[database] = map[string]*collectionInfo{}
+	}
+	collInfo, ok := m.collInfo[database][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{
+		collInfo = &collectionInfo{
 			partInfo: map[string]*partitionInfo{},
 		}
+		m.collInfo[database][collectionName] = collInfo
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		partInfo = map[string]*partitionInfo{}
 	}
 
 	// check partitionID, createdTimestamp and utcstamp has sam element numbers
 	if len(partitions.PartitionNames) != len(partitions.CreatedTimestamps) || len(partitions.PartitionNames) != len(partitions.CreatedUtcTimestamps) {
-		return errors.New("partition names and timestamps number is not aligned, response " + partitions.String())
+		return nil, errors.New("partition names and timestamps number is not aligned, response " + partitions.String())
 	}
 
 	for i := 0; i < len(partitions.PartitionIDs); i++ {
@@ -360,26 +427,194 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 			}
 		}
 	}
-	m.collInfo[collectionName].partInfo = partInfo
-	return nil
+	collInfo.partInfo = partInfo
+	return collInfo, nil
 }
 
-func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
+func (m *MetaCache) RemoveCollection(ctx context.Context, database, collectionName string) {
+	database = getDatabaseName(database)
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	delete(m.collInfo, collectionName)
+	delete(m.collInfo[database], collectionName)
 }
 
-func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
+func (m *MetaCache) RemovePartition(ctx context.Context, database, collectionName, partitionName string) {
+	database = getDatabaseName(database)
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	_, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	if !ok {
 		return
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		return
 	}
 	delete(partInfo, partitionName)
 }
+
+func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error) {
+	m.credMut.RLock()
+	credInfo, ok := m.credMap[username]
+	m.credMut.RUnlock()
+
+	if !ok {
+		req := &rootcoordpb.GetCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GetCredential,
+			},
+			Username: username,
+		}
+		resp, err := m.client.GetCredential(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, errors.New(resp.Status.Reason)
+		}
+		credInfo = &internalpb.CredentialInfo{
+			Username:          resp.Username,
+			EncryptedPassword: resp.Password,
+		}
+		m.UpdateCredential(credInfo)
+	}
+
+	return &internalpb.CredentialInfo{
+		Username:          credInfo.Username,
+		EncryptedPassword: credInfo.EncryptedPassword,
+		Sha256Password:    credInfo.Sha256Password,
+	}, nil
+}
+
+func (m *MetaCache) RemoveCredential(username string) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	delete(m.credMap, username)
+}
+
+func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	m.credMap[credInfo.Username] = &internalpb.CredentialInfo{
+		Username:          credInfo.Username,
+		EncryptedPassword: credInfo.EncryptedPassword,
+		Sha256Password:    credInfo.Sha256Password,
+	}
+}
+
+// loadPolicy loads all the grants and the user-role bindings from RootCoord, the caller should hold the write lock.
+func (m *MetaCache) loadPolicy(ctx context.Context) error {
+	req := &internalpb.ListPolicyRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_ListPolicy,
+		},
+	}
+	resp, err := m.client.ListPolicy(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+	m.privilegeInfos = make(map[string]struct{}, len(resp.PolicyInfos))
+	for _, policy := range resp.PolicyInfos {
+		m.privilegeInfos[policy] = struct{}{}
+	}
+	m.userToRoles = make(map[string]map[string]struct{})
+	for _, userRole := range resp.UserRoles {
+		username, roleName, err := funcutil.DecodeUserRoleCache(userRole)
+		if err != nil {
+			return err
+		}
+		m.bindUserRole(username, roleName)
+	}
+	m.policyLoaded = true
+	return nil
+}
+
+func (m *MetaCache) bindUserRole(username string, roleName string) {
+	if _, ok := m.userToRoles[username]; !ok {
+		m.userToRoles[username] = make(map[string]struct{})
+	}
+	m.userToRoles[username][roleName] = struct{}{}
+}
+
+func (m *MetaCache) ensurePolicyLoaded(ctx context.Context) error {
+	m.privilegeMut.RLock()
+	loaded := m.policyLoaded
+	m.privilegeMut.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	m.privilegeMut.Lock()
+	defer m.privilegeMut.Unlock()
+	if m.policyLoaded {
+		return nil
+	}
+	return m.loadPolicy(ctx)
+}
+
+func (m *MetaCache) GetUserRoles(ctx context.Context, username string) ([]string, error) {
+	if err := m.ensurePolicyLoaded(ctx); err != nil {
+		return nil, err
+	}
+	m.privilegeMut.RLock()
+	defer m.privilegeMut.RUnlock()
+	roles := make([]string, 0, len(m.userToRoles[username]))
+	for roleName := range m.userToRoles[username] {
+		roles = append(roles, roleName)
+	}
+	return roles, nil
+}
+
+func (m *MetaCache) HasPolicy(ctx context.Context, policy string) (bool, error) {
+	if err := m.ensurePolicyLoaded(ctx); err != nil {
+		return false, err
+	}
+	m.privilegeMut.RLock()
+	defer m.privilegeMut.RUnlock()
+	_, ok := m.privilegeInfos[policy]
+	return ok, nil
+}
+
+func (m *MetaCache) RefreshPolicyInfo(opType typeutil.CacheOpType, opKey string) error {
+	m.privilegeMut.Lock()
+	defer m.privilegeMut.Unlock()
+	// the whole cache will be loaded on the first use
+	if !m.policyLoaded {
+		return nil
+	}
+
+	switch opType {
+	case typeutil.CacheAddUserToRole, typeutil.CacheRemoveUserFromRole:
+		username, roleName, err := funcutil.DecodeUserRoleCache(opKey)
+		if err != nil {
+			return err
+		}
+		if opType == typeutil.CacheAddUserToRole {
+			m.bindUserRole(username, roleName)
+		} else if roles, ok := m.userToRoles[username]; ok {
+			delete(roles, roleName)
+		}
+	case typeutil.CacheGrantPrivilege:
+		m.privilegeInfos[opKey] = struct{}{}
+	case typeutil.CacheRevokePrivilege:
+		delete(m.privilegeInfos, opKey)
+	case typeutil.CacheDeleteUser:
+		delete(m.userToRoles, opKey)
+	case typeutil.CacheDropRole:
+		for _, roles := range m.userToRoles {
+			delete(roles, opKey)
+		}
+		for policy := range m.privilegeInfos {
+			roleName, _, _, _, err := funcutil.DecodePolicy(policy)
+			if err == nil && roleName == opKey {
+				delete(m.privilegeInfos, policy)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid cache op type: %d", opType)
+	}
+	return nil
+}
@@ -22,11 +22,16 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
@@ -90,6 +95,18 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 		return nil, errors.New("mocked error")
 	}
 	m.AccessCount++
+	if in.DbName == "db1" && in.CollectionName == "collection1" {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			CollectionID: typeutil.UniqueID(10),
+			DbId:         typeutil.UniqueID(1),
+			Schema: &schemapb.CollectionSchema{
+				AutoID: true,
+			},
+		}, nil
+	}
 	if in.CollectionName == "collection1" {
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
@@ -110,6 +127,7 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 			Schema: &schemapb.CollectionSchema{
 				AutoID: true,
 			},
+			ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
 		}, nil
 	}
 	if in.CollectionName == "errorCollection" {
@@ -134,6 +152,52 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 	}, nil
 }
 
+func (m *MockRootCoordClientInterface) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	if m.Error {
+		return nil, errors.New("mocked error")
+	}
+	m.AccessCount++
+	if req.Username == "mockUser" {
+		encryptedPassword, _ := crypto.PasswordEncrypt("mockPass")
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			Username: "mockUser",
+			Password: encryptedPassword,
+		}, nil
+	}
+
+	err := fmt.Errorf("can't find credential: " + req.Username)
+	return &rootcoordpb.GetCredentialResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_GetCredentialFailure,
+			Reason:    err.Error(),
+		},
+	}, nil
+}
+
+func (m *MockRootCoordClientInterface) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+	if m.Error {
+		return nil, errors.New("mocked error")
+	}
+	m.AccessCount++
+	return &internalpb.ListPolicyResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		PolicyInfos: []string{
+			funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "default.col1", "Insert"),
+			funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Global.String(), "*", "CreateCollection"),
+			funcutil.PolicyForPrivilege(common.RolePublic, commonpb.ObjectType_Collection.String(), "default.*", "Query"),
+		},
+		UserRoles: []string{
+			funcutil.EncodeUserRoleCache("mockUser", "role1"),
+			funcutil.EncodeUserRoleCache("adminUser", common.RoleAdmin),
+		},
+	}, nil
+}
+
 //Simulate the cache path and the
 func TestMetaCache_GetCollection(t *testing.T) {
 	ctx := context.Background()
@@ -141,24 +205,24 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
 	assert.Equal(t, client.AccessCount, 1)
 
 	// should'nt be accessed to remote root coord.
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 1)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
 		Fields: []*schemapb.FieldSchema{},
 	})
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection2")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection2")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection2")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection2")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -167,11 +231,11 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	})
 
 	// test to get from cache, this should trigger root request
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -181,6 +245,42 @@ func TestMetaCache_GetCollection(t *testing.T) {
 
 }
 
+func TestMetaCache_GetCollectionInDatabase(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	info, err := globalMetaCache.GetCollectionInfo(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), info.collID)
+	assert.Equal(t, common.DefaultDBID, info.dbID)
+	assert.Equal(t, 1, client.AccessCount)
+
+	// the same name in another database is a different collection
+	info, err = globalMetaCache.GetCollectionInfo(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(10), info.collID)
+	assert.Equal(t, typeutil.UniqueID(1), info.dbID)
+	assert.Equal(t, 2, client.AccessCount)
+
+	// the empty database name is the default database
+	id, err := globalMetaCache.GetCollectionID(ctx, common.DefaultDBName, "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	assert.Equal(t, 2, client.AccessCount)
+
+	globalMetaCache.RemoveCollection(ctx, "db1", "collection1")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	assert.Equal(t, 2, client.AccessCount)
+	id, err = globalMetaCache.GetCollectionID(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(10), id)
+	assert.Equal(t, 3, client.AccessCount)
+}
+
 func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	ctx := context.Background()
 	client := &MockRootCoordClientInterface{}
@@ -188,13 +288,13 @@ func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	assert.Nil(t, err)
 	client.Error = true
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 
 	client.Error = false
 
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
@@ -216,10 +316,10 @@ func TestMetaCache_GetNonExistCollection(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection3")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, int64(0))
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection3")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection3")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 }
@@ -230,16 +330,16 @@ func TestMetaCache_GetPartitionID(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetPartitionID(ctx, "collection1", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "collection1", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(3))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(4))
 }
@@ -251,25 +351,139 @@ func TestMetaCache_GetPartitionError(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Test the case where ShowPartitionsResponse is not aligned
-	id, err := globalMetaCache.GetPartitionID(ctx, "errorCollection", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "errorCollection", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
-	partitions, err2 := globalMetaCache.GetPartitions(ctx, "errorCollection")
+	partitions, err2 := globalMetaCache.GetPartitions(ctx, "", "errorCollection")
 	assert.NotNil(t, err2)
 	log.Debug(err.Error())
 	assert.Equal(t, len(partitions), 0)
 
 	// Test non existed tables
-	id, err = globalMetaCache.GetPartitionID(ctx, "nonExisted", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "nonExisted", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
 	// Test non existed partition
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par3")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
+
+func TestMetaCache_GetCredential(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, "mockUser", credInfo.Username)
+	assert.True(t, crypto.PasswordVerify("mockPass", credInfo.EncryptedPassword))
+	assert.Equal(t, 1, client.AccessCount)
+
+	// should be served from the cache
+	credInfo.Sha256Password = crypto.SHA256("mockPass", "mockUser")
+	globalMetaCache.UpdateCredential(credInfo)
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.SHA256("mockPass", "mockUser"), credInfo.Sha256Password)
+	assert.Equal(t, 1, client.AccessCount)
+
+	// should be fetched from root coord again after removed
+	globalMetaCache.RemoveCredential("mockUser")
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, "", credInfo.Sha256Password)
+	assert.Equal(t, 2, client.AccessCount)
+
+	_, err = globalMetaCache.GetCredentialInfo(ctx, "nonExistUser")
+	assert.NotNil(t, err)
+
+	client.Error = true
+	globalMetaCache.RemoveCredential("mockUser")
+	_, err = globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+	assert.NotNil(t, err)
+
+	globalMetaCache.UpdateCredential(&internalpb.CredentialInfo{Username: "mockUser", EncryptedPassword: "pwd"})
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, "pwd", credInfo.EncryptedPassword)
+}
+
+func TestMetaCache_PolicyInfo(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	// loaded on the first use
+	roles, err := globalMetaCache.GetUserRoles(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"role1"}, roles)
+	assert.Equal(t, 1, client.AccessCount)
+
+	insertPolicy := funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "default.col1", "Insert")
+	ok, err := globalMetaCache.HasPolicy(ctx, insertPolicy)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, client.AccessCount)
+
+	err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheRevokePrivilege, insertPolicy)
+	assert.Nil(t, err)
+	ok, err = globalMetaCache.HasPolicy(ctx, insertPolicy)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheGrantPrivilege, insertPolicy)
+	assert.Nil(t, err)
+	ok, err = globalMetaCache.HasPolicy(ctx, insertPolicy)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheAddUserToRole, funcutil.EncodeUserRoleCache("mockUser", "role2"))
+	assert.Nil(t, err)
+	roles, err = globalMetaCache.GetUserRoles(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"role1", "role2"}, roles)
+
+	err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheRemoveUserFromRole, funcutil.EncodeUserRoleCache("mockUser", "role2"))
+	assert.Nil(t, err)
+	roles, err = globalMetaCache.GetUserRoles(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"role1"}, roles)
+
+	err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheDropRole, "role1")
+	assert.Nil(t, err)
+	roles, err = globalMetaCache.GetUserRoles(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Empty(t, roles)
+	ok, err = globalMetaCache.HasPolicy(ctx, insertPolicy)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheDeleteUser, "adminUser")
+	assert.Nil(t, err)
+	roles, err = globalMetaCache.GetUserRoles(ctx, "adminUser")
+	assert.Nil(t, err)
+	assert.Empty(t, roles)
+
+	err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOpType(100), "")
+	assert.NotNil(t, err)
+	err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheAddUserToRole, "invalid")
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, client.AccessCount)
+
+	// failed to load
+	client.Error = true
+	err = InitMetaCache(client)
+	assert.Nil(t, err)
+	_, err = globalMetaCache.GetUserRoles(ctx, "mockUser")
+	assert.NotNil(t, err)
+	_, err = globalMetaCache.HasPolicy(ctx, insertPolicy)
+	assert.NotNil(t, err)
+}
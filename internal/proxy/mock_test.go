@@ -19,6 +19,7 @@ package proxy
 import (
 	"context"
 	"math/rand"
+	"strconv"
 	"sync"
 	"time"
 
@@ -448,6 +449,14 @@ func generateFloat64Array(numRows int) []float64 {
 	return ret
 }
 
+func generateStringArray(numRows int) []string {
+	ret := make([]string, 0, numRows)
+	for i := 0; i < numRows; i++ {
+		ret = append(ret, strconv.Itoa(i))
+	}
+	return ret
+}
+
 func generateFloatVectors(numRows, dim int) []float32 {
 	total := numRows * dim
 	ret := make([]float32, 0, total)
@@ -545,6 +554,16 @@ func newScalarFieldData(dType schemapb.DataType, fieldName string, numRows int)
 				},
 			},
 		}
+	case schemapb.DataType_String:
+		ret.Field = &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{
+						Data: generateStringArray(numRows),
+					},
+				},
+			},
+		}
 	}
 
 	return ret
@@ -58,8 +58,28 @@ type ParamTable struct {
 	MaxFieldNum              int64
 	MaxShardNum              int32
 	MaxDimension             int64
+	MaxTopK                  int64
 	DefaultPartitionName     string
 	DefaultIndexName         string
+	MaxUsernameLength        int64
+	MinPasswordLength        int64
+	MaxPasswordLength        int64
+	MaxRoleNameLength        int64
+	MaxDeleteBatchSize       int64
+	DeleteByExprBatchSize    int64
+	GroupBySearchFactor      int64
+	AuthorizationEnabled     bool
+	InternalToken            string
+	GracefulTime             int64
+	QueryIteratorTTL         int64
+	QueryIteratorBatchSize   int64
+
+	// --- Rate limits, 0 means unlimited ---
+	MaxInsertRowsRate  float64
+	MaxInsertBytesRate float64
+	MaxDeleteOpsRate   float64
+	MaxSearchQPS       float64
+	MaxQueryQPS        float64
 
 	// --- Channels ---
 	ClusterChannelPrefix      string
@@ -107,8 +127,22 @@ func (pt *ParamTable) Init() {
 	pt.initMaxFieldNum()
 	pt.initMaxShardNum()
 	pt.initMaxDimension()
+	pt.initMaxTopK()
 	pt.initDefaultPartitionName()
 	pt.initDefaultIndexName()
+	pt.initMaxUsernameLength()
+	pt.initMinPasswordLength()
+	pt.initMaxPasswordLength()
+	pt.initMaxRoleNameLength()
+	pt.initMaxDeleteBatchSize()
+	pt.initDeleteByExprBatchSize()
+	pt.initGroupBySearchFactor()
+	pt.initAuthorizationEnabled()
+	pt.initInternalToken()
+	pt.initGracefulTime()
+	pt.initQueryIteratorTTL()
+	pt.initQueryIteratorBatchSize()
+	pt.initRateLimits()
 
 	pt.initPulsarMaxMessageSize()
 
@@ -210,6 +244,11 @@ func (pt *ParamTable) initMaxDimension() {
 	pt.MaxDimension = maxDimension
 }
 
+// initMaxTopK initializes the max number of results a search can return, including the skipped offset
+func (pt *ParamTable) initMaxTopK() {
+	pt.MaxTopK = pt.ParseInt64WithDefault("proxy.maxTopK", 16384)
+}
+
 func (pt *ParamTable) initDefaultPartitionName() {
 	name, err := pt.Load("common.defaultPartitionName")
 	if err != nil {
@@ -226,6 +265,69 @@ func (pt *ParamTable) initDefaultIndexName() {
 	pt.DefaultIndexName = name
 }
 
+func (pt *ParamTable) initMaxUsernameLength() {
+	pt.MaxUsernameLength = pt.ParseInt64WithDefault("proxy.maxUsernameLength", 32)
+}
+
+func (pt *ParamTable) initMinPasswordLength() {
+	pt.MinPasswordLength = pt.ParseInt64WithDefault("proxy.minPasswordLength", 6)
+}
+
+func (pt *ParamTable) initMaxPasswordLength() {
+	pt.MaxPasswordLength = pt.ParseInt64WithDefault("proxy.maxPasswordLength", 256)
+}
+
+func (pt *ParamTable) initMaxRoleNameLength() {
+	pt.MaxRoleNameLength = pt.ParseInt64WithDefault("proxy.maxRoleNameLength", 32)
+}
+
+// initMaxDeleteBatchSize initializes the max number of primary keys in a single DeleteMsg
+func (pt *ParamTable) initMaxDeleteBatchSize() {
+	pt.MaxDeleteBatchSize = pt.ParseInt64WithDefault("proxy.maxDeleteBatchSize", 10000)
+}
+
+// initDeleteByExprBatchSize initializes the number of entities retrieved at once by a delete by expression
+func (pt *ParamTable) initDeleteByExprBatchSize() {
+	pt.DeleteByExprBatchSize = pt.ParseInt64WithDefault("proxy.deleteByExprBatchSize", 100000)
+}
+
+// initGroupBySearchFactor initializes the number of candidates searched for each group in grouped search
+func (pt *ParamTable) initGroupBySearchFactor() {
+	pt.GroupBySearchFactor = pt.ParseInt64WithDefault("proxy.groupBySearchFactor", 10)
+}
+
+// initRateLimits initializes the default global rate limits of the proxy
+func (pt *ParamTable) initRateLimits() {
+	pt.MaxInsertRowsRate = pt.ParseFloatWithDefault("proxy.rateLimit.insertRows", 0)
+	pt.MaxInsertBytesRate = pt.ParseFloatWithDefault("proxy.rateLimit.insertBytes", 0)
+	pt.MaxDeleteOpsRate = pt.ParseFloatWithDefault("proxy.rateLimit.deleteOps", 0)
+	pt.MaxSearchQPS = pt.ParseFloatWithDefault("proxy.rateLimit.searchQPS", 0)
+	pt.MaxQueryQPS = pt.ParseFloatWithDefault("proxy.rateLimit.queryQPS", 0)
+}
+
+// initGracefulTime initializes the staleness in milliseconds of the Bounded consistency level
+func (pt *ParamTable) initGracefulTime() {
+	pt.GracefulTime = pt.ParseInt64WithDefault("proxy.gracefulTime", 5000)
+}
+
+// initQueryIteratorTTL initializes the seconds a query iterator expires after its last batch
+func (pt *ParamTable) initQueryIteratorTTL() {
+	pt.QueryIteratorTTL = pt.ParseInt64WithDefault("proxy.queryIterator.ttl", 600)
+}
+
+// initQueryIteratorBatchSize initializes the default number of entities of each batch of a query iterator
+func (pt *ParamTable) initQueryIteratorBatchSize() {
+	pt.QueryIteratorBatchSize = pt.ParseInt64WithDefault("proxy.queryIterator.batchSize", 1000)
+}
+
+func (pt *ParamTable) initAuthorizationEnabled() {
+	pt.AuthorizationEnabled = pt.ParseBool("common.security.authorizationEnabled", false)
+}
+
+func (pt *ParamTable) initInternalToken() {
+	pt.InternalToken = pt.LoadWithDefault("common.security.internalToken", "")
+}
+
 func (pt *ParamTable) initPulsarMaxMessageSize() {
 	// pulsarHost, err := pt.Load("pulsar.address")
 	// if err != nil {
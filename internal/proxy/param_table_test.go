@@ -75,6 +75,18 @@ func TestParamTable_Normal(t *testing.T) {
 		t.Logf("MaxDimension: %d", Params.MaxDimension)
 	})
 
+	t.Run("MaxTopK", func(t *testing.T) {
+		assert.Equal(t, int64(16384), Params.MaxTopK)
+	})
+
+	t.Run("MaxDeleteBatchSize", func(t *testing.T) {
+		assert.Equal(t, int64(10000), Params.MaxDeleteBatchSize)
+	})
+
+	t.Run("GroupBySearchFactor", func(t *testing.T) {
+		assert.Equal(t, int64(10), Params.GroupBySearchFactor)
+	})
+
 	t.Run("DefaultPartitionName", func(t *testing.T) {
 		t.Logf("DefaultPartitionName: %s", Params.DefaultPartitionName)
 	})
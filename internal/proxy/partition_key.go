@@ -0,0 +1,242 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// getPartitionKeyPartitionIDs returns the ids of the partitions of a collection with a partition key, the i-th id
+// is the one of the partition the rows hashed to i are routed to
+func getPartitionKeyPartitionIDs(ctx context.Context, dbName, collectionName string, numPartitions int64) ([]UniqueID, error) {
+	partitions, err := globalMetaCache.GetPartitions(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	partitionIDs := make([]UniqueID, 0, numPartitions)
+	for _, name := range typeutil.PartitionKeyPartitionNames(Params.DefaultPartitionName, numPartitions) {
+		partitionID, ok := partitions[name]
+		if !ok {
+			return nil, fmt.Errorf("partition %s of collection %s not found", name, collectionName)
+		}
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+	return partitionIDs, nil
+}
+
+// hashPartitionKeys returns the index of the partition each row is routed to by its partition key
+func hashPartitionKeys(fieldData *schemapb.FieldData, numPartitions int64) ([]int64, error) {
+	var keys []interface{}
+	switch fieldData.GetType() {
+	case schemapb.DataType_Int64:
+		for _, key := range fieldData.GetScalars().GetLongData().GetData() {
+			keys = append(keys, key)
+		}
+	case schemapb.DataType_String:
+		for _, key := range fieldData.GetScalars().GetStringData().GetData() {
+			keys = append(keys, key)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported partition key type: %s", fieldData.GetType().String())
+	}
+
+	indexes := make([]int64, 0, len(keys))
+	for _, key := range keys {
+		idx, err := typeutil.HashKey2Partition(key, numPartitions)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, idx)
+	}
+	return indexes, nil
+}
+
+// partitionIndexesOfExpr returns the indexes of the partitions the entities matching the expression can be found in,
+// ok is false if the expression doesn't pin the partition key to some values
+func partitionIndexesOfExpr(expr *planpb.Expr, keyFieldID int64, numPartitions int64) (map[int64]struct{}, bool) {
+	hashValues := func(values []*planpb.GenericValue) (map[int64]struct{}, bool) {
+		indexes := make(map[int64]struct{})
+		for _, value := range values {
+			var key interface{}
+			switch v := value.GetVal().(type) {
+			case *planpb.GenericValue_Int64Val:
+				key = v.Int64Val
+			case *planpb.GenericValue_StringVal:
+				key = v.StringVal
+			default:
+				return nil, false
+			}
+			idx, err := typeutil.HashKey2Partition(key, numPartitions)
+			if err != nil {
+				return nil, false
+			}
+			indexes[idx] = struct{}{}
+		}
+		return indexes, true
+	}
+
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryRangeExpr:
+		if e.UnaryRangeExpr.GetColumnInfo().GetFieldId() != keyFieldID || e.UnaryRangeExpr.GetOp() != planpb.OpType_Equal {
+			return nil, false
+		}
+		return hashValues([]*planpb.GenericValue{e.UnaryRangeExpr.GetValue()})
+	case *planpb.Expr_TermExpr:
+		if e.TermExpr.GetColumnInfo().GetFieldId() != keyFieldID {
+			return nil, false
+		}
+		return hashValues(e.TermExpr.GetValues())
+	case *planpb.Expr_BinaryExpr:
+		left, leftOk := partitionIndexesOfExpr(e.BinaryExpr.GetLeft(), keyFieldID, numPartitions)
+		right, rightOk := partitionIndexesOfExpr(e.BinaryExpr.GetRight(), keyFieldID, numPartitions)
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			if !leftOk {
+				return right, rightOk
+			}
+			if !rightOk {
+				return left, leftOk
+			}
+			indexes := make(map[int64]struct{})
+			for idx := range left {
+				if _, ok := right[idx]; ok {
+					indexes[idx] = struct{}{}
+				}
+			}
+			return indexes, true
+		case planpb.BinaryExpr_LogicalOr:
+			if !leftOk || !rightOk {
+				return nil, false
+			}
+			for idx := range right {
+				left[idx] = struct{}{}
+			}
+			return left, true
+		}
+	}
+	return nil, false
+}
+
+// prunePartitionsByPartitionKey returns the ids of the partitions a search or query with the predicates has to visit,
+// it returns nil if the collection has no partition key or the predicates don't pin it, then all partitions are visited
+func prunePartitionsByPartitionKey(ctx context.Context, dbName, collectionName string, schema *schemapb.CollectionSchema,
+	predicates *planpb.Expr) ([]UniqueID, error) {
+	keyField := typeutil.GetPartitionKeyField(schema)
+	if keyField == nil || predicates == nil {
+		return nil, nil
+	}
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	indexes, ok := partitionIndexesOfExpr(predicates, keyField.GetFieldID(), collInfo.numPartitions)
+	if !ok {
+		return nil, nil
+	}
+	partitionIDs, err := getPartitionKeyPartitionIDs(ctx, dbName, collectionName, collInfo.numPartitions)
+	if err != nil {
+		return nil, err
+	}
+	pruned := make([]UniqueID, 0, len(indexes))
+	for idx := range indexes {
+		pruned = append(pruned, partitionIDs[idx])
+	}
+	sort.Slice(pruned, func(i, j int) bool { return pruned[i] < pruned[j] })
+	return pruned, nil
+}
+
+// assignPartitionsByPartitionKey hashes the partition keys of the inserted rows to the partitions they're routed to,
+// the partition can't be specified by the request if the collection has a partition key
+func (it *insertTask) assignPartitionsByPartitionKey(ctx context.Context) error {
+	keyField := typeutil.GetPartitionKeyField(it.schema)
+	if keyField == nil {
+		return nil
+	}
+	if it.req.GetPartitionName() != "" {
+		return fmt.Errorf("not allowed to specify the partition name when the collection %s has a partition key", it.CollectionName)
+	}
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, it.GetDbName(), it.CollectionName)
+	if err != nil {
+		return err
+	}
+	for _, fieldData := range it.req.GetFieldsData() {
+		if fieldData.GetFieldName() == keyField.GetName() {
+			it.partitionIndexes, err = hashPartitionKeys(fieldData, collInfo.numPartitions)
+			if err != nil {
+				return err
+			}
+			it.numPartitions = collInfo.numPartitions
+			return nil
+		}
+	}
+	return fmt.Errorf("the partition key field %s is missing", keyField.GetName())
+}
+
+// insertMsgs returns the insert msgs of the task, the rows are split into one msg per partition if they're routed
+// by the partition key, otherwise all of them are sent to the partition of the task
+func (it *insertTask) insertMsgs(ctx context.Context) ([]msgstream.TsMsg, error) {
+	if it.partitionIndexes == nil {
+		return []msgstream.TsMsg{&it.BaseInsertTask}, nil
+	}
+	if len(it.partitionIndexes) != len(it.RowData) {
+		return nil, fmt.Errorf("the number of partition keys %d doesn't match the number of rows %d", len(it.partitionIndexes), len(it.RowData))
+	}
+	partitionIDs, err := getPartitionKeyPartitionIDs(ctx, it.GetDbName(), it.CollectionName, it.numPartitions)
+	if err != nil {
+		return nil, err
+	}
+	partitionNames := typeutil.PartitionKeyPartitionNames(Params.DefaultPartitionName, it.numPartitions)
+
+	msgs := make([]msgstream.TsMsg, 0)
+	partitionMsgs := make(map[int64]*msgstream.InsertMsg)
+	for row, idx := range it.partitionIndexes {
+		msg, ok := partitionMsgs[idx]
+		if !ok {
+			msg = &msgstream.InsertMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx:            it.BaseMsg.Ctx,
+					BeginTimestamp: it.BeginTimestamp,
+					EndTimestamp:   it.EndTimestamp,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base:           it.Base,
+					DbName:         it.DbName,
+					CollectionName: it.CollectionName,
+					PartitionName:  partitionNames[idx],
+					DbID:           it.DbID,
+					CollectionID:   it.CollectionID,
+					PartitionID:    partitionIDs[idx],
+				},
+			}
+			partitionMsgs[idx] = msg
+			msgs = append(msgs, msg)
+		}
+		msg.HashValues = append(msg.HashValues, it.HashValues[row])
+		msg.Timestamps = append(msg.Timestamps, it.Timestamps[row])
+		msg.RowIDs = append(msg.RowIDs, it.RowIDs[row])
+		msg.RowData = append(msg.RowData, it.RowData[row])
+	}
+	return msgs, nil
+}
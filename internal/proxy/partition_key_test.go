@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const partitionKeyTestPartitions = int64(4)
+
+func partitionKeyTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "partition_key_coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_String, IsPartitionKey: true,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+}
+
+type partitionKeyRootCoord struct {
+	types.RootCoord
+}
+
+func (m *partitionKeyRootCoord) DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	return &milvuspb.DescribeCollectionResponse{
+		Status:        &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		CollectionID:  1,
+		Schema:        partitionKeyTestSchema(),
+		NumPartitions: partitionKeyTestPartitions,
+	}, nil
+}
+
+func (m *partitionKeyRootCoord) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	resp := &milvuspb.ShowPartitionsResponse{
+		Status:         &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		PartitionNames: typeutil.PartitionKeyPartitionNames(Params.DefaultPartitionName, partitionKeyTestPartitions),
+	}
+	for i := range resp.PartitionNames {
+		resp.PartitionIDs = append(resp.PartitionIDs, int64(1000+i))
+		resp.CreatedTimestamps = append(resp.CreatedTimestamps, 0)
+		resp.CreatedUtcTimestamps = append(resp.CreatedUtcTimestamps, 0)
+	}
+	return resp, nil
+}
+
+func hashTenant(t *testing.T, tenant string) int64 {
+	idx, err := typeutil.HashKey2Partition(tenant, partitionKeyTestPartitions)
+	assert.NoError(t, err)
+	return idx
+}
+
+func TestPartitionIndexesOfExpr(t *testing.T) {
+	schema := partitionKeyTestSchema()
+	indexesOf := func(expr string) (map[int64]struct{}, bool) {
+		plan, err := createExprPlan(schema, expr)
+		assert.NoError(t, err)
+		return partitionIndexesOfExpr(plan.GetPredicates(), 101, partitionKeyTestPartitions)
+	}
+	set := func(indexes ...int64) map[int64]struct{} {
+		s := make(map[int64]struct{})
+		for _, idx := range indexes {
+			s[idx] = struct{}{}
+		}
+		return s
+	}
+
+	indexes, ok := indexesOf(`tenant == "a"`)
+	assert.True(t, ok)
+	assert.Equal(t, set(hashTenant(t, "a")), indexes)
+
+	indexes, ok = indexesOf(`tenant in ["a", "b"]`)
+	assert.True(t, ok)
+	assert.Equal(t, set(hashTenant(t, "a"), hashTenant(t, "b")), indexes)
+
+	indexes, ok = indexesOf(`tenant == "a" && age > 1`)
+	assert.True(t, ok)
+	assert.Equal(t, set(hashTenant(t, "a")), indexes)
+
+	indexes, ok = indexesOf(`tenant == "a" || tenant == "b"`)
+	assert.True(t, ok)
+	assert.Equal(t, set(hashTenant(t, "a"), hashTenant(t, "b")), indexes)
+
+	_, ok = indexesOf(`tenant == "a" || age > 1`)
+	assert.False(t, ok)
+	_, ok = indexesOf(`age == 1`)
+	assert.False(t, ok)
+	_, ok = indexesOf(`tenant > "a"`)
+	assert.False(t, ok)
+}
+
+func TestHashPartitionKeys(t *testing.T) {
+	fieldData := &schemapb.FieldData{
+		Type:      schemapb.DataType_String,
+		FieldName: "tenant",
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}}}},
+	}
+	indexes, err := hashPartitionKeys(fieldData, partitionKeyTestPartitions)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{hashTenant(t, "a"), hashTenant(t, "b")}, indexes)
+
+	fieldData.Type = schemapb.DataType_Float
+	_, err = hashPartitionKeys(fieldData, partitionKeyTestPartitions)
+	assert.Error(t, err)
+}
+
+func TestPrunePartitionsByPartitionKey(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	err := InitMetaCache(&partitionKeyRootCoord{})
+	assert.NoError(t, err)
+	schema := partitionKeyTestSchema()
+
+	plan, err := createExprPlan(schema, `tenant == "a" && age > 1`)
+	assert.NoError(t, err)
+	partitionIDs, err := prunePartitionsByPartitionKey(ctx, "", schema.Name, schema, plan.GetPredicates())
+	assert.NoError(t, err)
+	assert.Equal(t, []UniqueID{1000 + hashTenant(t, "a")}, partitionIDs)
+
+	plan, err = createExprPlan(schema, `age > 1`)
+	assert.NoError(t, err)
+	partitionIDs, err = prunePartitionsByPartitionKey(ctx, "", schema.Name, schema, plan.GetPredicates())
+	assert.NoError(t, err)
+	assert.Nil(t, partitionIDs)
+}
+
+func TestInsertTask_PartitionKey(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	err := InitMetaCache(&partitionKeyRootCoord{})
+	assert.NoError(t, err)
+
+	tenants := []string{"a", "b", "c", "a"}
+	it := &insertTask{
+		ctx: ctx,
+		req: &milvuspb.InsertRequest{
+			CollectionName: "partition_key_coll",
+			FieldsData: []*schemapb.FieldData{{
+				Type:      schemapb.DataType_String,
+				FieldName: "tenant",
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: tenants}}}},
+			}},
+		},
+		BaseInsertTask: BaseInsertTask{
+			BaseMsg: msgstream.BaseMsg{HashValues: []uint32{0, 1, 2, 3}},
+			InsertRequest: internalpb.InsertRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+				CollectionName: "partition_key_coll",
+				CollectionID:   1,
+				Timestamps:     []uint64{1, 1, 1, 1},
+				RowIDs:         []int64{10, 11, 12, 13},
+				RowData:        []*commonpb.Blob{{Value: []byte{0}}, {Value: []byte{1}}, {Value: []byte{2}}, {Value: []byte{3}}},
+			},
+		},
+		schema: partitionKeyTestSchema(),
+	}
+	err = it.assignPartitionsByPartitionKey(ctx)
+	assert.NoError(t, err)
+
+	msgs, err := it.insertMsgs(ctx)
+	assert.NoError(t, err)
+	rows := 0
+	for _, msg := range msgs {
+		insertMsg := msg.(*msgstream.InsertMsg)
+		for i, rowID := range insertMsg.RowIDs {
+			idx := hashTenant(t, tenants[rowID-10])
+			assert.Equal(t, 1000+idx, insertMsg.PartitionID)
+			assert.Equal(t, typeutil.PartitionKeyPartitionNames(Params.DefaultPartitionName, partitionKeyTestPartitions)[idx], insertMsg.PartitionName)
+			assert.Equal(t, uint32(rowID-10), insertMsg.HashValues[i])
+			rows++
+		}
+	}
+	assert.Equal(t, len(tenants), rows)
+
+	// the partition can't be specified when the rows are routed by the partition key
+	it.req.PartitionName = "p1"
+	err = it.assignPartitionsByPartitionKey(ctx)
+	assert.Error(t, err)
+
+	// the rows of the collection without a partition key are sent to the partition of the task
+	it.schema = &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}}}
+	it.partitionIndexes = nil
+	err = it.assignPartitionsByPartitionKey(ctx)
+	assert.NoError(t, err)
+	msgs, err = it.insertMsgs(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []msgstream.TsMsg{&it.BaseInsertTask}, msgs)
+}
@@ -19,6 +19,8 @@ package proxy
 import (
 	"fmt"
 	"math"
+	"regexp"
+	"strconv"
 	"strings"
 
 	ant_ast "github.com/antonmedv/expr/ast"
@@ -169,11 +171,41 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 	}
 }
 
+// literalPattern matches the string literals of an expression
+var literalPattern = regexp.MustCompile(`"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'`)
+
+// nullPattern matches the `field is null` and `field is not null` predicates
+var nullPattern = regexp.MustCompile(`(?i)\b([a-z_][a-z0-9_]*)\s+is\s+(not\s+)?null\b`)
+
+// rewriteNullPredicates rewrites `field is null` and `field is not null` into `(field == nil)` and
+// `(field != nil)`, which the expression parser understands. The string literals are left untouched.
+func rewriteNullPredicates(exprStr string) string {
+	rewrite := func(s string) string {
+		return nullPattern.ReplaceAllStringFunc(s, func(predicate string) string {
+			groups := nullPattern.FindStringSubmatch(predicate)
+			if groups[2] != "" {
+				return "(" + groups[1] + " != nil)"
+			}
+			return "(" + groups[1] + " == nil)"
+		})
+	}
+
+	var builder strings.Builder
+	last := 0
+	for _, loc := range literalPattern.FindAllStringIndex(exprStr, -1) {
+		builder.WriteString(rewrite(exprStr[last:loc[0]]))
+		builder.WriteString(exprStr[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	builder.WriteString(rewrite(exprStr[last:]))
+	return builder.String()
+}
+
 func parseExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, error) {
 	if exprStr == "" {
 		return nil, nil
 	}
-	ast, err := ant_parser.Parse(exprStr)
+	ast, err := ant_parser.Parse(rewriteNullPredicates(exprStr))
 	if err != nil {
 		return nil, err
 	}
@@ -198,9 +230,36 @@ func createColumnInfo(field *schemapb.FieldSchema) *planpb.ColumnInfo {
 		FieldId:      field.FieldID,
 		DataType:     field.DataType,
 		IsPrimaryKey: field.IsPrimaryKey,
+		ElementType:  field.ElementType,
 	}
 }
 
+// jsonPath is the `field["key"]...` node, which stands for the values under the keys of the documents of a JSON
+// field. An integer key stands for the element of a JSON array at the index.
+type jsonPath struct {
+	field *schemapb.FieldSchema
+	keys  []string
+}
+
+func (path *jsonPath) columnInfo() *planpb.ColumnInfo {
+	columnInfo := createColumnInfo(path.field)
+	columnInfo.NestedPath = path.keys
+	return columnInfo
+}
+
+// isSameColumn returns true if the column infos refer to the same field, and the same keys if the field is a JSON
+func isSameColumn(a, b *planpb.ColumnInfo) bool {
+	if a.FieldId != b.FieldId || len(a.NestedPath) != len(b.NestedPath) {
+		return false
+	}
+	for i := range a.NestedPath {
+		if a.NestedPath[i] != b.NestedPath[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func isSameOrder(opStr1, opStr2 string) bool {
 	isLess1 := (opStr1 == "<") || (opStr1 == "<=")
 	isLess2 := (opStr2 == "<") || (opStr2 == "<=")
@@ -284,6 +343,40 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	}
 	idNodeLeft, okLeft := left.(*ant_ast.IdentifierNode)
 	idNodeRight, okRight := right.(*ant_ast.IdentifierNode)
+	_, nilLeft := left.(*ant_ast.NilNode)
+	_, nilRight := right.(*ant_ast.NilNode)
+	funcNodeLeft, funcLeft := left.(*ant_ast.FunctionNode)
+	funcNodeRight, funcRight := right.(*ant_ast.FunctionNode)
+
+	if funcLeft {
+		return pc.createArrayLengthExpr(funcNodeLeft, &right, operator, false)
+	}
+	if funcRight {
+		return pc.createArrayLengthExpr(funcNodeRight, &left, operator, true)
+	}
+
+	pathLeft, err := pc.handleJSONPath(left)
+	if err != nil {
+		return nil, err
+	}
+	pathRight, err := pc.handleJSONPath(right)
+	if err != nil {
+		return nil, err
+	}
+	if pathLeft != nil {
+		return pc.createJSONCmpExpr(pathLeft, &right, operator, false)
+	}
+	if pathRight != nil {
+		return pc.createJSONCmpExpr(pathRight, &left, operator, true)
+	}
+
+	if (okLeft && nilRight) || (nilLeft && okRight) {
+		idNode := idNodeLeft
+		if nilLeft {
+			idNode = idNodeRight
+		}
+		return pc.createNullExpr(idNode, operator)
+	}
 
 	if okLeft && okRight {
 		leftField, err := pc.handleIdentifier(idNodeLeft)
@@ -294,6 +387,15 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 		if err != nil {
 			return nil, err
 		}
+		if leftField.DataType == schemapb.DataType_String || rightField.DataType == schemapb.DataType_String {
+			return nil, fmt.Errorf("comparing VarChar fields(%s, %s) is not supported", leftField.Name, rightField.Name)
+		}
+		if leftField.DataType == schemapb.DataType_Array || rightField.DataType == schemapb.DataType_Array {
+			return nil, fmt.Errorf("comparing Array fields(%s, %s) is not supported", leftField.Name, rightField.Name)
+		}
+		if leftField.DataType == schemapb.DataType_JSON || rightField.DataType == schemapb.DataType_JSON {
+			return nil, fmt.Errorf("comparing JSON fields(%s, %s) is not supported", leftField.Name, rightField.Name)
+		}
 		op := getCompareOpType(operator, false)
 		if op == planpb.OpType_Invalid {
 			return nil, fmt.Errorf("invalid binary operator(%s)", operator)
@@ -329,6 +431,12 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	if err != nil {
 		return nil, err
 	}
+	if field.DataType == schemapb.DataType_Array {
+		return nil, fmt.Errorf("Array field %s can only be filtered by array_contains, array_contains_any, array_contains_all and array_length", field.Name)
+	}
+	if field.DataType == schemapb.DataType_JSON {
+		return nil, fmt.Errorf("JSON field %s can only be filtered by the values under its keys, such as %s[\"key\"]", field.Name, field.Name)
+	}
 
 	val, err := pc.handleLeafValue(valueNode, field.DataType)
 	if err != nil {
@@ -352,6 +460,203 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	return expr, nil
 }
 
+// handleJSONPath returns the JSON path of the `field["key"]...` node, or nil if the node is not indexed by keys
+func (pc *parserContext) handleJSONPath(node ant_ast.Node) (*jsonPath, error) {
+	var keys []string
+	for {
+		switch n := node.(type) {
+		case *ant_ast.IndexNode:
+			switch index := n.Index.(type) {
+			case *ant_ast.StringNode:
+				keys = append(keys, index.Value)
+			case *ant_ast.IntegerNode:
+				if index.Value < 0 {
+					return nil, fmt.Errorf("invalid index %d of JSON array", index.Value)
+				}
+				keys = append(keys, strconv.Itoa(index.Value))
+			default:
+				return nil, fmt.Errorf("key of JSON field must be a string or an integer")
+			}
+			node = n.Node
+		case *ant_ast.PropertyNode:
+			keys = append(keys, n.Property)
+			node = n.Node
+		case *ant_ast.IdentifierNode:
+			if len(keys) == 0 {
+				return nil, nil
+			}
+			field, err := pc.handleIdentifier(n)
+			if err != nil {
+				return nil, err
+			}
+			if field.DataType != schemapb.DataType_JSON {
+				return nil, fmt.Errorf("field %s of type %s can not be indexed by keys", field.Name, field.DataType.String())
+			}
+			// the keys are collected from the innermost one
+			for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+				keys[i], keys[j] = keys[j], keys[i]
+			}
+			return &jsonPath{field: field, keys: keys}, nil
+		default:
+			if len(keys) == 0 {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("only JSON fields can be indexed by keys")
+		}
+	}
+}
+
+// createJSONCmpExpr creates the expr comparing the values under the JSON path with a literal, reverse is true if the
+// path is the right operand. The documents which have no value under the path, or whose values are of other types
+// than the literal, do not match. Integers and floats are compared by their numeric values, so are the integer and
+// float values of the documents. Booleans can only be compared by == and !=.
+func (pc *parserContext) createJSONCmpExpr(path *jsonPath, valueNode *ant_ast.Node, operator string, reverse bool) (*planpb.Expr, error) {
+	val, err := pc.handleLeafValue(valueNode, schemapb.DataType_JSON)
+	if err != nil {
+		return nil, fmt.Errorf("JSON field %s can only be compared with a literal: %w", path.field.Name, err)
+	}
+	op := getCompareOpType(operator, reverse)
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("invalid binary operator(%s)", operator)
+	}
+	if _, ok := val.Val.(*planpb.GenericValue_BoolVal); ok && op != planpb.OpType_Equal && op != planpb.OpType_NotEqual {
+		return nil, fmt.Errorf("booleans of JSON field %s can only be compared by == and !=", path.field.Name)
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: path.columnInfo(),
+				Op:         op,
+				Value:      val,
+			},
+		},
+	}, nil
+}
+
+// createNullExpr creates the expr of `field == nil` or `field != nil`, which are rewritten from `field is null`
+// and `field is not null`
+func (pc *parserContext) createNullExpr(idNode *ant_ast.IdentifierNode, operator string) (*planpb.Expr, error) {
+	field, err := pc.handleIdentifier(idNode)
+	if err != nil {
+		return nil, err
+	}
+	if typeutil.IsVectorType(field.DataType) {
+		return nil, fmt.Errorf("vector field %s can not be null", field.Name)
+	}
+	var op planpb.NullExpr_NullOp
+	switch operator {
+	case "==":
+		op = planpb.NullExpr_IsNull
+	case "!=":
+		op = planpb.NullExpr_IsNotNull
+	default:
+		return nil, fmt.Errorf("invalid null operator(%s)", operator)
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_NullExpr{
+			NullExpr: &planpb.NullExpr{
+				ColumnInfo: createColumnInfo(field),
+				Op:         op,
+			},
+		},
+	}, nil
+}
+
+// getArrayField returns the Array field which is the only argument of the function node
+func (pc *parserContext) getArrayField(node *ant_ast.FunctionNode, numArgs int) (*schemapb.FieldSchema, error) {
+	if len(node.Arguments) != numArgs {
+		return nil, fmt.Errorf("%s expects %d arguments, got %d", node.Name, numArgs, len(node.Arguments))
+	}
+	idNode, ok := node.Arguments[0].(*ant_ast.IdentifierNode)
+	if !ok {
+		return nil, fmt.Errorf("first argument of %s must be an Array field", node.Name)
+	}
+	field, err := pc.handleIdentifier(idNode)
+	if err != nil {
+		return nil, err
+	}
+	if field.DataType != schemapb.DataType_Array {
+		return nil, fmt.Errorf("%s is not supported on field %s of type %s", node.Name, field.Name, field.DataType.String())
+	}
+	return field, nil
+}
+
+// createArrayLengthExpr creates the expr comparing `array_length(field)` with an integer, reverse is true if the
+// function is the right operand
+func (pc *parserContext) createArrayLengthExpr(node *ant_ast.FunctionNode, valueNode *ant_ast.Node, operator string, reverse bool) (*planpb.Expr, error) {
+	if node.Name != "array_length" {
+		return nil, fmt.Errorf("function %s can not be compared", node.Name)
+	}
+	field, err := pc.getArrayField(node, 1)
+	if err != nil {
+		return nil, err
+	}
+	intNode, ok := (*valueNode).(*ant_ast.IntegerNode)
+	if !ok {
+		return nil, fmt.Errorf("array_length of field %s can only be compared with an integer", field.Name)
+	}
+	op := getCompareOpType(operator, reverse)
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("invalid binary operator(%s)", operator)
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_ArrayLengthExpr{
+			ArrayLengthExpr: &planpb.ArrayLengthExpr{
+				ColumnInfo: createColumnInfo(field),
+				Op:         op,
+				Length:     int64(intNode.Value),
+			},
+		},
+	}, nil
+}
+
+// handleFunctionExpr handles `array_contains(field, value)`, `array_contains_any(field, [values])` and
+// `array_contains_all(field, [values])`
+func (pc *parserContext) handleFunctionExpr(node *ant_ast.FunctionNode) (*planpb.Expr, error) {
+	var op planpb.ArrayContainsExpr_ContainsOp
+	switch node.Name {
+	case "array_contains":
+		op = planpb.ArrayContainsExpr_Contains
+	case "array_contains_any":
+		op = planpb.ArrayContainsExpr_ContainsAny
+	case "array_contains_all":
+		op = planpb.ArrayContainsExpr_ContainsAll
+	default:
+		return nil, fmt.Errorf("unsupported function %s", node.Name)
+	}
+	field, err := pc.getArrayField(node, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	var elements []*planpb.GenericValue
+	if op == planpb.ArrayContainsExpr_Contains {
+		val, err := pc.handleLeafValue(&node.Arguments[1], field.ElementType)
+		if err != nil {
+			return nil, err
+		}
+		elements = []*planpb.GenericValue{val}
+	} else {
+		if _, ok := node.Arguments[1].(*ant_ast.ArrayNode); !ok {
+			return nil, fmt.Errorf("second argument of %s must be array", node.Name)
+		}
+		elements, err = pc.handleArrayExpr(&node.Arguments[1], field.ElementType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &planpb.Expr{
+		Expr: &planpb.Expr_ArrayContainsExpr{
+			ArrayContainsExpr: &planpb.ArrayContainsExpr{
+				ColumnInfo: createColumnInfo(field),
+				Op:         op,
+				Elements:   elements,
+			},
+		},
+	}, nil
+}
+
 func (pc *parserContext) handleCmpExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
 	return pc.createCmpExpr(node.Left, node.Right, node.Operator)
 }
@@ -406,15 +711,31 @@ func (pc *parserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Expr, e
 	if node.Operator != "in" && node.Operator != "not in" {
 		return nil, fmt.Errorf("invalid operator(%s)", node.Operator)
 	}
-	idNode, ok := node.Left.(*ant_ast.IdentifierNode)
-	if !ok {
-		return nil, fmt.Errorf("left operand of the InExpr must be identifier")
-	}
-	field, err := pc.handleIdentifier(idNode)
+	var columnInfo *planpb.ColumnInfo
+	path, err := pc.handleJSONPath(node.Left)
 	if err != nil {
 		return nil, err
 	}
-	arrayData, err := pc.handleArrayExpr(&node.Right, field.DataType)
+	if path != nil {
+		columnInfo = path.columnInfo()
+	} else {
+		idNode, ok := node.Left.(*ant_ast.IdentifierNode)
+		if !ok {
+			return nil, fmt.Errorf("left operand of the InExpr must be identifier")
+		}
+		field, err := pc.handleIdentifier(idNode)
+		if err != nil {
+			return nil, err
+		}
+		if field.DataType == schemapb.DataType_Array {
+			return nil, fmt.Errorf("in expr is not supported on Array field %s, use array_contains_any instead", field.Name)
+		}
+		if field.DataType == schemapb.DataType_JSON {
+			return nil, fmt.Errorf("in expr is not supported on JSON field %s, use the values under its keys instead", field.Name)
+		}
+		columnInfo = createColumnInfo(field)
+	}
+	arrayData, err := pc.handleArrayExpr(&node.Right, columnInfo.DataType)
 	if err != nil {
 		return nil, err
 	}
@@ -422,7 +743,7 @@ func (pc *parserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Expr, e
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_TermExpr{
 			TermExpr: &planpb.TermExpr{
-				ColumnInfo: createColumnInfo(field),
+				ColumnInfo: columnInfo,
 				Values:     arrayData,
 			},
 		},
@@ -487,7 +808,7 @@ func (pc *parserContext) handleMultiCmpExpr(node *ant_ast.BinaryNode) (*planpb.E
 	var lastExpr *planpb.UnaryRangeExpr
 	for i := len(exprs) - 1; i >= 0; i-- {
 		if expr, ok := exprs[i].Expr.(*planpb.Expr_UnaryRangeExpr); ok {
-			if lastExpr != nil && expr.UnaryRangeExpr.ColumnInfo.FieldId == lastExpr.ColumnInfo.FieldId {
+			if lastExpr != nil && isSameColumn(expr.UnaryRangeExpr.ColumnInfo, lastExpr.ColumnInfo) {
 				binaryRangeExpr := pc.combineUnaryRangeExpr(expr.UnaryRangeExpr, lastExpr)
 				exprs = append(exprs[0:i], append([]*planpb.Expr{binaryRangeExpr}, exprs[i+2:]...)...)
 				lastExpr = nil
@@ -545,7 +866,7 @@ func (pc *parserContext) createNotExpr(childExpr *planpb.Expr) (*planpb.Expr, er
 func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemapb.DataType) (gv *planpb.GenericValue, err error) {
 	switch node := (*nodeRaw).(type) {
 	case *ant_ast.FloatNode:
-		if typeutil.IsFloatingType(dataType) {
+		if typeutil.IsFloatingType(dataType) || dataType == schemapb.DataType_JSON {
 			gv = &planpb.GenericValue{
 				Val: &planpb.GenericValue_FloatVal{
 					FloatVal: node.Value,
@@ -561,7 +882,7 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 					FloatVal: float64(node.Value),
 				},
 			}
-		} else if typeutil.IsIntegerType(dataType) {
+		} else if typeutil.IsIntegerType(dataType) || dataType == schemapb.DataType_JSON {
 			gv = &planpb.GenericValue{
 				Val: &planpb.GenericValue_Int64Val{
 					Int64Val: int64(node.Value),
@@ -580,7 +901,7 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 			return nil, fmt.Errorf("type mismatch")
 		}
 	case *ant_ast.BoolNode:
-		if typeutil.IsBoolType(dataType) {
+		if typeutil.IsBoolType(dataType) || dataType == schemapb.DataType_JSON {
 			gv = &planpb.GenericValue{
 				Val: &planpb.GenericValue_BoolVal{
 					BoolVal: node.Value,
@@ -589,6 +910,16 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 		} else {
 			return nil, fmt.Errorf("type mismatch")
 		}
+	case *ant_ast.StringNode:
+		if dataType == schemapb.DataType_String || dataType == schemapb.DataType_JSON {
+			gv = &planpb.GenericValue{
+				Val: &planpb.GenericValue_StringVal{
+					StringVal: node.Value,
+				},
+			}
+		} else {
+			return nil, fmt.Errorf("type mismatch")
+		}
 	default:
 		return nil, fmt.Errorf("unsupported leaf node")
 	}
@@ -620,7 +951,8 @@ func (pc *parserContext) handleExpr(nodeRaw *ant_ast.Node) (*planpb.Expr, error)
 	case *ant_ast.IdentifierNode,
 		*ant_ast.FloatNode,
 		*ant_ast.IntegerNode,
-		*ant_ast.BoolNode:
+		*ant_ast.BoolNode,
+		*ant_ast.StringNode:
 		return nil, fmt.Errorf("scalar expr is not supported yet")
 	case *ant_ast.UnaryNode:
 		expr, err := pc.handleUnaryExpr(node)
@@ -630,6 +962,8 @@ func (pc *parserContext) handleExpr(nodeRaw *ant_ast.Node) (*planpb.Expr, error)
 		return expr, nil
 	case *ant_ast.BinaryNode:
 		return pc.handleBinaryExpr(node)
+	case *ant_ast.FunctionNode:
+		return pc.handleFunctionExpr(node)
 	default:
 		return nil, fmt.Errorf("unsupported node (%s)", node.Type().String())
 	}
@@ -159,6 +159,226 @@ func TestParseExpr_Naive(t *testing.T) {
 	})
 }
 
+func TestParseExpr_VarChar(t *testing.T) {
+	schemaPb := newTestSchema()
+	schemaPb.Fields = append(schemaPb.Fields,
+		&schemapb.FieldSchema{FieldID: 300, Name: "VarCharField", DataType: schemapb.DataType_String},
+		&schemapb.FieldSchema{FieldID: 301, Name: "VarCharField2", DataType: schemapb.DataType_String})
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+
+	exprProto, err := parseExpr(schema, `VarCharField == "abc"`)
+	assert.Nil(t, err)
+	unaryRangeExpr := exprProto.GetUnaryRangeExpr()
+	assert.Equal(t, planpb.OpType_Equal, unaryRangeExpr.Op)
+	assert.Equal(t, "abc", unaryRangeExpr.Value.GetStringVal())
+
+	exprProto, err = parseExpr(schema, `"abc" < VarCharField`)
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.OpType_GreaterThan, exprProto.GetUnaryRangeExpr().Op)
+
+	exprProto, err = parseExpr(schema, `"a" <= VarCharField < "b"`)
+	assert.Nil(t, err)
+	binaryRangeExpr := exprProto.GetBinaryRangeExpr()
+	assert.Equal(t, "a", binaryRangeExpr.LowerValue.GetStringVal())
+	assert.Equal(t, "b", binaryRangeExpr.UpperValue.GetStringVal())
+
+	exprProto, err = parseExpr(schema, `VarCharField in ["a", "b"]`)
+	assert.Nil(t, err)
+	values := exprProto.GetTermExpr().Values
+	assert.Equal(t, 2, len(values))
+	assert.Equal(t, "b", values[1].GetStringVal())
+
+	invalidExprs := []string{
+		`VarCharField == 1`,
+		`Int64Field == "1"`,
+		`VarCharField in ["a", 1]`,
+		`VarCharField == VarCharField2`,
+		`"abc"`,
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = parseExpr(schema, exprStr)
+		assert.Error(t, err, exprStr)
+	}
+}
+
+func TestParseExpr_Null(t *testing.T) {
+	schemaPb := newTestSchema()
+	schemaPb.Fields = append(schemaPb.Fields,
+		&schemapb.FieldSchema{FieldID: 300, Name: "VarCharField", DataType: schemapb.DataType_String, Nullable: true})
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+
+	exprProto, err := parseExpr(schema, "VarCharField is null")
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNull, exprProto.GetNullExpr().Op)
+	assert.Equal(t, int64(300), exprProto.GetNullExpr().ColumnInfo.FieldId)
+
+	exprProto, err = parseExpr(schema, "VarCharField IS NOT NULL")
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNotNull, exprProto.GetNullExpr().Op)
+
+	exprProto, err = parseExpr(schema, `not VarCharField is null and VarCharField == "is null"`)
+	assert.Nil(t, err)
+	binaryExpr := exprProto.GetBinaryExpr()
+	assert.Equal(t, planpb.NullExpr_IsNull, binaryExpr.Left.GetUnaryExpr().Child.GetNullExpr().Op)
+	assert.Equal(t, "is null", binaryExpr.Right.GetUnaryRangeExpr().Value.GetStringVal())
+
+	invalidExprs := []string{
+		"FloatVectorField is null",
+		"UnknownField is null",
+		"VarCharField > nil",
+		"is null",
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = parseExpr(schema, exprStr)
+		assert.Error(t, err, exprStr)
+	}
+}
+
+func TestParseExpr_Array(t *testing.T) {
+	schemaPb := newTestSchema()
+	schemaPb.Fields = append(schemaPb.Fields,
+		&schemapb.FieldSchema{FieldID: 300, Name: "IntArrayField", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int32},
+		&schemapb.FieldSchema{FieldID: 301, Name: "StringArrayField", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_String})
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+
+	exprProto, err := parseExpr(schema, "array_contains(IntArrayField, 1)")
+	assert.Nil(t, err)
+	containsExpr := exprProto.GetArrayContainsExpr()
+	assert.Equal(t, planpb.ArrayContainsExpr_Contains, containsExpr.Op)
+	assert.Equal(t, int64(300), containsExpr.ColumnInfo.FieldId)
+	assert.Equal(t, schemapb.DataType_Int32, containsExpr.ColumnInfo.ElementType)
+	assert.Equal(t, int64(1), containsExpr.Elements[0].GetInt64Val())
+
+	exprProto, err = parseExpr(schema, `array_contains_any(StringArrayField, ["a", "b"])`)
+	assert.Nil(t, err)
+	containsExpr = exprProto.GetArrayContainsExpr()
+	assert.Equal(t, planpb.ArrayContainsExpr_ContainsAny, containsExpr.Op)
+	assert.Equal(t, 2, len(containsExpr.Elements))
+	assert.Equal(t, "b", containsExpr.Elements[1].GetStringVal())
+
+	exprProto, err = parseExpr(schema, "not array_contains_all(IntArrayField, [1, 2])")
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.ArrayContainsExpr_ContainsAll, exprProto.GetUnaryExpr().Child.GetArrayContainsExpr().Op)
+
+	exprProto, err = parseExpr(schema, "array_length(IntArrayField) >= 2")
+	assert.Nil(t, err)
+	lengthExpr := exprProto.GetArrayLengthExpr()
+	assert.Equal(t, planpb.OpType_GreaterEqual, lengthExpr.Op)
+	assert.Equal(t, int64(2), lengthExpr.Length)
+
+	exprProto, err = parseExpr(schema, "2 > array_length(IntArrayField)")
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.OpType_LessThan, exprProto.GetArrayLengthExpr().Op)
+
+	exprProto, err = parseExpr(schema, "1 < array_length(IntArrayField) < 4")
+	assert.Nil(t, err)
+	binaryExpr := exprProto.GetBinaryExpr()
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binaryExpr.Op)
+	assert.NotNil(t, binaryExpr.Left.GetArrayLengthExpr())
+	assert.NotNil(t, binaryExpr.Right.GetArrayLengthExpr())
+
+	invalidExprs := []string{
+		`array_contains(IntArrayField, "a")`,
+		"array_contains(IntArrayField, [1])",
+		"array_contains_any(IntArrayField, 1)",
+		"array_contains(Int64Field, 1)",
+		"array_contains(IntArrayField)",
+		"array_length(IntArrayField) == 1.5",
+		"array_contains(IntArrayField, 1) == 1",
+		"unknown_func(IntArrayField, 1)",
+		"IntArrayField == 1",
+		"IntArrayField in [1, 2]",
+		"IntArrayField == StringArrayField",
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = parseExpr(schema, exprStr)
+		assert.Error(t, err, exprStr)
+	}
+}
+
+func TestParseExpr_JSON(t *testing.T) {
+	schemaPb := newTestSchema()
+	schemaPb.Fields = append(schemaPb.Fields,
+		&schemapb.FieldSchema{FieldID: 300, Name: "meta", DataType: schemapb.DataType_JSON, Nullable: true})
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+
+	exprProto, err := parseExpr(schema, `meta["brand"] == "acme"`)
+	assert.Nil(t, err)
+	unaryRangeExpr := exprProto.GetUnaryRangeExpr()
+	assert.Equal(t, int64(300), unaryRangeExpr.ColumnInfo.FieldId)
+	assert.Equal(t, schemapb.DataType_JSON, unaryRangeExpr.ColumnInfo.DataType)
+	assert.Equal(t, []string{"brand"}, unaryRangeExpr.ColumnInfo.NestedPath)
+	assert.Equal(t, planpb.OpType_Equal, unaryRangeExpr.Op)
+	assert.Equal(t, "acme", unaryRangeExpr.Value.GetStringVal())
+
+	exprProto, err = parseExpr(schema, `10 > meta["price"]`)
+	assert.Nil(t, err)
+	unaryRangeExpr = exprProto.GetUnaryRangeExpr()
+	assert.Equal(t, planpb.OpType_LessThan, unaryRangeExpr.Op)
+	assert.Equal(t, int64(10), unaryRangeExpr.Value.GetInt64Val())
+
+	exprProto, err = parseExpr(schema, `meta["sizes"][1].width >= 2.5`)
+	assert.Nil(t, err)
+	unaryRangeExpr = exprProto.GetUnaryRangeExpr()
+	assert.Equal(t, []string{"sizes", "1", "width"}, unaryRangeExpr.ColumnInfo.NestedPath)
+	assert.Equal(t, 2.5, unaryRangeExpr.Value.GetFloatVal())
+
+	exprProto, err = parseExpr(schema, `meta["in_stock"] != false`)
+	assert.Nil(t, err)
+	assert.False(t, exprProto.GetUnaryRangeExpr().Value.GetBoolVal())
+
+	exprProto, err = parseExpr(schema, `1 < meta["price"] <= 10`)
+	assert.Nil(t, err)
+	binaryRangeExpr := exprProto.GetBinaryRangeExpr()
+	assert.Equal(t, []string{"price"}, binaryRangeExpr.ColumnInfo.NestedPath)
+	assert.Equal(t, int64(1), binaryRangeExpr.LowerValue.GetInt64Val())
+	assert.Equal(t, int64(10), binaryRangeExpr.UpperValue.GetInt64Val())
+	assert.True(t, binaryRangeExpr.UpperInclusive)
+
+	// ranges of different keys are not combined
+	exprProto, err = parseExpr(schema, `1 < meta["price"] and meta["stock"] < 10`)
+	assert.Nil(t, err)
+	assert.NotNil(t, exprProto.GetBinaryExpr())
+
+	exprProto, err = parseExpr(schema, `meta["brand"] not in ["acme", "globex"]`)
+	assert.Nil(t, err)
+	termExpr := exprProto.GetUnaryExpr().Child.GetTermExpr()
+	assert.Equal(t, []string{"brand"}, termExpr.ColumnInfo.NestedPath)
+	assert.Equal(t, 2, len(termExpr.Values))
+
+	exprProto, err = parseExpr(schema, "meta is null")
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNull, exprProto.GetNullExpr().Op)
+
+	invalidExprs := []string{
+		`meta == "acme"`,
+		`meta in ["acme"]`,
+		`meta["price"] < meta["cost"]`,
+		`meta["price"] < Int64Field`,
+		`meta["in_stock"] < true`,
+		`meta[-1] == 1`,
+		`meta[1.5] == 1`,
+		`Int64Field["a"] == 1`,
+		`meta == Int64Field`,
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = parseExpr(schema, exprStr)
+		assert.Error(t, err, exprStr)
+	}
+}
+
+func TestRewriteNullPredicates(t *testing.T) {
+	assert.Equal(t, "(a == nil)", rewriteNullPredicates("a is null"))
+	assert.Equal(t, "(a != nil) && b > 1", rewriteNullPredicates("a is  not null && b > 1"))
+	assert.Equal(t, `a == "b is null" or (c == nil)`, rewriteNullPredicates(`a == "b is null" or c is null`))
+	assert.Equal(t, `a == 'b \' c is null'`, rewriteNullPredicates(`a == 'b \' c is null'`))
+	assert.Equal(t, "nullable > 1", rewriteNullPredicates("nullable > 1"))
+}
+
 func TestParsePlanNode_Naive(t *testing.T) {
 	exprStrs := []string{
 		"not (Int64Field > 3)",
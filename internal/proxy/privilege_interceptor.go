@@ -0,0 +1,268 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// PrivilegeFunc checks the privilege of the request, the request is rejected if error is returned.
+type PrivilegeFunc func(ctx context.Context, req interface{}) (context.Context, error)
+
+// UnaryServerInterceptor returns a new unary server interceptor that checks the privilege of the requests
+// before they are handled, so the tasks of the unauthorized requests are never enqueued.
+func UnaryServerInterceptor(privilegeFunc PrivilegeFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		newCtx, err := privilegeFunc(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(newCtx, req)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptor that checks the privilege of every request
+// received from the stream, so the stream RPCs can't bypass the privilege check of the unary ones.
+func StreamServerInterceptor(privilegeFunc PrivilegeFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &privilegeServerStream{ServerStream: stream, privilegeFunc: privilegeFunc})
+	}
+}
+
+// privilegeServerStream checks the privilege of the messages received from the wrapped stream.
+type privilegeServerStream struct {
+	grpc.ServerStream
+	privilegeFunc PrivilegeFunc
+}
+
+func (s *privilegeServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	_, err := s.privilegeFunc(s.Context(), m)
+	return err
+}
+
+// privilegeExt describes the privilege required by a request.
+type privilegeExt struct {
+	objectType      commonpb.ObjectType
+	objectPrivilege commonpb.ObjectPrivilege
+	// the database of the collection objects
+	dbName string
+	// the objects the request operates on, the request is allowed only if all of them are granted
+	objectNames []string
+}
+
+func collectionPrivilege(privilege commonpb.ObjectPrivilege, dbName string, collectionNames ...string) *privilegeExt {
+	return &privilegeExt{
+		objectType:      commonpb.ObjectType_Collection,
+		objectPrivilege: privilege,
+		dbName:          dbName,
+		objectNames:     collectionNames,
+	}
+}
+
+func globalPrivilege(privilege commonpb.ObjectPrivilege) *privilegeExt {
+	return &privilegeExt{
+		objectType:      commonpb.ObjectType_Global,
+		objectPrivilege: privilege,
+		objectNames:     []string{common.AnyWord},
+	}
+}
+
+// getPrivilegeExt returns the privilege required by the request, nil means no privilege is required.
+func getPrivilegeExt(req interface{}) *privilegeExt {
+	switch r := req.(type) {
+	case *milvuspb.CreateCollectionRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeCreateCollection)
+	case *milvuspb.DropCollectionRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeDropCollection, r.GetDbName(), r.CollectionName)
+	case *milvuspb.HasCollectionRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeDescribeCollection, r.GetDbName(), r.CollectionName)
+	case *milvuspb.DescribeCollectionRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeDescribeCollection, r.GetDbName(), r.CollectionName)
+	case *milvuspb.ShowCollectionsRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeShowCollections)
+	case *milvuspb.LoadCollectionRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeLoad, r.GetDbName(), r.CollectionName)
+	case *milvuspb.ReleaseCollectionRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeRelease, r.GetDbName(), r.CollectionName)
+	case *milvuspb.GetCollectionStatisticsRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeGetStatistics, r.GetDbName(), r.CollectionName)
+	case *milvuspb.CreatePartitionRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeCreatePartition, r.GetDbName(), r.CollectionName)
+	case *milvuspb.DropPartitionRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeDropPartition, r.GetDbName(), r.CollectionName)
+	case *milvuspb.HasPartitionRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeDescribeCollection, r.GetDbName(), r.CollectionName)
+	case *milvuspb.ShowPartitionsRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeDescribeCollection, r.GetDbName(), r.CollectionName)
+	case *milvuspb.LoadPartitionsRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeLoad, r.GetDbName(), r.CollectionName)
+	case *milvuspb.ReleasePartitionsRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeRelease, r.GetDbName(), r.CollectionName)
+	case *milvuspb.GetPartitionStatisticsRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeGetStatistics, r.GetDbName(), r.CollectionName)
+	case *milvuspb.CreateIndexRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeCreateIndex, r.GetDbName(), r.CollectionName)
+	case *milvuspb.DescribeIndexRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeIndexDetail, r.GetDbName(), r.CollectionName)
+	case *milvuspb.GetIndexStateRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeIndexDetail, r.GetDbName(), r.CollectionName)
+	case *milvuspb.GetIndexBuildProgressRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeIndexDetail, r.GetDbName(), r.CollectionName)
+	case *milvuspb.DropIndexRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeDropIndex, r.GetDbName(), r.CollectionName)
+	case *milvuspb.InsertRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeInsert, r.GetDbName(), r.CollectionName)
+	case *milvuspb.DeleteRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeDelete, r.GetDbName(), r.CollectionName)
+	case *milvuspb.UpsertRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeUpsert, r.GetDbName(), r.CollectionName)
+	case *milvuspb.SearchRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeSearch, r.GetDbName(), r.CollectionName)
+	case *milvuspb.HybridSearchRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeSearch, r.GetDbName(), r.CollectionName)
+	case *milvuspb.QueryRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeQuery, r.GetDbName(), r.CollectionName)
+	case *milvuspb.OpenQueryIteratorRequest:
+		// the iterator is only accessible to the user opening it
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeQuery, r.GetDbName(), r.CollectionName)
+	case *milvuspb.FlushRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeFlush, r.GetDbName(), r.CollectionNames...)
+	case *milvuspb.GetPersistentSegmentInfoRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeGetStatistics, r.GetDbName(), r.CollectionName)
+	case *milvuspb.GetQuerySegmentInfoRequest:
+		return collectionPrivilege(commonpb.ObjectPrivilege_PrivilegeGetStatistics, r.GetDbName(), r.CollectionName)
+	case *milvuspb.CreateAliasRequest, *milvuspb.AlterAliasRequest, *milvuspb.AddCollectionFieldRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeCreateCollection)
+	case *milvuspb.DropAliasRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeDropCollection)
+	case *milvuspb.CreateDatabaseRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeCreateDatabase)
+	case *milvuspb.DropDatabaseRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeDropDatabase)
+	case *milvuspb.ListDatabasesRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeListDatabases)
+	case *milvuspb.LoadBalanceRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeLoadBalance)
+	case *milvuspb.ManualCompactionRequest, *milvuspb.GetCompactionStateRequest, *milvuspb.GetCompactionPlansRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeCompaction)
+	case *milvuspb.CreateCredentialRequest, *milvuspb.CreateRoleRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeCreateOwnership)
+	case *milvuspb.DeleteCredentialRequest, *milvuspb.DropRoleRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeDropOwnership)
+	case *milvuspb.ListCredUsersRequest, *milvuspb.SelectGrantRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeSelectOwnership)
+	case *milvuspb.OperateUserRoleRequest, *milvuspb.OperatePrivilegeRequest:
+		return globalPrivilege(commonpb.ObjectPrivilege_PrivilegeManageOwnership)
+	case *milvuspb.UpdateCredentialRequest:
+		return &privilegeExt{
+			objectType:      commonpb.ObjectType_User,
+			objectPrivilege: commonpb.ObjectPrivilege_PrivilegeUpdateUser,
+			objectNames:     []string{r.Username},
+		}
+	default:
+		return nil
+	}
+}
+
+// hasPrivilege checks whether any of the roles is granted the privilege on the object,
+// the collection objects are only granted in the database of the request.
+func hasPrivilege(ctx context.Context, roles []string, ext *privilegeExt, objectName string) (bool, error) {
+	objectType := ext.objectType.String()
+	privileges := []string{funcutil.PrivilegeName(ext.objectPrivilege), funcutil.PrivilegeName(commonpb.ObjectPrivilege_PrivilegeAll)}
+	names := []string{
+		funcutil.PolicyObjectName(objectType, ext.dbName, objectName),
+		funcutil.PolicyObjectName(objectType, ext.dbName, common.AnyWord),
+	}
+	for _, roleName := range roles {
+		for _, name := range names {
+			for _, privilege := range privileges {
+				ok, err := globalMetaCache.HasPolicy(ctx, funcutil.PolicyForPrivilege(roleName, objectType, name, privilege))
+				if err != nil || ok {
+					return ok, err
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// PrivilegeInterceptor checks whether the current user is granted the privilege required by the request.
+// The root user and the users with the admin role own all the privileges, and every user implicitly has the public role.
+// The check is skipped when authorization isn't enabled or the request is sent to the internal Proxy service,
+// whose callers are authenticated by the internal token.
+func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context, error) {
+	if !Params.AuthorizationEnabled || isInternalMethod(ctx) {
+		return ctx, nil
+	}
+	ext := getPrivilegeExt(req)
+	if ext == nil {
+		return ctx, nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if username == common.DefaultRootUser {
+		return ctx, nil
+	}
+	// users can always update their own passwords
+	if ext.objectType == commonpb.ObjectType_User && len(ext.objectNames) == 1 && ext.objectNames[0] == username {
+		return ctx, nil
+	}
+
+	roles, err := globalMetaCache.GetUserRoles(ctx, username)
+	if err != nil {
+		log.Error("failed to get the roles of the user", zap.String("username", username), zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, roleName := range roles {
+		if roleName == common.RoleAdmin {
+			return ctx, nil
+		}
+	}
+	roles = append(roles, common.RolePublic)
+
+	for _, objectName := range ext.objectNames {
+		ok, err := hasPrivilege(ctx, roles, ext, objectName)
+		if err != nil {
+			log.Error("failed to check the privilege", zap.String("username", username), zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if !ok {
+			msg := fmt.Sprintf("permission deny, user = %s, object type = %s, object name = %s, privilege = %s",
+				username, ext.objectType.String(), objectName, funcutil.PrivilegeName(ext.objectPrivilege))
+			log.Debug(msg)
+			return nil, status.Error(codes.PermissionDenied, msg)
+		}
+	}
+	return ctx, nil
+}
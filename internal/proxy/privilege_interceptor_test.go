@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+func userContext(username string) context.Context {
+	md := metadata.Pairs(headerAuthorize, crypto.Base64Encode(username+credentialSeparator+"pwd"))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestPrivilegeInterceptor(t *testing.T) {
+	Params.Init()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	// authorization disabled
+	Params.AuthorizationEnabled = false
+	_, err = PrivilegeInterceptor(context.Background(), &milvuspb.InsertRequest{CollectionName: "col1"})
+	assert.Nil(t, err)
+
+	Params.AuthorizationEnabled = true
+	defer func() { Params.AuthorizationEnabled = false }()
+
+	// no privilege required
+	_, err = PrivilegeInterceptor(context.Background(), &milvuspb.DummyRequest{})
+	assert.Nil(t, err)
+	// no authorization
+	_, err = PrivilegeInterceptor(context.Background(), &milvuspb.InsertRequest{CollectionName: "col1"})
+	assert.NotNil(t, err)
+
+	// root and admin
+	_, err = PrivilegeInterceptor(userContext(common.DefaultRootUser), &milvuspb.DropRoleRequest{RoleName: "role1"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(userContext("adminUser"), &milvuspb.DropRoleRequest{RoleName: "role1"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(userContext("adminUser"), &milvuspb.CreateDatabaseRequest{DbName: "db1"})
+	assert.Nil(t, err)
+
+	// granted
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.InsertRequest{CollectionName: "col1"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.CreateCollectionRequest{CollectionName: "col2"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.AddCollectionFieldRequest{CollectionName: "col2"})
+	assert.Nil(t, err)
+	// granted to public
+	_, err = PrivilegeInterceptor(userContext("otherUser"), &milvuspb.QueryRequest{CollectionName: "col2"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(userContext("otherUser"), &milvuspb.OpenQueryIteratorRequest{CollectionName: "col2"})
+	assert.Nil(t, err)
+	// own user
+	_, err = PrivilegeInterceptor(userContext("otherUser"), &milvuspb.UpdateCredentialRequest{Username: "otherUser"})
+	assert.Nil(t, err)
+
+	// not granted
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.InsertRequest{CollectionName: "col2"})
+	assert.NotNil(t, err)
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.UpsertRequest{CollectionName: "col1"})
+	assert.NotNil(t, err)
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.HybridSearchRequest{CollectionName: "col1"})
+	assert.NotNil(t, err)
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.FlushRequest{CollectionNames: []string{"col1", "col2"}})
+	assert.NotNil(t, err)
+	_, err = PrivilegeInterceptor(userContext("otherUser"), &milvuspb.UpdateCredentialRequest{Username: "mockUser"})
+	assert.NotNil(t, err)
+	_, err = PrivilegeInterceptor(userContext("otherUser"), &milvuspb.CreateCollectionRequest{CollectionName: "col2"})
+	assert.NotNil(t, err)
+	_, err = PrivilegeInterceptor(userContext("otherUser"), &milvuspb.AddCollectionFieldRequest{CollectionName: "col2"})
+	assert.NotNil(t, err)
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.CreateDatabaseRequest{DbName: "db1"})
+	assert.NotNil(t, err)
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.DropDatabaseRequest{DbName: "db1"})
+	assert.NotNil(t, err)
+
+	// the grants on the collections are scoped by the database
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.InsertRequest{DbName: common.DefaultDBName, CollectionName: "col1"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.InsertRequest{DbName: "db1", CollectionName: "col1"})
+	assert.NotNil(t, err)
+	_, err = PrivilegeInterceptor(userContext("otherUser"), &milvuspb.QueryRequest{DbName: "db1", CollectionName: "col2"})
+	assert.NotNil(t, err)
+
+	// failed to load the policies
+	client.Error = true
+	err = InitMetaCache(client)
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(userContext("mockUser"), &milvuspb.InsertRequest{CollectionName: "col1"})
+	assert.NotNil(t, err)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+	interceptor := UnaryServerInterceptor(func(ctx context.Context, req interface{}) (context.Context, error) {
+		return ctx, nil
+	})
+	resp, err := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "req", resp)
+
+	interceptor = UnaryServerInterceptor(func(ctx context.Context, req interface{}) (context.Context, error) {
+		return nil, assert.AnError
+	})
+	resp, err = interceptor(context.Background(), "req", &grpc.UnaryServerInfo{}, handler)
+	assert.NotNil(t, err)
+	assert.Nil(t, resp)
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	msg string
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *mockServerStream) RecvMsg(m interface{}) error {
+	*m.(*string) = s.msg
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		var msg string
+		return stream.RecvMsg(&msg)
+	}
+	var checked interface{}
+	interceptor := StreamServerInterceptor(func(ctx context.Context, req interface{}) (context.Context, error) {
+		checked = req
+		return ctx, nil
+	})
+	err := interceptor(nil, &mockServerStream{msg: "req"}, &grpc.StreamServerInfo{}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "req", *checked.(*string))
+
+	interceptor = StreamServerInterceptor(func(ctx context.Context, req interface{}) (context.Context, error) {
+		return nil, assert.AnError
+	})
+	err = interceptor(nil, &mockServerStream{msg: "req"}, &grpc.StreamServerInfo{}, handler)
+	assert.NotNil(t, err)
+}
+
+func TestGetCurUserFromContext(t *testing.T) {
+	_, err := GetCurUserFromContext(context.Background())
+	assert.NotNil(t, err)
+
+	username, err := GetCurUserFromContext(userContext("mockUser"))
+	assert.Nil(t, err)
+	assert.Equal(t, "mockUser", username)
+
+	md := metadata.Pairs(headerAuthorize, crypto.Base64Encode("mockUser"))
+	_, err = GetCurUserFromContext(metadata.NewIncomingContext(context.Background(), md))
+	assert.NotNil(t, err)
+}
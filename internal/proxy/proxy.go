@@ -31,6 +31,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/allocator"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -84,6 +85,11 @@ type Proxy struct {
 
 	session *sessionutil.Session
 
+	etcdKV *etcdkv.EtcdKV
+
+	// writeTs tracks the last write of each session for the Session consistency level
+	writeTs *writeTsTracker
+
 	msFactory msgstream.Factory
 
 	// Add callback functions at different stages
@@ -99,6 +105,7 @@ func NewProxy(ctx context.Context, factory msgstream.Factory) (*Proxy, error) {
 		ctx:       ctx1,
 		cancel:    cancel,
 		msFactory: factory,
+		writeTs:   newWriteTsTracker(),
 	}
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 	log.Debug("Proxy", zap.Any("State", node.stateCode.Load()))
@@ -218,6 +225,11 @@ func (node *Proxy) Init() error {
 		return err
 	}
 
+	node.etcdKV, err = etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	if err != nil {
+		return err
+	}
+
 	node.chTicker = newChannelsTimeTicker(node.ctx, channelMgrTickerInterval, []string{}, node.sched.getPChanStatistics, tsoAllocator)
 
 	node.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
@@ -327,6 +339,9 @@ func (node *Proxy) Start() error {
 
 	node.sendChannelsTimeTickLoop()
 
+	node.watchRateLimitConfig()
+	log.Debug("start watching rate limit config")
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
@@ -363,6 +378,10 @@ func (node *Proxy) Stop() error {
 
 	node.wg.Wait()
 
+	if node.etcdKV != nil {
+		node.etcdKV.Close()
+	}
+
 	for _, cb := range node.closeCallbacks {
 		cb()
 	}
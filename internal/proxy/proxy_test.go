@@ -689,6 +689,47 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("database", func(t *testing.T) {
+		defer wg.Done()
+		otherDbName := "other_db"
+		resp, err := proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: otherDbName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// recreate -> fail
+		resp, err = proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: otherDbName})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// invalid name -> fail
+		resp, err = proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: "1db"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		listResp, err := proxy.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.Status.ErrorCode)
+		assert.Contains(t, listResp.DbNames, otherDbName)
+		assert.Contains(t, listResp.DbNames, common.DefaultDBName)
+
+		// the collection of the default database is invisible in the other database
+		hasResp, err := proxy.HasCollection(ctx, &milvuspb.HasCollectionRequest{
+			DbName:         otherDbName,
+			CollectionName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.False(t, hasResp.Value)
+
+		resp, err = proxy.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: otherDbName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: common.DefaultDBName})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("create alias", func(t *testing.T) {
 		defer wg.Done()
@@ -820,7 +861,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
@@ -989,7 +1030,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1031,6 +1072,23 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, int64(rowNum), resp.InsertCnt)
 	})
 
+	wg.Add(1)
+	t.Run("upsert fail, autoID", func(t *testing.T) {
+		defer wg.Done()
+		req := constructInsertRequest()
+
+		resp, err := proxy.Upsert(ctx, &milvuspb.UpsertRequest{
+			DbName:         req.DbName,
+			CollectionName: req.CollectionName,
+			FieldsData:     req.FieldsData,
+			HashKeys:       req.HashKeys,
+			NumRows:        req.NumRows,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, rowNum, len(resp.ErrIndex))
+	})
+
 	// TODO(dragondriver): proxy.Delete()
 
 	flushed := true // fortunately, no task depends on this state, maybe CreateIndex?
@@ -1268,6 +1326,36 @@ func TestProxy(t *testing.T) {
 			// assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 			// TODO(dragondriver): compare query result
 		})
+
+		wg.Add(1)
+		t.Run("query iterator", func(t *testing.T) {
+			defer wg.Done()
+			openResp, err := proxy.OpenQueryIterator(ctx, &milvuspb.OpenQueryIteratorRequest{
+				DbName:         dbName,
+				CollectionName: collectionName,
+				BatchSize:      int64(rowNum / 2),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, openResp.Status.ErrorCode)
+			assert.NotZero(t, openResp.TravelTimestamp)
+
+			_, err = proxy.NextQueryIterator(ctx, &milvuspb.NextQueryIteratorRequest{
+				IteratorID: openResp.IteratorID,
+			})
+			assert.NoError(t, err)
+
+			status, err := proxy.CloseQueryIterator(ctx, &milvuspb.CloseQueryIteratorRequest{
+				IteratorID: openResp.IteratorID,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+			nextResp, err := proxy.NextQueryIterator(ctx, &milvuspb.NextQueryIteratorRequest{
+				IteratorID: openResp.IteratorID,
+			})
+			assert.NoError(t, err)
+			assert.NotEqual(t, commonpb.ErrorCode_Success, nextResp.Status.ErrorCode)
+		})
 	}
 
 	wg.Add(1)
@@ -1402,7 +1490,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("release collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ReleaseCollection(ctx, &milvuspb.ReleaseCollectionRequest{
@@ -1474,7 +1562,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1531,7 +1619,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions after release partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1627,7 +1715,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions after drop partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1674,7 +1762,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("drop collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DropCollection(ctx, &milvuspb.DropCollectionRequest{
@@ -1928,6 +2016,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("Upsert fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Upsert(ctx, &milvuspb.UpsertRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("Search fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -1936,6 +2032,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("HybridSearch fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.HybridSearch(ctx, &milvuspb.HybridSearchRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("Flush fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -1952,6 +2056,30 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("OpenQueryIterator fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.OpenQueryIterator(ctx, &milvuspb.OpenQueryIteratorRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("NextQueryIterator fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.NextQueryIterator(ctx, &milvuspb.NextQueryIteratorRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CloseQueryIterator fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CloseQueryIterator(ctx, &milvuspb.CloseQueryIteratorRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("GetPersistentSegmentInfo fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -1992,6 +2120,30 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("CreateDatabase fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropDatabase fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("ListDatabases fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	proxy.UpdateStateCode(internalpb.StateCode_Healthy)
 
 	// queue full
@@ -2188,6 +2340,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("Upsert fail, dm queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Upsert(ctx, &milvuspb.UpsertRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	proxy.sched.dmQueue.setMaxTaskNum(dmParallelism)
 
 	dqParallelism := proxy.sched.dqQueue.getMaxTaskNum()
@@ -2201,6 +2361,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("HybridSearch fail, dq queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.HybridSearch(ctx, &milvuspb.HybridSearchRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("Query fail, dq queue full", func(t *testing.T) {
 		defer wg.Done()
@@ -2403,6 +2571,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("Upsert fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Upsert(shortCtx, &milvuspb.UpsertRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("Search fail, timeout", func(t *testing.T) {
 		defer wg.Done()
@@ -2411,6 +2587,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("HybridSearch fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.HybridSearch(shortCtx, &milvuspb.HybridSearchRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("Query fail, dq queue full", func(t *testing.T) {
 		defer wg.Done()
@@ -0,0 +1,281 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// queryIteratorKey returns the etcd key of the query iterator
+func queryIteratorKey(iteratorID UniqueID) string {
+	return path.Join(common.QueryIteratorPrefix, strconv.FormatInt(iteratorID, 10))
+}
+
+// newQueryIteratorSecret returns a random secret binding the query iterator to the client opening it
+func newQueryIteratorSecret() (string, error) {
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// saveQueryIterator stores the query iterator in etcd with a new lease, it expires after Params.QueryIteratorTTL
+// seconds unless the lease is renewed
+func (node *Proxy) saveQueryIterator(iteratorID UniqueID, info *internalpb.QueryIteratorInfo) error {
+	leaseID, err := node.etcdKV.Grant(Params.QueryIteratorTTL)
+	if err != nil {
+		return err
+	}
+	info.LeaseID = int64(leaseID)
+	value, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return node.etcdKV.SaveWithLease(queryIteratorKey(iteratorID), string(value), leaseID)
+}
+
+// loadQueryIterator loads the query iterator from etcd, only the client opening it has access to it
+func (node *Proxy) loadQueryIterator(ctx context.Context, iteratorID UniqueID, secret string) (*internalpb.QueryIteratorInfo, error) {
+	value, err := node.etcdKV.Load(queryIteratorKey(iteratorID))
+	if err != nil {
+		return nil, fmt.Errorf("query iterator %d does not exist or has expired", iteratorID)
+	}
+	info := &internalpb.QueryIteratorInfo{}
+	if err := proto.Unmarshal([]byte(value), info); err != nil {
+		return nil, err
+	}
+	if err := checkQueryIteratorAccess(ctx, iteratorID, info, secret); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// checkQueryIteratorAccess checks the client carries the secret of the query iterator and is the user opening it,
+// the username alone doesn't identify the client since all the users are anonymous without authorization
+func checkQueryIteratorAccess(ctx context.Context, iteratorID UniqueID, info *internalpb.QueryIteratorInfo, secret string) error {
+	secretSha256 := crypto.SHA256(secret, strconv.FormatInt(iteratorID, 10))
+	if subtle.ConstantTimeCompare([]byte(secretSha256), []byte(info.SecretSha256)) != 1 {
+		return fmt.Errorf("invalid secret of query iterator %d", iteratorID)
+	}
+	username, _ := GetCurUserFromContext(ctx)
+	if info.Username != username {
+		return fmt.Errorf("query iterator %d is opened by another user", iteratorID)
+	}
+	return nil
+}
+
+// openQueryIterator validates the request and stores a new query iterator pinned to the travel timestamp,
+// it returns the id and the secret of the iterator
+func (node *Proxy) openQueryIterator(ctx context.Context, req *milvuspb.OpenQueryIteratorRequest) (UniqueID, string, *internalpb.QueryIteratorInfo, error) {
+	if err := validateCollectionName(req.CollectionName); err != nil {
+		return 0, "", nil, err
+	}
+	if req.BatchSize < 0 {
+		return 0, "", nil, fmt.Errorf("invalid batch size %d, should not be negative", req.BatchSize)
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.DbName, req.CollectionName)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, req.DbName, req.CollectionName)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	if req.Expr != "" {
+		if _, err := createExprPlan(schema, req.Expr); err != nil {
+			return 0, "", nil, err
+		}
+	}
+	aggregates, _, err := parseAggregates(req.OutputFields, schema)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	if len(aggregates) > 0 {
+		return 0, "", nil, errors.New("aggregations are not supported by query iterator")
+	}
+
+	ts, err := node.tsoAllocator.AllocOne()
+	if err != nil {
+		return 0, "", nil, err
+	}
+	travelTimestamp := req.TravelTimestamp
+	if travelTimestamp == 0 {
+		travelTimestamp = ts
+	}
+	// the history before the timetravel of the compaction may have been compacted
+	if timetravel := tsoutil.AddPhysicalTimeOnTs(-common.TimetravelRange.Milliseconds(), ts); travelTimestamp < timetravel {
+		return 0, "", nil, fmt.Errorf("travel timestamp %d is older than the timetravel %d of the compaction", travelTimestamp, timetravel)
+	}
+	batchSize := req.BatchSize
+	if batchSize == 0 {
+		batchSize = Params.QueryIteratorBatchSize
+	}
+	username, _ := GetCurUserFromContext(ctx)
+	info := &internalpb.QueryIteratorInfo{
+		CollectionID:    collectionID,
+		DbName:          req.DbName,
+		CollectionName:  req.CollectionName,
+		Expr:            req.Expr,
+		OutputFields:    req.OutputFields,
+		PartitionNames:  req.PartitionNames,
+		TravelTimestamp: travelTimestamp,
+		BatchSize:       batchSize,
+		Username:        username,
+	}
+
+	iteratorID, err := node.idAllocator.AllocOne()
+	if err != nil {
+		return 0, "", nil, err
+	}
+	secret, err := newQueryIteratorSecret()
+	if err != nil {
+		return 0, "", nil, err
+	}
+	info.SecretSha256 = crypto.SHA256(secret, strconv.FormatInt(iteratorID, 10))
+	if err := node.saveQueryIterator(iteratorID, info); err != nil {
+		return 0, "", nil, err
+	}
+	return iteratorID, secret, info, nil
+}
+
+// nextQueryIterator queries the batch after the token and extends the expiration of the query iterator
+func (node *Proxy) nextQueryIterator(ctx context.Context, req *milvuspb.NextQueryIteratorRequest) (*milvuspb.QueryIteratorResults, error) {
+	info, err := node.loadQueryIterator(ctx, req.IteratorID, req.Secret)
+	if err != nil {
+		return nil, err
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, info.DbName, info.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	if collectionID != info.CollectionID {
+		return nil, fmt.Errorf("collection %s of query iterator %d has been dropped", info.CollectionName, req.IteratorID)
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, info.DbName, info.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	pkField, err := helper.GetPrimaryKeyField()
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := nextQueryRequest(info, pkField, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	result, err := node.Query(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return &milvuspb.QueryIteratorResults{Status: result.Status}, nil
+	}
+	if err := node.etcdKV.KeepAliveOnce(clientv3.LeaseID(info.LeaseID)); err != nil {
+		return nil, fmt.Errorf("failed to renew query iterator %d: %w", req.IteratorID, err)
+	}
+
+	token, rowNum := queryIteratorToken(result.FieldsData, pkField.FieldID)
+	if rowNum == 0 {
+		token = req.Token
+	}
+	return &milvuspb.QueryIteratorResults{
+		Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		FieldsData: result.FieldsData,
+		Token:      token,
+		Done:       int64(rowNum) < info.BatchSize,
+	}, nil
+}
+
+// nextQueryRequest returns the request to query the batch after the token, which is the primary key
+// of the last entity of the previous batch
+func nextQueryRequest(info *internalpb.QueryIteratorInfo, pkField *schemapb.FieldSchema, token string) (*milvuspb.QueryRequest, error) {
+	expr := info.Expr
+	if token != "" {
+		// the token of varchar primary keys is the key itself
+		after := fmt.Sprintf("%s > %s", pkField.Name, strconv.Quote(token))
+		if pkField.DataType != schemapb.DataType_String {
+			pk, err := strconv.ParseInt(token, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid query iterator token %s", token)
+			}
+			after = fmt.Sprintf("%s > %d", pkField.Name, pk)
+		}
+		if expr == "" {
+			expr = after
+		} else {
+			expr = fmt.Sprintf("(%s) && %s", expr, after)
+		}
+	}
+	if expr == "" {
+		// matches all the entities
+		expr = fmt.Sprintf("%s not in []", pkField.Name)
+	}
+	return &milvuspb.QueryRequest{
+		DbName:             info.DbName,
+		CollectionName:     info.CollectionName,
+		Expr:               expr,
+		OutputFields:       info.OutputFields,
+		PartitionNames:     info.PartitionNames,
+		TravelTimestamp:    info.TravelTimestamp,
+		GuaranteeTimestamp: info.TravelTimestamp,
+		Limit:              info.BatchSize,
+	}, nil
+}
+
+// queryIteratorToken returns the token and the number of entities of a batch sorted by primary key,
+// the token is the primary key of the last entity
+func queryIteratorToken(fieldsData []*schemapb.FieldData, pkFieldID int64) (string, int) {
+	for _, fieldData := range fieldsData {
+		if fieldData.FieldId != pkFieldID {
+			continue
+		}
+		if strPks := fieldData.GetScalars().GetStringData().GetData(); len(strPks) > 0 {
+			return strPks[len(strPks)-1], len(strPks)
+		}
+		pks := fieldData.GetScalars().GetLongData().GetData()
+		if len(pks) == 0 {
+			return "", 0
+		}
+		return strconv.FormatInt(pks[len(pks)-1], 10), len(pks)
+	}
+	return "", 0
+}
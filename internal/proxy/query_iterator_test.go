@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+type hybridTimestampAllocatorInterface struct {
+	ts Timestamp
+}
+
+func (tso *hybridTimestampAllocatorInterface) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
+	return &rootcoordpb.AllocTimestampResponse{
+		Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Timestamp: tso.ts,
+		Count:     req.Count,
+	}, nil
+}
+
+func TestNextQueryRequest(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+	info := &internalpb.QueryIteratorInfo{
+		DbName:          "db",
+		CollectionName:  "coll",
+		OutputFields:    []string{"age"},
+		TravelTimestamp: 100,
+		BatchSize:       10,
+	}
+
+	req, err := nextQueryRequest(info, pkField, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "pk not in []", req.Expr)
+	assert.Equal(t, "coll", req.CollectionName)
+	assert.Equal(t, []string{"age"}, req.OutputFields)
+	assert.Equal(t, uint64(100), req.TravelTimestamp)
+	assert.Equal(t, uint64(100), req.GuaranteeTimestamp)
+	assert.Equal(t, int64(10), req.Limit)
+
+	req, err = nextQueryRequest(info, pkField, "-5")
+	assert.NoError(t, err)
+	assert.Equal(t, "pk > -5", req.Expr)
+
+	info.Expr = "age > 1 || age < 0"
+	req, err = nextQueryRequest(info, pkField, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "age > 1 || age < 0", req.Expr)
+	req, err = nextQueryRequest(info, pkField, "7")
+	assert.NoError(t, err)
+	assert.Equal(t, "(age > 1 || age < 0) && pk > 7", req.Expr)
+
+	_, err = nextQueryRequest(info, pkField, "invalid")
+	assert.Error(t, err)
+
+	pkField.DataType = schemapb.DataType_String
+	req, err = nextQueryRequest(info, pkField, `sku"7`)
+	assert.NoError(t, err)
+	assert.Equal(t, `(age > 1 || age < 0) && pk > "sku\"7"`, req.Expr)
+}
+
+func TestQueryIteratorToken(t *testing.T) {
+	fieldsData := []*schemapb.FieldData{
+		{FieldId: 101, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{9, 8, 7}}}}}},
+		{FieldId: 100, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}}}}},
+	}
+	token, rowNum := queryIteratorToken(fieldsData, 100)
+	assert.Equal(t, "3", token)
+	assert.Equal(t, 3, rowNum)
+
+	token, rowNum = queryIteratorToken(nil, 100)
+	assert.Equal(t, "", token)
+	assert.Equal(t, 0, rowNum)
+
+	fieldsData = []*schemapb.FieldData{
+		{FieldId: 100, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}}}}},
+	}
+	token, rowNum = queryIteratorToken(fieldsData, 100)
+	assert.Equal(t, "b", token)
+	assert.Equal(t, 2, rowNum)
+}
+
+func TestQueryIteratorKey(t *testing.T) {
+	assert.Equal(t, "query_iterator/10", queryIteratorKey(10))
+}
+
+func TestOpenQueryIterator_expiredTravelTimestamp(t *testing.T) {
+	Params.Init()
+	assert.NoError(t, InitMetaCache(&MockRootCoordClientInterface{}))
+	ts := tsoutil.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0)
+	tsoAllocator, err := newTimestampAllocator(context.Background(), &hybridTimestampAllocatorInterface{ts: ts}, 1)
+	assert.NoError(t, err)
+	node := &Proxy{tsoAllocator: tsoAllocator}
+
+	expired := tsoutil.AddPhysicalTimeOnTs(-(common.TimetravelRange + time.Hour).Milliseconds(), ts)
+	_, _, _, err = node.openQueryIterator(context.Background(), &milvuspb.OpenQueryIteratorRequest{
+		CollectionName:  "collection1",
+		TravelTimestamp: expired,
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "older than the timetravel")
+}
+
+func TestCheckQueryIteratorAccess(t *testing.T) {
+	secret, err := newQueryIteratorSecret()
+	assert.NoError(t, err)
+	other, err := newQueryIteratorSecret()
+	assert.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+
+	info := &internalpb.QueryIteratorInfo{SecretSha256: crypto.SHA256(secret, "1")}
+	ctx := context.Background()
+	assert.NoError(t, checkQueryIteratorAccess(ctx, 1, info, secret))
+	// the anonymous clients can't access the iterators of each other
+	assert.Error(t, checkQueryIteratorAccess(ctx, 1, info, other))
+	assert.Error(t, checkQueryIteratorAccess(ctx, 1, info, ""))
+	assert.Error(t, checkQueryIteratorAccess(ctx, 2, info, secret))
+
+	info.Username = "u1"
+	assert.Error(t, checkQueryIteratorAccess(ctx, 1, info, secret))
+	assert.NoError(t, checkQueryIteratorAccess(userContext("u1"), 1, info, secret))
+}
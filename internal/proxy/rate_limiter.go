@@ -0,0 +1,382 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// rateLimitConfigKey is the key of the rate limit config in etcd, relative to the meta root path
+const rateLimitConfigKey = "proxy/rate_limit"
+
+// headerClientID is the metadata key identifying the client of a request
+const headerClientID = "client_id"
+
+// rateLimitEvictInterval is the interval of evicting the idle buckets of the collections and the clients
+const rateLimitEvictInterval = time.Minute
+
+type rateType int
+
+const (
+	insertRowsRate rateType = iota
+	insertBytesRate
+	deleteOpsRate
+	searchQPS
+	queryQPS
+)
+
+func (rt rateType) String() string {
+	switch rt {
+	case insertRowsRate:
+		return "insert rows"
+	case insertBytesRate:
+		return "insert bytes"
+	case deleteOpsRate:
+		return "delete ops"
+	case searchQPS:
+		return "search qps"
+	default:
+		return "query qps"
+	}
+}
+
+// rateLimits holds the limit of each rate per second, 0 means unlimited
+type rateLimits struct {
+	InsertRows  float64 `json:"insert_rows"`
+	InsertBytes float64 `json:"insert_bytes"`
+	DeleteOps   float64 `json:"delete_ops"`
+	SearchQPS   float64 `json:"search_qps"`
+	QueryQPS    float64 `json:"query_qps"`
+}
+
+func (l rateLimits) get(rt rateType) float64 {
+	switch rt {
+	case insertRowsRate:
+		return l.InsertRows
+	case insertBytesRate:
+		return l.InsertBytes
+	case deleteOpsRate:
+		return l.DeleteOps
+	case searchQPS:
+		return l.SearchQPS
+	default:
+		return l.QueryQPS
+	}
+}
+
+// rateLimitConfig is the rate limit config stored in etcd as json. Global limits all the requests of the proxy,
+// Collection and Client are the default limits of each collection and each client, which are overridden by
+// the limits of the collections by "db.collection" and the clients by id.
+type rateLimitConfig struct {
+	Global      rateLimits            `json:"global"`
+	Collection  rateLimits            `json:"collection"`
+	Client      rateLimits            `json:"client"`
+	Collections map[string]rateLimits `json:"collections"`
+	Clients     map[string]rateLimits `json:"clients"`
+}
+
+// defaultRateLimitConfig returns the global limits configured in milvus.yaml
+func defaultRateLimitConfig() *rateLimitConfig {
+	return &rateLimitConfig{
+		Global: rateLimits{
+			InsertRows:  Params.MaxInsertRowsRate,
+			InsertBytes: Params.MaxInsertBytesRate,
+			DeleteOps:   Params.MaxDeleteOpsRate,
+			SearchQPS:   Params.MaxSearchQPS,
+			QueryQPS:    Params.MaxQueryQPS,
+		},
+	}
+}
+
+// tokenBucket refills rate tokens per second up to rate tokens. A request taking more tokens than the bucket
+// holds is admitted once the bucket is full, and the bucket owes the excess tokens.
+type tokenBucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: rate, tokens: rate, last: now}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+}
+
+func (b *tokenBucket) allow(n float64) bool {
+	if n > b.rate {
+		n = b.rate
+	}
+	return b.tokens >= n
+}
+
+// rateLimiter limits the rates of the requests globally, per collection and per client with token buckets.
+// The buckets are only created for the limited rates, and the idle ones are evicted since a full bucket
+// admits the same requests as a new one.
+type rateLimiter struct {
+	mu          sync.Mutex
+	config      *rateLimitConfig
+	global      map[rateType]*tokenBucket
+	collections map[string]map[rateType]*tokenBucket
+	clients     map[string]map[rateType]*tokenBucket
+	lastEvict   time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	rl := &rateLimiter{}
+	rl.setConfig(defaultRateLimitConfig())
+	return rl
+}
+
+// setConfig replaces the limits, the buckets are refilled
+func (rl *rateLimiter) setConfig(config *rateLimitConfig) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.config = config
+	rl.global = make(map[rateType]*tokenBucket)
+	rl.collections = make(map[string]map[rateType]*tokenBucket)
+	rl.clients = make(map[string]map[rateType]*tokenBucket)
+}
+
+// loadConfig parses the json rate limit config and replaces the limits, the limits missing in the json
+// keep the defaults configured in milvus.yaml
+func (rl *rateLimiter) loadConfig(value string) error {
+	config := defaultRateLimitConfig()
+	if err := json.Unmarshal([]byte(value), config); err != nil {
+		return fmt.Errorf("invalid rate limit config %s: %w", value, err)
+	}
+	rl.setConfig(config)
+	return nil
+}
+
+// bucket returns the bucket of rt in buckets, it returns nil if the rate is unlimited
+func bucket(buckets map[rateType]*tokenBucket, rt rateType, limit float64, now time.Time) *tokenBucket {
+	if limit <= 0 {
+		return nil
+	}
+	b, ok := buckets[rt]
+	if !ok || b.rate != limit {
+		b = newTokenBucket(limit, now)
+		buckets[rt] = b
+	}
+	b.refill(now)
+	return b
+}
+
+// keyBucket returns the bucket of rt of key in buckets, it returns nil if the rate is unlimited
+func keyBucket(buckets map[string]map[rateType]*tokenBucket, key string, rt rateType, limit float64, now time.Time) *tokenBucket {
+	if limit <= 0 {
+		return nil
+	}
+	if _, ok := buckets[key]; !ok {
+		buckets[key] = make(map[rateType]*tokenBucket)
+	}
+	return bucket(buckets[key], rt, limit, now)
+}
+
+// evictFullBuckets removes the buckets which are full by now
+func evictFullBuckets(buckets map[string]map[rateType]*tokenBucket, now time.Time) {
+	for key, keyBuckets := range buckets {
+		for rt, b := range keyBuckets {
+			if b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.rate {
+				delete(keyBuckets, rt)
+			}
+		}
+		if len(keyBuckets) == 0 {
+			delete(buckets, key)
+		}
+	}
+}
+
+// collectionKey returns the key of the collection in the limits, which is "db.collection"
+func collectionKey(dbName string, collection string) string {
+	if dbName == "" {
+		dbName = common.DefaultDBName
+	}
+	return dbName + "." + collection
+}
+
+// limit takes n tokens of rt from the global, the collection and the client buckets,
+// no token is taken unless all of them admit the request
+func (rl *rateLimiter) limit(rt rateType, dbName string, collectionName string, client string, n float64, now time.Time) error {
+	if n <= 0 {
+		return nil
+	}
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if now.Sub(rl.lastEvict) >= rateLimitEvictInterval {
+		evictFullBuckets(rl.collections, now)
+		evictFullBuckets(rl.clients, now)
+		rl.lastEvict = now
+	}
+
+	collection := collectionKey(dbName, collectionName)
+	collectionLimits, ok := rl.config.Collections[collection]
+	if !ok {
+		collectionLimits = rl.config.Collection
+	}
+	clientLimits, ok := rl.config.Clients[client]
+	if !ok {
+		clientLimits = rl.config.Client
+	}
+
+	buckets := []*tokenBucket{
+		bucket(rl.global, rt, rl.config.Global.get(rt), now),
+		keyBucket(rl.collections, collection, rt, collectionLimits.get(rt), now),
+		keyBucket(rl.clients, client, rt, clientLimits.get(rt), now),
+	}
+	scopes := []string{"proxy", "collection " + collection, "client " + client}
+	for i, b := range buckets {
+		if b != nil && !b.allow(n) {
+			return errRateLimited(rt, scopes[i], b.rate)
+		}
+	}
+	for _, b := range buckets {
+		if b != nil {
+			b.tokens -= n
+		}
+	}
+	return nil
+}
+
+// rateChargedKey marks the context of the requests issued by a task which has already been charged for them,
+// e.g. the sub-searches of a hybrid search.
+type rateChargedKey struct{}
+
+// withRateCharged returns a context whose requests are not charged again by the rate limiter.
+func withRateCharged(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rateChargedKey{}, true)
+}
+
+// check limits the rates of the insert, delete, search and query tasks, the other tasks are not limited
+func (rl *rateLimiter) check(t task) error {
+	if charged, ok := t.TraceCtx().Value(rateChargedKey{}).(bool); ok && charged {
+		return nil
+	}
+	now := time.Now()
+	client := getClientID(t.TraceCtx())
+	switch tt := t.(type) {
+	case *insertTask:
+		if err := rl.limit(insertRowsRate, tt.req.GetDbName(), tt.req.GetCollectionName(), client, float64(tt.req.GetNumRows()), now); err != nil {
+			return err
+		}
+		return rl.limit(insertBytesRate, tt.req.GetDbName(), tt.req.GetCollectionName(), client, float64(proto.Size(tt.req)), now)
+	case *upsertTask:
+		if err := rl.limit(insertRowsRate, tt.req.GetDbName(), tt.req.GetCollectionName(), client, float64(tt.req.GetNumRows()), now); err != nil {
+			return err
+		}
+		if err := rl.limit(insertBytesRate, tt.req.GetDbName(), tt.req.GetCollectionName(), client, float64(proto.Size(tt.req)), now); err != nil {
+			return err
+		}
+		return rl.limit(deleteOpsRate, tt.req.GetDbName(), tt.req.GetCollectionName(), client, 1, now)
+	case *deleteTask:
+		return rl.limit(deleteOpsRate, tt.req.GetDbName(), tt.req.GetCollectionName(), client, 1, now)
+	case *searchTask:
+		return rl.limit(searchQPS, tt.query.GetDbName(), tt.query.GetCollectionName(), client, 1, now)
+	case *hybridSearchTask:
+		// charged for all the sub-searches at once, which are issued with a charged context
+		return rl.limit(searchQPS, tt.req.GetDbName(), tt.req.GetCollectionName(), client, float64(len(tt.req.GetRequests())), now)
+	case *queryTask:
+		return rl.limit(queryQPS, tt.query.GetDbName(), tt.query.GetCollectionName(), client, 1, now)
+	}
+	return nil
+}
+
+// getClientID returns the id of the client sending the request. When the authorization is enabled it is the
+// authenticated user, otherwise the client_id in the metadata, or the address of the client if there is none.
+func getClientID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if Params.AuthorizationEnabled {
+		if username, err := GetCurUserFromContext(ctx); err == nil {
+			return username
+		}
+	} else if md, ok := metadata.FromIncomingContext(ctx); ok {
+		// the requests are anonymous, the client_id is all that identifies the client
+		if ids := md.Get(headerClientID); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+	return ""
+}
+
+// watchRateLimitConfig loads the rate limit config from etcd and reloads it whenever it changes,
+// the limits of milvus.yaml are restored once the config is deleted
+func (node *Proxy) watchRateLimitConfig() {
+	if value, err := node.etcdKV.Load(rateLimitConfigKey); err == nil {
+		if err := node.sched.rateLimiter.loadConfig(value); err != nil {
+			log.Warn("failed to load rate limit config", zap.Error(err))
+		}
+	}
+
+	node.wg.Add(1)
+	go func() {
+		defer node.wg.Done()
+		watchChan := node.etcdKV.WatchWithPrefix(rateLimitConfigKey)
+		for {
+			select {
+			case <-node.ctx.Done():
+				return
+			case resp, ok := <-watchChan:
+				if !ok {
+					log.Warn("rate limit config watch channel closed")
+					return
+				}
+				for _, event := range resp.Events {
+					if event.Type == mvccpb.DELETE {
+						node.sched.rateLimiter.setConfig(defaultRateLimitConfig())
+						log.Debug("rate limit config deleted, restore the default limits")
+						continue
+					}
+					if err := node.sched.rateLimiter.loadConfig(string(event.Kv.Value)); err != nil {
+						log.Warn("failed to reload rate limit config", zap.Error(err))
+						continue
+					}
+					log.Debug("rate limit config reloaded", zap.String("config", string(event.Kv.Value)))
+				}
+			}
+		}
+	}()
+}
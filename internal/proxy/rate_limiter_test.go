@@ -0,0 +1,192 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(10, now)
+	assert.True(t, b.allow(10))
+	b.tokens -= 10
+	assert.False(t, b.allow(1))
+
+	b.refill(now.Add(100 * time.Millisecond))
+	assert.True(t, b.allow(1))
+	assert.False(t, b.allow(2))
+
+	// a request larger than the bucket is admitted once the bucket is full
+	b.refill(now.Add(time.Hour))
+	assert.Equal(t, float64(10), b.tokens)
+	assert.True(t, b.allow(100))
+}
+
+func TestRateLimiter_limit(t *testing.T) {
+	now := time.Now()
+	rl := &rateLimiter{}
+	rl.setConfig(&rateLimitConfig{
+		Global:      rateLimits{SearchQPS: 10},
+		Collection:  rateLimits{SearchQPS: 5},
+		Client:      rateLimits{SearchQPS: 3},
+		Collections: map[string]rateLimits{"default.big": {SearchQPS: 8}},
+		Clients:     map[string]rateLimits{"vip": {}},
+	})
+
+	// limited per client
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, rl.limit(searchQPS, "", "c1", "u1", 1, now))
+	}
+	err := rl.limit(searchQPS, "", "c1", "u1", 1, now)
+	assert.True(t, errors.Is(err, errRateLimit))
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, enqueueErrorCode(err))
+
+	// limited per collection, the rejected requests take no token
+	assert.NoError(t, rl.limit(searchQPS, "", "c1", "vip", 2, now))
+	assert.Error(t, rl.limit(searchQPS, "", "c1", "vip", 1, now))
+	assert.NoError(t, rl.limit(searchQPS, "", "big", "vip", 4, now))
+
+	// limited globally
+	assert.Error(t, rl.limit(searchQPS, "", "big", "vip", 2, now))
+	assert.NoError(t, rl.limit(searchQPS, "", "big", "vip", 1, now))
+
+	// the other rates are unlimited
+	assert.NoError(t, rl.limit(queryQPS, "", "c1", "u1", 100, now))
+
+	// the tokens are refilled
+	assert.NoError(t, rl.limit(searchQPS, "", "c1", "u1", 1, now.Add(time.Second)))
+
+	// the collections of different databases are limited apart
+	later := now.Add(2 * time.Second)
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, rl.limit(searchQPS, "db1", "big", "vip", 1, later))
+	}
+	assert.Error(t, rl.limit(searchQPS, "db1", "big", "vip", 1, later))
+	assert.NoError(t, rl.limit(searchQPS, "", "big", "vip", 1, later))
+
+	// the buckets are only created for the limited rates, the full ones are evicted
+	assert.Equal(t, 3, len(rl.collections))
+	assert.Nil(t, rl.collections["default.c2"])
+	assert.NoError(t, rl.limit(queryQPS, "", "c2", "u2", 1, now))
+	assert.Nil(t, rl.collections["default.c2"])
+	assert.Nil(t, rl.clients["u2"])
+	assert.NoError(t, rl.limit(queryQPS, "", "c2", "u2", 1, now.Add(rateLimitEvictInterval)))
+	assert.Equal(t, 0, len(rl.collections))
+	assert.Equal(t, 0, len(rl.clients))
+
+	// the buckets are reset with the config
+	assert.NoError(t, rl.loadConfig(`{"global": {"search_qps": 1}}`))
+	assert.NoError(t, rl.limit(searchQPS, "", "c1", "u1", 1, now))
+	assert.Error(t, rl.limit(searchQPS, "", "c1", "u1", 1, now))
+	assert.Error(t, rl.loadConfig("invalid"))
+}
+
+func TestRateLimiter_loadConfig(t *testing.T) {
+	Params.Init()
+	defer Params.initRateLimits()
+	Params.MaxInsertRowsRate = 100
+	Params.MaxSearchQPS = 10
+	rl := newRateLimiter()
+
+	// the etcd config is merged over the limits of milvus.yaml
+	assert.NoError(t, rl.loadConfig(`{"global": {"search_qps": 1}, "collections": {"default.c1": {"query_qps": 2}}}`))
+	assert.Equal(t, float64(100), rl.config.Global.InsertRows)
+	assert.Equal(t, float64(1), rl.config.Global.SearchQPS)
+	assert.Equal(t, float64(2), rl.config.Collections["default.c1"].QueryQPS)
+
+	assert.NoError(t, rl.loadConfig(`{"collections": {"default.c1": {"query_qps": 2}}}`))
+	assert.Equal(t, float64(100), rl.config.Global.InsertRows)
+	assert.Equal(t, float64(10), rl.config.Global.SearchQPS)
+}
+
+func TestRateLimiter_check(t *testing.T) {
+	Params.Init()
+	rl := &rateLimiter{}
+	rl.setConfig(&rateLimitConfig{
+		Global: rateLimits{InsertRows: 10, DeleteOps: 1, SearchQPS: 1, QueryQPS: 1},
+	})
+	ctx := context.Background()
+	newInsertTask := func(numRows uint32) *insertTask {
+		return &insertTask{ctx: ctx, req: &milvuspb.InsertRequest{CollectionName: "c", NumRows: numRows}}
+	}
+
+	assert.NoError(t, rl.check(newInsertTask(8)))
+	assert.Error(t, rl.check(newInsertTask(8)))
+	assert.NoError(t, rl.check(&deleteTask{ctx: ctx, req: &milvuspb.DeleteRequest{CollectionName: "c"}}))
+	assert.Error(t, rl.check(&deleteTask{ctx: ctx, req: &milvuspb.DeleteRequest{CollectionName: "c"}}))
+	assert.Error(t, rl.check(&upsertTask{ctx: ctx, req: &milvuspb.UpsertRequest{CollectionName: "c", NumRows: 1}}))
+	assert.NoError(t, rl.check(&searchTask{ctx: ctx, query: &milvuspb.SearchRequest{CollectionName: "c"}}))
+	assert.Error(t, rl.check(&searchTask{ctx: ctx, query: &milvuspb.SearchRequest{CollectionName: "c"}}))
+	assert.NoError(t, rl.check(&queryTask{ctx: ctx, query: &milvuspb.QueryRequest{CollectionName: "c"}}))
+	assert.Error(t, rl.check(&queryTask{ctx: ctx, query: &milvuspb.QueryRequest{CollectionName: "c"}}))
+
+	// tasks other than dml and dql are not limited
+	assert.NoError(t, rl.check(newDefaultMockTask()))
+
+	// the queue rejects the limited tasks before allocating any id
+	queue := newBaseTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+	queue.rateLimiter = rl
+	assert.Error(t, queue.Enqueue(newInsertTask(20)))
+	assert.True(t, queue.utEmpty())
+
+	// hybrid search is charged as search for each of its sub-searches, which are not charged again
+	rl.setConfig(&rateLimitConfig{Global: rateLimits{SearchQPS: 2}})
+	hybrid := &hybridSearchTask{ctx: ctx, req: &milvuspb.HybridSearchRequest{CollectionName: "c",
+		Requests: []*milvuspb.SearchRequest{{}, {}}}}
+	assert.NoError(t, rl.check(hybrid))
+	assert.Error(t, rl.check(&searchTask{ctx: ctx, query: &milvuspb.SearchRequest{CollectionName: "c"}}))
+	assert.NoError(t, rl.check(&searchTask{ctx: withRateCharged(ctx), query: &milvuspb.SearchRequest{CollectionName: "c"}}))
+	assert.Error(t, rl.check(hybrid))
+}
+
+func TestGetClientID(t *testing.T) {
+	assert.Equal(t, "", getClientID(context.Background()))
+
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	assert.Equal(t, "10.0.0.1", getClientID(ctx))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(headerClientID, "job",
+		headerAuthorize, crypto.Base64Encode("mockUser:mockPass")))
+	assert.Equal(t, "job", getClientID(ctx))
+
+	// the authenticated user can't be overridden by the client_id
+	Params.AuthorizationEnabled = true
+	defer func() { Params.AuthorizationEnabled = false }()
+	assert.Equal(t, "mockUser", getClientID(ctx))
+	ctx = metadata.NewIncomingContext(peer.NewContext(context.Background(), &peer.Peer{Addr: addr}),
+		metadata.Pairs(headerClientID, "job"))
+	assert.Equal(t, "10.0.0.1", getClientID(ctx))
+}
+
+func TestEnqueueErrorCode(t *testing.T) {
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, enqueueErrorCode(errors.New("mock")))
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, enqueueErrorCode(errRateLimited(insertRowsRate, "proxy", 1)))
+}
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	RankStrategyKey  = "strategy"
+	RRFParamKey      = "k"
+	WeightsParamKey  = "weights"
+	RRFRankStrategy  = "rrf"
+	WeightedStrategy = "weighted"
+	DefaultRRFParamK = 60
+)
+
+// reranker fuses the hits of the sub-searches of a hybrid search into one ranking
+type reranker struct {
+	strategy    string
+	k           float64   // smoothing constant of rrf
+	weights     []float64 // weight of each sub-search of the weighted strategy
+	metricTypes []string  // metric type of each sub-search, used to normalize the scores
+}
+
+// parseRankParams parses the rank params of a hybrid search of the sub-searches with the metric types,
+// the strategy is rrf by default, rrf takes the optional k and weighted requires one weight for each sub-search
+func parseRankParams(rankParams []*commonpb.KeyValuePair, metricTypes []string) (*reranker, error) {
+	strategy, err := funcutil.GetAttrByKeyFromRepeatedKV(RankStrategyKey, rankParams)
+	if err != nil {
+		strategy = RRFRankStrategy
+	}
+	r := &reranker{
+		strategy:    strings.ToLower(strategy),
+		metricTypes: metricTypes,
+	}
+
+	switch r.strategy {
+	case RRFRankStrategy:
+		kStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RRFParamKey, rankParams)
+		if err != nil {
+			kStr = strconv.Itoa(DefaultRRFParamK)
+		}
+		k, err := strconv.ParseFloat(kStr, 64)
+		if err != nil || k <= 0 {
+			return nil, errors.New(RRFParamKey + " " + kStr + " is not invalid")
+		}
+		r.k = k
+	case WeightedStrategy:
+		weightsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(WeightsParamKey, rankParams)
+		if err != nil {
+			return nil, errors.New(WeightsParamKey + " not found in rank_params")
+		}
+		if err := json.Unmarshal([]byte(weightsStr), &r.weights); err != nil {
+			return nil, errors.New(WeightsParamKey + " " + weightsStr + " is not invalid")
+		}
+		if len(r.weights) != len(metricTypes) {
+			return nil, fmt.Errorf("the number of %s %d mis-match with the number of requests %d", WeightsParamKey, len(r.weights), len(metricTypes))
+		}
+		for _, w := range r.weights {
+			if w < 0 || w > 1 {
+				return nil, errors.New(WeightsParamKey + " " + weightsStr + " should be in range [0, 1]")
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported rank strategy %s, only %s and %s are supported", strategy, RRFRankStrategy, WeightedStrategy)
+	}
+	return r, nil
+}
+
+// normalizeScore maps the score of a metric to [0, 1], the larger the more similar,
+// the distances of L2 and the other metrics are non-negative and the smaller the more similar
+func normalizeScore(metricType string, score float32) float64 {
+	if strings.ToUpper(metricType) == "IP" {
+		return 0.5 + math.Atan(float64(score))/math.Pi
+	}
+	return 1.0 - 2*math.Atan(float64(score))/math.Pi
+}
+
+// score returns the contribution of the hit at rank (starting from 0) with the score of the i-th sub-search
+func (r *reranker) score(i int, rank int, score float32) float64 {
+	if r.strategy == RRFRankStrategy {
+		return 1.0 / (r.k + float64(rank+1))
+	}
+	return r.weights[i] * normalizeScore(r.metricTypes[i], score)
+}
+
+// rerank fuses the search results of the sub-searches, the hits of each query are sorted by the fused scores
+// in descending order and the hits in [offset, offset + topk) are returned, ties are broken by the ids
+func (r *reranker) rerank(results []*schemapb.SearchResultData, topk int64, offset int64) (*schemapb.SearchResultData, error) {
+	if len(results) != len(r.metricTypes) {
+		return nil, fmt.Errorf("the number of search results %d mis-match with the number of requests %d", len(results), len(r.metricTypes))
+	}
+	nq := results[0].GetNumQueries()
+	bounds := make([][]int64, len(results))
+	for i, data := range results {
+		if data.GetNumQueries() != nq {
+			return nil, fmt.Errorf("search result's nq(%d) mis-match with %d", data.GetNumQueries(), nq)
+		}
+		bounds[i] = make([]int64, nq+1)
+		for qi := int64(0); qi < nq; qi++ {
+			bounds[i][qi+1] = bounds[i][qi]
+			if qi < int64(len(data.GetTopks())) {
+				bounds[i][qi+1] += data.GetTopks()[qi]
+			}
+		}
+		if bounds[i][nq] != int64(typeutil.GetSizeOfIDs(data.GetIds())) || bounds[i][nq] != int64(len(data.GetScores())) {
+			return nil, fmt.Errorf("search result's id length %d invalid", typeutil.GetSizeOfIDs(data.GetIds()))
+		}
+	}
+
+	ret := &schemapb.SearchResultData{
+		NumQueries: nq,
+		Scores:     make([]float32, 0),
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: make([]int64, 0),
+				},
+			},
+		},
+		Topks: make([]int64, 0, nq),
+	}
+	for qi := int64(0); qi < nq; qi++ {
+		scores := make(map[interface{}]float64)
+		ids := make([]interface{}, 0)
+		for i, data := range results {
+			for idx := bounds[i][qi]; idx < bounds[i][qi+1]; idx++ {
+				id := typeutil.GetPK(data.Ids, idx)
+				if _, ok := scores[id]; !ok {
+					ids = append(ids, id)
+				}
+				scores[id] += r.score(i, int(idx-bounds[i][qi]), data.Scores[idx])
+			}
+		}
+		sort.Slice(ids, func(a, b int) bool {
+			if scores[ids[a]] != scores[ids[b]] {
+				return scores[ids[a]] > scores[ids[b]]
+			}
+			return typeutil.LessPK(ids[a], ids[b])
+		})
+
+		var k int64
+		for j := offset; j < int64(len(ids)) && j < offset+topk; j++ {
+			typeutil.AppendPKs(ret.Ids, ids[j])
+			ret.Scores = append(ret.Scores, float32(scores[ids[j]]))
+			k++
+		}
+		ret.Topks = append(ret.Topks, k)
+		if k > ret.TopK {
+			ret.TopK = k
+		}
+	}
+	return ret, nil
+}
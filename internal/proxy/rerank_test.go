@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestParseRankParams(t *testing.T) {
+	metricTypes := []string{"L2", "IP"}
+
+	r, err := parseRankParams(nil, metricTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, RRFRankStrategy, r.strategy)
+	assert.Equal(t, float64(DefaultRRFParamK), r.k)
+
+	r, err = parseRankParams([]*commonpb.KeyValuePair{{Key: RankStrategyKey, Value: "RRF"}, {Key: RRFParamKey, Value: "10"}}, metricTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(10), r.k)
+
+	r, err = parseRankParams([]*commonpb.KeyValuePair{{Key: RankStrategyKey, Value: WeightedStrategy}, {Key: WeightsParamKey, Value: "[0.3, 0.7]"}}, metricTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0.3, 0.7}, r.weights)
+
+	invalid := [][]*commonpb.KeyValuePair{
+		{{Key: RankStrategyKey, Value: "unknown"}},
+		{{Key: RRFParamKey, Value: "0"}},
+		{{Key: RRFParamKey, Value: "k"}},
+		{{Key: RankStrategyKey, Value: WeightedStrategy}},
+		{{Key: RankStrategyKey, Value: WeightedStrategy}, {Key: WeightsParamKey, Value: "0.3"}},
+		{{Key: RankStrategyKey, Value: WeightedStrategy}, {Key: WeightsParamKey, Value: "[0.3]"}},
+		{{Key: RankStrategyKey, Value: WeightedStrategy}, {Key: WeightsParamKey, Value: "[0.3, 1.7]"}},
+	}
+	for _, params := range invalid {
+		_, err = parseRankParams(params, metricTypes)
+		assert.Error(t, err)
+	}
+}
+
+func TestNormalizeScore(t *testing.T) {
+	assert.InDelta(t, 0.5, normalizeScore("IP", 0), 1e-6)
+	assert.Greater(t, normalizeScore("IP", 2), normalizeScore("IP", 1))
+	assert.InDelta(t, 1.0, normalizeScore("L2", 0), 1e-6)
+	assert.Less(t, normalizeScore("L2", 2), normalizeScore("L2", 1))
+	assert.Greater(t, normalizeScore("L2", 1000), 0.0)
+}
+
+func TestReranker_rerank(t *testing.T) {
+	newData := func(topks []int64, ids []int64, scores []float32) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: int64(len(topks)),
+			Topks:      topks,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+			Scores:     scores,
+		}
+	}
+	// two queries, the distances of L2 and the scores of IP
+	l2 := newData([]int64{3, 2}, []int64{1, 2, 3, 4, 5}, []float32{0.1, 0.2, 0.3, 0.5, 0.6})
+	ip := newData([]int64{2, 1}, []int64{3, 1, 6}, []float32{0.9, 0.8, 0.7})
+
+	t.Run("rrf", func(t *testing.T) {
+		r := &reranker{strategy: RRFRankStrategy, k: 60, metricTypes: []string{"L2", "IP"}}
+		ret, err := r.rerank([]*schemapb.SearchResultData{l2, ip}, 2, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), ret.NumQueries)
+		assert.Equal(t, []int64{2, 2}, ret.Topks)
+		assert.Equal(t, int64(2), ret.TopK)
+		// query 0: 1 and 3 are ranked by both, 1 has the better ranks
+		// query 1: 4 and 6 are both ranked first, the tie is broken by the ids
+		assert.Equal(t, []int64{1, 3, 4, 6}, ret.Ids.GetIntId().GetData())
+		assert.InDelta(t, 1.0/61+1.0/62, ret.Scores[0], 1e-6)
+
+		ret, err = r.rerank([]*schemapb.SearchResultData{l2, ip}, 2, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 2}, ret.Topks)
+		assert.Equal(t, []int64{3, 2, 6, 5}, ret.Ids.GetIntId().GetData())
+
+		ret, err = r.rerank([]*schemapb.SearchResultData{l2, ip}, 10, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 1}, ret.Topks)
+		assert.Equal(t, []int64{2, 5}, ret.Ids.GetIntId().GetData())
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		r := &reranker{strategy: WeightedStrategy, weights: []float64{0.1, 0.9}, metricTypes: []string{"L2", "IP"}}
+		ret, err := r.rerank([]*schemapb.SearchResultData{l2, ip}, 3, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3, 3}, ret.Topks)
+		// the IP search dominates with the larger weight
+		assert.Equal(t, []int64{3, 1, 2, 6, 4, 5}, ret.Ids.GetIntId().GetData())
+		expected := 0.1*normalizeScore("L2", 0.3) + 0.9*normalizeScore("IP", 0.9)
+		assert.InDelta(t, expected, ret.Scores[0], 1e-6)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		r := &reranker{strategy: RRFRankStrategy, k: 60, metricTypes: []string{"L2", "IP"}}
+		_, err := r.rerank([]*schemapb.SearchResultData{l2}, 2, 0)
+		assert.Error(t, err)
+		_, err = r.rerank([]*schemapb.SearchResultData{l2, newData([]int64{2}, []int64{3, 1}, []float32{0.9, 0.8})}, 2, 0)
+		assert.Error(t, err)
+		_, err = r.rerank([]*schemapb.SearchResultData{l2, newData([]int64{2, 2}, []int64{3, 1, 6}, []float32{0.9, 0.8, 0.7})}, 2, 0)
+		assert.Error(t, err)
+	})
+}
@@ -106,6 +106,20 @@ type RootCoordMock struct {
 
 	lastTs    typeutil.Timestamp
 	lastTsMtx sync.Mutex
+
+	// username -> credential
+	credentials map[string]*internalpb.CredentialInfo
+	credMtx     sync.RWMutex
+
+	// role -> struct{}, username -> roles, policy -> grant
+	roles     map[string]struct{}
+	userRoles map[string]map[string]struct{}
+	grants    map[string]*milvuspb.GrantEntity
+	rbacMtx   sync.RWMutex
+
+	// database name -> created timestamp
+	databases map[string]uint64
+	dbMtx     sync.RWMutex
 }
 
 func (coord *RootCoordMock) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
@@ -200,6 +214,40 @@ func (coord *RootCoordMock) AlterAlias(ctx context.Context, req *milvuspb.AlterA
 	}, nil
 }
 
+func (coord *RootCoordMock) AddCollectionField(ctx context.Context, req *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	collID, exist := coord.collName2ID[req.CollectionName]
+	if !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    fmt.Sprintf("collection does not exist, name = %s", req.CollectionName),
+		}, nil
+	}
+	var field schemapb.FieldSchema
+	if err := proto.Unmarshal(req.Schema, &field); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("failed to parse field schema, error: %v", err),
+		}, nil
+	}
+	meta := coord.collID2Meta[collID]
+	field.FieldID = int64(common.StartOfUserFieldID + len(meta.schema.Fields))
+	meta.schema.Fields = append(meta.schema.Fields, &field)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (coord *RootCoordMock) updateState(state internalpb.StateCode) {
 	coord.state.Store(state)
 }
@@ -926,6 +974,316 @@ func (coord *RootCoordMock) GetMetrics(ctx context.Context, req *milvuspb.GetMet
 	}, nil
 }
 
+func (coord *RootCoordMock) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "unhealthy",
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	if _, ok := coord.credentials[req.Username]; ok {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateCredentialFailure,
+			Reason:    fmt.Sprintf("user already exists, username = %s", req.Username),
+		}, nil
+	}
+	coord.credentials[req.Username] = proto.Clone(req).(*internalpb.CredentialInfo)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "unhealthy",
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	if _, ok := coord.credentials[req.Username]; !ok {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    fmt.Sprintf("user does not exist, username = %s", req.Username),
+		}, nil
+	}
+	coord.credentials[req.Username] = proto.Clone(req).(*internalpb.CredentialInfo)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "unhealthy",
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	delete(coord.credentials, req.Username)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+	coord.credMtx.RLock()
+	defer coord.credMtx.RUnlock()
+	usernames := make([]string, 0, len(coord.credentials))
+	for username := range coord.credentials {
+		usernames = append(usernames, username)
+	}
+	return &milvuspb.ListCredUsersResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Usernames: usernames,
+	}, nil
+}
+
+func (coord *RootCoordMock) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	if !coord.healthy() {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+	coord.credMtx.RLock()
+	defer coord.credMtx.RUnlock()
+	credInfo, ok := coord.credentials[req.Username]
+	if !ok {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_GetCredentialFailure,
+				Reason:    fmt.Sprintf("user does not exist, username = %s", req.Username),
+			},
+		}, nil
+	}
+	return &rootcoordpb.GetCredentialResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Username: credInfo.Username,
+		Password: credInfo.EncryptedPassword,
+	}, nil
+}
+
+func (coord *RootCoordMock) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "unhealthy"}, nil
+	}
+	coord.rbacMtx.Lock()
+	defer coord.rbacMtx.Unlock()
+	if _, ok := coord.roles[req.Entity.Name]; ok {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateRoleFailure,
+			Reason:    fmt.Sprintf("role already exists, role = %s", req.Entity.Name),
+		}, nil
+	}
+	coord.roles[req.Entity.Name] = struct{}{}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "unhealthy"}, nil
+	}
+	coord.rbacMtx.Lock()
+	defer coord.rbacMtx.Unlock()
+	if _, ok := coord.roles[req.RoleName]; !ok {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DropRoleFailure,
+			Reason:    fmt.Sprintf("role does not exist, role = %s", req.RoleName),
+		}, nil
+	}
+	delete(coord.roles, req.RoleName)
+	for _, roles := range coord.userRoles {
+		delete(roles, req.RoleName)
+	}
+	for policy, grant := range coord.grants {
+		if grant.Role.Name == req.RoleName {
+			delete(coord.grants, policy)
+		}
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "unhealthy"}, nil
+	}
+	coord.rbacMtx.Lock()
+	defer coord.rbacMtx.Unlock()
+	if _, ok := coord.roles[req.RoleName]; !ok {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_OperateUserRoleFailure,
+			Reason:    fmt.Sprintf("role does not exist, role = %s", req.RoleName),
+		}, nil
+	}
+	if req.Type == milvuspb.OperateUserRoleType_RemoveUserFromRole {
+		delete(coord.userRoles[req.Username], req.RoleName)
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	if _, ok := coord.userRoles[req.Username]; !ok {
+		coord.userRoles[req.Username] = make(map[string]struct{})
+	}
+	coord.userRoles[req.Username][req.RoleName] = struct{}{}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "unhealthy"}, nil
+	}
+	coord.rbacMtx.Lock()
+	defer coord.rbacMtx.Unlock()
+	if _, ok := coord.roles[req.Entity.Role.Name]; !ok {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_OperatePrivilegeFailure,
+			Reason:    fmt.Sprintf("role does not exist, role = %s", req.Entity.Role.Name),
+		}, nil
+	}
+	policy := funcutil.PolicyForPrivilege(req.Entity.Role.Name, req.Entity.Object.Name,
+		funcutil.PolicyObjectName(req.Entity.Object.Name, req.Entity.DbName, req.Entity.ObjectName), req.Entity.Grantor.Privilege.Name)
+	if req.Type == milvuspb.OperatePrivilegeType_Revoke {
+		delete(coord.grants, policy)
+	} else {
+		coord.grants[policy] = req.Entity
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "unhealthy"},
+		}, nil
+	}
+	coord.rbacMtx.RLock()
+	defer coord.rbacMtx.RUnlock()
+	entities := make([]*milvuspb.GrantEntity, 0)
+	for _, grant := range coord.grants {
+		if grant.Role.Name != req.Entity.Role.Name {
+			continue
+		}
+		if req.Entity.Object != nil && grant.Object.Name != req.Entity.Object.Name {
+			continue
+		}
+		if req.Entity.ObjectName != "" && grant.ObjectName != req.Entity.ObjectName {
+			continue
+		}
+		entities = append(entities, grant)
+	}
+	return &milvuspb.SelectGrantResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Entities: entities,
+	}, nil
+}
+
+func (coord *RootCoordMock) ListPolicy(ctx context.Context, req *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+	if !coord.healthy() {
+		return &internalpb.ListPolicyResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "unhealthy"},
+		}, nil
+	}
+	coord.rbacMtx.RLock()
+	defer coord.rbacMtx.RUnlock()
+	policies := make([]string, 0, len(coord.grants))
+	for policy := range coord.grants {
+		policies = append(policies, policy)
+	}
+	userRoles := make([]string, 0)
+	for username, roles := range coord.userRoles {
+		for roleName := range roles {
+			userRoles = append(userRoles, funcutil.EncodeUserRoleCache(username, roleName))
+		}
+	}
+	return &internalpb.ListPolicyResponse{
+		Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		PolicyInfos: policies,
+		UserRoles:   userRoles,
+	}, nil
+}
+
+func (coord *RootCoordMock) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "unhealthy"}, nil
+	}
+	coord.dbMtx.Lock()
+	defer coord.dbMtx.Unlock()
+	if _, ok := coord.databases[req.DbName]; ok {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("database already exists, db = %s", req.DbName),
+		}, nil
+	}
+	coord.databases[req.DbName] = uint64(time.Now().UnixNano())
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "unhealthy"}, nil
+	}
+	coord.dbMtx.Lock()
+	defer coord.dbMtx.Unlock()
+	if req.DbName == common.DefaultDBName {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "can not drop default database",
+		}, nil
+	}
+	if _, ok := coord.databases[req.DbName]; !ok {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("database not found, db = %s", req.DbName),
+		}, nil
+	}
+	delete(coord.databases, req.DbName)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "unhealthy"},
+		}, nil
+	}
+	coord.dbMtx.RLock()
+	defer coord.dbMtx.RUnlock()
+	dbNames := make([]string, 0, len(coord.databases))
+	createdTimestamps := make([]uint64, 0, len(coord.databases))
+	for dbName, ts := range coord.databases {
+		dbNames = append(dbNames, dbName)
+		createdTimestamps = append(createdTimestamps, ts)
+	}
+	return &milvuspb.ListDatabasesResponse{
+		Status:            &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		DbNames:           dbNames,
+		CreatedTimestamps: createdTimestamps,
+	}, nil
+}
+
 func NewRootCoordMock(opts ...RootCoordMockOption) *RootCoordMock {
 	rc := &RootCoordMock{
 		nodeID:            typeutil.UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt()),
@@ -936,6 +1294,11 @@ func NewRootCoordMock(opts ...RootCoordMockOption) *RootCoordMock {
 		collID2Meta:       make(map[typeutil.UniqueID]collectionMeta),
 		collID2Partitions: make(map[typeutil.UniqueID]partitionMap),
 		lastTs:            typeutil.Timestamp(time.Now().UnixNano()),
+		credentials:       make(map[string]*internalpb.CredentialInfo),
+		roles:             map[string]struct{}{common.RoleAdmin: {}, common.RolePublic: {}},
+		userRoles:         make(map[string]map[string]struct{}),
+		grants:            make(map[string]*milvuspb.GrantEntity),
+		databases:         map[string]uint64{common.DefaultDBName: 0},
 	}
 
 	for _, opt := range opts {
@@ -28,6 +28,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unsafe"
 
 	"go.uber.org/zap"
@@ -58,10 +59,15 @@ const (
 	CreateCollectionTaskName        = "CreateCollectionTask"
 	DropCollectionTaskName          = "DropCollectionTask"
 	SearchTaskName                  = "SearchTask"
+	HybridSearchTaskName            = "HybridSearchTask"
 	RetrieveTaskName                = "RetrieveTask"
 	QueryTaskName                   = "QueryTask"
 	AnnsFieldKey                    = "anns_field"
 	TopKKey                         = "topk"
+	OffsetKey                       = "offset"
+	RadiusKey                       = "radius"
+	RangeFilterKey                  = "range_filter"
+	GroupByFieldKey                 = "group_by_field"
 	MetricTypeKey                   = "metric_type"
 	SearchParamsKey                 = "params"
 	RoundDecimalKey                 = "round_decimal"
@@ -85,9 +91,14 @@ const (
 	LoadPartitionTaskName           = "LoadPartitionsTask"
 	ReleasePartitionTaskName        = "ReleasePartitionsTask"
 	deleteTaskName                  = "DeleteTask"
+	UpsertTaskName                  = "UpsertTask"
 	CreateAliasTaskName             = "CreateAliasTask"
 	DropAliasTaskName               = "DropAliasTask"
 	AlterAliasTaskName              = "AlterAliasTask"
+	CreateDatabaseTaskName          = "CreateDatabaseTask"
+	AddCollectionFieldTaskName      = "AddCollectionFieldTask"
+	DropDatabaseTaskName            = "DropDatabaseTask"
+	ListDatabasesTaskName           = "ListDatabasesTask"
 
 	minFloat32 = -1 * float32(math.MaxFloat32)
 )
@@ -131,6 +142,10 @@ type insertTask struct {
 	vChannels      []vChan
 	pChannels      []pChan
 	schema         *schemapb.CollectionSchema
+
+	// the index of the partition each row is routed to, nil if the collection has no partition key
+	partitionIndexes []int64
+	numPartitions    int64
 }
 
 func (it *insertTask) TraceCtx() context.Context {
@@ -187,7 +202,7 @@ func (it *insertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *insertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.GetDbName(), it.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -238,10 +253,23 @@ func getNumRowsOfBinaryVectorField(bDatas []byte, dim int64) (uint32, error) {
 	return uint32(int((8 * int64(l)) / dim)), nil
 }
 
+// getNumRowsOfHalfFloatVectorField returns the number of rows of the float16 or bfloat16 vectors, 2 bytes per element
+func getNumRowsOfHalfFloatVectorField(bDatas []byte, dim int64) (uint32, error) {
+	if dim <= 0 {
+		return 0, errDimLessThanOrEqualToZero(int(dim))
+	}
+	l := len(bDatas)
+	if int64(l)%(2*dim) != 0 {
+		return 0, fmt.Errorf("the length(%d) of half float data should divide 2 * dim(%d)", l, dim)
+	}
+	return uint32(int(int64(l) / (2 * dim))), nil
+}
+
 func (it *insertTask) checkLengthOfFieldsData() error {
+	// the data of the autoID field is generated, the nullable fields and the fields with default values can be omitted
 	neededFieldsNum := 0
 	for _, field := range it.schema.Fields {
-		if !field.AutoID {
+		if !field.AutoID && !field.GetNullable() && field.GetDefaultValue() == nil {
 			neededFieldsNum++
 		}
 	}
@@ -265,6 +293,9 @@ func (it *insertTask) checkRowNums() error {
 	rowNums := it.req.NumRows
 
 	for i, field := range it.req.FieldsData {
+		if validNum := len(field.GetValidData()); validNum != 0 && uint32(validNum) != rowNums {
+			return errNumOfValidDataMismatchPassed(i, uint32(validNum), rowNums)
+		}
 		switch field.Field.(type) {
 		case *schemapb.FieldData_Scalars:
 			scalarField := field.GetScalars()
@@ -294,10 +325,23 @@ func (it *insertTask) checkRowNums() error {
 				if fieldNumRows != rowNums {
 					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
 				}
+			case *schemapb.ScalarField_StringData:
+				fieldNumRows := getNumRowsOfScalarField(scalarField.GetStringData().Data)
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
+			case *schemapb.ScalarField_ArrayData:
+				fieldNumRows := getNumRowsOfScalarField(scalarField.GetArrayData().Data)
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
+			case *schemapb.ScalarField_JsonData:
+				fieldNumRows := getNumRowsOfScalarField(scalarField.GetJsonData().Data)
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
 			case *schemapb.ScalarField_BytesData:
 				return errUnsupportedDType("bytes")
-			case *schemapb.ScalarField_StringData:
-				return errUnsupportedDType("string")
 			case nil:
 				continue
 			default:
@@ -324,6 +368,29 @@ func (it *insertTask) checkRowNums() error {
 				if fieldNumRows != rowNums {
 					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
 				}
+			case *schemapb.VectorField_Float16Vector:
+				dim := vectorField.GetDim()
+				fieldNumRows, err := getNumRowsOfHalfFloatVectorField(vectorField.GetFloat16Vector(), dim)
+				if err != nil {
+					return err
+				}
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
+			case *schemapb.VectorField_Bfloat16Vector:
+				dim := vectorField.GetDim()
+				fieldNumRows, err := getNumRowsOfHalfFloatVectorField(vectorField.GetBfloat16Vector(), dim)
+				if err != nil {
+					return err
+				}
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
+			case *schemapb.VectorField_SparseFloatVector:
+				fieldNumRows := uint32(len(vectorField.GetSparseFloatVector().Contents))
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
 			case nil:
 				continue
 			default:
@@ -335,10 +402,151 @@ func (it *insertTask) checkRowNums() error {
 	return nil
 }
 
-// TODO(dragondriver): ignore the order of fields in request, use the order of CollectionSchema to reorganize data
+// fillFieldsDataBySchema reorders the fields data by the collection schema. The omitted fields are filled with
+// their default values, or null values if they have no default values, and so are the null values of the fields
+// with default values.
+func (it *insertTask) fillFieldsDataBySchema() error {
+	fieldsData := make(map[string]*schemapb.FieldData, len(it.req.FieldsData))
+	for _, fieldData := range it.req.FieldsData {
+		if _, ok := fieldsData[fieldData.FieldName]; ok {
+			return fmt.Errorf("duplicated field %s", fieldData.FieldName)
+		}
+		fieldsData[fieldData.FieldName] = fieldData
+	}
+
+	filled := make([]*schemapb.FieldData, 0, len(it.schema.Fields))
+	for _, field := range it.schema.Fields {
+		fieldData, ok := fieldsData[field.Name]
+		delete(fieldsData, field.Name)
+		if !ok {
+			if field.AutoID {
+				continue
+			}
+			if !field.GetNullable() && field.GetDefaultValue() == nil {
+				return errFieldDataRequired(field.Name)
+			}
+			var err error
+			fieldData, err = newNullFieldData(field, int(it.req.NumRows))
+			if err != nil {
+				return err
+			}
+		}
+		if err := fillDefaultValues(fieldData, field); err != nil {
+			return err
+		}
+		filled = append(filled, fieldData)
+	}
+	for _, fieldData := range it.req.FieldsData {
+		if _, ok := fieldsData[fieldData.FieldName]; ok {
+			return fmt.Errorf("field %s does not exist in collection %s", fieldData.FieldName, it.CollectionName)
+		}
+	}
+
+	it.req.FieldsData = filled
+	return nil
+}
+
+// newNullFieldData returns the data of numRows null values of the scalar field
+func newNullFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	scalars := &schemapb.ScalarField{}
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: make([]bool, numRows)}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: make([]int32, numRows)}}
+	case schemapb.DataType_Int64:
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: make([]int64, numRows)}}
+	case schemapb.DataType_Float:
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: make([]float32, numRows)}}
+	case schemapb.DataType_Double:
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: make([]float64, numRows)}}
+	case schemapb.DataType_String:
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: make([]string, numRows)}}
+	case schemapb.DataType_Array:
+		arrays := make([]*schemapb.ScalarField, numRows)
+		for i := range arrays {
+			arrays[i] = &schemapb.ScalarField{}
+		}
+		scalars.Data = &schemapb.ScalarField_ArrayData{ArrayData: &schemapb.ArrayArray{Data: arrays, ElementType: field.GetElementType()}}
+	case schemapb.DataType_JSON:
+		scalars.Data = &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: make([][]byte, numRows)}}
+	default:
+		return nil, errUnsupportedDataType(field.DataType)
+	}
+	return &schemapb.FieldData{
+		Type:      field.DataType,
+		FieldName: field.Name,
+		FieldId:   field.FieldID,
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+		ValidData: make([]bool, numRows),
+	}, nil
+}
+
+// fillDefaultValues replaces the null values of the field data with the default value of the field, the values
+// stay null if the field has no default value
+func fillDefaultValues(fieldData *schemapb.FieldData, field *schemapb.FieldSchema) error {
+	validData := fieldData.GetValidData()
+	hasNull := false
+	for _, valid := range validData {
+		if !valid {
+			hasNull = true
+			break
+		}
+	}
+	if !hasNull {
+		fieldData.ValidData = nil
+		return nil
+	}
+	defaultValue := field.GetDefaultValue()
+	if defaultValue == nil {
+		if !field.GetNullable() {
+			return errNullValueOfNotNullableField(field.Name)
+		}
+		return nil
+	}
+
+	scalars := fieldData.GetScalars()
+	for i, valid := range validData {
+		if valid {
+			continue
+		}
+		switch data := scalars.GetData().(type) {
+		case *schemapb.ScalarField_BoolData:
+			data.BoolData.Data[i] = defaultValue.GetBoolData()
+		case *schemapb.ScalarField_IntData:
+			data.IntData.Data[i] = defaultValue.GetIntData()
+		case *schemapb.ScalarField_LongData:
+			data.LongData.Data[i] = defaultValue.GetLongData()
+		case *schemapb.ScalarField_FloatData:
+			data.FloatData.Data[i] = defaultValue.GetFloatData()
+		case *schemapb.ScalarField_DoubleData:
+			data.DoubleData.Data[i] = defaultValue.GetDoubleData()
+		case *schemapb.ScalarField_StringData:
+			data.StringData.Data[i] = defaultValue.GetStringData()
+		default:
+			return fmt.Errorf("field %s can not be filled with default value", field.Name)
+		}
+	}
+	fieldData.ValidData = nil
+	return nil
+}
+
 func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 	dTypes := make([]schemapb.DataType, 0, len(it.req.FieldsData))
 	datas := make([][]interface{}, 0, len(it.req.FieldsData))
+	// the max lengths of the VarChar columns, a VarChar value takes the uint32 length and the bytes of the string in a row
+	maxLengths := make(map[int]int)
+	// the schemas of the Array columns, an array takes the uint32 length and the bytes of its elements in a row
+	arrayFields := make(map[int]*schemapb.FieldSchema)
+	// the max nnz of the sparse float vector columns, a sparse row takes the uint32 length and its (index, value) pairs
+	// in a row
+	maxNnzs := make(map[int]int)
+	// the validity of the nullable columns, the values of nullable columns are led by a validity byte in the rows
+	validData := make(map[int][]bool)
+	nullables := make(map[string]bool)
+	for _, field := range it.schema.Fields {
+		nullables[field.Name] = field.GetNullable()
+	}
 	rowNum := 0
 
 	appendScalarField := func(getDataFunc func() interface{}) error {
@@ -407,6 +615,26 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 		return nil
 	}
 
+	appendHalfFloatVectorField := func(bDatas []byte, dim int64) error {
+		rowBytes := 2 * dim
+		l := len(bDatas)
+		if int64(l)%rowBytes != 0 {
+			return errors.New("invalid vectors")
+		}
+		r := int64(l) / rowBytes
+		if rowNum != 0 && rowNum != int(r) {
+			return errors.New("the row num of different column is not equal")
+		}
+		rowNum = int(r)
+		datas = append(datas, make([]interface{}, 0, rowNum))
+		idx := len(datas) - 1
+		for i := int64(0); i < r; i++ {
+			datas[idx] = append(datas[idx], bDatas[i*rowBytes:(i+1)*rowBytes])
+		}
+
+		return nil
+	}
+
 	for _, field := range it.req.FieldsData {
 		switch field.Field.(type) {
 		case *schemapb.FieldData_Scalars:
@@ -447,10 +675,53 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 				if err != nil {
 					return err
 				}
+			case *schemapb.ScalarField_StringData:
+				var fieldSchema *schemapb.FieldSchema
+				for _, f := range it.schema.Fields {
+					if f.Name == field.FieldName {
+						fieldSchema = f
+					}
+				}
+				if fieldSchema == nil {
+					return fmt.Errorf("field %s not exist", field.FieldName)
+				}
+				maxLength, err := typeutil.GetMaxLength(fieldSchema)
+				if err != nil {
+					return err
+				}
+				err = appendScalarField(func() interface{} {
+					return scalarField.GetStringData().Data
+				})
+				if err != nil {
+					return err
+				}
+				maxLengths[len(dTypes)] = maxLength
+			case *schemapb.ScalarField_ArrayData:
+				var fieldSchema *schemapb.FieldSchema
+				for _, f := range it.schema.Fields {
+					if f.Name == field.FieldName {
+						fieldSchema = f
+					}
+				}
+				if fieldSchema == nil {
+					return fmt.Errorf("field %s not exist", field.FieldName)
+				}
+				err := appendScalarField(func() interface{} {
+					return scalarField.GetArrayData().Data
+				})
+				if err != nil {
+					return err
+				}
+				arrayFields[len(dTypes)] = fieldSchema
+			case *schemapb.ScalarField_JsonData:
+				err := appendScalarField(func() interface{} {
+					return scalarField.GetJsonData().Data
+				})
+				if err != nil {
+					return err
+				}
 			case *schemapb.ScalarField_BytesData:
 				return errors.New("bytes field is not supported now")
-			case *schemapb.ScalarField_StringData:
-				return errors.New("string field is not supported now")
 			case nil:
 				continue
 			default:
@@ -473,6 +744,37 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 				if err != nil {
 					return err
 				}
+			case *schemapb.VectorField_Float16Vector:
+				err := appendHalfFloatVectorField(vectorField.GetFloat16Vector(), vectorField.GetDim())
+				if err != nil {
+					return err
+				}
+			case *schemapb.VectorField_Bfloat16Vector:
+				err := appendHalfFloatVectorField(vectorField.GetBfloat16Vector(), vectorField.GetDim())
+				if err != nil {
+					return err
+				}
+			case *schemapb.VectorField_SparseFloatVector:
+				var fieldSchema *schemapb.FieldSchema
+				for _, f := range it.schema.Fields {
+					if f.Name == field.FieldName {
+						fieldSchema = f
+					}
+				}
+				if fieldSchema == nil {
+					return fmt.Errorf("field %s not exist", field.FieldName)
+				}
+				maxNnz, err := typeutil.GetMaxNnz(fieldSchema)
+				if err != nil {
+					return err
+				}
+				err = appendScalarField(func() interface{} {
+					return vectorField.GetSparseFloatVector().Contents
+				})
+				if err != nil {
+					return err
+				}
+				maxNnzs[len(dTypes)] = maxNnz
 			case nil:
 				continue
 			default:
@@ -484,6 +786,9 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 			continue
 		}
 
+		if nullables[field.FieldName] {
+			validData[len(dTypes)] = field.GetValidData()
+		}
 		dTypes = append(dTypes, field.Type)
 	}
 
@@ -498,6 +803,13 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 		}
 
 		for j := 0; j < l; j++ {
+			if valid, ok := validData[j]; ok {
+				if len(valid) == 0 || valid[i] {
+					blob.Value = append(blob.Value, 1)
+				} else {
+					blob.Value = append(blob.Value, 0)
+				}
+			}
 			var buffer bytes.Buffer
 			switch dTypes[j] {
 			case schemapb.DataType_Bool:
@@ -549,6 +861,34 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 					log.Warn("ConvertData", zap.Error(err))
 				}
 				blob.Value = append(blob.Value, buffer.Bytes()...)
+			case schemapb.DataType_String:
+				d, err := typeutil.EncodeVarChar(datas[j][i].(string), maxLengths[j])
+				if err != nil {
+					return err
+				}
+				blob.Value = append(blob.Value, d...)
+			case schemapb.DataType_Array:
+				d, err := typeutil.EncodeArray(arrayFields[j], datas[j][i].(*schemapb.ScalarField))
+				if err != nil {
+					return err
+				}
+				blob.Value = append(blob.Value, d...)
+			case schemapb.DataType_JSON:
+				// a null document takes no bytes
+				var d []byte
+				if valid := validData[j]; len(valid) == 0 || valid[i] {
+					d = datas[j][i].([]byte)
+					if err := validateJSON(d); err != nil {
+						return err
+					}
+				}
+				blob.Value = append(blob.Value, typeutil.EncodeVariableLengthValue(d)...)
+			case schemapb.DataType_SparseFloatVector:
+				d, err := typeutil.EncodeSparseFloatRow(datas[j][i].([]byte), maxNnzs[j])
+				if err != nil {
+					return err
+				}
+				blob.Value = append(blob.Value, d...)
 			case schemapb.DataType_FloatVector:
 				d := datas[j][i].([]float32)
 				err := binary.Write(&buffer, endian, d)
@@ -556,7 +896,7 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 					log.Warn("ConvertData", zap.Error(err))
 				}
 				blob.Value = append(blob.Value, buffer.Bytes()...)
-			case schemapb.DataType_BinaryVector:
+			case schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
 				d := datas[j][i].([]byte)
 				err := binary.Write(&buffer, endian, d)
 				if err != nil {
@@ -615,7 +955,6 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 	}
 
 	var primaryField *schemapb.FieldData
-	var primaryData []int64
 	for _, field := range it.req.FieldsData {
 		if field.FieldName == autoIDFieldName {
 			return fmt.Errorf("autoID field (%v) does not require data", autoIDFieldName)
@@ -626,26 +965,20 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 	}
 
 	if primaryField != nil {
-		if primaryField.Type != schemapb.DataType_Int64 {
-			return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
+		if !typeutil.IsPrimaryFieldType(primaryField.Type) {
+			return fmt.Errorf("currently only support DataType Int64 or VarChar as PrimaryField")
 		}
-		switch primaryField.Field.(type) {
-		case *schemapb.FieldData_Scalars:
-			scalarField := primaryField.GetScalars()
-			switch scalarField.Data.(type) {
-			case *schemapb.ScalarField_LongData:
-				primaryData = scalarField.GetLongData().Data
-			default:
-				return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
-			}
-		default:
-			return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
+		ids, err := typeutil.GetPKsFromFieldData(primaryField)
+		if err != nil {
+			return err
 		}
-		it.result.IDs.IdField = &schemapb.IDs_IntId{
-			IntId: &schemapb.LongArray{
-				Data: primaryData,
-			},
+		// empty strings stand for the missing hits in search results
+		for _, pk := range ids.GetStrId().GetData() {
+			if pk == "" {
+				return fmt.Errorf("the value of varchar primary field %s can not be empty", primaryField.FieldName)
+			}
 		}
+		it.result.IDs = ids
 	}
 
 	var rowIDBegin UniqueID
@@ -688,9 +1021,12 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 			},
 		}
 
-		it.HashPK(it.BaseInsertTask.RowIDs)
+		it.HashPK(it.result.IDs)
+	} else if it.result.IDs.GetStrId() != nil {
+		// the string primary keys are hashed by themselves, the same as the deletes of them
+		it.HashPK(it.result.IDs)
 	} else {
-		it.HashPK(it.BaseInsertTask.RowIDs)
+		it.HashPK(&schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: it.BaseInsertTask.RowIDs}}})
 	}
 
 	sliceIndex := make([]uint32, rowNums)
@@ -702,15 +1038,11 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 	return nil
 }
 
-func (it *insertTask) HashPK(pks []int64) {
+func (it *insertTask) HashPK(pks *schemapb.IDs) {
 	if len(it.HashValues) != 0 {
 		log.Warn("the hashvalues passed through client is not supported now, and will be overwritten")
 	}
-	it.HashValues = make([]uint32, 0, len(pks))
-	for _, pk := range pks {
-		hash, _ := typeutil.Hash32Int64(pk)
-		it.HashValues = append(it.HashValues, hash)
-	}
+	it.HashValues = typeutil.HashPKs(pks)
 }
 
 func (it *insertTask) PreExecute(ctx context.Context) error {
@@ -739,7 +1071,7 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.GetDbName(), collectionName)
 	log.Debug("Proxy Insert PreExecute", zap.Any("collSchema", collSchema))
 	if err != nil {
 		return err
@@ -751,11 +1083,21 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	err = it.fillFieldsDataBySchema()
+	if err != nil {
+		return err
+	}
+
 	err = it.checkFieldAutoIDAndHashPK()
 	if err != nil {
 		return err
 	}
 
+	err = it.assignPartitionsByPartitionKey(ctx)
+	if err != nil {
+		return err
+	}
+
 	err = it.transferColumnBasedRequestToRowBasedData()
 	if err != nil {
 		return err
@@ -781,8 +1123,14 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 	tsMsgs := pack.Msgs
 	hashKeys := stream.ComputeProduceChannelIndexes(tsMsgs)
 	reqID := it.Base.MsgID
-	channelCountMap := make(map[int32]uint32)    //   channelID to count
-	channelMaxTSMap := make(map[int32]Timestamp) //  channelID to max Timestamp
+	// the segments are allocated per partition and channel, the rows of a collection with a partition key are
+	// split into the msgs of several partitions
+	type partitionChannel struct {
+		partitionID UniqueID
+		channelID   int32
+	}
+	channelCountMap := make(map[partitionChannel]uint32)    // partition and channel to count
+	channelMaxTSMap := make(map[partitionChannel]Timestamp) // partition and channel to max Timestamp
 	channelNames, err := it.chMgr.getVChannels(it.GetCollectionID())
 	if err != nil {
 		return nil, err
@@ -808,29 +1156,30 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 			return nil, fmt.Errorf("the length of hashValue, timestamps, rowIDs, RowData are not equal")
 		}
 		for idx, channelID := range keys {
-			channelCountMap[channelID]++
-			if _, ok := channelMaxTSMap[channelID]; !ok {
-				channelMaxTSMap[channelID] = typeutil.ZeroTimestamp
+			key := partitionChannel{partitionID: insertRequest.PartitionID, channelID: channelID}
+			channelCountMap[key]++
+			if _, ok := channelMaxTSMap[key]; !ok {
+				channelMaxTSMap[key] = typeutil.ZeroTimestamp
 			}
 			ts := insertRequest.Timestamps[idx]
-			if channelMaxTSMap[channelID] < ts {
-				channelMaxTSMap[channelID] = ts
+			if channelMaxTSMap[key] < ts {
+				channelMaxTSMap[key] = ts
 			}
 		}
 	}
 
-	reqSegCountMap := make(map[int32]map[UniqueID]uint32)
+	reqSegCountMap := make(map[partitionChannel]map[UniqueID]uint32)
 	for channelID, count := range channelCountMap {
 		ts, ok := channelMaxTSMap[channelID]
 		if !ok {
 			ts = typeutil.ZeroTimestamp
 			log.Debug("Warning: did not get max Timestamp!")
 		}
-		channelName := channelNames[channelID]
+		channelName := channelNames[channelID.channelID]
 		if channelName == "" {
 			return nil, fmt.Errorf("Proxy, repack_func, can not found channelName")
 		}
-		mapInfo, err := it.segIDAssigner.GetSegmentID(it.CollectionID, it.PartitionID, channelName, count, ts)
+		mapInfo, err := it.segIDAssigner.GetSegmentID(it.CollectionID, channelID.partitionID, channelName, count, ts)
 		if err != nil {
 			log.Debug("insertTask.go", zap.Any("MapInfo", mapInfo),
 				zap.Error(err))
@@ -841,9 +1190,9 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		log.Debug("Proxy", zap.Int64("repackFunc, reqSegCountMap, reqID", reqID), zap.Any("mapinfo", mapInfo))
 	}
 
-	reqSegAccumulateCountMap := make(map[int32][]uint32)
-	reqSegIDMap := make(map[int32][]UniqueID)
-	reqSegAllocateCounter := make(map[int32]uint32)
+	reqSegAccumulateCountMap := make(map[partitionChannel][]uint32)
+	reqSegIDMap := make(map[partitionChannel][]UniqueID)
+	reqSegAllocateCounter := make(map[partitionChannel]uint32)
 
 	for channelID, segInfo := range reqSegCountMap {
 		reqSegAllocateCounter[channelID] = 0
@@ -874,7 +1223,7 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		}
 	}
 
-	var getSegmentID = func(channelID int32) UniqueID {
+	var getSegmentID = func(channelID partitionChannel) UniqueID {
 		reqSegAllocateCounter[channelID]++
 		cur := reqSegAllocateCounter[channelID]
 		accumulateSlice := reqSegAccumulateCountMap[channelID]
@@ -909,8 +1258,8 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		return size
 	}
 
-	result := make(map[int32]msgstream.TsMsg)
-	curMsgSizeMap := make(map[int32]int)
+	result := make(map[partitionChannel]msgstream.TsMsg)
+	curMsgSizeMap := make(map[partitionChannel]int)
 
 	for i, request := range tsMsgs {
 		insertRequest := request.(*msgstream.InsertMsg)
@@ -920,7 +1269,8 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		partitionID := insertRequest.PartitionID
 		partitionName := insertRequest.PartitionName
 		proxyID := insertRequest.Base.SourceID
-		for index, key := range keys {
+		for index, channelID := range keys {
+			key := partitionChannel{partitionID: partitionID, channelID: channelID}
 			ts := insertRequest.Timestamps[index]
 			rowID := insertRequest.RowIDs[index]
 			row := insertRequest.RowData[index]
@@ -942,7 +1292,7 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 					CollectionName: collectionName,
 					PartitionName:  partitionName,
 					SegmentID:      segmentID,
-					ShardName:      channelNames[key],
+					ShardName:      channelNames[channelID],
 				}
 				insertMsg := &msgstream.InsertMsg{
 					BaseMsg: msgstream.BaseMsg{
@@ -985,35 +1335,38 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-Execute")
 	defer sp.Finish()
 	collectionName := it.BaseInsertTask.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.GetDbName(), collectionName)
 	if err != nil {
 		return err
 	}
 	it.CollectionID = collID
-	var partitionID UniqueID
-	if len(it.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
-		if err != nil {
-			return err
-		}
-	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.DefaultPartitionName)
-		if err != nil {
-			return err
+	if it.partitionIndexes == nil {
+		var partitionID UniqueID
+		if len(it.PartitionName) > 0 {
+			partitionID, err = globalMetaCache.GetPartitionID(ctx, it.GetDbName(), collectionName, it.PartitionName)
+			if err != nil {
+				return err
+			}
+		} else {
+			partitionID, err = globalMetaCache.GetPartitionID(ctx, it.GetDbName(), collectionName, Params.DefaultPartitionName)
+			if err != nil {
+				return err
+			}
 		}
+		it.PartitionID = partitionID
 	}
-	it.PartitionID = partitionID
 
-	var tsMsg msgstream.TsMsg = &it.BaseInsertTask
 	it.BaseMsg.Ctx = ctx
+	msgs, err := it.insertMsgs(ctx)
+	if err != nil {
+		return err
+	}
 	msgPack := msgstream.MsgPack{
 		BeginTs: it.BeginTs(),
 		EndTs:   it.EndTs(),
-		Msgs:    make([]msgstream.TsMsg, 1),
+		Msgs:    msgs,
 	}
 
-	msgPack.Msgs[0] = tsMsg
-
 	stream, err := it.chMgr.getDMLStream(collID)
 	if err != nil {
 		err = it.chMgr.createDMLMsgStream(collID)
@@ -1118,6 +1471,10 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("maximum shards's number should be limited to %d", Params.MaxShardNum)
 	}
 
+	if err := validateConsistencyLevel(cct.ConsistencyLevel); err != nil {
+		return err
+	}
+
 	if int64(len(cct.schema.Fields)) > Params.MaxFieldNum {
 		return fmt.Errorf("maximum field's number should be limited to %d", Params.MaxFieldNum)
 	}
@@ -1139,12 +1496,21 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := validatePartitionKey(cct.schema, cct.NumPartitions); err != nil {
+		return err
+	}
+
+	if err := validateTTL(cct.schema); err != nil {
+		return err
+	}
+
 	// validate field name
 	for _, field := range cct.schema.Fields {
 		if err := validateFieldName(field.Name); err != nil {
 			return err
 		}
-		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
+		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector ||
+			field.DataType == schemapb.DataType_Float16Vector || field.DataType == schemapb.DataType_BFloat16Vector {
 			exist := false
 			var dim int64 = 0
 			for _, param := range field.TypeParams {
@@ -1161,7 +1527,7 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 			if !exist {
 				return errors.New("dimension is not defined in field type params, check type param `dim` for vector field")
 			}
-			if field.DataType == schemapb.DataType_FloatVector {
+			if field.DataType != schemapb.DataType_BinaryVector {
 				if err := validateDimension(dim, false); err != nil {
 					return err
 				}
@@ -1171,6 +1537,24 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 				}
 			}
 		}
+		if field.DataType == schemapb.DataType_String {
+			if err := validateMaxLength(field); err != nil {
+				return err
+			}
+		}
+		if field.DataType == schemapb.DataType_Array {
+			if err := validateArrayField(field); err != nil {
+				return err
+			}
+		}
+		if field.DataType == schemapb.DataType_SparseFloatVector {
+			if err := validateSparseFloatVectorField(field); err != nil {
+				return err
+			}
+		}
+		if err := validateNullableAndDefaultValue(field); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1244,7 +1628,7 @@ func (dct *dropCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (dct *dropCollectionTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, dct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dct.GetDbName(), dct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1261,7 +1645,7 @@ func (dct *dropCollectionTask) Execute(ctx context.Context) error {
 }
 
 func (dct *dropCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.RemoveCollection(ctx, dct.CollectionName)
+	globalMetaCache.RemoveCollection(ctx, dct.GetDbName(), dct.CollectionName)
 	return nil
 }
 
@@ -1285,7 +1669,7 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 		if field.IsPrimaryKey {
 			primaryFieldName = field.Name
 		}
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+		if typeutil.IsVectorType(field.DataType) {
 			vectorFieldNameMap[field.Name] = true
 		} else {
 			scalarFieldNameMap[field.Name] = true
@@ -1326,6 +1710,18 @@ type searchTask struct {
 	query     *milvuspb.SearchRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	offset    int64
+	groupBy   *searchGroupBy
+	// session is the session_id of the request and sessionTs is the last write of the session
+	// for the Session consistency level
+	session   string
+	sessionTs Timestamp
+}
+
+// searchGroupBy describes a grouped search, which returns the best hit of each of the topk distinct values of a field
+type searchGroupBy struct {
+	fieldIdx int   // index of the group by field in the output fields
+	topk     int64 // number of distinct values of each query, including offset
 }
 
 func (st *searchTask) TraceCtx() context.Context {
@@ -1368,7 +1764,7 @@ func (st *searchTask) OnEnqueue() error {
 }
 
 func (st *searchTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.GetDbName(), st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1387,7 +1783,7 @@ func (st *searchTask) getChannels() ([]pChan, error) {
 }
 
 func (st *searchTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.GetDbName(), st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1412,7 +1808,7 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	st.Base.SourceID = Params.ProxyID
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1463,7 +1859,9 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 
 	st.Base.MsgType = commonpb.MsgType_Search
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	schema, _ := globalMetaCache.GetCollectionSchema(ctx, st.query.GetDbName(), collectionName)
+	// the partitions the search is pruned to by the partition key, nil if it isn't pruned
+	var prunedPartitionIDs []UniqueID
 
 	outputFields, err := translateOutputFields(st.query.OutputFields, schema, false)
 	if err != nil {
@@ -1472,20 +1870,41 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	log.Debug("translate output fields", zap.Any("OutputFields", outputFields))
 	st.query.OutputFields = outputFields
 
+	placeholderGroup := st.query.PlaceholderGroup
 	if st.query.GetDslType() == commonpb.DslType_BoolExprV1 {
 		annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, st.query.SearchParams)
 		if err != nil {
 			return errors.New(AnnsFieldKey + " not found in search_params")
 		}
 
+		offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, st.query.SearchParams)
+		if err != nil {
+			offsetStr = "0"
+		}
+		offset, err := strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			return errors.New(OffsetKey + " " + offsetStr + " is not invalid")
+		}
+
+		radiusStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RadiusKey, st.query.SearchParams)
+		rangeSearch := err == nil
+
 		topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, st.query.SearchParams)
 		if err != nil {
-			return errors.New(TopKKey + " not found in search_params")
+			if !rangeSearch {
+				return errors.New(TopKKey + " not found in search_params")
+			}
+			// range search returns all the hits within the range, up to the max topk
+			topKStr = strconv.FormatInt(Params.MaxTopK-int64(offset), 10)
 		}
 		topK, err := strconv.Atoi(topKStr)
-		if err != nil {
+		if err != nil || topK <= 0 {
 			return errors.New(TopKKey + " " + topKStr + " is not invalid")
 		}
+		if int64(topK+offset) > Params.MaxTopK {
+			return fmt.Errorf("%s %d plus %s %d should not be larger than %d", TopKKey, topK, OffsetKey, offset, Params.MaxTopK)
+		}
+		st.offset = int64(offset)
 
 		metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, st.query.SearchParams)
 		if err != nil {
@@ -1509,11 +1928,23 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(RoundDecimalKey + " " + roundDecimalStr + " is not invalid")
 		}
 
+		// query nodes search for offset + topk hits, the first offset hits are skipped in reduce
 		queryInfo := &planpb.QueryInfo{
-			Topk:         int64(topK),
+			Topk:         int64(topK + offset),
 			MetricType:   metricType,
 			SearchParams: searchParams,
 			RoundDecimal: int64(roundDecimal),
+			Offset:       int64(offset),
+		}
+		if rangeSearch {
+			if err := parseRangeSearchParams(queryInfo, radiusStr, st.query.SearchParams); err != nil {
+				return err
+			}
+		}
+		if groupByField, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, st.query.SearchParams); err == nil {
+			if err := st.parseGroupBy(schema, groupByField, queryInfo); err != nil {
+				return err
+			}
 		}
 
 		log.Debug("create query plan",
@@ -1533,11 +1964,26 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 
 			return fmt.Errorf("failed to create query plan: %v", err)
 		}
+		if typeutil.GetPartitionKeyField(schema) != nil && len(st.query.PartitionNames) > 0 {
+			return errors.New("not allowed to specify partition names when the collection has a partition key")
+		}
+		prunedPartitionIDs, err = prunePartitionsByPartitionKey(ctx, st.query.GetDbName(), collectionName, schema,
+			plan.GetVectorAnns().GetPredicates())
+		if err != nil {
+			return err
+		}
+		for _, field := range schema.Fields {
+			if field.Name == annsField && field.DataType == schemapb.DataType_SparseFloatVector {
+				if err := validateSparseFloatPlaceholderGroup(field, placeholderGroup); err != nil {
+					return err
+				}
+			}
+		}
 		for _, name := range st.query.OutputFields {
 			hitField := false
 			for _, field := range schema.Fields {
 				if field.Name == name {
-					if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+					if typeutil.IsVectorType(field.DataType) {
 						return errors.New("Search doesn't support vector field as output_fields")
 					}
 
@@ -1565,19 +2011,24 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	if travelTimestamp == 0 {
 		travelTimestamp = st.BeginTs()
 	}
-	guaranteeTimestamp := st.query.GuaranteeTimestamp
-	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = st.BeginTs()
+	consistencyLevel, err := getConsistencyLevel(ctx, st.query.GetDbName(), collectionName,
+		st.query.ConsistencyLevel, st.query.ExplicitConsistency)
+	if err != nil {
+		return err
+	}
+	if err := validateSession(consistencyLevel, st.query.GuaranteeTimestamp, st.session); err != nil {
+		return err
 	}
 	st.SearchRequest.TravelTimestamp = travelTimestamp
-	st.SearchRequest.GuaranteeTimestamp = guaranteeTimestamp
+	st.SearchRequest.GuaranteeTimestamp = parseGuaranteeTs(consistencyLevel, st.query.GuaranteeTimestamp, st.BeginTs(), st.sessionTs,
+		st.query.GracefulTime)
 
 	st.SearchRequest.ResultChannelID = Params.SearchResultChannelNames[0]
 	st.SearchRequest.DbID = 0 // todo
 	st.SearchRequest.CollectionID = collID
 	st.SearchRequest.PartitionIDs = make([]UniqueID, 0)
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, st.query.GetDbName(), collectionName)
 	if err != nil {
 		return err
 	}
@@ -1604,13 +2055,40 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(errMsg)
 		}
 	}
+	if prunedPartitionIDs != nil {
+		st.PartitionIDs = prunedPartitionIDs
+	}
 
 	st.SearchRequest.Dsl = st.query.Dsl
-	st.SearchRequest.PlaceholderGroup = st.query.PlaceholderGroup
+	st.SearchRequest.PlaceholderGroup = placeholderGroup
 
 	return nil
 }
 
+// validateSparseFloatPlaceholderGroup validates the sparse float vectors to search with against the sparse float vector
+// field, the query nodes search with their (index, value) pairs as they are
+func validateSparseFloatPlaceholderGroup(field *schemapb.FieldSchema, blob []byte) error {
+	maxNnz, err := typeutil.GetMaxNnz(field)
+	if err != nil {
+		return err
+	}
+	group := &milvuspb.PlaceholderGroup{}
+	if err := proto.Unmarshal(blob, group); err != nil {
+		return err
+	}
+	for _, placeholder := range group.Placeholders {
+		if placeholder.Type != milvuspb.PlaceholderType_SparseFloatVector {
+			return fmt.Errorf("type %s of the vectors to search mismatches with the sparse float vector field %s", placeholder.Type.String(), field.Name)
+		}
+		for _, value := range placeholder.Values {
+			if err := typeutil.ValidateSparseFloatRowNnz(value, maxNnz); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (st *searchTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(st.TraceCtx(), "Proxy-Search-Execute")
 	defer sp.Finish()
@@ -1631,7 +2109,7 @@ func (st *searchTask) Execute(ctx context.Context) error {
 	msgPack.Msgs[0] = tsMsg
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1698,32 +2176,134 @@ func decodeSearchResults(searchResults []*internalpb.SearchResults) ([]*schemapb
 	return results, nil
 }
 
-func checkSearchResultData(data *schemapb.SearchResultData, nq int64, topk int64) error {
-	if data.NumQueries != nq {
-		return fmt.Errorf("search result's nq(%d) mis-match with %d", data.NumQueries, nq)
+// parseGroupBy sets up a grouped search on the field, query nodes search for GroupBySearchFactor times more candidates
+// to find enough distinct values, and double the candidates until topk groups are found or the candidates run out.
+// The group by field is always returned
+func (st *searchTask) parseGroupBy(schema *schemapb.CollectionSchema, fieldName string, queryInfo *planpb.QueryInfo) error {
+	var groupByField *schemapb.FieldSchema
+	for _, field := range schema.Fields {
+		if field.Name == fieldName {
+			groupByField = field
+		}
+	}
+	if groupByField == nil {
+		return errors.New(GroupByFieldKey + " " + fieldName + " not exist")
+	}
+	switch groupByField.DataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_String:
+	default:
+		return fmt.Errorf("can not group by field %s of type %s", fieldName, groupByField.DataType.String())
+	}
+
+	queryInfo.GroupByFieldId = groupByField.FieldID
+	queryInfo.GroupTopk = queryInfo.Topk
+	queryInfo.Topk *= Params.GroupBySearchFactor
+	if queryInfo.Topk > Params.MaxTopK {
+		queryInfo.Topk = Params.MaxTopK
+	}
+
+	fieldIdx := -1
+	for i, name := range st.query.OutputFields {
+		if name == fieldName {
+			fieldIdx = i
+		}
+	}
+	if fieldIdx == -1 {
+		st.query.OutputFields = append(st.query.OutputFields, fieldName)
+		fieldIdx = len(st.query.OutputFields) - 1
+	}
+	st.groupBy = &searchGroupBy{fieldIdx: fieldIdx, topk: queryInfo.GroupTopk}
+	return nil
+}
+
+// parseRangeSearchParams fills the range of a range search into queryInfo. The hits within range_filter <= distance < radius
+// are kept for the distance metrics, and radius < score <= range_filter for IP, range_filter is unbounded if not specified
+func parseRangeSearchParams(queryInfo *planpb.QueryInfo, radiusStr string, searchParams []*commonpb.KeyValuePair) error {
+	radius, err := strconv.ParseFloat(radiusStr, 64)
+	if err != nil {
+		return errors.New(RadiusKey + " " + radiusStr + " is not invalid")
+	}
+
+	positivelyRelated := strings.ToUpper(queryInfo.MetricType) == "IP"
+	rangeFilter := 0.0
+	if positivelyRelated {
+		rangeFilter = math.MaxFloat32
+	}
+	if rangeFilterStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RangeFilterKey, searchParams); err == nil {
+		rangeFilter, err = strconv.ParseFloat(rangeFilterStr, 64)
+		if err != nil {
+			return errors.New(RangeFilterKey + " " + rangeFilterStr + " is not invalid")
+		}
+	}
+
+	if positivelyRelated && rangeFilter <= radius {
+		return fmt.Errorf("%s %v should be larger than %s %v for metric type %s", RangeFilterKey, rangeFilter, RadiusKey, radius, queryInfo.MetricType)
+	}
+	if !positivelyRelated && rangeFilter >= radius {
+		return fmt.Errorf("%s %v should be smaller than %s %v for metric type %s", RangeFilterKey, rangeFilter, RadiusKey, radius, queryInfo.MetricType)
+	}
+
+	queryInfo.RangeSearch = true
+	queryInfo.Radius = radius
+	queryInfo.RangeFilter = rangeFilter
+	return nil
+}
+
+// hasVariableTopks checks whether the queries of the search result have different numbers of hits, e.g. in range search,
+// the number of hits of each query is given by Topks then
+func hasVariableTopks(data *schemapb.SearchResultData, nq int64) bool {
+	if int64(len(data.Topks)) != nq {
+		return false
+	}
+	var sum int64
+	for _, k := range data.Topks {
+		sum += k
+	}
+	return sum == int64(typeutil.GetSizeOfIDs(data.Ids))
+}
+
+// searchResultBounds returns the start index of the hits of each query, the hits of query i are in [bounds[i], bounds[i+1])
+func searchResultBounds(data *schemapb.SearchResultData, nq int64, topk int64) []int64 {
+	bounds := make([]int64, nq+1)
+	variable := hasVariableTopks(data, nq)
+	for i := int64(0); i < nq; i++ {
+		if variable {
+			bounds[i+1] = bounds[i] + data.Topks[i]
+		} else {
+			bounds[i+1] = bounds[i] + topk
+		}
+	}
+	return bounds
+}
+
+func checkSearchResultData(data *schemapb.SearchResultData, nq int64, topk int64) error {
+	if data.NumQueries != nq {
+		return fmt.Errorf("search result's nq(%d) mis-match with %d", data.NumQueries, nq)
 	}
 	if data.TopK != topk {
 		return fmt.Errorf("search result's topk(%d) mis-match with %d", data.TopK, topk)
 	}
-	if len(data.Ids.GetIntId().Data) != (int)(nq*topk) {
-		return fmt.Errorf("search result's id length %d invalid", len(data.Ids.GetIntId().Data))
+	expected := searchResultBounds(data, nq, topk)[nq]
+	if typeutil.GetSizeOfIDs(data.Ids) != (int)(expected) {
+		return fmt.Errorf("search result's id length %d invalid", typeutil.GetSizeOfIDs(data.Ids))
 	}
-	if len(data.Scores) != (int)(nq*topk) {
+	if len(data.Scores) != (int)(expected) {
 		return fmt.Errorf("search result's score length %d invalid", len(data.Scores))
 	}
 	return nil
 }
 
-func selectSearchResultData(dataArray []*schemapb.SearchResultData, offsets []int64, topk int64, qi int64) int {
+func selectSearchResultData(dataArray []*schemapb.SearchResultData, bounds [][]int64, offsets []int64, qi int64) int {
 	sel := -1
 	maxDistance := minFloat32
 	for i, offset := range offsets { // query num, the number of ways to merge
-		if offset >= topk {
+		if offset >= bounds[i][qi+1]-bounds[i][qi] {
 			continue
 		}
-		idx := qi*topk + offset
-		id := dataArray[i].Ids.GetIntId().Data[idx]
-		if id != -1 {
+		idx := bounds[i][qi] + offset
+		id := typeutil.GetPK(dataArray[i].Ids, idx)
+		if typeutil.IsValidPK(id) {
 			distance := dataArray[i].Scores[idx]
 			if distance > maxDistance {
 				sel = i
@@ -1746,7 +2326,9 @@ func selectSearchResultData(dataArray []*schemapb.SearchResultData, offsets []in
 //	}
 //}
 
-func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string) (*milvuspb.SearchResults, error) {
+// reduceSearchResultData merges the search results of offset + limit hits, and skips the first offset hits of each query.
+// Only the best hit of each distinct value of the group by field is kept in grouped search
+func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, offset int64, metricType string, groupBy *searchGroupBy) (*milvuspb.SearchResults, error) {
 
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
@@ -1754,7 +2336,21 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 	}()
 
 	log.Debug("reduceSearchResultData", zap.Int("len(searchResultData)", len(searchResultData)),
-		zap.Int64("nq", nq), zap.Int64("topk", topk), zap.String("metricType", metricType))
+		zap.Int64("nq", nq), zap.Int64("topk", topk), zap.Int64("offset", offset), zap.String("metricType", metricType))
+
+	// grouped search returns topk distinct values out of more candidates
+	limit := topk
+	if groupBy != nil {
+		limit = groupBy.topk
+	}
+
+	// the results without any hit in range search have no fields data
+	fieldsNum := 0
+	for _, sData := range searchResultData {
+		if len(sData.FieldsData) > fieldsNum {
+			fieldsNum = len(sData.FieldsData)
+		}
+	}
 
 	ret := &milvuspb.SearchResults{
 		Status: &commonpb.Status{
@@ -1762,8 +2358,8 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 		},
 		Results: &schemapb.SearchResultData{
 			NumQueries: nq,
-			TopK:       topk,
-			FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
+			TopK:       limit - offset,
+			FieldsData: make([]*schemapb.FieldData, fieldsNum),
 			Scores:     make([]float32, 0),
 			Ids: &schemapb.IDs{
 				IdField: &schemapb.IDs_IntId{
@@ -1776,6 +2372,8 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 		},
 	}
 
+	bounds := make([][]int64, len(searchResultData))
+	variableTopks := false
 	for i, sData := range searchResultData {
 		log.Debug("reduceSearchResultData",
 			zap.Int("i", i),
@@ -1785,6 +2383,8 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 		if err := checkSearchResultData(sData, nq, topk); err != nil {
 			return ret, err
 		}
+		bounds[i] = searchResultBounds(sData, nq, topk)
+		variableTopks = variableTopks || hasVariableTopks(sData, nq)
 		//printSearchResultData(sData, strconv.FormatInt(int64(i), 10))
 	}
 
@@ -1793,27 +2393,40 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 	for i := int64(0); i < nq; i++ {
 		offsets := make([]int64, len(searchResultData))
 
-		var idSet = make(map[int64]struct{})
+		var idSet = make(map[interface{}]struct{})
+		var groupSet = make(map[interface{}]struct{})
 		var j int64
-		for j = 0; j < topk; {
-			sel := selectSearchResultData(searchResultData, offsets, topk, i)
+		for j = 0; j < limit; {
+			sel := selectSearchResultData(searchResultData, bounds, offsets, i)
 			if sel == -1 {
 				break
 			}
-			idx := i*topk + offsets[sel]
+			idx := bounds[sel][i] + offsets[sel]
 
-			id := searchResultData[sel].Ids.GetIntId().Data[idx]
+			id := typeutil.GetPK(searchResultData[sel].Ids, idx)
 			score := searchResultData[sel].Scores[idx]
 			// ignore invalid search result
-			if id == -1 {
+			if !typeutil.IsValidPK(id) {
 				continue
 			}
 
+			// skip the hits of the groups already selected
+			if groupBy != nil {
+				value := typeutil.GetScalarFieldValue(searchResultData[sel].FieldsData[groupBy.fieldIdx], idx)
+				if _, ok := groupSet[value]; ok {
+					offsets[sel]++
+					continue
+				}
+				groupSet[value] = struct{}{}
+			}
+
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.Results.FieldsData, searchResultData[sel].FieldsData, idx)
-				ret.Results.Ids.GetIntId().Data = append(ret.Results.Ids.GetIntId().Data, id)
-				ret.Results.Scores = append(ret.Results.Scores, score)
+				if j >= offset {
+					typeutil.AppendFieldData(ret.Results.FieldsData, searchResultData[sel].FieldsData, idx)
+					typeutil.AppendPKs(ret.Results.Ids, id)
+					ret.Results.Scores = append(ret.Results.Scores, score)
+				}
 				idSet[id] = struct{}{}
 				j++
 			} else {
@@ -1822,15 +2435,27 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			}
 			offsets[sel]++
 		}
-		if realTopK != -1 && realTopK != j {
+		if j < offset {
+			j = offset
+		}
+		if !variableTopks && realTopK != -1 && realTopK != j-offset {
 			log.Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
 			// return nil, errors.New("the length (topk) between all result of query is different")
 		}
-		realTopK = j
+		realTopK = j - offset
 		ret.Results.Topks = append(ret.Results.Topks, realTopK)
 	}
 	log.Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
 	ret.Results.TopK = realTopK
+	if variableTopks {
+		// the queries of range search have different numbers of hits, topk is the largest one
+		ret.Results.TopK = 0
+		for _, k := range ret.Results.Topks {
+			if k > ret.Results.TopK {
+				ret.Results.TopK = k
+			}
+		}
+	}
 
 	if metricType != "IP" {
 		for k := range ret.Results.Scores {
@@ -1915,12 +2540,12 @@ func (st *searchTask) PostExecute(ctx context.Context) error {
 				return nil
 			}
 
-			st.result, err = reduceSearchResultData(validSearchResults, searchResults[0].NumQueries, searchResults[0].TopK, searchResults[0].MetricType)
+			st.result, err = reduceSearchResultData(validSearchResults, searchResults[0].NumQueries, searchResults[0].TopK, st.offset, searchResults[0].MetricType, st.groupBy)
 			if err != nil {
 				return err
 			}
 
-			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
+			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.GetDbName(), st.query.CollectionName)
 			if err != nil {
 				return err
 			}
@@ -1940,6 +2565,300 @@ func (st *searchTask) PostExecute(ctx context.Context) error {
 	}
 }
 
+// hybridSearchTask runs an ANNS search for each of the sub-requests as sibling search tasks,
+// and fuses their results with the reranker specified by the rank params
+type hybridSearchTask struct {
+	Condition
+	ctx    context.Context
+	req    *milvuspb.HybridSearchRequest
+	result *milvuspb.SearchResults
+
+	reranker   *reranker
+	topk       int64
+	offset     int64
+	subResults []*milvuspb.SearchResults
+	// session is the session_id of the request and sessionTs is the last write of the session
+	// for the Session consistency level
+	session   string
+	sessionTs Timestamp
+
+	// searchFunc runs each of the sub-requests as a search task
+	searchFunc func(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+	// queryFunc retrieves the output fields of the fused hits
+	queryFunc func(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
+}
+
+func (ht *hybridSearchTask) TraceCtx() context.Context {
+	return ht.ctx
+}
+
+func (ht *hybridSearchTask) ID() UniqueID {
+	return ht.req.Base.MsgID
+}
+
+func (ht *hybridSearchTask) SetID(uid UniqueID) {
+	ht.req.Base.MsgID = uid
+}
+
+func (ht *hybridSearchTask) Name() string {
+	return HybridSearchTaskName
+}
+
+func (ht *hybridSearchTask) Type() commonpb.MsgType {
+	return ht.req.Base.MsgType
+}
+
+func (ht *hybridSearchTask) BeginTs() Timestamp {
+	return ht.req.Base.Timestamp
+}
+
+func (ht *hybridSearchTask) EndTs() Timestamp {
+	return ht.req.Base.Timestamp
+}
+
+func (ht *hybridSearchTask) SetTs(ts Timestamp) {
+	ht.req.Base.Timestamp = ts
+}
+
+func (ht *hybridSearchTask) OnEnqueue() error {
+	ht.req.Base = &commonpb.MsgBase{}
+	ht.req.Base.MsgType = commonpb.MsgType_Search
+	ht.req.Base.SourceID = Params.ProxyID
+	return nil
+}
+
+func (ht *hybridSearchTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ht.TraceCtx(), "Proxy-HybridSearch-PreExecute")
+	defer sp.Finish()
+
+	collectionName := ht.req.CollectionName
+	if err := validateCollectionName(collectionName); err != nil {
+		return err
+	}
+	if _, err := globalMetaCache.GetCollectionID(ctx, ht.req.GetDbName(), collectionName); err != nil {
+		return err
+	}
+	if len(ht.req.Requests) == 0 {
+		return errors.New("hybrid search requires at least one search request")
+	}
+
+	topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, ht.req.RankParams)
+	if err != nil {
+		return errors.New(TopKKey + " not found in rank_params")
+	}
+	topK, err := strconv.Atoi(topKStr)
+	if err != nil || topK <= 0 {
+		return errors.New(TopKKey + " " + topKStr + " is not invalid")
+	}
+	offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, ht.req.RankParams)
+	if err != nil {
+		offsetStr = "0"
+	}
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < 0 {
+		return errors.New(OffsetKey + " " + offsetStr + " is not invalid")
+	}
+	if int64(topK+offset) > Params.MaxTopK {
+		return fmt.Errorf("%s %d plus %s %d should not be larger than %d", TopKKey, topK, OffsetKey, offset, Params.MaxTopK)
+	}
+	ht.topk = int64(topK)
+	ht.offset = int64(offset)
+
+	// all the sub-searches read the same snapshot, the output fields are retrieved after the fusion
+	travelTimestamp := ht.req.TravelTimestamp
+	if travelTimestamp == 0 {
+		travelTimestamp = ht.BeginTs()
+	}
+	consistencyLevel, err := getConsistencyLevel(ctx, ht.req.GetDbName(), collectionName,
+		ht.req.ConsistencyLevel, ht.req.ExplicitConsistency)
+	if err != nil {
+		return err
+	}
+	if err := validateSession(consistencyLevel, ht.req.GuaranteeTimestamp, ht.session); err != nil {
+		return err
+	}
+	guaranteeTimestamp := parseGuaranteeTs(consistencyLevel, ht.req.GuaranteeTimestamp, ht.BeginTs(), ht.sessionTs,
+		ht.req.GracefulTime)
+	metricTypes := make([]string, 0, len(ht.req.Requests))
+	for i, sub := range ht.req.Requests {
+		metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, sub.SearchParams)
+		if err != nil {
+			return errors.New(MetricTypeKey + " not found in search_params")
+		}
+		metricTypes = append(metricTypes, metricType)
+		// every sub-search has to return enough candidates for the page of the fused results
+		if subTopKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, sub.SearchParams); err == nil {
+			subTopK, err := strconv.Atoi(subTopKStr)
+			if err != nil || subTopK < topK+offset {
+				return fmt.Errorf("%s %s of search request %d should not be less than %s %d plus %s %d",
+					TopKKey, subTopKStr, i, TopKKey, topK, OffsetKey, offset)
+			}
+		}
+
+		sub.DbName = ht.req.DbName
+		sub.CollectionName = collectionName
+		sub.PartitionNames = ht.req.PartitionNames
+		sub.OutputFields = nil
+		sub.TravelTimestamp = travelTimestamp
+		sub.GuaranteeTimestamp = guaranteeTimestamp
+	}
+	ht.req.TravelTimestamp = travelTimestamp
+	ht.req.GuaranteeTimestamp = guaranteeTimestamp
+
+	ht.reranker, err = parseRankParams(ht.req.RankParams, metricTypes)
+	return err
+}
+
+func (ht *hybridSearchTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ht.TraceCtx(), "Proxy-HybridSearch-Execute")
+	defer sp.Finish()
+
+	if ht.searchFunc == nil {
+		return errors.New("hybrid search is not supported")
+	}
+
+	// the hybrid search has been charged for the sub-searches by the rate limiter
+	ctx = withRateCharged(ctx)
+	ht.subResults = make([]*milvuspb.SearchResults, len(ht.req.Requests))
+	errs := make([]error, len(ht.req.Requests))
+	var wg sync.WaitGroup
+	for i, sub := range ht.req.Requests {
+		wg.Add(1)
+		go func(i int, sub *milvuspb.SearchRequest) {
+			defer wg.Done()
+			ht.subResults[i], errs[i] = ht.searchFunc(ctx, sub)
+		}(i, sub)
+	}
+	wg.Wait()
+
+	for i, result := range ht.subResults {
+		if errs[i] != nil {
+			return errs[i]
+		}
+		if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("search request %d of hybrid search failed, reason: %s", i, result.GetStatus().GetReason())
+		}
+	}
+	return nil
+}
+
+func (ht *hybridSearchTask) PostExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ht.TraceCtx(), "Proxy-HybridSearch-PostExecute")
+	defer sp.Finish()
+
+	results := make([]*schemapb.SearchResultData, 0, len(ht.subResults))
+	for _, result := range ht.subResults {
+		results = append(results, result.GetResults())
+	}
+	data, err := ht.reranker.rerank(results, ht.topk, ht.offset)
+	if err != nil {
+		return err
+	}
+	ht.result = &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Results: data,
+	}
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, ht.req.GetDbName(), ht.req.CollectionName)
+	if err != nil {
+		return err
+	}
+	return ht.fillOutputFields(ctx, schema)
+}
+
+// fillOutputFields retrieves the output fields of the fused hits by their primary keys,
+// the rows are arranged in the order of the hits
+func (ht *hybridSearchTask) fillOutputFields(ctx context.Context, schema *schemapb.CollectionSchema) error {
+	outputFields, err := translateOutputFields(ht.req.OutputFields, schema, false)
+	if err != nil {
+		return err
+	}
+	ids := ht.result.Results.Ids
+	if len(outputFields) == 0 || typeutil.GetSizeOfIDs(ids) == 0 {
+		return nil
+	}
+	if ht.queryFunc == nil {
+		return errors.New("output fields of hybrid search are not supported")
+	}
+
+	pkFieldName := ""
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey {
+			pkFieldName = field.Name
+		}
+	}
+	uniqueIDs := &schemapb.IDs{}
+	idSet := make(map[interface{}]struct{})
+	for i := 0; i < typeutil.GetSizeOfIDs(ids); i++ {
+		id := typeutil.GetPK(ids, int64(i))
+		if _, ok := idSet[id]; !ok {
+			idSet[id] = struct{}{}
+			typeutil.AppendPKs(uniqueIDs, id)
+		}
+	}
+
+	resp, err := ht.queryFunc(ctx, &milvuspb.QueryRequest{
+		DbName:             ht.req.DbName,
+		CollectionName:     ht.req.CollectionName,
+		PartitionNames:     ht.req.PartitionNames,
+		Expr:               IDs2Expr(pkFieldName, uniqueIDs),
+		OutputFields:       outputFields,
+		TravelTimestamp:    ht.req.TravelTimestamp,
+		GuaranteeTimestamp: ht.req.GuaranteeTimestamp,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to retrieve the output fields of hybrid search, reason: %s", resp.GetStatus().GetReason())
+	}
+
+	var pkColumn *schemapb.FieldData
+	columns := make(map[string]*schemapb.FieldData)
+	for _, fieldData := range resp.FieldsData {
+		columns[fieldData.FieldName] = fieldData
+		if fieldData.FieldName == pkFieldName {
+			pkColumn = fieldData
+		}
+	}
+	if pkColumn == nil {
+		return fmt.Errorf("primary field %s not found in the retrieve results", pkFieldName)
+	}
+	pks, err := typeutil.GetPKsFromFieldData(pkColumn)
+	if err != nil {
+		return err
+	}
+	rowOfID := make(map[interface{}]int64)
+	for row := 0; row < typeutil.GetSizeOfIDs(pks); row++ {
+		rowOfID[typeutil.GetPK(pks, int64(row))] = int64(row)
+	}
+	rows := make([]int64, 0, typeutil.GetSizeOfIDs(ids))
+	for i := 0; i < typeutil.GetSizeOfIDs(ids); i++ {
+		id := typeutil.GetPK(ids, int64(i))
+		row, ok := rowOfID[id]
+		if !ok {
+			return fmt.Errorf("entity %v of the search results not found", id)
+		}
+		rows = append(rows, row)
+	}
+
+	src := make([]*schemapb.FieldData, 0, len(outputFields))
+	for _, name := range outputFields {
+		column, ok := columns[name]
+		if !ok {
+			return fmt.Errorf("output field %s not found in the retrieve results", name)
+		}
+		src = append(src, column)
+	}
+	ht.result.Results.FieldsData = typeutil.SelectFieldData(src, rows)
+	for i, fieldData := range ht.result.Results.FieldsData {
+		fieldData.Type = src[i].Type
+	}
+	return nil
+}
+
 type queryTask struct {
 	Condition
 	*internalpb.RetrieveRequest
@@ -1950,6 +2869,10 @@ type queryTask struct {
 	chMgr     channelsMgr
 	qc        types.QueryCoord
 	ids       *schemapb.IDs
+	// session is the session_id of the request and sessionTs is the last write of the session
+	// for the Session consistency level
+	session   string
+	sessionTs Timestamp
 }
 
 func (qt *queryTask) TraceCtx() context.Context {
@@ -1990,7 +2913,7 @@ func (qt *queryTask) OnEnqueue() error {
 }
 
 func (qt *queryTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.GetDbName(), qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2009,7 +2932,7 @@ func (qt *queryTask) getChannels() ([]pChan, error) {
 }
 
 func (qt *queryTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.GetDbName(), qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2027,11 +2950,131 @@ func (qt *queryTask) getVChannels() ([]vChan, error) {
 	return channels, nil
 }
 
-func IDs2Expr(fieldName string, ids []int64) string {
-	idsStr := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids)), ", "), "[]")
+func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
+	var idsStr string
+	if strIDs := ids.GetStrId().GetData(); len(strIDs) > 0 {
+		quoted := make([]string, 0, len(strIDs))
+		for _, id := range strIDs {
+			quoted = append(quoted, strconv.Quote(id))
+		}
+		idsStr = strings.Join(quoted, ", ")
+	} else {
+		idsStr = strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids.GetIntId().GetData())), ", "), "[]")
+	}
 	return fieldName + " in [ " + idsStr + " ]"
 }
 
+var aggregateRegexp = regexp.MustCompile(`(?i)^(count|min|max|sum|avg)\s*\(\s*(\S+?)\s*\)$`)
+
+// parseAggregates splits the aggregations such as count(*) and sum(age) out of the output fields,
+// it returns the aggregations and the other output fields
+func parseAggregates(outputFields []string, schema *schemapb.CollectionSchema) ([]*internalpb.Aggregate, []string, error) {
+	var aggregates []*internalpb.Aggregate
+	var others []string
+	for _, outputField := range outputFields {
+		matches := aggregateRegexp.FindStringSubmatch(strings.TrimSpace(outputField))
+		if matches == nil {
+			others = append(others, outputField)
+			continue
+		}
+		op := internalpb.AggregateOp(internalpb.AggregateOp_value[strings.Title(strings.ToLower(matches[1]))])
+		agg := &internalpb.Aggregate{Op: op}
+		if op == internalpb.AggregateOp_Count {
+			if matches[2] != "*" {
+				return nil, nil, fmt.Errorf("count only supports *, but got %s", outputField)
+			}
+		} else {
+			found := false
+			for _, field := range schema.Fields {
+				if field.Name == matches[2] && field.FieldID >= common.StartOfUserFieldID {
+					agg.FieldID = field.FieldID
+					found = true
+				}
+			}
+			if !found {
+				return nil, nil, errors.New("Field " + matches[2] + " not exist")
+			}
+		}
+		aggregates = append(aggregates, agg)
+	}
+	return aggregates, others, nil
+}
+
+// prepareAggregates sets the aggregations and the group by field of the retrieve request, only the group by field
+// can be returned along with the aggregations, and each query node returns the partial aggregates of its segments
+func (qt *queryTask) prepareAggregates(schema *schemapb.CollectionSchema, plan *planpb.PlanNode, aggregates []*internalpb.Aggregate, outputFields []string) error {
+	if qt.query.Limit != 0 || qt.query.Offset != 0 || qt.query.OrderBy != "" {
+		return errors.New("limit, offset and order_by are not supported with aggregations")
+	}
+	var groupByFieldID int64
+	if qt.query.GroupBy != "" {
+		for _, field := range schema.Fields {
+			if field.Name == qt.query.GroupBy && field.FieldID >= common.StartOfUserFieldID {
+				groupByFieldID = field.FieldID
+			}
+		}
+		if groupByFieldID == 0 {
+			return errors.New("Field " + qt.query.GroupBy + " not exist")
+		}
+	}
+	for _, outputField := range outputFields {
+		if qt.query.GroupBy == "" || strings.TrimSpace(outputField) != qt.query.GroupBy {
+			return fmt.Errorf("field %s can not be returned with aggregations, only the group_by field can", outputField)
+		}
+	}
+	if _, err := typeutil.NewAggregator(schema, aggregates, groupByFieldID); err != nil {
+		return err
+	}
+
+	// the primary key, the group by field and the aggregated fields are retrieved
+	var fieldIDs []int64
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey {
+			fieldIDs = append(fieldIDs, field.FieldID)
+		}
+	}
+	if groupByFieldID != 0 && !funcutil.SliceContain(fieldIDs, groupByFieldID) {
+		fieldIDs = append(fieldIDs, groupByFieldID)
+	}
+	for _, agg := range aggregates {
+		if agg.FieldID != 0 && !funcutil.SliceContain(fieldIDs, agg.FieldID) {
+			fieldIDs = append(fieldIDs, agg.FieldID)
+		}
+	}
+	qt.OutputFieldsId = fieldIDs
+	plan.OutputFieldIds = append(plan.OutputFieldIds, fieldIDs...)
+	qt.Aggregates = aggregates
+	qt.GroupByFieldID = groupByFieldID
+	return nil
+}
+
+// reduceAggregates merges the partial aggregates returned by the query nodes into the results of the aggregations.
+// Each query node aggregates a primary key once, but the partials of different query nodes are simply added, so a
+// primary key served by two query nodes at the same time, e.g. while a growing segment is handed off as a sealed
+// one, is aggregated twice.
+func (qt *queryTask) reduceAggregates(ctx context.Context, retrieveResults []*internalpb.RetrieveResults) error {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.GetDbName(), qt.query.CollectionName)
+	if err != nil {
+		return err
+	}
+	aggregator, err := typeutil.NewAggregator(schema, qt.Aggregates, qt.GroupByFieldID)
+	if err != nil {
+		return err
+	}
+	for _, rr := range retrieveResults {
+		if err := aggregator.AddPartials(rr.GetAggregatePartials()); err != nil {
+			return err
+		}
+	}
+	qt.result = &milvuspb.QueryResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		FieldsData: aggregator.Finalize(),
+	}
+	return nil
+}
+
 func (qt *queryTask) PreExecute(ctx context.Context) error {
 	qt.Base.MsgType = commonpb.MsgType_Retrieve
 	qt.Base.SourceID = Params.ProxyID
@@ -2046,7 +3089,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	log.Info("Validate collection name.", zap.Any("collectionName", collectionName),
 		zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, qt.query.GetDbName(), collectionName)
 	if err != nil {
 		log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2096,7 +3139,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
 	}
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+	schema, _ := globalMetaCache.GetCollectionSchema(ctx, qt.query.GetDbName(), qt.query.CollectionName)
 
 	if qt.ids != nil {
 		pkField := ""
@@ -2105,7 +3148,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 				pkField = field.Name
 			}
 		}
-		qt.query.Expr = IDs2Expr(pkField, qt.ids.GetIntId().Data)
+		qt.query.Expr = IDs2Expr(pkField, qt.ids)
 	}
 
 	if qt.query.Expr == "" {
@@ -2117,44 +3160,96 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	qt.query.OutputFields, err = translateOutputFields(qt.query.OutputFields, schema, true)
+	if typeutil.GetPartitionKeyField(schema) != nil && len(qt.query.PartitionNames) > 0 {
+		return errors.New("not allowed to specify partition names when the collection has a partition key")
+	}
+	prunedPartitionIDs, err := prunePartitionsByPartitionKey(ctx, qt.query.GetDbName(), collectionName, schema, plan.GetPredicates())
 	if err != nil {
 		return err
 	}
-	log.Debug("translate output fields", zap.Any("OutputFields", qt.query.OutputFields))
-	if len(qt.query.OutputFields) == 0 {
-		for _, field := range schema.Fields {
-			if field.FieldID >= 100 && field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
-				qt.OutputFieldsId = append(qt.OutputFieldsId, field.FieldID)
-			}
+	aggregates, outputFields, err := parseAggregates(qt.query.OutputFields, schema)
+	if err != nil {
+		return err
+	}
+	if len(aggregates) > 0 {
+		if err := qt.prepareAggregates(schema, plan, aggregates, outputFields); err != nil {
+			return err
 		}
 	} else {
-		addPrimaryKey := false
-		for _, reqField := range qt.query.OutputFields {
-			findField := false
+		if qt.query.GroupBy != "" {
+			return errors.New("group_by is only valid with aggregations in output_fields")
+		}
+		qt.query.OutputFields, err = translateOutputFields(qt.query.OutputFields, schema, true)
+		if err != nil {
+			return err
+		}
+		log.Debug("translate output fields", zap.Any("OutputFields", qt.query.OutputFields))
+		if len(qt.query.OutputFields) == 0 {
 			for _, field := range schema.Fields {
-				if reqField == field.Name {
-					if field.IsPrimaryKey {
-						addPrimaryKey = true
-					}
-					findField = true
+				if field.FieldID >= 100 && !typeutil.IsVectorType(field.DataType) {
 					qt.OutputFieldsId = append(qt.OutputFieldsId, field.FieldID)
-					plan.OutputFieldIds = append(plan.OutputFieldIds, field.FieldID)
-				} else {
-					if field.IsPrimaryKey && !addPrimaryKey {
+				}
+			}
+		} else {
+			addPrimaryKey := false
+			for _, reqField := range qt.query.OutputFields {
+				findField := false
+				for _, field := range schema.Fields {
+					if reqField == field.Name {
+						if field.IsPrimaryKey {
+							addPrimaryKey = true
+						}
+						findField = true
 						qt.OutputFieldsId = append(qt.OutputFieldsId, field.FieldID)
 						plan.OutputFieldIds = append(plan.OutputFieldIds, field.FieldID)
-						addPrimaryKey = true
+					} else {
+						if field.IsPrimaryKey && !addPrimaryKey {
+							qt.OutputFieldsId = append(qt.OutputFieldsId, field.FieldID)
+							plan.OutputFieldIds = append(plan.OutputFieldIds, field.FieldID)
+							addPrimaryKey = true
+						}
 					}
 				}
-			}
-			if !findField {
-				errMsg := "Field " + reqField + " not exist"
-				return errors.New(errMsg)
+				if !findField {
+					errMsg := "Field " + reqField + " not exist"
+					return errors.New(errMsg)
+				}
 			}
 		}
 	}
-	log.Debug("translate output fields to field ids", zap.Any("OutputFieldsID", qt.OutputFieldsId))
+
+	if qt.query.Limit < 0 || qt.query.Offset < 0 {
+		return fmt.Errorf("invalid limit %d and offset %d, should not be negative", qt.query.Limit, qt.query.Offset)
+	}
+	if qt.query.Offset > 0 && qt.query.Limit == 0 {
+		return errors.New("offset is only valid with limit")
+	}
+	if qt.query.OrderBy != "" {
+		orderByFieldID := int64(-1)
+		for _, field := range schema.Fields {
+			if field.Name == qt.query.OrderBy && field.FieldID >= common.StartOfUserFieldID {
+				if typeutil.IsVectorType(field.DataType) {
+					return fmt.Errorf("can not order by vector field %s", field.Name)
+				}
+				orderByFieldID = field.FieldID
+			}
+		}
+		if orderByFieldID == -1 {
+			return errors.New("Field " + qt.query.OrderBy + " not exist")
+		}
+		// the order by field is always returned, just like the primary key
+		if !funcutil.SliceContain(qt.OutputFieldsId, orderByFieldID) {
+			qt.OutputFieldsId = append(qt.OutputFieldsId, orderByFieldID)
+			plan.OutputFieldIds = append(plan.OutputFieldIds, orderByFieldID)
+		}
+		qt.OrderByFieldID = orderByFieldID
+		qt.Descending = qt.query.Descending
+	}
+	if qt.query.Limit > 0 {
+		// each segment has to return offset + limit rows to get the correct page after merging
+		qt.RetrieveRequest.Limit = qt.query.Offset + qt.query.Limit
+	}
+	log.Debug("translate output fields to field ids", zap.Any("OutputFieldsID", qt.OutputFieldsId))
 
 	qt.RetrieveRequest.SerializedExprPlan, err = proto.Marshal(plan)
 	if err != nil {
@@ -2165,12 +3260,17 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	if travelTimestamp == 0 {
 		travelTimestamp = qt.BeginTs()
 	}
-	guaranteeTimestamp := qt.query.GuaranteeTimestamp
-	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = qt.BeginTs()
+	consistencyLevel, err := getConsistencyLevel(ctx, qt.query.GetDbName(), collectionName,
+		qt.query.ConsistencyLevel, qt.query.ExplicitConsistency)
+	if err != nil {
+		return err
+	}
+	if err := validateSession(consistencyLevel, qt.query.GuaranteeTimestamp, qt.session); err != nil {
+		return err
 	}
 	qt.TravelTimestamp = travelTimestamp
-	qt.GuaranteeTimestamp = guaranteeTimestamp
+	qt.GuaranteeTimestamp = parseGuaranteeTs(consistencyLevel, qt.query.GuaranteeTimestamp, qt.BeginTs(), qt.sessionTs,
+		qt.query.GracefulTime)
 
 	qt.ResultChannelID = Params.RetrieveResultChannelNames[0]
 	qt.DbID = 0 // todo(yukun)
@@ -2178,7 +3278,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	qt.CollectionID = collectionID
 	qt.PartitionIDs = make([]UniqueID, 0)
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, qt.query.GetDbName(), collectionName)
 	if err != nil {
 		log.Debug("Failed to get partitions in collection.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2212,6 +3312,9 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 			return errors.New(errMsg)
 		}
 	}
+	if prunedPartitionIDs != nil {
+		qt.PartitionIDs = prunedPartitionIDs
+	}
 
 	log.Info("Query PreExecute done.",
 		zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2265,12 +3368,12 @@ func (qt *queryTask) Execute(ctx context.Context) error {
 func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
 	var ret *milvuspb.QueryResults
 	var skipDupCnt int64 = 0
-	var idSet = make(map[int64]struct{})
+	var idSet = make(map[interface{}]struct{})
 
 	// merge results and remove duplicates
 	for _, rr := range retrieveResults {
 		// skip empty result, it will break merge result
-		if rr == nil || rr.Ids == nil || rr.Ids.GetIntId() == nil || len(rr.Ids.GetIntId().Data) == 0 {
+		if rr == nil || typeutil.GetSizeOfIDs(rr.Ids) == 0 {
 			continue
 		}
 
@@ -2284,7 +3387,8 @@ func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults) (*milvu
 			return nil, fmt.Errorf("mismatch FieldData in proxy RetrieveResults, expect %d get %d", len(ret.FieldsData), len(rr.FieldsData))
 		}
 
-		for i, id := range rr.Ids.GetIntId().GetData() {
+		for i := 0; i < typeutil.GetSizeOfIDs(rr.Ids); i++ {
+			id := typeutil.GetPK(rr.Ids, int64(i))
 			if _, ok := idSet[id]; !ok {
 				typeutil.AppendFieldData(ret.FieldsData, rr.FieldsData, int64(i))
 				idSet[id] = struct{}{}
@@ -2305,6 +3409,63 @@ func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults) (*milvu
 	return ret, nil
 }
 
+// limitQueryResults sorts the merged results by the order by field, or by primary key if there is none,
+// and keeps the rows between offset and offset + limit
+func (qt *queryTask) limitQueryResults(ctx context.Context) error {
+	if len(qt.result.FieldsData) == 0 {
+		return nil
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.GetDbName(), qt.query.CollectionName)
+	if err != nil {
+		return err
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return err
+	}
+	pkField, err := helper.GetPrimaryKeyField()
+	if err != nil {
+		return err
+	}
+
+	pks := &schemapb.IDs{}
+	var orderBy *schemapb.FieldData
+	for i, fieldData := range qt.result.FieldsData {
+		if i >= len(qt.OutputFieldsId) {
+			break
+		}
+		if qt.OutputFieldsId[i] == pkField.FieldID {
+			if pks, err = typeutil.GetPKsFromFieldData(fieldData); err != nil {
+				return err
+			}
+		}
+		if qt.query.OrderBy != "" && qt.OutputFieldsId[i] == qt.OrderByFieldID {
+			orderBy = fieldData
+		}
+	}
+
+	rows, err := typeutil.SortRows(pks, orderBy, qt.query.Descending)
+	if err != nil {
+		return err
+	}
+	if qt.query.Limit > 0 {
+		start, end := qt.query.Offset, qt.query.Offset+qt.query.Limit
+		if start > int64(len(rows)) {
+			start = int64(len(rows))
+		}
+		if end > int64(len(rows)) {
+			end = int64(len(rows))
+		}
+		rows = rows[start:end]
+	}
+	if len(rows) == 0 {
+		qt.result.FieldsData = []*schemapb.FieldData{}
+		return nil
+	}
+	qt.result.FieldsData = typeutil.SelectFieldData(qt.result.FieldsData, rows)
+	return nil
+}
+
 func (qt *queryTask) PostExecute(ctx context.Context) error {
 	tr := timerecord.NewTimeRecorder("queryTask PostExecute")
 	defer func() {
@@ -2337,12 +3498,26 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 			return errors.New(reason)
 		}
 
+		if len(qt.Aggregates) > 0 {
+			if err := qt.reduceAggregates(ctx, filterRetrieveResults); err != nil {
+				return err
+			}
+			log.Info("Query PostExecute done", zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
+			return nil
+		}
+
 		var err error
 		qt.result, err = mergeRetrieveResults(filterRetrieveResults)
 		if err != nil {
 			return err
 		}
 
+		if qt.query.Limit > 0 || qt.query.OrderBy != "" {
+			if err := qt.limitQueryResults(ctx); err != nil {
+				return err
+			}
+		}
+
 		if len(qt.result.FieldsData) > 0 {
 			qt.result.Status = &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_Success,
@@ -2356,7 +3531,7 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 			return nil
 		}
 
-		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.GetDbName(), qt.query.CollectionName)
 		if err != nil {
 			return err
 		}
@@ -2531,23 +3706,27 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.Schema.Name = result.Schema.Name
 		dct.result.Schema.Description = result.Schema.Description
 		dct.result.Schema.AutoID = result.Schema.AutoID
+		dct.result.Schema.TtlSeconds = result.Schema.TtlSeconds
 		dct.result.CollectionID = result.CollectionID
 		dct.result.VirtualChannelNames = result.VirtualChannelNames
 		dct.result.PhysicalChannelNames = result.PhysicalChannelNames
 		dct.result.CreatedTimestamp = result.CreatedTimestamp
 		dct.result.CreatedUtcTimestamp = result.CreatedUtcTimestamp
 		dct.result.ShardsNum = result.ShardsNum
+		dct.result.ConsistencyLevel = result.ConsistencyLevel
+		dct.result.NumPartitions = result.NumPartitions
 		for _, field := range result.Schema.Fields {
 			if field.FieldID >= common.StartOfUserFieldID {
 				dct.result.Schema.Fields = append(dct.result.Schema.Fields, &schemapb.FieldSchema{
-					FieldID:      field.FieldID,
-					Name:         field.Name,
-					IsPrimaryKey: field.IsPrimaryKey,
-					AutoID:       field.AutoID,
-					Description:  field.Description,
-					DataType:     field.DataType,
-					TypeParams:   field.TypeParams,
-					IndexParams:  field.IndexParams,
+					FieldID:        field.FieldID,
+					Name:           field.Name,
+					IsPrimaryKey:   field.IsPrimaryKey,
+					AutoID:         field.AutoID,
+					Description:    field.Description,
+					DataType:       field.DataType,
+					TypeParams:     field.TypeParams,
+					IndexParams:    field.IndexParams,
+					IsPartitionKey: field.IsPartitionKey,
 				})
 			}
 		}
@@ -2611,7 +3790,7 @@ func (g *getCollectionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getCollectionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.GetDbName(), g.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -2698,11 +3877,11 @@ func (g *getPartitionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getPartitionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.GetDbName(), g.CollectionName)
 	if err != nil {
 		return err
 	}
-	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.CollectionName, g.PartitionName)
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.GetDbName(), g.CollectionName, g.PartitionName)
 	if err != nil {
 		return err
 	}
@@ -2820,15 +3999,17 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 			IDs2Names[collectionID] = collectionName
 		}
 		collectionIDs := make([]UniqueID, 0)
+		var dbID UniqueID
 		for _, collectionName := range sct.CollectionNames {
-			collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+			collInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.GetDbName(), collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
 				return err
 			}
-			collectionIDs = append(collectionIDs, collectionID)
-			IDs2Names[collectionID] = collectionName
+			dbID = collInfo.dbID
+			collectionIDs = append(collectionIDs, collInfo.collID)
+			IDs2Names[collInfo.collID] = collectionName
 		}
 
 		resp, err := sct.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
@@ -2838,7 +4019,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 				Timestamp: sct.Base.Timestamp,
 				SourceID:  sct.Base.SourceID,
 			},
-			//DbID: sct.ShowCollectionsRequest.DbName,
+			DbID:          dbID,
 			CollectionIDs: collectionIDs,
 		})
 
@@ -2870,7 +4051,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
 				return errors.New("failed to show collections")
 			}
-			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.GetDbName(), collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -3204,7 +4385,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.GetDbName(), collectionName)
 		if err != nil {
 			log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 				zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3217,7 +4398,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 		}
 		partitionIDs := make([]UniqueID, 0)
 		for _, partitionName := range spt.PartitionNames {
-			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, spt.GetDbName(), collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3265,7 +4446,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
 				return errors.New("failed to show partitions")
 			}
-			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, collectionName, partitionName)
+			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, spt.GetDbName(), collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3383,6 +4564,17 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("invalid index params: %v", cit.CreateIndexRequest.ExtraParams)
 	}
 
+	// the sparse inverted index is the only index of sparse float vector fields
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, cit.GetDbName(), collName)
+	if err != nil {
+		return err
+	}
+	for _, field := range schema.Fields {
+		if field.Name == fieldName && (field.DataType == schemapb.DataType_SparseFloatVector) != (indexType == indexparamcheck.IndexSparseInverted) {
+			return fmt.Errorf("index type %s mismatches with data type %s of field %s", indexType, field.DataType.String(), fieldName)
+		}
+	}
+
 	return nil
 }
 
@@ -3561,6 +4753,28 @@ func (dit *dropIndexTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// matchIndexDescription returns the id of the index of the name on the field and the id of the field,
+// the index of the name on any vector field matches if the field name is empty
+func matchIndexDescription(ctx context.Context, dbName string, collectionName string, fieldName string, indexName string,
+	descriptions []*milvuspb.IndexDescription) (UniqueID, UniqueID, error) {
+	for _, desc := range descriptions {
+		if desc.IndexName != indexName || (fieldName != "" && desc.FieldName != fieldName) {
+			continue
+		}
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, field := range schema.Fields {
+			if field.Name == desc.FieldName {
+				return desc.IndexID, field.FieldID, nil
+			}
+		}
+		return 0, 0, fmt.Errorf("field %s of index %s not found", desc.FieldName, indexName)
+	}
+	return 0, 0, fmt.Errorf("no index is created")
+}
+
 type getIndexBuildProgressTask struct {
 	Condition
 	*milvuspb.GetIndexBuildProgressRequest
@@ -3621,7 +4835,7 @@ func (gibpt *getIndexBuildProgressTask) PreExecute(ctx context.Context) error {
 
 func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 	collectionName := gibpt.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gibpt.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -3663,17 +4877,10 @@ func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 		return err2
 	}
 
-	matchIndexID := int64(-1)
-	foundIndexID := false
-	for _, desc := range indexDescriptionResp.IndexDescriptions {
-		if desc.IndexName == gibpt.IndexName {
-			matchIndexID = desc.IndexID
-			foundIndexID = true
-			break
-		}
-	}
-	if !foundIndexID {
-		return fmt.Errorf("no index is created")
+	matchIndexID, matchFieldID, err := matchIndexDescription(ctx, gibpt.GetDbName(), collectionName, gibpt.FieldName, gibpt.IndexName,
+		indexDescriptionResp.IndexDescriptions)
+	if err != nil {
+		return err
 	}
 
 	var allSegmentIDs []UniqueID
@@ -3712,7 +4919,7 @@ func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 				SourceID:  Params.ProxyID,
 			},
 			CollectionID: collectionID,
-			SegmentID:    segmentID,
+			SegmentID:    segmentID,	FieldID:      matchFieldID,
 		}
 		segmentDesc, err := gibpt.rootCoord.DescribeSegment(ctx, describeSegmentRequest)
 		if err != nil {
@@ -3842,7 +5049,7 @@ func (gist *getIndexStateTask) PreExecute(ctx context.Context) error {
 
 func (gist *getIndexStateTask) Execute(ctx context.Context) error {
 	collectionName := gist.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -3884,17 +5091,10 @@ func (gist *getIndexStateTask) Execute(ctx context.Context) error {
 		return err2
 	}
 
-	matchIndexID := int64(-1)
-	foundIndexID := false
-	for _, desc := range indexDescriptionResp.IndexDescriptions {
-		if desc.IndexName == gist.IndexName {
-			matchIndexID = desc.IndexID
-			foundIndexID = true
-			break
-		}
-	}
-	if !foundIndexID {
-		return fmt.Errorf("no index is created")
+	matchIndexID, matchFieldID, err := matchIndexDescription(ctx, gist.GetDbName(), collectionName, gist.FieldName, gist.IndexName,
+		indexDescriptionResp.IndexDescriptions)
+	if err != nil {
+		return err
 	}
 
 	var allSegmentIDs []UniqueID
@@ -3932,7 +5132,7 @@ func (gist *getIndexStateTask) Execute(ctx context.Context) error {
 				SourceID:  Params.ProxyID,
 			},
 			CollectionID: collectionID,
-			SegmentID:    segmentID,
+			SegmentID:    segmentID,	FieldID:      matchFieldID,
 		}
 		segmentDesc, err := gist.rootCoord.DescribeSegment(ctx, describeSegmentRequest)
 		if err != nil {
@@ -4044,7 +5244,7 @@ func (ft *flushTask) PreExecute(ctx context.Context) error {
 func (ft *flushTask) Execute(ctx context.Context) error {
 	coll2Segments := make(map[string]*schemapb.LongArray)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collID, err := globalMetaCache.GetCollectionID(ctx, ft.GetDbName(), collName)
 		if err != nil {
 			return err
 		}
@@ -4143,11 +5343,11 @@ func (lct *loadCollectionTask) PreExecute(ctx context.Context) error {
 
 func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	log.Debug("loadCollectionTask Execute", zap.String("role", Params.RoleName), zap.Int64("msgID", lct.Base.MsgID))
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.GetDbName(), lct.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.GetDbName(), lct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -4237,7 +5437,7 @@ func (rct *releaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rct.GetDbName(), rct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -4323,16 +5523,16 @@ func (lpt *loadPartitionsTask) PreExecute(ctx context.Context) error {
 
 func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.GetDbName(), lpt.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.GetDbName(), lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.GetDbName(), lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4418,12 +5618,12 @@ func (rpt *releasePartitionsTask) PreExecute(ctx context.Context) error {
 
 func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.GetDbName(), rpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.GetDbName(), rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4460,6 +5660,11 @@ type deleteTask struct {
 	chTicker  channelsTimeTicker
 	vChannels []vChan
 	pChannels []pChan
+
+	// queryFunc retrieves the entities matched by the expressions other than "pk in [a, b]"
+	queryFunc func(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
+	// pkField is set if the primary keys matched by the expression are retrieved by queryFunc
+	pkField *schemapb.FieldSchema
 }
 
 func (dt *deleteTask) TraceCtx() context.Context {
@@ -4499,28 +5704,120 @@ func (dt *deleteTask) OnEnqueue() error {
 	return nil
 }
 
-func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (res []int64, err error) {
+func (dt *deleteTask) getPChanStats() (map[pChan]pChanStatistics, error) {
+	ret := make(map[pChan]pChanStatistics)
+
+	channels, err := dt.getChannels()
+	if err != nil {
+		return ret, err
+	}
+
+	beginTs := dt.BeginTs()
+	endTs := dt.EndTs()
+
+	for _, channel := range channels {
+		ret[channel] = pChanStatistics{
+			minTs: beginTs,
+			maxTs: endTs,
+		}
+	}
+	return ret, nil
+}
+
+func (dt *deleteTask) getChannels() ([]pChan, error) {
+	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.req.GetDbName(), dt.req.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	var channels []pChan
+	channels, err = dt.chMgr.getChannels(collID)
+	if err != nil {
+		err = dt.chMgr.createDMLMsgStream(collID)
+		if err != nil {
+			return nil, err
+		}
+		channels, err = dt.chMgr.getChannels(collID)
+	}
+	return channels, err
+}
+
+// getPrimaryKeysFromExpr returns the primary keys listed by the expression "pk in [a, b]",
+// isTerm is false if the expression is any other boolean expression.
+func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (res *schemapb.IDs, isTerm bool, err error) {
 	if len(expr) == 0 {
 		log.Warn("empty expr")
-		return
+		return res, false, fmt.Errorf("delete expression is empty")
 	}
 
 	plan, err := createExprPlan(schema, expr)
 	if err != nil {
-		return res, fmt.Errorf("failed to create expr plan, expr = %s", expr)
+		return res, false, fmt.Errorf("failed to create expr plan, expr = %s", expr)
 	}
 
-	// delete request only support expr "id in [a, b]"
 	termExpr, ok := plan.Node.(*planpb.PlanNode_Predicates).Predicates.Expr.(*planpb.Expr_TermExpr)
-	if !ok {
-		return res, fmt.Errorf("invalid plan node type")
+	if !ok || !termExpr.TermExpr.GetColumnInfo().GetIsPrimaryKey() {
+		return res, false, nil
 	}
 
+	res = &schemapb.IDs{}
 	for _, v := range termExpr.TermExpr.Values {
-		res = append(res, v.GetInt64Val())
+		switch val := v.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			typeutil.AppendPKs(res, val.Int64Val)
+		case *planpb.GenericValue_StringVal:
+			typeutil.AppendPKs(res, val.StringVal)
+		}
+	}
+
+	return res, true, nil
+}
+
+// queryPrimaryKeys retrieves the batch of the primary keys matched by the expression after the token, which is
+// the last primary key of the previous batch. The entities are retrieved at the timestamp right before the delete,
+// the time tick of the DML channels is held there until the delete is produced since the delete task is in the dm
+// queue, so all the entities inserted before the delete are matched.
+func (dt *deleteTask) queryPrimaryKeys(ctx context.Context, token string) (*schemapb.IDs, string, error) {
+	var partitionNames []string
+	if len(dt.req.PartitionName) > 0 {
+		partitionNames = []string{dt.req.PartitionName}
+	}
+	request, err := nextQueryRequest(&internalpb.QueryIteratorInfo{
+		DbName:          dt.req.GetDbName(),
+		CollectionName:  dt.req.CollectionName,
+		Expr:            dt.req.Expr,
+		OutputFields:    []string{dt.pkField.Name},
+		PartitionNames:  partitionNames,
+		TravelTimestamp: dt.BeginTs() - 1,
+		BatchSize:       Params.DeleteByExprBatchSize,
+	}, dt.pkField, token)
+	if err != nil {
+		return nil, "", err
+	}
+	// the delete has been charged for the retrieves by the rate limiter
+	resp, err := dt.queryFunc(withRateCharged(ctx), request)
+	if err != nil {
+		return nil, "", err
+	}
+	switch resp.GetStatus().GetErrorCode() {
+	case commonpb.ErrorCode_Success:
+	case commonpb.ErrorCode_EmptyCollection:
+		// no entity matches the expression
+		return &schemapb.IDs{}, token, nil
+	default:
+		return nil, "", errors.New(resp.GetStatus().GetReason())
 	}
 
-	return res, nil
+	for _, fieldData := range resp.FieldsData {
+		if fieldData.FieldName == dt.pkField.Name {
+			pks, err := typeutil.GetPKsFromFieldData(fieldData)
+			if err != nil {
+				return nil, "", err
+			}
+			next, _ := queryIteratorToken(resp.FieldsData, dt.pkField.FieldID)
+			return pks, next, nil
+		}
+	}
+	return nil, "", fmt.Errorf("primary field %s is not found in the query results", dt.pkField.Name)
 }
 
 func (dt *deleteTask) PreExecute(ctx context.Context) error {
@@ -4542,7 +5839,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		log.Error("Invalid collection name", zap.String("collectionName", collName))
 		return err
 	}
-	collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dt.req.GetDbName(), collName)
 	if err != nil {
 		log.Debug("Failed to get collection id", zap.String("collectionName", collName))
 		return err
@@ -4556,7 +5853,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 			log.Error("Invalid partition name", zap.String("partitionName", partName))
 			return err
 		}
-		partID, err := globalMetaCache.GetPartitionID(ctx, collName, partName)
+		partID, err := globalMetaCache.GetPartitionID(ctx, dt.req.GetDbName(), collName, partName)
 		if err != nil {
 			log.Debug("Failed to get partition id", zap.String("collectionName", collName), zap.String("partitionName", partName))
 			return err
@@ -4566,35 +5863,34 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		dt.DeleteRequest.PartitionID = common.InvalidPartitionID
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, dt.req.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dt.req.GetDbName(), dt.req.CollectionName)
 	if err != nil {
 		log.Error("Failed to get collection schema", zap.String("collectionName", dt.req.CollectionName))
 		return err
 	}
-
-	primaryKeys, err := getPrimaryKeysFromExpr(schema, dt.req.Expr)
+	primaryKeys, isTerm, err := getPrimaryKeysFromExpr(schema, dt.req.Expr)
 	if err != nil {
 		log.Error("Failed to get primary keys from expr", zap.Error(err))
 		return err
 	}
+	if !isTerm {
+		// the matched primary keys are retrieved in batches by Execute
+		if dt.queryFunc == nil {
+			return fmt.Errorf("delete by the expression %s is not supported", dt.req.Expr)
+		}
+		helper, err := typeutil.CreateSchemaHelper(schema)
+		if err != nil {
+			return err
+		}
+		dt.pkField, err = helper.GetPrimaryKeyField()
+		return err
+	}
 	log.Debug("get primary keys from expr", zap.Any("primary keys", primaryKeys))
-	dt.DeleteRequest.PrimaryKeys = primaryKeys
+	dt.setPrimaryKeys(primaryKeys)
 
 	// set result
-	dt.result.IDs.IdField = &schemapb.IDs_IntId{
-		IntId: &schemapb.LongArray{
-			Data: primaryKeys,
-		},
-	}
-	dt.result.DeleteCnt = int64(len(primaryKeys))
-
-	dt.HashPK(primaryKeys)
-
-	rowNum := len(primaryKeys)
-	dt.Timestamps = make([]uint64, rowNum)
-	for index := range dt.Timestamps {
-		dt.Timestamps[index] = dt.BeginTs()
-	}
+	dt.result.IDs = primaryKeys
+	dt.result.DeleteCnt = int64(typeutil.GetSizeOfIDs(primaryKeys))
 
 	return nil
 }
@@ -4603,14 +5899,6 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(dt.ctx, "Proxy-Delete-Execute")
 	defer sp.Finish()
 
-	var tsMsg msgstream.TsMsg = &dt.BaseDeleteTask
-	msgPack := msgstream.MsgPack{
-		BeginTs: dt.BeginTs(),
-		EndTs:   dt.EndTs(),
-		Msgs:    make([]msgstream.TsMsg, 1),
-	}
-	msgPack.Msgs[0] = tsMsg
-
 	collID := dt.DeleteRequest.CollectionID
 	stream, err := dt.chMgr.getDMLStream(collID)
 	if err != nil {
@@ -4627,6 +5915,66 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 			return err
 		}
 	}
+
+	if dt.pkField != nil {
+		err = dt.deleteByQuery(ctx, stream)
+	} else {
+		err = stream.Produce(dt.repackDeleteMsg(ctx, stream))
+	}
+	if err != nil {
+		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		dt.result.Status.Reason = err.Error()
+		return err
+	}
+	return nil
+}
+
+// deleteByQuery deletes the entities matched by the expression in batches of Params.DeleteByExprBatchSize,
+// all of them are stamped with the timestamp of the task
+func (dt *deleteTask) deleteByQuery(ctx context.Context, stream msgstream.MsgStream) error {
+	token := ""
+	for {
+		primaryKeys, next, err := dt.queryPrimaryKeys(ctx, token)
+		if err != nil {
+			return err
+		}
+		size := typeutil.GetSizeOfIDs(primaryKeys)
+		if size == 0 {
+			return nil
+		}
+		dt.setPrimaryKeys(primaryKeys)
+		if err := stream.Produce(dt.repackDeleteMsg(ctx, stream)); err != nil {
+			return err
+		}
+		for i := 0; i < size; i++ {
+			typeutil.AppendPKs(dt.result.IDs, typeutil.GetPK(primaryKeys, int64(i)))
+		}
+		dt.result.DeleteCnt += int64(size)
+		if int64(size) < Params.DeleteByExprBatchSize {
+			return nil
+		}
+		token = next
+	}
+}
+
+// repackDeleteMsg splits the delete request into the messages of the DML channels of the stream
+func (dt *deleteTask) repackDeleteMsg(ctx context.Context, stream msgstream.MsgStream) *msgstream.MsgPack {
+	var tsMsg msgstream.TsMsg = &dt.BaseDeleteTask
+	msgPack := msgstream.MsgPack{
+		BeginTs: dt.BeginTs(),
+		EndTs:   dt.EndTs(),
+		Msgs:    make([]msgstream.TsMsg, 1),
+	}
+	msgPack.Msgs[0] = tsMsg
+
+	newPack := &msgstream.MsgPack{
+		BeginTs:        msgPack.BeginTs,
+		EndTs:          msgPack.EndTs,
+		StartPositions: msgPack.StartPositions,
+		EndPositions:   msgPack.EndPositions,
+		Msgs:           make([]msgstream.TsMsg, 0),
+	}
+
 	result := make(map[int32]msgstream.TsMsg)
 	hashKeys := stream.ComputeProduceChannelIndexes(msgPack.Msgs)
 	// For each msg, assign PK to different message buckets by hash value of PK.
@@ -4640,7 +5988,6 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 		proxyID := deleteRequest.Base.SourceID
 		for index, key := range keys {
 			ts := deleteRequest.Timestamps[index]
-			pks := deleteRequest.PrimaryKeys[index]
 			_, ok := result[key]
 			if !ok {
 				sliceRequest := internalpb.DeleteRequest{
@@ -4666,16 +6013,18 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 			curMsg := result[key].(*msgstream.DeleteMsg)
 			curMsg.HashValues = append(curMsg.HashValues, deleteRequest.HashValues[index])
 			curMsg.Timestamps = append(curMsg.Timestamps, ts)
-			curMsg.PrimaryKeys = append(curMsg.PrimaryKeys, pks)
-		}
-	}
+			if len(deleteRequest.StringPrimaryKeys) > 0 {
+				curMsg.StringPrimaryKeys = append(curMsg.StringPrimaryKeys, deleteRequest.StringPrimaryKeys[index])
+			} else {
+				curMsg.PrimaryKeys = append(curMsg.PrimaryKeys, deleteRequest.PrimaryKeys[index])
+			}
 
-	newPack := &msgstream.MsgPack{
-		BeginTs:        msgPack.BeginTs,
-		EndTs:          msgPack.EndTs,
-		StartPositions: msgPack.StartPositions,
-		EndPositions:   msgPack.EndPositions,
-		Msgs:           make([]msgstream.TsMsg, 0),
+			// bound the size of each DeleteMsg
+			if int64(len(curMsg.Timestamps)) >= Params.MaxDeleteBatchSize {
+				newPack.Msgs = append(newPack.Msgs, curMsg)
+				delete(result, key)
+			}
+		}
 	}
 
 	for _, msg := range result {
@@ -4683,29 +6032,231 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 			newPack.Msgs = append(newPack.Msgs, msg)
 		}
 	}
-
-	err = stream.Produce(newPack)
-	if err != nil {
-		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
-		dt.result.Status.Reason = err.Error()
-		return err
-	}
-	return nil
+	return newPack
 }
 
 func (dt *deleteTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
-func (dt *deleteTask) HashPK(pks []int64) {
+func (dt *deleteTask) HashPK(pks *schemapb.IDs) {
 	if len(dt.HashValues) != 0 {
 		log.Warn("the hashvalues passed through client is not supported now, and will be overwritten")
 	}
-	dt.HashValues = make([]uint32, 0, len(pks))
-	for _, pk := range pks {
-		hash, _ := typeutil.Hash32Int64(pk)
-		dt.HashValues = append(dt.HashValues, hash)
+	dt.HashValues = typeutil.HashPKs(pks)
+}
+
+// setPrimaryKeys sets the primary keys to delete, the int64 keys or the string ones
+func (dt *deleteTask) setPrimaryKeys(pks *schemapb.IDs) {
+	dt.PrimaryKeys = pks.GetIntId().GetData()
+	dt.StringPrimaryKeys = pks.GetStrId().GetData()
+	dt.HashPK(pks)
+	dt.Timestamps = make([]uint64, typeutil.GetSizeOfIDs(pks))
+	for index := range dt.Timestamps {
+		dt.Timestamps[index] = dt.BeginTs()
+	}
+}
+
+// upsertTask replaces the entities with the same primary keys. The DeleteMsg of the old entities and
+// the InsertMsg of the new ones share a single timestamp and are produced to the same DML channels
+// at once, so the reads at any timestamp see either the old entities or the new ones.
+type upsertTask struct {
+	Condition
+	ctx    context.Context
+	req    *milvuspb.UpsertRequest
+	result *milvuspb.MutationResult
+
+	insertTask *insertTask
+	deleteTask *deleteTask
+	chMgr      channelsMgr
+}
+
+func (ut *upsertTask) TraceCtx() context.Context {
+	return ut.ctx
+}
+
+func (ut *upsertTask) ID() UniqueID {
+	return ut.insertTask.ID()
+}
+
+func (ut *upsertTask) SetID(uid UniqueID) {
+	ut.insertTask.SetID(uid)
+	ut.deleteTask.SetID(uid)
+}
+
+func (ut *upsertTask) Name() string {
+	return UpsertTaskName
+}
+
+func (ut *upsertTask) Type() commonpb.MsgType {
+	return commonpb.MsgType_Upsert
+}
+
+func (ut *upsertTask) BeginTs() Timestamp {
+	return ut.insertTask.BeginTs()
+}
+
+func (ut *upsertTask) EndTs() Timestamp {
+	return ut.insertTask.EndTs()
+}
+
+func (ut *upsertTask) SetTs(ts Timestamp) {
+	ut.insertTask.SetTs(ts)
+	ut.deleteTask.SetTs(ts)
+}
+
+func (ut *upsertTask) OnEnqueue() error {
+	if err := ut.insertTask.OnEnqueue(); err != nil {
+		return err
 	}
+	return ut.deleteTask.OnEnqueue()
+}
+
+func (ut *upsertTask) getChannels() ([]pChan, error) {
+	return ut.insertTask.getChannels()
+}
+
+func (ut *upsertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
+	return ut.insertTask.getPChanStats()
+}
+
+func (ut *upsertTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-PreExecute")
+	defer sp.Finish()
+
+	ut.result = &milvuspb.MutationResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IDs: &schemapb.IDs{
+			IdField: nil,
+		},
+		Timestamp: ut.EndTs(),
+	}
+
+	collectionName := ut.req.CollectionName
+	if err := validateCollectionName(collectionName); err != nil {
+		return err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, ut.req.GetDbName(), collectionName)
+	if err != nil {
+		return err
+	}
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey && field.AutoID {
+			return fmt.Errorf("upsert is not supported on the collection %s whose primary field %s is autoID", collectionName, field.Name)
+		}
+	}
+
+	it := ut.insertTask
+	if err := it.PreExecute(ctx); err != nil {
+		return err
+	}
+	primaryKeys := it.result.IDs
+	if typeutil.GetSizeOfIDs(primaryKeys) != int(ut.req.NumRows) {
+		return fmt.Errorf("upsert requires the data of the primary field, collection: %s", collectionName)
+	}
+	// all the rows share the timestamp of the delete, so every copy of a duplicated primary key would survive it
+	pks := make(map[interface{}]struct{}, ut.req.NumRows)
+	for i := int64(0); i < int64(ut.req.NumRows); i++ {
+		pk := typeutil.GetPK(primaryKeys, i)
+		if _, ok := pks[pk]; ok {
+			return fmt.Errorf("duplicate primary key %v in the upsert request, collection: %s", pk, collectionName)
+		}
+		pks[pk] = struct{}{}
+	}
+	// the new entities are hashed by the primary keys, the same as the deletes of the old ones
+	it.HashPK(primaryKeys)
+
+	dt := ut.deleteTask
+	dt.Base.MsgType = commonpb.MsgType_Delete
+	dt.Base.SourceID = Params.ProxyID
+	dt.CollectionID, err = globalMetaCache.GetCollectionID(ctx, ut.req.GetDbName(), collectionName)
+	if err != nil {
+		return err
+	}
+	if len(ut.req.PartitionName) > 0 {
+		dt.PartitionID, err = globalMetaCache.GetPartitionID(ctx, ut.req.GetDbName(), collectionName, ut.req.PartitionName)
+		if err != nil {
+			return err
+		}
+	} else {
+		dt.PartitionID = common.InvalidPartitionID
+	}
+	dt.setPrimaryKeys(primaryKeys)
+
+	ut.result.IDs = it.result.IDs
+	ut.result.SuccIndex = it.result.SuccIndex
+	ut.result.UpsertCnt = int64(typeutil.GetSizeOfIDs(primaryKeys))
+	return nil
+}
+
+func (ut *upsertTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-Execute")
+	defer sp.Finish()
+
+	it := ut.insertTask
+	collID := ut.deleteTask.CollectionID
+	it.CollectionID = collID
+	if it.partitionIndexes == nil {
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, ut.req.GetDbName(), it.CollectionName, it.PartitionName)
+		if err != nil {
+			return err
+		}
+		it.PartitionID = partitionID
+	}
+
+	stream, err := ut.chMgr.getDMLStream(collID)
+	if err != nil {
+		err = ut.chMgr.createDMLMsgStream(collID)
+		if err != nil {
+			ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			ut.result.Status.Reason = err.Error()
+			return err
+		}
+		stream, err = ut.chMgr.getDMLStream(collID)
+		if err != nil {
+			ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			ut.result.Status.Reason = err.Error()
+			return err
+		}
+	}
+
+	it.BaseMsg.Ctx = ctx
+	insertMsgs, err := it.insertMsgs(ctx)
+	if err != nil {
+		return err
+	}
+	insertPack, err := it._assignSegmentID(stream, &msgstream.MsgPack{
+		BeginTs: ut.BeginTs(),
+		EndTs:   ut.EndTs(),
+		Msgs:    insertMsgs,
+	})
+	if err != nil {
+		return err
+	}
+	deletePack := ut.deleteTask.repackDeleteMsg(ctx, stream)
+
+	// the deletes go ahead of the inserts in a single pack, both of them are stamped with the task timestamp
+	msgPack := &msgstream.MsgPack{
+		BeginTs: ut.BeginTs(),
+		EndTs:   ut.EndTs(),
+		Msgs:    make([]msgstream.TsMsg, 0, len(deletePack.Msgs)+len(insertPack.Msgs)),
+	}
+	msgPack.Msgs = append(msgPack.Msgs, deletePack.Msgs...)
+	msgPack.Msgs = append(msgPack.Msgs, insertPack.Msgs...)
+
+	err = stream.Produce(msgPack)
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+	return nil
+}
+
+func (ut *upsertTask) PostExecute(ctx context.Context) error {
+	return nil
 }
 
 type CreateAliasTask struct {
@@ -4917,3 +6468,271 @@ func (a *AlterAliasTask) Execute(ctx context.Context) error {
 func (a *AlterAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+type addCollectionFieldTask struct {
+	Condition
+	*milvuspb.AddCollectionFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (act *addCollectionFieldTask) TraceCtx() context.Context {
+	return act.ctx
+}
+
+func (act *addCollectionFieldTask) ID() UniqueID {
+	return act.Base.MsgID
+}
+
+func (act *addCollectionFieldTask) SetID(uid UniqueID) {
+	act.Base.MsgID = uid
+}
+
+func (act *addCollectionFieldTask) Name() string {
+	return AddCollectionFieldTaskName
+}
+
+func (act *addCollectionFieldTask) Type() commonpb.MsgType {
+	return act.Base.MsgType
+}
+
+func (act *addCollectionFieldTask) BeginTs() Timestamp {
+	return act.Base.Timestamp
+}
+
+func (act *addCollectionFieldTask) EndTs() Timestamp {
+	return act.Base.Timestamp
+}
+
+func (act *addCollectionFieldTask) SetTs(ts Timestamp) {
+	act.Base.Timestamp = ts
+}
+
+func (act *addCollectionFieldTask) OnEnqueue() error {
+	act.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (act *addCollectionFieldTask) PreExecute(ctx context.Context) error {
+	act.Base.MsgType = commonpb.MsgType_AddCollectionField
+	act.Base.SourceID = Params.ProxyID
+
+	if err := validateCollectionName(act.CollectionName); err != nil {
+		return err
+	}
+
+	field := &schemapb.FieldSchema{}
+	if err := proto.Unmarshal(act.Schema, field); err != nil {
+		return err
+	}
+	if err := validateFieldToAdd(field); err != nil {
+		return err
+	}
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, act.GetDbName(), act.CollectionName)
+	if err != nil {
+		return err
+	}
+	for _, f := range schema.Fields {
+		if f.Name == field.Name {
+			return fmt.Errorf("field %s already exists in collection %s", field.Name, act.CollectionName)
+		}
+	}
+	return nil
+}
+
+func (act *addCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	act.result, err = act.rootCoord.AddCollectionField(ctx, act.AddCollectionFieldRequest)
+	return err
+}
+
+func (act *addCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type CreateDatabaseTask struct {
+	Condition
+	*milvuspb.CreateDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (c *CreateDatabaseTask) TraceCtx() context.Context {
+	return c.ctx
+}
+
+func (c *CreateDatabaseTask) ID() UniqueID {
+	return c.Base.MsgID
+}
+
+func (c *CreateDatabaseTask) SetID(uid UniqueID) {
+	c.Base.MsgID = uid
+}
+
+func (c *CreateDatabaseTask) Name() string {
+	return CreateDatabaseTaskName
+}
+
+func (c *CreateDatabaseTask) Type() commonpb.MsgType {
+	return c.Base.MsgType
+}
+
+func (c *CreateDatabaseTask) BeginTs() Timestamp {
+	return c.Base.Timestamp
+}
+
+func (c *CreateDatabaseTask) EndTs() Timestamp {
+	return c.Base.Timestamp
+}
+
+func (c *CreateDatabaseTask) SetTs(ts Timestamp) {
+	c.Base.Timestamp = ts
+}
+
+func (c *CreateDatabaseTask) OnEnqueue() error {
+	c.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (c *CreateDatabaseTask) PreExecute(ctx context.Context) error {
+	c.Base.MsgType = commonpb.MsgType_CreateDatabase
+	c.Base.SourceID = Params.ProxyID
+
+	return ValidateDatabaseName(c.DbName)
+}
+
+func (c *CreateDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	c.result, err = c.rootCoord.CreateDatabase(ctx, c.CreateDatabaseRequest)
+	return err
+}
+
+func (c *CreateDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type DropDatabaseTask struct {
+	Condition
+	*milvuspb.DropDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (d *DropDatabaseTask) TraceCtx() context.Context {
+	return d.ctx
+}
+
+func (d *DropDatabaseTask) ID() UniqueID {
+	return d.Base.MsgID
+}
+
+func (d *DropDatabaseTask) SetID(uid UniqueID) {
+	d.Base.MsgID = uid
+}
+
+func (d *DropDatabaseTask) Name() string {
+	return DropDatabaseTaskName
+}
+
+func (d *DropDatabaseTask) Type() commonpb.MsgType {
+	return d.Base.MsgType
+}
+
+func (d *DropDatabaseTask) BeginTs() Timestamp {
+	return d.Base.Timestamp
+}
+
+func (d *DropDatabaseTask) EndTs() Timestamp {
+	return d.Base.Timestamp
+}
+
+func (d *DropDatabaseTask) SetTs(ts Timestamp) {
+	d.Base.Timestamp = ts
+}
+
+func (d *DropDatabaseTask) OnEnqueue() error {
+	d.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (d *DropDatabaseTask) PreExecute(ctx context.Context) error {
+	d.Base.MsgType = commonpb.MsgType_DropDatabase
+	d.Base.SourceID = Params.ProxyID
+
+	return ValidateDatabaseName(d.DbName)
+}
+
+func (d *DropDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	d.result, err = d.rootCoord.DropDatabase(ctx, d.DropDatabaseRequest)
+	return err
+}
+
+func (d *DropDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type ListDatabasesTask struct {
+	Condition
+	*milvuspb.ListDatabasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.ListDatabasesResponse
+}
+
+func (l *ListDatabasesTask) TraceCtx() context.Context {
+	return l.ctx
+}
+
+func (l *ListDatabasesTask) ID() UniqueID {
+	return l.Base.MsgID
+}
+
+func (l *ListDatabasesTask) SetID(uid UniqueID) {
+	l.Base.MsgID = uid
+}
+
+func (l *ListDatabasesTask) Name() string {
+	return ListDatabasesTaskName
+}
+
+func (l *ListDatabasesTask) Type() commonpb.MsgType {
+	return l.Base.MsgType
+}
+
+func (l *ListDatabasesTask) BeginTs() Timestamp {
+	return l.Base.Timestamp
+}
+
+func (l *ListDatabasesTask) EndTs() Timestamp {
+	return l.Base.Timestamp
+}
+
+func (l *ListDatabasesTask) SetTs(ts Timestamp) {
+	l.Base.Timestamp = ts
+}
+
+func (l *ListDatabasesTask) OnEnqueue() error {
+	l.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (l *ListDatabasesTask) PreExecute(ctx context.Context) error {
+	l.Base.MsgType = commonpb.MsgType_ListDatabases
+	l.Base.SourceID = Params.ProxyID
+	return nil
+}
+
+func (l *ListDatabasesTask) Execute(ctx context.Context) error {
+	var err error
+	l.result, err = l.rootCoord.ListDatabases(ctx, l.ListDatabasesRequest)
+	return err
+}
+
+func (l *ListDatabasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
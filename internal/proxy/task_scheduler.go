@@ -67,6 +67,9 @@ type baseTaskQueue struct {
 
 	tsoAllocatorIns tsoAllocator
 	idAllocatorIns  idAllocatorInterface
+
+	// rateLimiter rejects the tasks exceeding the rate limits, nil means unlimited
+	rateLimiter *rateLimiter
 }
 
 func (queue *baseTaskQueue) utChan() <-chan int {
@@ -166,6 +169,12 @@ func (queue *baseTaskQueue) getTaskByReqID(reqID UniqueID) task {
 }
 
 func (queue *baseTaskQueue) Enqueue(t task) error {
+	if queue.rateLimiter != nil {
+		if err := queue.rateLimiter.check(t); err != nil {
+			return err
+		}
+	}
+
 	err := t.OnEnqueue()
 	if err != nil {
 		return err
@@ -376,6 +385,8 @@ type taskScheduler struct {
 	cancel context.CancelFunc
 
 	msFactory msgstream.Factory
+
+	rateLimiter *rateLimiter
 }
 
 func newTaskScheduler(ctx context.Context,
@@ -392,6 +403,11 @@ func newTaskScheduler(ctx context.Context,
 	s.dmQueue = newDmTaskQueue(tsoAllocatorIns, idAllocatorIns)
 	s.dqQueue = newDqTaskQueue(tsoAllocatorIns, idAllocatorIns)
 
+	// only the dml and dql tasks are limited
+	s.rateLimiter = newRateLimiter()
+	s.dmQueue.rateLimiter = s.rateLimiter
+	s.dqQueue.rateLimiter = s.rateLimiter
+
 	return s, nil
 }
 
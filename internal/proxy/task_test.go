@@ -23,7 +23,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
+	"sort"
 	"strconv"
 	"sync"
 	"testing"
@@ -38,10 +40,12 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 	"github.com/stretchr/testify/assert"
 )
@@ -362,6 +366,36 @@ func TestGetNumRowsOfBinaryVectorField(t *testing.T) {
 	}
 }
 
+func TestGetNumRowsOfHalfFloatVectorField(t *testing.T) {
+	cases := []struct {
+		bDatas   []byte
+		dim      int64
+		want     uint32
+		errIsNil bool
+	}{
+		{[]byte{}, -1, 0, false},       // dim <= 0
+		{[]byte{}, 0, 0, false},        // dim <= 0
+		{[]byte{1, 2}, 2, 0, false},    // length % (2 * dim) != 0
+		{[]byte{1, 2, 3}, 1, 0, false}, // length % (2 * dim) != 0
+		{[]byte{}, 128, 0, true},
+		{[]byte{1, 2}, 1, 1, true},
+		{[]byte{1, 2, 3, 4}, 1, 2, true},
+		{[]byte{1, 2, 3, 4}, 2, 1, true},
+	}
+
+	for _, test := range cases {
+		got, err := getNumRowsOfHalfFloatVectorField(test.bDatas, test.dim)
+		if test.errIsNil {
+			assert.Equal(t, nil, err)
+			if got != test.want {
+				t.Errorf("getNumRowsOfHalfFloatVectorField(%v, %v) = %v, %v", test.bDatas, test.dim, test.want, nil)
+			}
+		} else {
+			assert.NotEqual(t, nil, err)
+		}
+	}
+}
+
 func TestInsertTask_checkLengthOfFieldsData(t *testing.T) {
 	var err error
 
@@ -654,6 +688,389 @@ func TestInsertTask_checkRowNums(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestInsertTask_transferVarChar(t *testing.T) {
+	numRows := 3
+	it := insertTask{
+		schema: &schemapb.CollectionSchema{
+			Name: "TestInsertTask_transferVarChar",
+			Fields: []*schemapb.FieldSchema{
+				{Name: "Int64", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{
+					Name:       "VarChar",
+					DataType:   schemapb.DataType_String,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "4"}},
+				},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: uint32(numRows),
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows),
+				newScalarFieldData(schemapb.DataType_String, "VarChar", numRows),
+			},
+		},
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{Base: &commonpb.MsgBase{}},
+		},
+	}
+	assert.NoError(t, it.checkRowNums())
+	assert.NoError(t, it.transferColumnBasedRequestToRowBasedData())
+	assert.Equal(t, numRows, len(it.RowData))
+	for i, row := range it.RowData {
+		// int64 and the VarChar, which takes the uint32 length and the bytes of the string
+		s, size, err := typeutil.DecodeVariableLengthValue(row.Value[8:])
+		assert.NoError(t, err)
+		assert.Equal(t, strconv.Itoa(i), string(s))
+		assert.Equal(t, 8+size, len(row.Value))
+	}
+
+	// exceeds the max length
+	it.req.FieldsData[1].GetScalars().GetStringData().Data[0] = "abcde"
+	assert.Error(t, it.transferColumnBasedRequestToRowBasedData())
+
+	// less VarChar data
+	it.req.FieldsData[1] = newScalarFieldData(schemapb.DataType_String, "VarChar", numRows-1)
+	assert.Error(t, it.checkRowNums())
+}
+
+func TestInsertTask_fillFieldsDataBySchema(t *testing.T) {
+	numRows := 3
+	schema := &schemapb.CollectionSchema{
+		Name: "TestInsertTask_fillFieldsDataBySchema",
+		Fields: []*schemapb.FieldSchema{
+			{Name: "Int64", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{Name: "Nullable", DataType: schemapb.DataType_Int32, Nullable: true},
+			{
+				Name:         "Default",
+				DataType:     schemapb.DataType_Double,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 0.5}},
+			},
+			{Name: "Required", DataType: schemapb.DataType_Bool},
+		},
+	}
+	newTask := func(fieldsData ...*schemapb.FieldData) *insertTask {
+		return &insertTask{
+			schema: schema,
+			req: &milvuspb.InsertRequest{
+				CollectionName: schema.Name,
+				NumRows:        uint32(numRows),
+				FieldsData:     fieldsData,
+			},
+			BaseInsertTask: BaseInsertTask{
+				InsertRequest: internalpb.InsertRequest{Base: &commonpb.MsgBase{}},
+			},
+		}
+	}
+
+	// the omitted fields are filled, and the fields are reordered by the schema
+	it := newTask(newScalarFieldData(schemapb.DataType_Bool, "Required", numRows), newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows))
+	assert.NoError(t, it.checkRowNums())
+	assert.NoError(t, it.fillFieldsDataBySchema())
+	assert.Equal(t, 4, len(it.req.FieldsData))
+	assert.Equal(t, "Nullable", it.req.FieldsData[1].FieldName)
+	assert.Equal(t, []bool{false, false, false}, it.req.FieldsData[1].ValidData)
+	assert.Equal(t, []float64{0.5, 0.5, 0.5}, it.req.FieldsData[2].GetScalars().GetDoubleData().Data)
+	assert.Nil(t, it.req.FieldsData[2].ValidData)
+	assert.Equal(t, "Required", it.req.FieldsData[3].FieldName)
+
+	// the values of the nullable fields are led by a validity byte in the rows
+	assert.NoError(t, it.transferColumnBasedRequestToRowBasedData())
+	for _, row := range it.RowData {
+		assert.Equal(t, 8+1+4+8+1, len(row.Value))
+		assert.Equal(t, byte(0), row.Value[8])
+	}
+
+	// the null values are replaced with the default value
+	defaults := newScalarFieldData(schemapb.DataType_Double, "Default", numRows)
+	defaults.ValidData = []bool{true, false, true}
+	nullable := newScalarFieldData(schemapb.DataType_Int32, "Nullable", numRows)
+	nullable.ValidData = []bool{false, true, true}
+	it = newTask(newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows), nullable, defaults, newScalarFieldData(schemapb.DataType_Bool, "Required", numRows))
+	assert.NoError(t, it.checkRowNums())
+	assert.NoError(t, it.fillFieldsDataBySchema())
+	assert.Equal(t, 0.5, it.req.FieldsData[2].GetScalars().GetDoubleData().Data[1])
+	assert.Nil(t, it.req.FieldsData[2].ValidData)
+	assert.Equal(t, []bool{false, true, true}, it.req.FieldsData[1].ValidData)
+	assert.NoError(t, it.transferColumnBasedRequestToRowBasedData())
+	assert.Equal(t, byte(0), it.RowData[0].Value[8])
+	assert.Equal(t, byte(1), it.RowData[1].Value[8])
+
+	// mismatched length of valid data
+	nullable.ValidData = []bool{true}
+	it = newTask(newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows), nullable, newScalarFieldData(schemapb.DataType_Bool, "Required", numRows))
+	assert.Error(t, it.checkRowNums())
+
+	// the required field is omitted
+	it = newTask(newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows))
+	assert.Error(t, it.checkLengthOfFieldsData())
+	assert.Error(t, it.fillFieldsDataBySchema())
+
+	// null values of the field which is not nullable
+	required := newScalarFieldData(schemapb.DataType_Bool, "Required", numRows)
+	required.ValidData = []bool{true, false, true}
+	it = newTask(newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows), required)
+	assert.Error(t, it.fillFieldsDataBySchema())
+
+	// unknown and duplicated fields
+	it = newTask(newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows), newScalarFieldData(schemapb.DataType_Bool, "Required", numRows),
+		newScalarFieldData(schemapb.DataType_Bool, "Unknown", numRows))
+	assert.Error(t, it.fillFieldsDataBySchema())
+	it = newTask(newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows), newScalarFieldData(schemapb.DataType_Bool, "Required", numRows),
+		newScalarFieldData(schemapb.DataType_Bool, "Required", numRows))
+	assert.Error(t, it.fillFieldsDataBySchema())
+}
+
+func TestInsertTask_transferArray(t *testing.T) {
+	numRows := 2
+	arrays := []*schemapb.ScalarField{
+		{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}}},
+		{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{3}}}},
+	}
+	it := insertTask{
+		schema: &schemapb.CollectionSchema{
+			Name: "TestInsertTask_transferArray",
+			Fields: []*schemapb.FieldSchema{
+				{Name: "Int64", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{
+					Name:        "Array",
+					DataType:    schemapb.DataType_Array,
+					ElementType: schemapb.DataType_Int64,
+					TypeParams:  []*commonpb.KeyValuePair{{Key: common.MaxCapacityKey, Value: "2"}},
+				},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: uint32(numRows),
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows),
+				{
+					Type:      schemapb.DataType_Array,
+					FieldName: "Array",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_ArrayData{
+								ArrayData: &schemapb.ArrayArray{Data: arrays, ElementType: schemapb.DataType_Int64},
+							},
+						},
+					},
+				},
+			},
+		},
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{Base: &commonpb.MsgBase{}},
+		},
+	}
+	assert.NoError(t, it.checkRowNums())
+	assert.NoError(t, it.transferColumnBasedRequestToRowBasedData())
+	assert.Equal(t, numRows, len(it.RowData))
+	for i, row := range it.RowData {
+		// int64 and the Array of its own length
+		value, size, err := typeutil.DecodeVariableLengthValue(row.Value[8:])
+		assert.NoError(t, err)
+		assert.Equal(t, 8+size, len(row.Value))
+		array, err := typeutil.DecodeArray(it.schema.Fields[1], value)
+		assert.NoError(t, err)
+		assert.Equal(t, arrays[i].GetLongData().Data, array.GetLongData().Data)
+	}
+
+	// exceeds the max capacity
+	arrays[0].GetLongData().Data = []int64{1, 2, 3}
+	assert.Error(t, it.transferColumnBasedRequestToRowBasedData())
+
+	// less Array data
+	it.req.FieldsData[1].GetScalars().GetArrayData().Data = arrays[:1]
+	assert.Error(t, it.checkRowNums())
+}
+
+func TestInsertTask_transferJSON(t *testing.T) {
+	numRows := 2
+	docs := [][]byte{[]byte(`{"brand": "acme", "price": 9.5}`), []byte("not a document")}
+	it := insertTask{
+		schema: &schemapb.CollectionSchema{
+			Name: "TestInsertTask_transferJSON",
+			Fields: []*schemapb.FieldSchema{
+				{Name: "Int64", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{Name: "JSON", DataType: schemapb.DataType_JSON, Nullable: true},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: uint32(numRows),
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows),
+				{
+					Type:      schemapb.DataType_JSON,
+					FieldName: "JSON",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: docs}},
+						},
+					},
+					ValidData: []bool{true, false},
+				},
+			},
+		},
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{Base: &commonpb.MsgBase{}},
+		},
+	}
+	assert.NoError(t, it.checkRowNums())
+	assert.NoError(t, it.transferColumnBasedRequestToRowBasedData())
+	assert.Equal(t, numRows, len(it.RowData))
+	// int64, the validity byte and the document of its own length, the null document takes no bytes
+	value, size, err := typeutil.DecodeVariableLengthValue(it.RowData[0].Value[9:])
+	assert.NoError(t, err)
+	assert.Equal(t, 9+size, len(it.RowData[0].Value))
+	assert.Equal(t, byte(1), it.RowData[0].Value[8])
+	assert.Equal(t, docs[0], value)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0}, it.RowData[1].Value[8:])
+
+	// invalid document
+	it.req.FieldsData[1].ValidData = nil
+	assert.Error(t, it.transferColumnBasedRequestToRowBasedData())
+
+	// less JSON data
+	it.req.FieldsData[1].GetScalars().GetJsonData().Data = docs[:1]
+	assert.Error(t, it.checkRowNums())
+}
+
+func TestInsertTask_transferSparseFloatVector(t *testing.T) {
+	pair := func(index uint32, value float32) []byte {
+		b := make([]byte, 8)
+		common.Endian.PutUint32(b, index)
+		common.Endian.PutUint32(b[4:], math.Float32bits(value))
+		return b
+	}
+	numRows := 2
+	rows := [][]byte{append(pair(1, 0.5), pair(9, 1)...), pair(3, 2)}
+	it := insertTask{
+		schema: &schemapb.CollectionSchema{
+			Name: "TestInsertTask_transferSparseFloatVector",
+			Fields: []*schemapb.FieldSchema{
+				{Name: "Int64", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{
+					Name:       "SparseFloatVector",
+					DataType:   schemapb.DataType_SparseFloatVector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxNnzKey, Value: "2"}},
+				},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: uint32(numRows),
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows),
+				{
+					Type:      schemapb.DataType_SparseFloatVector,
+					FieldName: "SparseFloatVector",
+					Field: &schemapb.FieldData_Vectors{
+						Vectors: &schemapb.VectorField{
+							Dim: 10,
+							Data: &schemapb.VectorField_SparseFloatVector{
+								SparseFloatVector: &schemapb.SparseFloatArray{Contents: rows, Dim: 10},
+							},
+						},
+					},
+				},
+			},
+		},
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{Base: &commonpb.MsgBase{}},
+		},
+	}
+	assert.NoError(t, it.checkRowNums())
+	assert.NoError(t, it.transferColumnBasedRequestToRowBasedData())
+	assert.Equal(t, numRows, len(it.RowData))
+	for i, row := range it.RowData {
+		// int64 and the length and pairs of the sparse row
+		assert.Equal(t, 8+4+len(rows[i]), len(row.Value))
+		r, size, err := typeutil.DecodeVariableLengthValue(row.Value[8:])
+		assert.NoError(t, err)
+		assert.Equal(t, len(row.Value)-8, size)
+		assert.Equal(t, rows[i], r)
+	}
+
+	// exceeds the max nnz
+	rows[1] = append(pair(1, 1), append(pair(2, 1), pair(3, 1)...)...)
+	assert.Error(t, it.transferColumnBasedRequestToRowBasedData())
+
+	// unsorted indices
+	rows[1] = append(pair(3, 1), pair(2, 1)...)
+	assert.Error(t, it.transferColumnBasedRequestToRowBasedData())
+
+	// less sparse rows
+	it.req.FieldsData[1].GetVectors().GetSparseFloatVector().Contents = rows[:1]
+	assert.Error(t, it.checkRowNums())
+}
+
+func TestInsertTask_transferHalfFloatVector(t *testing.T) {
+	numRows := 2
+	dim := 2
+	float16Data := make([]byte, 0, numRows*dim*2)
+	bfloat16Data := make([]byte, 0, numRows*dim*2)
+	for i := 0; i < numRows*dim; i++ {
+		float16Data = append(float16Data, typeutil.Float32ToFloat16(float32(i))...)
+		bfloat16Data = append(bfloat16Data, typeutil.Float32ToBFloat16(float32(-i))...)
+	}
+	dimParams := []*commonpb.KeyValuePair{{Key: "dim", Value: strconv.Itoa(dim)}}
+	it := insertTask{
+		schema: &schemapb.CollectionSchema{
+			Name: "TestInsertTask_transferHalfFloatVector",
+			Fields: []*schemapb.FieldSchema{
+				{Name: "Int64", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{Name: "Float16Vector", DataType: schemapb.DataType_Float16Vector, TypeParams: dimParams},
+				{Name: "BFloat16Vector", DataType: schemapb.DataType_BFloat16Vector, TypeParams: dimParams},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: uint32(numRows),
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows),
+				{
+					Type:      schemapb.DataType_Float16Vector,
+					FieldName: "Float16Vector",
+					Field: &schemapb.FieldData_Vectors{
+						Vectors: &schemapb.VectorField{
+							Dim:  int64(dim),
+							Data: &schemapb.VectorField_Float16Vector{Float16Vector: float16Data},
+						},
+					},
+				},
+				{
+					Type:      schemapb.DataType_BFloat16Vector,
+					FieldName: "BFloat16Vector",
+					Field: &schemapb.FieldData_Vectors{
+						Vectors: &schemapb.VectorField{
+							Dim:  int64(dim),
+							Data: &schemapb.VectorField_Bfloat16Vector{Bfloat16Vector: bfloat16Data},
+						},
+					},
+				},
+			},
+		},
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{Base: &commonpb.MsgBase{}},
+		},
+	}
+	assert.NoError(t, it.checkRowNums())
+	assert.NoError(t, it.transferColumnBasedRequestToRowBasedData())
+	assert.Equal(t, numRows, len(it.RowData))
+	rowBytes := dim * 2
+	for i, row := range it.RowData {
+		// int64 and 2 bytes per element of each vector
+		assert.Equal(t, 8+2*rowBytes, len(row.Value))
+		assert.Equal(t, float16Data[i*rowBytes:(i+1)*rowBytes], row.Value[8:8+rowBytes])
+		assert.Equal(t, bfloat16Data[i*rowBytes:(i+1)*rowBytes], row.Value[8+rowBytes:])
+	}
+
+	// the length of the vectors mismatches with the dim
+	it.req.FieldsData[1].GetVectors().Data = &schemapb.VectorField_Float16Vector{Float16Vector: float16Data[:3]}
+	assert.Error(t, it.checkRowNums())
+	assert.Error(t, it.transferColumnBasedRequestToRowBasedData())
+
+	// less rows
+	it.req.FieldsData[1].GetVectors().Data = &schemapb.VectorField_Float16Vector{Float16Vector: float16Data[:rowBytes]}
+	assert.Error(t, it.checkRowNums())
+}
+
 func TestTranslateOutputFields(t *testing.T) {
 	const (
 		idFieldName           = "id"
@@ -977,6 +1394,11 @@ func TestCreateCollectionTask(t *testing.T) {
 		assert.Error(t, err)
 		task.ShardsNum = shardsNum
 
+		task.ConsistencyLevel = commonpb.ConsistencyLevel(100)
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+		task.ConsistencyLevel = commonpb.ConsistencyLevel_Bounded
+
 		reqBackup := proto.Clone(task.CreateCollectionRequest).(*milvuspb.CreateCollectionRequest)
 		schemaBackup := proto.Clone(schema).(*schemapb.CollectionSchema)
 
@@ -1185,7 +1607,7 @@ func TestDropCollectionTask(t *testing.T) {
 	assert.NotNil(t, err)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1263,7 +1685,7 @@ func TestHasCollectionTask(t *testing.T) {
 	assert.Equal(t, false, task.result.Value)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1376,7 +1798,7 @@ func TestDescribeCollectionTask_ShardsNum1(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1435,7 +1857,7 @@ func TestDescribeCollectionTask_ShardsNum2(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1730,7 +2152,7 @@ func TestSearchTask_all(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2076,7 +2498,7 @@ func TestSearchTaskWithInvalidRoundDecimal(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2416,7 +2838,7 @@ func TestSearchTask_7803_reduce(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2780,7 +3202,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	assert.NoError(t, createColT.Execute(ctx))
 	assert.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, _ := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, _ := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// validateCollectionName
 	task.query.CollectionName = "$"
@@ -2923,6 +3345,77 @@ func TestSearchTask_PreExecute(t *testing.T) {
 
 	// invalid round_decimal
 	assert.Error(t, task.PreExecute(ctx))
+	task.query.SearchParams = []*commonpb.KeyValuePair{
+		{
+			Key:   AnnsFieldKey,
+			Value: floatVecField,
+		},
+		{
+			Key:   TopKKey,
+			Value: "10",
+		},
+		{
+			Key:   OffsetKey,
+			Value: "-1",
+		},
+		{
+			Key:   MetricTypeKey,
+			Value: distance.L2,
+		},
+		{
+			Key:   SearchParamsKey,
+			Value: `{"nprobe": 10}`,
+		},
+	}
+
+	// invalid offset
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.SearchParams = []*commonpb.KeyValuePair{
+		{
+			Key:   AnnsFieldKey,
+			Value: floatVecField,
+		},
+		{
+			Key:   TopKKey,
+			Value: "10",
+		},
+		{
+			Key:   OffsetKey,
+			Value: strconv.FormatInt(Params.MaxTopK, 10),
+		},
+		{
+			Key:   MetricTypeKey,
+			Value: distance.L2,
+		},
+		{
+			Key:   SearchParamsKey,
+			Value: `{"nprobe": 10}`,
+		},
+	}
+
+	// topk plus offset is too large
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.SearchParams = []*commonpb.KeyValuePair{
+		{
+			Key:   AnnsFieldKey,
+			Value: floatVecField,
+		},
+		{
+			Key:   TopKKey,
+			Value: "-1",
+		},
+		{
+			Key:   MetricTypeKey,
+			Value: distance.L2,
+		},
+		{
+			Key:   SearchParamsKey,
+			Value: `{"nprobe": 10}`,
+		},
+	}
+
+	// non-positive topk
+	assert.Error(t, task.PreExecute(ctx))
 	task.query.SearchParams = []*commonpb.KeyValuePair{
 		{
 			Key:   AnnsFieldKey,
@@ -3109,7 +3602,7 @@ func TestSearchTask_Reduce(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(dataArray, nq, topk, metricType)
+		res, err := reduceSearchResultData(dataArray, nq, topk, 0, metricType, nil)
 		assert.Nil(t, err)
 		assert.Equal(t, ids, res.Results.Ids.GetIntId().Data)
 		assert.Equal(t, []float32{1.0, 2.0, 3.0, 4.0}, res.Results.Scores)
@@ -3124,10 +3617,132 @@ func TestSearchTask_Reduce(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(dataArray, nq, topk, metricType)
+		res, err := reduceSearchResultData(dataArray, nq, topk, 0, metricType, nil)
 		assert.Nil(t, err)
 		assert.ElementsMatch(t, []int64{1, 5, 2, 3}, res.Results.Ids.GetIntId().Data)
 	})
+	t.Run("offset", func(t *testing.T) {
+		ids1 := []int64{1, 2, 3, 4}
+		scores1 := []float32{-1.0, -2.0, -3.0, -4.0}
+		ids2 := []int64{1, 5, 6, 7}
+		scores2 := []float32{-1.0, -1.5, -2.5, -5.0}
+		data1 := genSearchResultData(nq, topk, ids1, scores1)
+		data2 := genSearchResultData(nq, topk, ids2, scores2)
+		dataArray := []*schemapb.SearchResultData{data1, data2}
+		res, err := reduceSearchResultData(dataArray, nq, topk, 2, metricType, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{2, 6}, res.Results.Ids.GetIntId().Data)
+		assert.Equal(t, []float32{2.0, 2.5}, res.Results.Scores)
+		assert.Equal(t, int64(2), res.Results.TopK)
+		assert.Equal(t, []int64{2}, res.Results.Topks)
+
+		// offset beyond all the hits
+		res, err = reduceSearchResultData([]*schemapb.SearchResultData{data1}, nq, topk, 5, metricType, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(res.Results.Ids.GetIntId().Data))
+		assert.Equal(t, []int64{0}, res.Results.Topks)
+	})
+	t.Run("variable topks", func(t *testing.T) {
+		// two queries, results of range search only contain the hits within the range
+		data1 := genSearchResultData(2, topk, []int64{1, 2, 3}, []float32{-1.0, -2.0, -3.0})
+		data1.Topks = []int64{1, 2}
+		data2 := genSearchResultData(2, topk, []int64{4}, []float32{-0.5})
+		data2.Topks = []int64{1, 0}
+		res, err := reduceSearchResultData([]*schemapb.SearchResultData{data1, data2}, 2, topk, 0, metricType, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{4, 1, 2, 3}, res.Results.Ids.GetIntId().Data)
+		assert.Equal(t, []float32{0.5, 1.0, 2.0, 3.0}, res.Results.Scores)
+		assert.Equal(t, []int64{2, 2}, res.Results.Topks)
+		assert.Equal(t, int64(2), res.Results.TopK)
+
+		// mismatched topks
+		data2.Topks = []int64{2, 0}
+		_, err = reduceSearchResultData([]*schemapb.SearchResultData{data1, data2}, 2, topk, 0, metricType, nil)
+		assert.NotNil(t, err)
+	})
+	t.Run("group by", func(t *testing.T) {
+		groupField := func(values ...int64) []*schemapb.FieldData {
+			return []*schemapb.FieldData{{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+					},
+				},
+			}}
+		}
+		data1 := genSearchResultData(nq, topk, []int64{1, 2, 3, 4}, []float32{-1.0, -2.0, -3.0, -4.0})
+		data1.FieldsData = groupField(10, 10, 20, 30)
+		data2 := genSearchResultData(nq, topk, []int64{5, 6, 7, 8}, []float32{-1.5, -2.5, -3.5, -4.5})
+		data2.FieldsData = groupField(10, 20, 40, 50)
+		dataArray := []*schemapb.SearchResultData{data1, data2}
+
+		res, err := reduceSearchResultData(dataArray, nq, topk, 0, metricType, &searchGroupBy{fieldIdx: 0, topk: 3})
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 6, 7}, res.Results.Ids.GetIntId().Data)
+		assert.Equal(t, []int64{10, 20, 40}, res.Results.FieldsData[0].GetScalars().GetLongData().Data)
+		assert.Equal(t, []int64{3}, res.Results.Topks)
+
+		res, err = reduceSearchResultData(dataArray, nq, topk, 1, metricType, &searchGroupBy{fieldIdx: 0, topk: 3})
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{6, 7}, res.Results.Ids.GetIntId().Data)
+	})
+}
+
+func TestSearchTask_parseGroupBy(t *testing.T) {
+	Params.Init()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "doc", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Float},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	st := &searchTask{query: &milvuspb.SearchRequest{OutputFields: []string{"pk"}}}
+
+	queryInfo := &planpb.QueryInfo{Topk: 10}
+	assert.NoError(t, st.parseGroupBy(schema, "doc", queryInfo))
+	assert.Equal(t, int64(101), queryInfo.GroupByFieldId)
+	assert.Equal(t, int64(10), queryInfo.GroupTopk)
+	assert.Equal(t, 10*Params.GroupBySearchFactor, queryInfo.Topk)
+	assert.Equal(t, []string{"pk", "doc"}, st.query.OutputFields)
+	assert.Equal(t, &searchGroupBy{fieldIdx: 1, topk: 10}, st.groupBy)
+
+	queryInfo = &planpb.QueryInfo{Topk: Params.MaxTopK}
+	assert.NoError(t, st.parseGroupBy(schema, "doc", queryInfo))
+	assert.Equal(t, Params.MaxTopK, queryInfo.Topk)
+	assert.Equal(t, []string{"pk", "doc"}, st.query.OutputFields)
+
+	assert.Error(t, st.parseGroupBy(schema, "score", &planpb.QueryInfo{Topk: 10}))
+	assert.Error(t, st.parseGroupBy(schema, "vec", &planpb.QueryInfo{Topk: 10}))
+	assert.Error(t, st.parseGroupBy(schema, "not_exist", &planpb.QueryInfo{Topk: 10}))
+}
+
+func TestParseRangeSearchParams(t *testing.T) {
+	params := func(rangeFilter string) []*commonpb.KeyValuePair {
+		if rangeFilter == "" {
+			return nil
+		}
+		return []*commonpb.KeyValuePair{{Key: RangeFilterKey, Value: rangeFilter}}
+	}
+
+	queryInfo := &planpb.QueryInfo{MetricType: distance.L2}
+	assert.NoError(t, parseRangeSearchParams(queryInfo, "2.5", params("")))
+	assert.True(t, queryInfo.RangeSearch)
+	assert.Equal(t, 2.5, queryInfo.Radius)
+	assert.Equal(t, 0.0, queryInfo.RangeFilter)
+	assert.NoError(t, parseRangeSearchParams(queryInfo, "2.5", params("1")))
+	assert.Equal(t, 1.0, queryInfo.RangeFilter)
+	assert.Error(t, parseRangeSearchParams(queryInfo, "2.5", params("3")))
+	assert.Error(t, parseRangeSearchParams(queryInfo, "invalid", params("")))
+	assert.Error(t, parseRangeSearchParams(queryInfo, "2.5", params("invalid")))
+
+	queryInfo = &planpb.QueryInfo{MetricType: distance.IP}
+	assert.NoError(t, parseRangeSearchParams(queryInfo, "0.5", params("")))
+	assert.Equal(t, float64(math.MaxFloat32), queryInfo.RangeFilter)
+	assert.NoError(t, parseRangeSearchParams(queryInfo, "0.5", params("0.9")))
+	assert.Equal(t, 0.9, queryInfo.RangeFilter)
+	assert.Error(t, parseRangeSearchParams(queryInfo, "0.5", params("0.1")))
 }
 
 func TestQueryTask_all(t *testing.T) {
@@ -3194,7 +3809,7 @@ func TestQueryTask_all(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -3282,7 +3897,7 @@ func TestQueryTask_all(t *testing.T) {
 				return
 			case pack := <-stream.Chan():
 				for _, msg := range pack.Msgs {
-					_, ok := msg.(*msgstream.RetrieveMsg)
+					retrieveMsg, ok := msg.(*msgstream.RetrieveMsg)
 					assert.True(t, ok)
 					// TODO(dragondriver): construct result according to the request
 
@@ -3416,6 +4031,15 @@ func TestQueryTask_all(t *testing.T) {
 						FieldId: common.StartOfUserFieldID + 6,
 					}
 
+					if len(retrieveMsg.Aggregates) > 0 {
+						collSchema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+						assert.NoError(t, err)
+						aggregator, err := typeutil.NewAggregator(collSchema, retrieveMsg.Aggregates, retrieveMsg.GroupByFieldID)
+						assert.NoError(t, err)
+						assert.NoError(t, aggregator.AddRows(result1.FieldsData))
+						result1.AggregatePartials = aggregator.Partials()
+					}
+
 					// send search result
 					task.resultBuf <- []*internalpb.RetrieveResults{result1}
 				}
@@ -3428,6 +4052,67 @@ func TestQueryTask_all(t *testing.T) {
 	assert.NoError(t, task.Execute(ctx))
 	assert.NoError(t, task.PostExecute(ctx))
 
+	// invalid limit, offset and order by
+	task.query.Limit = -1
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.Limit = 0
+	task.query.Offset = 2
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.Limit = 3
+	task.query.OrderBy = floatVecField
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.OrderBy = "not_exist"
+	assert.Error(t, task.PreExecute(ctx))
+
+	// the third page of size 3 ordered by the double field
+	task.query.OrderBy = doubleField
+	task.query.Descending = true
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, int64(5), task.RetrieveRequest.Limit)
+	assert.Equal(t, int64(common.StartOfUserFieldID+4), task.RetrieveRequest.OrderByFieldID)
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+	doubles := task.result.FieldsData[4].GetScalars().GetDoubleData().GetData()
+	assert.Equal(t, 3, len(doubles))
+	assert.True(t, sort.SliceIsSorted(doubles, func(i, j int) bool { return doubles[i] > doubles[j] }))
+
+	// aggregations with limit, over non-numeric fields or along with other fields are invalid
+	task.query.OutputFields = []string{"count(*)", "max(" + doubleField + ")"}
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.Limit, task.query.Offset, task.query.OrderBy = 0, 0, ""
+	task.query.OutputFields = []string{"sum(" + boolField + ")"}
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.OutputFields = []string{"count(" + int64Field + ")"}
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.OutputFields = []string{"avg(not_exist)"}
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.OutputFields = []string{"count(*)", int32Field}
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.OutputFields = []string{int32Field}
+	task.query.GroupBy = boolField
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.OutputFields = []string{"count(*)", boolField}
+	task.query.GroupBy = floatField
+	assert.Error(t, task.PreExecute(ctx))
+
+	// count and max grouped by the bool field
+	task.query.OutputFields = []string{"COUNT( * )", "max(" + doubleField + ")", boolField}
+	task.query.GroupBy = boolField
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, 2, len(task.RetrieveRequest.Aggregates))
+	assert.Equal(t, int64(common.StartOfUserFieldID+0), task.RetrieveRequest.GroupByFieldID)
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+	assert.Equal(t, 3, len(task.result.FieldsData))
+	assert.Equal(t, boolField, task.result.FieldsData[0].FieldName)
+	assert.Equal(t, "count(*)", task.result.FieldsData[1].FieldName)
+	assert.Equal(t, "max("+doubleField+")", task.result.FieldsData[2].FieldName)
+	var count int64
+	for _, c := range task.result.FieldsData[1].GetScalars().GetLongData().GetData() {
+		count += c
+	}
+	assert.Equal(t, int64(hitNum), count)
+
 	cancel()
 	wg.Wait()
 }
@@ -3503,7 +4188,7 @@ func TestTask_all(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -3668,7 +4353,7 @@ func TestTask_all(t *testing.T) {
 
 		task.req.FieldsData[5] = &schemapb.FieldData{
 			Type:      schemapb.DataType_FloatVector,
-			FieldName: doubleField,
+			FieldName: floatVecField,
 			Field: &schemapb.FieldData_Vectors{
 				Vectors: &schemapb.VectorField{
 					Dim: int64(dim),
@@ -3684,7 +4369,7 @@ func TestTask_all(t *testing.T) {
 
 		task.req.FieldsData[6] = &schemapb.FieldData{
 			Type:      schemapb.DataType_BinaryVector,
-			FieldName: doubleField,
+			FieldName: binaryVecField,
 			Field: &schemapb.FieldData_Vectors{
 				Vectors: &schemapb.VectorField{
 					Dim: int64(dim),
@@ -3768,6 +4453,433 @@ func TestTask_all(t *testing.T) {
 		assert.NoError(t, task.Execute(ctx))
 		assert.NoError(t, task.PostExecute(ctx))
 	})
+
+	t.Run("delete by expression", func(t *testing.T) {
+		matched := []int64{2, 3, 5, 7, 11}
+		task := &deleteTask{
+			Condition: NewTaskCondition(ctx),
+			BaseDeleteTask: msgstream.DeleteMsg{
+				DeleteRequest: internalpb.DeleteRequest{
+					CollectionName: collectionName,
+				},
+			},
+			req: &milvuspb.DeleteRequest{
+				DbName:         dbName,
+				CollectionName: collectionName,
+				Expr:           int32Field + " > 1",
+			},
+			ctx:      ctx,
+			chMgr:    chMgr,
+			chTicker: ticker,
+		}
+		assert.NoError(t, task.OnEnqueue())
+		task.SetID(UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt()))
+		ts := Timestamp(time.Now().UnixNano())
+		task.SetTs(ts)
+
+		// the delete holds the time tick of the DML channels of the collection
+		stats, err := task.getPChanStats()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, stats)
+		for _, stat := range stats {
+			assert.Equal(t, ts, stat.minTs)
+		}
+
+		// the expression other than "pk in [a, b]" requires the query
+		assert.Error(t, task.PreExecute(ctx))
+
+		// the matched entities are retrieved in batches at the timestamp right before the delete
+		batchSize := Params.DeleteByExprBatchSize
+		Params.DeleteByExprBatchSize = 2
+		defer func() {
+			Params.DeleteByExprBatchSize = batchSize
+		}()
+		batches := 0
+		task.queryFunc = func(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			start := batches * 2
+			end := start + 2
+			if end > len(matched) {
+				end = len(matched)
+			}
+			if batches == 0 {
+				assert.Equal(t, int32Field+" > 1", request.Expr)
+			} else {
+				assert.Equal(t, fmt.Sprintf("(%s > 1) && %s > %d", int32Field, int64Field, matched[start-1]), request.Expr)
+			}
+			assert.Equal(t, []string{int64Field}, request.OutputFields)
+			assert.Equal(t, ts-1, request.TravelTimestamp)
+			assert.Equal(t, ts-1, request.GuaranteeTimestamp)
+			assert.Equal(t, int64(2), request.Limit)
+			batches++
+			return &milvuspb.QueryResults{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				FieldsData: []*schemapb.FieldData{
+					{
+						Type:      schemapb.DataType_Int64,
+						FieldName: int64Field,
+						FieldId:   task.pkField.GetFieldID(),
+						Field: &schemapb.FieldData_Scalars{
+							Scalars: &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_LongData{
+									LongData: &schemapb.LongArray{Data: matched[start:end]},
+								},
+							},
+						},
+					},
+				},
+			}, nil
+		}
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Equal(t, int64Field, task.pkField.GetName())
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, 3, batches)
+		assert.Equal(t, matched, task.result.IDs.GetIntId().GetData())
+		assert.Equal(t, int64(len(matched)), task.result.DeleteCnt)
+
+		// the DeleteMsgs are split into batches
+		maxDeleteBatchSize := Params.MaxDeleteBatchSize
+		Params.MaxDeleteBatchSize = 2
+		defer func() {
+			Params.MaxDeleteBatchSize = maxDeleteBatchSize
+		}()
+		task.setPrimaryKeys(&schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: matched}}})
+		stream, err := chMgr.getDMLStream(collectionID)
+		assert.NoError(t, err)
+		pack := task.repackDeleteMsg(ctx, stream)
+		deleted := 0
+		for _, msg := range pack.Msgs {
+			deleteMsg := msg.(*msgstream.DeleteMsg)
+			assert.LessOrEqual(t, len(deleteMsg.PrimaryKeys), 2)
+			deleted += len(deleteMsg.PrimaryKeys)
+		}
+		assert.Equal(t, len(matched), deleted)
+
+		// no entity matches the expression
+		task.queryFunc = func(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			return &milvuspb.QueryResults{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_EmptyCollection},
+			}, nil
+		}
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, int64(0), task.result.DeleteCnt)
+
+		task.queryFunc = func(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			return &milvuspb.QueryResults{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"},
+			}, nil
+		}
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Error(t, task.Execute(ctx))
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		hash := generateHashKeys(nb)
+		req := &milvuspb.UpsertRequest{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			PartitionName:  partitionName,
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Bool, boolField, nb),
+				newScalarFieldData(schemapb.DataType_Int32, int32Field, nb),
+				newScalarFieldData(schemapb.DataType_Int64, int64Field, nb),
+				newScalarFieldData(schemapb.DataType_Float, floatField, nb),
+				newScalarFieldData(schemapb.DataType_Double, doubleField, nb),
+				newFloatVectorFieldData(floatVecField, nb, dim),
+				newBinaryVectorFieldData(binaryVecField, nb, dim),
+			},
+			HashKeys: hash,
+			NumRows:  uint32(nb),
+		}
+		task := &upsertTask{
+			Condition: NewTaskCondition(ctx),
+			ctx:       ctx,
+			req:       req,
+			insertTask: &insertTask{
+				BaseInsertTask: BaseInsertTask{
+					BaseMsg: msgstream.BaseMsg{
+						HashValues: hash,
+					},
+					InsertRequest: internalpb.InsertRequest{
+						DbName:         dbName,
+						CollectionName: collectionName,
+						PartitionName:  partitionName,
+					},
+				},
+				req: &milvuspb.InsertRequest{
+					DbName:         dbName,
+					CollectionName: collectionName,
+					PartitionName:  partitionName,
+					FieldsData:     req.FieldsData,
+					HashKeys:       hash,
+					NumRows:        uint32(nb),
+				},
+				Condition:      NewTaskCondition(ctx),
+				ctx:            ctx,
+				rowIDAllocator: idAllocator,
+				segIDAssigner:  segAllocator,
+				chMgr:          chMgr,
+				chTicker:       ticker,
+			},
+			deleteTask: &deleteTask{
+				Condition: NewTaskCondition(ctx),
+				BaseDeleteTask: msgstream.DeleteMsg{
+					DeleteRequest: internalpb.DeleteRequest{
+						DbName:         dbName,
+						CollectionName: collectionName,
+						PartitionName:  partitionName,
+					},
+				},
+				ctx:      ctx,
+				chMgr:    chMgr,
+				chTicker: ticker,
+			},
+			chMgr: chMgr,
+		}
+
+		assert.NoError(t, task.OnEnqueue())
+		assert.NotNil(t, task.TraceCtx())
+		assert.Equal(t, UpsertTaskName, task.Name())
+		assert.Equal(t, commonpb.MsgType_Upsert, task.Type())
+
+		id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+		task.SetID(id)
+		assert.Equal(t, id, task.ID())
+		assert.Equal(t, id, task.deleteTask.ID())
+
+		ts := Timestamp(1000) // earlier than the expire time of the mocked segment allocation
+		task.SetTs(ts)
+		assert.Equal(t, ts, task.BeginTs())
+		assert.Equal(t, ts, task.EndTs())
+		assert.Equal(t, ts, task.deleteTask.BeginTs())
+
+		assert.NoError(t, task.PreExecute(ctx))
+		primaryKeys := req.FieldsData[2].GetScalars().GetLongData().GetData()
+		assert.Equal(t, primaryKeys, task.result.IDs.GetIntId().GetData())
+		assert.Equal(t, int64(nb), task.result.UpsertCnt)
+		assert.Equal(t, primaryKeys, task.deleteTask.PrimaryKeys)
+		// the old and the new entities are hashed to the same channels under the same timestamp
+		assert.Equal(t, task.deleteTask.HashValues, task.insertTask.HashValues)
+		assert.Equal(t, task.deleteTask.Timestamps, task.insertTask.Timestamps)
+
+		assert.NoError(t, task.Execute(ctx))
+		assert.NoError(t, task.PostExecute(ctx))
+
+		// the rows of the same primary key in one request
+		primaryKeys[1] = primaryKeys[0]
+		err := task.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate primary key")
+	})
+
+	t.Run("match index description", func(t *testing.T) {
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+		assert.NoError(t, err)
+		fieldIDs := make(map[string]int64)
+		for _, field := range schema.Fields {
+			fieldIDs[field.Name] = field.FieldID
+		}
+		descriptions := []*milvuspb.IndexDescription{
+			{IndexName: Params.DefaultIndexName, IndexID: 1, FieldName: floatVecField},
+			{IndexName: Params.DefaultIndexName, IndexID: 2, FieldName: binaryVecField},
+		}
+
+		indexID, fieldID, err := matchIndexDescription(ctx, dbName, collectionName, binaryVecField, Params.DefaultIndexName, descriptions)
+		assert.NoError(t, err)
+		assert.Equal(t, UniqueID(2), indexID)
+		assert.Equal(t, fieldIDs[binaryVecField], fieldID)
+
+		// any field matches without the field name
+		indexID, fieldID, err = matchIndexDescription(ctx, dbName, collectionName, "", Params.DefaultIndexName, descriptions)
+		assert.NoError(t, err)
+		assert.Equal(t, UniqueID(1), indexID)
+		assert.Equal(t, fieldIDs[floatVecField], fieldID)
+
+		_, _, err = matchIndexDescription(ctx, dbName, collectionName, floatVecField, "other", descriptions)
+		assert.Error(t, err)
+		_, _, err = matchIndexDescription(ctx, dbName, collectionName, "", Params.DefaultIndexName,
+			[]*milvuspb.IndexDescription{{IndexName: Params.DefaultIndexName, FieldName: "missing"}})
+		assert.Error(t, err)
+	})
+
+	t.Run("hybrid search", func(t *testing.T) {
+		subResults := map[string]*schemapb.SearchResultData{
+			floatVecField: {
+				NumQueries: 1,
+				TopK:       3,
+				Topks:      []int64{3},
+				Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+				Scores:     []float32{0.1, 0.2, 0.3},
+			},
+			binaryVecField: {
+				NumQueries: 1,
+				TopK:       2,
+				Topks:      []int64{2},
+				Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{3, 4}}}},
+				Scores:     []float32{1, 2},
+			},
+		}
+		subRequest := func(field string, metricType string) *milvuspb.SearchRequest {
+			return &milvuspb.SearchRequest{
+				Dsl:          int32Field + " > 0",
+				OutputFields: []string{doubleField},
+				SearchParams: []*commonpb.KeyValuePair{
+					{Key: AnnsFieldKey, Value: field},
+					{Key: TopKKey, Value: "10"},
+					{Key: MetricTypeKey, Value: metricType},
+				},
+			}
+		}
+		task := &hybridSearchTask{
+			Condition: NewTaskCondition(ctx),
+			ctx:       ctx,
+			req: &milvuspb.HybridSearchRequest{
+				DbName:         dbName,
+				CollectionName: collectionName,
+				PartitionNames: []string{partitionName},
+				Requests:       []*milvuspb.SearchRequest{subRequest(floatVecField, "L2"), subRequest(binaryVecField, "HAMMING")},
+				RankParams:     []*commonpb.KeyValuePair{{Key: TopKKey, Value: "2"}},
+				OutputFields:   []string{doubleField},
+			},
+		}
+		assert.NoError(t, task.OnEnqueue())
+		assert.Equal(t, HybridSearchTaskName, task.Name())
+		assert.Equal(t, commonpb.MsgType_Search, task.Type())
+		id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+		task.SetID(id)
+		assert.Equal(t, id, task.ID())
+		ts := Timestamp(time.Now().UnixNano())
+		task.SetTs(ts)
+		assert.Equal(t, ts, task.BeginTs())
+		assert.Equal(t, ts, task.EndTs())
+
+		assert.NoError(t, task.PreExecute(ctx))
+		for _, sub := range task.req.Requests {
+			assert.Equal(t, collectionName, sub.CollectionName)
+			assert.Equal(t, []string{partitionName}, sub.PartitionNames)
+			assert.Equal(t, ts, sub.TravelTimestamp)
+			assert.Empty(t, sub.OutputFields)
+		}
+
+		// no search function
+		assert.Error(t, task.Execute(ctx))
+
+		task.searchFunc = func(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+			field, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, request.SearchParams)
+			assert.NoError(t, err)
+			return &milvuspb.SearchResults{
+				Status:  &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				Results: subResults[field],
+			}, nil
+		}
+		task.queryFunc = func(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			assert.Equal(t, IDs2Expr(int64Field, &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{3, 1}}}}), request.Expr)
+			assert.Equal(t, []string{doubleField}, request.OutputFields)
+			assert.Equal(t, ts, request.TravelTimestamp)
+			return &milvuspb.QueryResults{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				FieldsData: []*schemapb.FieldData{
+					{
+						Type:      schemapb.DataType_Int64,
+						FieldName: int64Field,
+						Field: &schemapb.FieldData_Scalars{
+							Scalars: &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 3}}},
+							},
+						},
+					},
+					{
+						Type:      schemapb.DataType_Double,
+						FieldName: doubleField,
+						Field: &schemapb.FieldData_Scalars{
+							Scalars: &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: []float64{1.5, 3.5}}},
+							},
+						},
+					},
+				},
+			}, nil
+		}
+		assert.NoError(t, task.Execute(ctx))
+		assert.NoError(t, task.PostExecute(ctx))
+		// rrf scores: 3 is ranked by both the searches, 1 is ranked first by one of them
+		assert.Equal(t, []int64{3, 1}, task.result.Results.Ids.GetIntId().GetData())
+		assert.Equal(t, []int64{2}, task.result.Results.Topks)
+		assert.Equal(t, 1, len(task.result.Results.FieldsData))
+		assert.Equal(t, schemapb.DataType_Double, task.result.Results.FieldsData[0].Type)
+		assert.Equal(t, doubleField, task.result.Results.FieldsData[0].FieldName)
+		assert.Equal(t, []float64{3.5, 1.5}, task.result.Results.FieldsData[0].GetScalars().GetDoubleData().GetData())
+
+		// a failed sub-search fails the hybrid search
+		task.searchFunc = func(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+			return &milvuspb.SearchResults{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"},
+			}, nil
+		}
+		assert.Error(t, task.Execute(ctx))
+
+		// invalid rank params
+		task.req.RankParams = []*commonpb.KeyValuePair{{Key: TopKKey, Value: "2"}, {Key: RankStrategyKey, Value: WeightedStrategy}}
+		assert.Error(t, task.PreExecute(ctx))
+		task.req.RankParams = []*commonpb.KeyValuePair{{Key: OffsetKey, Value: "1"}}
+		assert.Error(t, task.PreExecute(ctx))
+		task.req.RankParams = []*commonpb.KeyValuePair{{Key: TopKKey, Value: "2"}, {Key: OffsetKey, Value: "-1"}}
+		assert.Error(t, task.PreExecute(ctx))
+		task.req.RankParams = []*commonpb.KeyValuePair{{Key: TopKKey, Value: strconv.FormatInt(Params.MaxTopK, 10)}, {Key: OffsetKey, Value: "1"}}
+		assert.Error(t, task.PreExecute(ctx))
+		// the sub-searches return less than topk plus offset candidates
+		task.req.RankParams = []*commonpb.KeyValuePair{{Key: TopKKey, Value: "8"}, {Key: OffsetKey, Value: "3"}}
+		assert.Error(t, task.PreExecute(ctx))
+		task.req.RankParams = []*commonpb.KeyValuePair{{Key: TopKKey, Value: "8"}, {Key: OffsetKey, Value: "2"}}
+		assert.NoError(t, task.PreExecute(ctx))
+		task.req.Requests = nil
+		task.req.RankParams = []*commonpb.KeyValuePair{{Key: TopKKey, Value: "2"}}
+		assert.Error(t, task.PreExecute(ctx))
+	})
+}
+
+func TestGetPrimaryKeysFromExpr(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "TestGetPrimaryKeysFromExpr",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tenant_id", DataType: schemapb.DataType_Int64},
+		},
+	}
+
+	pks, isTerm, err := getPrimaryKeysFromExpr(schema, "pk in [1, 2]")
+	assert.NoError(t, err)
+	assert.True(t, isTerm)
+	assert.Equal(t, []int64{1, 2}, pks.GetIntId().GetData())
+
+	_, isTerm, err = getPrimaryKeysFromExpr(schema, "tenant_id in [7]")
+	assert.NoError(t, err)
+	assert.False(t, isTerm)
+
+	_, isTerm, err = getPrimaryKeysFromExpr(schema, "tenant_id == 7 && pk > 10")
+	assert.NoError(t, err)
+	assert.False(t, isTerm)
+
+	_, _, err = getPrimaryKeysFromExpr(schema, "")
+	assert.Error(t, err)
+
+	_, _, err = getPrimaryKeysFromExpr(schema, "not_exist > 1")
+	assert.Error(t, err)
+
+	schema.Fields[0].DataType = schemapb.DataType_String
+	schema.Fields[0].TypeParams = []*commonpb.KeyValuePair{{Key: "max_length", Value: "16"}}
+	pks, isTerm, err = getPrimaryKeysFromExpr(schema, `pk in ["a", "b"]`)
+	assert.NoError(t, err)
+	assert.True(t, isTerm)
+	assert.Equal(t, []string{"a", "b"}, pks.GetStrId().GetData())
+}
+
+func TestIDs2Expr(t *testing.T) {
+	intIDs := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{3, 1}}}}
+	assert.Equal(t, "pk in [ 3, 1 ]", IDs2Expr("pk", intIDs))
+
+	strIDs := &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", `b"c`}}}}
+	assert.Equal(t, `pk in [ "a", "b\"c" ]`, IDs2Expr("pk", strIDs))
 }
 
 func TestCreateAlias_all(t *testing.T) {
@@ -3851,6 +4963,89 @@ func TestDropAlias_all(t *testing.T) {
 
 }
 
+func TestAddCollectionFieldTask(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	InitMetaCache(rc)
+
+	prefix := "TestAddCollectionFieldTask"
+	dbName := ""
+	collectionName := prefix + funcutil.GenRandomStr()
+	int64Field := "int64"
+	floatVecField := "fvec"
+
+	schema := constructCollectionSchema(int64Field, floatVecField, 128, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		DbName:         dbName,
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	newTask := func(field *schemapb.FieldSchema) *addCollectionFieldTask {
+		marshaledField, err := proto.Marshal(field)
+		assert.NoError(t, err)
+		task := &addCollectionFieldTask{
+			Condition: NewTaskCondition(ctx),
+			AddCollectionFieldRequest: &milvuspb.AddCollectionFieldRequest{
+				DbName:         dbName,
+				CollectionName: collectionName,
+				Schema:         marshaledField,
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	task := newTask(&schemapb.FieldSchema{Name: "price", DataType: schemapb.DataType_Double, Nullable: true})
+	assert.NotNil(t, task.TraceCtx())
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, AddCollectionFieldTaskName, task.Name())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_AddCollectionField, task.Type())
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
+	assert.NoError(t, task.PostExecute(ctx))
+
+	// the root coord invalidates the meta cache of the collection
+	globalMetaCache.RemoveCollection(ctx, dbName, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+	assert.NoError(t, err)
+	added := collSchema.Fields[len(collSchema.Fields)-1]
+	assert.Equal(t, "price", added.Name)
+	assert.True(t, added.Nullable)
+
+	// duplicated field
+	task = newTask(&schemapb.FieldSchema{Name: "price", DataType: schemapb.DataType_Double, Nullable: true})
+	assert.Error(t, task.PreExecute(ctx))
+	// neither nullable nor defaulted
+	task = newTask(&schemapb.FieldSchema{Name: "tag", DataType: schemapb.DataType_Int32})
+	assert.Error(t, task.PreExecute(ctx))
+	// vector field
+	task = newTask(&schemapb.FieldSchema{Name: "vec2", DataType: schemapb.DataType_FloatVector, Nullable: true})
+	assert.Error(t, task.PreExecute(ctx))
+	// collection not exists
+	task = newTask(&schemapb.FieldSchema{Name: "tag", DataType: schemapb.DataType_Int32, Nullable: true})
+	task.CollectionName = collectionName + "_not_exist"
+	assert.Error(t, task.PreExecute(ctx))
+}
+
 func TestAlterAlias_all(t *testing.T) {
 	Params.Init()
 	rc := NewRootCoordMock()
@@ -17,13 +17,17 @@
 package proxy
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func isAlpha(c uint8) bool {
@@ -79,6 +83,37 @@ func validateCollectionName(collName string) error {
 	return validateCollectionNameOrAlias(collName, "name")
 }
 
+// ValidateDatabaseName returns nil if dbName is a valid database name, database names follow the collection name rules.
+func ValidateDatabaseName(dbName string) error {
+	dbName = strings.TrimSpace(dbName)
+
+	if dbName == "" {
+		return errors.New("database name should not be empty")
+	}
+
+	invalidMsg := "Invalid database name: " + dbName + ". "
+	if int64(len(dbName)) > Params.MaxNameLength {
+		msg := invalidMsg + "The length of a database name must be less than " +
+			strconv.FormatInt(Params.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := dbName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a database name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(dbName); i++ {
+		c := dbName[i]
+		if c != '_' && c != '$' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Database name can only contain numbers, letters, dollars and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
 func validatePartitionTag(partitionTag string, strictCheck bool) error {
 	partitionTag = strings.TrimSpace(partitionTag)
 
@@ -155,8 +190,135 @@ func validateDimension(dim int64, isBinary bool) error {
 	return nil
 }
 
+// validateMaxLength checks the max_length type param of the VarChar field
+func validateMaxLength(field *schemapb.FieldSchema) error {
+	maxLength, err := typeutil.GetMaxLength(field)
+	if err != nil {
+		return fmt.Errorf("type param %s of VarChar field %s is invalid: %w", common.MaxLengthKey, field.Name, err)
+	}
+	if maxLength <= 0 || maxLength > common.MaxVarCharLength {
+		return fmt.Errorf("invalid max length: %d. should be in range 1 ~ %d", maxLength, common.MaxVarCharLength)
+	}
+	return nil
+}
+
+// validateArrayField checks the element type and the max_capacity type param of the Array field, the VarChar
+// elements also need the max_length type param
+func validateArrayField(field *schemapb.FieldSchema) error {
+	if !typeutil.IsArrayElementType(field.GetElementType()) {
+		return fmt.Errorf("element type %s of Array field %s is not supported", field.GetElementType().String(), field.Name)
+	}
+	maxCapacity, err := typeutil.GetMaxCapacity(field)
+	if err != nil {
+		return fmt.Errorf("type param %s of Array field %s is invalid: %w", common.MaxCapacityKey, field.Name, err)
+	}
+	if maxCapacity <= 0 || maxCapacity > common.MaxArrayCapacity {
+		return fmt.Errorf("invalid max capacity: %d. should be in range 1 ~ %d", maxCapacity, common.MaxArrayCapacity)
+	}
+	if field.GetElementType() == schemapb.DataType_String {
+		return validateMaxLength(field)
+	}
+	return nil
+}
+
+// validateSparseFloatVectorField checks the type params of the sparse float vector field, the max_nnz type param bounds
+// the number of non-zero elements of a row instead of the dim type param of the dense vector fields
+func validateSparseFloatVectorField(field *schemapb.FieldSchema) error {
+	maxNnz, err := typeutil.GetMaxNnz(field)
+	if err != nil {
+		return fmt.Errorf("type param %s of sparse float vector field %s is invalid: %w", common.MaxNnzKey, field.Name, err)
+	}
+	if maxNnz <= 0 || maxNnz > common.MaxSparseFloatNnz {
+		return fmt.Errorf("invalid max nnz: %d. should be in range 1 ~ %d", maxNnz, common.MaxSparseFloatNnz)
+	}
+	for _, kv := range field.TypeParams {
+		if kv.Key == "dim" {
+			return fmt.Errorf("sparse float vector field %s can not specify dim", field.Name)
+		}
+	}
+	return nil
+}
+
+// validateJSON checks the document of a JSON field, which must be a valid JSON of at most MaxJSONSize bytes
+func validateJSON(doc []byte) error {
+	if len(doc) > common.MaxJSONSize {
+		return fmt.Errorf("size of the JSON document %d exceeds the limit %d", len(doc), common.MaxJSONSize)
+	}
+	if !json.Valid(doc) {
+		return errors.New("invalid JSON document")
+	}
+	return nil
+}
+
+// validateNullableAndDefaultValue checks that only the scalar fields except the primary field are nullable or
+// have default values, and that the default value matches the data type of the field
+func validateNullableAndDefaultValue(field *schemapb.FieldSchema) error {
+	defaultValue := field.GetDefaultValue()
+	if !field.GetNullable() && defaultValue == nil {
+		return nil
+	}
+	if field.IsPrimaryKey {
+		return fmt.Errorf("primary field %s can not be nullable or have a default value", field.Name)
+	}
+	if typeutil.IsVectorType(field.DataType) {
+		return fmt.Errorf("vector field %s can not be nullable or have a default value", field.Name)
+	}
+	if defaultValue == nil {
+		return nil
+	}
+
+	mismatch := fmt.Errorf("type of the default value of field %s mismatches with data type %s", field.Name, field.DataType.String())
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		if _, ok := defaultValue.Data.(*schemapb.ValueField_BoolData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		v, ok := defaultValue.Data.(*schemapb.ValueField_IntData)
+		if !ok {
+			return mismatch
+		}
+		if (field.DataType == schemapb.DataType_Int8 && (v.IntData < math.MinInt8 || v.IntData > math.MaxInt8)) ||
+			(field.DataType == schemapb.DataType_Int16 && (v.IntData < math.MinInt16 || v.IntData > math.MaxInt16)) {
+			return fmt.Errorf("default value %d of field %s is out of the range of %s", v.IntData, field.Name, field.DataType.String())
+		}
+	case schemapb.DataType_Int64:
+		if _, ok := defaultValue.Data.(*schemapb.ValueField_LongData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_Float:
+		if _, ok := defaultValue.Data.(*schemapb.ValueField_FloatData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_Double:
+		if _, ok := defaultValue.Data.(*schemapb.ValueField_DoubleData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_String:
+		v, ok := defaultValue.Data.(*schemapb.ValueField_StringData)
+		if !ok {
+			return mismatch
+		}
+		maxLength, err := typeutil.GetMaxLength(field)
+		if err != nil {
+			return err
+		}
+		if len(v.StringData) > maxLength {
+			return fmt.Errorf("length of the default value of field %s exceeds max length %d", field.Name, maxLength)
+		}
+	case schemapb.DataType_Array:
+		return fmt.Errorf("Array field %s can not have a default value", field.Name)
+	case schemapb.DataType_JSON:
+		return fmt.Errorf("JSON field %s can not have a default value", field.Name)
+	default:
+		return mismatch
+	}
+	return nil
+}
+
 func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
-	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) {
+	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) &&
+		(field.DataType != schemapb.DataType_Float16Vector) && (field.DataType != schemapb.DataType_BFloat16Vector) {
 		return nil
 	}
 	for _, params := range field.IndexParams {
@@ -191,6 +353,9 @@ func ValidateFieldAutoID(coll *schemapb.CollectionSchema) error {
 			if !field.IsPrimaryKey {
 				return fmt.Errorf("only primary field can speficy AutoID with true, field name = %s", field.Name)
 			}
+			if field.DataType != schemapb.DataType_Int64 {
+				return fmt.Errorf("only int64 primary field can speficy AutoID with true, field name = %s", field.Name)
+			}
 		}
 	}
 	return nil
@@ -203,8 +368,8 @@ func validatePrimaryKey(coll *schemapb.CollectionSchema) error {
 			if idx != -1 {
 				return fmt.Errorf("there are more than one primary key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
 			}
-			if field.DataType != schemapb.DataType_Int64 {
-				return errors.New("the data type of primary key should be int64")
+			if !typeutil.IsPrimaryFieldType(field.DataType) {
+				return errors.New("the data type of primary key should be int64 or varchar")
 			}
 			idx = i
 		}
@@ -215,6 +380,45 @@ func validatePrimaryKey(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// validatePartitionKey checks the partition key field of the schema, the number of partitions can only be set when
+// the collection has a partition key
+func validatePartitionKey(coll *schemapb.CollectionSchema, numPartitions int64) error {
+	idx := -1
+	for i, field := range coll.Fields {
+		if !field.GetIsPartitionKey() {
+			continue
+		}
+		if idx != -1 {
+			return fmt.Errorf("there are more than one partition key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
+		}
+		if !typeutil.IsPartitionKeyType(field.DataType) {
+			return errors.New("the data type of partition key should be int64 or varchar")
+		}
+		if field.IsPrimaryKey {
+			return fmt.Errorf("the primary field %s can not be the partition key", field.Name)
+		}
+		if field.GetNullable() {
+			return fmt.Errorf("the partition key field %s can not be nullable", field.Name)
+		}
+		idx = i
+	}
+	if numPartitions < 0 {
+		return fmt.Errorf("the number of partitions should be positive, but got %d", numPartitions)
+	}
+	if idx == -1 && numPartitions > 0 {
+		return errors.New("the number of partitions can only be set when the collection has a partition key")
+	}
+	return nil
+}
+
+// validateTTL checks the ttl of the collection, 0 means the entities never expire
+func validateTTL(coll *schemapb.CollectionSchema) error {
+	if coll.GetTtlSeconds() < 0 {
+		return fmt.Errorf("the ttl of collection %s should not be negative, but got %d", coll.Name, coll.GetTtlSeconds())
+	}
+	return nil
+}
+
 // RepeatedKeyValToMap transfer the kv pairs to map.
 func RepeatedKeyValToMap(kvPairs []*commonpb.KeyValuePair) (map[string]string, error) {
 	resMap := make(map[string]string)
@@ -233,10 +437,12 @@ func isVector(dataType schemapb.DataType) (bool, error) {
 	case schemapb.DataType_Bool, schemapb.DataType_Int8,
 		schemapb.DataType_Int16, schemapb.DataType_Int32,
 		schemapb.DataType_Int64,
-		schemapb.DataType_Float, schemapb.DataType_Double:
+		schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_String, schemapb.DataType_Array, schemapb.DataType_JSON:
 		return false, nil
 
-	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, schemapb.DataType_SparseFloatVector,
+		schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
 		return true, nil
 	}
 
@@ -247,7 +453,11 @@ func validateMetricType(dataType schemapb.DataType, metricTypeStrRaw string) err
 	metricTypeStr := strings.ToUpper(metricTypeStrRaw)
 	switch metricTypeStr {
 	case "L2", "IP":
-		if dataType == schemapb.DataType_FloatVector {
+		if dataType == schemapb.DataType_FloatVector || dataType == schemapb.DataType_Float16Vector ||
+			dataType == schemapb.DataType_BFloat16Vector {
+			return nil
+		}
+		if metricTypeStr == "IP" && dataType == schemapb.DataType_SparseFloatVector {
 			return nil
 		}
 	case "JACCARD", "HAMMING", "TANIMOTO", "SUBSTRUCTURE", "SUBPERSTURCTURE":
@@ -277,8 +487,8 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 			} else if primaryIdx != -1 {
 				return fmt.Errorf("there are more than one primary key, field name = %s, %s", coll.Fields[primaryIdx].Name, field.Name)
 			}
-			if field.DataType != schemapb.DataType_Int64 {
-				return fmt.Errorf("type of primary key shoule be int64")
+			if !typeutil.IsPrimaryFieldType(field.DataType) {
+				return fmt.Errorf("type of primary key shoule be int64 or varchar")
 			}
 			primaryIdx = idx
 		}
@@ -308,13 +518,19 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 			if err2 != nil {
 				return err2
 			}
-			dimStr, ok := typeKv["dim"]
-			if !ok {
-				return fmt.Errorf("dim not found in type_params for vector field %s(%d)", field.Name, field.FieldID)
-			}
-			dim, err := strconv.Atoi(dimStr)
-			if err != nil || dim < 0 {
-				return fmt.Errorf("invalid dim; %s", dimStr)
+			if field.DataType == schemapb.DataType_SparseFloatVector {
+				if err := validateSparseFloatVectorField(field); err != nil {
+					return err
+				}
+			} else {
+				dimStr, ok := typeKv["dim"]
+				if !ok {
+					return fmt.Errorf("dim not found in type_params for vector field %s(%d)", field.Name, field.FieldID)
+				}
+				dim, err := strconv.Atoi(dimStr)
+				if err != nil || dim < 0 {
+					return fmt.Errorf("invalid dim; %s", dimStr)
+				}
 			}
 
 			metricTypeStr, ok := indexKv["metric_type"]
@@ -331,10 +547,21 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 			if len(field.IndexParams) != 0 {
 				return fmt.Errorf("index params is not empty for scalar field: %s(%d)", field.Name, field.FieldID)
 			}
-			if len(field.TypeParams) != 0 {
+			if field.DataType == schemapb.DataType_String {
+				if err := validateMaxLength(field); err != nil {
+					return err
+				}
+			} else if field.DataType == schemapb.DataType_Array {
+				if err := validateArrayField(field); err != nil {
+					return err
+				}
+			} else if len(field.TypeParams) != 0 {
 				return fmt.Errorf("type params is not empty for scalar field: %s(%d)", field.Name, field.FieldID)
 			}
 		}
+		if err := validateNullableAndDefaultValue(field); err != nil {
+			return err
+		}
 	}
 
 	if !autoID && primaryIdx == -1 {
@@ -343,3 +570,107 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 
 	return nil
 }
+
+// validateFieldToAdd checks the field to add to an existing collection, which must be a nullable scalar field or have
+// a default value since the existing entities have no value of it
+func validateFieldToAdd(field *schemapb.FieldSchema) error {
+	if err := validateFieldName(field.Name); err != nil {
+		return err
+	}
+	if field.IsPrimaryKey || field.AutoID {
+		return fmt.Errorf("field %s to add can not be the primary field", field.Name)
+	}
+	if typeutil.IsVectorType(field.DataType) {
+		return fmt.Errorf("field %s to add can not be a vector field", field.Name)
+	}
+	if !field.GetNullable() && field.GetDefaultValue() == nil {
+		return fmt.Errorf("field %s to add should be nullable or have a default value", field.Name)
+	}
+	if len(field.IndexParams) != 0 {
+		return fmt.Errorf("index params is not empty for scalar field: %s", field.Name)
+	}
+	if field.DataType == schemapb.DataType_String {
+		if err := validateMaxLength(field); err != nil {
+			return err
+		}
+	} else if field.DataType == schemapb.DataType_Array {
+		if err := validateArrayField(field); err != nil {
+			return err
+		}
+	} else if len(field.TypeParams) != 0 {
+		return fmt.Errorf("type params is not empty for scalar field: %s", field.Name)
+	}
+	return validateNullableAndDefaultValue(field)
+}
+
+// ValidateUsername validates the username, which must start with a letter and only contain letters, numbers and underscores.
+func ValidateUsername(username string) error {
+	username = strings.TrimSpace(username)
+
+	if username == "" {
+		return errors.New("username should not be empty")
+	}
+
+	invalidMsg := "Invalid username: " + username + ". "
+	if int64(len(username)) > Params.MaxUsernameLength {
+		msg := invalidMsg + "The length of username must be less than " +
+			strconv.FormatInt(Params.MaxUsernameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := username[0]
+	if !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of username must be a letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(username); i++ {
+		c := username[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Username should only contain numbers, letters, and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+// ValidateRoleName validates the role name, the rules are the same as the username.
+func ValidateRoleName(roleName string) error {
+	roleName = strings.TrimSpace(roleName)
+
+	if roleName == "" {
+		return errors.New("role name should not be empty")
+	}
+
+	invalidMsg := "Invalid role name: " + roleName + ". "
+	if int64(len(roleName)) > Params.MaxRoleNameLength {
+		msg := invalidMsg + "The length of role name must be less than " +
+			strconv.FormatInt(Params.MaxRoleNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := roleName[0]
+	if !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of role name must be a letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(roleName); i++ {
+		c := roleName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Role name should only contain numbers, letters, and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+// ValidatePassword validates the length of the raw password.
+func ValidatePassword(password string) error {
+	if int64(len(password)) < Params.MinPasswordLength || int64(len(password)) > Params.MaxPasswordLength {
+		msg := "The length of password must be at least " + strconv.FormatInt(Params.MinPasswordLength, 10) +
+			" and at most " + strconv.FormatInt(Params.MaxPasswordLength, 10) + " characters."
+		return errors.New(msg)
+	}
+	return nil
+}
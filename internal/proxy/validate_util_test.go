@@ -17,8 +17,10 @@
 package proxy
 
 import (
+	"strconv"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/stretchr/testify/assert"
@@ -50,6 +52,31 @@ func TestValidateCollectionName(t *testing.T) {
 	}
 }
 
+func TestValidateDatabaseName(t *testing.T) {
+	Params.Init()
+	assert.Nil(t, ValidateDatabaseName("abc"))
+	assert.Nil(t, ValidateDatabaseName("_123abc"))
+	assert.Nil(t, ValidateDatabaseName("abc123_$"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"123abc",
+		"$abc",
+		"_12 ac",
+		" ",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, ValidateDatabaseName(name))
+	}
+}
+
 func TestValidatePartitionTag(t *testing.T) {
 	assert.Nil(t, validatePartitionTag("abc", true))
 	assert.Nil(t, validatePartitionTag("123abc", true))
@@ -112,6 +139,145 @@ func TestValidateDimension(t *testing.T) {
 	assert.NotNil(t, validateDimension(9, true))
 }
 
+func TestValidateMaxLength(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:       "varchar",
+		DataType:   schemapb.DataType_String,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "1"}},
+	}
+	assert.Nil(t, validateMaxLength(field))
+	field.TypeParams[0].Value = strconv.Itoa(common.MaxVarCharLength)
+	assert.Nil(t, validateMaxLength(field))
+
+	// invalid max length
+	field.TypeParams[0].Value = "0"
+	assert.NotNil(t, validateMaxLength(field))
+	field.TypeParams[0].Value = strconv.Itoa(common.MaxVarCharLength + 1)
+	assert.NotNil(t, validateMaxLength(field))
+	field.TypeParams[0].Value = "invalid"
+	assert.NotNil(t, validateMaxLength(field))
+	field.TypeParams = nil
+	assert.NotNil(t, validateMaxLength(field))
+}
+
+func TestValidateArrayField(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:        "array",
+		DataType:    schemapb.DataType_Array,
+		ElementType: schemapb.DataType_Int64,
+		TypeParams:  []*commonpb.KeyValuePair{{Key: common.MaxCapacityKey, Value: "1"}},
+	}
+	assert.Nil(t, validateArrayField(field))
+	field.TypeParams[0].Value = strconv.Itoa(common.MaxArrayCapacity)
+	assert.Nil(t, validateArrayField(field))
+
+	// invalid max capacity
+	field.TypeParams[0].Value = "0"
+	assert.NotNil(t, validateArrayField(field))
+	field.TypeParams[0].Value = strconv.Itoa(common.MaxArrayCapacity + 1)
+	assert.NotNil(t, validateArrayField(field))
+	field.TypeParams[0].Value = "invalid"
+	assert.NotNil(t, validateArrayField(field))
+
+	// invalid element type
+	field.TypeParams[0].Value = "8"
+	field.ElementType = schemapb.DataType_FloatVector
+	assert.NotNil(t, validateArrayField(field))
+	field.ElementType = schemapb.DataType_Array
+	assert.NotNil(t, validateArrayField(field))
+
+	// VarChar elements need max_length
+	field.ElementType = schemapb.DataType_String
+	assert.NotNil(t, validateArrayField(field))
+	field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: common.MaxLengthKey, Value: "16"})
+	assert.Nil(t, validateArrayField(field))
+}
+
+func TestValidateSparseFloatVectorField(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:       "sparse",
+		DataType:   schemapb.DataType_SparseFloatVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxNnzKey, Value: "1"}},
+	}
+	assert.Nil(t, validateSparseFloatVectorField(field))
+	field.TypeParams[0].Value = strconv.Itoa(common.MaxSparseFloatNnz)
+	assert.Nil(t, validateSparseFloatVectorField(field))
+
+	// invalid max nnz
+	field.TypeParams[0].Value = "0"
+	assert.NotNil(t, validateSparseFloatVectorField(field))
+	field.TypeParams[0].Value = strconv.Itoa(common.MaxSparseFloatNnz + 1)
+	assert.NotNil(t, validateSparseFloatVectorField(field))
+	field.TypeParams[0].Value = "invalid"
+	assert.NotNil(t, validateSparseFloatVectorField(field))
+
+	// dim is not allowed
+	field.TypeParams[0].Value = "8"
+	field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: "dim", Value: "8"})
+	assert.NotNil(t, validateSparseFloatVectorField(field))
+	field.TypeParams = nil
+	assert.NotNil(t, validateSparseFloatVectorField(field))
+}
+
+func TestValidateNullableAndDefaultValue(t *testing.T) {
+	intValue := &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 100}}
+	stringValue := &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "abc"}}
+
+	assert.Nil(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{DataType: schemapb.DataType_Int64, IsPrimaryKey: true}))
+	assert.Nil(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{DataType: schemapb.DataType_Int32, Nullable: true}))
+	assert.Nil(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{DataType: schemapb.DataType_Int8, DefaultValue: intValue}))
+	assert.Nil(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{
+		DataType:     schemapb.DataType_String,
+		TypeParams:   []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "3"}},
+		Nullable:     true,
+		DefaultValue: stringValue,
+	}))
+
+	invalidFields := []*schemapb.FieldSchema{
+		{DataType: schemapb.DataType_Int64, IsPrimaryKey: true, Nullable: true},
+		{DataType: schemapb.DataType_FloatVector, DefaultValue: intValue},
+		{DataType: schemapb.DataType_Int64, DefaultValue: intValue},
+		{DataType: schemapb.DataType_Bool, DefaultValue: intValue},
+		{DataType: schemapb.DataType_Int8, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 128}}},
+		{DataType: schemapb.DataType_Int16, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: -32769}}},
+		{DataType: schemapb.DataType_Double, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: 1}}},
+		{
+			DataType:     schemapb.DataType_String,
+			TypeParams:   []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "2"}},
+			DefaultValue: stringValue,
+		},
+	}
+	for _, field := range invalidFields {
+		assert.NotNil(t, validateNullableAndDefaultValue(field), field.String())
+	}
+}
+
+func TestValidateFieldToAdd(t *testing.T) {
+	intValue := &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 100}}
+
+	assert.Nil(t, validateFieldToAdd(&schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int32, Nullable: true}))
+	assert.Nil(t, validateFieldToAdd(&schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int16, DefaultValue: intValue}))
+	assert.Nil(t, validateFieldToAdd(&schemapb.FieldSchema{
+		Name:       "a",
+		DataType:   schemapb.DataType_String,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "8"}},
+		Nullable:   true,
+	}))
+
+	invalidFields := []*schemapb.FieldSchema{
+		{Name: "", DataType: schemapb.DataType_Int32, Nullable: true},
+		{Name: "a", DataType: schemapb.DataType_Int32},
+		{Name: "a", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, Nullable: true},
+		{Name: "a", DataType: schemapb.DataType_FloatVector, Nullable: true},
+		{Name: "a", DataType: schemapb.DataType_String, Nullable: true},
+		{Name: "a", DataType: schemapb.DataType_Int32, Nullable: true, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+		{Name: "a", DataType: schemapb.DataType_Bool, DefaultValue: intValue},
+	}
+	for _, field := range invalidFields {
+		assert.NotNil(t, validateFieldToAdd(field), field.String())
+	}
+}
+
 func TestValidateVectorFieldMetricType(t *testing.T) {
 	field1 := &schemapb.FieldSchema{
 		Name:         "",
@@ -196,6 +362,46 @@ func TestValidatePrimaryKey(t *testing.T) {
 	assert.NotNil(t, validateSchema(&coll))
 }
 
+func TestValidatePartitionKey(t *testing.T) {
+	coll := &schemapb.CollectionSchema{
+		Name: "coll1",
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", FieldID: 100, IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{Name: "tenant", FieldID: 101, DataType: schemapb.DataType_String},
+		},
+	}
+	assert.Nil(t, validatePartitionKey(coll, 0))
+	// the number of partitions requires a partition key
+	assert.NotNil(t, validatePartitionKey(coll, 16))
+
+	coll.Fields[1].IsPartitionKey = true
+	assert.Nil(t, validatePartitionKey(coll, 0))
+	assert.Nil(t, validatePartitionKey(coll, 16))
+	assert.NotNil(t, validatePartitionKey(coll, -1))
+
+	coll.Fields[1].Nullable = true
+	assert.NotNil(t, validatePartitionKey(coll, 0))
+	coll.Fields[1].Nullable = false
+
+	coll.Fields[1].DataType = schemapb.DataType_Float
+	assert.NotNil(t, validatePartitionKey(coll, 0))
+	coll.Fields[1].DataType = schemapb.DataType_String
+
+	coll.Fields[0].IsPartitionKey = true
+	assert.NotNil(t, validatePartitionKey(coll, 0))
+	coll.Fields[1].IsPartitionKey = false
+	assert.NotNil(t, validatePartitionKey(coll, 0))
+}
+
+func TestValidateTTL(t *testing.T) {
+	coll := &schemapb.CollectionSchema{Name: "coll1"}
+	assert.Nil(t, validateTTL(coll))
+	coll.TtlSeconds = 7 * 24 * 3600
+	assert.Nil(t, validateTTL(coll))
+	coll.TtlSeconds = -1
+	assert.NotNil(t, validateTTL(coll))
+}
+
 func TestValidateSchema(t *testing.T) {
 	coll := &schemapb.CollectionSchema{
 		Name:        "coll1",
@@ -258,6 +464,12 @@ func TestValidateSchema(t *testing.T) {
 	pf2.DataType = schemapb.DataType_FloatVector
 	assert.NotNil(t, validateSchema(coll))
 
+	pf2.DataType = schemapb.DataType_String
+	assert.NotNil(t, validateSchema(coll))
+	pf2.TypeParams = []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "8"}}
+	assert.Nil(t, validateSchema(coll))
+	pf2.TypeParams = nil
+
 	pf2.DataType = schemapb.DataType_Int64
 	assert.Nil(t, validateSchema(coll))
 
@@ -372,3 +584,57 @@ func TestValidateSchema(t *testing.T) {
 	pf3.IndexParams = ip3Good
 	assert.Nil(t, validateSchema(coll))
 }
+
+func TestValidateUsername(t *testing.T) {
+	Params.Init()
+	// only spaces
+	res := ValidateUsername(" ")
+	assert.Error(t, res)
+	// starts with non-alphabet
+	res = ValidateUsername("1abc")
+	assert.Error(t, res)
+	// length gt 32
+	res = ValidateUsername("aaaaaaaaaabbbbbbbbbbccccccccccddddd")
+	assert.Error(t, res)
+	// illegal character which not alphabet, _, or number
+	res = ValidateUsername("a1^7*).,")
+	assert.Error(t, res)
+	// normal username that only contains alphabet, _, and number
+	res = ValidateUsername("a17_good")
+	assert.Nil(t, res)
+}
+
+func TestValidatePassword(t *testing.T) {
+	Params.Init()
+	// only spaces
+	res := ValidatePassword("")
+	assert.NotNil(t, res)
+	// length lt 6
+	res = ValidatePassword("12345")
+	assert.NotNil(t, res)
+	// length gt 256
+	res = ValidatePassword("aaaaaaaaaabbbbbbbbbbccccccccccddddddddddeeeeeeeeeeffffffffffgggggggggghhhhhhhhhhiiiiiiiiiijjjjjjjjjjkkkkkkkkkkllllllllllmmmmmmmmmnnnnnnnnnnnooooooooooppppppppppqqqqqqqqqqrrrrrrrrrrsssssssssstttttttttttuuuuuuuuuuuvvvvvvvvvvwwwwwwwwwwwxxxxxxxxxxyyyyyyyyyzzzzzzzzzzz")
+	assert.Error(t, res)
+	// normal password
+	res = ValidatePassword("a17_good!")
+	assert.Nil(t, res)
+}
+
+func TestValidateRoleName(t *testing.T) {
+	Params.Init()
+	// only spaces
+	res := ValidateRoleName(" ")
+	assert.Error(t, res)
+	// starts with non-alphabet
+	res = ValidateRoleName("1abc")
+	assert.Error(t, res)
+	// length gt 32
+	res = ValidateRoleName("aaaaaaaaaabbbbbbbbbbccccccccccddddd")
+	assert.Error(t, res)
+	// illegal character which not alphabet, _, or number
+	res = ValidateRoleName("a1^7*).,")
+	assert.Error(t, res)
+	// normal role name
+	res = ValidateRoleName("abc_123")
+	assert.Nil(t, res)
+}
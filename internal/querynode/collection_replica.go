@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // ReplicaInterface specifies all the methods that the Collection object needs to implement in QueryNode.
@@ -298,7 +299,7 @@ func (colReplica *collectionReplica) getVecFieldIDsByCollectionID(collectionID U
 
 	vecFields := make([]FieldID, 0)
 	for _, field := range fields {
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+		if typeutil.IsVectorType(field.DataType) {
 			vecFields = append(vecFields, field.FieldID)
 		}
 	}
@@ -4,8 +4,10 @@ import (
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 )
@@ -32,7 +34,7 @@ func (dNode *deleteNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	}
 
 	delData := &deleteData{
-		deleteIDs:        map[UniqueID][]int64{},
+		deleteIDs:        map[UniqueID]*schemapb.IDs{},
 		deleteTimestamps: map[UniqueID][]Timestamp{},
 		deleteOffset:     map[UniqueID]int64{},
 	}
@@ -58,6 +60,7 @@ func (dNode *deleteNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 				zap.Any("collectionID", delMsg.CollectionID),
 				zap.Any("collectionName", delMsg.CollectionName),
 				zap.Any("pks", delMsg.PrimaryKeys),
+				zap.Any("string pks", delMsg.StringPrimaryKeys),
 				zap.Any("timestamp", delMsg.Timestamps))
 			processDeleteMessages(dNode.replica, delMsg, delData)
 		}
@@ -70,7 +73,7 @@ func (dNode *deleteNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			log.Debug(err.Error())
 			continue
 		}
-		offset := segment.segmentPreDelete(len(pks))
+		offset := segment.segmentPreDelete(typeutil.GetSizeOfIDs(pks))
 		delData.deleteOffset[segmentID] = offset
 	}
 
@@ -109,13 +112,13 @@ func (dNode *deleteNode) delete(deleteData *deleteData, segmentID UniqueID, wg *
 	timestamps := deleteData.deleteTimestamps[segmentID]
 	offset := deleteData.deleteOffset[segmentID]
 
-	err = targetSegment.segmentDelete(offset, &ids, &timestamps)
+	err = targetSegment.segmentDelete(offset, ids, &timestamps)
 	if err != nil {
 		log.Warn("QueryNode: targetSegmentDelete failed", zap.Error(err))
 		return
 	}
 
-	log.Debug("Do delete done", zap.Int("len", len(deleteData.deleteIDs[segmentID])), zap.Int64("segmentID", segmentID))
+	log.Debug("Do delete done", zap.Int("len", typeutil.GetSizeOfIDs(ids)), zap.Int64("segmentID", segmentID))
 }
 
 func newDeleteNode(historicalReplica ReplicaInterface) *deleteNode {
@@ -19,6 +19,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
@@ -122,7 +123,7 @@ func TestFlowGraphDeleteNode_operate(t *testing.T) {
 		for i := 0; i < defaultMsgLength; i++ {
 			pks[i] = int64(i)
 		}
-		s.updateBloomFilter(pks)
+		s.updateBloomFilter(&schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}})
 		assert.Nil(t, err)
 		buf := make([]byte, 8)
 		for i := 0; i < defaultMsgLength; i++ {
@@ -142,7 +142,7 @@ func (fdmNode *filterDmNode) filterInvalidDeleteMessage(msg *msgstream.DeleteMsg
 		}
 	}
 
-	if len(msg.PrimaryKeys) != len(msg.Timestamps) {
+	if len(msg.PrimaryKeys)+len(msg.StringPrimaryKeys) != len(msg.Timestamps) {
 		log.Warn("Error, misaligned messages detected")
 		return nil
 	}
@@ -15,8 +15,6 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-	"io"
-	"strconv"
 	"sync"
 
 	"github.com/opentracing/opentracing-go"
@@ -29,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type insertNode struct {
@@ -41,11 +40,11 @@ type insertData struct {
 	insertTimestamps map[UniqueID][]Timestamp
 	insertRecords    map[UniqueID][]*commonpb.Blob
 	insertOffset     map[UniqueID]int64
-	insertPKs        map[UniqueID][]int64
+	insertPKs        map[UniqueID]*schemapb.IDs
 }
 
 type deleteData struct {
-	deleteIDs        map[UniqueID][]int64
+	deleteIDs        map[UniqueID]*schemapb.IDs
 	deleteTimestamps map[UniqueID][]Timestamp
 	deleteOffset     map[UniqueID]int64
 }
@@ -73,7 +72,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		insertTimestamps: make(map[UniqueID][]Timestamp),
 		insertRecords:    make(map[UniqueID][]*commonpb.Blob),
 		insertOffset:     make(map[UniqueID]int64),
-		insertPKs:        make(map[UniqueID][]int64),
+		insertPKs:        make(map[UniqueID]*schemapb.IDs),
 	}
 
 	if iMsg == nil {
@@ -89,6 +88,27 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	// 1. hash insertMessages to insertData
 	for _, task := range iMsg.insertMessages {
+		// the rows written before a field was added to the collection have no value of the field
+		collection, err := iNode.streamingReplica.getCollectionByID(task.CollectionID)
+		if err != nil {
+			log.Error("failed to get collection of insert message, the message is dropped",
+				zap.Int64("msgID", task.ID()),
+				zap.Int64("collectionID", task.CollectionID),
+				zap.Int64("segmentID", task.SegmentID),
+				zap.Int("numRows", len(task.RowData)),
+				zap.Error(err))
+			continue
+		}
+		if err := typeutil.AlignRowsToSchema(collection.Schema(), task.RowData); err != nil {
+			log.Error("failed to align the rows of insert message to the schema, the message is dropped",
+				zap.Int64("msgID", task.ID()),
+				zap.Int64("collectionID", task.CollectionID),
+				zap.Int64("segmentID", task.SegmentID),
+				zap.Int("numRows", len(task.RowData)),
+				zap.Error(err))
+			continue
+		}
+
 		// check if partition exists, if not, create partition
 		if hasPartition := iNode.streamingReplica.hasPartition(task.PartitionID); !hasPartition {
 			err := iNode.streamingReplica.addPartition(task.CollectionID, task.PartitionID)
@@ -143,7 +163,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	wg.Wait()
 
 	delData := &deleteData{
-		deleteIDs:        make(map[UniqueID][]int64),
+		deleteIDs:        make(map[UniqueID]*schemapb.IDs),
 		deleteTimestamps: make(map[UniqueID][]Timestamp),
 		deleteOffset:     make(map[UniqueID]int64),
 	}
@@ -154,6 +174,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 				zap.Any("collectionID", delMsg.CollectionID),
 				zap.Any("collectionName", delMsg.CollectionName),
 				zap.Any("pks", delMsg.PrimaryKeys),
+				zap.Any("string pks", delMsg.StringPrimaryKeys),
 				zap.Any("timestamp", delMsg.Timestamps))
 			processDeleteMessages(iNode.streamingReplica, delMsg, delData)
 		}
@@ -166,7 +187,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			log.Debug(err.Error())
 			continue
 		}
-		offset := segment.segmentPreDelete(len(pks))
+		offset := segment.segmentPreDelete(typeutil.GetSizeOfIDs(pks))
 		delData.deleteOffset[segmentID] = offset
 	}
 
@@ -214,20 +235,33 @@ func processDeleteMessages(replica ReplicaInterface, msg *msgstream.DeleteMsg, d
 			log.Warn(err.Error())
 			continue
 		}
-		pks, err := filterSegmentsByPKs(msg.PrimaryKeys, segment)
+		pks, err := filterSegmentsByPKs(getDeletePKs(msg), segment)
 		if err != nil {
 			log.Warn(err.Error())
 			continue
 		}
-		if len(pks) > 0 {
-			delData.deleteIDs[segmentID] = append(delData.deleteIDs[segmentID], pks...)
+		if size := typeutil.GetSizeOfIDs(pks); size > 0 {
+			if delData.deleteIDs[segmentID] == nil {
+				delData.deleteIDs[segmentID] = &schemapb.IDs{}
+			}
+			for i := 0; i < size; i++ {
+				typeutil.AppendPKs(delData.deleteIDs[segmentID], typeutil.GetPK(pks, int64(i)))
+			}
 			// TODO(yukun) get offset of pks
-			delData.deleteTimestamps[segmentID] = append(delData.deleteTimestamps[segmentID], msg.Timestamps[:len(pks)]...)
+			delData.deleteTimestamps[segmentID] = append(delData.deleteTimestamps[segmentID], msg.Timestamps[:size]...)
 		}
 	}
 }
 
-func filterSegmentsByPKs(pks []int64, segment *Segment) ([]int64, error) {
+// getDeletePKs returns the primary keys of the delete message, which are int64 or string
+func getDeletePKs(msg *msgstream.DeleteMsg) *schemapb.IDs {
+	if len(msg.StringPrimaryKeys) > 0 {
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: msg.StringPrimaryKeys}}}
+	}
+	return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: msg.PrimaryKeys}}}
+}
+
+func filterSegmentsByPKs(pks *schemapb.IDs, segment *Segment) (*schemapb.IDs, error) {
 	if pks == nil {
 		return nil, fmt.Errorf("pks is nil when getSegmentsByPKs")
 	}
@@ -235,15 +269,20 @@ func filterSegmentsByPKs(pks []int64, segment *Segment) ([]int64, error) {
 		return nil, fmt.Errorf("segments is nil when getSegmentsByPKs")
 	}
 	buf := make([]byte, 8)
-	res := make([]int64, 0)
-	for _, pk := range pks {
+	res := &schemapb.IDs{}
+	for _, pk := range pks.GetIntId().GetData() {
 		common.Endian.PutUint64(buf, uint64(pk))
 		exist := segment.pkFilter.Test(buf)
 		if exist {
-			res = append(res, pk)
+			typeutil.AppendPKs(res, pk)
+		}
+	}
+	for _, pk := range pks.GetStrId().GetData() {
+		if segment.pkFilter.TestString(pk) {
+			typeutil.AppendPKs(res, pk)
 		}
 	}
-	log.Debug("In filterSegmentsByPKs", zap.Any("pk len", len(res)), zap.Any("segment", segment.segmentID))
+	log.Debug("In filterSegmentsByPKs", zap.Any("pk len", typeutil.GetSizeOfIDs(res)), zap.Any("segment", segment.segmentID))
 	return res, nil
 }
 
@@ -296,16 +335,16 @@ func (iNode *insertNode) delete(deleteData *deleteData, segmentID UniqueID, wg *
 	timestamps := deleteData.deleteTimestamps[segmentID]
 	offset := deleteData.deleteOffset[segmentID]
 
-	err = targetSegment.segmentDelete(offset, &ids, &timestamps)
+	err = targetSegment.segmentDelete(offset, ids, &timestamps)
 	if err != nil {
 		log.Warn("QueryNode: targetSegmentDelete failed", zap.Error(err))
 		return
 	}
 
-	log.Debug("Do delete done", zap.Int("len", len(deleteData.deleteIDs[segmentID])), zap.Int64("segmentID", segmentID))
+	log.Debug("Do delete done", zap.Int("len", typeutil.GetSizeOfIDs(ids)), zap.Int64("segmentID", segmentID))
 }
 
-func (iNode *insertNode) getPrimaryKeys(msg *msgstream.InsertMsg) []int64 {
+func (iNode *insertNode) getPrimaryKeys(msg *msgstream.InsertMsg) *schemapb.IDs {
 	if len(msg.RowIDs) != len(msg.Timestamps) || len(msg.RowIDs) != len(msg.RowData) {
 		log.Warn("misaligned messages detected")
 		return nil
@@ -317,67 +356,55 @@ func (iNode *insertNode) getPrimaryKeys(msg *msgstream.InsertMsg) []int64 {
 		log.Warn(err.Error())
 		return nil
 	}
-	offset := 0
+	// the values of the fields before the primary key may be of variable lengths, so each row is split to find the key
+	var fields []*schemapb.FieldSchema
+	var pkField *schemapb.FieldSchema
 	for _, field := range collection.schema.Fields {
+		fields = append(fields, field)
 		if field.IsPrimaryKey {
+			pkField = field
 			break
 		}
-		switch field.DataType {
-		case schemapb.DataType_Bool:
-			offset++
-		case schemapb.DataType_Int8:
-			offset++
-		case schemapb.DataType_Int16:
-			offset += 2
-		case schemapb.DataType_Int32:
-			offset += 4
-		case schemapb.DataType_Int64:
-			offset += 8
-		case schemapb.DataType_Float:
-			offset += 4
-		case schemapb.DataType_Double:
-			offset += 8
-		case schemapb.DataType_FloatVector:
-			for _, t := range field.TypeParams {
-				if t.Key == "dim" {
-					dim, err := strconv.Atoi(t.Value)
-					if err != nil {
-						log.Error("strconv wrong on get dim", zap.Error(err))
-						break
-					}
-					offset += dim * 4
-					break
-				}
-			}
-		case schemapb.DataType_BinaryVector:
-			for _, t := range field.TypeParams {
-				if t.Key == "dim" {
-					dim, err := strconv.Atoi(t.Value)
-					if err != nil {
-						log.Error("strconv wrong on get dim", zap.Error(err))
-						return nil
-					}
-					offset += dim / 8
-					break
-				}
-			}
+	}
+	if pkField == nil {
+		log.Warn("primary key not found in the schema", zap.Int64("collectionID", collectionID))
+		return nil
+	}
+	pkValues := make([][]byte, len(msg.RowData))
+	for i, blob := range msg.RowData {
+		values, err := typeutil.SplitRow(fields, blob.GetValue())
+		if err != nil {
+			log.Warn("split row failed", zap.Error(err))
+			return nil
+		}
+		if len(values) != len(fields) {
+			log.Warn("primary key not found in the row", zap.Int("size", len(blob.GetValue())))
+			return nil
 		}
+		pkValues[i] = values[len(values)-1]
 	}
 
-	blobReaders := make([]io.Reader, len(msg.RowData))
-	for i, blob := range msg.RowData {
-		blobReaders[i] = bytes.NewReader(blob.GetValue()[offset : offset+8])
+	if pkField.DataType == schemapb.DataType_String {
+		pks := make([]string, len(pkValues))
+		for i, value := range pkValues {
+			pk, _, err := typeutil.DecodeVariableLengthValue(value)
+			if err != nil {
+				log.Warn("decode varchar primary key failed", zap.Error(err))
+			}
+			pks[i] = string(pk)
+		}
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: pks}}}
 	}
-	pks := make([]int64, len(blobReaders))
 
-	for i, reader := range blobReaders {
-		err := binary.Read(reader, common.Endian, &pks[i])
+	pks := make([]int64, len(pkValues))
+	for i, value := range pkValues {
+		err := binary.Read(bytes.NewReader(value), common.Endian, &pks[i])
 		if err != nil {
 			log.Warn("binary read blob value failed", zap.Error(err))
 		}
 	}
 
-	return pks
+	return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}
 }
 func newInsertNode(streamingReplica ReplicaInterface) *insertNode {
 	maxQueueLength := Params.FlowGraphMaxQueueLength
@@ -21,7 +21,9 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func genFlowGraphInsertData() (*insertData, error) {
@@ -53,8 +55,8 @@ func genFlowGraphDeleteData() (*deleteData, error) {
 		return nil, err
 	}
 	dData := &deleteData{
-		deleteIDs: map[UniqueID][]UniqueID{
-			defaultSegmentID: deleteMsg.PrimaryKeys,
+		deleteIDs: map[UniqueID]*schemapb.IDs{
+			defaultSegmentID: getDeletePKs(deleteMsg),
 		},
 		deleteTimestamps: map[UniqueID][]Timestamp{
 			defaultSegmentID: deleteMsg.Timestamps,
@@ -372,15 +374,25 @@ func TestGetSegmentsByPKs(t *testing.T) {
 		segmentID: 1,
 		pkFilter:  filter,
 	}
-	pks, err := filterSegmentsByPKs([]int64{0, 1, 2, 3, 4}, segment)
+	int64IDs := func(pks ...int64) *schemapb.IDs {
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}
+	}
+	pks, err := filterSegmentsByPKs(int64IDs(0, 1, 2, 3, 4), segment)
 	assert.Nil(t, err)
-	assert.Equal(t, len(pks), 3)
+	assert.Equal(t, []int64{0, 1, 2}, pks.GetIntId().GetData())
 
-	pks, err = filterSegmentsByPKs([]int64{}, segment)
+	pks, err = filterSegmentsByPKs(int64IDs(), segment)
 	assert.Nil(t, err)
-	assert.Equal(t, len(pks), 0)
+	assert.Equal(t, typeutil.GetSizeOfIDs(pks), 0)
 	_, err = filterSegmentsByPKs(nil, segment)
 	assert.NotNil(t, err)
-	_, err = filterSegmentsByPKs([]int64{0, 1, 2, 3, 4}, nil)
+	_, err = filterSegmentsByPKs(int64IDs(0, 1, 2, 3, 4), nil)
 	assert.NotNil(t, err)
+
+	filter.AddString("a")
+	filter.AddString("b")
+	strIDs := &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "b", "c"}}}}
+	pks, err = filterSegmentsByPKs(strIDs, segment)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, pks.GetStrId().GetData())
 }
@@ -181,6 +181,7 @@ func (loader *indexLoader) setIndexInfo(collectionID UniqueID, segment *Segment,
 		},
 		CollectionID: collectionID,
 		SegmentID:    segment.segmentID,
+		FieldID:      fieldID,
 	}
 	response, err := loader.rootCoord.DescribeSegment(ctx, req)
 	if err != nil {
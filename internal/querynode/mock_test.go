@@ -847,7 +847,7 @@ func genSealedSegment(schemaForCreate *schemapb.CollectionSchema,
 		for _, numRow := range numRows {
 			totalNumRows += numRow
 		}
-		err := seg.segmentLoadFieldData(k, int(totalNumRows), data)
+		err := seg.segmentLoadFieldData(k, int(totalNumRows), data, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"unsafe"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -610,24 +612,24 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 	if err != nil {
 		return nil, err
 	}
-	topK := len(pbHits.IDs)
+	// the hits of varchar primary keys are carried by StrIds
+	topK := len(pbHits.IDs) + len(pbHits.StrIds)
 
 	blobOffset += 8
-	var ids []int64
+	ids := &schemapb.IDs{}
 	var scores []float32
 	for _, hit := range hits {
-		ids = append(ids, hit.IDs...)
+		for _, id := range hit.IDs {
+			typeutil.AppendPKs(ids, id)
+		}
+		for _, id := range hit.StrIds {
+			typeutil.AppendPKs(ids, id)
+		}
 		scores = append(scores, hit.Scores...)
 	}
 
 	finalResult := &schemapb.SearchResultData{
-		Ids: &schemapb.IDs{
-			IdField: &schemapb.IDs_IntId{
-				IntId: &schemapb.LongArray{
-					Data: ids,
-				},
-			},
-		},
+		Ids:        ids,
 		Scores:     scores,
 		TopK:       int64(topK),
 		NumQueries: int64(numQueries),
@@ -638,6 +640,19 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 		if err != nil {
 			return nil, err
 		}
+		// the value of a nullable field is led by a validity byte
+		var validData []bool
+		hasNull := false
+		if fieldMeta.GetNullable() {
+			for _, hit := range hits {
+				for _, row := range hit.RowData {
+					valid := row[blobOffset] != 0
+					hasNull = hasNull || !valid
+					validData = append(validData, valid)
+				}
+			}
+			blobOffset++
+		}
 		switch fieldMeta.DataType {
 		case schemapb.DataType_Bool:
 			blobLen := 1
@@ -800,6 +815,89 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
 			blobOffset += blobLen
+		case schemapb.DataType_String:
+			var colData []string
+			for _, hit := range hits {
+				for j, row := range hit.RowData {
+					data, size, err := typeutil.DecodeVariableLengthValue(row[blobOffset:])
+					if err != nil {
+						return nil, err
+					}
+					colData = append(colData, string(data))
+					// the values are of variable lengths, the rows are cut to begin with the next field
+					hit.RowData[j] = row[blobOffset+size:]
+				}
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{
+							StringData: &schemapb.StringArray{
+								Data: colData,
+							},
+						},
+					},
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
+			blobOffset = 0
+		case schemapb.DataType_Array:
+			var colData []*schemapb.ScalarField
+			for _, hit := range hits {
+				for j, row := range hit.RowData {
+					value, size, err := typeutil.DecodeVariableLengthValue(row[blobOffset:])
+					if err != nil {
+						return nil, err
+					}
+					data, err := typeutil.DecodeArray(fieldMeta, value)
+					if err != nil {
+						return nil, err
+					}
+					colData = append(colData, data)
+					// the values are of variable lengths, the rows are cut to begin with the next field
+					hit.RowData[j] = row[blobOffset+size:]
+				}
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_ArrayData{
+							ArrayData: &schemapb.ArrayArray{
+								Data:        colData,
+								ElementType: fieldMeta.ElementType,
+							},
+						},
+					},
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
+			blobOffset = 0
+		case schemapb.DataType_JSON:
+			var colData [][]byte
+			for _, hit := range hits {
+				for j, row := range hit.RowData {
+					data, size, err := typeutil.DecodeVariableLengthValue(row[blobOffset:])
+					if err != nil {
+						return nil, err
+					}
+					colData = append(colData, data)
+					// the values are of variable lengths, the rows are cut to begin with the next field
+					hit.RowData[j] = row[blobOffset+size:]
+				}
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_JsonData{
+							JsonData: &schemapb.JSONArray{
+								Data: colData,
+							},
+						},
+					},
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
+			blobOffset = 0
 		case schemapb.DataType_FloatVector:
 			dim, err := schema.GetVectorDimFromID(fieldID)
 			if err != nil {
@@ -856,14 +954,140 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
 			blobOffset += blobLen
+		case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			dim, err := schema.GetVectorDimFromID(fieldID)
+			if err != nil {
+				return nil, err
+			}
+			blobLen := dim * 2
+			var colData []byte
+			for _, hit := range hits {
+				for _, row := range hit.RowData {
+					dataBlob := row[blobOffset : blobOffset+blobLen]
+					colData = append(colData, dataBlob...)
+				}
+			}
+			vectors := &schemapb.VectorField{Dim: int64(dim)}
+			if fieldMeta.DataType == schemapb.DataType_Float16Vector {
+				vectors.Data = &schemapb.VectorField_Float16Vector{Float16Vector: colData}
+			} else {
+				vectors.Data = &schemapb.VectorField_Bfloat16Vector{Bfloat16Vector: colData}
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: vectors,
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
+			blobOffset += blobLen
 		default:
 			return nil, fmt.Errorf("unsupported data type %s", schemapb.DataType_name[int32(fieldMeta.DataType)])
 		}
+		if hasNull {
+			finalResult.FieldsData[len(finalResult.FieldsData)-1].ValidData = validData
+		}
 	}
 
 	return finalResult, nil
 }
 
+// filterSearchResultData keeps the valid hits of each query accepted by keep, which is called in the order of the hits.
+// The number of hits of each query is given by Topks if it has been filtered before, otherwise each query has TopK hits
+func filterSearchResultData(data *schemapb.SearchResultData, keep func(qi int64, idx int64) bool) *schemapb.SearchResultData {
+	ids := data.Ids
+	bounds := make([]int64, data.NumQueries+1)
+	for i := int64(0); i < data.NumQueries; i++ {
+		if int64(len(data.Topks)) == data.NumQueries {
+			bounds[i+1] = bounds[i] + data.Topks[i]
+		} else {
+			bounds[i+1] = bounds[i] + data.TopK
+		}
+	}
+
+	rows := make([]int64, 0)
+	topks := make([]int64, 0, data.NumQueries)
+	for i := int64(0); i < data.NumQueries; i++ {
+		var topk int64
+		for j := bounds[i]; j < bounds[i+1] && j < int64(typeutil.GetSizeOfIDs(ids)); j++ {
+			if typeutil.IsValidPK(typeutil.GetPK(ids, j)) && keep(i, j) {
+				rows = append(rows, j)
+				topk++
+			}
+		}
+		topks = append(topks, topk)
+	}
+
+	ret := &schemapb.SearchResultData{
+		NumQueries: data.NumQueries,
+		TopK:       data.TopK,
+		Scores:     make([]float32, 0, len(rows)),
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: make([]int64, 0, len(rows)),
+				},
+			},
+		},
+		Topks: topks,
+	}
+	for _, idx := range rows {
+		typeutil.AppendPKs(ret.Ids, typeutil.GetPK(ids, idx))
+		ret.Scores = append(ret.Scores, data.Scores[idx])
+	}
+	if len(rows) > 0 {
+		ret.FieldsData = typeutil.SelectFieldData(data.FieldsData, rows)
+	}
+	return ret
+}
+
+// filterSearchResultDataByRange drops the hits out of the range of a range search. The scores of the distance metrics
+// are negated, so range_filter <= distance < radius turns into -radius < score <= -range_filter
+func filterSearchResultDataByRange(data *schemapb.SearchResultData, queryInfo *planpb.QueryInfo) *schemapb.SearchResultData {
+	lower, upper := queryInfo.Radius, queryInfo.RangeFilter
+	if strings.ToUpper(queryInfo.MetricType) != "IP" {
+		lower, upper = -queryInfo.Radius, -queryInfo.RangeFilter
+	}
+	return filterSearchResultData(data, func(qi int64, idx int64) bool {
+		score := float64(data.Scores[idx])
+		return score > lower && score <= upper
+	})
+}
+
+// groupSearchResultData keeps the best hit of each of the first groupTopK distinct values of the group by field
+func groupSearchResultData(data *schemapb.SearchResultData, groupByField *schemapb.FieldData, groupTopK int64) *schemapb.SearchResultData {
+	groups := make([]map[interface{}]struct{}, data.NumQueries)
+	return filterSearchResultData(data, func(qi int64, idx int64) bool {
+		if groups[qi] == nil {
+			groups[qi] = make(map[interface{}]struct{})
+		}
+		if int64(len(groups[qi])) >= groupTopK {
+			return false
+		}
+		value := typeutil.GetScalarFieldValue(groupByField, idx)
+		if _, ok := groups[qi][value]; ok {
+			return false
+		}
+		groups[qi][value] = struct{}{}
+		return true
+	})
+}
+
+// maxSearchTopK is the max number of candidates of each query searched in the segments
+const maxSearchTopK = 16384
+
+// groupSearchResultDataByField groups the hits by the group by field of queryInfo, which is one of the output fields
+func groupSearchResultDataByField(data *schemapb.SearchResultData, outputFieldIDs []int64, queryInfo *planpb.QueryInfo) (*schemapb.SearchResultData, error) {
+	if typeutil.GetSizeOfIDs(data.Ids) == 0 {
+		return data, nil
+	}
+	for i, fieldID := range outputFieldIDs {
+		if fieldID == queryInfo.GroupByFieldId && i < len(data.FieldsData) {
+			return groupSearchResultData(data, data.FieldsData[i], queryInfo.GroupTopk), nil
+		}
+	}
+	return nil, fmt.Errorf("group by field %d is not in the output fields", queryInfo.GroupByFieldId)
+}
+
 // TODO:: cache map[dsl]plan
 // TODO: reBatched search requests
 func (q *queryCollection) search(msg queryMsg) error {
@@ -877,7 +1101,6 @@ func (q *queryCollection) search(msg queryMsg) error {
 	defer sp.Finish()
 	searchMsg.SetTraceCtx(ctx)
 	searchTimestamp := searchMsg.BeginTs()
-	travelTimestamp := searchMsg.TravelTimestamp
 
 	collection, err := q.streaming.replica.getCollectionByID(searchMsg.CollectionID)
 	if err != nil {
@@ -890,12 +1113,17 @@ func (q *queryCollection) search(msg queryMsg) error {
 	}
 
 	var plan *SearchPlan
+	var planNode *planpb.PlanNode
 	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
 		expr := searchMsg.SerializedExprPlan
 		plan, err = createSearchPlanByExpr(collection, expr)
 		if err != nil {
 			return err
 		}
+		planNode = &planpb.PlanNode{}
+		if err = proto.Unmarshal(expr, planNode); err != nil {
+			return err
+		}
 	} else {
 		dsl := searchMsg.Dsl
 		plan, err = createSearchPlan(collection, dsl)
@@ -903,11 +1131,12 @@ func (q *queryCollection) search(msg queryMsg) error {
 			return err
 		}
 	}
+	queryInfo := planNode.GetVectorAnns().GetQueryInfo()
 	topK := plan.getTopK()
 	if topK == 0 {
 		return fmt.Errorf("limit must be greater than 0")
 	}
-	if topK >= 16385 {
+	if topK > maxSearchTopK {
 		return fmt.Errorf("limit %d is too large", topK)
 	}
 	searchRequestBlob := searchMsg.PlaceholderGroup
@@ -916,8 +1145,6 @@ func (q *queryCollection) search(msg queryMsg) error {
 		return err
 	}
 	queryNum := searchReq.getNumOfQuery()
-	searchRequests := make([]*searchRequest, 0)
-	searchRequests = append(searchRequests, searchReq)
 
 	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
 		sp.LogFields(oplog.String("statistical time", "stats start"),
@@ -939,177 +1166,194 @@ func (q *queryCollection) search(msg queryMsg) error {
 		globalSealedSegments = q.historical.getGlobalSegmentIDsByCollectionID(collection.id)
 	}
 
+	metricType := plan.getMetricType()
+	transformed, sealedSegmentSearched, err := q.searchSegments(collection, schema, searchMsg, plan, searchReq, queryInfo, tr)
+	if err != nil {
+		return err
+	}
+	plan.delete()
+	searchReq.delete()
+
+	if transformed != nil && queryInfo.GetGroupByFieldId() != 0 {
+		// the best hits may crowd into a few groups, the candidates are doubled until each query finds GroupTopk
+		// groups, or the candidates run out
+		candidates := topK
+		for {
+			grouped, err := groupSearchResultDataByField(transformed, searchMsg.OutputFieldsId, queryInfo)
+			if err != nil {
+				return err
+			}
+			if candidates >= maxSearchTopK || !searchGroupsUnderfilled(transformed, grouped, queryInfo.GroupTopk) {
+				// the groups are reduced by the proxy with the results of the other query nodes on the same topk
+				grouped.TopK = topK
+				transformed = grouped
+				break
+			}
+			candidates *= 2
+			if candidates > maxSearchTopK {
+				candidates = maxSearchTopK
+			}
+			queryInfo.Topk = candidates
+			expr, err := proto.Marshal(planNode)
+			if err != nil {
+				return err
+			}
+			plan, err = createSearchPlanByExpr(collection, expr)
+			if err != nil {
+				return err
+			}
+			searchReq, err = parseSearchRequest(plan, searchRequestBlob)
+			if err != nil {
+				plan.delete()
+				return err
+			}
+			transformed, sealedSegmentSearched, err = q.searchSegments(collection, schema, searchMsg, plan, searchReq, queryInfo, tr)
+			plan.delete()
+			searchReq.delete()
+			if err != nil {
+				return err
+			}
+			if transformed == nil {
+				break
+			}
+			tr.Record(fmt.Sprintf("search %d candidates for the groups", candidates))
+		}
+	}
+
+	var byteBlobs []byte
+	if transformed != nil {
+		if byteBlobs, err = proto.Marshal(transformed); err != nil {
+			return err
+		}
+	}
+
+	resultChannelInt := 0
+	searchResultMsg := &msgstream.SearchResultMsg{
+		BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
+		SearchResults: internalpb.SearchResults{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_SearchResult,
+				MsgID:     searchMsg.Base.MsgID,
+				Timestamp: searchTimestamp,
+				SourceID:  searchMsg.Base.SourceID,
+			},
+			Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			ResultChannelID:          searchMsg.ResultChannelID,
+			MetricType:               metricType,
+			NumQueries:               queryNum,
+			TopK:                     topK,
+			SlicedBlob:               byteBlobs,
+			SlicedOffset:             1,
+			SlicedNumCount:           1,
+			SealedSegmentIDsSearched: sealedSegmentSearched,
+			ChannelIDsSearched:       collection.getVChannels(),
+			GlobalSealedSegmentIDs:   globalSealedSegments,
+		},
+	}
+	log.Debug("QueryNode SearchResultMsg",
+		zap.Any("collectionID", collection.id),
+		zap.Any("msgID", searchMsg.ID()),
+		zap.Any("vChannels", collection.getVChannels()),
+		zap.Any("sealedSegmentSearched", sealedSegmentSearched),
+	)
+	err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
+	if err != nil {
+		return err
+	}
+	tr.Record("publish search result")
+	tr.Elapse("all done")
+	return nil
+}
+
+// searchSegments searches the historical and the streaming segments of the collection, and returns the reduced hits of
+// the queries, which is nil if no segment is searched
+func (q *queryCollection) searchSegments(collection *Collection, schema *typeutil.SchemaHelper, searchMsg *msgstream.SearchMsg,
+	plan *SearchPlan, searchReq *searchRequest, queryInfo *planpb.QueryInfo, tr *timerecord.TimeRecorder) (*schemapb.SearchResultData, []UniqueID, error) {
+	travelTimestamp := searchMsg.TravelTimestamp
+	searchRequests := []*searchRequest{searchReq}
 	searchResults := make([]*SearchResult, 0)
+	defer func() {
+		deleteSearchResults(searchResults)
+	}()
 
 	// historical search
 	hisSearchResults, sealedSegmentSearched, err := q.historical.search(searchRequests, collection.id, searchMsg.PartitionIDs, plan, travelTimestamp)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	searchResults = append(searchResults, hisSearchResults...)
 	tr.Record("historical search done")
 
 	for _, channel := range collection.getVChannels() {
-		var strSearchResults []*SearchResult
 		strSearchResults, err := q.streaming.search(searchRequests, collection.id, searchMsg.PartitionIDs, channel, plan, travelTimestamp)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		searchResults = append(searchResults, strSearchResults...)
 	}
 	tr.Record("streaming search done")
 
-	sp.LogFields(oplog.String("statistical time", "segment search end"))
 	if len(searchResults) <= 0 {
-		for range searchRequests {
-			resultChannelInt := 0
-			searchResultMsg := &msgstream.SearchResultMsg{
-				BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-				SearchResults: internalpb.SearchResults{
-					Base: &commonpb.MsgBase{
-						MsgType:   commonpb.MsgType_SearchResult,
-						MsgID:     searchMsg.Base.MsgID,
-						Timestamp: searchTimestamp,
-						SourceID:  searchMsg.Base.SourceID,
-					},
-					Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-					ResultChannelID:          searchMsg.ResultChannelID,
-					MetricType:               plan.getMetricType(),
-					NumQueries:               queryNum,
-					TopK:                     topK,
-					SlicedBlob:               nil,
-					SlicedOffset:             1,
-					SlicedNumCount:           1,
-					SealedSegmentIDsSearched: sealedSegmentSearched,
-					ChannelIDsSearched:       collection.getVChannels(),
-					GlobalSealedSegmentIDs:   globalSealedSegments,
-				},
-			}
-			log.Debug("QueryNode Empty SearchResultMsg",
-				zap.Any("collectionID", collection.id),
-				zap.Any("msgID", searchMsg.ID()),
-				zap.Any("vChannels", collection.getVChannels()),
-				zap.Any("sealedSegmentSearched", sealedSegmentSearched),
-			)
-			err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
-			if err != nil {
-				return err
-			}
-			tr.Record("publish empty search result done")
-			tr.Elapse("all done")
-			return nil
-		}
+		log.Debug("QueryNode Empty SearchResultMsg",
+			zap.Any("collectionID", collection.id),
+			zap.Any("msgID", searchMsg.ID()),
+			zap.Any("vChannels", collection.getVChannels()),
+			zap.Any("sealedSegmentSearched", sealedSegmentSearched),
+		)
+		return nil, sealedSegmentSearched, nil
 	}
 
 	numSegment := int64(len(searchResults))
-	var marshaledHits *MarshaledHits = nil
 	err = reduceSearchResultsAndFillData(plan, searchResults, numSegment)
-	sp.LogFields(oplog.String("statistical time", "reduceSearchResults end"))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	marshaledHits, err = reorganizeSearchResults(searchResults, numSegment)
-	sp.LogFields(oplog.String("statistical time", "reorganizeSearchResults end"))
+	marshaledHits, err := reorganizeSearchResults(searchResults, numSegment)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
+	defer deleteMarshaledHits(marshaledHits)
 
 	hitsBlob, err := marshaledHits.getHitsBlob()
-	sp.LogFields(oplog.String("statistical time", "getHitsBlob end"))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	tr.Record("reduce result done")
 
-	var offset int64 = 0
-	for index := range searchRequests {
-		hitBlobSizePeerQuery, err := marshaledHits.hitBlobSizeInGroup(int64(index))
-		if err != nil {
-			return err
-		}
-		hits := make([][]byte, len(hitBlobSizePeerQuery))
-		for i, len := range hitBlobSizePeerQuery {
-			hits[i] = hitsBlob[offset : offset+len]
-			//test code to checkout marshaled hits
-			//marshaledHit := hitsBlob[offset:offset+len]
-			//unMarshaledHit := milvuspb.Hits{}
-			//err = proto.Unmarshal(marshaledHit, &unMarshaledHit)
-			//if err != nil {
-			//	return err
-			//}
-			//log.Debug("hits msg  = ", unMarshaledHit)
-			offset += len
-		}
-
-		// TODO: remove inefficient code in cgo and use SearchResultData directly
-		// TODO: Currently add a translate layer from hits to SearchResultData
-		// TODO: hits marshal and unmarshal is likely bottleneck
-
-		transformed, err := translateHits(schema, searchMsg.OutputFieldsId, hits)
-		if err != nil {
-			return err
-		}
-		byteBlobs, err := proto.Marshal(transformed)
-		if err != nil {
-			return err
-		}
+	hitBlobSizePeerQuery, err := marshaledHits.hitBlobSizeInGroup(0)
+	if err != nil {
+		return nil, nil, err
+	}
+	hits := make([][]byte, len(hitBlobSizePeerQuery))
+	var offset int64
+	for i, size := range hitBlobSizePeerQuery {
+		hits[i] = hitsBlob[offset : offset+size]
+		offset += size
+	}
 
-		resultChannelInt := 0
-		searchResultMsg := &msgstream.SearchResultMsg{
-			BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-			SearchResults: internalpb.SearchResults{
-				Base: &commonpb.MsgBase{
-					MsgType:   commonpb.MsgType_SearchResult,
-					MsgID:     searchMsg.Base.MsgID,
-					Timestamp: searchTimestamp,
-					SourceID:  searchMsg.Base.SourceID,
-				},
-				Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-				ResultChannelID:          searchMsg.ResultChannelID,
-				MetricType:               plan.getMetricType(),
-				NumQueries:               queryNum,
-				TopK:                     topK,
-				SlicedBlob:               byteBlobs,
-				SlicedOffset:             1,
-				SlicedNumCount:           1,
-				SealedSegmentIDsSearched: sealedSegmentSearched,
-				ChannelIDsSearched:       collection.getVChannels(),
-				GlobalSealedSegmentIDs:   globalSealedSegments,
-			},
-		}
-		log.Debug("QueryNode SearchResultMsg",
-			zap.Any("collectionID", collection.id),
-			zap.Any("msgID", searchMsg.ID()),
-			zap.Any("vChannels", collection.getVChannels()),
-			zap.Any("sealedSegmentSearched", sealedSegmentSearched),
-		)
+	// TODO: remove inefficient code in cgo and use SearchResultData directly
+	// TODO: Currently add a translate layer from hits to SearchResultData
+	// TODO: hits marshal and unmarshal is likely bottleneck
+	transformed, err := translateHits(schema, searchMsg.OutputFieldsId, hits)
+	if err != nil {
+		return nil, nil, err
+	}
+	if queryInfo.GetRangeSearch() {
+		transformed = filterSearchResultDataByRange(transformed, queryInfo)
+	}
+	return transformed, sealedSegmentSearched, nil
+}
 
-		// For debugging, please don't delete.
-		//fmt.Println("==================== search result ======================")
-		//for i := 0; i < len(hits); i++ {
-		//	testHits := milvuspb.Hits{}
-		//	err := proto.Unmarshal(hits[i], &testHits)
-		//	if err != nil {
-		//		panic(err)
-		//	}
-		//	fmt.Println(testHits.IDs)
-		//	fmt.Println(testHits.Scores)
-		//}
-		err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
-		if err != nil {
-			return err
+// searchGroupsUnderfilled tells whether a query of the grouped search finds fewer than groupTopK groups while all its
+// candidates are valid hits, where searching more candidates may find more groups
+func searchGroupsUnderfilled(candidates *schemapb.SearchResultData, grouped *schemapb.SearchResultData, groupTopK int64) bool {
+	valid := filterSearchResultData(candidates, func(qi int64, idx int64) bool { return true })
+	for qi := int64(0); qi < grouped.NumQueries; qi++ {
+		if grouped.Topks[qi] < groupTopK && valid.Topks[qi] >= candidates.TopK {
+			return true
 		}
-		tr.Record("publish search result")
 	}
-
-	sp.LogFields(oplog.String("statistical time", "before free c++ memory"))
-	deleteSearchResults(searchResults)
-	deleteMarshaledHits(marshaledHits)
-	sp.LogFields(oplog.String("statistical time", "stats done"))
-	plan.delete()
-	searchReq.delete()
-	tr.Elapse("all done")
-	return nil
+	return false
 }
 
 func (q *queryCollection) retrieve(msg queryMsg) error {
@@ -1131,13 +1375,6 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		return err
 	}
 
-	expr := retrieveMsg.SerializedExprPlan
-	plan, err := createRetrievePlanByExpr(collection, expr, timestamp)
-	if err != nil {
-		return err
-	}
-	defer plan.delete()
-
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("retrieve %d", retrieveMsg.CollectionID))
 
 	var globalSealedSegments []UniqueID
@@ -1147,8 +1384,6 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		globalSealedSegments = q.historical.getGlobalSegmentIDsByCollectionID(collectionID)
 	}
 
-	var mergeList []*segcorepb.RetrieveResults
-
 	if q.vectorChunkManager == nil {
 		if q.localChunkManager == nil {
 			return fmt.Errorf("can not create vector chunk manager for local chunk manager is nil")
@@ -1163,26 +1398,61 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 			}, q.localCacheEnabled)
 	}
 
-	// historical retrieve
-	hisRetrieveResults, sealedSegmentRetrieved, err := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, q.vectorChunkManager, plan)
-	if err != nil {
-		return err
+	expr := retrieveMsg.SerializedExprPlan
+	if retrieveMsg.Limit > 0 && len(retrieveMsg.Aggregates) == 0 {
+		// pick the rows of the page on the primary key and the order by field only, the output fields are then
+		// retrieved for these rows, so that a small limit does not pay for the output fields of the whole match set
+		keysExpr, err := retrieveKeysExpr(collection.schema, expr, retrieveMsg.Limit, retrieveMsg.OrderByFieldID, retrieveMsg.Descending)
+		if err != nil {
+			return err
+		}
+		// the rows beyond the limit are truncated in each segment by segcore
+		keysList, _, err := q.retrieveSegments(collection, retrieveMsg.PartitionIDs, keysExpr, timestamp)
+		if err != nil {
+			return err
+		}
+		keys, err := mergeRetrieveResults(keysList)
+		if err != nil {
+			return err
+		}
+		keys, err = limitRetrieveResults(keys, retrieveMsg.Limit, retrieveMsg.OrderByFieldID, retrieveMsg.Descending)
+		if err != nil {
+			return err
+		}
+		expr, err = retrieveByPKsExpr(collection.schema, expr, keys.GetIds())
+		if err != nil {
+			return err
+		}
+		tr.Record("retrieve keys done")
 	}
-	mergeList = append(mergeList, hisRetrieveResults...)
-	tr.Record("historical retrieve done")
 
-	// streaming retrieve
-	strRetrieveResults, _, err := q.streaming.retrieve(collectionID, retrieveMsg.PartitionIDs, plan)
+	mergeList, sealedSegmentRetrieved, err := q.retrieveSegments(collection, retrieveMsg.PartitionIDs, expr, timestamp)
 	if err != nil {
 		return err
 	}
-	mergeList = append(mergeList, strRetrieveResults...)
-	tr.Record("streaming retrieve done")
+	tr.Record("retrieve done")
+
+	var aggregatePartials []*schemapb.FieldData
+	if len(retrieveMsg.Aggregates) > 0 {
+		aggregatePartials, err = aggregateRetrieveResults(collection.schema, mergeList, retrieveMsg.Aggregates, retrieveMsg.GroupByFieldID)
+		if err != nil {
+			return err
+		}
+		// only the partial aggregates are returned
+		mergeList = nil
+		tr.Record("aggregate done")
+	}
 
 	result, err := mergeRetrieveResults(mergeList)
 	if err != nil {
 		return err
 	}
+	if retrieveMsg.Limit > 0 {
+		result, err = limitRetrieveResults(result, retrieveMsg.Limit, retrieveMsg.OrderByFieldID, retrieveMsg.Descending)
+		if err != nil {
+			return err
+		}
+	}
 	tr.Record("merge result done")
 
 	resultChannelInt := 0
@@ -1201,6 +1471,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 			SealedSegmentIDsRetrieved: sealedSegmentRetrieved,
 			ChannelIDsRetrieved:       collection.getVChannels(),
 			GlobalSealedSegmentIDs:    globalSealedSegments,
+			AggregatePartials:         aggregatePartials,
 		},
 	}
 
@@ -1217,10 +1488,170 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	return nil
 }
 
+// retrieveSegments retrieves the serialized plan on the historical and the streaming segments of the collection
+func (q *queryCollection) retrieveSegments(collection *Collection, partitionIDs []UniqueID, expr []byte,
+	timestamp Timestamp) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
+	plan, err := createRetrievePlanByExpr(collection, expr, timestamp)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer plan.delete()
+
+	// historical retrieve
+	hisRetrieveResults, sealedSegmentRetrieved, err := q.historical.retrieve(collection.ID(), partitionIDs, q.vectorChunkManager, plan)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// streaming retrieve
+	strRetrieveResults, _, err := q.streaming.retrieve(collection.ID(), partitionIDs, plan)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(hisRetrieveResults, strRetrieveResults...), sealedSegmentRetrieved, nil
+}
+
+// retrieveKeysExpr returns the serialized plan retrieving only the primary key and the order by field of the rows
+// matched by expr, which is all a limited retrieve needs to pick its rows, each segment keeps its first limit rows
+func retrieveKeysExpr(schema *schemapb.CollectionSchema, expr []byte, limit int64, orderByFieldID int64, descending bool) ([]byte, error) {
+	planNode := &planpb.PlanNode{}
+	if err := proto.Unmarshal(expr, planNode); err != nil {
+		return nil, err
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	pkField, err := helper.GetPrimaryKeyField()
+	if err != nil {
+		return nil, err
+	}
+	planNode.OutputFieldIds = []int64{pkField.FieldID}
+	if orderByFieldID != 0 && orderByFieldID != pkField.FieldID {
+		planNode.OutputFieldIds = append(planNode.OutputFieldIds, orderByFieldID)
+	}
+	planNode.Limit = limit
+	planNode.OrderByFieldId = orderByFieldID
+	planNode.Descending = descending
+	return proto.Marshal(planNode)
+}
+
+// retrieveByPKsExpr returns the serialized plan retrieving the output fields of expr for the rows of the primary
+// keys pks
+func retrieveByPKsExpr(schema *schemapb.CollectionSchema, expr []byte, pks *schemapb.IDs) ([]byte, error) {
+	planNode := &planpb.PlanNode{}
+	if err := proto.Unmarshal(expr, planNode); err != nil {
+		return nil, err
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	pkField, err := helper.GetPrimaryKeyField()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*planpb.GenericValue, 0, typeutil.GetSizeOfIDs(pks))
+	for i := 0; i < typeutil.GetSizeOfIDs(pks); i++ {
+		switch pk := typeutil.GetPK(pks, int64(i)).(type) {
+		case int64:
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: pk}})
+		case string:
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: pk}})
+		}
+	}
+	predicates := &planpb.Expr{
+		Expr: &planpb.Expr_TermExpr{
+			TermExpr: &planpb.TermExpr{
+				ColumnInfo: &planpb.ColumnInfo{
+					FieldId:      pkField.FieldID,
+					DataType:     pkField.DataType,
+					IsPrimaryKey: true,
+					IsAutoID:     pkField.AutoID,
+				},
+				Values: values,
+			},
+		},
+	}
+	// keep the original predicates, a primary key may have several versions in a segment
+	if expr := planNode.GetPredicates(); expr != nil {
+		predicates = &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Op:    planpb.BinaryExpr_LogicalAnd,
+					Left:  predicates,
+					Right: expr,
+				},
+			},
+		}
+	}
+	planNode.Node = &planpb.PlanNode_Predicates{Predicates: predicates}
+	return proto.Marshal(planNode)
+}
+
+// aggregateRetrieveResults computes the partial aggregates of the retrieve results, the retrieve results have been
+// filtered by the deletions and the travel timestamp in segcore, and a primary key retrieved from several segments is
+// aggregated only once
+func aggregateRetrieveResults(schema *schemapb.CollectionSchema, retrieveResults []*segcorepb.RetrieveResults,
+	aggregates []*internalpb.Aggregate, groupByFieldID int64) ([]*schemapb.FieldData, error) {
+	aggregator, err := typeutil.NewAggregator(schema, aggregates, groupByFieldID)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := mergeRetrieveResults(retrieveResults)
+	if err != nil {
+		return nil, err
+	}
+	if err := aggregator.AddRows(merged.GetFieldsData()); err != nil {
+		return nil, err
+	}
+	return aggregator.Partials(), nil
+}
+
+// limitRetrieveResults keeps the first limit rows of the retrieve result, ordered by the field of orderByFieldID,
+// or by primary key if orderByFieldID is 0
+func limitRetrieveResults(rr *segcorepb.RetrieveResults, limit int64, orderByFieldID int64, descending bool) (*segcorepb.RetrieveResults, error) {
+	pks := rr.GetIds()
+	if int64(typeutil.GetSizeOfIDs(pks)) <= limit {
+		return rr, nil
+	}
+
+	var orderBy *schemapb.FieldData
+	if orderByFieldID != 0 {
+		for _, fieldData := range rr.FieldsData {
+			if fieldData.FieldId == orderByFieldID {
+				orderBy = fieldData
+			}
+		}
+		if orderBy == nil {
+			return nil, fmt.Errorf("order by field %d is not retrieved", orderByFieldID)
+		}
+	}
+
+	rows, err := typeutil.SortRows(pks, orderBy, descending)
+	if err != nil {
+		return nil, err
+	}
+	rows = rows[:limit]
+
+	ret := &segcorepb.RetrieveResults{
+		Ids:        &schemapb.IDs{},
+		FieldsData: typeutil.SelectFieldData(rr.FieldsData, rows),
+	}
+	for _, idx := range rows {
+		typeutil.AppendPKs(ret.Ids, typeutil.GetPK(pks, idx))
+		if len(rr.Offset) == typeutil.GetSizeOfIDs(pks) {
+			ret.Offset = append(ret.Offset, rr.Offset[idx])
+		}
+	}
+	return ret, nil
+}
+
 func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
 	var ret *segcorepb.RetrieveResults
 	var skipDupCnt int64 = 0
-	var idSet = make(map[int64]struct{})
+	var idSet = make(map[interface{}]struct{})
 
 	// merge results and remove duplicates
 	for _, rr := range retrieveResults {
@@ -1246,10 +1677,10 @@ func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcor
 			return nil, fmt.Errorf("mismatch FieldData in RetrieveResults")
 		}
 
-		dstIds := ret.Ids.GetIntId()
-		for i, id := range rr.Ids.GetIntId().GetData() {
+		for i := 0; i < typeutil.GetSizeOfIDs(rr.Ids); i++ {
+			id := typeutil.GetPK(rr.Ids, int64(i))
 			if _, ok := idSet[id]; !ok {
-				dstIds.Data = append(dstIds.Data, id)
+				typeutil.AppendPKs(ret.Ids, id)
 				typeutil.AppendFieldData(ret.FieldsData, rr.FieldsData, int64(i))
 				idSet[id] = struct{}{}
 			} else {
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
@@ -152,13 +153,13 @@ func TestQueryCollection_withoutVChannel(t *testing.T) {
 	timeStamp := []int64{0, 1}
 	age := []int64{10, 20}
 	vectorData := []float32{1, 2, 3, 4}
-	err = segment.segmentLoadFieldData(0, N, rowID)
+	err = segment.segmentLoadFieldData(0, N, rowID, nil)
 	assert.Nil(t, err)
-	err = segment.segmentLoadFieldData(1, N, timeStamp)
+	err = segment.segmentLoadFieldData(1, N, timeStamp, nil)
 	assert.Nil(t, err)
-	err = segment.segmentLoadFieldData(101, N, age)
+	err = segment.segmentLoadFieldData(101, N, age, nil)
 	assert.Nil(t, err)
-	err = segment.segmentLoadFieldData(100, N, vectorData)
+	err = segment.segmentLoadFieldData(100, N, vectorData, nil)
 	assert.Nil(t, err)
 
 	//create a streaming
@@ -446,6 +447,44 @@ func TestQueryCollection_TranslateHits(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("test nullable field", func(t *testing.T) {
+		field := genConstantField(constFieldParam{
+			id:       fieldID,
+			dataType: schemapb.DataType_Int64,
+		})
+		field.Nullable = true
+		schemaHelper, err := typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{
+			Name:   defaultCollectionName,
+			AutoID: true,
+			Fields: []*schemapb.FieldSchema{field},
+		})
+		assert.NoError(t, err)
+
+		// the value of a nullable field is led by a validity byte
+		rawData := make([][]byte, 0)
+		for i, valid := range []bool{true, false} {
+			var buf bytes.Buffer
+			err := binary.Write(&buf, common.Endian, int64(0))
+			assert.NoError(t, err)
+			err = binary.Write(&buf, common.Endian, valid)
+			assert.NoError(t, err)
+			err = binary.Write(&buf, common.Endian, int64(i))
+			assert.NoError(t, err)
+			rawData = append(rawData, buf.Bytes())
+		}
+		rawHit, err := proto.Marshal(&milvuspb.Hits{
+			IDs:     []int64{0, 1},
+			Scores:  []float32{0, 0},
+			RowData: rawData,
+		})
+		assert.NoError(t, err)
+
+		result, err := translateHits(schemaHelper, fieldIDs, [][]byte{rawHit})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{0, 1}, result.FieldsData[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []bool{true, false}, result.FieldsData[0].GetValidData())
+	})
+
 	t.Run("test field with error type", func(t *testing.T) {
 		dataType := schemapb.DataType_FloatVector
 		_, err := translateHits(genSchema(dataType), fieldIDs, genRawHits(dataType))
@@ -544,6 +583,18 @@ func TestQueryCollection_mergeRetrieveResults(t *testing.T) {
 
 	_, err = mergeRetrieveResults(nil)
 	assert.NoError(t, err)
+
+	strResult := func(pks ...string) *segcorepb.RetrieveResults {
+		return &segcorepb.RetrieveResults{
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: pks}}},
+			Offset:     []int64{0, 1},
+			FieldsData: []*schemapb.FieldData{genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, Int64Array[0:2], 1)},
+		}
+	}
+	result, err = mergeRetrieveResults([]*segcorepb.RetrieveResults{strResult("a", "b"), strResult("b", "c")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, result.Ids.GetStrId().GetData())
+	assert.Equal(t, []int64{11, 22, 22}, result.FieldsData[0].GetScalars().GetLongData().Data)
 }
 
 func TestQueryCollection_doUnsolvedQueryMsg(t *testing.T) {
@@ -780,3 +831,254 @@ func TestQueryCollection_search_while_release(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func TestQueryCollection_limitRetrieveResults(t *testing.T) {
+	const (
+		Int64FieldName = "Int64Field"
+		Int64FieldID   = common.StartOfUserFieldID + 1
+	)
+	result := &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: []int64{3, 1, 2, 0},
+				},
+			},
+		},
+		Offset: []int64{0, 1, 2, 3},
+		FieldsData: []*schemapb.FieldData{
+			genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{10, 40, 30, 20}, 1),
+		},
+	}
+
+	// ordered by primary key
+	ret, err := limitRetrieveResults(result, 2, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 1}, ret.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{3, 1}, ret.Offset)
+	assert.Equal(t, []int64{20, 40}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+
+	// ordered by field
+	ret, err = limitRetrieveResults(result, 3, Int64FieldID, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 0}, ret.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{40, 30, 20}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+
+	// no more than limit rows
+	ret, err = limitRetrieveResults(result, 4, Int64FieldID, false)
+	assert.NoError(t, err)
+	assert.Equal(t, result, ret)
+
+	// order by field not retrieved
+	_, err = limitRetrieveResults(result, 2, Int64FieldID+1, false)
+	assert.Error(t, err)
+}
+
+func TestQueryCollection_retrieveLimitExpr(t *testing.T) {
+	schema := genSimpleSegCoreSchema()
+	expr, err := genSimpleRetrievePlanExpr()
+	assert.NoError(t, err)
+
+	// only the primary key and the order by field are retrieved to pick the rows
+	keysExpr, err := retrieveKeysExpr(schema, expr, 10, simpleConstField.id, true)
+	assert.NoError(t, err)
+	planNode := &planpb.PlanNode{}
+	assert.NoError(t, proto.Unmarshal(keysExpr, planNode))
+	assert.Equal(t, []int64{simplePKField.id, simpleConstField.id}, planNode.OutputFieldIds)
+	assert.NotNil(t, planNode.GetPredicates().GetTermExpr())
+	// the limit is pushed down to the segments
+	assert.Equal(t, int64(10), planNode.Limit)
+	assert.Equal(t, simpleConstField.id, planNode.OrderByFieldId)
+	assert.True(t, planNode.Descending)
+
+	keysExpr, err = retrieveKeysExpr(schema, expr, 10, 0, false)
+	assert.NoError(t, err)
+	planNode = &planpb.PlanNode{}
+	assert.NoError(t, proto.Unmarshal(keysExpr, planNode))
+	assert.Equal(t, []int64{simplePKField.id}, planNode.OutputFieldIds)
+	assert.Equal(t, int64(0), planNode.OrderByFieldId)
+
+	// the output fields are retrieved for the picked primary keys only
+	pks := &schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: []int64{5, 7},
+			},
+		},
+	}
+	pksExpr, err := retrieveByPKsExpr(schema, expr, pks)
+	assert.NoError(t, err)
+	planNode = &planpb.PlanNode{}
+	assert.NoError(t, proto.Unmarshal(pksExpr, planNode))
+	assert.Equal(t, []int64{simpleConstField.id}, planNode.OutputFieldIds)
+	binaryExpr := planNode.GetPredicates().GetBinaryExpr()
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binaryExpr.GetOp())
+	termExpr := binaryExpr.GetLeft().GetTermExpr()
+	assert.Equal(t, simplePKField.id, termExpr.GetColumnInfo().GetFieldId())
+	assert.True(t, termExpr.GetColumnInfo().GetIsPrimaryKey())
+	assert.Equal(t, 2, len(termExpr.GetValues()))
+	assert.Equal(t, int64(7), termExpr.GetValues()[1].GetInt64Val())
+	assert.Equal(t, simpleConstField.id, binaryExpr.GetRight().GetTermExpr().GetColumnInfo().GetFieldId())
+
+	_, err = retrieveKeysExpr(schema, []byte{1}, 10, 0, false)
+	assert.Error(t, err)
+}
+
+func TestQueryCollection_aggregateRetrieveResults(t *testing.T) {
+	const (
+		Int64FieldName = "Int64Field"
+		Int64FieldID   = common.StartOfUserFieldID + 1
+		Int32FieldName = "Int32Field"
+		Int32FieldID   = common.StartOfUserFieldID + 2
+	)
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.StartOfUserFieldID, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: Int64FieldID, Name: Int64FieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: Int32FieldID, Name: Int32FieldName, DataType: schemapb.DataType_Int32},
+		},
+	}
+	genResult := func(pks []int64, values []int64, groups []int32) *segcorepb.RetrieveResults {
+		return &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: pks,
+					},
+				},
+			},
+			Offset: pks,
+			FieldsData: []*schemapb.FieldData{
+				genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, values, 1),
+				genFieldData(Int32FieldName, Int32FieldID, schemapb.DataType_Int32, groups, 1),
+			},
+		}
+	}
+	results := []*segcorepb.RetrieveResults{
+		genResult([]int64{1, 2, 3}, []int64{10, 40, 30}, []int32{1, 2, 1}),
+		genResult([]int64{4}, []int64{20}, []int32{2}),
+		genResult([]int64{}, []int64{}, []int32{}),
+	}
+	aggregates := []*internalpb.Aggregate{
+		{Op: internalpb.AggregateOp_Count},
+		{Op: internalpb.AggregateOp_Max, FieldID: Int64FieldID},
+	}
+
+	partials, err := aggregateRetrieveResults(schema, results, aggregates, Int32FieldID)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(partials))
+	assert.Equal(t, []int32{1, 2}, partials[0].GetScalars().GetIntData().Data)
+	assert.Equal(t, []int64{2, 2}, partials[1].GetScalars().GetLongData().Data)
+	assert.Equal(t, []int64{2, 2}, partials[2].GetScalars().GetLongData().Data)
+	assert.Equal(t, []int64{30, 40}, partials[3].GetScalars().GetLongData().Data)
+
+	partials, err = aggregateRetrieveResults(schema, results, aggregates, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(partials))
+	assert.Equal(t, []int64{4}, partials[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []int64{40}, partials[2].GetScalars().GetLongData().Data)
+
+	// the primary keys retrieved from several segments are aggregated once
+	duplicated := append(results, genResult([]int64{2, 5}, []int64{40, 50}, []int32{2, 1}))
+	partials, err = aggregateRetrieveResults(schema, duplicated, []*internalpb.Aggregate{
+		{Op: internalpb.AggregateOp_Count},
+		{Op: internalpb.AggregateOp_Sum, FieldID: Int64FieldID},
+	}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{5}, partials[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []int64{150}, partials[2].GetScalars().GetLongData().Data)
+
+	// aggregated field not retrieved
+	_, err = aggregateRetrieveResults(schema, results, []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Sum, FieldID: common.StartOfUserFieldID}}, 0)
+	assert.Error(t, err)
+}
+
+func TestQueryCollection_filterSearchResultDataByRange(t *testing.T) {
+	const (
+		Int64FieldName = "Int64Field"
+		Int64FieldID   = common.StartOfUserFieldID + 1
+	)
+	// scores of L2 are negated distances
+	data := &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       3,
+		Scores:     []float32{-0.5, -1.0, -2.0, -1.5, -3.0, 0},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: []int64{1, 2, 3, 4, 5, -1},
+				},
+			},
+		},
+		FieldsData: []*schemapb.FieldData{
+			genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{10, 20, 30, 40, 50, 0}, 1),
+		},
+	}
+
+	ret := filterSearchResultDataByRange(data, &planpb.QueryInfo{MetricType: "L2", RangeSearch: true, Radius: 2.0, RangeFilter: 0.8})
+	assert.Equal(t, int64(3), ret.TopK)
+	assert.Equal(t, []int64{1, 1}, ret.Topks)
+	assert.Equal(t, []int64{2, 4}, ret.Ids.GetIntId().Data)
+	assert.Equal(t, []float32{-1.0, -1.5}, ret.Scores)
+	assert.Equal(t, []int64{20, 40}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+
+	ret = filterSearchResultDataByRange(data, &planpb.QueryInfo{MetricType: "IP", RangeSearch: true, Radius: -1.5, RangeFilter: 1.0})
+	assert.Equal(t, []int64{2, 0}, ret.Topks)
+	assert.Equal(t, []int64{1, 2}, ret.Ids.GetIntId().Data)
+
+	ret = filterSearchResultDataByRange(data, &planpb.QueryInfo{MetricType: "IP", RangeSearch: true, Radius: 1.0, RangeFilter: 2.0})
+	assert.Equal(t, []int64{0, 0}, ret.Topks)
+	assert.Equal(t, 0, len(ret.Ids.GetIntId().Data))
+	assert.Nil(t, ret.FieldsData)
+}
+
+func TestQueryCollection_groupSearchResultData(t *testing.T) {
+	const (
+		Int64FieldName = "Int64Field"
+		Int64FieldID   = common.StartOfUserFieldID + 1
+	)
+	data := &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       3,
+		Scores:     []float32{-0.5, -1.0, -2.0, -1.5, -3.0, 0},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: []int64{1, 2, 3, 4, 5, -1},
+				},
+			},
+		},
+		FieldsData: []*schemapb.FieldData{
+			genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{10, 10, 20, 30, 30, 0}, 1),
+		},
+	}
+
+	queryInfo := &planpb.QueryInfo{GroupByFieldId: Int64FieldID, GroupTopk: 2}
+	ret, err := groupSearchResultDataByField(data, []int64{Int64FieldID}, queryInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 1}, ret.Topks)
+	assert.Equal(t, []int64{1, 3, 4}, ret.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{10, 20, 30}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+
+	queryInfo.GroupTopk = 1
+	ret, err = groupSearchResultDataByField(data, []int64{Int64FieldID}, queryInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 1}, ret.Topks)
+	assert.Equal(t, []int64{1, 4}, ret.Ids.GetIntId().Data)
+
+	_, err = groupSearchResultDataByField(data, []int64{Int64FieldID + 1}, queryInfo)
+	assert.Error(t, err)
+
+	// the second query runs out of candidates, and the first query fills its groups
+	queryInfo.GroupTopk = 2
+	ret, err = groupSearchResultDataByField(data, []int64{Int64FieldID}, queryInfo)
+	assert.NoError(t, err)
+	assert.False(t, searchGroupsUnderfilled(data, ret, queryInfo.GroupTopk))
+
+	// the first query finds 2 groups out of its 3 candidates, more candidates may find the third group
+	queryInfo.GroupTopk = 3
+	ret, err = groupSearchResultDataByField(data, []int64{Int64FieldID}, queryInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 1}, ret.Topks)
+	assert.True(t, searchGroupsUnderfilled(data, ret, queryInfo.GroupTopk))
+}
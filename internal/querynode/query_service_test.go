@@ -43,16 +43,16 @@ func loadFields(segment *Segment, DIM int, N int) error {
 		ages[i] = int32(N)
 	}
 
-	err := segment.segmentLoadFieldData(vectorFieldID, N, vectors)
+	err := segment.segmentLoadFieldData(vectorFieldID, N, vectors, nil)
 	if err != nil {
 		return err
 	}
-	err = segment.segmentLoadFieldData(agesFieldID, N, ages)
+	err = segment.segmentLoadFieldData(agesFieldID, N, ages, nil)
 	if err != nil {
 		return err
 	}
 	rowIDs := ages
-	err = segment.segmentLoadFieldData(rowIDFieldID, N, rowIDs)
+	err = segment.segmentLoadFieldData(rowIDFieldID, N, rowIDs, nil)
 	return err
 }
 
@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type segmentType int32
@@ -67,6 +68,23 @@ func newVectorFieldInfo(fieldBinlog *datapb.FieldBinlog) *VectorFieldInfo {
 	}
 }
 
+// variableLengthData is the column of a variable-length field loaded into segcore, the bytes of the values are back to
+// back in data, and the i-th value takes data[offsets[i]:offsets[i+1]]
+type variableLengthData struct {
+	offsets []int64
+	data    []byte
+}
+
+func newVariableLengthData(rowCount int) *variableLengthData {
+	offsets := make([]int64, 1, rowCount+1)
+	return &variableLengthData{offsets: offsets}
+}
+
+func (d *variableLengthData) append(value []byte) {
+	d.data = append(d.data, value...)
+	d.offsets = append(d.offsets, int64(len(d.data)))
+}
+
 // Segment is a wrapper of the underlying C-structure segment.
 type Segment struct {
 	segPtrMu   sync.RWMutex // guards segmentPtr
@@ -350,9 +368,11 @@ func (s *Segment) fillVectorFieldsData(collectionID UniqueID,
 
 		for i, offset := range result.Offset {
 			var vecPath string
+			var binlogRowSize int64
 			for index, idBinlogRowSize := range s.idBinlogRowSizes {
 				if offset < idBinlogRowSize {
 					vecPath = vecFieldInfo.fieldBinlog.Binlogs[index]
+					binlogRowSize = idBinlogRowSize
 					break
 				} else {
 					offset -= idBinlogRowSize
@@ -391,6 +411,48 @@ func (s *Segment) fillVectorFieldsData(collectionID UniqueID,
 
 				resultLen := dim
 				copy(x.FloatVector.Data[i*int(resultLen):(i+1)*int(resultLen)], floatResult)
+			case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+				rowBytes := dim * 2
+				content := make([]byte, rowBytes)
+				_, err := vcm.ReadAt(vecPath, content, offset*rowBytes)
+				if err != nil {
+					return err
+				}
+				log.Debug("FillVectorFieldData", zap.Any("halfFloatVectorResult", content))
+
+				var x []byte
+				if fieldData.Type == schemapb.DataType_Float16Vector {
+					x = fieldData.GetVectors().GetFloat16Vector()
+				} else {
+					x = fieldData.GetVectors().GetBfloat16Vector()
+				}
+				copy(x[i*int(rowBytes):(i+1)*int(rowBytes)], content)
+			case schemapb.DataType_SparseFloatVector:
+				// the sparse rows are cached as the int64 offsets of the rows followed by their (index, value) pairs
+				x := fieldData.GetVectors().GetSparseFloatVector()
+				offsets := make([]byte, 16)
+				_, err := vcm.ReadAt(vecPath, offsets, offset*8)
+				if err != nil {
+					return err
+				}
+				begin := int64(common.Endian.Uint64(offsets))
+				end := int64(common.Endian.Uint64(offsets[8:]))
+				if end < begin {
+					return fmt.Errorf("invalid offsets [%d, %d) of sparse float vector in %s", begin, end, vecPath)
+				}
+				row := make([]byte, end-begin)
+				_, err = vcm.ReadAt(vecPath, row, 8*(binlogRowSize+1)+begin)
+				if err != nil {
+					return err
+				}
+				if err := typeutil.ValidateSparseFloatRow(row); err != nil {
+					return err
+				}
+				x.Contents[i] = row
+				if rowDim := typeutil.SparseFloatRowDim(row); rowDim > x.Dim {
+					x.Dim = rowDim
+					fieldData.GetVectors().Dim = rowDim
+				}
 			}
 		}
 	}
@@ -540,12 +602,15 @@ func (s *Segment) checkIndexReady(fieldID int64) bool {
 	return s.indexInfos[fieldID].getReadyLoad()
 }
 
-func (s *Segment) updateBloomFilter(pks []int64) {
+func (s *Segment) updateBloomFilter(pks *schemapb.IDs) {
 	buf := make([]byte, 8)
-	for _, pk := range pks {
+	for _, pk := range pks.GetIntId().GetData() {
 		common.Endian.PutUint64(buf, uint64(pk))
 		s.pkFilter.Add(buf)
 	}
+	for _, pk := range pks.GetStrId().GetData() {
+		s.pkFilter.AddString(pk)
+	}
 }
 
 //-------------------------------------------------------------------------------------- interfaces for growing segment
@@ -590,7 +655,7 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 		           const long* primary_keys,
 		           const unsigned long* timestamps,
 		           void* raw_data,
-		           int sizeof_per_row,
+		           int64_t raw_data_size,
 		           signed long int count);
 	*/
 	s.segPtrMu.RLock()
@@ -603,27 +668,31 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 		return errors.New("null seg core pointer")
 	}
 
-	// Blobs to one big blob
+	// Blobs to one big blob, the rows are of variable sizes if the schema has variable-length fields
 	var numOfRow = len(*entityIDs)
-	var sizeofPerRow = len((*records)[0].Value)
 
 	assert.Equal(nil, numOfRow, len(*records))
 	if numOfRow != len(*records) {
 		return errors.New("EntityIDs row num not equal to length of records")
 	}
 
-	var rawData = make([]byte, numOfRow*sizeofPerRow)
-	var copyOffset = 0
+	var rawDataSize = 0
+	for i := 0; i < len(*records); i++ {
+		rawDataSize += len((*records)[i].Value)
+	}
+	var rawData = make([]byte, 0, rawDataSize)
 	for i := 0; i < len(*records); i++ {
-		copy(rawData[copyOffset:], (*records)[i].Value)
-		copyOffset += sizeofPerRow
+		rawData = append(rawData, (*records)[i].Value...)
+	}
+	if len(rawData) == 0 {
+		return errors.New("empty rows to be inserted")
 	}
 
 	var cOffset = C.long(offset)
 	var cNumOfRows = C.long(numOfRow)
 	var cEntityIdsPtr = (*C.long)(&(*entityIDs)[0])
 	var cTimestampsPtr = (*C.ulong)(&(*timestamps)[0])
-	var cSizeofPerRow = C.int(sizeofPerRow)
+	var cRawDataSize = C.int64_t(rawDataSize)
 	var cRawDataVoidPtr = unsafe.Pointer(&rawData[0])
 	log.Debug("QueryNode::Segment::InsertBegin", zap.Any("cNumOfRows", cNumOfRows))
 	status := C.Insert(s.segmentPtr,
@@ -632,7 +701,7 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 		cEntityIdsPtr,
 		cTimestampsPtr,
 		cRawDataVoidPtr,
-		cSizeofPerRow,
+		cRawDataSize,
 		cNumOfRows)
 	if err := HandleCStatus(&status, "Insert failed"); err != nil {
 		return err
@@ -642,13 +711,14 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 	return nil
 }
 
-func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps *[]Timestamp) error {
+func (s *Segment) segmentDelete(offset int64, entityIDs *schemapb.IDs, timestamps *[]Timestamp) error {
 	/*
 		CStatus
 		Delete(CSegmentInterface c_segment,
 		           long int reserved_offset,
 		           long size,
-		           const long* primary_keys,
+		           const void* ids,
+		           int64_t ids_size,
 		           const unsigned long* timestamps);
 	*/
 	s.segPtrMu.RLock()
@@ -657,16 +727,21 @@ func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps
 		return errors.New("null seg core pointer")
 	}
 
-	if len(*entityIDs) != len(*timestamps) {
+	if typeutil.GetSizeOfIDs(entityIDs) != len(*timestamps) {
 		return errors.New("Length of entityIDs not equal to length of timestamps")
 	}
 
+	ids, err := MarshalForCGo(entityIDs)
+	if err != nil {
+		return err
+	}
+	defer ids.destruct()
+
 	var cOffset = C.long(offset)
-	var cSize = C.long(len(*entityIDs))
-	var cEntityIdsPtr = (*C.long)(&(*entityIDs)[0])
+	var cSize = C.long(len(*timestamps))
 	var cTimestampsPtr = (*C.ulong)(&(*timestamps)[0])
 
-	status := C.Delete(s.segmentPtr, cOffset, cSize, cEntityIdsPtr, cTimestampsPtr)
+	status := C.Delete(s.segmentPtr, cOffset, cSize, ids.CProto.proto_blob, ids.CProto.proto_size, cTimestampsPtr)
 	if err := HandleCStatus(&status, "Delete failed"); err != nil {
 		return err
 	}
@@ -675,7 +750,9 @@ func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps
 }
 
 //-------------------------------------------------------------------------------------- interfaces for sealed segment
-func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interface{}) error {
+// segmentLoadFieldData loads the data of a field into the sealed segment, validData marks the null rows of a nullable
+// field, nil if all the rows are valid
+func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interface{}, validData []bool) error {
 	/*
 		CStatus
 		LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_info);
@@ -692,6 +769,7 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 
 	// data interface check
 	var dataPointer unsafe.Pointer
+	var offsetsPointer *C.int64_t
 	emptyErr := errors.New("null field data to be loaded")
 	switch d := data.(type) {
 	case []bool:
@@ -734,24 +812,44 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 			return emptyErr
 		}
 		dataPointer = unsafe.Pointer(&d[0])
+	case *variableLengthData:
+		if len(d.offsets) != rowCount+1 {
+			return fmt.Errorf("the number of offsets %d mismatches with the row count %d", len(d.offsets), rowCount)
+		}
+		// all the values may be empty
+		if len(d.data) > 0 {
+			dataPointer = unsafe.Pointer(&d.data[0])
+		}
+		offsetsPointer = (*C.int64_t)(unsafe.Pointer(&d.offsets[0]))
 	case []string:
-		// TODO: support string type
-		return errors.New("we cannot support string type now")
+		return errors.New("string field data should be encoded into variable-length data")
 	default:
 		return errors.New("illegal field data type")
 	}
 
+	var validPointer *C.bool
+	if len(validData) > 0 {
+		if len(validData) != rowCount {
+			return fmt.Errorf("the length of valid data %d mismatches with the row count %d", len(validData), rowCount)
+		}
+		validPointer = (*C.bool)(unsafe.Pointer(&validData[0]))
+	}
+
 	/*
 		typedef struct CLoadFieldDataInfo {
 		    int64_t field_id;
 		    void* blob;
 		    int64_t row_count;
+		    const bool* valid_data;
+		    const int64_t* offsets;
 		} CLoadFieldDataInfo;
 	*/
 	loadInfo := C.CLoadFieldDataInfo{
-		field_id:  C.int64_t(fieldID),
-		blob:      dataPointer,
-		row_count: C.int64_t(rowCount),
+		field_id:   C.int64_t(fieldID),
+		blob:       dataPointer,
+		row_count:  C.int64_t(rowCount),
+		valid_data: validPointer,
+		offsets:    offsetsPointer,
 	}
 
 	status := C.LoadFieldData(s.segmentPtr, loadInfo)
@@ -767,7 +865,7 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 	return nil
 }
 
-func (s *Segment) segmentLoadDeletedRecord(primaryKeys []IntPrimaryKey, timestamps []Timestamp, rowCount int64) error {
+func (s *Segment) segmentLoadDeletedRecord(primaryKeys *schemapb.IDs, timestamps []Timestamp, rowCount int64) error {
 	s.segPtrMu.RLock()
 	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
 	if s.segmentPtr == nil {
@@ -777,10 +875,16 @@ func (s *Segment) segmentLoadDeletedRecord(primaryKeys []IntPrimaryKey, timestam
 		errMsg := fmt.Sprintln("segmentLoadFieldData failed, illegal segment type ", s.segmentType, "segmentID = ", s.ID())
 		return errors.New(errMsg)
 	}
+	pks, err := MarshalForCGo(primaryKeys)
+	if err != nil {
+		return err
+	}
+	defer pks.destruct()
 	loadInfo := C.CLoadDeletedRecordInfo{
-		timestamps:   unsafe.Pointer(&timestamps[0]),
-		primary_keys: unsafe.Pointer(&primaryKeys[0]),
-		row_count:    C.int64_t(rowCount),
+		timestamps:        unsafe.Pointer(&timestamps[0]),
+		primary_keys:      pks.CProto.proto_blob,
+		primary_keys_size: pks.CProto.proto_size,
+		row_count:         C.int64_t(rowCount),
 	}
 	/*
 		CStatus
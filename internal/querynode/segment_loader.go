@@ -27,9 +27,11 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
@@ -225,6 +227,10 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		log.Warn(err.Error())
 		return err
 	}
+	err = loader.fillAddedFieldsData(segment, insertData)
+	if err != nil {
+		return err
+	}
 
 	for fieldID, value := range insertData.Data {
 		var numRows []int64
@@ -253,13 +259,41 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 			data = fieldData.Data
 		case *storage.StringFieldData:
 			numRows = fieldData.NumRows
-			data = fieldData.Data
+			data, err = loader.encodeVarCharFieldData(segment.collectionID, fieldID, fieldData.Data)
+			if err != nil {
+				return err
+			}
+		case *storage.ArrayFieldData:
+			numRows = fieldData.NumRows
+			data, err = loader.encodeArrayFieldData(segment.collectionID, fieldID, fieldData.Data)
+			if err != nil {
+				return err
+			}
+		case *storage.JSONFieldData:
+			numRows = fieldData.NumRows
+			jsonData := newVariableLengthData(len(fieldData.Data))
+			for _, doc := range fieldData.Data {
+				jsonData.append(doc)
+			}
+			data = jsonData
 		case *storage.FloatVectorFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
 		case *storage.BinaryVectorFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
+		case *storage.Float16VectorFieldData:
+			numRows = fieldData.NumRows
+			data = fieldData.Data
+		case *storage.BFloat16VectorFieldData:
+			numRows = fieldData.NumRows
+			data = fieldData.Data
+		case *storage.SparseFloatVectorFieldData:
+			numRows = fieldData.NumRows
+			data, err = loader.encodeSparseFloatVectorFieldData(segment.collectionID, fieldID, fieldData.Data)
+			if err != nil {
+				return err
+			}
 		default:
 			return errors.New("unexpected field data type")
 		}
@@ -270,7 +304,7 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		for _, numRow := range numRows {
 			totalNumRows += numRow
 		}
-		err = segment.segmentLoadFieldData(fieldID, int(totalNumRows), data)
+		err = segment.segmentLoadFieldData(fieldID, int(totalNumRows), data, storage.GetValidData(value))
 		if err != nil {
 			// TODO: return or continue?
 			return err
@@ -280,6 +314,122 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 	return nil
 }
 
+// fillAddedFieldsData fills the scalar fields added to the collection after the segment was written, the segment has
+// no binlogs of them, so the rows take the default values of the fields or are null
+func (loader *segmentLoader) fillAddedFieldsData(segment *Segment, insertData *storage.InsertData) error {
+	tsData, ok := insertData.Data[common.TimeStampField]
+	if !ok {
+		return nil
+	}
+	collection, err := loader.historicalReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+	for _, field := range collection.Schema().Fields {
+		if field.FieldID < common.StartOfUserFieldID || typeutil.IsVectorType(field.DataType) {
+			continue
+		}
+		if _, ok := insertData.Data[field.FieldID]; ok {
+			continue
+		}
+		log.Debug("fill the field added after the segment was written",
+			zap.Int64("segmentID", segment.segmentID),
+			zap.Int64("fieldID", field.FieldID))
+		fieldData, err := storage.NewDefaultFieldData(field, tsData.Length())
+		if err != nil {
+			return err
+		}
+		insertData.Data[field.FieldID] = fieldData
+	}
+	return nil
+}
+
+// encodeVarCharFieldData encodes the varchar values into the variable-length column of segcore
+func (loader *segmentLoader) encodeVarCharFieldData(collectionID UniqueID, fieldID FieldID, values []string) (*variableLengthData, error) {
+	collection, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	helper, err := typeutil.CreateSchemaHelper(collection.Schema())
+	if err != nil {
+		return nil, err
+	}
+	field, err := helper.GetFieldFromID(fieldID)
+	if err != nil {
+		return nil, err
+	}
+	maxLength, err := typeutil.GetMaxLength(field)
+	if err != nil {
+		return nil, err
+	}
+	data := newVariableLengthData(len(values))
+	for _, value := range values {
+		if len(value) > maxLength {
+			return nil, fmt.Errorf("length of varchar %d exceeds max length %d", len(value), maxLength)
+		}
+		data.append([]byte(value))
+	}
+	return data, nil
+}
+
+// encodeArrayFieldData encodes the arrays into the variable-length column of segcore
+func (loader *segmentLoader) encodeArrayFieldData(collectionID UniqueID, fieldID FieldID, values []*schemapb.ScalarField) (*variableLengthData, error) {
+	collection, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	helper, err := typeutil.CreateSchemaHelper(collection.Schema())
+	if err != nil {
+		return nil, err
+	}
+	field, err := helper.GetFieldFromID(fieldID)
+	if err != nil {
+		return nil, err
+	}
+	data := newVariableLengthData(len(values))
+	for _, value := range values {
+		encoded, err := typeutil.EncodeArray(field, value)
+		if err != nil {
+			return nil, err
+		}
+		// the column keeps the bytes of the values without their lengths
+		array, _, err := typeutil.DecodeVariableLengthValue(encoded)
+		if err != nil {
+			return nil, err
+		}
+		data.append(array)
+	}
+	return data, nil
+}
+
+// encodeSparseFloatVectorFieldData encodes the sparse float vector rows into the variable-length column of segcore
+func (loader *segmentLoader) encodeSparseFloatVectorFieldData(collectionID UniqueID, fieldID FieldID, rows [][]byte) (*variableLengthData, error) {
+	collection, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	helper, err := typeutil.CreateSchemaHelper(collection.Schema())
+	if err != nil {
+		return nil, err
+	}
+	field, err := helper.GetFieldFromID(fieldID)
+	if err != nil {
+		return nil, err
+	}
+	maxNnz, err := typeutil.GetMaxNnz(field)
+	if err != nil {
+		return nil, err
+	}
+	data := newVariableLengthData(len(rows))
+	for _, row := range rows {
+		if err := typeutil.ValidateSparseFloatRowNnz(row, maxNnz); err != nil {
+			return nil, err
+		}
+		data.append(row)
+	}
+	return data, nil
+}
+
 func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPaths []string) error {
 	if len(binlogPaths) == 0 {
 		log.Info("there are no stats logs saved with segment", zap.Any("segmentID", segment.segmentID))
@@ -295,6 +445,35 @@ func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPath
 		blobs = append(blobs, &storage.Blob{Value: []byte(values[i])})
 	}
 
+	collection, err := loader.historicalReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+	helper, err := typeutil.CreateSchemaHelper(collection.Schema())
+	if err != nil {
+		return err
+	}
+	pkField, err := helper.GetPrimaryKeyField()
+	if err != nil {
+		return err
+	}
+	if pkField.DataType == schemapb.DataType_String {
+		stringStats, err := storage.DeserializeStringStats(blobs)
+		if err != nil {
+			return err
+		}
+		for _, stat := range stringStats {
+			if stat.BF == nil {
+				log.Warn("stat log with nil bloom filter", zap.Int64("segmentID", segment.segmentID), zap.Any("stat", stat))
+				continue
+			}
+			if err = segment.pkFilter.Merge(stat.BF); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	stats, err := storage.DeserializeStats(blobs)
 	if err != nil {
 		return err
@@ -335,7 +514,11 @@ func (loader *segmentLoader) loadDeltaLogs(segment *Segment, deltaLogs []*datapb
 		return err
 	}
 
-	err = segment.segmentLoadDeletedRecord(deltaData.Pks, deltaData.Tss, deltaData.RowCount)
+	pks := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: deltaData.Pks}}}
+	if len(deltaData.StringPks) > 0 {
+		pks = &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: deltaData.StringPks}}}
+	}
+	err = segment.segmentLoadDeletedRecord(pks, deltaData.Tss, deltaData.RowCount)
 	if err != nil {
 		return err
 	}
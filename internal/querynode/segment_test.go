@@ -275,7 +275,7 @@ func TestSegment_getDeletedCount(t *testing.T) {
 	var offsetDelete = segment.segmentPreDelete(10)
 	assert.GreaterOrEqual(t, offsetDelete, int64(0))
 
-	err = segment.segmentDelete(offsetDelete, &ids, &timestamps)
+	err = segment.segmentDelete(offsetDelete, &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}}, &timestamps)
 	assert.NoError(t, err)
 
 	var deletedCount = segment.getDeletedCount()
@@ -445,7 +445,7 @@ func TestSegment_segmentDelete(t *testing.T) {
 	var offsetDelete = segment.segmentPreDelete(10)
 	assert.GreaterOrEqual(t, offsetDelete, int64(0))
 
-	err = segment.segmentDelete(offsetDelete, &ids, &timestamps)
+	err = segment.segmentDelete(offsetDelete, &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}}, &timestamps)
 	assert.NoError(t, err)
 
 	deleteCollection(collection)
@@ -665,7 +665,7 @@ func TestSegment_segmentLoadDeletedRecord(t *testing.T) {
 		defaultVChannel,
 		segmentTypeSealed,
 		true)
-	pks := []IntPrimaryKey{1, 2, 3}
+	pks := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}}
 	timestamps := []Timestamp{10, 10, 10}
 	var rowCount int64 = 3
 	error := seg.segmentLoadDeletedRecord(pks, timestamps, rowCount)
@@ -873,7 +873,7 @@ func TestSegment_ConcurrentOperation(t *testing.T) {
 		}()
 		go func() {
 			// segmentLoadFieldData result error may be nil or not, we just expected this test would not crash.
-			_ = segment.segmentLoadFieldData(101, N, ages)
+			_ = segment.segmentLoadFieldData(101, N, ages, nil)
 			wg.Done()
 		}()
 	}
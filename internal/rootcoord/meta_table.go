@@ -15,17 +15,22 @@ import (
 	"bytes"
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -39,6 +44,9 @@ const (
 	// ProxyMetaPrefix prefix for proxy meta
 	ProxyMetaPrefix = ComponentPrefix + "/proxy"
 
+	// DatabaseMetaPrefix prefix for database meta
+	DatabaseMetaPrefix = ComponentPrefix + "/database"
+
 	// CollectionMetaPrefix prefix for collection meta
 	CollectionMetaPrefix = ComponentPrefix + "/collection"
 
@@ -60,6 +68,18 @@ const (
 	// DDMsgSendPrefix prefix to indicate whether DD msg has been send
 	DDMsgSendPrefix = ComponentPrefix + "/dd-msg-send"
 
+	// CredentialPrefix prefix for credential user
+	CredentialPrefix = ComponentPrefix + "/credential/users"
+
+	// RolePrefix prefix for role
+	RolePrefix = ComponentPrefix + "/credential/roles"
+
+	// RoleMappingPrefix prefix for the binding of user and role
+	RoleMappingPrefix = ComponentPrefix + "/credential/user-role-mapping"
+
+	// GranteePrefix prefix for the privileges granted to role
+	GranteePrefix = ComponentPrefix + "/credential/grantee-privileges"
+
 	// CreateCollectionDDType name of DD type for create collection
 	CreateCollectionDDType = "CreateCollection"
 
@@ -79,16 +99,22 @@ type MetaTable struct {
 	snapshot        kv.SnapShotKV                                                   // client of a reliable snapshotkv service, i.e. etcd client
 	tenantID2Meta   map[typeutil.UniqueID]pb.TenantMeta                             // tenant id to tenant meta
 	proxyID2Meta    map[typeutil.UniqueID]pb.ProxyMeta                              // proxy id to proxy meta
+	dbName2Meta     map[string]pb.DatabaseInfo                                      // database name -> meta
 	collID2Meta     map[typeutil.UniqueID]pb.CollectionInfo                         // collection_id -> meta
-	collName2ID     map[string]typeutil.UniqueID                                    // collection name to collection id
-	collAlias2ID    map[string]typeutil.UniqueID                                    // collection alias to collection id
+	collName2ID     map[typeutil.UniqueID]map[string]typeutil.UniqueID              // database id -> collection name -> collection id
+	collAlias2ID    map[typeutil.UniqueID]map[string]typeutil.UniqueID              // database id -> collection alias -> collection id
 	partID2SegID    map[typeutil.UniqueID]map[typeutil.UniqueID]bool                // partition_id -> segment_id -> bool
 	segID2IndexMeta map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo // collection_id/index_id/partition_id/segment_id -> meta
 	indexID2Meta    map[typeutil.UniqueID]pb.IndexInfo                              // collection_id/index_id -> meta
+	userName2Cred   map[string]*internalpb.CredentialInfo                           // username -> credential info
+	roles           map[string]struct{}                                             // role name set
+	user2Roles      map[string]map[string]struct{}                                  // username -> role names
+	policy2Grant    map[string]*milvuspb.GrantEntity                                // role/object_type/object_name/privilege -> grant
 
 	tenantLock sync.RWMutex
 	proxyLock  sync.RWMutex
 	ddLock     sync.RWMutex
+	credLock   sync.RWMutex
 }
 
 // NewMetaTable create meta table for rootcoord, which stores all in-memory information
@@ -100,6 +126,7 @@ func NewMetaTable(txn kv.TxnKV, snap kv.SnapShotKV) (*MetaTable, error) {
 		tenantLock: sync.RWMutex{},
 		proxyLock:  sync.RWMutex{},
 		ddLock:     sync.RWMutex{},
+		credLock:   sync.RWMutex{},
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -111,12 +138,17 @@ func NewMetaTable(txn kv.TxnKV, snap kv.SnapShotKV) (*MetaTable, error) {
 func (mt *MetaTable) reloadFromKV() error {
 	mt.tenantID2Meta = make(map[typeutil.UniqueID]pb.TenantMeta)
 	mt.proxyID2Meta = make(map[typeutil.UniqueID]pb.ProxyMeta)
+	mt.dbName2Meta = make(map[string]pb.DatabaseInfo)
 	mt.collID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
-	mt.collName2ID = make(map[string]typeutil.UniqueID)
-	mt.collAlias2ID = make(map[string]typeutil.UniqueID)
+	mt.collName2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
+	mt.collAlias2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
 	mt.partID2SegID = make(map[typeutil.UniqueID]map[typeutil.UniqueID]bool)
 	mt.segID2IndexMeta = make(map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo)
 	mt.indexID2Meta = make(map[typeutil.UniqueID]pb.IndexInfo)
+	mt.userName2Cred = make(map[string]*internalpb.CredentialInfo)
+	mt.roles = make(map[string]struct{})
+	mt.user2Roles = make(map[string]map[string]struct{})
+	mt.policy2Grant = make(map[string]*milvuspb.GrantEntity)
 
 	_, values, err := mt.snapshot.LoadWithPrefix(TenantMetaPrefix, 0)
 	if err != nil {
@@ -150,6 +182,22 @@ func (mt *MetaTable) reloadFromKV() error {
 		mt.proxyID2Meta[proxyMeta.ID] = proxyMeta
 	}
 
+	// the default database isn't persisted, the collections created before databases are supported belong to it
+	mt.dbName2Meta[common.DefaultDBName] = pb.DatabaseInfo{ID: common.DefaultDBID, Name: common.DefaultDBName}
+	_, values, err = mt.snapshot.LoadWithPrefix(DatabaseMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		dbInfo := pb.DatabaseInfo{}
+		err = proto.Unmarshal([]byte(value), &dbInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord Unmarshal pb.DatabaseInfo err:%w", err)
+		}
+		mt.dbName2Meta[dbInfo.Name] = dbInfo
+	}
+
 	_, values, err = mt.snapshot.LoadWithPrefix(CollectionMetaPrefix, 0)
 	if err != nil {
 		return err
@@ -162,7 +210,7 @@ func (mt *MetaTable) reloadFromKV() error {
 			return fmt.Errorf("RootCoord Unmarshal pb.CollectionInfo err:%w", err)
 		}
 		mt.collID2Meta[collInfo.ID] = collInfo
-		mt.collName2ID[collInfo.Schema.Name] = collInfo.ID
+		setName2ID(mt.collName2ID, collInfo.DbId, collInfo.Schema.Name, collInfo.ID)
 	}
 
 	_, values, err = mt.txn.LoadWithPrefix(SegmentIndexMetaPrefix)
@@ -228,13 +276,201 @@ func (mt *MetaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("RootCoord Unmarshal pb.AliasInfo err:%w", err)
 		}
-		mt.collAlias2ID[aliasInfo.Schema.Name] = aliasInfo.ID
+		setName2ID(mt.collAlias2ID, aliasInfo.DbId, aliasInfo.Schema.Name, aliasInfo.ID)
+	}
+
+	_, values, err = mt.txn.LoadWithPrefix(CredentialPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		credInfo := internalpb.CredentialInfo{}
+		err = proto.Unmarshal([]byte(value), &credInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord Unmarshal internalpb.CredentialInfo err:%w", err)
+		}
+		mt.userName2Cred[credInfo.Username] = &credInfo
+	}
+
+	_, values, err = mt.txn.LoadWithPrefix(RolePrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		role := milvuspb.RoleEntity{}
+		err = proto.Unmarshal([]byte(value), &role)
+		if err != nil {
+			return fmt.Errorf("RootCoord Unmarshal milvuspb.RoleEntity err:%w", err)
+		}
+		mt.roles[role.Name] = struct{}{}
+	}
+
+	_, values, err = mt.txn.LoadWithPrefix(RoleMappingPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		username, roleName, err := funcutil.DecodeUserRoleCache(value)
+		if err != nil {
+			return fmt.Errorf("RootCoord decode user role mapping err:%w", err)
+		}
+		mt.bindUserRole(username, roleName)
+	}
+
+	_, values, err = mt.txn.LoadWithPrefix(GranteePrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		grant := milvuspb.GrantEntity{}
+		err = proto.Unmarshal([]byte(value), &grant)
+		if err != nil {
+			return fmt.Errorf("RootCoord Unmarshal milvuspb.GrantEntity err:%w", err)
+		}
+		mt.policy2Grant[policyOfGrant(&grant)] = &grant
 	}
 
 	log.Debug("reload meta table from KV successfully")
 	return nil
 }
 
+func setName2ID(name2ID map[typeutil.UniqueID]map[string]typeutil.UniqueID, dbID typeutil.UniqueID, name string, id typeutil.UniqueID) {
+	if _, ok := name2ID[dbID]; !ok {
+		name2ID[dbID] = make(map[string]typeutil.UniqueID)
+	}
+	name2ID[dbID][name] = id
+}
+
+func aliasKey(dbID typeutil.UniqueID, alias string) string {
+	// keep the key of the aliases in the default database compatible with the ones created before databases are supported
+	if dbID == common.DefaultDBID {
+		return fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias)
+	}
+	return fmt.Sprintf("%s/%d/%s", CollectionAliasMetaPrefix, dbID, alias)
+}
+
+// getDatabaseID returns the id of the database, the default database is used if the name is empty
+func (mt *MetaTable) getDatabaseID(dbName string) (typeutil.UniqueID, error) {
+	if dbName == "" {
+		dbName = common.DefaultDBName
+	}
+	dbInfo, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return 0, fmt.Errorf("database %s not exist", dbName)
+	}
+	return dbInfo.ID, nil
+}
+
+func (mt *MetaTable) hasDatabaseID(dbID typeutil.UniqueID) bool {
+	for _, dbInfo := range mt.dbName2Meta {
+		if dbInfo.ID == dbID {
+			return true
+		}
+	}
+	return false
+}
+
+// getCollectionID returns the id of the collection in the database, the name can be an alias
+func (mt *MetaTable) getCollectionID(dbName string, collName string) (typeutil.UniqueID, error) {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return 0, err
+	}
+	if collID, ok := mt.collName2ID[dbID][collName]; ok {
+		return collID, nil
+	}
+	if collID, ok := mt.collAlias2ID[dbID][collName]; ok {
+		return collID, nil
+	}
+	return 0, fmt.Errorf("collection %s not found", collName)
+}
+
+// CreateDatabase creates a database
+func (mt *MetaTable) CreateDatabase(dbInfo *pb.DatabaseInfo, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, ok := mt.dbName2Meta[dbInfo.Name]; ok {
+		return fmt.Errorf("database %s exist", dbInfo.Name)
+	}
+	dbInfo.CreatedTime = ts
+
+	k := fmt.Sprintf("%s/%d", DatabaseMetaPrefix, dbInfo.ID)
+	v, err := proto.Marshal(dbInfo)
+	if err != nil {
+		log.Error("MetaTable CreateDatabase Marshal fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("MetaTable CreateDatabase Marshal fail key:%s, err:%w", k, err)
+	}
+	err = mt.snapshot.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.dbName2Meta[dbInfo.Name] = *dbInfo
+	return nil
+}
+
+// DropDatabase drops the database, only the empty database except the default one can be dropped
+func (mt *MetaTable) DropDatabase(dbName string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if dbName == common.DefaultDBName {
+		return fmt.Errorf("can not drop the default database")
+	}
+	dbInfo, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return fmt.Errorf("database %s not exist", dbName)
+	}
+	if len(mt.collName2ID[dbInfo.ID]) > 0 {
+		return fmt.Errorf("database %s is not empty, drop the collections in it first", dbName)
+	}
+
+	delMetaKeys := []string{fmt.Sprintf("%s/%d", DatabaseMetaPrefix, dbInfo.ID)}
+	err := mt.snapshot.MultiSaveAndRemoveWithPrefix(map[string]string{}, delMetaKeys, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	delete(mt.dbName2Meta, dbName)
+	delete(mt.collName2ID, dbInfo.ID)
+	delete(mt.collAlias2ID, dbInfo.ID)
+	return nil
+}
+
+// GetDatabaseByName returns the database meta, the default database is returned if the name is empty
+func (mt *MetaTable) GetDatabaseByName(dbName string) (*pb.DatabaseInfo, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	if dbName == "" {
+		dbName = common.DefaultDBName
+	}
+	dbInfo, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return nil, fmt.Errorf("database %s not exist", dbName)
+	}
+	return proto.Clone(&dbInfo).(*pb.DatabaseInfo), nil
+}
+
+// ListDatabases returns all the databases sorted by name
+func (mt *MetaTable) ListDatabases() []*pb.DatabaseInfo {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbNames := make([]string, 0, len(mt.dbName2Meta))
+	for dbName := range mt.dbName2Meta {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+	dbInfos := make([]*pb.DatabaseInfo, 0, len(dbNames))
+	for _, dbName := range dbNames {
+		dbInfo := mt.dbName2Meta[dbName]
+		dbInfos = append(dbInfos, proto.Clone(&dbInfo).(*pb.DatabaseInfo))
+	}
+	return dbInfos
+}
+
 // AddTenant add tenant
 func (mt *MetaTable) AddTenant(te *pb.TenantMeta, ts typeutil.Timestamp) error {
 	mt.tenantLock.Lock()
@@ -287,7 +523,10 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 		(len(coll.PartitionIDs) != 1 && len(coll.PartitionIDs) != 0) {
 		return fmt.Errorf("PartitionIDs, PartitionNames and PartitionCreatedTimestmaps' length mis-match when creating collection")
 	}
-	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
+	if !mt.hasDatabaseID(coll.DbId) {
+		return fmt.Errorf("database id = %d not exist", coll.DbId)
+	}
+	if _, ok := mt.collName2ID[coll.DbId][coll.Schema.Name]; ok {
 		return fmt.Errorf("collection %s exist", coll.Schema.Name)
 	}
 	if len(coll.FieldIndexes) != len(idx) {
@@ -299,7 +538,7 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 		coll.PartitionCreatedTimestamps[0] = ts
 	}
 	mt.collID2Meta[coll.ID] = *coll
-	mt.collName2ID[coll.Schema.Name] = coll.ID
+	setName2ID(mt.collName2ID, coll.DbId, coll.Schema.Name, coll.ID)
 	for _, i := range idx {
 		mt.indexID2Meta[i.IndexID] = *i
 	}
@@ -348,7 +587,7 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 
 	delete(mt.collID2Meta, collID)
-	delete(mt.collName2ID, collMeta.Schema.Name)
+	delete(mt.collName2ID[collMeta.DbId], collMeta.Schema.Name)
 
 	// update segID2IndexMeta
 	for partID := range collMeta.PartitionIDs {
@@ -374,7 +613,7 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 	var aliases []string
 	// delete collection aliases
-	for alias, cid := range mt.collAlias2ID {
+	for alias, cid := range mt.collAlias2ID[collMeta.DbId] {
 		if cid == collID {
 			aliases = append(aliases, alias)
 		}
@@ -389,10 +628,8 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 
 	for _, alias := range aliases {
-		delete(mt.collAlias2ID, alias)
-		delMetakeysSnap = append(delMetakeysSnap,
-			fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias),
-		)
+		delete(mt.collAlias2ID[collMeta.DbId], alias)
+		delMetakeysSnap = append(delMetakeysSnap, aliasKey(collMeta.DbId, alias))
 	}
 
 	// save ddOpStr into etcd
@@ -454,15 +691,19 @@ func (mt *MetaTable) GetCollectionByID(collectionID typeutil.UniqueID, ts typeut
 	return &colMeta, nil
 }
 
-// GetCollectionByName return collection meta by collection name
-func (mt *MetaTable) GetCollectionByName(collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
+// GetCollectionByName return collection meta by collection name in the database
+func (mt *MetaTable) GetCollectionByName(dbName string, collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
 	if ts == 0 {
-		vid, ok := mt.collName2ID[collectionName]
+		vid, ok := mt.collName2ID[dbID][collectionName]
 		if !ok {
-			if vid, ok = mt.collAlias2ID[collectionName]; !ok {
+			if vid, ok = mt.collAlias2ID[dbID][collectionName]; !ok {
 				return nil, fmt.Errorf("can't find collection: " + collectionName)
 			}
 		}
@@ -484,21 +725,25 @@ func (mt *MetaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 			continue
 		}
-		if collMeta.Schema.Name == collectionName {
+		if collMeta.DbId == dbID && collMeta.Schema.Name == collectionName {
 			return &collMeta, nil
 		}
 	}
 	return nil, fmt.Errorf("can't find collection: %s, at timestamp = %d", collectionName, ts)
 }
 
-// ListCollections list all collection names
-func (mt *MetaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
+// ListCollections list all collection names in the database
+func (mt *MetaTable) ListCollections(dbName string, ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	colls := make(map[string]*pb.CollectionInfo)
 
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
 	if ts == 0 {
-		for collName, collID := range mt.collName2ID {
+		for collName, collID := range mt.collName2ID[dbID] {
 			coll := mt.collID2Meta[collID]
 			colCopy := proto.Clone(&coll)
 			colls[collName] = colCopy.(*pb.CollectionInfo)
@@ -515,8 +760,11 @@ func (mt *MetaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.Coll
 		err := proto.Unmarshal([]byte(val), &collMeta)
 		if err != nil {
 			log.Debug("unmarshal collection info failed", zap.Error(err))
+			continue
+		}
+		if collMeta.DbId == dbID {
+			colls[collMeta.Schema.Name] = &collMeta
 		}
-		colls[collMeta.Schema.Name] = &collMeta
 	}
 	return colls, nil
 }
@@ -526,7 +774,7 @@ func (mt *MetaTable) ListAliases(collID typeutil.UniqueID) []string {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	var aliases []string
-	for alias, cid := range mt.collAlias2ID {
+	for alias, cid := range mt.collAlias2ID[mt.collID2Meta[collID].DbId] {
 		if cid == collID {
 			aliases = append(aliases, alias)
 		}
@@ -625,6 +873,50 @@ func (mt *MetaTable) AddPartition(collID typeutil.UniqueID, partitionName string
 	return nil
 }
 
+// AddCollectionField appends the field to the schema of the collection and increases the schema version, the field
+// id is allocated after the existing user fields
+func (mt *MetaTable) AddCollectionField(collID typeutil.UniqueID, field *schemapb.FieldSchema, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+
+	fieldID := typeutil.UniqueID(StartOfUserFieldID)
+	for _, f := range coll.Schema.Fields {
+		if f.Name == field.Name {
+			return fmt.Errorf("field name = %s already exists", field.Name)
+		}
+		if f.FieldID >= fieldID {
+			fieldID = f.FieldID + 1
+		}
+	}
+
+	schema := proto.Clone(coll.Schema).(*schemapb.CollectionSchema)
+	newField := proto.Clone(field).(*schemapb.FieldSchema)
+	newField.FieldID = fieldID
+	schema.Fields = append(schema.Fields, newField)
+	coll.Schema = schema
+	coll.SchemaVersion++
+
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v, err := proto.Marshal(&coll)
+	if err != nil {
+		log.Error("MetaTable AddCollectionField Marshal fail",
+			zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("MetaTable AddCollectionField Marshal fail key:%s, err:%w", k, err)
+	}
+
+	err = mt.snapshot.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.collID2Meta[collID] = coll
+	return nil
+}
+
 // GetPartitionNameByID return partition name by partition id
 func (mt *MetaTable) GetPartitionNameByID(collID, partitionID typeutil.UniqueID, ts typeutil.Timestamp) (string, error) {
 	if ts == 0 {
@@ -845,22 +1137,19 @@ func (mt *MetaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo) error {
 }
 
 // DropIndex drop index
-func (mt *MetaTable) DropIndex(collName, fieldName, indexName string) (typeutil.UniqueID, bool, error) {
+func (mt *MetaTable) DropIndex(dbName, collName, fieldName, indexName string) (typeutil.UniqueID, bool, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
-	collID, ok := mt.collName2ID[collName]
-	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return 0, false, fmt.Errorf("collection name = %s not exist", collName)
-		}
+	collID, err := mt.getCollectionID(dbName, collName)
+	if err != nil {
+		return 0, false, fmt.Errorf("collection name = %s not exist", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
 		return 0, false, fmt.Errorf("collection name  = %s not has meta", collName)
 	}
-	fieldSch, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSch, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return 0, false, err
 	}
@@ -953,6 +1242,25 @@ func (mt *MetaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, fieldID in
 				return seg, nil
 			}
 		}
+	} else if idxName == "" { // return the default index of the field, or any index of the field
+		var fieldIdx *pb.SegmentIndexInfo
+		for _, seg := range segIdxMap {
+			if seg.FieldID != fieldID {
+				continue
+			}
+			info, ok := mt.indexID2Meta[seg.IndexID]
+			if !ok {
+				continue
+			}
+			if info.IndexName == Params.DefaultIndexName {
+				return seg, nil
+			}
+			seg := seg
+			fieldIdx = &seg
+		}
+		if fieldIdx != nil {
+			return *fieldIdx, nil
+		}
 	} else {
 		for idxID, seg := range segIdxMap {
 			idxMeta, ok := mt.indexID2Meta[idxID]
@@ -971,20 +1279,17 @@ func (mt *MetaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, fieldID in
 }
 
 // GetFieldSchema return field schema
-func (mt *MetaTable) GetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
+func (mt *MetaTable) GetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	return mt.unlockGetFieldSchema(collName, fieldName)
+	return mt.unlockGetFieldSchema(dbName, collName, fieldName)
 }
 
-func (mt *MetaTable) unlockGetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
-	collID, ok := mt.collName2ID[collName]
-	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
-		}
+func (mt *MetaTable) unlockGetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
+	collID, err := mt.getCollectionID(dbName, collName)
+	if err != nil {
+		return schemapb.FieldSchema{}, err
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1029,31 +1334,32 @@ func (mt *MetaTable) unlockIsSegmentIndexed(segID typeutil.UniqueID, fieldSchema
 }
 
 // GetNotIndexedSegments return segment ids which have no index
-func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
+func (mt *MetaTable) GetNotIndexedSegments(dbName string, collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	if idxInfo.IndexParams == nil {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("index param is nil")
 	}
-	collID, ok := mt.collName2ID[collName]
-	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
-		}
+	collID, err := mt.getCollectionID(dbName, collName)
+	if err != nil {
+		return nil, schemapb.FieldSchema{}, err
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
-	fieldSchema, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSchema, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return nil, fieldSchema, err
 	}
 
+	// the index names are unique within a field, the vector fields may have indexes of the same name
 	var dupIdx typeutil.UniqueID = 0
 	for _, f := range collMeta.FieldIndexes {
+		if f.FiledID != fieldSchema.FieldID {
+			continue
+		}
 		if info, ok := mt.indexID2Meta[f.IndexID]; ok {
 			if info.IndexName == idxInfo.IndexName {
 				dupIdx = info.IndexID
@@ -1164,16 +1470,13 @@ func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, id
 }
 
 // GetIndexByName return index info by index name
-func (mt *MetaTable) GetIndexByName(collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
+func (mt *MetaTable) GetIndexByName(dbName, collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	collID, ok := mt.collName2ID[collName]
-	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
-		}
+	collID, err := mt.getCollectionID(dbName, collName)
+	if err != nil {
+		return pb.CollectionInfo{}, nil, err
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1231,26 +1534,30 @@ func (mt *MetaTable) dupMeta() (
 	return collID2Meta, segID2IndexMeta, indexID2Meta
 }
 
-// AddAlias add collection alias
-func (mt *MetaTable) AddAlias(collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
+// AddAlias add collection alias, the alias and the collection are in the same database
+func (mt *MetaTable) AddAlias(dbName string, collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; ok {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; ok {
 		return fmt.Errorf("duplicate collection alias, alias = %s", collectionAlias)
 	}
 
-	if _, ok := mt.collName2ID[collectionAlias]; ok {
+	if _, ok := mt.collName2ID[dbID][collectionAlias]; ok {
 		return fmt.Errorf("collection alias collides with existing collection name. collection = %s, alias = %s", collectionAlias, collectionAlias)
 	}
 
-	id, ok := mt.collName2ID[collectionName]
+	id, ok := mt.collName2ID[dbID][collectionName]
 	if !ok {
 		return fmt.Errorf("aliased collection name does not exist, name = %s", collectionName)
 	}
-	mt.collAlias2ID[collectionAlias] = id
+	setName2ID(mt.collAlias2ID, dbID, collectionAlias, id)
 
-	k := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias)
-	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}})
+	k := aliasKey(dbID, collectionAlias)
+	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}, DbId: dbID})
 	if err != nil {
 		log.Error("MetaTable AddAlias Marshal CollectionInfo fail",
 			zap.String("key", k), zap.Error(err))
@@ -1266,19 +1573,23 @@ func (mt *MetaTable) AddAlias(collectionAlias string, collectionName string, ts
 }
 
 // DropAlias drop collection alias
-func (mt *MetaTable) DropAlias(collectionAlias string, ts typeutil.Timestamp) error {
+func (mt *MetaTable) DropAlias(dbName string, collectionAlias string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; !ok {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; !ok {
 		return fmt.Errorf("alias does not exist, alias = %s", collectionAlias)
 	}
-	delete(mt.collAlias2ID, collectionAlias)
+	delete(mt.collAlias2ID[dbID], collectionAlias)
 
 	delMetakeys := []string{
-		fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias),
+		aliasKey(dbID, collectionAlias),
 	}
 	meta := make(map[string]string)
-	err := mt.snapshot.MultiSaveAndRemoveWithPrefix(meta, delMetakeys, ts)
+	err = mt.snapshot.MultiSaveAndRemoveWithPrefix(meta, delMetakeys, ts)
 	if err != nil {
 		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
 		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
@@ -1287,21 +1598,25 @@ func (mt *MetaTable) DropAlias(collectionAlias string, ts typeutil.Timestamp) er
 }
 
 // AlterAlias alter collection alias
-func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
+func (mt *MetaTable) AlterAlias(dbName string, collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; !ok {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; !ok {
 		return fmt.Errorf("alias does not exist, alias = %s", collectionAlias)
 	}
 
-	id, ok := mt.collName2ID[collectionName]
+	id, ok := mt.collName2ID[dbID][collectionName]
 	if !ok {
 		return fmt.Errorf("aliased collection name does not exist, name = %s", collectionName)
 	}
-	mt.collAlias2ID[collectionAlias] = id
+	mt.collAlias2ID[dbID][collectionAlias] = id
 
-	k := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias)
-	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}})
+	k := aliasKey(dbID, collectionAlias)
+	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}, DbId: dbID})
 	if err != nil {
 		log.Error("MetaTable AlterAlias Marshal CollectionInfo fail",
 			zap.String("key", k), zap.Error(err))
@@ -1316,10 +1631,334 @@ func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, t
 	return nil
 }
 
-// IsAlias returns true if specific `collectionAlias` is an alias of collection.
-func (mt *MetaTable) IsAlias(collectionAlias string) bool {
+// IsAlias returns true if specific `collectionAlias` is an alias of collection in the database.
+func (mt *MetaTable) IsAlias(dbName string, collectionAlias string) bool {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
-	_, ok := mt.collAlias2ID[collectionAlias]
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return false
+	}
+	_, ok := mt.collAlias2ID[dbID][collectionAlias]
 	return ok
 }
+
+// AddCredential add credential for a new user, the password must have been encrypted
+func (mt *MetaTable) AddCredential(credInfo *internalpb.CredentialInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userName2Cred[credInfo.Username]; ok {
+		return fmt.Errorf("user already exists, username = %s", credInfo.Username)
+	}
+	return mt.saveCredential(credInfo)
+}
+
+// AlterCredential replace the encrypted password of an existing user
+func (mt *MetaTable) AlterCredential(credInfo *internalpb.CredentialInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userName2Cred[credInfo.Username]; !ok {
+		return fmt.Errorf("user does not exist, username = %s", credInfo.Username)
+	}
+	return mt.saveCredential(credInfo)
+}
+
+func (mt *MetaTable) saveCredential(credInfo *internalpb.CredentialInfo) error {
+	if credInfo.Username == "" || credInfo.EncryptedPassword == "" {
+		return fmt.Errorf("username and encrypted password should not be empty")
+	}
+	// never persist the fast-path digest of the raw password
+	cred := &internalpb.CredentialInfo{
+		Username:          credInfo.Username,
+		EncryptedPassword: credInfo.EncryptedPassword,
+	}
+	k := fmt.Sprintf("%s/%s", CredentialPrefix, cred.Username)
+	v, err := proto.Marshal(cred)
+	if err != nil {
+		log.Error("MetaTable saveCredential Marshal CredentialInfo fail",
+			zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("MetaTable saveCredential Marshal CredentialInfo fail key:%s, err:%w", k, err)
+	}
+	err = mt.txn.Save(k, string(v))
+	if err != nil {
+		log.Error("TxnKV Save fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("TxnKV Save fail key:%s, err:%w", k, err)
+	}
+	mt.userName2Cred[cred.Username] = cred
+	return nil
+}
+
+// GetCredential return the credential info of the user
+func (mt *MetaTable) GetCredential(username string) (*internalpb.CredentialInfo, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	cred, ok := mt.userName2Cred[username]
+	if !ok {
+		return nil, fmt.Errorf("user does not exist, username = %s", username)
+	}
+	return proto.Clone(cred).(*internalpb.CredentialInfo), nil
+}
+
+// DeleteCredential delete the credential of the user
+func (mt *MetaTable) DeleteCredential(username string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userName2Cred[username]; !ok {
+		return fmt.Errorf("user does not exist, username = %s", username)
+	}
+	// the bindings of the user are removed together with the credential
+	keys := []string{fmt.Sprintf("%s/%s", CredentialPrefix, username)}
+	for roleName := range mt.user2Roles[username] {
+		keys = append(keys, userRoleKey(username, roleName))
+	}
+	err := mt.txn.MultiRemove(keys)
+	if err != nil {
+		log.Error("TxnKV MultiRemove fail", zap.Strings("keys", keys), zap.Error(err))
+		return fmt.Errorf("TxnKV MultiRemove fail keys:%v, err:%w", keys, err)
+	}
+	delete(mt.userName2Cred, username)
+	delete(mt.user2Roles, username)
+	return nil
+}
+
+// ListCredentialUsernames list all the usernames in ascending order
+func (mt *MetaTable) ListCredentialUsernames() []string {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	usernames := make([]string, 0, len(mt.userName2Cred))
+	for username := range mt.userName2Cred {
+		usernames = append(usernames, username)
+	}
+	sort.Strings(usernames)
+	return usernames
+}
+
+func userRoleKey(username string, roleName string) string {
+	return fmt.Sprintf("%s/%s/%s", RoleMappingPrefix, username, roleName)
+}
+
+func policyOfGrant(grant *milvuspb.GrantEntity) string {
+	return funcutil.PolicyForPrivilege(grant.Role.Name, grant.Object.Name,
+		funcutil.PolicyObjectName(grant.Object.Name, grant.DbName, grant.ObjectName), grant.Grantor.Privilege.Name)
+}
+
+func grantKey(policy string) string {
+	return fmt.Sprintf("%s/%s", GranteePrefix, policy)
+}
+
+func (mt *MetaTable) bindUserRole(username string, roleName string) {
+	if _, ok := mt.user2Roles[username]; !ok {
+		mt.user2Roles[username] = make(map[string]struct{})
+	}
+	mt.user2Roles[username][roleName] = struct{}{}
+}
+
+// CreateRole create a new role
+func (mt *MetaTable) CreateRole(roleName string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.roles[roleName]; ok {
+		return fmt.Errorf("role already exists, role = %s", roleName)
+	}
+	k := fmt.Sprintf("%s/%s", RolePrefix, roleName)
+	v, err := proto.Marshal(&milvuspb.RoleEntity{Name: roleName})
+	if err != nil {
+		log.Error("MetaTable CreateRole Marshal RoleEntity fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("MetaTable CreateRole Marshal RoleEntity fail key:%s, err:%w", k, err)
+	}
+	err = mt.txn.Save(k, string(v))
+	if err != nil {
+		log.Error("TxnKV Save fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("TxnKV Save fail key:%s, err:%w", k, err)
+	}
+	mt.roles[roleName] = struct{}{}
+	return nil
+}
+
+// HasRole return whether the role exists
+func (mt *MetaTable) HasRole(roleName string) bool {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	_, ok := mt.roles[roleName]
+	return ok
+}
+
+// DropRole drop the role, the bindings and the grants of the role are removed too
+func (mt *MetaTable) DropRole(roleName string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.roles[roleName]; !ok {
+		return fmt.Errorf("role does not exist, role = %s", roleName)
+	}
+	keys := []string{fmt.Sprintf("%s/%s", RolePrefix, roleName)}
+	for username, roles := range mt.user2Roles {
+		if _, ok := roles[roleName]; ok {
+			keys = append(keys, userRoleKey(username, roleName))
+		}
+	}
+	var policies []string
+	for policy, grant := range mt.policy2Grant {
+		if grant.Role.Name == roleName {
+			policies = append(policies, policy)
+			keys = append(keys, grantKey(policy))
+		}
+	}
+	err := mt.txn.MultiRemove(keys)
+	if err != nil {
+		log.Error("TxnKV MultiRemove fail", zap.Strings("keys", keys), zap.Error(err))
+		return fmt.Errorf("TxnKV MultiRemove fail keys:%v, err:%w", keys, err)
+	}
+	delete(mt.roles, roleName)
+	for _, roles := range mt.user2Roles {
+		delete(roles, roleName)
+	}
+	for _, policy := range policies {
+		delete(mt.policy2Grant, policy)
+	}
+	return nil
+}
+
+// OperateUserRole bind the user to the role or unbind the user from the role
+func (mt *MetaTable) OperateUserRole(username string, roleName string, operateType milvuspb.OperateUserRoleType) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userName2Cred[username]; !ok {
+		return fmt.Errorf("user does not exist, username = %s", username)
+	}
+	if _, ok := mt.roles[roleName]; !ok {
+		return fmt.Errorf("role does not exist, role = %s", roleName)
+	}
+	_, bound := mt.user2Roles[username][roleName]
+	k := userRoleKey(username, roleName)
+	switch operateType {
+	case milvuspb.OperateUserRoleType_AddUserToRole:
+		if bound {
+			return fmt.Errorf("user already has the role, username = %s, role = %s", username, roleName)
+		}
+		err := mt.txn.Save(k, funcutil.EncodeUserRoleCache(username, roleName))
+		if err != nil {
+			log.Error("TxnKV Save fail", zap.String("key", k), zap.Error(err))
+			return fmt.Errorf("TxnKV Save fail key:%s, err:%w", k, err)
+		}
+		mt.bindUserRole(username, roleName)
+	case milvuspb.OperateUserRoleType_RemoveUserFromRole:
+		if !bound {
+			return fmt.Errorf("user does not have the role, username = %s, role = %s", username, roleName)
+		}
+		err := mt.txn.Remove(k)
+		if err != nil {
+			log.Error("TxnKV Remove fail", zap.String("key", k), zap.Error(err))
+			return fmt.Errorf("TxnKV Remove fail key:%s, err:%w", k, err)
+		}
+		delete(mt.user2Roles[username], roleName)
+	default:
+		return fmt.Errorf("invalid operate user role type: %s", operateType.String())
+	}
+	return nil
+}
+
+// OperatePrivilege grant the privilege to the role or revoke the privilege from the role
+func (mt *MetaTable) OperatePrivilege(grant *milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.roles[grant.Role.Name]; !ok {
+		return fmt.Errorf("role does not exist, role = %s", grant.Role.Name)
+	}
+	policy := policyOfGrant(grant)
+	_, granted := mt.policy2Grant[policy]
+	k := grantKey(policy)
+	switch operateType {
+	case milvuspb.OperatePrivilegeType_Grant:
+		if granted {
+			return fmt.Errorf("privilege already granted, policy = %s", policy)
+		}
+		v, err := proto.Marshal(grant)
+		if err != nil {
+			log.Error("MetaTable OperatePrivilege Marshal GrantEntity fail", zap.String("key", k), zap.Error(err))
+			return fmt.Errorf("MetaTable OperatePrivilege Marshal GrantEntity fail key:%s, err:%w", k, err)
+		}
+		err = mt.txn.Save(k, string(v))
+		if err != nil {
+			log.Error("TxnKV Save fail", zap.String("key", k), zap.Error(err))
+			return fmt.Errorf("TxnKV Save fail key:%s, err:%w", k, err)
+		}
+		mt.policy2Grant[policy] = proto.Clone(grant).(*milvuspb.GrantEntity)
+	case milvuspb.OperatePrivilegeType_Revoke:
+		if !granted {
+			return fmt.Errorf("privilege not granted, policy = %s", policy)
+		}
+		err := mt.txn.Remove(k)
+		if err != nil {
+			log.Error("TxnKV Remove fail", zap.String("key", k), zap.Error(err))
+			return fmt.Errorf("TxnKV Remove fail key:%s, err:%w", k, err)
+		}
+		delete(mt.policy2Grant, policy)
+	default:
+		return fmt.Errorf("invalid operate privilege type: %s", operateType.String())
+	}
+	return nil
+}
+
+// SelectGrant list the grants of the role in ascending order of the policies,
+// the grants are filtered by the object type, the database and the object name if they are specified
+func (mt *MetaTable) SelectGrant(filter *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	if _, ok := mt.roles[filter.Role.Name]; !ok {
+		return nil, fmt.Errorf("role does not exist, role = %s", filter.Role.Name)
+	}
+	var policies []string
+	for policy, grant := range mt.policy2Grant {
+		if grant.Role.Name != filter.Role.Name {
+			continue
+		}
+		if filter.Object != nil && filter.Object.Name != "" && grant.Object.Name != filter.Object.Name {
+			continue
+		}
+		if filter.DbName != "" && grant.DbName != filter.DbName {
+			continue
+		}
+		if filter.ObjectName != "" && grant.ObjectName != filter.ObjectName {
+			continue
+		}
+		policies = append(policies, policy)
+	}
+	sort.Strings(policies)
+	grants := make([]*milvuspb.GrantEntity, 0, len(policies))
+	for _, policy := range policies {
+		grants = append(grants, proto.Clone(mt.policy2Grant[policy]).(*milvuspb.GrantEntity))
+	}
+	return grants, nil
+}
+
+// ListPolicy return all the grants encoded by funcutil.PolicyForPrivilege and
+// all the user-role bindings encoded by funcutil.EncodeUserRoleCache
+func (mt *MetaTable) ListPolicy() ([]string, []string) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	policies := make([]string, 0, len(mt.policy2Grant))
+	for policy := range mt.policy2Grant {
+		policies = append(policies, policy)
+	}
+	var userRoles []string
+	for username, roles := range mt.user2Roles {
+		for roleName := range roles {
+			userRoles = append(userRoles, funcutil.EncodeUserRoleCache(username, roleName))
+		}
+	}
+	sort.Strings(policies)
+	sort.Strings(userRoles)
+	return policies, userRoles
+}
@@ -19,11 +19,14 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -296,7 +299,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, uint64(1), ts)
 
-		collMeta, err := mt.GetCollectionByName(collName, 0)
+		collMeta, err := mt.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, collMeta.CreateTime, ts)
 		assert.Equal(t, collMeta.PartitionCreatedTimestamps[0], ts)
@@ -305,7 +308,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		assert.True(t, mt.HasCollection(collInfo.ID, 0))
 
-		field, err := mt.GetFieldSchema(collName, "field110")
+		field, err := mt.GetFieldSchema("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, collInfo.Schema.Fields[0].FieldID, field.FieldID)
 
@@ -317,27 +320,27 @@ func TestMetaTable(t *testing.T) {
 
 	t.Run("add alias", func(t *testing.T) {
 		ts := ftso()
-		exists := mt.IsAlias(aliasName1)
+		exists := mt.IsAlias("", aliasName1)
 		assert.False(t, exists)
-		err = mt.AddAlias(aliasName1, collName, ts)
+		err = mt.AddAlias("", aliasName1, collName, ts)
 		assert.Nil(t, err)
 		aliases := mt.ListAliases(collID)
 		assert.Equal(t, aliases, []string{aliasName1})
-		exists = mt.IsAlias(aliasName1)
+		exists = mt.IsAlias("", aliasName1)
 		assert.True(t, exists)
 	})
 
 	t.Run("alter alias", func(t *testing.T) {
 		ts := ftso()
-		err = mt.AlterAlias(aliasName1, collName, ts)
+		err = mt.AlterAlias("", aliasName1, collName, ts)
 		assert.Nil(t, err)
-		err = mt.AlterAlias(aliasName1, collNameInvalid, ts)
+		err = mt.AlterAlias("", aliasName1, collNameInvalid, ts)
 		assert.NotNil(t, err)
 	})
 
 	t.Run("delete alias", func(t *testing.T) {
 		ts := ftso()
-		err = mt.DropAlias(aliasName1, ts)
+		err = mt.DropAlias("", aliasName1, ts)
 		assert.Nil(t, err)
 	})
 
@@ -409,9 +412,9 @@ func TestMetaTable(t *testing.T) {
 			IndexParams: params,
 		}
 
-		_, _, err := mt.GetNotIndexedSegments("collTest", "field110", idxInfo, nil)
+		_, _, err := mt.GetNotIndexedSegments("", "collTest", "field110", idxInfo, nil)
 		assert.NotNil(t, err)
-		seg, field, err := mt.GetNotIndexedSegments(collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		seg, field, err := mt.GetNotIndexedSegments("", collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(seg))
 		assert.Equal(t, segID2, seg[0])
@@ -427,7 +430,7 @@ func TestMetaTable(t *testing.T) {
 		idxInfo.IndexID = 2001
 		idxInfo.IndexName = "field110-1"
 
-		seg, field, err = mt.GetNotIndexedSegments(collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		seg, field, err = mt.GetNotIndexedSegments("", collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(seg))
 		assert.Equal(t, segID, seg[0])
@@ -437,7 +440,7 @@ func TestMetaTable(t *testing.T) {
 	})
 
 	t.Run("get index by name", func(t *testing.T) {
-		_, idx, err := mt.GetIndexByName(collName, "field110")
+		_, idx, err := mt.GetIndexByName("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 		assert.Equal(t, indexID, idx[0].IndexID)
@@ -453,7 +456,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		assert.True(t, EqualKeyPairArray(idx[0].IndexParams, params))
 
-		_, idx, err = mt.GetIndexByName(collName, "idx201")
+		_, idx, err = mt.GetIndexByName("", collName, "idx201")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idx))
 	})
@@ -475,20 +478,20 @@ func TestMetaTable(t *testing.T) {
 	})
 
 	t.Run("drop index", func(t *testing.T) {
-		idx, ok, err := mt.DropIndex(collName, "field110", "field110")
+		idx, ok, err := mt.DropIndex("", collName, "field110", "field110")
 		assert.Nil(t, err)
 		assert.True(t, ok)
 		assert.Equal(t, indexID, idx)
 
-		_, ok, err = mt.DropIndex(collName, "field110", "field110-error")
+		_, ok, err = mt.DropIndex("", collName, "field110", "field110-error")
 		assert.Nil(t, err)
 		assert.False(t, ok)
 
-		_, idxs, err := mt.GetIndexByName(collName, "field110")
+		_, idxs, err := mt.GetIndexByName("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idxs))
 
-		_, idxs, err = mt.GetIndexByName(collName, "field110-1")
+		_, idxs, err = mt.GetIndexByName("", collName, "field110-1")
 		assert.Nil(t, err)
 		assert.Equal(t, len(idxs), 1)
 		assert.Equal(t, idxs[0].IndexID, int64(2001))
@@ -514,12 +517,12 @@ func TestMetaTable(t *testing.T) {
 		err = mt.DeleteCollection(collIDInvalid, ts, "")
 		assert.NotNil(t, err)
 		ts2 := ftso()
-		err = mt.AddAlias(aliasName2, collName, ts2)
+		err = mt.AddAlias("", aliasName2, collName, ts2)
 		assert.Nil(t, err)
 		err = mt.DeleteCollection(collID, ts, "")
 		assert.Nil(t, err)
 		ts3 := ftso()
-		err = mt.DropAlias(aliasName2, ts3)
+		err = mt.DropAlias("", aliasName2, ts3)
 		assert.NotNil(t, err)
 
 		// check DD operation flag
@@ -580,7 +583,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.GetCollectionByName(collInfo.Schema.Name, 0)
+		_, err = mt.GetCollectionByName("", collInfo.Schema.Name, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find collection %s with id %d", collInfo.Schema.Name, collInfo.ID))
 
@@ -782,16 +785,16 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 
-		_, _, err = mt.DropIndex("abc", "abc", "abc")
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name = abc not exist")
 
-		mt.collName2ID["abc"] = 2
-		_, _, err = mt.DropIndex("abc", "abc", "abc")
+		setName2ID(mt.collName2ID, common.DefaultDBID, "abc", 2)
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name  = abc not has meta")
 
-		_, _, err = mt.DropIndex(collInfo.Schema.Name, "abc", "abc")
+		_, _, err = mt.DropIndex("", collInfo.Schema.Name, "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed abc", collInfo.Schema.Name))
 
@@ -808,7 +811,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		mt.collID2Meta[coll.ID] = coll
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		idxID, isDroped, err := mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
+		idxID, isDroped, err := mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
 		assert.Zero(t, idxID)
 		assert.False(t, isDroped)
 		assert.Nil(t, err)
@@ -824,7 +827,7 @@ func TestMetaTable(t *testing.T) {
 		mockTxnKV.multiSaveAndRemoveWithPrefix = func(saves map[string]string, removals []string) error {
 			return fmt.Errorf("multi save and remove with prefix error")
 		}
-		assert.Panics(t, func() { mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName) })
+		assert.Panics(t, func() { mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName) })
 	})
 
 	t.Run("get segment index info by id", func(t *testing.T) {
@@ -897,12 +900,12 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 
-		mt.collName2ID = make(map[string]int64)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		mt.collName2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 	})
@@ -947,8 +950,8 @@ func TestMetaTable(t *testing.T) {
 			},
 		}
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetNotIndexedSegments("abc", "no-field", idx, nil)
+		setName2ID(mt.collName2ID, common.DefaultDBID, "abc", 123)
+		_, _, err = mt.GetNotIndexedSegments("", "abc", "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -968,13 +971,13 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, "no-field", idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed no-field", collInfo.Schema.Name))
 
 		bakMeta := mt.indexID2Meta
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 		mt.indexID2Meta = bakMeta
@@ -982,8 +985,8 @@ func TestMetaTable(t *testing.T) {
 		mockTxnKV.multiSave = func(kvs map[string]string) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 
@@ -1016,8 +1019,8 @@ func TestMetaTable(t *testing.T) {
 		mockTxnKV.multiSave = func(kvs map[string]string) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 	})
@@ -1029,8 +1032,8 @@ func TestMetaTable(t *testing.T) {
 		err := mt.reloadFromKV()
 		assert.Nil(t, err)
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetIndexByName("abc", "hij")
+		setName2ID(mt.collName2ID, common.DefaultDBID, "abc", 123)
+		_, _, err = mt.GetIndexByName("", "abc", "hij")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -1050,7 +1053,7 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetIndexByName(collInfo.Schema.Name, idxInfo[0].IndexName)
+		_, _, err = mt.GetIndexByName("", collInfo.Schema.Name, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 
@@ -1155,29 +1158,29 @@ func TestMetaWithTimestamp(t *testing.T) {
 	c2, err = mt.GetCollectionByID(collID2, tsoStart)
 	assert.NotNil(t, err)
 
-	c1, err = mt.GetCollectionByName(collName1, 0)
+	c1, err = mt.GetCollectionByName("", collName1, 0)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, 0)
+	c2, err = mt.GetCollectionByName("", collName2, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t2)
+	c1, err = mt.GetCollectionByName("", collName1, t2)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t2)
+	c2, err = mt.GetCollectionByName("", collName2, t2)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t1)
+	c1, err = mt.GetCollectionByName("", collName1, t1)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t1)
+	c2, err = mt.GetCollectionByName("", collName2, t1)
 	assert.NotNil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, tsoStart)
+	c1, err = mt.GetCollectionByName("", collName1, tsoStart)
 	assert.NotNil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, tsoStart)
+	c2, err = mt.GetCollectionByName("", collName2, tsoStart)
 	assert.NotNil(t, err)
 
 	getKeys := func(m map[string]*pb.CollectionInfo) []string {
@@ -1188,22 +1191,22 @@ func TestMetaWithTimestamp(t *testing.T) {
 		return keys
 	}
 
-	s1, err := mt.ListCollections(0)
+	s1, err := mt.ListCollections("", 0)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t2)
+	s1, err = mt.ListCollections("", t2)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t1)
+	s1, err = mt.ListCollections("", t1)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1})
 
-	s1, err = mt.ListCollections(tsoStart)
+	s1, err = mt.ListCollections("", tsoStart)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(s1))
 
@@ -1257,3 +1260,514 @@ func TestFixIssue10540(t *testing.T) {
 	_, err = NewMetaTable(txnKV, skv)
 	assert.Nil(t, err)
 }
+
+func TestMetaTable_Credential(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := memkv.NewMemoryKV()
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	t.Run("add credential", func(t *testing.T) {
+		err := mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd1"})
+		assert.Nil(t, err)
+		err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user2", EncryptedPassword: "pwd2", Sha256Password: "sha"})
+		assert.Nil(t, err)
+
+		err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd"})
+		assert.NotNil(t, err)
+		err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user3"})
+		assert.NotNil(t, err)
+
+		assert.Equal(t, []string{"user1", "user2"}, mt.ListCredentialUsernames())
+	})
+
+	t.Run("get credential", func(t *testing.T) {
+		cred, err := mt.GetCredential("user2")
+		assert.Nil(t, err)
+		assert.Equal(t, "pwd2", cred.EncryptedPassword)
+		assert.Equal(t, "", cred.Sha256Password)
+
+		_, err = mt.GetCredential("user3")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("alter credential", func(t *testing.T) {
+		err := mt.AlterCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "newPwd1"})
+		assert.Nil(t, err)
+		cred, err := mt.GetCredential("user1")
+		assert.Nil(t, err)
+		assert.Equal(t, "newPwd1", cred.EncryptedPassword)
+
+		err = mt.AlterCredential(&internalpb.CredentialInfo{Username: "user3", EncryptedPassword: "pwd3"})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("reload credential", func(t *testing.T) {
+		mt2, err := NewMetaTable(txnKV, skv)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"user1", "user2"}, mt2.ListCredentialUsernames())
+		cred, err := mt2.GetCredential("user1")
+		assert.Nil(t, err)
+		assert.Equal(t, "newPwd1", cred.EncryptedPassword)
+	})
+
+	t.Run("delete credential", func(t *testing.T) {
+		err := mt.DeleteCredential("user1")
+		assert.Nil(t, err)
+		err = mt.DeleteCredential("user1")
+		assert.NotNil(t, err)
+		assert.Equal(t, []string{"user2"}, mt.ListCredentialUsernames())
+	})
+}
+
+func TestMetaTable_Rbac(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := memkv.NewMemoryKV()
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd1"})
+	assert.Nil(t, err)
+
+	grant := func(roleName string, objectName string, privilege string) *milvuspb.GrantEntity {
+		return &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: roleName},
+			Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+			ObjectName: objectName,
+			Grantor: &milvuspb.GrantorEntity{
+				User:      &milvuspb.UserEntity{Name: "root"},
+				Privilege: &milvuspb.PrivilegeEntity{Name: privilege},
+			},
+		}
+	}
+
+	t.Run("create role", func(t *testing.T) {
+		err := mt.CreateRole("role1")
+		assert.Nil(t, err)
+		err = mt.CreateRole("role1")
+		assert.NotNil(t, err)
+		assert.True(t, mt.HasRole("role1"))
+		assert.False(t, mt.HasRole("role2"))
+	})
+
+	t.Run("operate user role", func(t *testing.T) {
+		err := mt.OperateUserRole("user1", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.Nil(t, err)
+		err = mt.OperateUserRole("user1", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.NotNil(t, err)
+		err = mt.OperateUserRole("user2", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.NotNil(t, err)
+		err = mt.OperateUserRole("user1", "role2", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.NotNil(t, err)
+		err = mt.OperateUserRole("user1", "role1", milvuspb.OperateUserRoleType_RemoveUserFromRole)
+		assert.Nil(t, err)
+		err = mt.OperateUserRole("user1", "role1", milvuspb.OperateUserRoleType_RemoveUserFromRole)
+		assert.NotNil(t, err)
+		err = mt.OperateUserRole("user1", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.Nil(t, err)
+	})
+
+	t.Run("operate privilege", func(t *testing.T) {
+		err := mt.OperatePrivilege(grant("role1", "col1", "Insert"), milvuspb.OperatePrivilegeType_Grant)
+		assert.Nil(t, err)
+		err = mt.OperatePrivilege(grant("role1", "col1", "Insert"), milvuspb.OperatePrivilegeType_Grant)
+		assert.NotNil(t, err)
+		err = mt.OperatePrivilege(grant("role1", "col2", "Search"), milvuspb.OperatePrivilegeType_Grant)
+		assert.Nil(t, err)
+		err = mt.OperatePrivilege(grant("role2", "col1", "Insert"), milvuspb.OperatePrivilegeType_Grant)
+		assert.NotNil(t, err)
+		err = mt.OperatePrivilege(grant("role1", "col3", "Insert"), milvuspb.OperatePrivilegeType_Revoke)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("select grant", func(t *testing.T) {
+		grants, err := mt.SelectGrant(&milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: "role1"}})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(grants))
+		grants, err = mt.SelectGrant(&milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: "role1"}, ObjectName: "col2"})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(grants))
+		assert.Equal(t, "Search", grants[0].Grantor.Privilege.Name)
+		_, err = mt.SelectGrant(&milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: "role2"}})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("reload rbac", func(t *testing.T) {
+		mt2, err := NewMetaTable(txnKV, skv)
+		assert.Nil(t, err)
+		assert.True(t, mt2.HasRole("role1"))
+		policies, userRoles := mt2.ListPolicy()
+		assert.Equal(t, []string{"role1/Collection/default.col1/Insert", "role1/Collection/default.col2/Search"}, policies)
+		assert.Equal(t, []string{"user1/role1"}, userRoles)
+	})
+
+	t.Run("revoke privilege", func(t *testing.T) {
+		err := mt.OperatePrivilege(grant("role1", "col2", "Search"), milvuspb.OperatePrivilegeType_Revoke)
+		assert.Nil(t, err)
+		policies, _ := mt.ListPolicy()
+		assert.Equal(t, []string{"role1/Collection/default.col1/Insert"}, policies)
+	})
+
+	t.Run("grant in another database", func(t *testing.T) {
+		dbGrant := grant("role1", "col1", "Insert")
+		dbGrant.DbName = "db1"
+		err := mt.OperatePrivilege(dbGrant, milvuspb.OperatePrivilegeType_Grant)
+		assert.Nil(t, err)
+		policies, _ := mt.ListPolicy()
+		assert.Equal(t, []string{"role1/Collection/db1.col1/Insert", "role1/Collection/default.col1/Insert"}, policies)
+		grants, err := mt.SelectGrant(&milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: "role1"}, DbName: "db1"})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(grants))
+		err = mt.OperatePrivilege(dbGrant, milvuspb.OperatePrivilegeType_Revoke)
+		assert.Nil(t, err)
+	})
+
+	t.Run("drop role", func(t *testing.T) {
+		err := mt.DropRole("role1")
+		assert.Nil(t, err)
+		err = mt.DropRole("role1")
+		assert.NotNil(t, err)
+		policies, userRoles := mt.ListPolicy()
+		assert.Empty(t, policies)
+		assert.Empty(t, userRoles)
+	})
+
+	t.Run("delete user", func(t *testing.T) {
+		err := mt.CreateRole("role1")
+		assert.Nil(t, err)
+		err = mt.OperateUserRole("user1", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.Nil(t, err)
+		err = mt.DeleteCredential("user1")
+		assert.Nil(t, err)
+		_, userRoles := mt.ListPolicy()
+		assert.Empty(t, userRoles)
+	})
+}
+
+func TestMetaTable_Database(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := memkv.NewMemoryKV()
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	const dbName = "db1"
+	const dbID = int64(100)
+	const collName = "coll"
+	newCollection := func(collID typeutil.UniqueID, dbID typeutil.UniqueID) *pb.CollectionInfo {
+		return &pb.CollectionInfo{
+			ID:     collID,
+			DbId:   dbID,
+			Schema: &schemapb.CollectionSchema{Name: collName},
+		}
+	}
+
+	t.Run("default database", func(t *testing.T) {
+		dbInfo, err := mt.GetDatabaseByName("")
+		assert.Nil(t, err)
+		assert.Equal(t, common.DefaultDBName, dbInfo.Name)
+		assert.Equal(t, common.DefaultDBID, dbInfo.ID)
+
+		err = mt.DropDatabase(common.DefaultDBName, ftso())
+		assert.NotNil(t, err)
+	})
+
+	t.Run("create database", func(t *testing.T) {
+		err := mt.CreateDatabase(&pb.DatabaseInfo{ID: dbID, Name: dbName}, ftso())
+		assert.Nil(t, err)
+		err = mt.CreateDatabase(&pb.DatabaseInfo{ID: dbID + 1, Name: dbName}, ftso())
+		assert.NotNil(t, err)
+
+		dbs := mt.ListDatabases()
+		assert.Equal(t, 2, len(dbs))
+		assert.Equal(t, dbName, dbs[0].Name)
+		assert.Equal(t, common.DefaultDBName, dbs[1].Name)
+
+		_, err = mt.GetDatabaseByName("db2")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("collections scoped by database", func(t *testing.T) {
+		err := mt.AddCollection(newCollection(1, common.DefaultDBID), ftso(), nil, "")
+		assert.Nil(t, err)
+		err = mt.AddCollection(newCollection(2, dbID), ftso(), nil, "")
+		assert.Nil(t, err)
+		err = mt.AddCollection(newCollection(3, dbID), ftso(), nil, "")
+		assert.NotNil(t, err)
+		err = mt.AddCollection(newCollection(4, dbID+1), ftso(), nil, "")
+		assert.NotNil(t, err)
+
+		coll, err := mt.GetCollectionByName("", collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), coll.ID)
+		coll, err = mt.GetCollectionByName(dbName, collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), coll.ID)
+		_, err = mt.GetCollectionByName("db2", collName, 0)
+		assert.NotNil(t, err)
+
+		colls, err := mt.ListCollections(dbName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(colls))
+		assert.Equal(t, int64(2), colls[collName].ID)
+	})
+
+	t.Run("aliases scoped by database", func(t *testing.T) {
+		err := mt.AddAlias(dbName, "alias", collName, ftso())
+		assert.Nil(t, err)
+		assert.True(t, mt.IsAlias(dbName, "alias"))
+		assert.False(t, mt.IsAlias("", "alias"))
+
+		err = mt.AddAlias("", "alias", collName, ftso())
+		assert.Nil(t, err)
+		coll, err := mt.GetCollectionByName("", "alias", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), coll.ID)
+		coll, err = mt.GetCollectionByName(dbName, "alias", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), coll.ID)
+	})
+
+	t.Run("reload meta", func(t *testing.T) {
+		mt, err := NewMetaTable(txnKV, skv)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(mt.ListDatabases()))
+		coll, err := mt.GetCollectionByName(dbName, "alias", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), coll.ID)
+		coll, err = mt.GetCollectionByName("", "alias", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), coll.ID)
+	})
+
+	t.Run("drop database", func(t *testing.T) {
+		err := mt.DropDatabase(dbName, ftso())
+		assert.NotNil(t, err)
+
+		err = mt.DeleteCollection(2, ftso(), "")
+		assert.Nil(t, err)
+		assert.False(t, mt.IsAlias(dbName, "alias"))
+		assert.True(t, mt.IsAlias("", "alias"))
+
+		err = mt.DropDatabase(dbName, ftso())
+		assert.Nil(t, err)
+		err = mt.DropDatabase(dbName, ftso())
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, len(mt.ListDatabases()))
+
+		mt, err := NewMetaTable(txnKV, skv)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(mt.ListDatabases()))
+	})
+}
+
+func TestMetaTable_MultiVectorFields(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := memkv.NewMemoryKV()
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	const (
+		collName = "coll"
+		collID   = typeutil.UniqueID(1)
+		partID   = typeutil.UniqueID(10)
+		segID    = typeutil.UniqueID(100)
+		fieldID1 = typeutil.UniqueID(101)
+		fieldID2 = typeutil.UniqueID(102)
+	)
+	collInfo := &pb.CollectionInfo{
+		ID: collID,
+		Schema: &schemapb.CollectionSchema{
+			Name: collName,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: fieldID1, Name: "vec1", DataType: schemapb.DataType_FloatVector},
+				{FieldID: fieldID2, Name: "vec2", DataType: schemapb.DataType_BinaryVector},
+			},
+		},
+		PartitionIDs:               []typeutil.UniqueID{partID},
+		PartitionNames:             []string{Params.DefaultPartitionName},
+		PartitionCreatedTimestamps: []uint64{0},
+	}
+	err = mt.AddCollection(collInfo, 1, nil, "")
+	assert.Nil(t, err)
+
+	newIndex := func(indexID typeutil.UniqueID, indexType string) *pb.IndexInfo {
+		return &pb.IndexInfo{
+			IndexName:   Params.DefaultIndexName,
+			IndexID:     indexID,
+			IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: indexType}},
+		}
+	}
+
+	t.Run("create indexes", func(t *testing.T) {
+		segIDs, field, err := mt.GetNotIndexedSegments("", collName, "vec1", newIndex(1000, "IVF_FLAT"), []typeutil.UniqueID{segID})
+		assert.Nil(t, err)
+		assert.Equal(t, fieldID1, field.FieldID)
+		assert.Equal(t, []typeutil.UniqueID{segID}, segIDs)
+		segIDs, field, err = mt.GetNotIndexedSegments("", collName, "vec2", newIndex(2000, "BIN_IVF_FLAT"), []typeutil.UniqueID{segID})
+		assert.Nil(t, err)
+		assert.Equal(t, fieldID2, field.FieldID)
+		assert.Equal(t, []typeutil.UniqueID{segID}, segIDs)
+
+		// the index of the other field is not renamed
+		_, indexes, err := mt.GetIndexByName("", collName, Params.DefaultIndexName)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(indexes))
+
+		for _, info := range []pb.SegmentIndexInfo{
+			{CollectionID: collID, PartitionID: partID, SegmentID: segID, FieldID: fieldID1, IndexID: 1000, BuildID: 1001, EnableIndex: true},
+			{CollectionID: collID, PartitionID: partID, SegmentID: segID, FieldID: fieldID2, IndexID: 2000, BuildID: 2001, EnableIndex: true},
+		} {
+			info := info
+			assert.Nil(t, mt.AddIndex(&info))
+		}
+	})
+
+	t.Run("segment index of each field", func(t *testing.T) {
+		info, err := mt.GetSegmentIndexInfoByID(segID, fieldID1, "")
+		assert.Nil(t, err)
+		assert.Equal(t, typeutil.UniqueID(1001), info.BuildID)
+		info, err = mt.GetSegmentIndexInfoByID(segID, fieldID2, "")
+		assert.Nil(t, err)
+		assert.Equal(t, typeutil.UniqueID(2001), info.BuildID)
+		_, err = mt.GetSegmentIndexInfoByID(segID, 103, "")
+		assert.NotNil(t, err)
+		_, err = mt.GetSegmentIndexInfoByID(segID, -1, "")
+		assert.Nil(t, err)
+	})
+
+	t.Run("drop the index of a field", func(t *testing.T) {
+		dropped, ok, err := mt.DropIndex("", collName, "vec2", Params.DefaultIndexName)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, typeutil.UniqueID(2000), dropped)
+
+		_, indexes, err := mt.GetIndexByName("", collName, Params.DefaultIndexName)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(indexes))
+		assert.Equal(t, typeutil.UniqueID(1000), indexes[0].IndexID)
+		_, err = mt.GetSegmentIndexInfoByID(segID, fieldID2, "")
+		assert.NotNil(t, err)
+		info, err := mt.GetSegmentIndexInfoByID(segID, fieldID1, "")
+		assert.Nil(t, err)
+		assert.Equal(t, typeutil.UniqueID(1001), info.BuildID)
+	})
+}
+
+func TestMetaTable_AddCollectionField(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := memkv.NewMemoryKV()
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	const (
+		collName = "coll"
+		collID   = typeutil.UniqueID(1)
+		partID   = typeutil.UniqueID(10)
+	)
+	collInfo := &pb.CollectionInfo{
+		ID: collID,
+		Schema: &schemapb.CollectionSchema{
+			Name: collName,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+				{FieldID: RowIDField, Name: RowIDFieldName, DataType: schemapb.DataType_Int64},
+				{FieldID: TimeStampField, Name: TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			},
+		},
+		PartitionIDs:               []typeutil.UniqueID{partID},
+		PartitionNames:             []string{Params.DefaultPartitionName},
+		PartitionCreatedTimestamps: []uint64{0},
+	}
+	err = mt.AddCollection(collInfo, 1, nil, "")
+	assert.Nil(t, err)
+
+	field := &schemapb.FieldSchema{Name: "price", DataType: schemapb.DataType_Double, Nullable: true}
+	err = mt.AddCollectionField(collID, field, 2)
+	assert.Nil(t, err)
+
+	coll, err := mt.GetCollectionByID(collID, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), coll.SchemaVersion)
+	assert.Equal(t, 5, len(coll.Schema.Fields))
+	assert.Equal(t, "price", coll.Schema.Fields[4].Name)
+	assert.Equal(t, int64(102), coll.Schema.Fields[4].FieldID)
+	assert.True(t, coll.Schema.Fields[4].Nullable)
+
+	// the schema before the field was added is kept in the snapshot
+	coll, err = mt.GetCollectionByID(collID, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), coll.SchemaVersion)
+	assert.Equal(t, 4, len(coll.Schema.Fields))
+	coll, err = mt.GetCollectionByID(collID, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(coll.Schema.Fields))
+
+	err = mt.AddCollectionField(collID, field, 3)
+	assert.NotNil(t, err)
+	err = mt.AddCollectionField(collID+1, &schemapb.FieldSchema{Name: "tag"}, 3)
+	assert.NotNil(t, err)
+
+	err = mt.AddCollectionField(collID, &schemapb.FieldSchema{Name: "tag", DataType: schemapb.DataType_Int32, Nullable: true}, 4)
+	assert.Nil(t, err)
+	coll, err = mt.GetCollectionByName("", collName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), coll.SchemaVersion)
+	assert.Equal(t, int64(103), coll.Schema.Fields[5].FieldID)
+}
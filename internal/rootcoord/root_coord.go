@@ -14,6 +14,7 @@ package rootcoord
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
@@ -40,6 +42,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -123,6 +127,8 @@ type Core struct {
 	//query service interface, notify query service to release collection
 	CallReleaseCollectionService func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID) error
 	CallReleasePartitionService  func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error
+	// list the collections loaded by query service
+	CallShowLoadedCollectionsService func(ctx context.Context, ts typeutil.Timestamp, dbID typeutil.UniqueID) ([]typeutil.UniqueID, error)
 
 	CallWatchChannels func(ctx context.Context, collectionID int64, channelNames []string) error
 
@@ -247,6 +253,9 @@ func (c *Core) checkInit() error {
 	if c.CallReleasePartitionService == nil {
 		return fmt.Errorf("CallReleasePartitionService is nil")
 	}
+	if c.CallShowLoadedCollectionsService == nil {
+		return fmt.Errorf("CallShowLoadedCollectionsService is nil")
+	}
 
 	return nil
 }
@@ -829,6 +838,31 @@ func (c *Core) SetQueryCoord(s types.QueryCoord) error {
 		}
 		return nil
 	}
+	c.CallShowLoadedCollectionsService = func(ctx context.Context, ts typeutil.Timestamp, dbID typeutil.UniqueID) (collectionIDs []typeutil.UniqueID, retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("show collections from query service panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req := &querypb.ShowCollectionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ShowCollections,
+				MsgID:     0, //TODO, msg ID
+				Timestamp: ts,
+				SourceID:  c.session.ServerID,
+			},
+			DbID: dbID,
+		}
+		rsp, err := s.ShowCollections(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if rsp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("ShowCollections from query service failed, error = %s", rsp.Status.Reason)
+		}
+		return rsp.CollectionIDs, nil
+	}
 	return nil
 }
 
@@ -905,6 +939,14 @@ func (c *Core) Init() error {
 				log.Error("RootCoord, Failed to new MetaTable", zap.Any("reason", initError))
 				return initError
 			}
+			if initError = c.initCredentials(); initError != nil {
+				log.Error("RootCoord, Failed to init credentials", zap.Error(initError))
+				return initError
+			}
+			if initError = c.initRbac(); initError != nil {
+				log.Error("RootCoord, Failed to init rbac", zap.Error(initError))
+				return initError
+			}
 
 			return nil
 		}
@@ -1040,7 +1082,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		if err = proto.Unmarshal(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1055,7 +1097,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		}
 		ts = ddReq.Base.Timestamp
 		dbName, collName = ddReq.DbName, ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1070,7 +1112,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		}
 		ts = ddReq.Base.Timestamp
 		dbName, collName = ddReq.DbName, ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1088,7 +1130,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		}
 		ts = ddReq.Base.Timestamp
 		dbName, collName = ddReq.DbName, ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -2160,3 +2202,544 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 		Reason:    "",
 	}, nil
 }
+
+// AddCollectionField append a field to the schema of the collection
+func (c *Core) AddCollectionField(ctx context.Context, in *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("AddCollectionField", zap.String("name", in.CollectionName))
+	t := &AddCollectionFieldReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("AddCollectionField failed", zap.String("name", in.CollectionName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Add collection field failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("AddCollectionField Success", zap.String("name", in.CollectionName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// CreateDatabase create a database
+func (c *Core) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateDatabase", zap.String("dbname", in.DbName))
+	t := &CreateDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateDatabase failed", zap.String("dbname", in.DbName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Create database failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateDatabase Success", zap.String("dbname", in.DbName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// DropDatabase drop a database, the database must be empty
+func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropDatabase", zap.String("dbname", in.DbName))
+	t := &DropDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DropDatabase failed", zap.String("dbname", in.DbName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Drop database failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropDatabase Success", zap.String("dbname", in.DbName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// ListDatabases list all the databases
+func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListDatabases")
+	t := &ListDatabasesReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListDatabasesResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListDatabases failed", zap.Error(err))
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "ListDatabases failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListDatabases Success", zap.Strings("dbnames", t.Rsp.DbNames))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+// initCredentials creates the default root user if it doesn't exist, otherwise nobody could log in
+// after the authentication is enabled
+func (c *Core) initCredentials() error {
+	if _, err := c.MetaTable.GetCredential(common.DefaultRootUser); err == nil {
+		return nil
+	}
+	encryptedPwd, err := crypto.PasswordEncrypt(common.DefaultRootPassword)
+	if err != nil {
+		return err
+	}
+	log.Debug("RootCoord create default root user", zap.String("username", common.DefaultRootUser))
+	return c.MetaTable.AddCredential(&internalpb.CredentialInfo{
+		Username:          common.DefaultRootUser,
+		EncryptedPassword: encryptedPwd,
+	})
+}
+
+// CreateCredential create a new user, the password has been encrypted by proxy
+func (c *Core) CreateCredential(ctx context.Context, credInfo *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateCredential", zap.String("username", credInfo.Username))
+	err := c.MetaTable.AddCredential(credInfo)
+	if err != nil {
+		log.Debug("CreateCredential failed", zap.String("username", credInfo.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateCredentialFailure,
+			Reason:    "CreateCredential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateCredential Success", zap.String("username", credInfo.Username))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// GetCredential get the encrypted password of the user, only used by proxy
+func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("GetCredential", zap.String("username", in.Username))
+	credInfo, err := c.MetaTable.GetCredential(in.Username)
+	if err != nil {
+		log.Debug("GetCredential failed", zap.String("username", in.Username), zap.Error(err))
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_GetCredentialFailure,
+				Reason:    "GetCredential failed: " + err.Error(),
+			},
+		}, nil
+	}
+	return &rootcoordpb.GetCredentialResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Username: credInfo.Username,
+		Password: credInfo.EncryptedPassword,
+	}, nil
+}
+
+// UpdateCredential replace the password of an existing user, and invalidate the credential cache of proxies
+func (c *Core) UpdateCredential(ctx context.Context, credInfo *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("UpdateCredential", zap.String("username", credInfo.Username))
+	err := c.MetaTable.AlterCredential(credInfo)
+	if err != nil {
+		log.Debug("UpdateCredential failed", zap.String("username", credInfo.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UpdateCredentialFailure,
+			Reason:    "UpdateCredential failed: " + err.Error(),
+		}, nil
+	}
+	c.invalidateCredentialCache(ctx, commonpb.MsgType_UpdateCredential, credInfo.Username)
+	log.Debug("UpdateCredential Success", zap.String("username", credInfo.Username))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// DeleteCredential delete the user, and invalidate the credential cache of proxies
+func (c *Core) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DeleteCredential", zap.String("username", in.Username))
+	err := c.MetaTable.DeleteCredential(in.Username)
+	if err != nil {
+		log.Debug("DeleteCredential failed", zap.String("username", in.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DeleteCredentialFailure,
+			Reason:    "DeleteCredential failed: " + err.Error(),
+		}, nil
+	}
+	c.invalidateCredentialCache(ctx, commonpb.MsgType_DeleteCredential, in.Username)
+	c.refreshPolicyInfoCache(ctx, typeutil.CacheDeleteUser, in.Username)
+	log.Debug("DeleteCredential Success", zap.String("username", in.Username))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// ListCredUsers list all the usernames
+func (c *Core) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	return &milvuspb.ListCredUsersResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Usernames: c.MetaTable.ListCredentialUsernames(),
+	}, nil
+}
+
+func (c *Core) invalidateCredentialCache(ctx context.Context, msgType commonpb.MsgType, username string) {
+	req := proxypb.InvalidateCredCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  msgType,
+			MsgID:    0, //TODO, msg id
+			SourceID: c.session.ServerID,
+		},
+		Username: username,
+	}
+	// error doesn't matter here
+	c.proxyClientManager.InvalidateCredentialCache(ctx, &req)
+}
+
+// initRbac creates the built-in roles if they don't exist
+func (c *Core) initRbac() error {
+	for _, roleName := range []string{common.RoleAdmin, common.RolePublic} {
+		if c.MetaTable.HasRole(roleName) {
+			continue
+		}
+		log.Debug("RootCoord create built-in role", zap.String("role", roleName))
+		if err := c.MetaTable.CreateRole(roleName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Core) refreshPolicyInfoCache(ctx context.Context, opType typeutil.CacheOpType, opKey string) {
+	req := proxypb.RefreshPolicyInfoCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_RefreshPolicyInfoCache,
+			MsgID:    0, //TODO, msg id
+			SourceID: c.session.ServerID,
+		},
+		OpType: int32(opType),
+		OpKey:  opKey,
+	}
+	// error doesn't matter here
+	c.proxyClientManager.RefreshPolicyInfoCache(ctx, &req)
+}
+
+func validGrantEntity(entity *milvuspb.GrantEntity) error {
+	if entity == nil || entity.Role == nil || entity.Role.Name == "" {
+		return errors.New("the role in the grant entity should not be empty")
+	}
+	if entity.Object == nil || !funcutil.IsValidObjectType(entity.Object.Name) {
+		return fmt.Errorf("invalid object type in the grant entity: %v", entity.Object)
+	}
+	if entity.ObjectName == "" {
+		return errors.New("the object name in the grant entity should not be empty")
+	}
+	if entity.Grantor == nil || entity.Grantor.Privilege == nil || !funcutil.IsValidPrivilegeName(entity.Grantor.Privilege.Name) {
+		return fmt.Errorf("invalid privilege in the grant entity: %v", entity.Grantor)
+	}
+	return nil
+}
+
+// normalizeGrantDbName sets the database of the collection objects to the default one if it isn't specified,
+// the database is meaningless for the other object types
+func normalizeGrantDbName(entity *milvuspb.GrantEntity) {
+	if entity.Object.Name != commonpb.ObjectType_Collection.String() {
+		entity.DbName = ""
+	} else if entity.DbName == "" {
+		entity.DbName = common.DefaultDBName
+	}
+}
+
+// CreateRole create a new role
+func (c *Core) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	if in.Entity == nil || in.Entity.Name == "" {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateRoleFailure,
+			Reason:    "CreateRole failed: the role name should not be empty",
+		}, nil
+	}
+	log.Debug("CreateRole", zap.String("role", in.Entity.Name))
+	err := c.MetaTable.CreateRole(in.Entity.Name)
+	if err != nil {
+		log.Debug("CreateRole failed", zap.String("role", in.Entity.Name), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CreateRoleFailure,
+			Reason:    "CreateRole failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateRole Success", zap.String("role", in.Entity.Name))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// DropRole drop the role, the bindings and the grants of the role are removed too
+func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropRole", zap.String("role", in.RoleName))
+	if in.RoleName == common.RoleAdmin || in.RoleName == common.RolePublic {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DropRoleFailure,
+			Reason:    fmt.Sprintf("DropRole failed: the built-in role %s cannot be dropped", in.RoleName),
+		}, nil
+	}
+	err := c.MetaTable.DropRole(in.RoleName)
+	if err != nil {
+		log.Debug("DropRole failed", zap.String("role", in.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DropRoleFailure,
+			Reason:    "DropRole failed: " + err.Error(),
+		}, nil
+	}
+	c.refreshPolicyInfoCache(ctx, typeutil.CacheDropRole, in.RoleName)
+	log.Debug("DropRole Success", zap.String("role", in.RoleName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// OperateUserRole bind the user to the role or unbind the user from the role
+func (c *Core) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("OperateUserRole", zap.String("username", in.Username), zap.String("role", in.RoleName),
+		zap.String("type", in.Type.String()))
+	err := c.MetaTable.OperateUserRole(in.Username, in.RoleName, in.Type)
+	if err != nil {
+		log.Debug("OperateUserRole failed", zap.String("username", in.Username), zap.String("role", in.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_OperateUserRoleFailure,
+			Reason:    "OperateUserRole failed: " + err.Error(),
+		}, nil
+	}
+	opType := typeutil.CacheAddUserToRole
+	if in.Type == milvuspb.OperateUserRoleType_RemoveUserFromRole {
+		opType = typeutil.CacheRemoveUserFromRole
+	}
+	c.refreshPolicyInfoCache(ctx, opType, funcutil.EncodeUserRoleCache(in.Username, in.RoleName))
+	log.Debug("OperateUserRole Success", zap.String("username", in.Username), zap.String("role", in.RoleName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// OperatePrivilege grant the privilege to the role or revoke the privilege from the role
+func (c *Core) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	if err := validGrantEntity(in.Entity); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_OperatePrivilegeFailure,
+			Reason:    "OperatePrivilege failed: " + err.Error(),
+		}, nil
+	}
+	normalizeGrantDbName(in.Entity)
+	policy := policyOfGrant(in.Entity)
+	log.Debug("OperatePrivilege", zap.String("policy", policy), zap.String("type", in.Type.String()))
+	err := c.MetaTable.OperatePrivilege(in.Entity, in.Type)
+	if err != nil {
+		log.Debug("OperatePrivilege failed", zap.String("policy", policy), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_OperatePrivilegeFailure,
+			Reason:    "OperatePrivilege failed: " + err.Error(),
+		}, nil
+	}
+	opType := typeutil.CacheGrantPrivilege
+	if in.Type == milvuspb.OperatePrivilegeType_Revoke {
+		opType = typeutil.CacheRevokePrivilege
+	}
+	c.refreshPolicyInfoCache(ctx, opType, policy)
+	log.Debug("OperatePrivilege Success", zap.String("policy", policy))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// SelectGrant list the grants of the role, filtered by the object type and the object name
+func (c *Core) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	if in.Entity == nil || in.Entity.Role == nil || in.Entity.Role.Name == "" {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_SelectGrantFailure,
+				Reason:    "SelectGrant failed: the role in the grant entity should not be empty",
+			},
+		}, nil
+	}
+	log.Debug("SelectGrant", zap.String("role", in.Entity.Role.Name))
+	grants, err := c.MetaTable.SelectGrant(in.Entity)
+	if err != nil {
+		log.Debug("SelectGrant failed", zap.String("role", in.Entity.Role.Name), zap.Error(err))
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_SelectGrantFailure,
+				Reason:    "SelectGrant failed: " + err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.SelectGrantResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Entities: grants,
+	}, nil
+}
+
+// ListPolicy list all the grants and the user-role bindings, only used by proxy
+func (c *Core) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &internalpb.ListPolicyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	policies, userRoles := c.MetaTable.ListPolicy()
+	return &internalpb.ListPolicyResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		PolicyInfos: policies,
+		UserRoles:   userRoles,
+	}, nil
+}
@@ -81,6 +81,20 @@ func (p *proxyMock) ReleaseDQLMessageStream(ctx context.Context, request *proxyp
 	}, nil
 }
 
+func (p *proxyMock) InvalidateCredentialCache(ctx context.Context, req *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (p *proxyMock) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 type dataMock struct {
 	types.DataCoord
 	randVal int
@@ -157,8 +171,9 @@ func (d *dataMock) WatchChannels(ctx context.Context, req *datapb.WatchChannelsR
 
 type queryMock struct {
 	types.QueryCoord
-	collID []typeutil.UniqueID
-	mutex  sync.Mutex
+	collID   []typeutil.UniqueID
+	loadedID []typeutil.UniqueID
+	mutex    sync.Mutex
 }
 
 func (q *queryMock) Init() error {
@@ -186,6 +201,18 @@ func (q *queryMock) ReleasePartitions(ctx context.Context, req *querypb.ReleaseP
 	}, nil
 }
 
+func (q *queryMock) ShowCollections(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return &querypb.ShowCollectionsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		CollectionIDs: q.loadedID,
+	}, nil
+}
+
 type indexMock struct {
 	types.IndexCoord
 	fileArray  []string
@@ -315,6 +342,10 @@ func createCollectionInMeta(dbName, collName string, core *Core, shardsNum int32
 	}
 	schema.Fields = append(schema.Fields, rowIDField, timeStampField)
 
+	dbInfo, err := core.MetaTable.GetDatabaseByName(dbName)
+	if err != nil {
+		return err
+	}
 	collID, _, err := core.IDAllocator(1)
 	if err != nil {
 		return fmt.Errorf("alloc collection id error = %w", err)
@@ -333,6 +364,7 @@ func createCollectionInMeta(dbName, collName string, core *Core, shardsNum int32
 
 	collInfo := etcdpb.CollectionInfo{
 		ID:                         collID,
+		DbId:                       dbInfo.ID,
 		Schema:                     &schema,
 		PartitionIDs:               []typeutil.UniqueID{partID},
 		PartitionNames:             []string{Params.DefaultPartitionName},
@@ -641,6 +673,31 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, ttm2.Base.Timestamp, ttm.Base.Timestamp+1)
 	})
 
+	t.Run("create database", func(t *testing.T) {
+		req := &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: dbName,
+		}
+		status, err := core.CreateDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.CreateDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+		rsp, err := core.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListDatabases,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.ElementsMatch(t, []string{common.DefaultDBName, dbName}, rsp.DbNames)
+	})
+
 	t.Run("create collection", func(t *testing.T) {
 		schema := schemapb.CollectionSchema{
 			Name:   collName,
@@ -671,10 +728,11 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 100,
 				SourceID:  100,
 			},
-			DbName:         dbName,
-			CollectionName: collName,
-			Schema:         sbf,
-			ShardsNum:      shardsNum,
+			DbName:           dbName,
+			CollectionName:   collName,
+			Schema:           sbf,
+			ShardsNum:        shardsNum,
+			ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
 		}
 		status, err := core.CreateCollection(ctx, req)
 		assert.Nil(t, err)
@@ -682,7 +740,7 @@ func TestRootCoord(t *testing.T) {
 
 		assert.Equal(t, shardsNum, int32(core.dmlChannels.GetNumChannels()))
 
-		createMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		createMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		dmlStream.AsConsumer([]string{createMeta.PhysicalChannelNames[0]}, Params.MsgChannelSubName)
 		dmlStream.Start()
@@ -705,6 +763,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, shardsNum, int32(len(createMeta.VirtualChannelNames)))
 		assert.Equal(t, shardsNum, int32(len(createMeta.PhysicalChannelNames)))
 		assert.Equal(t, shardsNum, createMeta.ShardsNum)
+		assert.Equal(t, commonpb.ConsistencyLevel_Bounded, createMeta.ConsistencyLevel)
 
 		vChanName := createMeta.VirtualChannelNames[0]
 		assert.Equal(t, createMeta.PhysicalChannelNames[0], ToPhysicalChannel(vChanName))
@@ -827,7 +886,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -847,6 +906,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, shardsNum, int32(len(rsp.VirtualChannelNames)))
 		assert.Equal(t, shardsNum, int32(len(rsp.PhysicalChannelNames)))
 		assert.Equal(t, shardsNum, rsp.ShardsNum)
+		assert.Equal(t, commonpb.ConsistencyLevel_Bounded, rsp.ConsistencyLevel)
 	})
 
 	t.Run("show collection", func(t *testing.T) {
@@ -883,7 +943,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		t.Log(status.Reason)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partNameIdx1, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -940,7 +1000,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -961,7 +1021,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		dm.mu.Lock()
@@ -993,7 +1053,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 180,
 				SourceID:  180,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			ExtraParams: []*commonpb.KeyValuePair{
@@ -1003,7 +1063,7 @@ func TestRootCoord(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(collMeta.FieldIndexes))
 
@@ -1014,7 +1074,7 @@ func TestRootCoord(t *testing.T) {
 		files := im.getFileArray()
 		assert.Equal(t, 3, len(files))
 		assert.ElementsMatch(t, files, []string{"file0-100", "file1-100", "file2-100"})
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		idxMeta, err := core.MetaTable.GetIndexByID(collMeta.FieldIndexes[0].IndexID)
@@ -1028,7 +1088,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -1055,7 +1115,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 200,
 				SourceID:  200,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			IndexName:      "",
@@ -1076,7 +1136,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 200,
 				SourceID:  200,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			IndexName:      "not-exist-index",
@@ -1088,7 +1148,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 
@@ -1113,7 +1173,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 210,
 				SourceID:  210,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			IndexName:      "",
@@ -1133,7 +1193,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 211,
 				SourceID:  211,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			ExtraParams: []*commonpb.KeyValuePair{
@@ -1144,7 +1204,7 @@ func TestRootCoord(t *testing.T) {
 			},
 		}
 
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		oldIdx := collMeta.FieldIndexes[0].IndexID
@@ -1154,7 +1214,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 		time.Sleep(100 * time.Millisecond)
 
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.FieldIndexes))
 		assert.Equal(t, oldIdx, collMeta.FieldIndexes[0].IndexID)
@@ -1177,12 +1237,12 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 215,
 				SourceID:  215,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			IndexName:      Params.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, Params.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName(dbName, collName, Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 
@@ -1195,7 +1255,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, idx[0].IndexID, im.idxDropID[0])
 		im.mutex.Unlock()
 
-		_, idx, err = core.MetaTable.GetIndexByName(collName, Params.DefaultIndexName)
+		_, idx, err = core.MetaTable.GetIndexByName(dbName, collName, Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(idx))
 	})
@@ -1212,13 +1272,13 @@ func TestRootCoord(t *testing.T) {
 			CollectionName: collName,
 			PartitionName:  partName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		dropPartID := collMeta.PartitionIDs[1]
 		status, err := core.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -1257,7 +1317,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("remove DQL msgstream", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 
 		req := &proxypb.ReleaseDQLMessageStreamRequest{
@@ -1283,7 +1343,7 @@ func TestRootCoord(t *testing.T) {
 			DbName:         dbName,
 			CollectionName: collName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		status, err := core.DropCollection(ctx, req)
 		assert.Nil(t, err)
@@ -1868,6 +1928,64 @@ func TestRootCoord(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
+	t.Run("add collection field", func(t *testing.T) {
+		field := &schemapb.FieldSchema{
+			Name:         "price",
+			DataType:     schemapb.DataType_Double,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 1.5}},
+		}
+		fieldBytes, err := proto.Marshal(field)
+		assert.Nil(t, err)
+		req := &milvuspb.AddCollectionFieldRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AddCollectionField,
+				MsgID:     3015,
+				Timestamp: 3015,
+				SourceID:  3015,
+			},
+			DbName:         dbName,
+			CollectionName: collName2,
+			Schema:         fieldBytes,
+		}
+		rsp, err := core.AddCollectionField(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName2, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(1), coll.SchemaVersion)
+		added := coll.Schema.Fields[len(coll.Schema.Fields)-1]
+		assert.Equal(t, "price", added.Name)
+		assert.Equal(t, 1.5, added.GetDefaultValue().GetDoubleData())
+
+		// duplicated field
+		rsp, err = core.AddCollectionField(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// neither nullable nor defaulted
+		fieldBytes, err = proto.Marshal(&schemapb.FieldSchema{Name: "tag", DataType: schemapb.DataType_Int32})
+		assert.Nil(t, err)
+		req.Schema = fieldBytes
+		rsp, err = core.AddCollectionField(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// the collection is loaded
+		fieldBytes, err = proto.Marshal(&schemapb.FieldSchema{Name: "tag", DataType: schemapb.DataType_Int32, Nullable: true})
+		assert.Nil(t, err)
+		req.Schema = fieldBytes
+		qm.mutex.Lock()
+		qm.loadedID = []typeutil.UniqueID{coll.ID}
+		qm.mutex.Unlock()
+		rsp, err = core.AddCollectionField(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+		qm.mutex.Lock()
+		qm.loadedID = nil
+		qm.mutex.Unlock()
+	})
+
 	t.Run("alter alias", func(t *testing.T) {
 		req := &milvuspb.AlterAliasRequest{
 			Base: &commonpb.MsgBase{
@@ -1974,6 +2092,136 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("credential", func(t *testing.T) {
+		// the default root user is created on init
+		listResp, err := core.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.Status.ErrorCode)
+		assert.Contains(t, listResp.Usernames, common.DefaultRootUser)
+
+		status, err := core.CreateCredential(ctx, &internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		status, err = core.CreateCredential(ctx, &internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CreateCredentialFailure, status.ErrorCode)
+
+		getResp, err := core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: "user1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, getResp.Status.ErrorCode)
+		assert.Equal(t, "pwd1", getResp.Password)
+
+		status, err = core.UpdateCredential(ctx, &internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd2"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		getResp, err = core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: "user1"})
+		assert.Nil(t, err)
+		assert.Equal(t, "pwd2", getResp.Password)
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: "user1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		getResp, err = core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: "user1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_GetCredentialFailure, getResp.Status.ErrorCode)
+		status, err = core.UpdateCredential(ctx, &internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd2"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UpdateCredentialFailure, status.ErrorCode)
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: "user1"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_DeleteCredentialFailure, status.ErrorCode)
+	})
+
+	t.Run("drop database", func(t *testing.T) {
+		req := &milvuspb.DropDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DropDatabase,
+			},
+			DbName: common.DefaultDBName,
+		}
+		status, err := core.DropDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+		status, err = core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: "emptyDb",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		req.DbName = "emptyDb"
+		status, err = core.DropDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.DropDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+
+	t.Run("rbac", func(t *testing.T) {
+		status, err := core.CreateCredential(ctx, &internalpb.CredentialInfo{Username: "rbacUser", EncryptedPassword: "pwd"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.CreateRole(ctx, &milvuspb.CreateRoleRequest{Entity: &milvuspb.RoleEntity{Name: "rbacRole"}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		status, err = core.CreateRole(ctx, &milvuspb.CreateRoleRequest{Entity: &milvuspb.RoleEntity{Name: "rbacRole"}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CreateRoleFailure, status.ErrorCode)
+
+		status, err = core.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{
+			Username: "rbacUser",
+			RoleName: "rbacRole",
+			Type:     milvuspb.OperateUserRoleType_AddUserToRole,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		entity := &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: "rbacRole"},
+			Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+			ObjectName: "col1",
+			Grantor: &milvuspb.GrantorEntity{
+				User:      &milvuspb.UserEntity{Name: common.DefaultRootUser},
+				Privilege: &milvuspb.PrivilegeEntity{Name: "Insert"},
+			},
+		}
+		status, err = core.OperatePrivilege(ctx, &milvuspb.OperatePrivilegeRequest{Entity: entity, Type: milvuspb.OperatePrivilegeType_Grant})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		selectResp, err := core.SelectGrant(ctx, &milvuspb.SelectGrantRequest{Entity: &milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: "rbacRole"}}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, selectResp.Status.ErrorCode)
+		assert.Equal(t, 1, len(selectResp.Entities))
+
+		policyResp, err := core.ListPolicy(ctx, &internalpb.ListPolicyRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, policyResp.Status.ErrorCode)
+		assert.Contains(t, policyResp.PolicyInfos, "rbacRole/Collection/default.col1/Insert")
+		assert.Contains(t, policyResp.UserRoles, "rbacUser/rbacRole")
+
+		status, err = core.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: common.RoleAdmin})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_DropRoleFailure, status.ErrorCode)
+		status, err = core.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: "rbacRole"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		policyResp, err = core.ListPolicy(ctx, &internalpb.ListPolicyRequest{})
+		assert.Nil(t, err)
+		assert.NotContains(t, policyResp.PolicyInfos, "rbacRole/Collection/default.col1/Insert")
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: "rbacUser"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	t.Run("get system info", func(t *testing.T) {
 		// normal case
 		systemInfoMetricType := metricsinfo.SystemInfoMetrics
@@ -2268,6 +2516,17 @@ func TestRootCoord2(t *testing.T) {
 		assert.Greater(t, ttm.Base.Timestamp, typeutil.Timestamp(0))
 	})
 
+	t.Run("create database", func(t *testing.T) {
+		status, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: dbName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	t.Run("create collection", func(t *testing.T) {
 		schema := schemapb.CollectionSchema{
 			Name: collName,
@@ -2289,7 +2548,7 @@ func TestRootCoord2(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
-		collInfo, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collInfo, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		dmlStream, _ := msFactory.NewMsgStream(ctx)
 		dmlStream.AsConsumer([]string{collInfo.PhysicalChannelNames[0]}, Params.MsgChannelSubName)
@@ -2304,7 +2563,7 @@ func TestRootCoord2(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -2447,6 +2706,12 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
+	c.CallShowLoadedCollectionsService = func(ctx context.Context, ts typeutil.Timestamp, dbID typeutil.UniqueID) ([]typeutil.UniqueID, error) {
+		return nil, nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
 	c.CallWatchChannels = func(ctx context.Context, collectionID int64, channelNames []string) error {
 		return nil
 	}
@@ -2688,10 +2953,12 @@ func TestRootCoord_CheckZeroShardsNum(t *testing.T) {
 		collInfo.ShardsNum = 0
 	}
 
+	err = core.MetaTable.CreateDatabase(&etcdpb.DatabaseInfo{ID: 1, Name: dbName}, 0)
+	assert.Nil(t, err)
 	createCollectionInMeta(dbName, collName, core, shardsNum, modifyFunc)
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
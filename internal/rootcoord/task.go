@@ -97,6 +97,25 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Req.ShardsNum <= 0 {
 		t.Req.ShardsNum = common.DefaultShardsNum
 	}
+	// a collection with a partition key is split into a fixed number of partitions, the rows are routed to them
+	// by the hash of the partition key
+	numPartitions := int64(1)
+	hasPartitionKey := typeutil.GetPartitionKeyField(&schema) != nil
+	if hasPartitionKey {
+		numPartitions = t.Req.NumPartitions
+		if numPartitions <= 0 {
+			numPartitions = common.DefaultPartitionsWithPartitionKey
+		}
+		if numPartitions > Params.MaxPartitionNum {
+			return fmt.Errorf("the number of partitions %d exceeds the limit %d", numPartitions, Params.MaxPartitionNum)
+		}
+	} else if t.Req.NumPartitions > 0 {
+		return fmt.Errorf("the number of partitions can only be set when the collection has a partition key")
+	}
+	dbInfo, err := t.core.MetaTable.GetDatabaseByName(t.Req.DbName)
+	if err != nil {
+		return err
+	}
 	log.Debug("CreateCollectionReqTask Execute", zap.Any("CollectionName", t.Req.CollectionName),
 		zap.Any("ShardsNum", t.Req.ShardsNum))
 
@@ -123,15 +142,25 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("alloc collection id error = %w", err)
 	}
-	partID, _, err := t.core.IDAllocator(1)
+	partID, _, err := t.core.IDAllocator(uint32(numPartitions))
 	if err != nil {
 		return fmt.Errorf("alloc partition id error = %w", err)
 	}
+	partIDs := []typeutil.UniqueID{partID}
+	partNames := []string{Params.DefaultPartitionName}
+	if hasPartitionKey {
+		partIDs = make([]typeutil.UniqueID, 0, numPartitions)
+		for i := int64(0); i < numPartitions; i++ {
+			partIDs = append(partIDs, partID+i)
+		}
+		partNames = typeutil.PartitionKeyPartitionNames(Params.DefaultPartitionName, numPartitions)
+	}
 
 	log.Debug("collection name -> id",
 		zap.String("collection name", t.Req.CollectionName),
 		zap.Int64("collection_id", collID),
-		zap.Int64("default partition id", partID))
+		zap.Int64("default partition id", partID),
+		zap.Int64("partitions", numPartitions))
 
 	vchanNames := make([]string, t.Req.ShardsNum)
 	chanNames := make([]string, t.Req.ShardsNum)
@@ -150,13 +179,15 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	collInfo := etcdpb.CollectionInfo{
 		ID:                         collID,
 		Schema:                     &schema,
-		PartitionIDs:               []typeutil.UniqueID{partID},
-		PartitionNames:             []string{Params.DefaultPartitionName},
+		PartitionIDs:               partIDs,
+		PartitionNames:             partNames,
 		FieldIndexes:               make([]*etcdpb.FieldIndexInfo, 0, 16),
 		VirtualChannelNames:        vchanNames,
 		PhysicalChannelNames:       chanNames,
 		ShardsNum:                  t.Req.ShardsNum,
-		PartitionCreatedTimestamps: []uint64{0},
+		ConsistencyLevel:           t.Req.ConsistencyLevel,
+		PartitionCreatedTimestamps: make([]uint64, numPartitions),
+		DbId:                       dbInfo.ID,
 	}
 
 	idxInfo := make([]*etcdpb.IndexInfo, 0, 16)
@@ -172,8 +203,8 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		Base:                 t.Req.Base,
 		DbName:               t.Req.DbName,
 		CollectionName:       t.Req.CollectionName,
-		PartitionName:        Params.DefaultPartitionName,
-		DbID:                 0, //TODO,not used
+		PartitionName:        partNames[0],
+		DbID:                 dbInfo.ID,
 		CollectionID:         collID,
 		PartitionID:          partID,
 		Schema:               schemaBytes,
@@ -265,11 +296,11 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropCollection {
 		return fmt.Errorf("drop collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	if t.core.MetaTable.IsAlias(t.Req.CollectionName) {
+	if t.core.MetaTable.IsAlias(t.Req.DbName, t.Req.CollectionName) {
 		return fmt.Errorf("cannot drop the collection via alias = %s", t.Req.CollectionName)
 	}
 
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -398,7 +429,7 @@ func (t *HasCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasCollection {
 		return fmt.Errorf("has collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+	_, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 	if err == nil {
 		t.HasCollection = true
 	} else {
@@ -428,7 +459,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	var err error
 
 	if t.Req.CollectionName != "" {
-		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 		if err != nil {
 			return err
 		}
@@ -453,6 +484,11 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	t.Rsp.CreatedUtcTimestamp = uint64(createdPhysicalTime)
 	t.Rsp.Aliases = t.core.MetaTable.ListAliases(collInfo.ID)
 	t.Rsp.StartPositions = collInfo.GetStartPositions()
+	t.Rsp.DbId = collInfo.DbId
+	t.Rsp.ConsistencyLevel = collInfo.ConsistencyLevel
+	if typeutil.GetPartitionKeyField(collInfo.Schema) != nil {
+		t.Rsp.NumPartitions = int64(len(collInfo.PartitionIDs))
+	}
 	return nil
 }
 
@@ -473,7 +509,7 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_ShowCollections {
 		return fmt.Errorf("show collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.ListCollections(t.Req.TimeStamp)
+	coll, err := t.core.MetaTable.ListCollections(t.Req.DbName, t.Req.TimeStamp)
 	if err != nil {
 		return err
 	}
@@ -503,10 +539,13 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_CreatePartition {
 		return fmt.Errorf("create partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
+	if typeutil.GetPartitionKeyField(collMeta.Schema) != nil {
+		return fmt.Errorf("can't create partition in collection %s with a partition key", t.Req.CollectionName)
+	}
 	partID, _, err := t.core.IDAllocator(1)
 	if err != nil {
 		return err
@@ -599,10 +638,13 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropPartition {
 		return fmt.Errorf("drop partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
+	if typeutil.GetPartitionKeyField(collInfo.Schema) != nil {
+		return fmt.Errorf("can't drop partition of collection %s with a partition key", t.Req.CollectionName)
+	}
 	partID, err := t.core.MetaTable.GetPartitionByName(collInfo.ID, t.Req.PartitionName, 0)
 	if err != nil {
 		return err
@@ -702,7 +744,7 @@ func (t *HasPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasPartition {
 		return fmt.Errorf("has partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -732,7 +774,7 @@ func (t *ShowPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Req.CollectionName == "" {
 		coll, err = t.core.MetaTable.GetCollectionByID(t.Req.CollectionID, 0)
 	} else {
-		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	}
 	if err != nil {
 		return err
@@ -787,8 +829,12 @@ func (t *DescribeSegmentReqTask) Execute(ctx context.Context) error {
 	if !exist {
 		return fmt.Errorf("segment id %d not belong to collection id %d", t.Req.SegmentID, t.Req.CollectionID)
 	}
-	//TODO, get filed_id and index_name from request
-	segIdxInfo, err := t.core.MetaTable.GetSegmentIndexInfoByID(t.Req.SegmentID, -1, "")
+	//TODO, get index_name from request
+	fieldID := int64(-1)
+	if t.Req.FieldID != 0 {
+		fieldID = t.Req.FieldID
+	}
+	segIdxInfo, err := t.core.MetaTable.GetSegmentIndexInfoByID(t.Req.SegmentID, fieldID, "")
 	log.Debug("RootCoord DescribeSegmentReqTask, MetaTable.GetSegmentIndexInfoByID", zap.Any("SegmentID", t.Req.SegmentID),
 		zap.Any("segIdxInfo", segIdxInfo), zap.Error(err))
 	if err != nil {
@@ -797,6 +843,7 @@ func (t *DescribeSegmentReqTask) Execute(ctx context.Context) error {
 	t.Rsp.IndexID = segIdxInfo.IndexID
 	t.Rsp.BuildID = segIdxInfo.BuildID
 	t.Rsp.EnableIndex = segIdxInfo.EnableIndex
+	t.Rsp.FieldID = segIdxInfo.FieldID
 	return nil
 }
 
@@ -868,7 +915,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		IndexID:     indexID,
 		IndexParams: t.Req.ExtraParams,
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -882,12 +929,12 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
+	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
 	if err != nil {
 		log.Debug("RootCoord CreateIndexReqTask metaTable.GetNotIndexedSegments", zap.Error(err))
 		return err
 	}
-	if field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
+	if !typeutil.IsVectorType(field.DataType) {
 		return fmt.Errorf("field name = %s, data type = %s", t.Req.FieldName, schemapb.DataType_name[int32(field.DataType)])
 	}
 
@@ -932,7 +979,7 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DescribeIndex {
 		return fmt.Errorf("describe index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		return err
 	}
@@ -942,6 +989,10 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 			log.Warn("Get field schema by index id failed", zap.String("collection name", t.Req.CollectionName), zap.String("index name", t.Req.IndexName), zap.Error(err))
 			continue
 		}
+		// the indexes of all the vector fields are listed if the field name is not specified
+		if t.Req.FieldName != "" && f.Name != t.Req.FieldName {
+			continue
+		}
 		desc := &milvuspb.IndexDescription{
 			IndexName: i.IndexName,
 			Params:    i.IndexParams,
@@ -969,11 +1020,20 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropIndex {
 		return fmt.Errorf("drop index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, info, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		log.Warn("GetIndexByName failed,", zap.String("collection name", t.Req.CollectionName), zap.String("field name", t.Req.FieldName), zap.String("index name", t.Req.IndexName), zap.Error(err))
 		return err
 	}
+	// the vector fields may have indexes of the same name, only the index of the field is dropped
+	info := make([]etcdpb.IndexInfo, 0, len(idx))
+	for _, i := range idx {
+		f, err := GetFieldSchemaByIndexID(&coll, i.IndexID)
+		if err != nil || f.Name != t.Req.FieldName {
+			continue
+		}
+		info = append(info, i)
+	}
 	if len(info) == 0 {
 		return nil
 	}
@@ -984,7 +1044,7 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	_, _, err = t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName)
+	_, _, err = t.core.MetaTable.DropIndex(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName)
 	return err
 }
 
@@ -1009,7 +1069,7 @@ func (t *CreateAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.AddAlias(t.Req.Alias, t.Req.CollectionName, ts)
+	err = t.core.MetaTable.AddAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName, ts)
 	if err != nil {
 		return fmt.Errorf("meta table add alias failed, error = %w", err)
 	}
@@ -1038,7 +1098,7 @@ func (t *DropAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.DropAlias(t.Req.Alias, ts)
+	err = t.core.MetaTable.DropAlias(t.Req.DbName, t.Req.Alias, ts)
 	if err != nil {
 		return fmt.Errorf("meta table drop alias failed, error = %w", err)
 	}
@@ -1050,6 +1110,7 @@ func (t *DropAliasReqTask) Execute(ctx context.Context) error {
 			Timestamp: ts,
 			SourceID:  t.core.session.ServerID,
 		},
+		DbName:         t.Req.DbName,
 		CollectionName: t.Req.Alias,
 	}
 	// error doesn't matter here
@@ -1079,7 +1140,7 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.AlterAlias(t.Req.Alias, t.Req.CollectionName, ts)
+	err = t.core.MetaTable.AlterAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName, ts)
 	if err != nil {
 		return fmt.Errorf("meta table alter alias failed, error = %w", err)
 	}
@@ -1091,6 +1152,7 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 			Timestamp: ts,
 			SourceID:  t.core.session.ServerID,
 		},
+		DbName:         t.Req.DbName,
 		CollectionName: t.Req.Alias,
 	}
 	// error doesn't matter here
@@ -1098,3 +1160,168 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 
 	return nil
 }
+
+// AddCollectionFieldReqTask add collection field request task
+type AddCollectionFieldReqTask struct {
+	baseReqTask
+	Req *milvuspb.AddCollectionFieldRequest
+}
+
+// Type return msg type
+func (t *AddCollectionFieldReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *AddCollectionFieldReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AddCollectionField {
+		return fmt.Errorf("add collection field, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	var field schemapb.FieldSchema
+	err := proto.Unmarshal(t.Req.Schema, &field)
+	if err != nil {
+		return fmt.Errorf("unmarshal field schema error = %w", err)
+	}
+	// the existing entities have no value of the field, so they are filled with the default value or null
+	if field.IsPrimaryKey || field.AutoID || typeutil.IsVectorType(field.DataType) {
+		return fmt.Errorf("field %s to add should be a scalar field other than the primary field", field.Name)
+	}
+	if !field.GetNullable() && field.GetDefaultValue() == nil {
+		return fmt.Errorf("field %s to add should be nullable or have a default value", field.Name)
+	}
+
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+
+	// lock for ddl operation
+	t.core.ddlLock.Lock()
+	defer t.core.ddlLock.Unlock()
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// the query nodes keep the schema of a collection since it's loaded, they would cut the values of the field off
+	// the rows inserted later, so the collection should be released before the field is added
+	loadedIDs, err := t.core.CallShowLoadedCollectionsService(ctx, ts, 0)
+	if err != nil {
+		return fmt.Errorf("show loaded collections failed, error = %w", err)
+	}
+	for _, id := range loadedIDs {
+		if id == collMeta.ID {
+			return fmt.Errorf("collection %s is loaded, release it before adding field %s", t.Req.CollectionName, field.Name)
+		}
+	}
+
+	err = t.core.MetaTable.AddCollectionField(collMeta.ID, &field, ts)
+	if err != nil {
+		return fmt.Errorf("meta table add collection field failed, error = %w", err)
+	}
+
+	// the collection may be cached by its aliases as well
+	names := append([]string{collMeta.Schema.Name}, t.core.MetaTable.ListAliases(collMeta.ID)...)
+	for _, name := range names {
+		req := proxypb.InvalidateCollMetaCacheRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   0, //TODO, msg type
+				MsgID:     0, //TODO, msg id
+				Timestamp: ts,
+				SourceID:  t.core.session.ServerID,
+			},
+			DbName:         t.Req.DbName,
+			CollectionName: name,
+		}
+		// error doesn't matter here
+		t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+	}
+
+	return nil
+}
+
+// CreateDatabaseReqTask create database request task
+type CreateDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateDatabaseRequest
+}
+
+// Type return msg type
+func (t *CreateDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *CreateDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateDatabase {
+		return fmt.Errorf("create database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	dbID, _, err := t.core.IDAllocator(1)
+	if err != nil {
+		return fmt.Errorf("alloc database id error = %w", err)
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	err = t.core.MetaTable.CreateDatabase(&etcdpb.DatabaseInfo{ID: dbID, Name: t.Req.DbName}, ts)
+	if err != nil {
+		return fmt.Errorf("meta table create database failed, error = %w", err)
+	}
+	return nil
+}
+
+// DropDatabaseReqTask drop database request task
+type DropDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropDatabaseRequest
+}
+
+// Type return msg type
+func (t *DropDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *DropDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropDatabase {
+		return fmt.Errorf("drop database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	err = t.core.MetaTable.DropDatabase(t.Req.DbName, ts)
+	if err != nil {
+		return fmt.Errorf("meta table drop database failed, error = %w", err)
+	}
+	return nil
+}
+
+// ListDatabasesReqTask list databases request task
+type ListDatabasesReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListDatabasesRequest
+	Rsp *milvuspb.ListDatabasesResponse
+}
+
+// Type return msg type
+func (t *ListDatabasesReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *ListDatabasesReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListDatabases {
+		return fmt.Errorf("list databases, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	for _, dbInfo := range t.core.MetaTable.ListDatabases() {
+		t.Rsp.DbNames = append(t.Rsp.DbNames, dbInfo.Name)
+		t.Rsp.CreatedTimestamps = append(t.Rsp.CreatedTimestamps, dbInfo.CreatedTime)
+	}
+	return nil
+}
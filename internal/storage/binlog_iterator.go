@@ -83,6 +83,11 @@ func (itr *InsertBinlogIterator) Next() (interface{}, error) {
 
 	m := make(map[FieldID]interface{})
 	for fieldID, fieldData := range itr.data.Data {
+		if validData := GetValidData(fieldData); len(validData) > 0 && !validData[itr.pos] {
+			// a null value
+			m[fieldID] = nil
+			continue
+		}
 		m[fieldID] = fieldData.Get(itr.pos)
 	}
 
@@ -11,6 +11,7 @@
 
 #include "ParquetWrapper.h"
 #include "PayloadStream.h"
+#include <arrow/util/bit_util.h>
 
 static const char *ErrorMsg(const std::string &msg) {
   if (msg.empty()) return nullptr;
@@ -77,6 +78,27 @@ CPayloadWriter NewPayloadWriter(int columnType) {
       p->schema = arrow::schema({arrow::field("val", arrow::utf8())});
       break;
     }
+    case ColumnType::ARRAY : {
+      // each array is stored as the serialized bytes of a ScalarField
+      p->columnType = ColumnType::ARRAY;
+      p->builder = std::make_shared<arrow::BinaryBuilder>();
+      p->schema = arrow::schema({arrow::field("val", arrow::binary())});
+      break;
+    }
+    case ColumnType::JSON : {
+      // each row is stored as the bytes of its serialized JSON document
+      p->columnType = ColumnType::JSON;
+      p->builder = std::make_shared<arrow::BinaryBuilder>();
+      p->schema = arrow::schema({arrow::field("val", arrow::binary())});
+      break;
+    }
+    case ColumnType::VECTOR_SPARSE_FLOAT : {
+      // each row is stored as its (uint32 index, float32 value) pairs
+      p->columnType = ColumnType::VECTOR_SPARSE_FLOAT;
+      p->builder = std::make_shared<arrow::BinaryBuilder>();
+      p->schema = arrow::schema({arrow::field("val", arrow::binary())});
+      break;
+    }
     case ColumnType::VECTOR_BINARY : {
       p->columnType = ColumnType::VECTOR_BINARY;
       p->dimension = wrapper::EMPTY_DIMENSION;
@@ -87,6 +109,16 @@ CPayloadWriter NewPayloadWriter(int columnType) {
       p->dimension = wrapper::EMPTY_DIMENSION;
       break;
     }
+    case ColumnType::VECTOR_FLOAT16 : {
+      p->columnType = ColumnType::VECTOR_FLOAT16;
+      p->dimension = wrapper::EMPTY_DIMENSION;
+      break;
+    }
+    case ColumnType::VECTOR_BFLOAT16 : {
+      p->columnType = ColumnType::VECTOR_BFLOAT16;
+      p->dimension = wrapper::EMPTY_DIMENSION;
+      break;
+    }
     default: {
       delete p;
       return nullptr;
@@ -194,6 +226,90 @@ CStatus AddOneStringToPayload(CPayloadWriter payloadWriter, char *cstr, int str_
   return st;
 }
 
+extern "C"
+CStatus AddOneArrayToPayload(CPayloadWriter payloadWriter, uint8_t *data, int length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+
+  auto p = reinterpret_cast<wrapper::PayloadWriter *>(payloadWriter);
+  auto builder = std::dynamic_pointer_cast<arrow::BinaryBuilder>(p->builder);
+  if (builder == nullptr || p->columnType != ColumnType::ARRAY) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("incorrect data type");
+    return st;
+  }
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("payload has finished");
+    return st;
+  }
+  auto ast = builder->Append(data, length);
+  if (!ast.ok()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg(ast.message());
+    return st;
+  }
+  p->rows++;
+  return st;
+}
+
+extern "C"
+CStatus AddOneJSONToPayload(CPayloadWriter payloadWriter, uint8_t *data, int length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+
+  auto p = reinterpret_cast<wrapper::PayloadWriter *>(payloadWriter);
+  auto builder = std::dynamic_pointer_cast<arrow::BinaryBuilder>(p->builder);
+  if (builder == nullptr || p->columnType != ColumnType::JSON) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("incorrect data type");
+    return st;
+  }
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("payload has finished");
+    return st;
+  }
+  auto ast = builder->Append(data, length);
+  if (!ast.ok()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg(ast.message());
+    return st;
+  }
+  p->rows++;
+  return st;
+}
+
+extern "C"
+CStatus AddOneSparseFloatVectorToPayload(CPayloadWriter payloadWriter, uint8_t *data, int length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+
+  auto p = reinterpret_cast<wrapper::PayloadWriter *>(payloadWriter);
+  auto builder = std::dynamic_pointer_cast<arrow::BinaryBuilder>(p->builder);
+  if (builder == nullptr || p->columnType != ColumnType::VECTOR_SPARSE_FLOAT) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("incorrect data type");
+    return st;
+  }
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("payload has finished");
+    return st;
+  }
+  auto ast = builder->Append(data, length);
+  if (!ast.ok()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg(ast.message());
+    return st;
+  }
+  p->rows++;
+  return st;
+}
+
 extern "C"
 CStatus AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length) {
   CStatus st;
@@ -286,6 +402,96 @@ CStatus AddFloatVectorToPayload(CPayloadWriter payloadWriter, float *values, int
   return st;
 }
 
+// float16 and bfloat16 vectors are stored as 2 bytes per element
+CStatus AddHalfFloatVectorToPayload(CPayloadWriter payloadWriter,
+                                    ColumnType columnType,
+                                    uint8_t *values,
+                                    int dimension,
+                                    int length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  if (length <= 0) return st;
+
+  auto p = reinterpret_cast<wrapper::PayloadWriter *>(payloadWriter);
+  if (p->columnType != columnType) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("incorrect data type");
+    return st;
+  }
+  if (p->dimension == wrapper::EMPTY_DIMENSION) {
+    if (dimension <= 0) {
+      st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+      st.error_msg = ErrorMsg("incorrect dimension value");
+      return st;
+    }
+    if (p->builder != nullptr) {
+      st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+      st.error_msg = ErrorMsg("incorrect data type");
+      return st;
+    }
+    p->builder = std::make_shared<arrow::FixedSizeBinaryBuilder>(arrow::fixed_size_binary(dimension * 2));
+    p->schema = arrow::schema({arrow::field("val", arrow::fixed_size_binary(dimension * 2))});
+    p->dimension = dimension;
+  } else if (p->dimension != dimension) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("dimension changed");
+    return st;
+  }
+  auto builder = std::dynamic_pointer_cast<arrow::FixedSizeBinaryBuilder>(p->builder);
+  if (builder == nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("incorrect data type");
+    return st;
+  }
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("payload has finished");
+    return st;
+  }
+  auto ast = builder->AppendValues(values, length);
+  if (!ast.ok()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg(ast.message());
+    return st;
+  }
+  p->rows += length;
+  return st;
+}
+
+extern "C"
+CStatus AddFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length) {
+  return AddHalfFloatVectorToPayload(payloadWriter, ColumnType::VECTOR_FLOAT16, values, dimension, length);
+}
+
+extern "C"
+CStatus AddBFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length) {
+  return AddHalfFloatVectorToPayload(payloadWriter, ColumnType::VECTOR_BFLOAT16, values, dimension, length);
+}
+
+// the validity of the rows of a nullable column, it is applied to the values when the payload is finished
+extern "C"
+CStatus AddValidDataToPayload(CPayloadWriter payloadWriter, bool *valid_data, int length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  if (length <= 0) return st;
+
+  auto p = reinterpret_cast<wrapper::PayloadWriter *>(payloadWriter);
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("payload has finished");
+    return st;
+  }
+  if (p->dimension != wrapper::EMPTY_DIMENSION) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("vector can not be null");
+    return st;
+  }
+  p->valid_data.insert(p->valid_data.end(), valid_data, valid_data + length);
+  return st;
+}
+
 extern "C"
 CStatus FinishPayloadWriter(CPayloadWriter payloadWriter) {
   CStatus st;
@@ -305,6 +511,29 @@ CStatus FinishPayloadWriter(CPayloadWriter payloadWriter) {
       st.error_msg = ErrorMsg(ast.message());
       return st;
     }
+    if (!p->valid_data.empty()) {
+      if (static_cast<int64_t>(p->valid_data.size()) != array->length()) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("the length of valid data mismatches with the rows");
+        return st;
+      }
+      std::shared_ptr<arrow::Buffer> null_bitmap;
+      ast = arrow::AllocateEmptyBitmap(array->length()).Value(&null_bitmap);
+      if (!ast.ok()) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg(ast.message());
+        return st;
+      }
+      for (int64_t i = 0; i < array->length(); i++) {
+        if (p->valid_data[i]) {
+          arrow::BitUtil::SetBit(null_bitmap->mutable_data(), i);
+        }
+      }
+      auto data = array->data()->Copy();
+      data->buffers[0] = null_bitmap;
+      data->null_count = arrow::kUnknownNullCount;
+      array = arrow::MakeArray(data);
+    }
     auto table = arrow::Table::Make(p->schema, {array});
     p->output = std::make_shared<wrapper::PayloadOutputStream>();
     ast = parquet::arrow::WriteTable(*table, arrow::default_memory_pool(), p->output, 1024 * 1024 * 1024);
@@ -351,6 +580,7 @@ extern "C"
 CPayloadReader NewPayloadReader(int columnType, uint8_t *buffer, int64_t buf_size) {
   auto p = new wrapper::PayloadReader;
   p->bValues = nullptr;
+  p->validValues = nullptr;
   p->input = std::make_shared<wrapper::PayloadInputStream>(buffer, buf_size);
   auto st = parquet::arrow::OpenFile(p->input, arrow::default_memory_pool(), &p->reader);
   if (!st.ok()) {
@@ -376,8 +606,13 @@ CPayloadReader NewPayloadReader(int columnType, uint8_t *buffer, int64_t buf_siz
     case ColumnType::FLOAT :
     case ColumnType::DOUBLE :
     case ColumnType::STRING :
+    case ColumnType::ARRAY :
+    case ColumnType::JSON :
     case ColumnType::VECTOR_BINARY :
-    case ColumnType::VECTOR_FLOAT : {
+    case ColumnType::VECTOR_FLOAT :
+    case ColumnType::VECTOR_FLOAT16 :
+    case ColumnType::VECTOR_BFLOAT16 :
+    case ColumnType::VECTOR_SPARSE_FLOAT : {
       break;
     }
     default: {
@@ -482,6 +717,75 @@ CStatus GetOneStringFromPayload(CPayloadReader payloadReader, int idx, char **cs
   return st;
 }
 
+extern "C"
+CStatus GetOneArrayFromPayload(CPayloadReader payloadReader, int idx, uint8_t **data, int *length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
+  auto array = std::dynamic_pointer_cast<arrow::BinaryArray>(p->array);
+  if (array == nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("Incorrect data type");
+    return st;
+  }
+  if (idx >= array->length()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("memory overflow");
+    return st;
+  }
+  arrow::BinaryArray::offset_type size;
+  *data = (uint8_t *) array->GetValue(idx, &size);
+  *length = size;
+  return st;
+}
+
+extern "C"
+CStatus GetOneJSONFromPayload(CPayloadReader payloadReader, int idx, uint8_t **data, int *length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
+  auto array = std::dynamic_pointer_cast<arrow::BinaryArray>(p->array);
+  if (array == nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("Incorrect data type");
+    return st;
+  }
+  if (idx >= array->length()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("memory overflow");
+    return st;
+  }
+  arrow::BinaryArray::offset_type size;
+  *data = (uint8_t *) array->GetValue(idx, &size);
+  *length = size;
+  return st;
+}
+
+extern "C"
+CStatus GetOneSparseFloatVectorFromPayload(CPayloadReader payloadReader, int idx, uint8_t **data, int *length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
+  auto array = std::dynamic_pointer_cast<arrow::BinaryArray>(p->array);
+  if (array == nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("Incorrect data type");
+    return st;
+  }
+  if (idx >= array->length()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("memory overflow");
+    return st;
+  }
+  arrow::BinaryArray::offset_type size;
+  *data = (uint8_t *) array->GetValue(idx, &size);
+  *length = size;
+  return st;
+}
+
 extern "C"
 CStatus GetBinaryVectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length) {
   CStatus st;
@@ -518,6 +822,52 @@ CStatus GetFloatVectorFromPayload(CPayloadReader payloadReader, float **values,
   return st;
 }
 
+CStatus GetHalfFloatVectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
+  auto array = std::dynamic_pointer_cast<arrow::FixedSizeBinaryArray>(p->array);
+  if (array == nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("Incorrect data type");
+    return st;
+  }
+  *dimension = array->byte_width() / 2;
+  *length = array->length();
+  *values = (uint8_t *) array->raw_values();
+  return st;
+}
+
+extern "C"
+CStatus GetFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length) {
+  return GetHalfFloatVectorFromPayload(payloadReader, values, dimension, length);
+}
+
+extern "C"
+CStatus GetBFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length) {
+  return GetHalfFloatVectorFromPayload(payloadReader, values, dimension, length);
+}
+
+// the validity of the rows, all the rows are valid if the column has no null value
+extern "C"
+CStatus GetValidDataFromPayload(CPayloadReader payloadReader, bool **valid_data, int *length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
+  if (p->validValues == nullptr) {
+    int len = p->array->length();
+    p->validValues = new bool[len];
+    for (int i = 0; i < len; i++) {
+      p->validValues[i] = p->array->IsValid(i);
+    }
+  }
+  *valid_data = p->validValues;
+  *length = p->array->length();
+  return st;
+}
+
 extern "C"
 int GetPayloadLengthFromReader(CPayloadReader payloadReader) {
   auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
@@ -532,6 +882,7 @@ CStatus ReleasePayloadReader(CPayloadReader payloadReader) {
   st.error_msg = nullptr;
   auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
   delete[] p->bValues;
+  delete[] p->validValues;
   delete p;
   return st;
 }
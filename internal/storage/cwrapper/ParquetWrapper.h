@@ -39,8 +39,14 @@ CStatus AddInt64ToPayload(CPayloadWriter payloadWriter, int64_t *values, int len
 CStatus AddFloatToPayload(CPayloadWriter payloadWriter, float *values, int length);
 CStatus AddDoubleToPayload(CPayloadWriter payloadWriter, double *values, int length);
 CStatus AddOneStringToPayload(CPayloadWriter payloadWriter, char *cstr, int str_size);
+CStatus AddOneArrayToPayload(CPayloadWriter payloadWriter, uint8_t *data, int length);
+CStatus AddOneJSONToPayload(CPayloadWriter payloadWriter, uint8_t *data, int length);
 CStatus AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length);
 CStatus AddFloatVectorToPayload(CPayloadWriter payloadWriter, float *values, int dimension, int length);
+CStatus AddFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length);
+CStatus AddBFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length);
+CStatus AddOneSparseFloatVectorToPayload(CPayloadWriter payloadWriter, uint8_t *data, int length);
+CStatus AddValidDataToPayload(CPayloadWriter payloadWriter, bool *valid_data, int length);
 
 CStatus FinishPayloadWriter(CPayloadWriter payloadWriter);
 CBuffer GetPayloadBufferFromWriter(CPayloadWriter payloadWriter);
@@ -58,8 +64,14 @@ CStatus GetInt64FromPayload(CPayloadReader payloadReader, int64_t **values, int
 CStatus GetFloatFromPayload(CPayloadReader payloadReader, float **values, int *length);
 CStatus GetDoubleFromPayload(CPayloadReader payloadReader, double **values, int *length);
 CStatus GetOneStringFromPayload(CPayloadReader payloadReader, int idx, char **cstr, int *str_size);
+CStatus GetOneArrayFromPayload(CPayloadReader payloadReader, int idx, uint8_t **data, int *length);
+CStatus GetOneJSONFromPayload(CPayloadReader payloadReader, int idx, uint8_t **data, int *length);
 CStatus GetBinaryVectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length);
 CStatus GetFloatVectorFromPayload(CPayloadReader payloadReader, float **values, int *dimension, int *length);
+CStatus GetFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length);
+CStatus GetBFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length);
+CStatus GetOneSparseFloatVectorFromPayload(CPayloadReader payloadReader, int idx, uint8_t **data, int *length);
+CStatus GetValidDataFromPayload(CPayloadReader payloadReader, bool **valid_data, int *length);
 
 int GetPayloadLengthFromReader(CPayloadReader payloadReader);
 CStatus ReleasePayloadReader(CPayloadReader payloadReader);
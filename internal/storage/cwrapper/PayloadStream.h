@@ -31,6 +31,7 @@ struct PayloadWriter {
   std::shared_ptr<arrow::Schema> schema;
   std::shared_ptr<PayloadOutputStream> output;
   int rows;
+  std::vector<uint8_t> valid_data; // validity of the rows of a nullable column, empty if all the rows are valid
 };
 
 struct PayloadReader {
@@ -41,6 +42,7 @@ struct PayloadReader {
   std::shared_ptr<arrow::ChunkedArray> column;
   std::shared_ptr<arrow::Array> array;
   bool *bValues;
+  bool *validValues;
 };
 
 class PayloadOutputStream : public arrow::io::OutputStream {
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"go.uber.org/zap"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
@@ -98,36 +99,54 @@ type FieldData interface {
 }
 
 type BoolFieldData struct {
-	NumRows []int64
-	Data    []bool
+	NumRows   []int64
+	Data      []bool
+	ValidData []bool // nil if all the rows are valid
 }
 type Int8FieldData struct {
-	NumRows []int64
-	Data    []int8
+	NumRows   []int64
+	Data      []int8
+	ValidData []bool // nil if all the rows are valid
 }
 type Int16FieldData struct {
-	NumRows []int64
-	Data    []int16
+	NumRows   []int64
+	Data      []int16
+	ValidData []bool // nil if all the rows are valid
 }
 type Int32FieldData struct {
-	NumRows []int64
-	Data    []int32
+	NumRows   []int64
+	Data      []int32
+	ValidData []bool // nil if all the rows are valid
 }
 type Int64FieldData struct {
-	NumRows []int64
-	Data    []int64
+	NumRows   []int64
+	Data      []int64
+	ValidData []bool // nil if all the rows are valid
 }
 type FloatFieldData struct {
-	NumRows []int64
-	Data    []float32
+	NumRows   []int64
+	Data      []float32
+	ValidData []bool // nil if all the rows are valid
 }
 type DoubleFieldData struct {
-	NumRows []int64
-	Data    []float64
+	NumRows   []int64
+	Data      []float64
+	ValidData []bool // nil if all the rows are valid
 }
 type StringFieldData struct {
-	NumRows []int64
-	Data    []string
+	NumRows   []int64
+	Data      []string
+	ValidData []bool // nil if all the rows are valid
+}
+type ArrayFieldData struct {
+	NumRows   []int64
+	Data      []*schemapb.ScalarField
+	ValidData []bool // nil if all the rows are valid
+}
+type JSONFieldData struct {
+	NumRows   []int64
+	Data      [][]byte // each row is a serialized JSON document
+	ValidData []bool   // nil if all the rows are valid
 }
 type BinaryVectorFieldData struct {
 	NumRows []int64
@@ -139,28 +158,52 @@ type FloatVectorFieldData struct {
 	Data    []float32
 	Dim     int
 }
+type Float16VectorFieldData struct {
+	NumRows []int64
+	Data    []byte // 2 bytes per element
+	Dim     int
+}
+type BFloat16VectorFieldData struct {
+	NumRows []int64
+	Data    []byte // 2 bytes per element
+	Dim     int
+}
+type SparseFloatVectorFieldData struct {
+	NumRows []int64
+	Data    [][]byte // each row is (uint32 index, float32 value) pairs
+}
 
-func (data *BoolFieldData) Length() int         { return len(data.Data) }
-func (data *Int8FieldData) Length() int         { return len(data.Data) }
-func (data *Int16FieldData) Length() int        { return len(data.Data) }
-func (data *Int32FieldData) Length() int        { return len(data.Data) }
-func (data *Int64FieldData) Length() int        { return len(data.Data) }
-func (data *FloatFieldData) Length() int        { return len(data.Data) }
-func (data *DoubleFieldData) Length() int       { return len(data.Data) }
-func (data *StringFieldData) Length() int       { return len(data.Data) }
-func (data *BinaryVectorFieldData) Length() int { return len(data.Data) }
-func (data *FloatVectorFieldData) Length() int  { return len(data.Data) }
-
-func (data *BoolFieldData) Get(i int) interface{}         { return data.Data[i] }
-func (data *Int8FieldData) Get(i int) interface{}         { return data.Data[i] }
-func (data *Int16FieldData) Get(i int) interface{}        { return data.Data[i] }
-func (data *Int32FieldData) Get(i int) interface{}        { return data.Data[i] }
-func (data *Int64FieldData) Get(i int) interface{}        { return data.Data[i] }
-func (data *FloatFieldData) Get(i int) interface{}        { return data.Data[i] }
-func (data *DoubleFieldData) Get(i int) interface{}       { return data.Data[i] }
-func (data *StringFieldData) Get(i int) interface{}       { return data.Data[i] }
-func (data *BinaryVectorFieldData) Get(i int) interface{} { return data.Data[i] }
-func (data *FloatVectorFieldData) Get(i int) interface{}  { return data.Data[i] }
+func (data *BoolFieldData) Length() int              { return len(data.Data) }
+func (data *Int8FieldData) Length() int              { return len(data.Data) }
+func (data *Int16FieldData) Length() int             { return len(data.Data) }
+func (data *Int32FieldData) Length() int             { return len(data.Data) }
+func (data *Int64FieldData) Length() int             { return len(data.Data) }
+func (data *FloatFieldData) Length() int             { return len(data.Data) }
+func (data *DoubleFieldData) Length() int            { return len(data.Data) }
+func (data *StringFieldData) Length() int            { return len(data.Data) }
+func (data *ArrayFieldData) Length() int             { return len(data.Data) }
+func (data *JSONFieldData) Length() int              { return len(data.Data) }
+func (data *BinaryVectorFieldData) Length() int      { return len(data.Data) }
+func (data *FloatVectorFieldData) Length() int       { return len(data.Data) }
+func (data *Float16VectorFieldData) Length() int     { return len(data.Data) }
+func (data *BFloat16VectorFieldData) Length() int    { return len(data.Data) }
+func (data *SparseFloatVectorFieldData) Length() int { return len(data.Data) }
+
+func (data *BoolFieldData) Get(i int) interface{}              { return data.Data[i] }
+func (data *Int8FieldData) Get(i int) interface{}              { return data.Data[i] }
+func (data *Int16FieldData) Get(i int) interface{}             { return data.Data[i] }
+func (data *Int32FieldData) Get(i int) interface{}             { return data.Data[i] }
+func (data *Int64FieldData) Get(i int) interface{}             { return data.Data[i] }
+func (data *FloatFieldData) Get(i int) interface{}             { return data.Data[i] }
+func (data *DoubleFieldData) Get(i int) interface{}            { return data.Data[i] }
+func (data *StringFieldData) Get(i int) interface{}            { return data.Data[i] }
+func (data *ArrayFieldData) Get(i int) interface{}             { return data.Data[i] }
+func (data *JSONFieldData) Get(i int) interface{}              { return data.Data[i] }
+func (data *BinaryVectorFieldData) Get(i int) interface{}      { return data.Data[i] }
+func (data *FloatVectorFieldData) Get(i int) interface{}       { return data.Data[i] }
+func (data *Float16VectorFieldData) Get(i int) interface{}     { return data.Data[i] }
+func (data *BFloat16VectorFieldData) Get(i int) interface{}    { return data.Data[i] }
+func (data *SparseFloatVectorFieldData) Get(i int) interface{} { return data.Data[i] }
 
 // why not binary.Size(data) directly? binary.Size(data) return -1
 // binary.Size returns how many bytes Write would generate to encode the value v, which
@@ -168,35 +211,51 @@ func (data *FloatVectorFieldData) Get(i int) interface{}  { return data.Data[i]
 // If v is neither of these, binary.Size returns -1.
 
 func (data *BoolFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *Int8FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *Int16FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *Int32FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *Int64FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *FloatFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *DoubleFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *StringFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
+}
+
+func (data *ArrayFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows) + binary.Size(data.ValidData)
+	for _, array := range data.Data {
+		size += proto.Size(array)
+	}
+	return size
+}
+
+func (data *JSONFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows) + binary.Size(data.ValidData)
+	for _, doc := range data.Data {
+		size += len(doc)
+	}
+	return size
 }
 
 func (data *BinaryVectorFieldData) GetMemorySize() int {
@@ -207,6 +266,194 @@ func (data *FloatVectorFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
 }
 
+func (data *Float16VectorFieldData) GetMemorySize() int {
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
+}
+
+func (data *BFloat16VectorFieldData) GetMemorySize() int {
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
+}
+
+func (data *SparseFloatVectorFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows)
+	for _, row := range data.Data {
+		size += len(row)
+	}
+	return size
+}
+
+// GetValidData returns the validity of the rows of a scalar field data, nil if all the rows are valid
+func GetValidData(data FieldData) []bool {
+	switch fieldData := data.(type) {
+	case *BoolFieldData:
+		return fieldData.ValidData
+	case *Int8FieldData:
+		return fieldData.ValidData
+	case *Int16FieldData:
+		return fieldData.ValidData
+	case *Int32FieldData:
+		return fieldData.ValidData
+	case *Int64FieldData:
+		return fieldData.ValidData
+	case *FloatFieldData:
+		return fieldData.ValidData
+	case *DoubleFieldData:
+		return fieldData.ValidData
+	case *StringFieldData:
+		return fieldData.ValidData
+	case *ArrayFieldData:
+		return fieldData.ValidData
+	case *JSONFieldData:
+		return fieldData.ValidData
+	default:
+		return nil
+	}
+}
+
+// AppendValidData appends the validity of length new rows to the validity of the numRows rows before them,
+// the validity stays nil as long as no null row is appended
+func AppendValidData(validData []bool, numRows int, eventValidData []bool, length int) []bool {
+	if validData == nil && eventValidData == nil {
+		return nil
+	}
+	if validData == nil {
+		validData = make([]bool, numRows, numRows+length)
+		for i := range validData {
+			validData[i] = true
+		}
+	}
+	if eventValidData != nil {
+		return append(validData, eventValidData...)
+	}
+	for i := 0; i < length; i++ {
+		validData = append(validData, true)
+	}
+	return validData
+}
+
+// GetDefaultValue returns the default value of the scalar field as the element type of its field data,
+// nil stands for null if the field has no default value
+func GetDefaultValue(field *schemapb.FieldSchema) interface{} {
+	defaultValue := field.GetDefaultValue()
+	if defaultValue == nil {
+		return nil
+	}
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		return defaultValue.GetBoolData()
+	case schemapb.DataType_Int8:
+		return int8(defaultValue.GetIntData())
+	case schemapb.DataType_Int16:
+		return int16(defaultValue.GetIntData())
+	case schemapb.DataType_Int32:
+		return defaultValue.GetIntData()
+	case schemapb.DataType_Int64:
+		return defaultValue.GetLongData()
+	case schemapb.DataType_Float:
+		return defaultValue.GetFloatData()
+	case schemapb.DataType_Double:
+		return defaultValue.GetDoubleData()
+	case schemapb.DataType_String:
+		return defaultValue.GetStringData()
+	default:
+		return nil
+	}
+}
+
+// NewDefaultFieldData returns the data of numRows rows of the scalar field, which take the default value of the
+// field or are null. It stands for the rows written before the field was added to the collection.
+func NewDefaultFieldData(field *schemapb.FieldSchema, numRows int) (FieldData, error) {
+	var validData []bool
+	defaultValue := GetDefaultValue(field)
+	if defaultValue == nil {
+		validData = make([]bool, numRows)
+	}
+	numOfRows := []int64{int64(numRows)}
+
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		data := &BoolFieldData{NumRows: numOfRows, Data: make([]bool, numRows), ValidData: validData}
+		if v, ok := defaultValue.(bool); ok {
+			for i := range data.Data {
+				data.Data[i] = v
+			}
+		}
+		return data, nil
+	case schemapb.DataType_Int8:
+		data := &Int8FieldData{NumRows: numOfRows, Data: make([]int8, numRows), ValidData: validData}
+		if v, ok := defaultValue.(int8); ok {
+			for i := range data.Data {
+				data.Data[i] = v
+			}
+		}
+		return data, nil
+	case schemapb.DataType_Int16:
+		data := &Int16FieldData{NumRows: numOfRows, Data: make([]int16, numRows), ValidData: validData}
+		if v, ok := defaultValue.(int16); ok {
+			for i := range data.Data {
+				data.Data[i] = v
+			}
+		}
+		return data, nil
+	case schemapb.DataType_Int32:
+		data := &Int32FieldData{NumRows: numOfRows, Data: make([]int32, numRows), ValidData: validData}
+		if v, ok := defaultValue.(int32); ok {
+			for i := range data.Data {
+				data.Data[i] = v
+			}
+		}
+		return data, nil
+	case schemapb.DataType_Int64:
+		data := &Int64FieldData{NumRows: numOfRows, Data: make([]int64, numRows), ValidData: validData}
+		if v, ok := defaultValue.(int64); ok {
+			for i := range data.Data {
+				data.Data[i] = v
+			}
+		}
+		return data, nil
+	case schemapb.DataType_Float:
+		data := &FloatFieldData{NumRows: numOfRows, Data: make([]float32, numRows), ValidData: validData}
+		if v, ok := defaultValue.(float32); ok {
+			for i := range data.Data {
+				data.Data[i] = v
+			}
+		}
+		return data, nil
+	case schemapb.DataType_Double:
+		data := &DoubleFieldData{NumRows: numOfRows, Data: make([]float64, numRows), ValidData: validData}
+		if v, ok := defaultValue.(float64); ok {
+			for i := range data.Data {
+				data.Data[i] = v
+			}
+		}
+		return data, nil
+	case schemapb.DataType_String:
+		data := &StringFieldData{NumRows: numOfRows, Data: make([]string, numRows), ValidData: validData}
+		if v, ok := defaultValue.(string); ok {
+			for i := range data.Data {
+				data.Data[i] = v
+			}
+		}
+		return data, nil
+	case schemapb.DataType_Array:
+		// Array fields have no default value, the rows are null
+		data := &ArrayFieldData{NumRows: numOfRows, Data: make([]*schemapb.ScalarField, numRows), ValidData: validData}
+		for i := range data.Data {
+			data.Data[i] = &schemapb.ScalarField{}
+		}
+		return data, nil
+	case schemapb.DataType_JSON:
+		// JSON fields have no default value, the rows are null
+		data := &JSONFieldData{NumRows: numOfRows, Data: make([][]byte, numRows), ValidData: validData}
+		for i := range data.Data {
+			data.Data[i] = []byte{}
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("field %s of data type %s has no default value", field.Name, field.DataType.String())
+	}
+}
+
 // system filed id:
 // 0: unique row id
 // 1: timestamp
@@ -255,6 +502,18 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 	startTs := ts[0]
 	endTs := ts[len(ts)-1]
 
+	// the fields added to the collection after the rows were written take the default values
+	for _, field := range insertCodec.Schema.Schema.Fields {
+		if _, ok := data.Data[field.FieldID]; ok {
+			continue
+		}
+		fieldData, err := NewDefaultFieldData(field, len(ts))
+		if err != nil {
+			return nil, nil, err
+		}
+		data.Data[field.FieldID] = fieldData
+	}
+
 	dataSorter := &DataSorter{
 		InsertCodec: insertCodec,
 		InsertData:  data,
@@ -302,18 +561,52 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				}
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
+		case schemapb.DataType_Array:
+			for _, singleArray := range singleData.(*ArrayFieldData).Data {
+				err = eventWriter.AddOneArrayToPayload(singleArray)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*ArrayFieldData).GetMemorySize()))
+		case schemapb.DataType_JSON:
+			for _, singleJSON := range singleData.(*JSONFieldData).Data {
+				err = eventWriter.AddOneJSONToPayload(singleJSON)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*JSONFieldData).GetMemorySize()))
 		case schemapb.DataType_BinaryVector:
 			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BinaryVectorFieldData).GetMemorySize()))
 		case schemapb.DataType_FloatVector:
 			err = eventWriter.AddFloatVectorToPayload(singleData.(*FloatVectorFieldData).Data, singleData.(*FloatVectorFieldData).Dim)
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatVectorFieldData).GetMemorySize()))
+		case schemapb.DataType_Float16Vector:
+			err = eventWriter.AddFloat16VectorToPayload(singleData.(*Float16VectorFieldData).Data, singleData.(*Float16VectorFieldData).Dim)
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Float16VectorFieldData).GetMemorySize()))
+		case schemapb.DataType_BFloat16Vector:
+			err = eventWriter.AddBFloat16VectorToPayload(singleData.(*BFloat16VectorFieldData).Data, singleData.(*BFloat16VectorFieldData).Dim)
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BFloat16VectorFieldData).GetMemorySize()))
+		case schemapb.DataType_SparseFloatVector:
+			for _, row := range singleData.(*SparseFloatVectorFieldData).Data {
+				err = eventWriter.AddOneSparseFloatVectorToPayload(row)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*SparseFloatVectorFieldData).GetMemorySize()))
 		default:
 			return nil, nil, fmt.Errorf("undefined data type %d", field.DataType)
 		}
 		if err != nil {
 			return nil, nil, err
 		}
+		err = eventWriter.AddValidDataToPayload(GetValidData(singleData))
+		if err != nil {
+			return nil, nil, err
+		}
 		writer.SetEventTimeStamp(typeutil.Timestamp(startTs), typeutil.Timestamp(endTs))
 
 		err = writer.Close()
@@ -344,6 +637,20 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				Key:   blobKey,
 				Value: statsBuffer,
 			})
+		case schemapb.DataType_String:
+			if !field.IsPrimaryKey {
+				break
+			}
+			statsWriter := &StatsWriter{}
+			err = statsWriter.StatsString(field.FieldID, field.IsPrimaryKey, singleData.(*StringFieldData).Data)
+			if err != nil {
+				return nil, nil, err
+			}
+			statsBuffer := statsWriter.GetBuffer()
+			statsBlobs = append(statsBlobs, &Blob{
+				Key:   blobKey,
+				Value: statsBuffer,
+			})
 		}
 	}
 
@@ -409,6 +716,11 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				}
 				totalLength += length
 				boolFieldData.NumRows = append(boolFieldData.NumRows, int64(length))
+				validData, err := eventReader.GetValidDataFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				boolFieldData.ValidData = AppendValidData(boolFieldData.ValidData, len(boolFieldData.Data)-length, validData, length)
 				resultData.Data[fieldID] = boolFieldData
 			case schemapb.DataType_Int8:
 				if resultData.Data[fieldID] == nil {
@@ -426,6 +738,11 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				}
 				totalLength += length
 				int8FieldData.NumRows = append(int8FieldData.NumRows, int64(length))
+				validData, err := eventReader.GetValidDataFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				int8FieldData.ValidData = AppendValidData(int8FieldData.ValidData, len(int8FieldData.Data)-length, validData, length)
 				resultData.Data[fieldID] = int8FieldData
 			case schemapb.DataType_Int16:
 				if resultData.Data[fieldID] == nil {
@@ -443,6 +760,11 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				}
 				totalLength += length
 				int16FieldData.NumRows = append(int16FieldData.NumRows, int64(length))
+				validData, err := eventReader.GetValidDataFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				int16FieldData.ValidData = AppendValidData(int16FieldData.ValidData, len(int16FieldData.Data)-length, validData, length)
 				resultData.Data[fieldID] = int16FieldData
 			case schemapb.DataType_Int32:
 				if resultData.Data[fieldID] == nil {
@@ -460,6 +782,11 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				}
 				totalLength += length
 				int32FieldData.NumRows = append(int32FieldData.NumRows, int64(length))
+				validData, err := eventReader.GetValidDataFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				int32FieldData.ValidData = AppendValidData(int32FieldData.ValidData, len(int32FieldData.Data)-length, validData, length)
 				resultData.Data[fieldID] = int32FieldData
 			case schemapb.DataType_Int64:
 				if resultData.Data[fieldID] == nil {
@@ -477,6 +804,11 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				}
 				totalLength += length
 				int64FieldData.NumRows = append(int64FieldData.NumRows, int64(length))
+				validData, err := eventReader.GetValidDataFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				int64FieldData.ValidData = AppendValidData(int64FieldData.ValidData, len(int64FieldData.Data)-length, validData, length)
 				resultData.Data[fieldID] = int64FieldData
 			case schemapb.DataType_Float:
 				if resultData.Data[fieldID] == nil {
@@ -494,6 +826,11 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				}
 				totalLength += length
 				floatFieldData.NumRows = append(floatFieldData.NumRows, int64(length))
+				validData, err := eventReader.GetValidDataFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				floatFieldData.ValidData = AppendValidData(floatFieldData.ValidData, len(floatFieldData.Data)-length, validData, length)
 				resultData.Data[fieldID] = floatFieldData
 			case schemapb.DataType_Double:
 				if resultData.Data[fieldID] == nil {
@@ -511,6 +848,11 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				}
 				totalLength += length
 				doubleFieldData.NumRows = append(doubleFieldData.NumRows, int64(length))
+				validData, err := eventReader.GetValidDataFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				doubleFieldData.ValidData = AppendValidData(doubleFieldData.ValidData, len(doubleFieldData.Data)-length, validData, length)
 				resultData.Data[fieldID] = doubleFieldData
 			case schemapb.DataType_String:
 				if resultData.Data[fieldID] == nil {
@@ -530,7 +872,60 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 					}
 					stringFieldData.Data = append(stringFieldData.Data, singleString)
 				}
+				validData, err := eventReader.GetValidDataFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				stringFieldData.ValidData = AppendValidData(stringFieldData.ValidData, len(stringFieldData.Data)-length, validData, length)
 				resultData.Data[fieldID] = stringFieldData
+			case schemapb.DataType_Array:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &ArrayFieldData{}
+				}
+				arrayFieldData := resultData.Data[fieldID].(*ArrayFieldData)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				arrayFieldData.NumRows = append(arrayFieldData.NumRows, int64(length))
+				for i := 0; i < length; i++ {
+					singleArray, err := eventReader.GetOneArrayFromPayload(i)
+					if err != nil {
+						return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+					}
+					arrayFieldData.Data = append(arrayFieldData.Data, singleArray)
+				}
+				validData, err := eventReader.GetValidDataFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				arrayFieldData.ValidData = AppendValidData(arrayFieldData.ValidData, len(arrayFieldData.Data)-length, validData, length)
+				resultData.Data[fieldID] = arrayFieldData
+			case schemapb.DataType_JSON:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &JSONFieldData{}
+				}
+				jsonFieldData := resultData.Data[fieldID].(*JSONFieldData)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				jsonFieldData.NumRows = append(jsonFieldData.NumRows, int64(length))
+				for i := 0; i < length; i++ {
+					singleJSON, err := eventReader.GetOneJSONFromPayload(i)
+					if err != nil {
+						return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+					}
+					jsonFieldData.Data = append(jsonFieldData.Data, singleJSON)
+				}
+				validData, err := eventReader.GetValidDataFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				jsonFieldData.ValidData = AppendValidData(jsonFieldData.ValidData, len(jsonFieldData.Data)-length, validData, length)
+				resultData.Data[fieldID] = jsonFieldData
 			case schemapb.DataType_BinaryVector:
 				if resultData.Data[fieldID] == nil {
 					resultData.Data[fieldID] = &BinaryVectorFieldData{}
@@ -567,6 +962,61 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				totalLength += length
 				floatVectorFieldData.NumRows = append(floatVectorFieldData.NumRows, int64(length))
 				resultData.Data[fieldID] = floatVectorFieldData
+			case schemapb.DataType_Float16Vector:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &Float16VectorFieldData{}
+				}
+				float16VectorFieldData := resultData.Data[fieldID].(*Float16VectorFieldData)
+				var singleData []byte
+				singleData, float16VectorFieldData.Dim, err = eventReader.GetFloat16VectorFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				float16VectorFieldData.Data = append(float16VectorFieldData.Data, singleData...)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				float16VectorFieldData.NumRows = append(float16VectorFieldData.NumRows, int64(length))
+				resultData.Data[fieldID] = float16VectorFieldData
+			case schemapb.DataType_BFloat16Vector:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &BFloat16VectorFieldData{}
+				}
+				bfloat16VectorFieldData := resultData.Data[fieldID].(*BFloat16VectorFieldData)
+				var singleData []byte
+				singleData, bfloat16VectorFieldData.Dim, err = eventReader.GetBFloat16VectorFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				bfloat16VectorFieldData.Data = append(bfloat16VectorFieldData.Data, singleData...)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				bfloat16VectorFieldData.NumRows = append(bfloat16VectorFieldData.NumRows, int64(length))
+				resultData.Data[fieldID] = bfloat16VectorFieldData
+			case schemapb.DataType_SparseFloatVector:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &SparseFloatVectorFieldData{}
+				}
+				sparseFieldData := resultData.Data[fieldID].(*SparseFloatVectorFieldData)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				sparseFieldData.NumRows = append(sparseFieldData.NumRows, int64(length))
+				for i := 0; i < length; i++ {
+					row, err := eventReader.GetOneSparseFloatVectorFromPayload(i)
+					if err != nil {
+						return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+					}
+					sparseFieldData.Data = append(sparseFieldData.Data, row)
+				}
+				resultData.Data[fieldID] = sparseFieldData
 			default:
 				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("undefined data type %d", dataType)
 			}
@@ -604,10 +1054,12 @@ func (insertCodec *InsertCodec) Close() error {
 
 // DeleteData saves each entity delete message represented as <primarykey,timestamp> map.
 // timestamp represents the time when this instance was deleted
+// Only one of Pks and StringPks is filled, which depends on the data type of the primary key.
 type DeleteData struct {
-	Pks      []int64     // primary keys
-	Tss      []Timestamp // timestamps
-	RowCount int64
+	Pks       []int64     // primary keys
+	StringPks []string    // string primary keys
+	Tss       []Timestamp // timestamps
+	RowCount  int64
 }
 
 // Append append 1 pk&ts pair to DeleteData
@@ -617,6 +1069,13 @@ func (data *DeleteData) Append(pk UniqueID, ts Timestamp) {
 	data.RowCount++
 }
 
+// AppendString append 1 string pk&ts pair to DeleteData
+func (data *DeleteData) AppendString(pk string, ts Timestamp) {
+	data.StringPks = append(data.StringPks, pk)
+	data.Tss = append(data.Tss, ts)
+	data.RowCount++
+}
+
 // DeleteCodec serializes and deserializes the delete data
 type DeleteCodec struct {
 	readerCloseFunc []func() error
@@ -635,15 +1094,17 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 	if err != nil {
 		return nil, err
 	}
-	if len(data.Pks) != len(data.Tss) {
+	if len(data.Pks) > 0 && len(data.StringPks) > 0 {
+		return nil, fmt.Errorf("The int64 pks and string pks are mixed")
+	}
+	if len(data.Pks)+len(data.StringPks) != len(data.Tss) {
 		return nil, fmt.Errorf("The length of pks, and TimeStamps is not equal")
 	}
-	length := len(data.Pks)
+	length := len(data.Tss)
 	sizeTotal := 0
 	var startTs, endTs Timestamp
 	startTs, endTs = math.MaxUint64, 0
 	for i := 0; i < length; i++ {
-		pk := data.Pks[i]
 		ts := data.Tss[i]
 		if ts < startTs {
 			startTs = ts
@@ -651,11 +1112,21 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 		if ts > endTs {
 			endTs = ts
 		}
-		err := eventWriter.AddOneStringToPayload(fmt.Sprintf("%d,%d", pk, ts))
+		// the string pk is quoted, so that it can be told from the int64 pk and may contain the separator
+		var row string
+		if len(data.StringPks) > 0 {
+			pk := data.StringPks[i]
+			row = fmt.Sprintf("%s,%d", strconv.Quote(pk), ts)
+			sizeTotal += len(pk)
+		} else {
+			pk := data.Pks[i]
+			row = fmt.Sprintf("%d,%d", pk, ts)
+			sizeTotal += binary.Size(pk)
+		}
+		err := eventWriter.AddOneStringToPayload(row)
 		if err != nil {
 			return nil, err
 		}
-		sizeTotal += binary.Size(pk)
 		sizeTotal += binary.Size(ts)
 	}
 	eventWriter.SetEventTimestamp(startTs, endTs)
@@ -716,29 +1187,40 @@ func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 				return InvalidUniqueID, InvalidUniqueID, nil, err
 			}
 
-			splits := strings.Split(singleString, ",")
-			if len(splits) != 2 {
+			sep := strings.LastIndex(singleString, ",")
+			if sep < 0 {
 				return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("the format of delta log is incorrect")
 			}
+			pkString, tsString := singleString[:sep], singleString[sep+1:]
 
-			pk, err := strconv.ParseInt(splits[0], 10, 64)
+			ts, err := strconv.ParseUint(tsString, 10, 64)
 			if err != nil {
 				return InvalidUniqueID, InvalidUniqueID, nil, err
 			}
 
-			ts, err := strconv.ParseUint(splits[1], 10, 64)
-			if err != nil {
-				return InvalidUniqueID, InvalidUniqueID, nil, err
+			if strings.HasPrefix(pkString, "\"") {
+				pk, err := strconv.Unquote(pkString)
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				result.StringPks = append(result.StringPks, pk)
+			} else {
+				pk, err := strconv.ParseInt(pkString, 10, 64)
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				result.Pks = append(result.Pks, pk)
 			}
-
-			result.Pks = append(result.Pks, pk)
 			result.Tss = append(result.Tss, ts)
 		}
 
 		deleteCodec.readerCloseFunc = append(deleteCodec.readerCloseFunc, readerClose(binlogReader))
 
 	}
-	result.RowCount = int64(len(result.Pks))
+	if len(result.Pks) > 0 && len(result.StringPks) > 0 {
+		return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("the int64 pks and string pks are mixed in delta logs")
+	}
+	result.RowCount = int64(len(result.Tss))
 
 	return pid, sid, result, nil
 }
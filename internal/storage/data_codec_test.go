@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -310,6 +311,67 @@ func TestInsertCodec(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestAppendValidData(t *testing.T) {
+	var validData []bool
+	validData = AppendValidData(validData, 0, nil, 2)
+	assert.Nil(t, validData)
+	validData = AppendValidData(validData, 2, []bool{false, true}, 2)
+	assert.Equal(t, []bool{true, true, false, true}, validData)
+	validData = AppendValidData(validData, 4, nil, 1)
+	assert.Equal(t, []bool{true, true, false, true, true}, validData)
+
+	assert.Equal(t, validData, GetValidData(&Int64FieldData{Data: make([]int64, 5), ValidData: validData}))
+	assert.Nil(t, GetValidData(&FloatVectorFieldData{Data: []float32{1.0}, Dim: 1}))
+}
+
+func TestNewDefaultFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:      200,
+		Name:         "added",
+		DataType:     schemapb.DataType_Int16,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 3}},
+	}
+	assert.Equal(t, int16(3), GetDefaultValue(field))
+	fieldData, err := NewDefaultFieldData(field, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, &Int16FieldData{NumRows: []int64{2}, Data: []int16{3, 3}}, fieldData)
+
+	field = &schemapb.FieldSchema{
+		FieldID:  201,
+		Name:     "nullable",
+		DataType: schemapb.DataType_String,
+		Nullable: true,
+	}
+	assert.Nil(t, GetDefaultValue(field))
+	fieldData, err = NewDefaultFieldData(field, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, fieldData.Length())
+	assert.Equal(t, []bool{false, false}, GetValidData(fieldData))
+
+	field = &schemapb.FieldSchema{
+		FieldID:     203,
+		Name:        "array",
+		DataType:    schemapb.DataType_Array,
+		ElementType: schemapb.DataType_Int64,
+		Nullable:    true,
+	}
+	fieldData, err = NewDefaultFieldData(field, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, fieldData.Length())
+	assert.Equal(t, []bool{false, false}, GetValidData(fieldData))
+	assert.Equal(t, 0, typeutil.GetArrayLength(fieldData.Get(0).(*schemapb.ScalarField)))
+
+	field = &schemapb.FieldSchema{FieldID: 204, Name: "json", DataType: schemapb.DataType_JSON, Nullable: true}
+	fieldData, err = NewDefaultFieldData(field, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, fieldData.Length())
+	assert.Equal(t, []bool{false, false}, GetValidData(fieldData))
+
+	field = &schemapb.FieldSchema{FieldID: 202, Name: "vector", DataType: schemapb.DataType_FloatVector}
+	_, err = NewDefaultFieldData(field, 2)
+	assert.NotNil(t, err)
+}
+
 func TestDeleteCodec(t *testing.T) {
 	deleteCodec := NewDeleteCodec()
 	deleteData := &DeleteData{
@@ -327,6 +389,21 @@ func TestDeleteCodec(t *testing.T) {
 	assert.Equal(t, pid, int64(1))
 	assert.Equal(t, sid, int64(1))
 	assert.Equal(t, data, deleteData)
+
+	stringDeleteData := &DeleteData{}
+	stringDeleteData.AppendString("sku-1", 43757345)
+	stringDeleteData.AppendString(`uuid,"2"`, 23578294723)
+	blob, err = deleteCodec.Serialize(CollectionID, 1, 1, stringDeleteData)
+	assert.Nil(t, err)
+	_, _, data, err = deleteCodec.Deserialize([]*Blob{blob})
+	assert.Nil(t, err)
+	assert.Equal(t, stringDeleteData.StringPks, data.StringPks)
+	assert.Equal(t, stringDeleteData.Tss, data.Tss)
+	assert.Equal(t, int64(2), data.RowCount)
+
+	stringDeleteData.Append(3, 43757345)
+	_, err = deleteCodec.Serialize(CollectionID, 1, 1, stringDeleteData)
+	assert.Error(t, err)
 }
 
 func TestDDCodec(t *testing.T) {
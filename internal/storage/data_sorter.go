@@ -75,6 +75,15 @@ func (ds *DataSorter) Swap(i, j int) {
 		case schemapb.DataType_String:
 			data := singleData.(*StringFieldData).Data
 			data[i], data[j] = data[j], data[i]
+		case schemapb.DataType_Array:
+			data := singleData.(*ArrayFieldData).Data
+			data[i], data[j] = data[j], data[i]
+		case schemapb.DataType_JSON:
+			data := singleData.(*JSONFieldData).Data
+			data[i], data[j] = data[j], data[i]
+		case schemapb.DataType_SparseFloatVector:
+			data := singleData.(*SparseFloatVectorFieldData).Data
+			data[i], data[j] = data[j], data[i]
 		case schemapb.DataType_BinaryVector:
 			data := singleData.(*BinaryVectorFieldData).Data
 			dim := singleData.(*BinaryVectorFieldData).Dim
@@ -87,10 +96,25 @@ func (ds *DataSorter) Swap(i, j int) {
 			for i := 0; i < dim; i++ {
 				data[i], data[i+dim] = data[i+dim], data[i]
 			}
+		case schemapb.DataType_Float16Vector:
+			data := singleData.(*Float16VectorFieldData).Data
+			dim := singleData.(*Float16VectorFieldData).Dim
+			for i := 0; i < dim*2; i++ {
+				data[i], data[i+dim*2] = data[i+dim*2], data[i]
+			}
+		case schemapb.DataType_BFloat16Vector:
+			data := singleData.(*BFloat16VectorFieldData).Data
+			dim := singleData.(*BFloat16VectorFieldData).Dim
+			for i := 0; i < dim*2; i++ {
+				data[i], data[i+dim*2] = data[i+dim*2], data[i]
+			}
 		default:
 			errMsg := "undefined data type " + string(field.DataType)
 			panic(errMsg)
 		}
+		if validData := GetValidData(singleData); len(validData) > 0 {
+			validData[i], validData[j] = validData[j], validData[i]
+		}
 	}
 }
 
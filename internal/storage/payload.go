@@ -23,6 +23,7 @@ import (
 	"errors"
 	"unsafe"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
@@ -37,8 +38,14 @@ type PayloadWriterInterface interface {
 	AddFloatToPayload(msgs []float32) error
 	AddDoubleToPayload(msgs []float64) error
 	AddOneStringToPayload(msgs string) error
+	AddOneArrayToPayload(msg *schemapb.ScalarField) error
+	AddOneJSONToPayload(msg []byte) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
+	AddFloat16VectorToPayload(data []byte, dim int) error
+	AddBFloat16VectorToPayload(data []byte, dim int) error
+	AddOneSparseFloatVectorToPayload(row []byte) error
+	AddValidDataToPayload(validData []bool) error
 	FinishPayloadWriter() error
 	GetPayloadBufferFromWriter() ([]byte, error)
 	GetPayloadLengthFromWriter() (int, error)
@@ -56,8 +63,14 @@ type PayloadReaderInterface interface {
 	GetFloatFromPayload() ([]float32, error)
 	GetDoubleFromPayload() ([]float64, error)
 	GetOneStringFromPayload(idx int) (string, error)
+	GetOneArrayFromPayload(idx int) (*schemapb.ScalarField, error)
+	GetOneJSONFromPayload(idx int) ([]byte, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
+	GetFloat16VectorFromPayload() ([]byte, int, error)
+	GetBFloat16VectorFromPayload() ([]byte, int, error)
+	GetOneSparseFloatVectorFromPayload(idx int) ([]byte, error)
+	GetValidDataFromPayload() ([]bool, error)
 	GetPayloadLengthFromReader() (int, error)
 	ReleasePayloadReader() error
 	Close() error
@@ -133,6 +146,24 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case schemapb.DataType_Array:
+			val, ok := msgs.(*schemapb.ScalarField)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneArrayToPayload(val)
+		case schemapb.DataType_JSON:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneJSONToPayload(val)
+		case schemapb.DataType_SparseFloatVector:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneSparseFloatVectorToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -150,6 +181,18 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddFloatVectorToPayload(val, dim[0])
+		case schemapb.DataType_Float16Vector:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddFloat16VectorToPayload(val, dim[0])
+		case schemapb.DataType_BFloat16Vector:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddBFloat16VectorToPayload(val, dim[0])
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -318,6 +361,118 @@ func (w *PayloadWriter) AddOneStringToPayload(msg string) error {
 	return nil
 }
 
+// AddOneArrayToPayload adds the array of a row, which is stored as the serialized bytes of the ScalarField
+func (w *PayloadWriter) AddOneArrayToPayload(msg *schemapb.ScalarField) error {
+	bytes, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	length := len(bytes)
+	// an empty array is serialized into no bytes, keep the pointer valid for the c wrapper
+	bytes = append(bytes, 0)
+
+	cBytes := (*C.uint8_t)(unsafe.Pointer(&bytes[0]))
+	cLength := C.int(length)
+
+	st := C.AddOneArrayToPayload(w.payloadWriterPtr, cBytes, cLength)
+
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// AddOneJSONToPayload adds the serialized JSON document of a row
+func (w *PayloadWriter) AddOneJSONToPayload(msg []byte) error {
+	length := len(msg)
+	// an empty document has no bytes, keep the pointer valid for the c wrapper
+	msg = append(msg[:length:length], 0)
+
+	cBytes := (*C.uint8_t)(unsafe.Pointer(&msg[0]))
+	cLength := C.int(length)
+
+	st := C.AddOneJSONToPayload(w.payloadWriterPtr, cBytes, cLength)
+
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// AddFloat16VectorToPayload adds float16 vectors, 2 bytes per element
+func (w *PayloadWriter) AddFloat16VectorToPayload(data []byte, dim int) error {
+	length := len(data)
+	if length <= 0 {
+		return errors.New("can't add empty float16 vectors into payload")
+	}
+	if dim <= 0 {
+		return errors.New("dimension should be greater than 0")
+	}
+
+	cVec := (*C.uint8_t)(&data[0])
+	cDim := C.int(dim)
+	cLength := C.int(length / (dim * 2))
+
+	st := C.AddFloat16VectorToPayload(w.payloadWriterPtr, cVec, cDim, cLength)
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// AddBFloat16VectorToPayload adds bfloat16 vectors, 2 bytes per element
+func (w *PayloadWriter) AddBFloat16VectorToPayload(data []byte, dim int) error {
+	length := len(data)
+	if length <= 0 {
+		return errors.New("can't add empty bfloat16 vectors into payload")
+	}
+	if dim <= 0 {
+		return errors.New("dimension should be greater than 0")
+	}
+
+	cVec := (*C.uint8_t)(&data[0])
+	cDim := C.int(dim)
+	cLength := C.int(length / (dim * 2))
+
+	st := C.AddBFloat16VectorToPayload(w.payloadWriterPtr, cVec, cDim, cLength)
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// AddOneSparseFloatVectorToPayload adds the (uint32 index, float32 value) pairs of a sparse float vector row
+func (w *PayloadWriter) AddOneSparseFloatVectorToPayload(row []byte) error {
+	length := len(row)
+	// an empty row has no bytes, keep the pointer valid for the c wrapper
+	row = append(row[:length:length], 0)
+
+	cBytes := (*C.uint8_t)(unsafe.Pointer(&row[0]))
+	cLength := C.int(length)
+
+	st := C.AddOneSparseFloatVectorToPayload(w.payloadWriterPtr, cBytes, cLength)
+
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return errors.New(msg)
+	}
+	return nil
+}
+
 // dimension > 0 && (%8 == 0)
 func (w *PayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
 	length := len(binVec)
@@ -366,6 +521,27 @@ func (w *PayloadWriter) AddFloatVectorToPayload(floatVec []float32, dim int) err
 	return nil
 }
 
+// AddValidDataToPayload marks the rows of a nullable column, false for a null row
+func (w *PayloadWriter) AddValidDataToPayload(validData []bool) error {
+	length := len(validData)
+	if length <= 0 {
+		return nil
+	}
+
+	cValid := (*C.bool)(unsafe.Pointer(&validData[0]))
+	cLength := C.int(length)
+
+	status := C.AddValidDataToPayload(w.payloadWriterPtr, cValid, cLength)
+
+	errCode := commonpb.ErrorCode(status.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return errors.New(msg)
+	}
+	return nil
+}
+
 func (w *PayloadWriter) FinishPayloadWriter() error {
 	st := C.FinishPayloadWriter(w.payloadWriterPtr)
 	errCode := commonpb.ErrorCode(st.error_code)
@@ -433,6 +609,15 @@ func (r *PayloadReader) GetDataFromPayload(idx ...int) (interface{}, int, error)
 		case schemapb.DataType_String:
 			val, err := r.GetOneStringFromPayload(idx[0])
 			return val, 0, err
+		case schemapb.DataType_Array:
+			val, err := r.GetOneArrayFromPayload(idx[0])
+			return val, 0, err
+		case schemapb.DataType_JSON:
+			val, err := r.GetOneJSONFromPayload(idx[0])
+			return val, 0, err
+		case schemapb.DataType_SparseFloatVector:
+			val, err := r.GetOneSparseFloatVectorFromPayload(idx[0])
+			return val, 0, err
 		default:
 			return nil, 0, errors.New("unknown type")
 		}
@@ -463,6 +648,10 @@ func (r *PayloadReader) GetDataFromPayload(idx ...int) (interface{}, int, error)
 			return r.GetBinaryVectorFromPayload()
 		case schemapb.DataType_FloatVector:
 			return r.GetFloatVectorFromPayload()
+		case schemapb.DataType_Float16Vector:
+			return r.GetFloat16VectorFromPayload()
+		case schemapb.DataType_BFloat16Vector:
+			return r.GetBFloat16VectorFromPayload()
 		default:
 			return nil, 0, errors.New("unknown type")
 		}
@@ -641,6 +830,71 @@ func (r *PayloadReader) GetOneStringFromPayload(idx int) (string, error) {
 	return C.GoStringN(cStr, cSize), nil
 }
 
+// GetOneArrayFromPayload returns the array of the row at idx
+func (r *PayloadReader) GetOneArrayFromPayload(idx int) (*schemapb.ScalarField, error) {
+	if r.colType != schemapb.DataType_Array {
+		return nil, errors.New("incorrect data type")
+	}
+
+	var cBytes *C.uint8_t
+	var cSize C.int
+
+	st := C.GetOneArrayFromPayload(r.payloadReaderPtr, C.int(idx), &cBytes, &cSize)
+
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return nil, errors.New(msg)
+	}
+	array := &schemapb.ScalarField{}
+	if err := proto.Unmarshal(C.GoBytes(unsafe.Pointer(cBytes), cSize), array); err != nil {
+		return nil, err
+	}
+	return array, nil
+}
+
+// GetOneJSONFromPayload returns the serialized JSON document of the row at idx
+func (r *PayloadReader) GetOneJSONFromPayload(idx int) ([]byte, error) {
+	if r.colType != schemapb.DataType_JSON {
+		return nil, errors.New("incorrect data type")
+	}
+
+	var cBytes *C.uint8_t
+	var cSize C.int
+
+	st := C.GetOneJSONFromPayload(r.payloadReaderPtr, C.int(idx), &cBytes, &cSize)
+
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return nil, errors.New(msg)
+	}
+	return C.GoBytes(unsafe.Pointer(cBytes), cSize), nil
+}
+
+// GetOneSparseFloatVectorFromPayload returns the (uint32 index, float32 value) pairs of the sparse float vector row
+// at idx
+func (r *PayloadReader) GetOneSparseFloatVectorFromPayload(idx int) ([]byte, error) {
+	if r.colType != schemapb.DataType_SparseFloatVector {
+		return nil, errors.New("incorrect data type")
+	}
+
+	var cBytes *C.uint8_t
+	var cSize C.int
+
+	st := C.GetOneSparseFloatVectorFromPayload(r.payloadReaderPtr, C.int(idx), &cBytes, &cSize)
+
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return nil, errors.New(msg)
+	}
+	return C.GoBytes(unsafe.Pointer(cBytes), cSize), nil
+}
+
 // ,dimension, error
 func (r *PayloadReader) GetBinaryVectorFromPayload() ([]byte, int, error) {
 	if r.colType != schemapb.DataType_BinaryVector {
@@ -687,6 +941,74 @@ func (r *PayloadReader) GetFloatVectorFromPayload() ([]float32, int, error) {
 	return slice, int(cDim), nil
 }
 
+// ,dimension, error
+func (r *PayloadReader) GetFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != schemapb.DataType_Float16Vector {
+		return nil, 0, errors.New("incorrect data type")
+	}
+
+	var cMsg *C.uint8_t
+	var cDim C.int
+	var cLen C.int
+
+	st := C.GetFloat16VectorFromPayload(r.payloadReaderPtr, &cMsg, &cDim, &cLen)
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return nil, 0, errors.New(msg)
+	}
+	length := cDim * 2 * cLen
+
+	slice := (*[1 << 28]byte)(unsafe.Pointer(cMsg))[:length:length]
+	return slice, int(cDim), nil
+}
+
+// ,dimension, error
+func (r *PayloadReader) GetBFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != schemapb.DataType_BFloat16Vector {
+		return nil, 0, errors.New("incorrect data type")
+	}
+
+	var cMsg *C.uint8_t
+	var cDim C.int
+	var cLen C.int
+
+	st := C.GetBFloat16VectorFromPayload(r.payloadReaderPtr, &cMsg, &cDim, &cLen)
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return nil, 0, errors.New(msg)
+	}
+	length := cDim * 2 * cLen
+
+	slice := (*[1 << 28]byte)(unsafe.Pointer(cMsg))[:length:length]
+	return slice, int(cDim), nil
+}
+
+// GetValidDataFromPayload returns the validity of the rows, nil if there is no null row
+func (r *PayloadReader) GetValidDataFromPayload() ([]bool, error) {
+	var cValid *C.bool
+	var cSize C.int
+
+	st := C.GetValidDataFromPayload(r.payloadReaderPtr, &cValid, &cSize)
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return nil, errors.New(msg)
+	}
+
+	slice := (*[1 << 28]bool)(unsafe.Pointer(cValid))[:cSize:cSize]
+	for _, valid := range slice {
+		if !valid {
+			return slice, nil
+		}
+	}
+	return nil, nil
+}
+
 func (r *PayloadReader) GetPayloadLengthFromReader() (int, error) {
 	length := C.GetPayloadLengthFromReader(r.payloadReaderPtr)
 	return int(length), nil
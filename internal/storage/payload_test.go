@@ -206,6 +206,51 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		defer r.ReleasePayloadReader()
 	})
 
+	t.Run("TestValidData", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_Int64)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddInt64ToPayload([]int64{1, 0, 3})
+		assert.Nil(t, err)
+		err = w.AddValidDataToPayload([]bool{true, false, true})
+		assert.Nil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		defer w.ReleasePayloadWriter()
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_Int64, buffer)
+		require.Nil(t, err)
+		length, err := r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, length)
+
+		validData, err := r.GetValidDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, []bool{true, false, true}, validData)
+
+		int64s, err := r.GetInt64FromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), int64s[0])
+		assert.Equal(t, int64(3), int64s[2])
+		defer r.ReleasePayloadReader()
+	})
+
+	t.Run("TestValidDataOfVector", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_FloatVector)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+		defer w.ReleasePayloadWriter()
+
+		err = w.AddFloatVectorToPayload([]float32{1.0, 2.0}, 1)
+		assert.Nil(t, err)
+		err = w.AddValidDataToPayload([]bool{true, false})
+		assert.NotNil(t, err)
+	})
+
 	t.Run("TestFloat32", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_Float)
 		require.Nil(t, err)
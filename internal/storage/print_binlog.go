@@ -15,13 +15,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"syscall"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PrintBinlogFiles call printBinlogFile in turn for the file list specified by parameter fileList.
@@ -282,6 +285,30 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 			}
 			fmt.Printf("\t\t%d : %s\n", i, val)
 		}
+	case schemapb.DataType_Array:
+		rows, err := reader.GetPayloadLengthFromReader()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < rows; i++ {
+			val, err := reader.GetOneArrayFromPayload(i)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("\t\t%d : %s\n", i, proto.CompactTextString(val))
+		}
+	case schemapb.DataType_JSON:
+		rows, err := reader.GetPayloadLengthFromReader()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < rows; i++ {
+			val, err := reader.GetOneJSONFromPayload(i)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("\t\t%d : %s\n", i, val)
+		}
 	case schemapb.DataType_BinaryVector:
 		val, dim, err := reader.GetBinaryVectorFromPayload()
 		if err != nil {
@@ -311,6 +338,52 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 			}
 			fmt.Println()
 		}
+	case schemapb.DataType_Float16Vector:
+		val, dim, err := reader.GetFloat16VectorFromPayload()
+		if err != nil {
+			return err
+		}
+		vec := typeutil.Float16VectorToFloat32(val)
+		length := len(vec) / dim
+		for i := 0; i < length; i++ {
+			fmt.Printf("\t\t%d :", i)
+			for j := 0; j < dim; j++ {
+				idx := i*dim + j
+				fmt.Printf(" %f", vec[idx])
+			}
+			fmt.Println()
+		}
+	case schemapb.DataType_BFloat16Vector:
+		val, dim, err := reader.GetBFloat16VectorFromPayload()
+		if err != nil {
+			return err
+		}
+		vec := typeutil.BFloat16VectorToFloat32(val)
+		length := len(vec) / dim
+		for i := 0; i < length; i++ {
+			fmt.Printf("\t\t%d :", i)
+			for j := 0; j < dim; j++ {
+				idx := i*dim + j
+				fmt.Printf(" %f", vec[idx])
+			}
+			fmt.Println()
+		}
+	case schemapb.DataType_SparseFloatVector:
+		rows, err := reader.GetPayloadLengthFromReader()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < rows; i++ {
+			val, err := reader.GetOneSparseFloatVectorFromPayload(i)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("\t\t%d :", i)
+			for j := 0; j+8 <= len(val); j += 8 {
+				fmt.Printf(" %d:%f", common.Endian.Uint32(val[j:]), math.Float32frombits(common.Endian.Uint32(val[j+4:])))
+			}
+			fmt.Println()
+		}
 	default:
 		return errors.New("undefined data type")
 	}
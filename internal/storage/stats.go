@@ -34,6 +34,14 @@ type Int64Stats struct {
 	BF      *bloom.BloomFilter `json:"bf"`
 }
 
+// StringStats is the statistics of a string primary key field, the bloom filter is built on the bytes of the keys
+type StringStats struct {
+	FieldID int64              `json:"fieldID"`
+	Max     string             `json:"max"`
+	Min     string             `json:"min"`
+	BF      *bloom.BloomFilter `json:"bf"`
+}
+
 type StatsWriter struct {
 	buffer []byte
 }
@@ -70,6 +78,41 @@ func (sw *StatsWriter) StatsInt64(fieldID int64, isPrimaryKey bool, msgs []int64
 	return nil
 }
 
+// StatsString writes the statistics of the string field, the bloom filter is built only for the primary key
+func (sw *StatsWriter) StatsString(fieldID int64, isPrimaryKey bool, msgs []string) error {
+	if len(msgs) < 1 {
+		// return error: msgs must has one element at least
+		return nil
+	}
+
+	stats := &StringStats{
+		FieldID: fieldID,
+		Max:     msgs[0],
+		Min:     msgs[0],
+	}
+	for _, msg := range msgs {
+		if msg > stats.Max {
+			stats.Max = msg
+		}
+		if msg < stats.Min {
+			stats.Min = msg
+		}
+	}
+	if isPrimaryKey {
+		stats.BF = bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive)
+		for _, msg := range msgs {
+			stats.BF.AddString(msg)
+		}
+	}
+	b, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	sw.buffer = b
+
+	return nil
+}
+
 type StatsReader struct {
 	buffer []byte
 }
@@ -87,6 +130,16 @@ func (sr *StatsReader) GetInt64Stats() (*Int64Stats, error) {
 	return stats, nil
 }
 
+// GetStringStats returns the statistics of the string field
+func (sr *StatsReader) GetStringStats() (*StringStats, error) {
+	stats := &StringStats{}
+	err := json.Unmarshal(sr.buffer, &stats)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 func DeserializeStats(blobs []*Blob) ([]*Int64Stats, error) {
 	results := make([]*Int64Stats, 0, len(blobs))
 	for _, blob := range blobs {
@@ -103,3 +156,21 @@ func DeserializeStats(blobs []*Blob) ([]*Int64Stats, error) {
 	}
 	return results, nil
 }
+
+// DeserializeStringStats deserializes the statistics blobs of a string field
+func DeserializeStringStats(blobs []*Blob) ([]*StringStats, error) {
+	results := make([]*StringStats, 0, len(blobs))
+	for _, blob := range blobs {
+		if blob.Value == nil {
+			continue
+		}
+		sr := &StatsReader{}
+		sr.SetBuffer(blob.Value)
+		stats, err := sr.GetStringStats()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, stats)
+	}
+	return results, nil
+}
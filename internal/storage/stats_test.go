@@ -42,3 +42,22 @@ func TestStatsWriter_StatsInt64(t *testing.T) {
 	err = sw.StatsInt64(rootcoord.RowIDField, true, msgs)
 	assert.Nil(t, err)
 }
+
+func TestStatsWriter_StatsString(t *testing.T) {
+	data := []string{"bc", "a", "bd", "ab"}
+	sw := &StatsWriter{}
+	err := sw.StatsString(common.StartOfUserFieldID, true, data)
+	assert.NoError(t, err)
+
+	stats, err := DeserializeStringStats([]*Blob{{Value: sw.GetBuffer()}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(stats))
+	assert.Equal(t, "bd", stats[0].Max)
+	assert.Equal(t, "a", stats[0].Min)
+	for _, pk := range data {
+		assert.True(t, stats[0].BF.TestString(pk))
+	}
+
+	err = sw.StatsString(common.StartOfUserFieldID, true, []string{})
+	assert.NoError(t, err)
+}
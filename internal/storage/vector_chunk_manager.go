@@ -80,10 +80,41 @@ func (vcm *VectorChunkManager) downloadVectorFile(key string) ([]byte, error) {
 			}
 			results = buf.Bytes()
 		}
+		float16Vector, ok := singleData.(*Float16VectorFieldData)
+		if ok {
+			results = float16Vector.Data
+		}
+		bfloat16Vector, ok := singleData.(*BFloat16VectorFieldData)
+		if ok {
+			results = bfloat16Vector.Data
+		}
+		sparseVector, ok := singleData.(*SparseFloatVectorFieldData)
+		if ok {
+			results = encodeSparseFloatVectorCache(sparseVector.Data)
+		}
 	}
 	return results, nil
 }
 
+// encodeSparseFloatVectorCache encodes the sparse float vector rows into the local cache, which is the rowCount+1 int64
+// offsets of the rows in the pairs followed by the (index, value) pairs of the rows.
+func encodeSparseFloatVectorCache(rows [][]byte) []byte {
+	header := 8 * (len(rows) + 1)
+	size := header
+	for _, row := range rows {
+		size += len(row)
+	}
+	results := make([]byte, header, size)
+	offset := 0
+	for i, row := range rows {
+		common.Endian.PutUint64(results[8*i:], uint64(offset))
+		offset += len(row)
+		results = append(results, row...)
+	}
+	common.Endian.PutUint64(results[8*len(rows):], uint64(offset))
+	return results
+}
+
 // GetPath returns the path of vector data. If cached, return local path.
 // If not cached return remote path.
 func (vcm *VectorChunkManager) GetPath(key string) (string, error) {
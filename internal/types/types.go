@@ -441,6 +441,16 @@ type RootCoord interface {
 	// error is always nil
 	AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 
+	// AddCollectionField notifies RootCoord to append a field to the schema of the collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name and the schema of the field
+	//
+	// The `ErrorCode` of `Status` is `Success` if the field is added successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AddCollectionField(ctx context.Context, req *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error)
+
 	// AllocTimestamp notifies RootCoord to alloc timestamps
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -518,6 +528,154 @@ type RootCoord interface {
 
 	// GetMetrics notifies RootCoord to collect metrics for specified component
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+
+	// CreateCredential notifies RootCoord to create a new user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the username and the password encrypted by Proxy
+	//
+	// The `ErrorCode` of `Status` is `Success` if create credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `CreateCredentialFailure`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
+
+	// UpdateCredential notifies RootCoord to replace the password of an existing user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the username and the new password encrypted by Proxy
+	//
+	// The `ErrorCode` of `Status` is `Success` if update credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `UpdateCredentialFailure`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// RootCoord notifies all the Proxies to invalidate the credential cache of this user
+	UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
+
+	// DeleteCredential notifies RootCoord to delete a user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the username
+	//
+	// The `ErrorCode` of `Status` is `Success` if delete credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `DeleteCredentialFailure`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// RootCoord notifies all the Proxies to invalidate the credential cache of this user
+	DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+
+	// ListCredUsers notifies RootCoord to list all the usernames
+	//
+	// ctx is the context to control request deadline and cancellation
+	//
+	// The `Status` in response struct `ListCredUsersResponse` indicates if this operation is processed successfully or fail cause;
+	// `Usernames` in `ListCredUsersResponse` records all the usernames.
+	// error is always nil
+	ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+
+	// GetCredential notifies RootCoord to return the encrypted password of a user, it is only used by Proxy
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the username
+	//
+	// The `Status` in response struct `GetCredentialResponse` indicates if this operation is processed successfully or fail cause;
+	// `Password` in `GetCredentialResponse` is the password encrypted by bcrypt.
+	// error is always nil
+	GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error)
+
+	// CreateRole notifies RootCoord to create a new role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the role name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create role successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `CreateRoleFailure`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+
+	// DropRole notifies RootCoord to drop a role, the bindings and the grants of the role are removed too
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the role name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop role successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `DropRoleFailure`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// RootCoord notifies all the Proxies to refresh the privilege cache
+	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+
+	// OperateUserRole notifies RootCoord to bind a user to a role or unbind a user from a role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the username, the role name and the operation type
+	//
+	// The `ErrorCode` of `Status` is `Success` if the operation is done successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `OperateUserRoleFailure`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// RootCoord notifies all the Proxies to refresh the privilege cache
+	OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+
+	// OperatePrivilege notifies RootCoord to grant a privilege to a role or revoke a privilege from a role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the role, the object type, the object name, the privilege and the operation type
+	//
+	// The `ErrorCode` of `Status` is `Success` if the operation is done successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `OperatePrivilegeFailure`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// RootCoord notifies all the Proxies to refresh the privilege cache
+	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
+
+	// SelectGrant notifies RootCoord to list the grants of a role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the role, and optionally the object type and the object name to filter the grants
+	//
+	// The `Status` in response struct `SelectGrantResponse` indicates if this operation is processed successfully or fail cause;
+	// `Entities` in `SelectGrantResponse` records the grants.
+	// error is always nil
+	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
+
+	// ListPolicy notifies RootCoord to list all the grants and the user-role bindings, it is only used by Proxy
+	//
+	// ctx is the context to control request deadline and cancellation
+	//
+	// The `Status` in response struct `ListPolicyResponse` indicates if this operation is processed successfully or fail cause;
+	// `PolicyInfos` in `ListPolicyResponse` records all the grants, `UserRoles` records all the user-role bindings.
+	// error is always nil
+	ListPolicy(ctx context.Context, req *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
+
+	// CreateDatabase notifies RootCoord to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies RootCoord to drop a database, only the empty database can be dropped
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases notifies RootCoord to list all the databases, including the default one
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// `DbNames` in `ListDatabasesResponse` records the database names, `CreatedTimestamps` records the created timestamps.
+	// error is always nil
+	ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
 }
 
 // RootCoordComponent is used by grpc server of RootCoord
@@ -584,6 +742,29 @@ type Proxy interface {
 	//
 	// error is returned only when some communication issue occurs.
 	ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
+
+	// InvalidateCredentialCache notifies Proxy to clear the cached credential of specific user.
+	//
+	// InvalidateCredentialCache should be called when the password of the user is updated or the user is deleted.
+	//
+	// ctx is the request to control request deadline and cancellation.
+	// request contains the request params, which is the username.
+	//
+	// InvalidateCredentialCache should always succeed even though the specific user isn't cached in Proxy.
+	// So the code of response `Status` should be always `Success`.
+	//
+	// error is returned only when some communication issue occurs.
+	InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error)
+
+	// RefreshPolicyInfoCache notifies Proxy to apply the change of the grants or the user-role bindings to the privilege cache.
+	//
+	// ctx is the request to control request deadline and cancellation.
+	// request contains the operation type and the affected grant or binding.
+	//
+	// RefreshPolicyInfoCache should always succeed even though the privilege cache hasn't been loaded.
+	//
+	// error is returned only when some communication issue occurs.
+	RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error)
 }
 
 // ProxyComponent defines the interface of proxy component.
@@ -833,13 +1014,29 @@ type ProxyComponent interface {
 	// ctx is the context to control request deadline and cancellation
 	// req contains the request params, including database name(reserved), collection name, partition name(optional), filter expression
 	//
+	// The filter expression is any boolean expression, the primary keys are resolved by a query on the loaded collection
+	// unless the expression is "pk in [a, b]".
 	// The `Status` in response struct `MutationResult` indicates if this operation is processed successfully or fail cause;
 	// the `IDs` in `MutationResult` return the id list of deleted rows.
+	// the `DeleteCnt` in `MutationResult` return the number of deleted rows.
 	// the `SuccIndex` in `MutationResult` return the succeed number of deleted rows.
 	// the `ErrIndex` in `MutationResult` return the failed number of delete rows.
 	// error is always nil
 	Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error)
 
+	// Upsert notifies Proxy to replace the rows with the same primary keys
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name(optional), fields data
+	//
+	// The old rows are deleted and the new rows are inserted under a single timestamp, so the reads never see
+	// both of them or neither of them.
+	// The `Status` in response struct `MutationResult` indicates if this operation is processed successfully or fail cause;
+	// the `IDs` in `MutationResult` return the primary keys of the upserted rows.
+	// the `UpsertCnt` in `MutationResult` return the number of upserted rows.
+	// error is always nil
+	Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error)
+
 	// Search notifies Proxy to do search
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -850,6 +1047,17 @@ type ProxyComponent interface {
 	// error is always nil
 	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
 
+	// HybridSearch notifies Proxy to do an ANNS search on each of the vector fields and fuse the results
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name(optional),
+	// the search requests of the vector fields and the rank params to fuse their results
+	//
+	// The `Status` in response struct `SearchResults` indicates if this operation is processed successfully or fail cause;
+	// the `Results` in `SearchResults` return the fused search results.
+	// error is always nil
+	HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error)
+
 	// Flush notifies Proxy to flush buffer into storage
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -869,6 +1077,37 @@ type ProxyComponent interface {
 	// error is always nil
 	Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
 
+	// OpenQueryIterator notifies Proxy to open an iterator on the rows matching the filter expression at a snapshot,
+	// the snapshot is kept from compaction until the iterator is closed or expires
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition names(optional),
+	// filter expression(optional), output fields, travel timestamp(optional) and batch size(optional)
+	//
+	// The `Status` in response struct `OpenQueryIteratorResponse` indicates if this operation is processed successfully or fail cause;
+	// the `IteratorID` in `OpenQueryIteratorResponse` identifies the iterator.
+	// error is always nil
+	OpenQueryIterator(ctx context.Context, request *milvuspb.OpenQueryIteratorRequest) (*milvuspb.OpenQueryIteratorResponse, error)
+
+	// NextQueryIterator notifies Proxy to fetch the next batch of a query iterator, the rows are sorted by primary key
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the iterator id and the token of the last batch
+	//
+	// The `Status` in response struct `QueryIteratorResults` indicates if this operation is processed successfully or fail cause;
+	// the `FieldsData` in `QueryIteratorResults` return the batch, and the `Token` is passed to fetch the next batch.
+	// error is always nil
+	NextQueryIterator(ctx context.Context, request *milvuspb.NextQueryIteratorRequest) (*milvuspb.QueryIteratorResults, error)
+
+	// CloseQueryIterator notifies Proxy to close a query iterator
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the iterator id
+	//
+	// The `ErrorCode` of `Status` is `Success` if close iterator successfully;
+	// error is always nil
+	CloseQueryIterator(ctx context.Context, request *milvuspb.CloseQueryIteratorRequest) (*commonpb.Status, error)
+
 	// CalcDistance notifies Proxy to calculate distance between specified vectors
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -955,9 +1194,139 @@ type ProxyComponent interface {
 	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
 	// error is always nil
 	AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+
+	// AddCollectionField notifies Proxy to add a nullable or defaulted scalar field to an existing collection, the
+	// collection must not be loaded
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name and the schema of the field
+	//
+	// The `ErrorCode` of `Status` is `Success` if the field is added successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error)
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+
+	// CreateCredential notifies Proxy to create a new user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username and raw password
+	//
+	// The `ErrorCode` of `Status` is `Success` if create credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error)
+
+	// UpdateCredential notifies Proxy to update the password of an existing user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username, old password and new password
+	//
+	// The `ErrorCode` of `Status` is `Success` if update credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error)
+
+	// DeleteCredential notifies Proxy to delete a user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username
+	//
+	// The `ErrorCode` of `Status` is `Success` if delete credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+
+	// ListCredUsers notifies Proxy to list all the usernames
+	//
+	// ctx is the context to control request deadline and cancellation
+	//
+	// The `Status` in response struct `ListCredUsersResponse` indicates if this operation is processed successfully or fail cause;
+	// `Usernames` in `ListCredUsersResponse` records all the usernames.
+	// error is always nil
+	ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+
+	// CreateRole notifies Proxy to create a new role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the role name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create role successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+
+	// DropRole notifies Proxy to drop a role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the role name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop role successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+
+	// OperateUserRole notifies Proxy to bind a user to a role or unbind a user from a role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the username, the role name and the operation type
+	//
+	// The `ErrorCode` of `Status` is `Success` if the operation is done successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+
+	// OperatePrivilege notifies Proxy to grant a privilege to a role or revoke a privilege from a role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the role, the object type, the object name, the privilege and the operation type
+	//
+	// The `ErrorCode` of `Status` is `Success` if the operation is done successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
+
+	// SelectGrant notifies Proxy to list the grants of a role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the role, and optionally the object type and the object name
+	//
+	// The `Status` in response struct `SelectGrantResponse` indicates if this operation is processed successfully or fail cause;
+	// `Entities` in `SelectGrantResponse` records the grants.
+	// error is always nil
+	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
+
+	// CreateDatabase notifies Proxy to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies Proxy to drop a database, only the empty database can be dropped
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases notifies Proxy to list all the databases, including the default one
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// `DbNames` in `ListDatabasesResponse` records the database names, `CreatedTimestamps` records the created timestamps.
+	// error is always nil
+	ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
 }
 
 // QueryNode is the interface `querynode` package implements
@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordEncrypt hashes the raw password with a random salt by bcrypt.
+func PasswordEncrypt(pwd string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// PasswordVerify checks whether the raw password matches the bcrypt hashed one.
+func PasswordVerify(rawPwd, encryptedPwd string) bool {
+	err := bcrypt.CompareHashAndPassword([]byte(encryptedPwd), []byte(rawPwd))
+	return err == nil
+}
+
+// SHA256 returns the hex encoded sha256 digest of src salted by salt.
+func SHA256(src string, salt string) string {
+	h := sha256.New()
+	h.Write([]byte(src + salt))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Base64Encode encodes the string with standard base64 encoding.
+func Base64Encode(pwd string) string {
+	return base64.StdEncoding.EncodeToString([]byte(pwd))
+}
+
+// Base64Decode decodes the standard base64 encoded string.
+func Base64Decode(pwd string) (string, error) {
+	bytes, err := base64.StdEncoding.DecodeString(pwd)
+	return string(bytes), err
+}
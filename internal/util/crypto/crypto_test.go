@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordVerify(t *testing.T) {
+	wrongPassword := "test_my_name"
+	correctPassword := "test_my_pass_new"
+
+	hash, err := PasswordEncrypt(correctPassword)
+	assert.Nil(t, err)
+	assert.NotEqual(t, correctPassword, hash)
+	assert.True(t, PasswordVerify(correctPassword, hash))
+	assert.False(t, PasswordVerify(wrongPassword, hash))
+
+	// the same password must not produce the same hash because of the salt
+	hash2, err := PasswordEncrypt(correctPassword)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, hash2)
+	assert.True(t, PasswordVerify(correctPassword, hash2))
+}
+
+func TestSHA256(t *testing.T) {
+	assert.Equal(t, SHA256("pwd", "user"), SHA256("pwd", "user"))
+	assert.NotEqual(t, SHA256("pwd", "user"), SHA256("pwd", "user2"))
+	assert.Equal(t, 64, len(SHA256("pwd", "user")))
+}
+
+func TestBase64(t *testing.T) {
+	raw := "root:Milvus"
+	decoded, err := Base64Decode(Base64Encode(raw))
+	assert.Nil(t, err)
+	assert.Equal(t, raw, decoded)
+
+	_, err = Base64Decode("!@#")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package funcutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+const (
+	policySeparator       = "/"
+	objectNameSeparator   = "."
+	privilegeNamePrefix   = "Privilege"
+	policyFieldNum        = 4
+	userRoleCacheFieldNum = 2
+)
+
+// PolicyForPrivilege encodes a grant as "role/objectType/objectName/privilege".
+func PolicyForPrivilege(roleName string, objectType string, objectName string, privilege string) string {
+	return strings.Join([]string{roleName, objectType, objectName, privilege}, policySeparator)
+}
+
+// PolicyObjectName returns the object name encoded in the grants. The collections of different databases can share
+// a name, so the collection objects are scoped by the database as "db.collection", and "db.*" means all the
+// collections of the database. The database is the default one if it isn't specified.
+func PolicyObjectName(objectType string, dbName string, objectName string) string {
+	if objectType != commonpb.ObjectType_Collection.String() {
+		return objectName
+	}
+	if dbName == "" {
+		dbName = common.DefaultDBName
+	}
+	return dbName + objectNameSeparator + objectName
+}
+
+// DecodePolicy decodes the grant encoded by PolicyForPrivilege.
+func DecodePolicy(policy string) (roleName string, objectType string, objectName string, privilege string, err error) {
+	fields := strings.Split(policy, policySeparator)
+	if len(fields) != policyFieldNum {
+		return "", "", "", "", fmt.Errorf("invalid policy: %s", policy)
+	}
+	return fields[0], fields[1], fields[2], fields[3], nil
+}
+
+// EncodeUserRoleCache encodes a user-role binding as "user/role".
+func EncodeUserRoleCache(user string, role string) string {
+	return user + policySeparator + role
+}
+
+// DecodeUserRoleCache decodes the user-role binding encoded by EncodeUserRoleCache.
+func DecodeUserRoleCache(cache string) (string, string, error) {
+	fields := strings.Split(cache, policySeparator)
+	if len(fields) != userRoleCacheFieldNum {
+		return "", "", fmt.Errorf("invalid user-role cache: %s", cache)
+	}
+	return fields[0], fields[1], nil
+}
+
+// PrivilegeName returns the name of the privilege used in the grants, e.g. Insert for PrivilegeInsert.
+func PrivilegeName(privilege commonpb.ObjectPrivilege) string {
+	return strings.TrimPrefix(privilege.String(), privilegeNamePrefix)
+}
+
+// IsValidPrivilegeName returns true if the name is the name of a commonpb.ObjectPrivilege.
+func IsValidPrivilegeName(name string) bool {
+	_, ok := commonpb.ObjectPrivilege_value[privilegeNamePrefix+name]
+	return ok
+}
+
+// IsValidObjectType returns true if the name is the name of a commonpb.ObjectType.
+func IsValidObjectType(name string) bool {
+	_, ok := commonpb.ObjectType_value[name]
+	return ok
+}
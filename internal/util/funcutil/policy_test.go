@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package funcutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+func Test_PolicyForPrivilege(t *testing.T) {
+	policy := PolicyForPrivilege("role1", "Collection", "col1", "Insert")
+	assert.Equal(t, "role1/Collection/col1/Insert", policy)
+
+	role, objectType, objectName, privilege, err := DecodePolicy(policy)
+	assert.Nil(t, err)
+	assert.Equal(t, "role1", role)
+	assert.Equal(t, "Collection", objectType)
+	assert.Equal(t, "col1", objectName)
+	assert.Equal(t, "Insert", privilege)
+
+	_, _, _, _, err = DecodePolicy("role1/Collection/col1")
+	assert.NotNil(t, err)
+}
+
+func Test_PolicyObjectName(t *testing.T) {
+	assert.Equal(t, "default.col1", PolicyObjectName("Collection", "", "col1"))
+	assert.Equal(t, "db1.col1", PolicyObjectName("Collection", "db1", "col1"))
+	assert.Equal(t, "db1.*", PolicyObjectName("Collection", "db1", "*"))
+	assert.Equal(t, "*", PolicyObjectName("Global", "db1", "*"))
+	assert.Equal(t, "user1", PolicyObjectName("User", "", "user1"))
+}
+
+func Test_EncodeUserRoleCache(t *testing.T) {
+	cache := EncodeUserRoleCache("user1", "role1")
+	user, role, err := DecodeUserRoleCache(cache)
+	assert.Nil(t, err)
+	assert.Equal(t, "user1", user)
+	assert.Equal(t, "role1", role)
+
+	_, _, err = DecodeUserRoleCache("user1")
+	assert.NotNil(t, err)
+}
+
+func Test_PrivilegeName(t *testing.T) {
+	assert.Equal(t, "Insert", PrivilegeName(commonpb.ObjectPrivilege_PrivilegeInsert))
+	assert.Equal(t, "All", PrivilegeName(commonpb.ObjectPrivilege_PrivilegeAll))
+
+	assert.True(t, IsValidPrivilegeName("Search"))
+	assert.False(t, IsValidPrivilegeName("PrivilegeSearch"))
+	assert.False(t, IsValidPrivilegeName("Fly"))
+
+	assert.True(t, IsValidObjectType("Collection"))
+	assert.True(t, IsValidObjectType("Global"))
+	assert.False(t, IsValidObjectType("Partition"))
+}
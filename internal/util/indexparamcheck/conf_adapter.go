@@ -101,6 +101,9 @@ const (
 	OutgoingEdgeSize = "outgoing_edge_size"
 	IncomingEdgeSize = "incoming_edge_size"
 
+	// DropRatioBuild is the ratio of the smallest values of a sparse float vector to drop when building the index
+	DropRatioBuild = "drop_ratio_build"
+
 	IndexMode = "index_mode"
 	CPUMode   = "CPU"
 	GPUMode   = "GPU"
@@ -115,6 +118,9 @@ var BinIvfMetrics = []string{HAMMING, JACCARD, TANIMOTO}
 var supportDimPerSubQuantizer = []int{32, 28, 24, 20, 16, 12, 10, 8, 6, 4, 3, 2, 1}        // const
 var supportSubQuantizer = []int{96, 64, 56, 48, 40, 32, 28, 24, 20, 16, 12, 8, 4, 3, 2, 1} // const
 
+// SparseMetrics is a set of all metric types supported for sparse float vector.
+var SparseMetrics = []string{IP} // const
+
 type ConfAdapter interface {
 	// CheckTrain returns true if the index can be built with the specific index parameters.
 	CheckTrain(map[string]string) bool
@@ -482,3 +488,22 @@ func (adapter *NGTONNGConfAdapter) CheckTrain(params map[string]string) bool {
 func newNGTONNGConfAdapter() *NGTONNGConfAdapter {
 	return &NGTONNGConfAdapter{}
 }
+
+// SparseInvertedConfAdapter checks if a `SPARSE_INVERTED_INDEX` index can be built.
+type SparseInvertedConfAdapter struct {
+}
+
+func (adapter *SparseInvertedConfAdapter) CheckTrain(params map[string]string) bool {
+	if dropRatioStr, ok := params[DropRatioBuild]; ok {
+		dropRatio, err := strconv.ParseFloat(dropRatioStr, 64)
+		if err != nil || dropRatio < 0 || dropRatio >= 1 {
+			return false
+		}
+	}
+
+	return CheckStrByValues(params, Metric, SparseMetrics)
+}
+
+func newSparseInvertedConfAdapter() *SparseInvertedConfAdapter {
+	return &SparseInvertedConfAdapter{}
+}
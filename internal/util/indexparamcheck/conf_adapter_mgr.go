@@ -59,6 +59,7 @@ func (mgr *ConfAdapterMgrImpl) registerConfAdapter() {
 	mgr.adapters[IndexRHNSWSQ] = newRHNSWSQConfAdapter()
 	mgr.adapters[IndexNGTPANNG] = newNGTPANNGConfAdapter()
 	mgr.adapters[IndexNGTONNG] = newNGTONNGConfAdapter()
+	mgr.adapters[IndexSparseInverted] = newSparseInvertedConfAdapter()
 }
 
 func newConfAdapterMgrImpl() *ConfAdapterMgrImpl {
@@ -117,6 +117,12 @@ func Test_GetConfAdapterMgrInstance(t *testing.T) {
 	assert.NotEqual(t, nil, adapter)
 	_, ok = adapter.(*NGTONNGConfAdapter)
 	assert.Equal(t, true, ok)
+
+	adapter, err = adapterMgr.GetAdapter(IndexSparseInverted)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, nil, adapter)
+	_, ok = adapter.(*SparseInvertedConfAdapter)
+	assert.Equal(t, true, ok)
 }
 
 func TestConfAdapterMgrImpl_GetAdapter(t *testing.T) {
@@ -219,4 +225,10 @@ func TestConfAdapterMgrImpl_GetAdapter(t *testing.T) {
 	assert.NotEqual(t, nil, adapter)
 	_, ok = adapter.(*NGTONNGConfAdapter)
 	assert.Equal(t, true, ok)
+
+	adapter, err = adapterMgr.GetAdapter(IndexSparseInverted)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, nil, adapter)
+	_, ok = adapter.(*SparseInvertedConfAdapter)
+	assert.Equal(t, true, ok)
 }
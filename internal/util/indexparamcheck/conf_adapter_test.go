@@ -595,3 +595,43 @@ func TestNGTONNGConfAdapter_CheckTrain(t *testing.T) {
 		}
 	}
 }
+
+func TestSparseInvertedConfAdapter_CheckTrain(t *testing.T) {
+	validParams := map[string]string{
+		Metric: IP,
+	}
+
+	validDropRatioParams := copyParams(validParams)
+	validDropRatioParams[DropRatioBuild] = "0.2"
+
+	invalidMetricParams := copyParams(validParams)
+	invalidMetricParams[Metric] = L2
+
+	invalidDropRatioParamsMin := copyParams(validParams)
+	invalidDropRatioParamsMin[DropRatioBuild] = "-0.1"
+
+	invalidDropRatioParamsMax := copyParams(validParams)
+	invalidDropRatioParamsMax[DropRatioBuild] = "1"
+
+	invalidDropRatioParamsNaN := copyParams(validParams)
+	invalidDropRatioParamsNaN[DropRatioBuild] = "ratio"
+
+	cases := []struct {
+		params map[string]string
+		want   bool
+	}{
+		{validParams, true},
+		{validDropRatioParams, true},
+		{invalidMetricParams, false},
+		{invalidDropRatioParamsMin, false},
+		{invalidDropRatioParamsMax, false},
+		{invalidDropRatioParamsNaN, false},
+	}
+
+	adapter := newSparseInvertedConfAdapter()
+	for _, test := range cases {
+		if got := adapter.CheckTrain(test.params); got != test.want {
+			t.Errorf("SparseInvertedConfAdapter.CheckTrain(%v) = %v", test.params, test.want)
+		}
+	}
+}
@@ -31,4 +31,8 @@ const (
 	IndexANNOY           IndexType = "ANNOY"
 	IndexNGTPANNG        IndexType = "NGT_PANNG"
 	IndexNGTONNG         IndexType = "NGT_ONNG"
+
+	// IndexSparseInverted represents "SPARSE_INVERTED_INDEX" which maps the indices of sparse float vectors to the
+	// rows having non-zero values at them.
+	IndexSparseInverted IndexType = "SPARSE_INVERTED_INDEX"
 )
@@ -0,0 +1,449 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// Aggregator accumulates the aggregations of a query, grouped by the values of the group by field if there is one.
+// The partial aggregates of a group hold its row count and the count, min, max or sum of each aggregation,
+// avg is accumulated as a sum and divided by the row count when the results are finalized.
+// Rows are accumulated with AddRows, partial aggregates of other aggregators with AddPartials.
+type Aggregator struct {
+	aggregates []*internalpb.Aggregate
+	fields     []*schemapb.FieldSchema // the field of each aggregation, nil for count(*)
+	groupBy    *schemapb.FieldSchema
+	groups     map[interface{}]*aggregateGroup
+	keys       []interface{} // group keys in the order they are seen
+}
+
+type aggregateGroup struct {
+	count   int64
+	longs   []int64   // values of count and of the aggregations over integer fields
+	doubles []float64 // values of the aggregations over floating fields
+}
+
+// NewAggregator creates an aggregator of the aggregations over the fields of schema, the aggregated fields have to
+// be numeric, and the group by field a bool, integer or string field. groupByFieldID is 0 if the rows are not grouped.
+func NewAggregator(schema *schemapb.CollectionSchema, aggregates []*internalpb.Aggregate, groupByFieldID int64) (*Aggregator, error) {
+	helper, err := CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	a := &Aggregator{
+		aggregates: aggregates,
+		fields:     make([]*schemapb.FieldSchema, len(aggregates)),
+		groups:     make(map[interface{}]*aggregateGroup),
+	}
+	for i, agg := range aggregates {
+		if agg.GetOp() == internalpb.AggregateOp_Count {
+			if agg.GetFieldID() != 0 {
+				return nil, fmt.Errorf("count only supports *, but got field %d", agg.GetFieldID())
+			}
+			continue
+		}
+		field, err := helper.GetFieldFromID(agg.GetFieldID())
+		if err != nil {
+			return nil, err
+		}
+		if !IsIntegerType(field.DataType) && !IsFloatingType(field.DataType) {
+			return nil, fmt.Errorf("can not aggregate %s over field %s of type %s", strings.ToLower(agg.GetOp().String()), field.Name, field.DataType.String())
+		}
+		if field.GetNullable() {
+			return nil, fmt.Errorf("can not aggregate %s over nullable field %s", strings.ToLower(agg.GetOp().String()), field.Name)
+		}
+		a.fields[i] = field
+	}
+	if groupByFieldID != 0 {
+		field, err := helper.GetFieldFromID(groupByFieldID)
+		if err != nil {
+			return nil, err
+		}
+		if !IsBoolType(field.DataType) && !IsIntegerType(field.DataType) && field.DataType != schemapb.DataType_String {
+			return nil, fmt.Errorf("can not group by field %s of type %s", field.Name, field.DataType.String())
+		}
+		a.groupBy = field
+	}
+	return a, nil
+}
+
+// AggregateName returns the name of the result column of an aggregation over the field, such as count(*) and sum(age)
+func AggregateName(agg *internalpb.Aggregate, fieldName string) string {
+	if agg.GetOp() == internalpb.AggregateOp_Count {
+		fieldName = "*"
+	}
+	return strings.ToLower(agg.GetOp().String()) + "(" + fieldName + ")"
+}
+
+// isFloating returns whether the i-th aggregation accumulates floating values
+func (a *Aggregator) isFloating(i int) bool {
+	return a.fields[i] != nil && IsFloatingType(a.fields[i].DataType)
+}
+
+// accumulate merges count rows with the values of each aggregation into the group of key
+func (a *Aggregator) accumulate(key interface{}, count int64, longs []int64, doubles []float64) {
+	g, ok := a.groups[key]
+	if !ok {
+		g = &aggregateGroup{
+			longs:   make([]int64, len(a.aggregates)),
+			doubles: make([]float64, len(a.aggregates)),
+		}
+		a.groups[key] = g
+		a.keys = append(a.keys, key)
+	}
+	first := g.count == 0
+	g.count += count
+	for i, agg := range a.aggregates {
+		switch agg.GetOp() {
+		case internalpb.AggregateOp_Min:
+			if first || longs[i] < g.longs[i] {
+				g.longs[i] = longs[i]
+			}
+			if first || doubles[i] < g.doubles[i] {
+				g.doubles[i] = doubles[i]
+			}
+		case internalpb.AggregateOp_Max:
+			if first || longs[i] > g.longs[i] {
+				g.longs[i] = longs[i]
+			}
+			if first || doubles[i] > g.doubles[i] {
+				g.doubles[i] = doubles[i]
+			}
+		default:
+			g.longs[i] += longs[i]
+			g.doubles[i] += doubles[i]
+		}
+	}
+}
+
+// AddRows accumulates the rows of the retrieved fields data, which contain the aggregated and group by fields
+func (a *Aggregator) AddRows(fieldsData []*schemapb.FieldData) error {
+	if len(fieldsData) == 0 {
+		return nil
+	}
+	findField := func(fieldID int64) (*schemapb.FieldData, error) {
+		for _, fieldData := range fieldsData {
+			if fieldData.FieldId == fieldID {
+				return fieldData, nil
+			}
+		}
+		return nil, fmt.Errorf("field %d is not retrieved", fieldID)
+	}
+	columns := make([]*schemapb.FieldData, len(a.aggregates))
+	for i, field := range a.fields {
+		if field == nil {
+			continue
+		}
+		column, err := findField(field.FieldID)
+		if err != nil {
+			return err
+		}
+		columns[i] = column
+	}
+	var groupColumn *schemapb.FieldData
+	if a.groupBy != nil {
+		column, err := findField(a.groupBy.FieldID)
+		if err != nil {
+			return err
+		}
+		groupColumn = column
+	}
+
+	var numRows int64
+	for _, fieldData := range fieldsData {
+		if n := getScalarRowNum(fieldData); n > numRows {
+			numRows = n
+		}
+	}
+	longs := make([]int64, len(a.aggregates))
+	doubles := make([]float64, len(a.aggregates))
+	for row := int64(0); row < numRows; row++ {
+		var key interface{}
+		if groupColumn != nil {
+			key = GetScalarFieldValue(groupColumn, row)
+		}
+		for i, column := range columns {
+			if column == nil {
+				longs[i] = 1
+				continue
+			}
+			switch v := GetScalarFieldValue(column, row).(type) {
+			case int32:
+				longs[i] = int64(v)
+			case int64:
+				longs[i] = v
+			case float32:
+				doubles[i] = float64(v)
+			case float64:
+				doubles[i] = v
+			default:
+				return fmt.Errorf("can not aggregate field %s", column.FieldName)
+			}
+		}
+		a.accumulate(key, 1, longs, doubles)
+	}
+	return nil
+}
+
+// AddPartials accumulates the partial aggregates returned by Partials of another aggregator of the same aggregations
+func (a *Aggregator) AddPartials(partials []*schemapb.FieldData) error {
+	if len(partials) == 0 {
+		return nil
+	}
+	offset := 0
+	if a.groupBy != nil {
+		offset = 1
+	}
+	if len(partials) != offset+1+len(a.aggregates) {
+		return fmt.Errorf("mismatch partial aggregates, expect %d columns get %d", offset+1+len(a.aggregates), len(partials))
+	}
+	counts := partials[offset].GetScalars().GetLongData().GetData()
+	if a.groupBy != nil && getScalarRowNum(partials[0]) != int64(len(counts)) {
+		return fmt.Errorf("mismatch partial aggregates, expect %d rows", len(counts))
+	}
+	for i := range a.aggregates {
+		column := partials[offset+1+i]
+		if getScalarRowNum(column) != int64(len(counts)) || a.isFloating(i) != (column.GetScalars().GetDoubleData() != nil) {
+			return fmt.Errorf("mismatch partial aggregates of %s", strings.ToLower(a.aggregates[i].GetOp().String()))
+		}
+	}
+
+	longs := make([]int64, len(a.aggregates))
+	doubles := make([]float64, len(a.aggregates))
+	for row, count := range counts {
+		var key interface{}
+		if a.groupBy != nil {
+			key = GetScalarFieldValue(partials[0], int64(row))
+		}
+		for i := range a.aggregates {
+			column := partials[offset+1+i]
+			if a.isFloating(i) {
+				doubles[i] = column.GetScalars().GetDoubleData().GetData()[row]
+			} else {
+				longs[i] = column.GetScalars().GetLongData().GetData()[row]
+			}
+		}
+		a.accumulate(key, count, longs, doubles)
+	}
+	return nil
+}
+
+// Partials returns the partial aggregates, the values of the group by field if there is one, the row counts
+// and the partial aggregate of each aggregation, one row for each group
+func (a *Aggregator) Partials() []*schemapb.FieldData {
+	if len(a.keys) == 0 {
+		return nil
+	}
+	var partials []*schemapb.FieldData
+	if a.groupBy != nil {
+		partials = append(partials, newScalarFieldData(a.groupBy, a.keys))
+	}
+	counts := make([]int64, 0, len(a.keys))
+	for _, key := range a.keys {
+		counts = append(counts, a.groups[key].count)
+	}
+	partials = append(partials, newLongFieldData("", counts))
+	for i := range a.aggregates {
+		partials = append(partials, a.aggregateColumn(i, "", a.keys, a.isFloating(i)))
+	}
+	return partials
+}
+
+// Finalize returns the results of the aggregations, one row for each group sorted by the group by values,
+// aggregations over no rows return a single row of zero values
+func (a *Aggregator) Finalize() []*schemapb.FieldData {
+	if a.groupBy == nil && len(a.keys) == 0 {
+		a.accumulate(nil, 0, make([]int64, len(a.aggregates)), make([]float64, len(a.aggregates)))
+	}
+
+	keys := a.keys
+	var results []*schemapb.FieldData
+	if a.groupBy != nil {
+		groupColumn := newScalarFieldData(a.groupBy, a.keys)
+		// SortRows can not fail on the bool, integer and string columns
+		pks := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: make([]int64, len(a.keys))}}}
+		rows, _ := SortRows(pks, groupColumn, false)
+		keys = make([]interface{}, 0, len(rows))
+		for _, row := range rows {
+			keys = append(keys, a.keys[row])
+		}
+		groupColumn = newScalarFieldData(a.groupBy, keys)
+		groupColumn.FieldName = a.groupBy.Name
+		groupColumn.FieldId = a.groupBy.FieldID
+		groupColumn.Type = a.groupBy.DataType
+		results = append(results, groupColumn)
+	}
+	for i, agg := range a.aggregates {
+		fieldName := ""
+		if a.fields[i] != nil {
+			fieldName = a.fields[i].Name
+		}
+		name := AggregateName(agg, fieldName)
+		if agg.GetOp() != internalpb.AggregateOp_Avg {
+			results = append(results, a.aggregateColumn(i, name, keys, a.isFloating(i)))
+			continue
+		}
+		avgs := make([]float64, 0, len(keys))
+		for _, key := range keys {
+			g := a.groups[key]
+			sum := g.doubles[i]
+			if !a.isFloating(i) {
+				sum = float64(g.longs[i])
+			}
+			if g.count == 0 {
+				avgs = append(avgs, 0)
+			} else {
+				avgs = append(avgs, sum/float64(g.count))
+			}
+		}
+		results = append(results, newDoubleFieldData(name, avgs))
+	}
+	return results
+}
+
+// aggregateColumn returns the values of the i-th aggregation of the groups of keys
+func (a *Aggregator) aggregateColumn(i int, name string, keys []interface{}, floating bool) *schemapb.FieldData {
+	if floating {
+		values := make([]float64, 0, len(keys))
+		for _, key := range keys {
+			values = append(values, a.groups[key].doubles[i])
+		}
+		return newDoubleFieldData(name, values)
+	}
+	values := make([]int64, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, a.groups[key].longs[i])
+	}
+	return newLongFieldData(name, values)
+}
+
+func newLongFieldData(name string, values []int64) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_Int64,
+		FieldName: name,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+			},
+		},
+	}
+}
+
+func newDoubleFieldData(name string, values []float64) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_Double,
+		FieldName: name,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: values}},
+			},
+		},
+	}
+}
+
+// newScalarFieldData returns the field data of the values of a bool, integer or string field,
+// the values are typed as returned by GetScalarFieldValue, nil values are null
+func newScalarFieldData(field *schemapb.FieldSchema, values []interface{}) *schemapb.FieldData {
+	var validData []bool
+	for _, v := range values {
+		if v == nil {
+			validData = make([]bool, len(values))
+			break
+		}
+	}
+	if validData != nil {
+		nonNull := make([]interface{}, len(values))
+		for i, v := range values {
+			validData[i] = v != nil
+			nonNull[i] = v
+			if v == nil {
+				nonNull[i] = zeroValue(field.DataType)
+			}
+		}
+		values = nonNull
+	}
+	scalars := &schemapb.ScalarField{}
+	switch {
+	case IsBoolType(field.DataType):
+		data := make([]bool, 0, len(values))
+		for _, v := range values {
+			data = append(data, v.(bool))
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case field.DataType == schemapb.DataType_Int64:
+		data := make([]int64, 0, len(values))
+		for _, v := range values {
+			data = append(data, v.(int64))
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case IsIntegerType(field.DataType):
+		data := make([]int32, 0, len(values))
+		for _, v := range values {
+			data = append(data, v.(int32))
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	default:
+		data := make([]string, 0, len(values))
+		for _, v := range values {
+			data = append(data, v.(string))
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	}
+	return &schemapb.FieldData{
+		Type:      field.DataType,
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+		ValidData: validData,
+	}
+}
+
+// zeroValue returns the zero value of a bool, integer or string field, typed as returned by GetScalarFieldValue
+func zeroValue(dataType schemapb.DataType) interface{} {
+	switch {
+	case IsBoolType(dataType):
+		return false
+	case dataType == schemapb.DataType_Int64:
+		return int64(0)
+	case IsIntegerType(dataType):
+		return int32(0)
+	default:
+		return ""
+	}
+}
+
+// getScalarRowNum returns the number of rows of a scalar field, 0 for the other fields
+func getScalarRowNum(fieldData *schemapb.FieldData) int64 {
+	switch data := fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return int64(len(data.BoolData.Data))
+	case *schemapb.ScalarField_IntData:
+		return int64(len(data.IntData.Data))
+	case *schemapb.ScalarField_LongData:
+		return int64(len(data.LongData.Data))
+	case *schemapb.ScalarField_FloatData:
+		return int64(len(data.FloatData.Data))
+	case *schemapb.ScalarField_DoubleData:
+		return int64(len(data.DoubleData.Data))
+	case *schemapb.ScalarField_StringData:
+		return int64(len(data.StringData.Data))
+	case *schemapb.ScalarField_ArrayData:
+		return int64(len(data.ArrayData.Data))
+	case *schemapb.ScalarField_JsonData:
+		return int64(len(data.JsonData.Data))
+	default:
+		return 0
+	}
+}
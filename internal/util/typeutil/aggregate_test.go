@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestAggregator(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Double},
+			{FieldID: 103, Name: "tag", DataType: schemapb.DataType_Bool},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	aggregates := []*internalpb.Aggregate{
+		{Op: internalpb.AggregateOp_Count},
+		{Op: internalpb.AggregateOp_Min, FieldID: 101},
+		{Op: internalpb.AggregateOp_Max, FieldID: 102},
+		{Op: internalpb.AggregateOp_Sum, FieldID: 101},
+		{Op: internalpb.AggregateOp_Avg, FieldID: 102},
+	}
+	genRows := func(ages []int32, scores []float64, tags []bool) []*schemapb.FieldData {
+		return []*schemapb.FieldData{
+			{FieldId: 101, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: ages}}}}},
+			{FieldId: 102, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: scores}}}}},
+			{FieldId: 103, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: tags}}}}},
+		}
+	}
+
+	t.Run("no group", func(t *testing.T) {
+		partial, err := NewAggregator(schema, aggregates, 0)
+		assert.NoError(t, err)
+		assert.NoError(t, partial.AddRows(genRows([]int32{3, 1}, []float64{0.5, 1.5}, []bool{true, false})))
+
+		a, err := NewAggregator(schema, aggregates, 0)
+		assert.NoError(t, err)
+		assert.NoError(t, a.AddPartials(partial.Partials()))
+		assert.NoError(t, a.AddRows(genRows([]int32{5}, []float64{4}, []bool{true})))
+		results := a.Finalize()
+		assert.Equal(t, 5, len(results))
+		assert.Equal(t, "count(*)", results[0].FieldName)
+		assert.Equal(t, []int64{3}, results[0].GetScalars().GetLongData().Data)
+		assert.Equal(t, "min(age)", results[1].FieldName)
+		assert.Equal(t, []int64{1}, results[1].GetScalars().GetLongData().Data)
+		assert.Equal(t, schemapb.DataType_Double, results[2].Type)
+		assert.Equal(t, []float64{4}, results[2].GetScalars().GetDoubleData().Data)
+		assert.Equal(t, []int64{9}, results[3].GetScalars().GetLongData().Data)
+		assert.Equal(t, "avg(score)", results[4].FieldName)
+		assert.Equal(t, []float64{2}, results[4].GetScalars().GetDoubleData().Data)
+	})
+
+	t.Run("group by", func(t *testing.T) {
+		a, err := NewAggregator(schema, aggregates, 103)
+		assert.NoError(t, err)
+		assert.NoError(t, a.AddRows(genRows([]int32{3, 1, 5}, []float64{0.5, 1.5, 4}, []bool{true, false, true})))
+		results := a.Finalize()
+		assert.Equal(t, 6, len(results))
+		assert.Equal(t, "tag", results[0].FieldName)
+		assert.Equal(t, int64(103), results[0].FieldId)
+		assert.Equal(t, []bool{false, true}, results[0].GetScalars().GetBoolData().Data)
+		assert.Equal(t, []int64{1, 2}, results[1].GetScalars().GetLongData().Data)
+		assert.Equal(t, []int64{1, 3}, results[2].GetScalars().GetLongData().Data)
+		assert.Equal(t, []float64{1.5, 2.25}, results[5].GetScalars().GetDoubleData().Data)
+	})
+
+	t.Run("group by nullable", func(t *testing.T) {
+		nullableSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+		nullableSchema.Fields[3].Nullable = true
+		a, err := NewAggregator(nullableSchema, aggregates[:2], 103)
+		assert.NoError(t, err)
+		rows := genRows([]int32{3, 1, 5}, []float64{0.5, 1.5, 4}, []bool{false, true, false})
+		rows[2].ValidData = []bool{false, true, true}
+		assert.NoError(t, a.AddRows(rows))
+
+		merged, err := NewAggregator(nullableSchema, aggregates[:2], 103)
+		assert.NoError(t, err)
+		assert.NoError(t, merged.AddPartials(a.Partials()))
+		results := merged.Finalize()
+		assert.Equal(t, []bool{false, true, false}, results[0].GetScalars().GetBoolData().Data)
+		assert.Equal(t, []bool{true, true, false}, results[0].ValidData)
+		assert.Equal(t, []int64{1, 1, 1}, results[1].GetScalars().GetLongData().Data)
+		assert.Equal(t, []int64{5, 1, 3}, results[2].GetScalars().GetLongData().Data)
+
+		nullableSchema.Fields[1].Nullable = true
+		_, err = NewAggregator(nullableSchema, aggregates, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		a, err := NewAggregator(schema, aggregates, 0)
+		assert.NoError(t, err)
+		assert.NoError(t, a.AddRows(nil))
+		assert.Nil(t, a.Partials())
+		results := a.Finalize()
+		assert.Equal(t, []int64{0}, results[0].GetScalars().GetLongData().Data)
+		assert.Equal(t, []float64{0}, results[4].GetScalars().GetDoubleData().Data)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewAggregator(schema, []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Count, FieldID: 101}}, 0)
+		assert.Error(t, err)
+		_, err = NewAggregator(schema, []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Sum, FieldID: 103}}, 0)
+		assert.Error(t, err)
+		_, err = NewAggregator(schema, []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Sum, FieldID: 999}}, 0)
+		assert.Error(t, err)
+		_, err = NewAggregator(schema, aggregates, 102)
+		assert.Error(t, err)
+
+		a, err := NewAggregator(schema, aggregates, 0)
+		assert.NoError(t, err)
+		assert.Error(t, a.AddRows(genRows(nil, nil, nil)[1:]))
+		partials := []*schemapb.FieldData{newLongFieldData("", []int64{1})}
+		assert.Error(t, a.AddPartials(partials))
+		for range aggregates {
+			partials = append(partials, newLongFieldData("", []int64{1}))
+		}
+		// max(score) has to be a double column
+		assert.Error(t, a.AddPartials(partials))
+	})
+}
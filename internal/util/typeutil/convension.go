@@ -84,6 +84,186 @@ func Uint64ToBytes(v uint64) []byte {
 	return b
 }
 
+// EncodeVariableLengthValue encodes the value of a variable-length field in a row, which is the uint32 length
+// followed by the bytes of the value
+func EncodeVariableLengthValue(value []byte) []byte {
+	buf := make([]byte, 4+len(value))
+	common.Endian.PutUint32(buf, uint32(len(value)))
+	copy(buf[4:], value)
+	return buf
+}
+
+// DecodeVariableLengthValue decodes the value of a variable-length field at the beginning of data, it returns the bytes
+// of the value and the size the value takes in the row.
+func DecodeVariableLengthValue(data []byte) ([]byte, int, error) {
+	if len(data) < 4 {
+		return nil, 0, fmt.Errorf("Failed to decode variable-length value: invalid size %d", len(data))
+	}
+	l := int(common.Endian.Uint32(data))
+	if 4+l > len(data) {
+		return nil, 0, fmt.Errorf("Failed to decode variable-length value: length %d exceeds size %d", l, len(data)-4)
+	}
+	return data[4 : 4+l], 4 + l, nil
+}
+
+// EncodeVarChar encodes the VarChar value in a row, the string must be no longer than maxLength.
+func EncodeVarChar(s string, maxLength int) ([]byte, error) {
+	if len(s) > maxLength {
+		return nil, fmt.Errorf("length of varchar %d exceeds max length %d", len(s), maxLength)
+	}
+	return EncodeVariableLengthValue([]byte(s)), nil
+}
+
+// ValidateSparseFloatRow checks the row of a sparse float vector, which is (uint32 index, float32 value) pairs in
+// little endian, the indices must be strictly increasing and the values must be finite.
+func ValidateSparseFloatRow(row []byte) error {
+	if len(row)%8 != 0 {
+		return fmt.Errorf("invalid size %d of sparse float vector, must be a multiple of 8", len(row))
+	}
+	for i := 0; i < len(row)/8; i++ {
+		index := common.Endian.Uint32(row[i*8:])
+		if i > 0 && index <= common.Endian.Uint32(row[(i-1)*8:]) {
+			return fmt.Errorf("indices of sparse float vector must be strictly increasing, got %d at position %d", index, i)
+		}
+		value := math.Float32frombits(common.Endian.Uint32(row[i*8+4:]))
+		if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+			return fmt.Errorf("value of sparse float vector at index %d is not finite", index)
+		}
+	}
+	return nil
+}
+
+// EncodeSparseFloatRow encodes the row of a sparse float vector as its value in a row, the row must have no more
+// than maxNnz non-zero elements.
+func EncodeSparseFloatRow(row []byte, maxNnz int) ([]byte, error) {
+	if err := ValidateSparseFloatRowNnz(row, maxNnz); err != nil {
+		return nil, err
+	}
+	return EncodeVariableLengthValue(row), nil
+}
+
+// ValidateSparseFloatRowNnz checks the row of a sparse float vector like ValidateSparseFloatRow, and that the row has
+// no more than maxNnz non-zero elements.
+func ValidateSparseFloatRowNnz(row []byte, maxNnz int) error {
+	if err := ValidateSparseFloatRow(row); err != nil {
+		return err
+	}
+	if nnz := len(row) / 8; nnz > maxNnz {
+		return fmt.Errorf("number of non-zero elements of sparse float vector %d exceeds max nnz %d", nnz, maxNnz)
+	}
+	return nil
+}
+
+// SparseFloatRowDim returns the dimension of the row of a sparse float vector, which is the largest index plus one
+func SparseFloatRowDim(row []byte) int64 {
+	if len(row) < 8 {
+		return 0
+	}
+	return int64(common.Endian.Uint32(row[len(row)-8:])) + 1
+}
+
+// Float16ToFloat32 converts a little endian IEEE 754 half precision value to float32.
+func Float16ToFloat32(b []byte) float32 {
+	h := uint32(common.Endian.Uint16(b))
+	sign := (h >> 15) << 31
+	exp := (h >> 10) & 0x1f
+	mant := h & 0x3ff
+	switch {
+	case exp == 0 && mant == 0:
+		return math.Float32frombits(sign)
+	case exp == 0:
+		// subnormal, normalize it into a float32 exponent
+		exp = 127 - 15 + 1
+		for mant&0x400 == 0 {
+			mant <<= 1
+			exp--
+		}
+		return math.Float32frombits(sign | exp<<23 | (mant&0x3ff)<<13)
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0xff<<23 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// Float32ToFloat16 converts a float32 to a little endian IEEE 754 half precision value, rounding to nearest even.
+func Float32ToFloat16(f float32) []byte {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int((bits >> 23) & 0xff)
+	mant := bits & 0x7fffff
+
+	var h uint16
+	switch e := exp - 127 + 15; {
+	case exp == 0xff && mant != 0:
+		h = sign | 0x7e00
+	case exp == 0xff || e >= 0x1f:
+		h = sign | 0x7c00
+	case e <= 0:
+		// subnormal or underflow to zero
+		if e < -10 {
+			h = sign
+			break
+		}
+		mant |= 0x800000
+		shift := uint(14 - e)
+		v := mant >> shift
+		rem, half := mant&(1<<shift-1), uint32(1)<<(shift-1)
+		if rem > half || (rem == half && v&1 == 1) {
+			v++
+		}
+		h = sign | uint16(v)
+	default:
+		v := uint32(e)<<10 | mant>>13
+		rem := mant & 0x1fff
+		if rem > 0x1000 || (rem == 0x1000 && v&1 == 1) {
+			// a carry into the exponent rounds up to the next power of two or to infinity
+			v++
+		}
+		h = sign | uint16(v)
+	}
+	b := make([]byte, 2)
+	common.Endian.PutUint16(b, h)
+	return b
+}
+
+// BFloat16ToFloat32 converts a little endian bfloat16 value to float32.
+func BFloat16ToFloat32(b []byte) float32 {
+	return math.Float32frombits(uint32(common.Endian.Uint16(b)) << 16)
+}
+
+// Float32ToBFloat16 converts a float32 to a little endian bfloat16 value, rounding to nearest even.
+func Float32ToBFloat16(f float32) []byte {
+	bits := math.Float32bits(f)
+	var h uint16
+	if math.IsNaN(float64(f)) {
+		h = uint16(bits>>16) | 0x40
+	} else {
+		h = uint16((bits + 0x7fff + (bits>>16)&1) >> 16)
+	}
+	b := make([]byte, 2)
+	common.Endian.PutUint16(b, h)
+	return b
+}
+
+// Float16VectorToFloat32 converts the data of a float16 vector field to float32.
+func Float16VectorToFloat32(data []byte) []float32 {
+	ret := make([]float32, len(data)/2)
+	for i := range ret {
+		ret[i] = Float16ToFloat32(data[i*2:])
+	}
+	return ret
+}
+
+// BFloat16VectorToFloat32 converts the data of a bfloat16 vector field to float32.
+func BFloat16VectorToFloat32(data []byte) []float32 {
+	ret := make([]float32, len(data)/2)
+	for i := range ret {
+		ret[i] = BFloat16ToFloat32(data[i*2:])
+	}
+	return ret
+}
+
 // SliceRemoveDuplicate is used to dedup a Slice
 func SliceRemoveDuplicate(a interface{}) (ret []interface{}) {
 	if reflect.TypeOf(a).Kind() != reflect.Slice {
@@ -15,6 +15,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -81,6 +82,115 @@ func TestConversion(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 
+	t.Run("TestEncodeVarChar", func(t *testing.T) {
+		value, err := EncodeVarChar("abc", 5)
+		assert.Nil(t, err)
+		assert.Equal(t, 4+3, len(value))
+		data, size, err := DecodeVariableLengthValue(append(value, 'x'))
+		assert.Nil(t, err)
+		assert.Equal(t, "abc", string(data))
+		assert.Equal(t, len(value), size)
+
+		value, err = EncodeVarChar("", 5)
+		assert.Nil(t, err)
+		data, size, err = DecodeVariableLengthValue(value)
+		assert.Nil(t, err)
+		assert.Equal(t, "", string(data))
+		assert.Equal(t, 4, size)
+
+		_, err = EncodeVarChar("abcdef", 5)
+		assert.NotNil(t, err)
+		_, _, err = DecodeVariableLengthValue([]byte("ab"))
+		assert.NotNil(t, err)
+		_, _, err = DecodeVariableLengthValue([]byte{10, 0, 0, 0, 'a'})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("TestConvertSparseFloatRow", func(t *testing.T) {
+		pair := func(index uint32, value float32) []byte {
+			b := make([]byte, 8)
+			common.Endian.PutUint32(b, index)
+			common.Endian.PutUint32(b[4:], math.Float32bits(value))
+			return b
+		}
+		row := append(pair(1, 0.5), pair(7, -2)...)
+		assert.Nil(t, ValidateSparseFloatRow(row))
+		assert.Equal(t, int64(8), SparseFloatRowDim(row))
+
+		value, err := EncodeSparseFloatRow(row, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, 4+len(row), len(value))
+		r, size, err := DecodeVariableLengthValue(value)
+		assert.Nil(t, err)
+		assert.Equal(t, len(value), size)
+		assert.Equal(t, row, r)
+
+		value, err = EncodeSparseFloatRow(nil, 3)
+		assert.Nil(t, err)
+		r, _, err = DecodeVariableLengthValue(value)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(r))
+		assert.Equal(t, int64(0), SparseFloatRowDim(r))
+
+		_, err = EncodeSparseFloatRow(row, 1)
+		assert.NotNil(t, err)
+		_, err = EncodeSparseFloatRow(row[:5], 3)
+		assert.NotNil(t, err)
+		_, err = EncodeSparseFloatRow(append(pair(7, 1), pair(1, 1)...), 3)
+		assert.NotNil(t, err)
+		_, err = EncodeSparseFloatRow(append(pair(1, 1), pair(1, 2)...), 3)
+		assert.NotNil(t, err)
+		_, err = EncodeSparseFloatRow(pair(1, float32(math.NaN())), 3)
+		assert.NotNil(t, err)
+		assert.Nil(t, ValidateSparseFloatRowNnz(row, 2))
+		assert.NotNil(t, ValidateSparseFloatRowNnz(row, 1))
+	})
+
+	t.Run("TestConvertFloat16", func(t *testing.T) {
+		comp := func(f float32, h uint16) {
+			b := Float32ToFloat16(f)
+			assert.Equal(t, h, common.Endian.Uint16(b))
+			assert.Equal(t, f, Float16ToFloat32(b))
+		}
+		comp(0, 0x0000)
+		comp(1, 0x3c00)
+		comp(-2, 0xc000)
+		comp(0.5, 0x3800)
+		comp(65504, 0x7bff)
+		comp(float32(math.Pow(2, -24)), 0x0001)
+		comp(float32(math.Inf(1)), 0x7c00)
+
+		// rounding and overflow
+		assert.Equal(t, uint16(0x3c00), common.Endian.Uint16(Float32ToFloat16(1+1.0/2048)))
+		assert.Equal(t, uint16(0x3c02), common.Endian.Uint16(Float32ToFloat16(1+3.0/2048)))
+		assert.Equal(t, uint16(0x7c00), common.Endian.Uint16(Float32ToFloat16(1e6)))
+		assert.Equal(t, uint16(0x0000), common.Endian.Uint16(Float32ToFloat16(1e-10)))
+		assert.True(t, math.IsNaN(float64(Float16ToFloat32(Float32ToFloat16(float32(math.NaN()))))))
+
+		data := append(Float32ToFloat16(1), Float32ToFloat16(-0.5)...)
+		assert.Equal(t, []float32{1, -0.5}, Float16VectorToFloat32(data))
+	})
+
+	t.Run("TestConvertBFloat16", func(t *testing.T) {
+		comp := func(f float32, h uint16) {
+			b := Float32ToBFloat16(f)
+			assert.Equal(t, h, common.Endian.Uint16(b))
+			assert.Equal(t, f, BFloat16ToFloat32(b))
+		}
+		comp(0, 0x0000)
+		comp(1, 0x3f80)
+		comp(-2, 0xc000)
+		comp(float32(math.Inf(-1)), 0xff80)
+
+		// rounding
+		assert.Equal(t, float32(1), BFloat16ToFloat32(Float32ToBFloat16(1+1.0/256)))
+		assert.Equal(t, float32(1+1.0/64), BFloat16ToFloat32(Float32ToBFloat16(1+3.0/256)))
+		assert.True(t, math.IsNaN(float64(BFloat16ToFloat32(Float32ToBFloat16(float32(math.NaN()))))))
+
+		data := append(Float32ToBFloat16(1), Float32ToBFloat16(-0.5)...)
+		assert.Equal(t, []float32{1, -0.5}, BFloat16VectorToFloat32(data))
+	})
+
 	t.Run("TestSliceRemoveDuplicate", func(t *testing.T) {
 		ret := SliceRemoveDuplicate(1)
 		assert.Equal(t, 0, len(ret))
@@ -12,6 +12,7 @@
 package typeutil
 
 import (
+	"fmt"
 	"unsafe"
 
 	"github.com/milvus-io/milvus/internal/common"
@@ -50,3 +51,27 @@ func Hash32String(s string) (int64, error) {
 	}
 	return int64(v), nil
 }
+
+// HashKey2Partition hashes a partition key value, which is an int64 or a string, to the index of the partition
+// among numPartitions partitions
+func HashKey2Partition(key interface{}, numPartitions int64) (int64, error) {
+	if numPartitions <= 0 {
+		return 0, fmt.Errorf("invalid number of partitions: %d", numPartitions)
+	}
+	switch k := key.(type) {
+	case int64:
+		h, err := Hash32Int64(k)
+		if err != nil {
+			return 0, err
+		}
+		return int64(h) % numPartitions, nil
+	case string:
+		h, err := Hash32String(k)
+		if err != nil {
+			return 0, err
+		}
+		return h % numPartitions, nil
+	default:
+		return 0, fmt.Errorf("unsupported partition key type: %T", key)
+	}
+}
@@ -62,3 +62,26 @@ func TestHash32_String(t *testing.T) {
 
 	assert.Equal(t, uint32(h), h2)
 }
+
+func TestHashKey2Partition(t *testing.T) {
+	numPartitions := int64(16)
+
+	h, err := Hash32Int64(100)
+	assert.Nil(t, err)
+	idx, err := HashKey2Partition(int64(100), numPartitions)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(h)%numPartitions, idx)
+
+	hs, err := Hash32String("tenant")
+	assert.Nil(t, err)
+	idx, err = HashKey2Partition("tenant", numPartitions)
+	assert.Nil(t, err)
+	assert.Equal(t, hs%numPartitions, idx)
+	assert.True(t, idx >= 0 && idx < numPartitions)
+
+	_, err = HashKey2Partition(int32(1), numPartitions)
+	assert.NotNil(t, err)
+
+	_, err = HashKey2Partition(int64(1), 0)
+	assert.NotNil(t, err)
+}
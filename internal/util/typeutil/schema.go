@@ -14,11 +14,15 @@ package typeutil
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
@@ -37,6 +41,21 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 			res += 8
 		case schemapb.DataType_String:
 			res += 125 // todo find a better way to estimate string type
+		case schemapb.DataType_Array:
+			size, err := estimateArraySize(fs)
+			if err != nil {
+				return -1, err
+			}
+			res += size
+		case schemapb.DataType_JSON:
+			res += 4 + 256 // todo find a better way to estimate json type, a document takes at most MaxJSONSize bytes
+		case schemapb.DataType_SparseFloatVector:
+			maxNnz, err := GetMaxNnz(fs)
+			if err != nil {
+				return -1, err
+			}
+			// the length and at most maxNnz (uint32 index, float32 value) pairs
+			res += 4 + maxNnz*8
 		case schemapb.DataType_BinaryVector:
 			for _, kv := range fs.TypeParams {
 				if kv.Key == "dim" {
@@ -59,11 +78,38 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 					break
 				}
 			}
+		case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			for _, kv := range fs.TypeParams {
+				if kv.Key == "dim" {
+					v, err := strconv.Atoi(kv.Value)
+					if err != nil {
+						return -1, err
+					}
+					res += v * 2
+					break
+				}
+			}
 		}
 	}
 	return res, nil
 }
 
+// estimateArraySize returns the estimate size of an Array value of max_capacity elements in a row
+func estimateArraySize(field *schemapb.FieldSchema) (int, error) {
+	maxCapacity, err := GetMaxCapacity(field)
+	if err != nil {
+		return 0, err
+	}
+	elementSize := 4 + 125 // todo find a better way to estimate string type
+	if field.GetElementType() != schemapb.DataType_String {
+		elementSize, err = arrayElementSize(field)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return 4 + 4 + maxCapacity*elementSize, nil
+}
+
 // SchemaHelper provides methods to get the schema of fields
 type SchemaHelper struct {
 	schema           *schemapb.CollectionSchema
@@ -144,10 +190,491 @@ func (helper *SchemaHelper) GetVectorDimFromID(fieldID int64) (int, error) {
 	return 0, fmt.Errorf("fieldID(%d) not has dim", fieldID)
 }
 
+// GetMaxLength returns the max_length type param of the VarChar field
+func GetMaxLength(field *schemapb.FieldSchema) (int, error) {
+	for _, kv := range field.TypeParams {
+		if kv.Key == common.MaxLengthKey {
+			maxLength, err := strconv.Atoi(kv.Value)
+			if err != nil {
+				return 0, err
+			}
+			return maxLength, nil
+		}
+	}
+	return 0, fmt.Errorf("field %s has no %s", field.Name, common.MaxLengthKey)
+}
+
+// GetMaxCapacity returns the max_capacity type param of the Array field
+func GetMaxCapacity(field *schemapb.FieldSchema) (int, error) {
+	for _, kv := range field.TypeParams {
+		if kv.Key == common.MaxCapacityKey {
+			maxCapacity, err := strconv.Atoi(kv.Value)
+			if err != nil {
+				return 0, err
+			}
+			return maxCapacity, nil
+		}
+	}
+	return 0, fmt.Errorf("field %s has no %s", field.Name, common.MaxCapacityKey)
+}
+
+// GetMaxNnz returns the max_nnz type param of the sparse float vector field
+func GetMaxNnz(field *schemapb.FieldSchema) (int, error) {
+	for _, kv := range field.TypeParams {
+		if kv.Key == common.MaxNnzKey {
+			maxNnz, err := strconv.Atoi(kv.Value)
+			if err != nil {
+				return 0, err
+			}
+			return maxNnz, nil
+		}
+	}
+	return 0, fmt.Errorf("field %s has no %s", field.Name, common.MaxNnzKey)
+}
+
+// IsArrayElementType returns true if the data type can be the element type of an Array field
+func IsArrayElementType(dataType schemapb.DataType) bool {
+	return IsBoolType(dataType) || IsIntegerType(dataType) || IsFloatingType(dataType) || dataType == schemapb.DataType_String
+}
+
+// arrayElementSize returns the size of a fixed-width element of the Array field, VarChar elements take variable
+// lengths and have no fixed size
+func arrayElementSize(field *schemapb.FieldSchema) (int, error) {
+	switch field.GetElementType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8:
+		return 1, nil
+	case schemapb.DataType_Int16:
+		return 2, nil
+	case schemapb.DataType_Int32, schemapb.DataType_Float:
+		return 4, nil
+	case schemapb.DataType_Int64, schemapb.DataType_Double:
+		return 8, nil
+	default:
+		return 0, fmt.Errorf("invalid element type %s of array field %s", field.GetElementType().String(), field.Name)
+	}
+}
+
+// GetArrayLength returns the number of elements of the array
+func GetArrayLength(array *schemapb.ScalarField) int {
+	switch data := array.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return len(data.BoolData.GetData())
+	case *schemapb.ScalarField_IntData:
+		return len(data.IntData.GetData())
+	case *schemapb.ScalarField_LongData:
+		return len(data.LongData.GetData())
+	case *schemapb.ScalarField_FloatData:
+		return len(data.FloatData.GetData())
+	case *schemapb.ScalarField_DoubleData:
+		return len(data.DoubleData.GetData())
+	case *schemapb.ScalarField_StringData:
+		return len(data.StringData.GetData())
+	default:
+		return 0
+	}
+}
+
+// EncodeArray encodes the Array value in a row, the bytes of the value are the uint32 number of elements followed by
+// the elements, and a VarChar element is the uint32 length followed by the bytes. The elements must be of the element
+// type of the field and no more than max_capacity.
+func EncodeArray(field *schemapb.FieldSchema, array *schemapb.ScalarField) ([]byte, error) {
+	maxCapacity, err := GetMaxCapacity(field)
+	if err != nil {
+		return nil, err
+	}
+	length := GetArrayLength(array)
+	if length > maxCapacity {
+		return nil, fmt.Errorf("length of array %d exceeds max capacity %d of field %s", length, maxCapacity, field.Name)
+	}
+
+	var elements []byte
+	mismatch := fmt.Errorf("elements of array mismatch with the element type %s of field %s", field.GetElementType().String(), field.Name)
+	switch field.GetElementType() {
+	case schemapb.DataType_Bool:
+		data := array.GetBoolData()
+		if data == nil && length > 0 {
+			return nil, mismatch
+		}
+		elements = make([]byte, length)
+		for i, v := range data.GetData() {
+			if v {
+				elements[i] = 1
+			}
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := array.GetIntData()
+		if data == nil && length > 0 {
+			return nil, mismatch
+		}
+		elementSize, err := arrayElementSize(field)
+		if err != nil {
+			return nil, err
+		}
+		elements = make([]byte, length*elementSize)
+		for i, v := range data.GetData() {
+			switch elementSize {
+			case 1:
+				elements[i] = byte(int8(v))
+			case 2:
+				common.Endian.PutUint16(elements[i*2:], uint16(int16(v)))
+			default:
+				common.Endian.PutUint32(elements[i*4:], uint32(v))
+			}
+		}
+	case schemapb.DataType_Int64:
+		data := array.GetLongData()
+		if data == nil && length > 0 {
+			return nil, mismatch
+		}
+		elements = make([]byte, length*8)
+		for i, v := range data.GetData() {
+			common.Endian.PutUint64(elements[i*8:], uint64(v))
+		}
+	case schemapb.DataType_Float:
+		data := array.GetFloatData()
+		if data == nil && length > 0 {
+			return nil, mismatch
+		}
+		elements = make([]byte, length*4)
+		for i, v := range data.GetData() {
+			common.Endian.PutUint32(elements[i*4:], math.Float32bits(v))
+		}
+	case schemapb.DataType_Double:
+		data := array.GetDoubleData()
+		if data == nil && length > 0 {
+			return nil, mismatch
+		}
+		elements = make([]byte, length*8)
+		for i, v := range data.GetData() {
+			common.Endian.PutUint64(elements[i*8:], math.Float64bits(v))
+		}
+	case schemapb.DataType_String:
+		data := array.GetStringData()
+		if data == nil && length > 0 {
+			return nil, mismatch
+		}
+		maxLength, err := GetMaxLength(field)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range data.GetData() {
+			element, err := EncodeVarChar(v, maxLength)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, element...)
+		}
+	default:
+		return nil, fmt.Errorf("invalid element type %s of array field %s", field.GetElementType().String(), field.Name)
+	}
+
+	value := make([]byte, 4, 4+len(elements))
+	common.Endian.PutUint32(value, uint32(length))
+	return EncodeVariableLengthValue(append(value, elements...)), nil
+}
+
+// DecodeArray decodes the array from the bytes of the Array value, which are the uint32 number of elements followed
+// by the elements
+func DecodeArray(field *schemapb.FieldSchema, value []byte) (*schemapb.ScalarField, error) {
+	if len(value) < 4 {
+		return nil, fmt.Errorf("Failed to convert []byte to array: invalid size %d", len(value))
+	}
+	length := int(common.Endian.Uint32(value))
+	elements := value[4:]
+	elementSize := 0 // VarChar elements take variable lengths
+	if field.GetElementType() != schemapb.DataType_String {
+		var err error
+		elementSize, err = arrayElementSize(field)
+		if err != nil {
+			return nil, err
+		}
+		if length*elementSize != len(elements) {
+			return nil, fmt.Errorf("Failed to convert []byte to array: %d elements mismatch with size %d", length, len(elements))
+		}
+	}
+
+	array := &schemapb.ScalarField{}
+	switch field.GetElementType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, length)
+		for i := range data {
+			data[i] = elements[i] != 0
+		}
+		array.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := make([]int32, length)
+		for i := range data {
+			switch elementSize {
+			case 1:
+				data[i] = int32(int8(elements[i]))
+			case 2:
+				data[i] = int32(int16(common.Endian.Uint16(elements[i*2:])))
+			default:
+				data[i] = int32(common.Endian.Uint32(elements[i*4:]))
+			}
+		}
+		array.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, length)
+		for i := range data {
+			data[i] = int64(common.Endian.Uint64(elements[i*8:]))
+		}
+		array.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_Float:
+		data := make([]float32, length)
+		for i := range data {
+			data[i] = math.Float32frombits(common.Endian.Uint32(elements[i*4:]))
+		}
+		array.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case schemapb.DataType_Double:
+		data := make([]float64, length)
+		for i := range data {
+			data[i] = math.Float64frombits(common.Endian.Uint64(elements[i*8:]))
+		}
+		array.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case schemapb.DataType_String:
+		data := make([]string, length)
+		offset := 0
+		for i := range data {
+			element, size, err := DecodeVariableLengthValue(elements[offset:])
+			if err != nil {
+				return nil, err
+			}
+			data[i] = string(element)
+			offset += size
+		}
+		if offset != len(elements) {
+			return nil, fmt.Errorf("Failed to convert []byte to array: %d elements mismatch with size %d", length, len(elements))
+		}
+		array.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	}
+	return array, nil
+}
+
+// encodeDefaultValueInRow returns the bytes of the user field in a row taking its default value, or null if the field
+// has no default value
+func encodeDefaultValueInRow(field *schemapb.FieldSchema) ([]byte, error) {
+	var buf []byte
+	defaultValue := field.GetDefaultValue()
+	if field.GetNullable() {
+		// the validity byte
+		if defaultValue != nil {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+	}
+
+	var value []byte
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		value = make([]byte, 1)
+		if defaultValue.GetBoolData() {
+			value[0] = 1
+		}
+	case schemapb.DataType_Int8:
+		value = []byte{byte(int8(defaultValue.GetIntData()))}
+	case schemapb.DataType_Int16:
+		value = make([]byte, 2)
+		common.Endian.PutUint16(value, uint16(int16(defaultValue.GetIntData())))
+	case schemapb.DataType_Int32:
+		value = make([]byte, 4)
+		common.Endian.PutUint32(value, uint32(defaultValue.GetIntData()))
+	case schemapb.DataType_Int64:
+		value = make([]byte, 8)
+		common.Endian.PutUint64(value, uint64(defaultValue.GetLongData()))
+	case schemapb.DataType_Float:
+		value = make([]byte, 4)
+		common.Endian.PutUint32(value, math.Float32bits(defaultValue.GetFloatData()))
+	case schemapb.DataType_Double:
+		value = make([]byte, 8)
+		common.Endian.PutUint64(value, math.Float64bits(defaultValue.GetDoubleData()))
+	case schemapb.DataType_String:
+		maxLength, err := GetMaxLength(field)
+		if err != nil {
+			return nil, err
+		}
+		value, err = EncodeVarChar(defaultValue.GetStringData(), maxLength)
+		if err != nil {
+			return nil, err
+		}
+	case schemapb.DataType_Array:
+		// Array fields have no default value, a null array is an empty array
+		var err error
+		value, err = EncodeArray(field, &schemapb.ScalarField{})
+		if err != nil {
+			return nil, err
+		}
+	case schemapb.DataType_JSON:
+		// JSON fields have no default value, a null document has no bytes
+		value = EncodeVariableLengthValue(nil)
+	default:
+		return nil, fmt.Errorf("field %s of data type %s has no default value", field.Name, field.DataType.String())
+	}
+	return append(buf, value...), nil
+}
+
+// IsVariableLengthType returns true if the values of the data type take variable lengths in a row, such a value is
+// the uint32 length followed by the bytes of the value
+func IsVariableLengthType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_String, schemapb.DataType_Array, schemapb.DataType_JSON, schemapb.DataType_SparseFloatVector:
+		return true
+	default:
+		return false
+	}
+}
+
+// fixedValueSize returns the size of the value of a fixed-width field in a row
+func fixedValueSize(field *schemapb.FieldSchema) (int, error) {
+	switch field.DataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8:
+		return 1, nil
+	case schemapb.DataType_Int16:
+		return 2, nil
+	case schemapb.DataType_Int32, schemapb.DataType_Float:
+		return 4, nil
+	case schemapb.DataType_Int64, schemapb.DataType_Double:
+		return 8, nil
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector,
+		schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		for _, kv := range field.TypeParams {
+			if kv.Key == "dim" {
+				dim, err := strconv.Atoi(kv.Value)
+				if err != nil {
+					return 0, err
+				}
+				switch field.DataType {
+				case schemapb.DataType_FloatVector:
+					return dim * 4, nil
+				case schemapb.DataType_BinaryVector:
+					return dim / 8, nil
+				default:
+					return dim * 2, nil
+				}
+			}
+		}
+		return 0, fmt.Errorf("dim not found in type params of field %s", field.Name)
+	default:
+		return 0, fmt.Errorf("invalid data type %s of field %s", field.DataType.String(), field.Name)
+	}
+}
+
+// SplitRow splits the row into the values of the fields, the value of a nullable field keeps its validity byte. The
+// row may end before the values of the trailing fields, which are then missing from the result, and the bytes after
+// the values of all the fields are ignored.
+func SplitRow(fields []*schemapb.FieldSchema, row []byte) ([][]byte, error) {
+	values := make([][]byte, 0, len(fields))
+	offset := 0
+	for _, field := range fields {
+		if offset == len(row) {
+			break
+		}
+		begin := offset
+		if field.GetNullable() {
+			// the validity byte
+			offset++
+		}
+		if IsVariableLengthType(field.DataType) {
+			if offset > len(row) {
+				return nil, fmt.Errorf("row of size %d is truncated in the value of field %s", len(row), field.Name)
+			}
+			_, size, err := DecodeVariableLengthValue(row[offset:])
+			if err != nil {
+				return nil, fmt.Errorf("row of size %d is truncated in the value of field %s: %w", len(row), field.Name, err)
+			}
+			offset += size
+		} else {
+			size, err := fixedValueSize(field)
+			if err != nil {
+				return nil, err
+			}
+			offset += size
+		}
+		if offset > len(row) {
+			return nil, fmt.Errorf("row of size %d is truncated in the value of field %s", len(row), field.Name)
+		}
+		values = append(values, row[begin:offset])
+	}
+	return values, nil
+}
+
+// AlignRowsToSchema fills the values of the fields added to the collection after the rows were written, the fields
+// are appended to the schema so their values are missing at the end of the rows, and take the default values or
+// null. The values of the fields unknown to the schema are cut off from the rows.
+func AlignRowsToSchema(schema *schemapb.CollectionSchema, rows []*commonpb.Blob) error {
+	var userFields []*schemapb.FieldSchema
+	for _, field := range schema.Fields {
+		if field.FieldID >= common.StartOfUserFieldID {
+			userFields = append(userFields, field)
+		}
+	}
+	defaults := make([][]byte, len(userFields)) // the bytes of the default values of the user fields
+
+	for _, row := range rows {
+		values, err := SplitRow(userFields, row.Value)
+		if err != nil {
+			return fmt.Errorf("row mismatches with the schema of collection %s: %w", schema.Name, err)
+		}
+		size := 0
+		for _, value := range values {
+			size += len(value)
+		}
+		if len(values) == len(userFields) {
+			row.Value = row.Value[:size]
+			continue
+		}
+		value := make([]byte, size)
+		copy(value, row.Value)
+		for j := len(values); j < len(userFields); j++ {
+			if defaults[j] == nil {
+				defaults[j], err = encodeDefaultValueInRow(userFields[j])
+				if err != nil {
+					return err
+				}
+			}
+			value = append(value, defaults[j]...)
+		}
+		row.Value = value
+	}
+	return nil
+}
+
+// IsPrimaryFieldType returns true if the data type can be used as the primary key, which is int64 or varchar
+func IsPrimaryFieldType(dataType schemapb.DataType) bool {
+	return dataType == schemapb.DataType_Int64 || dataType == schemapb.DataType_String
+}
+
+// IsPartitionKeyType returns true if the data type can be used as the partition key, which is int64 or varchar
+func IsPartitionKeyType(dataType schemapb.DataType) bool {
+	return dataType == schemapb.DataType_Int64 || dataType == schemapb.DataType_String
+}
+
+// GetPartitionKeyField returns the partition key field of the schema, or nil if the schema has no partition key
+func GetPartitionKeyField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if field.GetIsPartitionKey() {
+			return field
+		}
+	}
+	return nil
+}
+
+// PartitionKeyPartitionNames returns the names of the partitions the rows of a collection with a partition key
+// are routed to, the idx-th partition is named defaultName_idx
+func PartitionKeyPartitionNames(defaultName string, numPartitions int64) []string {
+	names := make([]string, 0, numPartitions)
+	for i := int64(0); i < numPartitions; i++ {
+		names = append(names, fmt.Sprintf("%s_%d", defaultName, i))
+	}
+	return names
+}
+
 // IsVectorType returns true if input is a vector type, otherwise false
 func IsVectorType(dataType schemapb.DataType) bool {
 	switch dataType {
-	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, schemapb.DataType_SparseFloatVector,
+		schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
 		return true
 	default:
 		return false
@@ -199,6 +726,9 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 					},
 				}
 			}
+			if len(fieldData.ValidData) > 0 {
+				dst[i].ValidData = append(dst[i].ValidData, fieldData.ValidData[idx])
+			}
 			dstScalar := dst[i].GetScalars()
 			switch srcScalar := fieldType.Scalars.Data.(type) {
 			case *schemapb.ScalarField_BoolData:
@@ -251,6 +781,37 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstScalar.GetDoubleData().Data = append(dstScalar.GetDoubleData().Data, srcScalar.DoubleData.Data[idx])
 				}
+			case *schemapb.ScalarField_StringData:
+				if dstScalar.GetStringData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: []string{srcScalar.StringData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
+				}
+			case *schemapb.ScalarField_ArrayData:
+				if dstScalar.GetArrayData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_ArrayData{
+						ArrayData: &schemapb.ArrayArray{
+							Data:        []*schemapb.ScalarField{srcScalar.ArrayData.Data[idx]},
+							ElementType: srcScalar.ArrayData.ElementType,
+						},
+					}
+				} else {
+					dstScalar.GetArrayData().Data = append(dstScalar.GetArrayData().Data, srcScalar.ArrayData.Data[idx])
+				}
+			case *schemapb.ScalarField_JsonData:
+				if dstScalar.GetJsonData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_JsonData{
+						JsonData: &schemapb.JSONArray{
+							Data: [][]byte{srcScalar.JsonData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetJsonData().Data = append(dstScalar.GetJsonData().Data, srcScalar.JsonData.Data[idx])
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
@@ -288,9 +849,271 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstVector.GetFloatVector().Data = append(dstVector.GetFloatVector().Data, srcVector.FloatVector.Data[idx*dim:(idx+1)*dim]...)
 				}
+			case *schemapb.VectorField_Float16Vector:
+				if dstVector.GetFloat16Vector() == nil {
+					dstVector.Data = &schemapb.VectorField_Float16Vector{
+						Float16Vector: srcVector.Float16Vector[idx*(dim*2) : (idx+1)*(dim*2)],
+					}
+				} else {
+					dstFloat16Vector := dstVector.Data.(*schemapb.VectorField_Float16Vector)
+					dstFloat16Vector.Float16Vector = append(dstFloat16Vector.Float16Vector, srcVector.Float16Vector[idx*(dim*2):(idx+1)*(dim*2)]...)
+				}
+			case *schemapb.VectorField_Bfloat16Vector:
+				if dstVector.GetBfloat16Vector() == nil {
+					dstVector.Data = &schemapb.VectorField_Bfloat16Vector{
+						Bfloat16Vector: srcVector.Bfloat16Vector[idx*(dim*2) : (idx+1)*(dim*2)],
+					}
+				} else {
+					dstBFloat16Vector := dstVector.Data.(*schemapb.VectorField_Bfloat16Vector)
+					dstBFloat16Vector.Bfloat16Vector = append(dstBFloat16Vector.Bfloat16Vector, srcVector.Bfloat16Vector[idx*(dim*2):(idx+1)*(dim*2)]...)
+				}
+			case *schemapb.VectorField_SparseFloatVector:
+				row := srcVector.SparseFloatVector.Contents[idx]
+				if dstVector.GetSparseFloatVector() == nil {
+					dstVector.Data = &schemapb.VectorField_SparseFloatVector{
+						SparseFloatVector: &schemapb.SparseFloatArray{
+							Contents: [][]byte{row},
+						},
+					}
+				} else {
+					dstVector.GetSparseFloatVector().Contents = append(dstVector.GetSparseFloatVector().Contents, row)
+				}
+				if rowDim := SparseFloatRowDim(row); rowDim > dstVector.GetSparseFloatVector().Dim {
+					dstVector.GetSparseFloatVector().Dim = rowDim
+					dstVector.Dim = rowDim
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
 		}
 	}
 }
+
+// SelectFieldData returns the fields data of the rows at the specified indexes of src
+func SelectFieldData(src []*schemapb.FieldData, rows []int64) []*schemapb.FieldData {
+	dst := make([]*schemapb.FieldData, len(src))
+	for _, idx := range rows {
+		AppendFieldData(dst, src, idx)
+	}
+	return dst
+}
+
+// SortRows returns the row indexes sorted by the values of the orderBy field. Rows with the same value are
+// sorted by their primary keys in ascending order, rows are sorted by primary keys only if orderBy is nil.
+func SortRows(pks *schemapb.IDs, orderBy *schemapb.FieldData, descending bool) ([]int64, error) {
+	compare := func(i, j int64) int { return 0 }
+	if orderBy != nil {
+		cmp, err := fieldDataComparator(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		compare = cmp
+		if descending {
+			compare = func(i, j int64) int { return cmp(j, i) }
+		}
+	}
+
+	rows := make([]int64, GetSizeOfIDs(pks))
+	for i := range rows {
+		rows[i] = int64(i)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if c := compare(rows[i], rows[j]); c != 0 {
+			return c < 0
+		}
+		return LessPK(GetPK(pks, rows[i]), GetPK(pks, rows[j]))
+	})
+	return rows, nil
+}
+
+// fieldDataComparator returns a function comparing the values of two rows of a scalar field, null values are
+// greater than all the other values
+func fieldDataComparator(fieldData *schemapb.FieldData) (func(i, j int64) int, error) {
+	cmp, err := valueComparator(fieldData)
+	if err != nil || len(fieldData.ValidData) == 0 {
+		return cmp, err
+	}
+	validData := fieldData.ValidData
+	return func(i, j int64) int {
+		switch {
+		case validData[i] && validData[j]:
+			return cmp(i, j)
+		case validData[i]:
+			return -1
+		case validData[j]:
+			return 1
+		default:
+			return 0
+		}
+	}, nil
+}
+
+func valueComparator(fieldData *schemapb.FieldData) (func(i, j int64) int, error) {
+	scalars := fieldData.GetScalars()
+	if scalars == nil {
+		return nil, fmt.Errorf("can not order by non-scalar field %s", fieldData.FieldName)
+	}
+	switch data := scalars.Data.(type) {
+	case *schemapb.ScalarField_BoolData:
+		values := data.BoolData.Data
+		return func(i, j int64) int {
+			switch {
+			case values[i] == values[j]:
+				return 0
+			case !values[i]:
+				return -1
+			default:
+				return 1
+			}
+		}, nil
+	case *schemapb.ScalarField_IntData:
+		values := data.IntData.Data
+		return func(i, j int64) int { return compareOrdered(values[i] < values[j], values[i] > values[j]) }, nil
+	case *schemapb.ScalarField_LongData:
+		values := data.LongData.Data
+		return func(i, j int64) int { return compareOrdered(values[i] < values[j], values[i] > values[j]) }, nil
+	case *schemapb.ScalarField_FloatData:
+		values := data.FloatData.Data
+		return func(i, j int64) int { return compareOrdered(values[i] < values[j], values[i] > values[j]) }, nil
+	case *schemapb.ScalarField_DoubleData:
+		values := data.DoubleData.Data
+		return func(i, j int64) int { return compareOrdered(values[i] < values[j], values[i] > values[j]) }, nil
+	case *schemapb.ScalarField_StringData:
+		values := data.StringData.Data
+		return func(i, j int64) int { return compareOrdered(values[i] < values[j], values[i] > values[j]) }, nil
+	default:
+		return nil, fmt.Errorf("can not order by field %s of type %s", fieldData.FieldName, fieldData.Type.String())
+	}
+}
+
+func compareOrdered(less, greater bool) int {
+	if less {
+		return -1
+	}
+	if greater {
+		return 1
+	}
+	return 0
+}
+
+// GetScalarFieldValue returns the value of the row at idx of a scalar field, it returns nil for null values and
+// the other fields
+func GetScalarFieldValue(fieldData *schemapb.FieldData, idx int64) interface{} {
+	if !IsValidRow(fieldData, idx) {
+		return nil
+	}
+	switch data := fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return data.BoolData.Data[idx]
+	case *schemapb.ScalarField_IntData:
+		return data.IntData.Data[idx]
+	case *schemapb.ScalarField_LongData:
+		return data.LongData.Data[idx]
+	case *schemapb.ScalarField_FloatData:
+		return data.FloatData.Data[idx]
+	case *schemapb.ScalarField_DoubleData:
+		return data.DoubleData.Data[idx]
+	case *schemapb.ScalarField_StringData:
+		return data.StringData.Data[idx]
+	default:
+		return nil
+	}
+}
+
+// IsValidRow returns false if the value of the row at idx of the field is null
+func IsValidRow(fieldData *schemapb.FieldData, idx int64) bool {
+	validData := fieldData.GetValidData()
+	return len(validData) == 0 || validData[idx]
+}
+
+// GetSizeOfIDs returns the number of primary keys in ids
+func GetSizeOfIDs(ids *schemapb.IDs) int {
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return len(ids.GetIntId().GetData())
+	case *schemapb.IDs_StrId:
+		return len(ids.GetStrId().GetData())
+	default:
+		return 0
+	}
+}
+
+// GetPK returns the primary key at idx of ids, which is an int64 or a string
+func GetPK(ids *schemapb.IDs, idx int64) interface{} {
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return ids.GetIntId().GetData()[idx]
+	case *schemapb.IDs_StrId:
+		return ids.GetStrId().GetData()[idx]
+	default:
+		return nil
+	}
+}
+
+// IsValidPK returns false for the placeholders of the missing hits in search results, which are -1 for the int64
+// primary keys and empty strings for the varchar ones
+func IsValidPK(pk interface{}) bool {
+	switch v := pk.(type) {
+	case int64:
+		return v != -1
+	case string:
+		return v != ""
+	default:
+		return false
+	}
+}
+
+// LessPK returns true if the primary key a is less than b, both of them are int64 or string
+func LessPK(a, b interface{}) bool {
+	switch v := a.(type) {
+	case int64:
+		return v < b.(int64)
+	case string:
+		return v < b.(string)
+	default:
+		return false
+	}
+}
+
+// AppendPKs appends the primary key to ids, the id field of ids is created by the type of the key if it is not set
+func AppendPKs(ids *schemapb.IDs, pk interface{}) {
+	switch v := pk.(type) {
+	case int64:
+		if ids.GetIntId() == nil {
+			ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}
+		}
+		ids.GetIntId().Data = append(ids.GetIntId().Data, v)
+	case string:
+		if ids.GetStrId() == nil {
+			ids.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{}}
+		}
+		ids.GetStrId().Data = append(ids.GetStrId().Data, v)
+	}
+}
+
+// GetPKsFromFieldData returns the primary keys in the field data of the primary field
+func GetPKsFromFieldData(fieldData *schemapb.FieldData) (*schemapb.IDs, error) {
+	switch data := fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_LongData:
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: data.LongData.GetData()}}}, nil
+	case *schemapb.ScalarField_StringData:
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: data.StringData.GetData()}}}, nil
+	default:
+		return nil, fmt.Errorf("the data type of primary field %s should be int64 or varchar", fieldData.FieldName)
+	}
+}
+
+// HashPKs hashes the primary keys, the int64 keys are hashed by their little endian bytes and the string keys by
+// their own bytes
+func HashPKs(ids *schemapb.IDs) []uint32 {
+	hashValues := make([]uint32, 0, GetSizeOfIDs(ids))
+	for _, pk := range ids.GetIntId().GetData() {
+		hash, _ := Hash32Int64(pk)
+		hashValues = append(hashValues, hash)
+	}
+	for _, pk := range ids.GetStrId().GetData() {
+		hash, _ := Hash32Bytes([]byte(pk))
+		hashValues = append(hashValues, hash)
+	}
+	return hashValues
+}
@@ -159,6 +159,8 @@ func TestSchema(t *testing.T) {
 		assert.False(t, IsVectorType(schemapb.DataType_String))
 		assert.True(t, IsVectorType(schemapb.DataType_BinaryVector))
 		assert.True(t, IsVectorType(schemapb.DataType_FloatVector))
+		assert.True(t, IsVectorType(schemapb.DataType_Float16Vector))
+		assert.True(t, IsVectorType(schemapb.DataType_BFloat16Vector))
 
 		assert.False(t, IsIntegerType(schemapb.DataType_Bool))
 		assert.True(t, IsIntegerType(schemapb.DataType_Int8))
@@ -437,6 +439,49 @@ func genFieldData(fieldName string, fieldID int64, fieldType schemapb.DataType,
 			},
 			FieldId: fieldID,
 		}
+	case schemapb.DataType_Float16Vector:
+		fieldData = &schemapb.FieldData{
+			Type:      schemapb.DataType_Float16Vector,
+			FieldName: fieldName,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: dim,
+					Data: &schemapb.VectorField_Float16Vector{
+						Float16Vector: fieldValue.([]byte),
+					},
+				},
+			},
+			FieldId: fieldID,
+		}
+	case schemapb.DataType_BFloat16Vector:
+		fieldData = &schemapb.FieldData{
+			Type:      schemapb.DataType_BFloat16Vector,
+			FieldName: fieldName,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: dim,
+					Data: &schemapb.VectorField_Bfloat16Vector{
+						Bfloat16Vector: fieldValue.([]byte),
+					},
+				},
+			},
+			FieldId: fieldID,
+		}
+	case schemapb.DataType_String:
+		fieldData = &schemapb.FieldData{
+			Type:      schemapb.DataType_String,
+			FieldName: fieldName,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: fieldValue.([]string),
+						},
+					},
+				},
+			},
+			FieldId: fieldID,
+		}
 	default:
 		log.Error("not supported field type", zap.String("field type", fieldType.String()))
 	}
@@ -446,21 +491,25 @@ func genFieldData(fieldName string, fieldID int64, fieldType schemapb.DataType,
 
 func TestAppendFieldData(t *testing.T) {
 	const (
-		Dim                   = 8
-		BoolFieldName         = "BoolField"
-		Int32FieldName        = "Int32Field"
-		Int64FieldName        = "Int64Field"
-		FloatFieldName        = "FloatField"
-		DoubleFieldName       = "DoubleField"
-		BinaryVectorFieldName = "BinaryVectorField"
-		FloatVectorFieldName  = "FloatVectorField"
-		BoolFieldID           = common.StartOfUserFieldID + 1
-		Int32FieldID          = common.StartOfUserFieldID + 2
-		Int64FieldID          = common.StartOfUserFieldID + 3
-		FloatFieldID          = common.StartOfUserFieldID + 4
-		DoubleFieldID         = common.StartOfUserFieldID + 5
-		BinaryVectorFieldID   = common.StartOfUserFieldID + 6
-		FloatVectorFieldID    = common.StartOfUserFieldID + 7
+		Dim                     = 8
+		BoolFieldName           = "BoolField"
+		Int32FieldName          = "Int32Field"
+		Int64FieldName          = "Int64Field"
+		FloatFieldName          = "FloatField"
+		DoubleFieldName         = "DoubleField"
+		BinaryVectorFieldName   = "BinaryVectorField"
+		FloatVectorFieldName    = "FloatVectorField"
+		Float16VectorFieldName  = "Float16VectorField"
+		BFloat16VectorFieldName = "BFloat16VectorField"
+		BoolFieldID             = common.StartOfUserFieldID + 1
+		Int32FieldID            = common.StartOfUserFieldID + 2
+		Int64FieldID            = common.StartOfUserFieldID + 3
+		FloatFieldID            = common.StartOfUserFieldID + 4
+		DoubleFieldID           = common.StartOfUserFieldID + 5
+		BinaryVectorFieldID     = common.StartOfUserFieldID + 6
+		FloatVectorFieldID      = common.StartOfUserFieldID + 7
+		Float16VectorFieldID    = common.StartOfUserFieldID + 8
+		BFloat16VectorFieldID   = common.StartOfUserFieldID + 9
 	)
 	BoolArray := []bool{true, false}
 	Int32Array := []int32{1, 2}
@@ -469,8 +518,14 @@ func TestAppendFieldData(t *testing.T) {
 	DoubleArray := []float64{11.0, 22.0}
 	BinaryVector := []byte{0x12, 0x34}
 	FloatVector := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 11.0, 22.0, 33.0, 44.0, 55.0, 66.0, 77.0, 88.0}
+	Float16Vector := make([]byte, 0, 2*Dim*2)
+	BFloat16Vector := make([]byte, 0, 2*Dim*2)
+	for _, f := range FloatVector {
+		Float16Vector = append(Float16Vector, Float32ToFloat16(f)...)
+		BFloat16Vector = append(BFloat16Vector, Float32ToBFloat16(f)...)
+	}
 
-	result := make([]*schemapb.FieldData, 7)
+	result := make([]*schemapb.FieldData, 9)
 	var fieldDataArray1 []*schemapb.FieldData
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(Int32FieldName, Int32FieldID, schemapb.DataType_Int32, Int32Array[0:1], 1))
@@ -479,6 +534,8 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[0:Dim/8], Dim))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[0:Dim], Dim))
+	fieldDataArray1 = append(fieldDataArray1, genFieldData(Float16VectorFieldName, Float16VectorFieldID, schemapb.DataType_Float16Vector, Float16Vector[0:Dim*2], Dim))
+	fieldDataArray1 = append(fieldDataArray1, genFieldData(BFloat16VectorFieldName, BFloat16VectorFieldID, schemapb.DataType_BFloat16Vector, BFloat16Vector[0:Dim*2], Dim))
 
 	var fieldDataArray2 []*schemapb.FieldData
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[1:2], 1))
@@ -488,6 +545,8 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[1:2], 1))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[Dim/8:2*Dim/8], Dim))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[Dim:2*Dim], Dim))
+	fieldDataArray2 = append(fieldDataArray2, genFieldData(Float16VectorFieldName, Float16VectorFieldID, schemapb.DataType_Float16Vector, Float16Vector[Dim*2:2*Dim*2], Dim))
+	fieldDataArray2 = append(fieldDataArray2, genFieldData(BFloat16VectorFieldName, BFloat16VectorFieldID, schemapb.DataType_BFloat16Vector, BFloat16Vector[Dim*2:2*Dim*2], Dim))
 
 	AppendFieldData(result, fieldDataArray1, 0)
 	AppendFieldData(result, fieldDataArray2, 0)
@@ -499,4 +558,365 @@ func TestAppendFieldData(t *testing.T) {
 	assert.Equal(t, DoubleArray, result[4].GetScalars().GetDoubleData().Data)
 	assert.Equal(t, BinaryVector, result[5].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector)
 	assert.Equal(t, FloatVector, result[6].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, Float16Vector, result[7].GetVectors().GetFloat16Vector())
+	assert.Equal(t, BFloat16Vector, result[8].GetVectors().GetBfloat16Vector())
+}
+
+func TestSortRows(t *testing.T) {
+	pks := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{5, 4, 3, 2, 1}}}}
+
+	rows, err := SortRows(pks, nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 3, 2, 1, 0}, rows)
+
+	longs := genFieldData("long", 100, schemapb.DataType_Int64, []int64{1, 3, 2, 3, 1}, 1)
+	rows, err = SortRows(pks, longs, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 0, 2, 3, 1}, rows)
+	rows, err = SortRows(pks, longs, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3, 1, 2, 4, 0}, rows)
+
+	doubles := genFieldData("double", 101, schemapb.DataType_Double, []float64{0.5, 0.1, 0.4, 0.2, 0.3}, 1)
+	rows, err = SortRows(pks, doubles, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 3, 4, 2, 0}, rows)
+
+	bools := genFieldData("bool", 102, schemapb.DataType_Bool, []bool{true, false, true, false, true}, 1)
+	rows, err = SortRows(pks, bools, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3, 1, 4, 2, 0}, rows)
+
+	vectors := genFieldData("vector", 103, schemapb.DataType_FloatVector, make([]float32, 5*8), 8)
+	_, err = SortRows(pks, vectors, false)
+	assert.NotNil(t, err)
+
+	strPks := &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"e", "d", "c", "b", "a"}}}}
+	rows, err = SortRows(strPks, nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 3, 2, 1, 0}, rows)
+	rows, err = SortRows(strPks, longs, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 0, 2, 3, 1}, rows)
+
+	// null values are sorted after the other values
+	nullable := genFieldData("nullable", 104, schemapb.DataType_Int64, []int64{0, 3, 2, 0, 1}, 1)
+	nullable.ValidData = []bool{false, true, true, false, true}
+	rows, err = SortRows(pks, nullable, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 2, 1, 3, 0}, rows)
+	rows, err = SortRows(pks, nullable, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3, 0, 1, 2, 4}, rows)
+}
+
+func TestSelectFieldData(t *testing.T) {
+	src := []*schemapb.FieldData{
+		genFieldData("long", 100, schemapb.DataType_Int64, []int64{10, 20, 30}, 1),
+		genFieldData("vector", 101, schemapb.DataType_FloatVector, []float32{1, 1, 2, 2, 3, 3}, 2),
+	}
+	dst := SelectFieldData(src, []int64{2, 0})
+	assert.Equal(t, []int64{30, 10}, dst[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []float32{3, 3, 1, 1}, dst[1].GetVectors().GetFloatVector().Data)
+
+	strs := []*schemapb.FieldData{genFieldData("varchar", 102, schemapb.DataType_String, []string{"a", "b", "c"}, 1)}
+	dst = SelectFieldData(strs, []int64{1, 2})
+	assert.Equal(t, []string{"b", "c"}, dst[0].GetScalars().GetStringData().Data)
+
+	nullable := genFieldData("nullable", 103, schemapb.DataType_Int64, []int64{10, 0, 30}, 1)
+	nullable.ValidData = []bool{true, false, true}
+	dst = SelectFieldData([]*schemapb.FieldData{nullable}, []int64{1, 2})
+	assert.Equal(t, []int64{0, 30}, dst[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []bool{false, true}, dst[0].ValidData)
+}
+
+func TestGetMaxLength(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:       "varchar",
+		DataType:   schemapb.DataType_String,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "16"}},
+	}
+	maxLength, err := GetMaxLength(field)
+	assert.Nil(t, err)
+	assert.Equal(t, 16, maxLength)
+
+	field.TypeParams[0].Value = "invalid"
+	_, err = GetMaxLength(field)
+	assert.NotNil(t, err)
+
+	field.TypeParams = nil
+	_, err = GetMaxLength(field)
+	assert.NotNil(t, err)
+}
+
+func TestEncodeArray(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:        "array",
+		DataType:    schemapb.DataType_Array,
+		ElementType: schemapb.DataType_Int16,
+		TypeParams:  []*commonpb.KeyValuePair{{Key: common.MaxCapacityKey, Value: "3"}},
+	}
+	decode := func(value []byte) (*schemapb.ScalarField, error) {
+		data, size, err := DecodeVariableLengthValue(value)
+		assert.Nil(t, err)
+		assert.Equal(t, len(value), size)
+		return DecodeArray(field, data)
+	}
+
+	array := &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{-1, 300}}}}
+	value, err := EncodeArray(field, array)
+	assert.Nil(t, err)
+	assert.Equal(t, 4+4+2*2, len(value))
+	decoded, err := decode(value)
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{-1, 300}, decoded.GetIntData().Data)
+
+	// empty array
+	value, err = EncodeArray(field, &schemapb.ScalarField{})
+	assert.Nil(t, err)
+	assert.Equal(t, 4+4, len(value))
+	decoded, err = decode(value)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, GetArrayLength(decoded))
+
+	// too many elements
+	array.GetIntData().Data = []int32{1, 2, 3, 4}
+	_, err = EncodeArray(field, array)
+	assert.NotNil(t, err)
+
+	// elements mismatch with the element type
+	_, err = EncodeArray(field, &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1}}}})
+	assert.NotNil(t, err)
+
+	// elements mismatch with the size
+	_, err = DecodeArray(field, []byte{2, 0, 0, 0, 1, 0})
+	assert.NotNil(t, err)
+	_, err = DecodeArray(field, []byte{2, 0})
+	assert.NotNil(t, err)
+
+	field = &schemapb.FieldSchema{
+		Name:        "array",
+		DataType:    schemapb.DataType_Array,
+		ElementType: schemapb.DataType_String,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.MaxCapacityKey, Value: "2"},
+			{Key: common.MaxLengthKey, Value: "4"},
+		},
+	}
+
+	array = &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"ab", "abcd"}}}}
+	value, err = EncodeArray(field, array)
+	assert.Nil(t, err)
+	assert.Equal(t, 4+4+(4+2)+(4+4), len(value))
+	decoded, err = decode(value)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ab", "abcd"}, decoded.GetStringData().Data)
+
+	// truncated element
+	_, err = DecodeArray(field, []byte{1, 0, 0, 0, 3, 0, 0, 0, 'a'})
+	assert.NotNil(t, err)
+
+	// element longer than max_length
+	array.GetStringData().Data = []string{"abcde"}
+	_, err = EncodeArray(field, array)
+	assert.NotNil(t, err)
+
+	// invalid element type
+	field.ElementType = schemapb.DataType_FloatVector
+	_, err = EncodeArray(field, &schemapb.ScalarField{})
+	assert.NotNil(t, err)
+}
+
+func TestAlignRowsToSchema(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{
+				FieldID:      101,
+				Name:         "added_int32",
+				DataType:     schemapb.DataType_Int32,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+			},
+			{FieldID: 102, Name: "added_nullable", DataType: schemapb.DataType_Int16, Nullable: true},
+		},
+	}
+
+	row := make([]byte, 8)
+	common.Endian.PutUint64(row, 1)
+	rows := []*commonpb.Blob{{Value: row}}
+	err := AlignRowsToSchema(schema, rows)
+	assert.Nil(t, err)
+	assert.Equal(t, 8+4+3, len(rows[0].Value))
+	assert.Equal(t, uint64(1), common.Endian.Uint64(rows[0].Value[:8]))
+	assert.Equal(t, uint32(7), common.Endian.Uint32(rows[0].Value[8:12]))
+	assert.Equal(t, []byte{0, 0, 0}, rows[0].Value[12:])
+
+	// rows wider than the schema are cut off
+	rows = []*commonpb.Blob{{Value: make([]byte, 20)}}
+	err = AlignRowsToSchema(schema, rows)
+	assert.Nil(t, err)
+	assert.Equal(t, 15, len(rows[0].Value))
+
+	// rows not ending on a field boundary mismatch with the schema
+	rows = []*commonpb.Blob{{Value: make([]byte, 10)}}
+	err = AlignRowsToSchema(schema, rows)
+	assert.NotNil(t, err)
+
+	// the added varchar takes its default value, which is as long as the string
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:      103,
+		Name:         "added_varchar",
+		DataType:     schemapb.DataType_String,
+		TypeParams:   []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}},
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "abc"}},
+	})
+	rows = []*commonpb.Blob{{Value: row}}
+	err = AlignRowsToSchema(schema, rows)
+	assert.Nil(t, err)
+	assert.Equal(t, 8+4+3+4+3, len(rows[0].Value))
+	assert.Equal(t, uint32(3), common.Endian.Uint32(rows[0].Value[15:19]))
+	assert.Equal(t, "abc", string(rows[0].Value[19:]))
+
+	// the added JSON is null, which is an invalid document of no bytes
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 104, Name: "added_json", DataType: schemapb.DataType_JSON, Nullable: true})
+	rows = []*commonpb.Blob{{Value: row}}
+	err = AlignRowsToSchema(schema, rows)
+	assert.Nil(t, err)
+	assert.Equal(t, 8+4+3+4+3+1+4, len(rows[0].Value))
+	assert.Equal(t, []byte{0, 0, 0, 0, 0}, rows[0].Value[22:])
+}
+
+func TestSplitRow(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		{
+			FieldID:    101,
+			Name:       "varchar",
+			DataType:   schemapb.DataType_String,
+			TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "8"}},
+			Nullable:   true,
+		},
+		{FieldID: 102, Name: "int32", DataType: schemapb.DataType_Int32},
+		{
+			FieldID:     103,
+			Name:        "array",
+			DataType:    schemapb.DataType_Array,
+			ElementType: schemapb.DataType_Int64,
+			TypeParams:  []*commonpb.KeyValuePair{{Key: common.MaxCapacityKey, Value: "4"}},
+		},
+	}
+	pk := make([]byte, 8)
+	common.Endian.PutUint64(pk, 1)
+	varchar, err := EncodeVarChar("abc", 8)
+	assert.Nil(t, err)
+	varchar = append([]byte{1}, varchar...)
+	int32Value := make([]byte, 4)
+	common.Endian.PutUint32(int32Value, 7)
+	array, err := EncodeArray(fields[3], &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}}})
+	assert.Nil(t, err)
+
+	var row []byte
+	row = append(row, pk...)
+	row = append(row, varchar...)
+	row = append(row, int32Value...)
+	row = append(row, array...)
+	values, err := SplitRow(fields, row)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{pk, varchar, int32Value, array}, values)
+
+	// the trailing fields are missing
+	values, err = SplitRow(fields, row[:len(pk)+len(varchar)])
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{pk, varchar}, values)
+
+	// truncated in the varchar
+	_, err = SplitRow(fields, row[:len(pk)+3])
+	assert.NotNil(t, err)
+	// truncated in the int32
+	_, err = SplitRow(fields, row[:len(pk)+len(varchar)+3])
+	assert.NotNil(t, err)
+	// truncated in the array
+	_, err = SplitRow(fields, row[:len(row)-1])
+	assert.NotNil(t, err)
+}
+
+func TestGetScalarFieldValue(t *testing.T) {
+	assert.Equal(t, true, GetScalarFieldValue(genFieldData("bool", 100, schemapb.DataType_Bool, []bool{false, true}, 1), 1))
+	assert.Equal(t, int32(2), GetScalarFieldValue(genFieldData("int", 101, schemapb.DataType_Int32, []int32{1, 2}, 1), 1))
+	assert.Equal(t, int64(2), GetScalarFieldValue(genFieldData("long", 102, schemapb.DataType_Int64, []int64{1, 2}, 1), 1))
+	assert.Equal(t, float32(2), GetScalarFieldValue(genFieldData("float", 103, schemapb.DataType_Float, []float32{1, 2}, 1), 1))
+	assert.Equal(t, float64(2), GetScalarFieldValue(genFieldData("double", 104, schemapb.DataType_Double, []float64{1, 2}, 1), 1))
+	assert.Nil(t, GetScalarFieldValue(genFieldData("vector", 105, schemapb.DataType_FloatVector, []float32{1, 2}, 2), 0))
+
+	nullable := genFieldData("nullable", 106, schemapb.DataType_Int64, []int64{1, 0}, 1)
+	nullable.ValidData = []bool{true, false}
+	assert.Equal(t, int64(1), GetScalarFieldValue(nullable, 0))
+	assert.Nil(t, GetScalarFieldValue(nullable, 1))
+}
+
+func TestPrimaryKeys(t *testing.T) {
+	assert.True(t, IsPrimaryFieldType(schemapb.DataType_Int64))
+	assert.True(t, IsPrimaryFieldType(schemapb.DataType_String))
+	assert.False(t, IsPrimaryFieldType(schemapb.DataType_Int32))
+
+	intIDs := &schemapb.IDs{}
+	AppendPKs(intIDs, int64(2))
+	AppendPKs(intIDs, int64(-1))
+	assert.Equal(t, 2, GetSizeOfIDs(intIDs))
+	assert.Equal(t, int64(2), GetPK(intIDs, 0))
+	assert.True(t, IsValidPK(GetPK(intIDs, 0)))
+	assert.False(t, IsValidPK(GetPK(intIDs, 1)))
+	hash, _ := Hash32Int64(2)
+	assert.Equal(t, hash, HashPKs(intIDs)[0])
+
+	strIDs := &schemapb.IDs{}
+	AppendPKs(strIDs, "b")
+	AppendPKs(strIDs, "")
+	assert.Equal(t, 2, GetSizeOfIDs(strIDs))
+	assert.Equal(t, "b", GetPK(strIDs, 0))
+	assert.True(t, IsValidPK(GetPK(strIDs, 0)))
+	assert.False(t, IsValidPK(GetPK(strIDs, 1)))
+	hash, _ = Hash32Bytes([]byte("b"))
+	assert.Equal(t, hash, HashPKs(strIDs)[0])
+
+	assert.True(t, LessPK(int64(1), int64(2)))
+	assert.True(t, LessPK("a", "b"))
+	assert.False(t, LessPK("b", "a"))
+	assert.Equal(t, 0, GetSizeOfIDs(nil))
+
+	fieldData := &schemapb.FieldData{
+		FieldName: "pk",
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}}}},
+	}
+	ids, err := GetPKsFromFieldData(fieldData)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, ids.GetStrId().GetData())
+	fieldData.Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+		Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: []float32{1}}}}}
+	_, err = GetPKsFromFieldData(fieldData)
+	assert.Error(t, err)
+}
+
+func TestPartitionKey(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	assert.Nil(t, GetPartitionKeyField(schema))
+
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID: 102, Name: "tenant", DataType: schemapb.DataType_String, IsPartitionKey: true})
+	assert.Equal(t, "tenant", GetPartitionKeyField(schema).GetName())
+
+	assert.True(t, IsPartitionKeyType(schemapb.DataType_Int64))
+	assert.True(t, IsPartitionKeyType(schemapb.DataType_String))
+	assert.False(t, IsPartitionKeyType(schemapb.DataType_Float))
+
+	assert.Equal(t, []string{"_default_0", "_default_1"}, PartitionKeyPartitionNames("_default", 2))
 }
@@ -38,3 +38,21 @@ const (
 	// DataNodeRole is a constant represent DataNode
 	DataNodeRole = "DataNode"
 )
+
+// CacheOpType is the operation applied to the privilege cache of Proxy
+type CacheOpType int32
+
+const (
+	// CacheAddUserToRole binds a user to a role, the op key is encoded by funcutil.EncodeUserRoleCache
+	CacheAddUserToRole CacheOpType = iota + 1
+	// CacheRemoveUserFromRole unbinds a user from a role, the op key is encoded by funcutil.EncodeUserRoleCache
+	CacheRemoveUserFromRole
+	// CacheGrantPrivilege adds a grant, the op key is encoded by funcutil.PolicyForPrivilege
+	CacheGrantPrivilege
+	// CacheRevokePrivilege removes a grant, the op key is encoded by funcutil.PolicyForPrivilege
+	CacheRevokePrivilege
+	// CacheDeleteUser removes all the bindings of a user, the op key is the username
+	CacheDeleteUser
+	// CacheDropRole removes all the bindings and grants of a role, the op key is the role name
+	CacheDropRole
+)